@@ -29,15 +29,18 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WAo\xdc6\x13\xbd\xebW\f\xf2\x1dr\xf9\xa4M\xd0C\v\xddR\xb7\x05\x82&\x86a\a\xbe\x14=P\xe4\xec.c\x8ad\xc9\xe1\xa6ۢ\xff\xbd\x18R\xf2j%\xd9\x1b\a\xa8n\"\x87of\xde\xcc\x1bQU]ו\xf0\xfa\x1eC\xd4ζ \xbc\xc6?\t-\xbf\xc5\xe6\xe1\x87\xd8h\xb79\xbc\xad\x1e\xb4U-\\\xa5H\xae\xbf\xc5\xe8R\x90\xf8\x13n\xb5դ\x9d\xadz$\xa1\x04\x89\xb6\x02\x10\xd6:\x12\xbc\x1c\xf9\x15@:K\xc1\x19\x83\xa1ޡm\x1eR\x87]\xd2Fa\xc8\xe0\xa3\xebÛ\xe6\xfb\xe6M\x05 \x03\xe6\xe3\x9ft\x8f\x91D\xef[\xb0ɘ\n\xc0\x8a\x1e[\xe8\x84|H>\xa0wQ\x93\v\x1acs@\x83\xc15\xdaUѣd\xb7\xbb\xe0\x92o\xe1\xb4QN\x0f!\x95t~\xcc@\xb7#\xd01o\x19\x1d\xe9\xd7\xd5\xed\x0f:R6\xf1&\x05a\xd6\x02\xc9\xdbQ\xdb]2\",\f\xd8A\x94\xcec\v\xd7\x1c\x8b\x17\x12U\x050P\x90c\xabA(\x95I\x15\xe6&hK\x18\xae\x9cI\xfdHf\r\x9f\xa3\xb37\x82\xf6-4#\xed͂\xb2l;\x12\xf6n\x87\xc3;\x1dٹ\x12\x84K0f\xae9\xc5\xfa\xe9\xe8\xf1\f\xe5D\x04L\xf6\nb\xa4\xa0\xed\xae:\x19\x1f\xde\x16*\xe4\x1e{\xd1\x0e\xb6Σ}w\xf3\xfe\xfe\xbb\xbb\xb3e\x00\x1f\x9c\xc7@z,Oy&}9Y\x05P\x18eОr\u05fcf\xc0b\x05\x8a\x1b\x12#\xd0\x1eGNQ\r1\x80\xdb\x02\xedu\x84\x80>`D[Z\xf4\f\x18\xd8HXp\xddg\x94\xd4\xc0\x1d\x06\x86\x81\xb8w\xc9(\xee\xe3\x03\x06\x82\x80\xd2\xed\xac\xfe\xeb\x11;\x02\xb9\xec\xd4\b¡GNO\xae\xa1\x15\x06\x0e\xc2$\xfc?\b\xab\xa0\x17G\b\xc8^ \xd9\t^6\x89\r|t\x01AۭkaO\xe4c\xbb\xd9\xec4\x8dz\x94\xae\xef\x93\xd5t\xdcdi\xe9.\x91\vq\xa3\xf0\x80f\x13\xf5\xae\x16A\xee5\xa1\xa4\x14p#\xbc\xaes\xe86k\xb2\xe9\xd5\xff\u00a0\xe0\xf8\xfa,\xd6E-˓\xc5\xf2L\x05X-\xa0#\x88\xe1h\xc9\xe2D4/1;\xb7?\xdf}\x82\xd1u.Ɯ\xfd\xcc\xfb\xe9`<\x95\x80\t\xd3v\x8b\xa1\x14q\x1b\\\x9f1\xd1*ﴥ\xfc\"\x8dF;\xa7?\xa6\xae\xd7\xc4u\xff#a$\xaeU\x03WyHA\x87\x90<\xabA5\xf0\xde\u0095\xe8\xd1\\\x89\x88\xffy\x01\x98\xe9X3\xb1_W\x82\xe9|\x9d\x1b\x17\xd6&\x1b\xe3\b|\xa2^\xf3\xb1v\xe7Qr\xf9\x98A>\xaa\xb7Zfm\xc0\xd6\x05\x10\v\xfb\xe6\fz]\xba\xfc\x94\xe1wG.\x88\x1d~p\x05sn\xb4\x1a\xdb\xec\xcc\x18\x1cO\x96\"c\\7\\`\x03\xd0^\xd0D\xbf$\xb4}\x1c\x03\xab\xf9<S\x84\\\b\xc1r\xb6\xc2J\xfc%w\x94\x95\xc7\v9}\\9\xc2)\xed\xdd\x17p[B;\x05\x1db]ɤC\bɾ(\xd8\xf3a~!\xcc\xdb3c\xd0Vq\x1b\fӔ\x9d\x8c\xd4s]\xd1*\b\xe7\xdf\xcd\xe9\x836\xf5Kw5<8\xaf\xc5\xcaz\xc0HZ\xael\xbcz\xf5\xb2|\x19\xe6\xbdb\xa1m5\x86\x8b\x19\x9f\x9b\x8f}\xb6M\xc6\fX\xb5t\xbd\x17\xa4;\x83\xeb.\xf9a\x99\xe8\x82r,\xb3\xee\xdb\xfb\xeb\xc0\xdfz|\xbc\x1d\\\xc8\xe0\xfe\xdcz*\x94\xb2\x90C)B|\xae^0j#\x82wj\bb8\x179\xbf\x17\xe4\xc0-\xae\x03ξ\x18\xf5\xfa8\x98٬\xa9kf2\xaf\xf1l{\xc6\xdfW\x8dK\x12\x94\xe2K\x06f>0\x92-S\bhi\x80\xc97\x88o\x1e\x99FD\x9a\x8c\v\xbe\xcd]\xe8\x80\x0f\xcb\x13c`\f\x06\xc4\v\xd3\xf9\xf2E̿\xba\xb9hk\x93e\xebB/\xa8\\\x17k\x06ZX\xf0\xb5\\t\x06[\xa0\x90\x96\xdb\xcf\xcdQ\x8cQ\xec.e\xf7\xb1X\x95\xcb\xc5p\x04D\xe7\x12=A=\xed\x97Q\xc0\x85r\\\x88\xd4\xefE\xbc\x14\xe7\r۬5\xc4\xec{\xf5\\\bO\xcd\xcck\xfc\xb2\xb2z\x8bB-u\\õ\xa3\xf5\xad'3\\U\xc5b1\xf2=LM\xea\x1c\x8b\x90\xa7+\xa9{\xbcW\xb6\xf0\xf7?\xd5IXBJ\xf4\x84\xeaz\xfe\a6\xcc\xf7\xf1\x87*\xbfJg\xcb\x0fPl\xe1\xb7߫\xe2\n\xd5\xfd\xf8\x93ċ\xff\x06\x00\x00\xff\xff\xc8p\x98۸\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=Msܸrw\xfd\x8a.\xe5\xe0\xf7\xaa4\xa3u吔n^\xd9[Q\xedƫ\xb2\xf4\x9cC*\a\f\xd93\x83\x15\t\xf0\x01\xe0ȓT\xfe{\xaa\x1b\xe0\xe7\x80$F+\xbd\xb7/e\\\xec!\x81F\xa3\xd1\xe8/4[\x17\xab\xd5\xeaBT\xf2+\x1a+\xb5\xba\x01QI\xfc\xe6P\xd1/\xbb~\xfaW\xbb\x96\xfa\xfa\xf0\xfe\xe2I\xaa\xfc\x06nk\xebt\xf9\x05\xad\xaeM\x86\x1fq+\x95tR\xab\x8b\x12\x9dȅ\x137\x17\x00B)\xed\x04=\xb6\xf4\x13 \xd3\xca\x19]\x14hV;T\xeb\xa7z\x83\x9bZ\x169\x1a\x06\xdeL}\xf8a\xfd/\xeb\x1f.\x002\x83<\xfcQ\x96h\x9d(\xab\x1bPuQ\\\x00(Q\xe2\rlD\xf6TWv}\xc0\x02\x8d^K}a+\xcch\xae\x9d\xd1uu\x03\xdd\v?$\xe0\xe1\xd7\xf0#\x8f\xe6\a\x85\xb4\xee\xe7\xde\xc3_\xa4u\xfc\xa2*j#\x8av&~f\xa5\xdaՅ0\xcd\xd3\v\x00\x9b\xe9\no\xe03MQ\x89\f\xf3\v\x80\xb0\x1c\x9er\x15\x10>\xbc\xf7\x10\xb2=\x96\xc2\xe3\x02\xa0+T\x1f\xee\xef\xbe\xfe\xf3\xc3\xe01@\x8e63\xb2rL\x14\x8f\x18H\v\x02\xbe\xf2\xb2\xc0\x04\xf2\x83\xdb\v\a\x06+\x83\x16\x95\xb3\xe0\xf6\b\x99\xa8\\m\x10\xf4\x16~\xae7h\x14:\xb4-h\x80\xac\xa8\xadC\x03\xd6\t\x87 \x1c\b\xa8\xb4T\x0e\xa4\x02'K\x84?}\xb8\xbf\x03\xbd\xf9\r3gA\xa8\x1c\x84\xb5:\x93\xc2a\x0e\a]\xd4%\xfa\xb1\u007f^\xb7P+\xa3+4N6t\xf6\xad\xc7U\xbd\xa7\xa3\xe5\xbd#\n\xf8^\x90\x13;\xa1_F\xa0\"\xe6\x81h\xb4\x1e\xb7\x97\xb6[.s\xc8\x000P'\xa1\x02\xf2kx@C`\xc0\xeeu]\xe4ą\a4D\xb0L\xef\x94\xfc\xef\x16\xb6\x05\xa7y\xd2B8\f\f\xd05\xa9\x1c\x1a%\n8\x88\xa2\xc6+&I)\x8e`\x90f\x81Z\xf5\xe0q\x17\xbb\x86\u007f\xd7\x06A\xaa\xad\xbe\x81\xbds\x95\xbd\xb9\xbe\xdeIל\xa6L\x97e\xad\xa4;^\xf3\xc1\x90\x9b\xdaic\xafs<`qm\xe5n%L\xb6\x97\x0e3\xda\xc8kQ\xc9\x15\xa3\xae\xf8D\xad\xcb\xfc\x9f\x1a\x06\xb0\xef\x06\xb8\xba#1\xa3uF\xaa]\xef\x05s\xfd\xcc\x0e\xd0\x01\xf0\xfc\xe5\x87\xfaUt\x84\xa6GD\x9d/\x9f\x1e\x1e\xfb\xbc'\xed\x98\xfaL\xf7\x1eCv[@\x04\x93j\x8b\xc6o\xe2\xd6\xe8\x92a\xa2\xca=\xf71\xeb\x16\x12\u0558\xfc\xb6ޔ\xd2Ѿ\xff\xb5FKL\xae\xd7p\xcb\"\x066\bu\x95\x13g\xae\xe1N\xc1\xad(\xb1\xb8\x15\x16\xdf|\x03\x88\xd2vE\x84Mۂ\xbet\x1cw\xf6T\xeb\xbdhd\xd9\xc4~y\x81\xf0Pa6804Jne\xc6\xc7\x02\xb6\xdat\xf2\u008b\xab\xf5\x00d\xfc\xc8Rˬ|P\xa2\xb2{\xedH\xfe\xeaڍ{\x8c\x10\xba}\xb8\x1b\rh\x90\t\xa8\xb1X\xa9-\xe6tΞ\x85t\x84\xde\tL @\xf0\x95%L\x03\x8f%Mm\xc1\xd5F\xf1)\xfd\x82\"?>\xea\xbfX\x84\xbcffmt\xc5\x15lp\xab\rF\xe0\x1a\xa4\xf1\xd4\x19\x8d!\xc2XFI\xd7n\r\x8f{$2\x8a\xbap\x81聾\xf7?@)U\xedp}\x02mb\x83=Q\x18\x8c_\x81}\xd4_\xd0:\x99-\x10\xefctP\x8f\x80\xcf{t{4t\xf0\xf8\x05˲\xc8\"7\x1d\x89\x9dxB\x10a\xdbY&\x16\x05T\xba\x11\xdf\x166\xc7\x06٩\x05n\xb4.P\x8c\xc5+~ˊ:Ǽ\xd5w'\xcc3Zݧ\x93\x01l\v\b\xa9Hܐ\xf6%\xf4T\xf7\x964Zdq\xc2 Ё\x97\xca\xc3ce\xb5\xc7(gS\x93\x0e\xcb\bn\xb3\xdb\alc\x88M\x817\xe0L}\xcaH~\xac0F\x1c'\xe8\xd2\xd8E\xa9di\xfb\a\xf1[Ȍ\x15w+d\x992^͋(k\xff\x81\x89\xb2\xd7\xfai\x89\x10\xffF}:\x85\x01\x19\x9b\x97\xb0\xc1\xbd8Hm\xc2҃\xfe\xde \xe07\xccj\x871\xfe\x17\x0er\xb9ݢ!8\xd5^X\xb4\xdef\x98&ȴ\f\x04\x96\x1a\x93\x9by\xb2\x8en#\x89Sy\xe5S\xa8Ӂ\x1e\x9f\xab\xa6\x11\xa2$\xa6\xc8\xdeS\xb9<ȼ\x16\x05He\x9dP\x99_\x8fh\xf1:]\x0f\xccm\xf2\t\xce^\x8f4\x98\xd3N\ft\x8aV\b\xda@I\x8a\xf4\xb4\xebX\xf5wmj\xd9\x1bA\xd2I{\x165u\x816L\x95\xb3\xb2\xead\xc0\xd5$\xe8vG\xbc\x11V\x88\r\x16`\xb1\xc0\xcci\x13'\xc7\xd2&\xfb\x96\"\xd7&\xa8\x18\x91pC\xe5\xd7-l\x06$\xb0f\xdc\xcbl\xef\xed#\xe2 \x86\x03\xb9F˧\\TUq\x9cZ$,\xed|\x98d\xee\xa0wm\xe1ȏ\xe1\xc5\x0e\u007f\xd7\x12dc\xd7\x16\xa4䐲-;\x80ӳ\xcb\xfe\xffI\xd8F쿀i\xefN\x86\xbe.\xd3\x12I%\xf9Aw[\xc0\xb2r\xc7+\x90\xaey\xba\x04\x91\x8c\x95n\xfe\u007f\xe0\x8d9\x9f\xe3\xef\xc6#_\x95\xe3gwe\t\"\xedJ;\xfd?র\xb2x\b\xba\"yC~鏺\x02\xb9m7$\xbf\x82\xad,\x1c\x9a\xd1\xce\xfc\xae\xf3\xf2\x1a\xc4H\xd1w\xd4J\xe1\xb2\xfd\xa7ody\xd9.R\x97H\x97\xf1`o\xbf6\xf6\xfcP1/\xc0\x05\xf6\xec\xa5\xc1\xd2G\f\x1e\x99\x9a\xdd\x13\xb6\xa8>|\xfe\x88\xf9\x1cy \x8d\xf3N\x16\xf2a\x84l\u007f\xea`\x94\xa7.#\x98>\xad\u007f\xe3cAW \xe0\t\x8f\xdeb\x11\nhs\x04M4\xe1\xe9\x9c\x12\x87\x83R\xccdOxd0!ʴ8:\x95\x15|{\xc2cJ\xb7\x11\x01\t'iC\xf4\x8c(I\x0f\x98\x10\x1c\x94H'\x1epİ\x91Eˋ\x83tAҴ\x86\xf6/Xf\xbbm\xbdh+o\xec;뷈N\xc1^V\x89\v%5\a\x16\xf9\xb441ï\xa2\x90y;\x91\xe7\xfb;5m\r\x0f\xdbg\xed\xee\xd4\x15|\xfa&m\b\xdb~\xd4h?k\xc7Oބ\x9c\x1e\xf1\x17\x10\xd3\x0f\xe4㥼\xd8&:\xf4\x83\x8f\t\xcc\xed\u06dd\xf7\xf0\xda\xed\x91\x16\xee\x14\xf9-\x81\x1e\x1cJ\xf6\xd3\xcd\xeb\x87a+k\xcb\xd1E\xa5ՊU\xe5:6\x93'v\"Hm\x06;r\x8aZ;\xa9\x9f0\x11\xec#i\x12?\xde\a\xc7\v\x91a\xde\x04\xc78\xa4+\x1c\xeed\x06%\x9aݜ\xe2跊\xe4{\x1a\n\x89R\u05f739,M\xb57-\x88\xee|\x19\x99\x15\x9d܄^\xcdf/v\x9d\x88\xe4Nw]^\x11\xabX\xb6?\x16\xa9+\xf2\x9c/\xe1Dq\u007f\x86\xc4?c/Nu\xbfG\xcck\xc8Rp\x90\xf1\u007fH\xcd1C\xff/TB\x9a\x843\xfc\x81\xef\xd4\n\x1c\x8c\rQ\xac\xfe44\x83\xb4@\xfb{\x10\xc5\xe9\x1dAdq\x9ad\v\x16^\x91\xeb\xed\x89\xc5r\x05\xcf{m\xbdN\xddJ\x8c\x86T\x87MZ\xb8|\xc2\xe3\xe5Չ\x1c\xb8\xbcS\x97^\xc1\x9f-nZkA\xab\xe2\b\x97<\xf6\xf2\xf7\x18A\x89\x9c\x98ԍ\xef.SMe\xf2%\x1bK\x80\x06\xb6\x17vd\xe6\xcea\x9dć\x95\xb6\x91k\x88\tT\xee\xb5u>\xb280Kωb\x81\xe7\xa1\x10\xbd\x02\xb1\xf5W\xa6\xda4\x97a$\xf6F\x01W\xda5;/ai\x1bۈ\x98\aJ\x8e\xd5ew\x82\xbd<\xbd\xf47d<\x89\xc8ظX\x84[\x19\x9d\xa1\xb5\xf3,\x92 \xad\x17\x82\x84m\x80Px\a\xc6\xdf4\xcd\a%\x9b\x96n\x90\x12\x91\xce4\xe5?}\xebE/\xe9\xf0\xd3\xef%\xe6;\x17/\xe03[\x96b|\xa5\x9a\x84\xe2\xad\x1f\xd9\x1c\x93\x00Ȼ\x06fW\xf3QO\xb7 \x03#\xfd\x11\xd4t)\xd5\x1dO\x00\xef_]\xad\xb7B\x12_b\xb8\xdf6c;\xa2\xb7\x0f\xf8\xf4\xa6ZD\x9a#\xf7\x06\a;w\x1a\xe7&C1\x11\xa4Ү\x1fN \xb8\x95\xce\xdfY\xd8Jc]\x1f\xd1T\xa6\xa8\x17N\u007f\xd7\xce\xf5\x9c\xd4'c^\xe48\xfd\xeaG\xf6\x02Y{\xfd\xdc\\LO^f\xc6\x1a_\n!\xc8-H\a\xa82]+\x0e\xbf\xd0Q\xe7)\xfc\x16x\x01\x9dL\xb24\x01A\rU]\xa6\x11`\xc5\\'\xd5l\x9c\xa6\xdf\xfd'!\x8b\xb7\xd867u\u007f\x1fk\x83mk.\xf2\xfb\x19\x06\xa5\xf8&˺\x04Q\x12\xe9Sݞ\xad\xbf\xfe\x1f\xecx\x9b\x04\xc0pY\x8d8M\x87\xaa*Х\x9eH\u007f\xddO\xc7\xc4\xca\x1c[\xc5\x1c\xb8@+\x10\xb0\x15\xb2\xa8M\xa2\x84<\x8b\xb6\xe7\xf8\x1aAX\xbc\x9e\x13\x916\xf9\x8aI\x91\x10\x88M4\x16\xe7\xa5ue\xd2M\xc5{\x83i\xe6\xd9RP\xba1\xcf*#\x89\x97\xf4k[h\x81ń:~7\xd1N\xdaw\x13m\xa1}7\xd1&\xdbw\x13m\xb9}7\xd1B\xfbn\xa25\xed\xbb\x89\xf6\xddD\x9b\xeb6'\xad\x970\xf2\x9f*L\xbc\\\xc4\"\xe1zz\x0e\xc5\x19\xf8!\x9b\xe2\xd6\u007f\xb6\x90\x9aay\x17\x1f\x15ɫ\r\xdfC\xac\xf8S\x8e\x18\atI\x17\x9d*iS.\xe9\x804\xec\xed3\xaf\x17\x920\x93\xd2)\xe3ٷ)\t?Ki>\xc3<\xd36ͦI4\xd5\xcd$\x11:4\x9f\x84\x90\xd9\xdb\xcf!\x19\xe6밝\xdb`\xfaw\xcfAMH\xc5YH\xc0\x99O̝\xa3\xd7\xc8\xf5\x18\x12\xcc\f\x12F\xff0\xf4ZȒ\x99\u038d\t7A\xe8\xc4\xe1\xfdz\xf8\xc6\xe9\x90)\x03\xcf\xd2\xed#Kyޣ\xe2;,\xb5맽6\xfc\x16\xbe\xcd\x19\xd3\x11\xb4\x01%\v&\xe7\f\xb7\x0e\xc8\v\xbfVޅ;\xfb\\λ\x1fi\xb94/Π\x19f\xc8L\x88\xe8s\xaf\x8c\xd2\x13\x85\xd3sd\xe6\x93Z\xceɌ\x19\xe7\xbdL\x02]·I\xf1\x1c\x17r_^\x90\xf1\x92\x98\xed\xf8\xbb/\xc6RrZ^\x94ɲ\x98\x10\x98\x98\xbf2\xccL\x99\ayF\xd6J\x12q\x963T\xce\xceK\ty \xb3\xebH\xceF\x89\xe4\x99\xcc\x02\x9e\xccA\x99\xcb.Y\x88J\x9df\x9e\xa4\xe7\x94̂\xe6|\x93\xe5L\x92\xd7\xcb\x17}\r\x1bxZ\xd4,f\x83,\xda\xc8\xf3\xf8-\xe6{\x9c\x93\xe5\xb1H\xb1\x17ft\xb4\x19\x1b\x13\xf3\x9e\x9b\xc71\xccӘ\x00\x9a\x92\xbd1\x91\x9d1\x01q6g#5'c\x02\xf6\x82ڝ咙\x97\xf1/HaQ\xbf\x15\u007f+\x8ez\xe9´\x19\x98\x8bK\x16\xfa\xaf\xa3\ued17\x8d\xd54o~\xc6,O\xe9\xf6盟e]8Y\x15\x1c\xce?\xc8<\xea4\xba=\x1e\xe1Y\x16\x05\x89\xd5\xdf4\u007f\xe6\xb492\xa4_\xbf\xb4\xec\xb9\x1e\x19\xd1\xc2\xc23\x16\x05\x88\x18s\x9d\xac<\xf3\x1fAgz\x85$\xf3\xe9\xc0\x85O>÷\xd2W\x9e\x83\xf9K\xaeX\xc4\xd3\xed\xb1$(ͷ\xa3g\xb8\x1f\xf3\x06\xa2\xb7e\xf9\xd9_k4G\xd0\a4\x9dŰ\xf0\x1d\x81?h\xb6.\xbaĭ ?\xfc\xa7\xf7#ù;p\xf0Ay\x15\x16\x05;\u0091\xe1Й/ڽ&\xf1F~\xc0D\xd7x\xe0C\xb7\xa3#\xef\x97l\xcf\xd4$\xfc\xb7u\x1d\xcew\x1e\x16\xd5\xf6\x9b8\x10/w!f@\xa6&է]@-&ѿ\x95+\xb1\xe4L$[QiI\xf2o\x91\x1c\u007fFR\xfc\x19N\xc5ynE2\x99R\x92\xdf\xdfĹxC\xf7\xe2-\x1c\x8c\x97\xb9\x18\v GI\xed)\xe9\xeaI\x97\xab\xc9\xf7\v)\x97\xa3\xcbW\x00\xf3i\xe8\t\xe9\xe7\t\x97\x03K\x98&\xa4\x99\x9f\x97^\x9e@\xc37r>\xde\xc8\xfdx\v\a\xe4m]\x90E'd\x91sf_\xbf8\xba\xacM\x8ef6\x18\x9f\xcaj\xb3L6\xf2\x17\x86s\x8e\xbe\xa8mj\xa4P\xaf\x81i\x1a\v)\xb7_\u007ff\xf0\xb3T\xb9\xdf\x0fb\xaa\x9e\x1e\xe7bJ\x9c\xff\xde\x1a\x15\x9d}\x16\a:\xbaT\xb0X\t\xc3ն6G\u007f1i\xd7\xf0Id\xfbaG\xd8\v\v[mʨ\xc1t\xd9\xde\xc8\\7\xa3\xe8\xc9\xe5\x1a\xe0'\xdd^z\xf5+*XYVő\xfc\x00\xb8\x1c\x0ey\x19\x03D\x99ǆ\xba>\xa1\xdc͂\xaf\xf70\xec\x1d\xb9\xbck\x8a\xddd\x85\xae\xf3\x16\xfa\xc4\xe6\tu\x84\xfb\xafl\x93p\x99\x90\xac+\x99\x12\xac\x8e\xc6\xe7\x1bWT\xf9\xf1\xf5/\xf3\xac\xd3F\xec\xf0\x17\xed\v6-Qb\xd8{P\xad+Ȋ\xe6r\xbd\xf9\xf6\"\xa6CC\xe9\xa8\x11\xb0.g&\x9c\x86\ue793\xb0\x8c\t\x91\x99\xf3\xe7\\\xb1\xb0\x98\xc7\xc7_\xfc\x02\x9c,q\xfd\xb1\xf6\x17\xa7\xabJ\x18\x8bD\xcdfa~І\xfe\xbb\xd7ϱ؆\x0ek\xfeq\x8c\xb7A\xce\xcb\xe1\xfbٳ\xb0?\f\xcaO5$Zbԯ\xf1Q=Ǭ\xb7I\xfe\x94G\x1d\xf2)8\xbd\n|\x1c\xb2\xe0\xefj^\xb7\xccϔԞ\xaaQ\xc6u\xb9\x96\xab\x94\xf9\xf2]\xa1&a\xc8\xee\xaa\r\xd7\xe8\t\xa5\xbd\xb8\xa6\xcd\xcb\n\x95\xf9d\x94A\x9d\xc8\xf9}\xba=\x1d\xc1\xd5\x00M\xde+T\xd6\x16\xcez\x16\xb6Mx\x89*\xd2\x0e\x9c\x1fɖ,A\xc3\x1c\xf0\x80\n\xb4\xe2\xfc\x16\xae~\xe3+V\x8e\xc7D\xa0\xf6\xa1\x84\x04\x9a\xba*\xb4ț\x13\xde\xe8\xacP\xe5\xf0\x91\xe5\x979\xa0ygg`rq\xb0\xad61\"\x9c\nL\xafXn \x17\x0eWQ\xa0I\xb2/\xcal\x99\x95CF\xb7\x1f\x9c#\xbf f+\x8f+\xcdM\x8dl\xf4\xaf\xd3N\x14\xa0\xear\xe3\x15\xbah:\xc4\xf6\xef\xa4ޜ\r\x19O3\xc7\xcb/L*\x87\xbb\x93\x98\xe2\xe9\xcan\x1b\xfe9{e\xedȩ\x95\xd9:\xcb\xd0\xdam]\x141Ӿ\xe5\xdc\xd7_&\xe7\xf2-\xd68\xe3N^\x04r\"`S\x88\xceg\x02\x96h\xad\xd85\xc5͞I\x03\xedP!\x1b>\xb1x\xa3w\f\xbḇai/\x1f\xc1\x12\x99\xabE\x98\xa0\xb9\xf9\xef\xf5z\x17\xb3\v\n\xbd\x83\xad,\xb8k\xa8_\x19T\xf3\x994\xf9VI\x93\xa2\xca?\xb5\x1d\x896\x1c|\xe6\x8d\xe8\xea\xbcb!w\x92\xf4 m\xd2N\x98\x8d\xd8\xe1*\xd3E\x81\x9cf~\x8a\xd7[\x1e\u0590\x9f\xf7\x05\x85]\\\xdaO\xfd\xbe!\xd2\xe1w\xdbW\xc6\x10\xbe@!\x97\xfdt\xd2`WG\xf7\x04!\xcd\x13\x9f\xa5\xba=\x15\xa2\x15gO1\xed\xf7m\x0eX\x90\xab\x1eNS\x80\xf6*\x18\x83qo\xb6\x14\xbfis\x05\xa5T\xf4\x0fY\xfc\x1c\x8ah\x06\x9f\x85?\u05ec[\xc0\xfb\x9e\xfa\xb4i\xd2=E\x8á\x982U㩱+\xf8\x8c\xa7\x96\x95\xcfvŜ\x83o\xb12\xbb\xd4\xe5N\xdd\x1b\xbd#\u007f8\xf2\xb2\x15^\x91w\xf7\xc28)\x8a\xe2\xe8'\x99\x9c=\xf2\xe2#\x92⚴^\xe2d\rX.Q6t\xeb\\o\xa9<'p\x9e\xeaF\xd7n J:Q\x14\x0f\xfb3\xb05|\xd6\x0e\x9b\x88\xae\x1c\xc2$\xe1\x8b֭p\xbb\xd5\xc6yO\u007f\xb5\x02\xb9\r\xd6P\x04.\x9d\t\xbe\x91\xf2UoA\xba\xeeR\xbe\xe3^vt\f\x1fB\xae\xf0T\x8a\xa3\xcfY\x14YF\xc66^['\x8a\x88|\xfb]9Plv\x12\xf7a\xfe\x97\x88\x1dvB\xf0\xbb~\xff\xf6\xc3\xf1V\xbb18O9\xce)\xf7\xb2=\xaa\xe9\x803\x8dQ\xc1\xb3\x91Α<\xed_ف#\tZ\x14`I\xa6L\x94\t\x9c\x93\xec\xfc\x9et\xef\xddt\bq\xe8ߴ\x9d\xa7TwX\x9c\xa6m\xd90\t&\x96\xe5\xbfY\x92\xb6\x19K[\x99\xed\x85\xda\x11S\x19]\xef\xf6\r_NhƩ\b\\MHAU\xd4;b\xf5p]\xe2j\xa3z!\x98p\x81\x92\xf7\xd0\x15\xd9\xd3$\xa6!$\xdcT^\xbf\x0e\x85\xffV[\xa3\xcbU\xd8\v\xbe\xe5\xb8\n\xa1\x11#5\xd9\xff\xe4\xc8O\x00\xed*l1\x1bT\x15*\x106\xe0\x93\xf0A\xd5\xfc\xb6\xce\xc5)\x9c0.իx\x18t^p(\x18r\x1c߇\x10\xf8\xf1\x1f\x96ݎk\xe0_\x81\x95\xaa)\xfa\xee\x03K\x9e\x15,\xf9\x19\x06\xd9W\x8f^`\x9dx\b\x03\u007f`\x88\xfe\xdf\xd6\x158\xb4\x1a\xe6S\x8aM\xf9u\xd4}\x94\x9dK\xa7\xbc\x83\x18\xec\xc0\b=\xfe$\xb7\xfeN-#\xac\xff\xfcwϺ=$\xd9,\xeff\xcd\x15\xb6DZ\xbb\x03>be0\x13Q\xc7\x03\xe0\xbe@\xb2#,\xe2\xd0\x12zw\x96\xc9{x\x99\x13\xf7\x9a\x1e\\\xf3\xf7\b^ǯ9\xbc\xccw{3\xc7\xeduW\xf7,\xb8\x06\xfa\xd2\x19\xfb\x8f\xd0-\xe2\xb9\x05\b\x11\xdf-\xb2\x8c֛[\xf4\xddz\xae[\x83\xe3D\xb5\xeb\x91;\xf7J\xce[T\x0f\x9c<d\x01\x9a\xf7\xcev\x98)<\xe9\x02b\"ː\xd8\xf5\xf3\xf8\xef\x8e\\^\xf2\x8f\xe6O\x8b\xf0\xcfL+\xafn\xed\r\xfc\xe7\u007f]@\x88\xb8~m\xfe\x86\b=\xfc\xbf\x00\x00\x00\xff\xff/`\x9c\x92\xa3e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKo#\xb9\x11\xbe\xebW\x14f\x0f\xbe\x8cZ\xb3\xc9!\x81.\x81F\x93\x00\x83x\xd6\xc6\xc8q\x0eI\x80\xa5Ȓ\xc45\x9b\xec\xf0!\xad\x12\xe4\xbf\aŇ\xba\xd5ݲ\xe4A\xb2ˋ->\x8aU_\xbdٓ\xe9t:a\x8d|F\xeb\xa4\xd1s`\x8dğ=j\xfa媗\u07fbJ\x9a\xd9\xfe\xfbɋ\xd4b\x0e\xcb༩\xbf\xa23\xc1r\xfc\x84\x1b\xa9\xa5\x97FOj\xf4L0\xcf\xe6\x13\x00\xa6\xb5\xf1\x8c\xa6\x1d\xfd\x04\xe0F{k\x94B;ݢ\xae^\xc2\x1a\xd7A*\x816\x12/W\xef?T\xbf\xab>L\x00\xb8\xc5x\xfcI\xd6\xe8<\xab\x9b9\xe8\xa0\xd4\x04@\xb3\x1a\xe7\xb0f\xfc%4\xce\x1b˶\xa8\fOwU{ThM%\xcd\xc45\xc8\xe9\xea\xad5\xa1\x99C\xbb\x90(d\xb6\x92H\x1f#\xb1U\"v\x9f\x89\xc5u%\x9d\xff\xf3\xe5=\xf7\xd2\xf9\xb8\xafQ\xc12u\x89\xad\xb8\xc5\xed\x8c\xf5?\xb4WOa\xedTZ\x91z\x1b\x14\xb3\x17\x8eO\x00\x1c7\r\xce!\x9en\x18G1\x01ȘEjS`BD-0\xf5h\xa5\xf6h\x97F\x85Z\x9f\xee\x12踕\x8d\x8f('Y \v\x03E\x1ap\x9e\xf9\xe0\xc0\x05\xbe\x03\xe6`\xb1gR\xb1\xb5\xc2\xd9_4+\xffGz\x00?9\xa3\x1f\x99\xdf͡J\xa7\xaaf\xc7\\YM:z\xec\xcc\xf8#\t༕z;\xc6\xd2=s\xfe\x99))NZ\a\xe9\xc0\xef\x10\x14s\x1e<MЯ\x84\x10\x10D\b\x05!80\x97\xef\x01\xd8'*\x11\xa3qN\xd5\xe0\xae3\xb6\x89\x15x\xeeQI\xfc\xd3L\xe6\xbeC\xb6\x18~50\xda3\xba\x8b-^\"v\x06\xc5'ܰ\xa0|WTҒ\xea\xda\xe5\xb9X\r\xf2J\xa4Sg7~:\x9bK\xb7\xae\x8dQ\xc8\x12\x95\xb4k\xff}\xb2B\xbeÚ\xcd\xf3fӠ^<~~\xfe\xed\xeal\x1a\xc6\f\xa9\xe7\x14\xa48\xd6\xd1\xcd\x0e-\xc2s\xf4\xbf\xa47\x97E;\xd1\x040럐\xfbV\x89\x8d5\rZ/\x8b\xb3\xa4\xd1\tR\x9d\xd9\x1eOw\xc4v\xda\x05\x82\xa2\x13&;\xca\xfe\x82\"K\nf\x03~'\x1dXl,:Ծ\v\uf271\r0\x9d٫`\x85\x96Ȑ/\a%(\xa8\xed\xd1z\xb0\xc8\xcdV\xcb\u007f\x9dh;\xf0&\x1b\xafG\xe7{4\xa3\u007fj\xa6\xc8T\x03\xbe\a\xa6\x05\xd4\xec\b\x16\xe9\x16\b\xbaC/nq\x15|!{\x97zc\xe6\xb0\xf3\xbeq\xf3\xd9l+}\t\xce\xdc\xd4u\xd0\xd2\x1fg1\xce\xcau\xf0ƺ\x99\xc0=\xaa\x99\x93\xdb)\xb3|'=r\x1f,\xceX#\xa7\x91u\x9d\x82f-\xbe\xb39\x9c\xbb\xbb3^\a^\x9bF\x8c\x9a\xafh\x80\"f\xb2\x82t4I\xd1\x02MS\x84\xce\xd7?\xae\x9e\xa0\\\x1d\x95\xd1G?\xe2\xde\x1et\xad\n\b0\xa97h\x93\x127\xd6ԑ&j\xd1\x18\xa9}\xfc\xc1\x95D݇߅u-=\xe9\xfd\x9f\x01\x9d']U\xb0\x8c\x19\v\xd6\b\xa1\x89~_\xc1g\rKV\xa3Z2\x87\xffw\x05\x10\xd2nJ\xc0ަ\x82n\xb2\xedoN\xa8u\x16J.\xbc\xa0\xafQ/^5\xc8\xcf\xfcG\xa0\x93\x96,\xdc3\x8f\xd1/z\xb8f\x17\xbf\x9cL\xcb\x18wn\x1a\x8cst\xee\x8b\x11\xd8_鱼8m<\xe3\xb1A[K\x17\xd3\"l\x8c\xedg\fv\x8a\xc0\xddQ\"U5XC\x1d\xea!#S\xf8\x8aL<hu\xbc\xb0\xf4W+\xfd\xf0\xa2\v\x8a\xa4\x91X\\\x1d5\u007fD+\x8d\xb8\"\xfc\xc7\xde\xf6\x13\x04;s\x80M4k\xedՑb\x90;j>\x8c\xb6e,\x1e?\x97ț\x1c(\xfb[ƪ\x82E\xf6\\\xb3\x81\x0f \xa4\xa3\x02\xc0E\xa2C\xb0\xa8<\xa3\xf59x\x1b\xde$>7z#\xb7C\xa1\xbb5\xcd%\x8b\xb9B\xba\x87\xdc2\xdeD\xa1\x89\xac\xa3\xb1f/\x05\xda)\xf9\x87\xdcH\x9e9\t6e\xae\x8dD%\xdcP\xd2\v^\x16E\xb1(ȫ\x99\xba\xa2\xc3\xe5ic,\x8d\x99\xd4ɂ[\x021\xd8\xd8:\xa7T\xedQ\x8bS5rƍ\x89Qˡ\x80\x83\xf4\xbb\x14\x0e\u0558\xdf\xc1\xab\xbeG\xe3\x05\x8fc\xd3=ޟvH;S\x02Ep\xc8-\xfahm\xa8\xc8|Ȕ*\x80/\xc1ŀڏ\x13e\xc4B\xad\x9c~\xc1\xe3\x10h\xb8\xa6\xdc\\\xc2\\g\xf9\x8eJ\xe7°\xc5\rZ\xd4~4\xa8Sgb5z\x8cq]\x18\xee(\xa4sl\xbc\x9b\x99=ڽ\xc4\xc3\xec`\xec\x8b\xd4\xdb)\x01>\xcd\x1e4\x8bm\xc5\xec\xbb\xf8\xe7\x82\xc8O\x0f\x9f\x1e\xe6\xb0\x10\x02\x8cߡ%\xadm\x82*\x86֩o\xde\xc7\x1c\xfb\x1e\x82\x14\u007f\xb8\xfb\x16\\L\x93<\xe7\x06lV\xd1\xfa\x8fT\xa8E\xa6\b\xa2UҊ\xb1@\x99\x92\x94]gm\xa6X3f\x88c\x15fwP`\xa2\f2\x16Q_p\x18L_q\xb3\\\xec^\xf1\xb1RHK-$\xa7B\xec\xdc7J\x83!\xce\xea\xed\x11\xc1\xfa\x15\xf8\xa5\x880.x\x12 \xe7\xc3+\x1c?t\xf7\xb6mY\nO9\xc79\xf4T@9\xd0H9\x90\xd9!r1(p\xa35y\xa37\xc0N\xa1\xee\xce\xf5c\xfc\x1b#\xc4:\xf0\x17\x1c\x01~ \xcaǸ\xb1`\x9c\x8e\x11/\xc1a\f\xbe\xd7\u0600\xeb6\xce\xd9\x12\xed-\xbc,\x17\xb4\xf1\x94&\x19,\x17\xb0\x0eZ(,\x1c\x1dv\xa8\xa9C\x90\x9b\xe3\xf8]4\x9e\xeeW\x05\xd5Xa\xe4\x1a\xbf`;.C\x8a\xe1sX\x1fGj\x82\x1b\x84l,n\xe4\xcf7\b\xf9\x187\x16\xc0\x1b\xe6w \xb5\x93\x02\x81\x8d\xc0\x9f\x8a\xb5\v\x82\x9e\xf2\xffC\x8e\"ߠ\x9e\u05fc=\xb1\xf3\x16\x87/\x18_\xf1\x9fǼ\xed\x84B\xf9\x9d#\xffy-xɏG%ڟ\x1e\f\xfe\x94*,>\x92*Ϙy\x1e\x9ex\xa5R+\xcf\x16c\xceLu\x81\xb1\x16]c\xb4\xa0\xe6\xe9\xb6:\xade\xf9\u007fW\xad\x8d\xabuz\x1e\xe5zkE\v7\xb5*\xf1\x89\xe6\xcd\xcdJz\xb8\xea\xb6\x02f\xed\xa8Sl\xfb\x95\x9e\x8c\xbfH\x9b\xf2\xaeӧP?\xac!\xe8X\xa9Ō_\xc1\xdf5|\xa2ޖ\xb2\x93\x98\x13\xdfv\xcc\x00\xa4\x03m\x0et\xbcC/\x92\x00\xa3S\xbe\xa6n\x8di\x91\x9b\xe1\xb8t\x90JQƶX\x9b\xfdhƦBӢ:\x02sd:\xfb\xdfT\x1f\xaaw\xbfZ\x17\xa4\x98\xf3\xd4Ԡ\xf8\x8a{9|\xe5\x19\xa2{?8Q\x1c\xff\xe4\x0e\xf4\xe3\xc7\xd2,\xcfl\xde\xf6\xe3\b\x18\x1b\xa9\xa8\x16\x1c\x89\x13m\xc50|\x8f\xfc\xb8\xba\xbfs\xb1\x84G\xed\xc7ʾ\x03Z\x8c\x1d\x13\n\xaa\xe2M~\x97\bΣ\x1d1\x80\x93\xf6\xa2\xceA\x19\xbd\xed9N\x1a\xf9\x95\x82*\xb4dPƂ@O\xa9Io\x81\xef\x98\xdeb\xfb\n\x95\xf9\u007f\x9dS2\x9f\x9eʹ\x16\"\xf5%\xf3\xb8I\xa3Or\xacL\x1f\xbc\x00\xb7\x9b\xc7_\u007f\v\xf7E\xb3\x17ۜ+\xb8\x0f\xf6\x97,M\xa0N}\xfb\"\u070eooo\x87\xcf\xcd7 \xf1ַ\xf0W\xde5\xe0\xc0\\\xfb*\xfe\xeb\xe1PS\xb5z\xb5\x04\xfe\x92v\xa5\xe7\xc3|\x04\xd8\xda\x04\xff\x9agލ\x19t~\xee\u007f\v\x8f\xf1#Ƶ\"\x83\xf6\x14\x8d\xf0`\xa9\x95l_\xc5bP\x18\xcb-\xb7?/-z\xdfZ\xbak\xc3/17\xc85\x9ak\a\x93)_v\xf4\x9aA\xee΄\xf5\xe9\xa5x\x0e\xff\xfeϤMה\x13\x1b\x8f\xe2\x87\xfeǵw)d\x94/d\xf1'\xa7:&}\x1d\x84\xbf\xfdc\x92\xaeB\xf1\\>i\xd1\xe4\u007f\x03\x00\x00\xff\xff\x1d\r\x93\v\x97\x1c\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xcc[͒ܶ\x11\xbe\xcfSt)\x87\xbd\xecp\xe5J*I\xcd\xcd^\xc5)Ų\xb2\xb5\xab\xf8\xe2\xf2\x01\x03\xf6\f\xe1!\x01\x1a\x00w=N\xe5\xddS\r\x12$H\x82?3\xd2&\x16\xf7\xa0!\x81F\xff\xf7\x87\x06\xb9\xd9n\xb7\x1bV\x8a\x1fP\x1b\xa1\xe4\x0eX)\xf0W\x8b\x92~\x99\xe4\xf4W\x93\bu\xf7\xfc\xd5\xe6$d\xba\x83\xfb\xcaXU<\xa2Q\x95\xe6\xf8\x0e\x0fB\n+\x94\xdc\x14hY\xca,\xdbm\x00\x98\x94\xca2\xbam\xe8'\x00W\xd2j\x95稷G\x94ɩ\xda\xe3\xbe\x12y\x8a\xda\x11\xf7K?\xbfM\xfe\x92\xbc\xdd\x00p\x8dn\xfa'Q\xa0\xb1\xac(w \xab<\xdf\x00HV\xe0\x0e\xf6\x8c\x9f\xaaRc\xa9\x8c\xb0J\v4\xc93\xe6\xa8U\"\xd4Ɣ\xc8i٣VU\xb9\x83\xeeA=\xbba\xa9\x16\xe7\x1bG\xe8\xd1\x13:\xbbG\xb90\xf6\xbb\xe8\xe3\x0f\xc2X7\xa4\xcc+\xcd\xf2\x18#\xee\xb1\x11\xf2X\xe5L\x8f\x06\x9c7\x00\x86\xab\x12w\xf0\x91\x15hJ\xc61\xdd\x004*p\xbcm\x81\xa5\xa9S*\xcb\x1f\xb4\x90\x16\xf5\xbdʫ\xc2+s\v?\x1b%\x1f\x98\xcdv\x90x\xb5'#\x959F\xbc¾>b\xf3۞i\xf1\x94Y\x1c\x13#\xcd%\x1d\xaf\x9fΥ\x9fUS\xe9\x14\x01\xc1\xb3\x9a\xa2\xb1Z\xc8\xe3\xa6\x1b\xfc\xfc\x95\xfbax\x86\x85\xf3\n\xfa\xa5J\x94_?\xbc\xff\xe1\x8fO\xbd\xdb\x00\xa5V%j+\xbcy\xea+\xf0\xcb\xe0.@\x8a\x86kQ\x92\xbc;\xb8!\x82\xf5(H\xc9!р\xcd\xd0\xeb\x14ӆ\aP\a\xb0\x990\xa0\xb1\xd4hP\xd6.\xda#\f4\x88IP\xfb\x9f\x91\xdb\x04\x9eP\x13\x190\x99\xaa\xf2\x94\xfc\xf8\x19\xb5\x05\x8d\\\x1d\xa5\xf8\xad\xa5m\xc0*\xb7h\xce,6>\xd2]Ά\x92\xe5\xf0\xcc\xf2\no\x81\xc9\x14\nv\x06\x8d\xb4\nT2\xa0熘\x04\xbeW\x1aAȃ\xdaAfmivwwGa}<rU\x14\x95\x14\xf6|\xe7BK\xec+\xab\xb4\xb9K\xf1\x19\xf3;#\x8e[\xa6y&,r[i\xbcc\xa5\xd8:\xd6%\tl\x92\"\xfd\x83n\"\xd8\xdc\xf4x\x1dٲ\xfes\xc12c\x01\x8a\x16\x10\x06X3\xb5\x16\xb4S4\xdd\"\xed<\xfe\xed\xe9\x13\xf8\xa5\x9d1zD\xa1\xd1{7\xd1t& \x85\ty@\xed\xe6\xc1A\xab\xc2i\x1ceZ*!\xad\xfb\xc1s\x81r\xa8~S\xed\va\xc9\xee\xbfTh,\xd9*\x81{\x97\xa4`\x8fP\x95\x14\ri\x02\xef%ܳ\x02\xf3{f\xf0\xd5\r@\x9a6[R\xec:\x13\x84\xf9\xb5\xfbGTv\x8dւ\a>\x05N\xd8k\x98֞J\xe4d>\xd2 M\x15\a\xc1]l\xc0Ai`\xa34\x98\xf4H\xc7C\x97\xae:\xf9=Y\xa5\xd9\x11?\xa8\x9a\xe6pP\x94\xb7\xc1\x1c\xcf\x1c\xa5!\x8aP\xfa\x7ft\xe0\x886\x80͘\r\xe2\xd72!\xdb4\x10\x95g\xc6\b\xf4w\xc2\xf3\x13r\x8dvA\x90\xef\xfc\xb8\x18\xf3\xf5\x93[\x102\x90\xa5S\xf0\x8d\x19\xd1n\x8a\x17\x15\x8c\xdbZ\xa6L\xe5iM\xb9\x93\xe4\xc6@ɌyQ:M\xe0S\xefQ\x84be\x9aTy\xc23\x98\x8ciLa\x7f\x06\x96\xe7}\xb2\x02\r\x88\x03\b{c\x00\x8b\xd2^\xa6\xb0\x82Q\xfe\x93Lr\xfcօ\xa0\xe4\xe7\x05\xdd}\x1f\x99Bj\xcc\xd4\v\xa8\x83E\x19\x12m\x8c;\xa2\b\x14ܺ\x92\x171\xdb\xe9\x8b*\xdc\x02\x9b\x9d\xc1h0\b\x99R\xdc4:\xa5E\xbc\xb9)\x10P\xa6s\xd6@Y\x15\xe3\xe5\xb6pR\xa5`\x91\xfb\x1a\x8d\x15<\xf2\xe0͛\xcb\xe4%2\xefS\xcaL\a\x81zQ\xe2\xfep\xefۇ*\xcf\x1bZ[\xae\x8a\x92Y\xb1\xcf1\xbe$]\x94WD\xbd\xe8\xb9.\x0e\xd7\a\xe43\xea6a\xadu\xb0\x1fbs\xfa\x1eFbQ\xc2\xf51:c;\xf0\xf9e\x8f\r7\x98&\xf0\x80Z\xa8T\xf0\x1e\x83\xb4F*\f\xdb瘶1\xf5\x1b!\xd7kE~D\x96\xbec\x96=\xa0\xe6(\xed\x05\x82\x0ffz[\x965!v\xf4\xee;\xa2\b\x13\x8aqy\xe9\x86\f\xc9R\x87|\x1d\xd2\xe1\x19\xf2\x13\xa6\U0001286csH\xab\xa3\b\xe5\x7f\xda\f\xf5\x8b0\xd8W\x9b\x92\xf9\xb9\xa6DL2ۙ\xa7\xcfB4s\x1a\xc9J\x93)\a\x01\x1c\x80P$k\x031Ɗ/د\xa2\xa8\x8a\x1d|\xf5\xf6\xed@\xf1\x00\x85\x90\xf5\xc3\xf1#\x8a\xf8\x1dP\xe6:\xa2\x1e<}&\x04\x8f-\xe6_\xb2R\x7f\xb4\xb7L[\x01\xea\x02F\x1a\xae\xca@\xf8\x11Q\xf0\x15\xcf@\xa9҆\x89f\x9e\xa1\xe2~\x81\xd7Q\x94\b\x8d\x03\x1c\xb8\x85\xfdb\x1d\xdeFK\xc0`\xc80\x11\r\x1e\x0f\xf4\xb7\n\x04Yf\xab\x01&\xe9iyXu\x9f\xdc\x04\xafl^iMQa껴/\x18\xd5\xe9\xb5@\xe8\x84翣D\xbd\x06\x00}\x17\x8e\xf5\xcc\x1c\xbb;MI\xa1\x92\xddw\xfd\x11Y\xa0\xd9(\xb9>\x97\x96\xe2O\xd8,\x81\xf7\x14\x80Br\x8d\x05J\xba\x8d\x8cg`E\x81-YJŴ\x7f\x8f\x05蜏\x9f\xf0\xfc\xd8\xec\xfb\xff\xa1\xf6\xcbb\x06\x83c\x18\x89n7\t\xa5\xb1E\x1e\x93Q;*\xcd\x06\x83\xb4\xd2L\x1f\xda\xea\x96\xd2-\x93\x97Ֆ\x9c\x19\x1bpJ\x9b\xeb\x05\xb9>\x8cgx\xe1\x88X_\xd3\x13\xac\x8eV\x00xa3F9(]0[\xef귴\xc0h\x04uO\xa8\xe6\xec\xc0\xea\n/\xd5@\x00\xc7Vj`0c\xac\x81\x10\xbf\xbd\xb0XҎ\"\xb7W\x97T\x1cW\x05\xe9\x87p\xac\x97N\xa3\xa9r\xeb\x8dZw\x02\xa0hG\xa9È&D\xcdO\xbd\x04&\x15\x15\xc2&\xbb\x82\xa9\xd3+\xe4M~M.\x95{:7u\x1b\xb5裁\xe0\xdf4\xb5\xa3\x11YV\xc5\x1e5\x89\xec\x8b\xcaK\xa6\f\x06\xd5&J\xb2]\x11^P\xb7;p\x92\xbb-Ф\x18\xae\xca6F\xba<7\x96})1\xd1E\xa84G\x8b]KqY\xd8\xfb\xe1\x1c/v\x1bĝu\x0fB\n\x93Ţs\x9d߮\xb0\xe1\x82\xff\xd2\xdf\xcfj\xbfB\xae\x85\x84\v\x9aɾt\xc95\xbc\x14h\f;\xe2\n~\xbe\xafG\x92v\x99\x9f\x06l\xaf*\x1b\x89\xab\x96\xa9\xdb(aj\xf7\xf0\fXm(Ԛv\x1a\x16\x0eL\xe4\xbe\x06^#L\xcd\xc3\nY\x1e\xdd\xc0xJX\xd0g|\vH\xd7\x16\x9e*\xce\x11S\xd7,\x1e_[\xf8\xd6\xc9w\x8dd-8^!\xdcS\v\xa4G\xf1\xdfal\xaa\xd9u\\sU\x8a\t\xa6\xc0Ǹe\xfa\x88v&\xb1\xad\tnc\x99\xb6\x97D\xf6So\xc2LX;\xca\xff郞u\xe4\xb1\xf5\n\xf1>\xf5&\xc4b}m\xf7\xccר.\xfd:(R{2\xa6\xd4J\xbd^\xa2\xae䭖)\xa8\x92\x93Ruc\xa2T\x9d\xddf\xea\x8b\xdf\xd7^\xe0\x99\x13rNlL<\xd8\b\xf7\xe2\xbbͬ\xf0\x1f\x06\xc3\xe3\xf9\xa5\x81\x1c\xe1\xc6yD\x16\xfc\xe0p\v?\xd4[\xb2\xb9Љ\xe7\xe1\xc5\xeb\xd4\xde^\x7f\xe0\xf7Q~SV\xb0#\xa6m\x9a\\!\xe7\xbb\xc1\x14`\xba\x16\xef\xfd;\xe3M\xd5\xe5V\x87\xae\xa2D}O\xc6x&\x9a\xad\x9e\xeb\xd76}\xfc\xe6I\xcfק:<\x9d8\xc0\x99\xbc\xb1\xd4Le\xb6>\xe9pq\x0f%\xd3Vp:\xe6\xecj\xc8-y\xd4K&x6A\x923\x83\xae\xc1<\n\xbb\x1bӑqJ\xe0J\x1a\x91\"5\xa5\xbdHQ\xa2\xc2b1\x01]\x17\r\xe6\a0\xad\xd9\xf9\xd5\xf1T\xe8\xb1\xc9\xe6\nv_\tR\x85|\xadAU\x8d_\x1cT%SP\xfaK#\xad\x85~\xe6Hֵ}̞\xb3\xcd\x05Q\x8d`X\xd0ȼ\x0e\x96|\x11и\xec4s\xb8\xf1\x81\x193\x01\xc1\xb6>\xf7|yH\xf9\x85\xe1X\xa8\x82\xdf\x03\"\x9b\xa9\xebA_c\xb9\a\x16tI>\xb3\aVII\xc7(aWE\xc9/\xd7\x06\x9b\xcc;\x97\xe4\x9cqC5^y\x96\xc1\xc8\x1c\xa7\x1e\xb9\xaf\xd0~0\xf43t߬(\x8fQu\xaf\xe8\xe5\xc42\xee\x156*3f\x96,\xf4@c\xbc\xac\xa1=Z\xa1\x97\x95\x1f\xcf6[\xf8\x88/\x91\xbb\x94\x9b\xc7Z\xdb\xc2Ge\xe3\x8ff$\xd4H\xf9<\b\x9a\x05i\x1f\x87\xe3\xbd\xe4u^n\xe1U\xa1\x8c{\xa5\x87\x94\x11DЈ\xb8\x8b3s\v*O\xd1X8\bml\xb2Y\rGz\xbc\r\xf5\x1cp\xd9?\x86h\xa3%B\x11\x80\x85\f\x13{k\xce)\xd6@\xf6ՠ\xfdb\xd8\x1e2<\x8f\xdaצ\xf3U\t}ֳ\x16\xc0\xde@Ƶp/\x904\xb9\x96\xa7\xc9\xcc{y\xfe\xedÊ\x80\xb9)\xc8wm+m\x85X\x1ay\xceD\x81\xe97g\x8bf\x95t\x8f\xbd)\xfe\xac\xbe\xa8\xe8\xf4*\xc36\xabVƽ\xc42Aq\xd4\xcc0\x99f\xf2\x04i\xa5\xfd;j\xa1\xd5\xea-\x94*\x84\xb5\x13H\xa8I\xd6DՈ\xdf\"H\x138\xbd9\xd8l\xa0\nd\xa6\xd2\xcb\xfe.\xa4\xfd\xf3\x9f&\xc6,\x81\xcey\xd8y\x01\xf0\f\x151Ak\x1ay.\xf7,\x17 \xe6\n'Z\x033/\x03\x9a\x81\xc8\xf38\xf3\x7f\x9b\x9af\xf0\xe6\xdc\x06\x96JG$\xb6\x86\n麹]\f\x05\x9b\xa6a-\xb9\x85\xe8q\x9d\xa2wYL{\xea5\xe7;\x9f\xd5Kj\x0e\x8ev\x9bE[\x0f\x8e\xaaF¹\x83\xef\x06\x93Q2\x9b\xdb\x11\n9\b\xeb+Z\x11\x11\xe6:\xad\xfa~\xec\x945&h\xf6\xbby\xccČ\xd1\x19\xffJ \xb0\x06\f\xd0\xc5\xd2t!_\x8f\xa4\xfc\xba\x9d\xd2\n\x15$\xc9\xf6\xf5\x9d\x9a\xeb\xc9=y\xe3\xcd]s\x88\xa8\xfa\xf3\x83\xce$\xb7 \x12L\xea\x12,\x15 \xd3\xf9\xf0=\x92\xe1?\xdfp\x82\x8c\xa5Nߘ\xf6s\xbe\xcf\xed\xdd*u7l\x96j\xdb&s\x11QK\xa9:\xfe\xa7l\xb0\xb6\x00\xac+\x02]\xac\xac\xb6V\xed\x831hS\x13\x9ac|1Y\xd3_\xae\x8e\x82\xb3\xfc2\x1f\xfa\x10L\x9a\xf4\xa2\xc9\xcddwy'\xeb\f\x01{<\xd0[\xf6)\xa6U\x99϶X^\xc3:\x9e\x8f{UI\xbbZ\x1d\xbeQ\xecf\x8d\x8f\xe1\xae\b\xa7\x95\xb9m\xbdh\xf17\xc5\xc6\xef\x8cM<\x9e\xadv\xf3\x15\x8f.N\xdf\x19q\xff\xee\xcd\f4\xe8\xa9\xf5~<k\x84\x0e\xa8\x9e\nzK\u0378\xe3\xcd(\xd1v\xfd)\xe4\xb0\x0e7\xac@\r\v\x11\xb7\x1ck\xd7FY\xe0)\x93>\xb6\x10c\xd1\x1c\xdb,7EQu\xe9(\xf0]\x03\x95<I\xf5\"\xe7\xb5=\x1d\xb1K\x0e\xbd\"J/\x89ϋ\xc3n\x89?\xab\xecz+\x7fRv\xc6\xc6\x1d\xe0p[\xa8\x99\x8c\x1a0\xbd\xaa\\\xfa\x8d\x0f\b\xfbZv\xaa\xa4\xf8\xa5µ\x8a\xf8W7:\xa6\x89\xce[\xdd\xe1\x93e\x9bY\xf0\x15?\xc4\"\xa0\xecAū\b=\x93(Î\xf9r'4<Y\xfe\xbcf\xa8[\xb7y\xa3\xbfI\x1a\x8d\x9f\x7f\x81>tT\xde\xd1MC\x9f\xec\xa5A\xe2l\xdc:\xbcS\xed\xdb\xef\xdfv\xf0\xef\xffl\xbaW\x85\x19\xe7Ho\xcb~\x1c~)\xfa\xe6M\xef\xc3O\xf7\x93+Y\x7f\xa8iv\xf0\xe3O\xf4i\xa7\xb3v\xf31\xa2\xd9\xc1\x8f?m\xfe;\x00\xd8=\x10w`;\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}Ks\xe38\x92\xf0]\xbf\"\xc3\xdf\xc13\x11\x96<\x1d\xdfa7|\xabq\xd5\xecx\xa7\xbb\xcaQ\xae\xae=L\xcc\x01\"S\x12\xda\x14\xc0\x01@\xbb\xd4\x1b\xfb\xdf7\x12\x0f>A\x12\xb4\xe5\xdd\ue352*\xa2\xdb\"\x90D>\x90\xc8\x17\x80\xd5z\xbd^\xb1\x92\x7fE\xa5\xb9\x147\xc0J\x8e\xdf\f\n\xfaKo\x1e\xffUo\xb8\xbc~\xfaa\xf5\xc8E~\x03\xb7\x956\xf2\xf8\x19\xb5\xacT\x86\xefq\xc7\x057\\\x8a\xd5\x11\r˙a7+\x00&\x844\x8c~\xd6\xf4'@&\x85Q\xb2(P\xad\xf7(6\x8f\xd5\x16\xb7\x15/rT\x16xx\xf5ӟ6\xff\xb2\xf9\xd3\n Sh\xbb\x7f\xe1GԆ\x1d\xcb\x1b\x10UQ\xac\x00\x04;\xe2\rlY\xf6X\x95z\xf3\x84\x05*\xb9\xe1r\xa5K\xcc\xe8]{%\xab\xf2\x06\x9a\a\xae\x8b\x1f\x87\xc3\xe1϶\xb7\xfd\xa1\xe0\xda\xfc\xad\xf5\xe3\x8f\\\x1b\xfb\xa0,*Ŋ\xfaM\xf67\xcdž*\x98\n\xbf\xae\x00t&K\xbc\x81\x8f숺d\x19\xe6+\x00\x8f\x8e}\xe5\xda\x0f\xf8\xe9\a\a!;\xe0ђ\x88\xfe\x92%\x8aw\xf7w_\xff\xffC\xe7g\x80\x1cu\xa6xI\x14\b\x03\x03\xae\x81\xc1W\x8b\x16(O~0\af@a\xa9P\xa30\x1a\xcc\x01!c\xa5\xa9\x14\x82\xdc\xc1ߪ-*\x81\x06u\r\x1a +*mP\x816\xcc 0\x03\fJɅ\x01.\xc0\xf0#\xc2\x1f\xde\xdd߁\xdc\xfe\x82\x99\xd1\xc0D\x0eLk\x99qf0\x87'YTGt}\xff\xb8\xa9\xa1\x96J\x96\xa8\f\x0ftvߖT\xb5~\xed\xa1wI\x14p\xad 'qB\x87\x86\xa7\"\xe6\x9eh\x84\x8f9pݠk%\xa4\x03\x18\xa8\x11\x13~\xf0\x1bx@E`@\x1fdU\xe4$\x85O\xa8\x88`\x99\xdc\v\xfek\r[\x83\x91\xf6\xa5\x053\xe8\x05\xa0\xf9raP\tV\xc0\x13+*\xbc\xb2$9\xb2\x13($\x12A%Z\xf0l\x13\xbd\x81\x9f\xa4B\xe0b'o\xe0`L\xa9o\xae\xaf\xf7܄ٔ\xc9\xe3\xb1\x12ܜ\xae\xed\xc4\xe0\xdb\xcaH\xa5\xafs|\xc2\xe2Z\xf3\xfd\x9a\xa9\xec\xc0\rf\xa6Rx\xcdJ\xbe\xb6C\x17\x84\xb0\xde\x1c\xf3\xff\x17\x04@_v\xc6jN$\x8c\xda(.\xf6\xad\aV\xea'8@\x13\xc0ɗ\xeb\xea\x10m\b\xcd\xc5\xdeR\xe7\xf3\x87\x87/m\xd9\xe3m\xb1\xa2\xaf\xa3{\xd3Q7, \x82q\xb1Ce\xfb\xc1Nɣ\x85\x89\"w\xd2G\x7fd\x05G\xd1'\xbf\xae\xb6Gn\x88\xef\xff\xacP\x93\x90\xcb\r\xdcZ\x15\x03[\x84\xaa\xccI27p'\xe0\x96\x1d\xb1\xb8e\x1aߜ\x01Di\xbd&¦\xb1\xa0\xad\x1d\x9b\x0fA\xb9\xf1Tk=\b\xbal\x84_N!<\x94\x98u&\f\xf5\xe2;\x9e\xd9i\x01;\xa9\x1a}\xe1\xd4U3]ǧ,}3&2,\xfa\xbf\xf6\x06qk\x1b\xb5\x98B\x9a\x88x\xe8\xdeE\x9c\xd1F\x96%q\xe6\x9d\xffq\x00\x11\x9c\x02;0\r\xc4MZK\xf4\x01s8\xa1\xb1\xbd5\x94Jf\xa8I\xf1\x027x\xd4W~t\x1aH$J\x99G`z\x1d\xe55\xf7\x15(<ʧ@$\xc1J}\x90\x86\xfa\xdb\xf7\x1a\xf6\x88\xc2Ni\x14\xb9\xb6:\xf0\x80\x11\xa0\x0e_̡<\x90|\rZ8Vn\xa5,\x90\x89\xde\xd3L\xf3\a\xff^Z\xd0de\xe6\x88\xfbp\xd7\xeb\x10\xb8\xebѰz\xbaҘ\x93\xe2zf\xdc\x10\xbf\a0\x81\x00\xc1WK\x8e\x00Ϫ\xeeJ\x83\xa9\x94\xa0\xa9\x04\x9f\x91\xe5\xa7/\xf2g\x8d\x90Wv\xf6\x87\xc5\xf7\n\xb6\xb8\x93*F\r\x85ԟ\x1a\xa3R$i\xda.\x1d\xb22\x1b\xf8r@\x92KV\x15\xc6+\x12\xae\xe1\x87?\xc1\x91\x8bʌRn0c\xe8\x9f\a\xe30\xd0_\xe4gԆg3\xc4{\x1f\xed\xd4\"\xe0\xf3\x01\xcd\x01\x15i2\xfb\xc0.\x0e\x03\x98\x00ۆ\xc4$%\xc0\x82l\xd3\"S\x14Pʰ\x1ej؞\xc2`\x97\x89\x06~ˊ*Ǽ6 \xf4\fv\x1f\x06\x1dhY3\x8c\v\xd2\xdfd\xce\xd0\x1a(\x9a\xa74\xc3\x06 \x01\x98B;\xe7\xb8p\xf0\xbc\xe4{\x14\x87H\xd8\xd97\x1c\xdb$\xfb\xc0\x1aml[\xe0\r\x18U\r\x05\xc9\xf5eJ\xb1\xd3\b]\x82\xa1\x99J\x96\xba\xbd_\xcf\n\x9eYK\xa8^\xb5,e\x9c\xdaaQ\xd1\xfe\r\x13\xe5 \xe5\xe3\x1c!\xfeJm\x9a\x15\x182k\xaf\xc3\x16\x0f\xec\x89K\xe5\x15\xb57\x88\xb6\b\xf8\r\xb3\xca`L\x8f2\x039\xdf\xedP\xa10N\xedi\"\xe5\x14A\xc6\x17\x15\xfa\x06&D\x1f\xf6\xf0h\x18I\x92j1\x1f\x1b:<\x1f\xb0?\xaf\u0087\x06Jj\x8a\fh\x91\xf3'\x9eW\xac\x00.\xb4!}n\xf1a\xf5\xb8\x86\xf8L2y0f\xb70\x87\x91\x13':\x8b\xb4\x14\bR\xc1\x91L\xc3aS\xbd\x8a\xbe\x00`\x14\xed-\xa3\x05@:\x11UU\x81ڿ*\xa7ՠ\xa5\x03\xaeFA\xd7\x1cqVm\xc1\xb6X\x80\xc6\x023#U\x9c\x1csLN\xd7k#T\x8ch\xb8\xee\xe2\xd7 6\x01\x12Hm?\x1fxvp\x06'I\x90]\x03 \x97\xe8\xcc\rV\x96\xc5i\f\xc9Y\xce'L\xf4\xe4)\x9f2\xf9\x87\xb4\rҳ\x9c\xb4u\xcf֪H\x94\xad\xc5\x01\x8c\x9c\x80\t\xffG\t\xcbE_\xf2\x92){7\xe8z^\xa1%Y\xe5\xa87p\xb7\x03<\x96\xe6tE\x06\xac\xffu\x0e\"+\x8a\xd6\xfb\x7fǌY.\xf1w\xfd\x9eg\x95\xf8I\xae\xccA$\xaeԯ\xff\x1d2\xc5.\x16\x0f~\xadHfȏ\xed^W\xc0w5C\xf2+\xd8\xf1\u00a0\xeaq\xe6U\xf3\xe5\x1c\xc4HY\xef\xe8{d&;|\xf8F\xc1\xb8:\xfe\a\x90H\x97~g\xe0m{\xbe\xbb0\xcf\xc0%C\xeb\x9f\x15Wxt!\x18r\xc8ڿX\xdb\xff\xdd\xc7\xf7\x98OI]\xa2\xe4\r\x10y\xd7\x1bl\xfb\xd5\xde(OEÛ>\xb5\x7fc\xbdI}\x05\f\x1e\xf1\xe4,\x16\n\xf6\x95\xa8\x18\xbdh\xc4\xd3\xe9\x7f\x15\xda(\x9f\x9d\xfe\x8fx\xb2`|\xd8n\xb6w\xaa(\xf8\xb8\x1b\x9eR\x9a\xf5\bHc\xe2ڇ#\x89\xed\xf4C\x1d^I\x96\x01\xafdj]4\xc7\xebE\x8a$|\x03\xed_\x80fͶ&Z\xe8\x18{I\xa1\xbe\xc2F\xb1\xf4\x81\x97I\x90\xed\xc2I\x92egK\b\xc2~e\x05\xcf\xeb1:O\xe2N\\\xad\x92\x00\xc2Gi\xee\xc4\x15|\xf8Ƶ\x8f\x83\xbf\x97\xa8?Jc\x7fy\x13r\xba\x81\xbf\x80\x98\xae\xa3\x9d^©m\xa2C;\x9a\x9b \xdc\xee\xdf\xdd\xce\xcaY\xcd\x1e\xae)\xb2*U\xa0\a=\xf4\xaf\x9b^\x1f\xba\x9fc\xa5\ry/B\x8a\xb5]*7\xb17Y\xd2\xeaU\x02<\x8a6\xab\x0eG\x86C\xab_\xea^\x98\b\xf6\vY^\x165\xa2\xa7²\xa0\xbcN\b\x8e\xd9\x1893\xb8\xe7\x19\x1cQ\xedq5\v\xd0\xfe+I\xbf\xa7\r!Q\xeb\xbeH\xc2Җ\xf6\xf0\U0006aed7<\x88}\xd74s\x13Z\x05f\xcf6\x1d\t\x8d\xbf\x06#\xbb\xc4Z\xfbc\x96\xba,\xcfmV\x93\x15\xf7\v4\xfe\x02^tfok`$r\f\x8e\xcc\x06\x19\xff\x93\x969+\xd0\xff\x05%\xe3*a\x0e\xbf\x03\x8a\x95\x17\xd8\xe9\xeb\xa3X\xed\xd7\xd0\x1b\xb8\x06\xe2\xef\x13+\x86I\x97\xe1\x87\x14\xac\x00,\xac\rA\xa3\xeb[,W\xf0|\x90\x1aI\x10`\xc71\x1aR\xed~\xb9\x86\x8bG<]\\\r\xf4\xc0ŝ\xb8p\v\xfcbuS[\vR\x14'\xb8\xb0}/^c\x04%JbR3\xf2\xc2nV\x89bAnh\xb0\x04\xa8c\x9d\x01%\xb7p\xb3z\xa5\x1c\x96R\x9b\x9bѧ\xbd\xa1\xdcKml\x90\xaak\x96.\x89by\x19\xf2\xd1+`;\x97\x83\x96*d\x17I\xed\xf5\x02\xae\xc45=\xada\x99jE\xc4\x1cPr\xac.\x9a\x19\xec\x12G\x17.\xe5H\xff\x0f,\xa3'\xd3C%\xb8>\xf94-\"\tںC\xca!\xcd\xea\x00!s\x0e\f\x05\xef悒\xcb\rR\"\xd2\\\x9b\xdeP?|kE/\x99\xb0\xb1\xe2Y\xe1[:.\xfaR:\x96\xf5s\xd4IC\xbcu=\xc34\xf1\x80\xac\xe6`j_\x91\xaeҫ\x04\xa0\x1d\xe1\xfc-,\xd3G.\xee\xacd\xc1\x0fg_\xd6!\xa4\x8c\xf0%\x86\xfbm\xe8\xdb\x10\xbd\xfea,{\x1a\xfbP\xfa\xec\xf9\x80\n;\x9c\x1bƹ\xc9PL\x04IQ\xddV8\x81\xe0\x962\xbf\u0530\xe3J\u05ce\xa4\x1dy\"DJ\x00nVo\xc0a)>P\xe6\xf4\x05\xf4\xff\xe4zֈR\x98\xf09d\xfaG\x93\x99\xb1\xafM\n!\xc5`\xb8\x01\x14\x99\xac\xa8\xd2\xc5\xfa\x10.\xad\xebX\xe0\x14t2\xc9\xd2\x14\x04}QT\xc74\x02\xac\xad\xd4q1\x19\xa7i\xbek\xf8\v\xe3\xc5[\xb0\xcdg\xb9_\xc0\xb6\x90\xc8\x0f\xfa\x94\x84\xf3Ⱦ\xf1cu\x04v$\xd2'\xc1\x04Zwi\x14]\x8e\xd7E\x00v2\x11\vH\x9fe\xf2X\x16hRg\xa4K\xf7\xd34\xd1<\xc7za\xf6R \x050\xd81^TjfQz\x11m\x97\xf8\x1a^Y̶L4\xddR_\xbe\xb6+\xe0\xea\foL\xd1֥J7\x15\xef\x15\xa6\x99gsAi\xaft\xa1T\\*\x12\xa13[h^Ę8}7Ѿ\x9bh\xdfM\xb4\xef&\xdaw\x13\xed\xbb\x89\xf6\xddD\xfbn\xa2\xfd\xfeL\xb4\xb9\x11\xb9\xbd\x1f\xab\x17\x8e\"!==5\xc4\t\xf8\xbe\x9a\xe2\xd6\xed\x03y\x8f%\x8a\x1cE\x165\x04b\xc5\x14\x91\x8e\x91\xeaZ\xd2\xec~\xab\xc9\xda\ue489\xc9B\xb0\xa0|Y\"\x19\x95\x98CU\xb6\x1e\xe4\xf65@\xe5Ⱥ\xca\x0e\xb6\xd4\xf8\x80\xe0\a\xf1Y\x16\xd1\n\x01\xb9\x03z\xf4g.r.\xf6\xba\x0e%?\x18\xa9\xd8\x1eo\v\xa6}9\xe3=\xed3\xd1\x06\x85\xaf\x18\xbe-\x18?\xeaX\x92\xb0Y\xf7B=J\xdf\x18\xbe3.\xea\x1c*@\xa80\xb1G\xb5`U\xc6\x06\xcd5TBc\xa4\x84xF\x18\xa6*\x8cy\xfc\xf5\x8bX]\xf7\x8a\xf0y\x01\x8f#\x04$\x8f5(3\x9b\xf2\xed\x91\xf4\r(1Y\xde5W\xd4խ*\xae\x8b\xaaBY\xb1\f/\x19\x00\x0e\x9bc\xb4\x8dC\xb7+\x86\xba\xd5Y6/\x11F\xbaY%[\xb4\x93\x8a<\x89h1=\x12\x06\xb2Pl\x92˰\xa7\xe8՟[\x1d\x825B\xf5\x9b\xa2\xd7LM\xd4x%\x94\xa3\x13\xed\x12z\xfaa\xd3}b\xa4\xaf\x8b\x82gn\x0e\x03\x98T\x9aF;XrRt\xed\"\xe7 oFF\xe9H\xe9s\xc1\v+\x7f\x13\xd2\xda!/|\xb2cg\xc5f)ɦ\x9d\xcd~*1֦G\xbd~\x97\xa9z\xa9\xb0R[Ws\xb3\x1aK\xfb/K\x10\x8eJ\xd6+*\xa2\xa6K\x98\x96\xd4A\xf5\xab\x9cF\x81\xceW?\xa5\xc4\tf*\x9d^P\xdf\x14*\x97&\xa0\xc2LU\xd3\xe4\x14\x0f\xdf@\xb5\xe4\xe1\xa7\xd6-͖\x7f&V+u됦A.\xa8QJ\"\xce|=R\x874)UH\xbe\xeag\x95RU6[{\x14\xa9*Z-\xacm\xf2\xe5]\x13\xb5D\x93\x10cuF\xe9\x15D\x93\xa0mu\xd1|\xddФ\x1eZ\xc0\xeb\xa9e-|\xe6=\x9eqU3[\xfb3\xeb\x11M\x8f\xafU\xdd\x12\x1fޒ\x9a\x9eY\x8au\xe4>\xbd~\xa7\xae\xcf\x19y\xefҪ\x9dnU\xce\bДZ\x9d\x91Z\x9c\x11\x88\x93\x15:\xa9\x158#\xb0g\x96\xddI)\x99x\x18߀=\xbf\xbe\x15\xffS\x12\xf5RĤꘋ\x91\x01td\xf5S\xaf91>XM\xd3\xe6\xe7\x00.X\x83t\xb9\xf9y\xac\n\xc3\xcb\xc2&o\x9ex\x1eu\x1a\xcd\x01O\xf0̋\x82\xd4\xea/\xd2njے\x99\x80\xf0\xe9s-\x9e\x9b\x9e\x11\xcd4<cQ\x00\x8b\t\xd7\x00\xf3̝!\x90\xc95\xd2\"@\x9e\xa7\xdf\xe0\xebw\xb5_9o\xde\xeeۋŷ\xcd\x01\x8f\xb4\x1f=\xec\x14ެ\x92\x95\xf3\xb4\x81h\x95\x88\x95<\xf8g\x85\xea\x04\xf2\tUc1\xd4\xceO|\x8a\xb8\x89\xa6\xab\xa2)\xd3\xf3\xfa\x83\x8c\xbd\x81\xe1\xdcL8x'\x9c\x8f\x15\x05\xdb\x1b\xa3\x85\x83\x9a܇\xc0k\xda\xe9O~\xc0H\xd3(T!\xebޫ\xe5\xb6g\x1f\x99x\xab\x1e\xb9\xcf\xee:,w\x1ef\x97\xedi\xf9x\xa1\x03\xf1r\x17b\x02d\xea\x16\x8a9V&9\x12=\u009cѕ\x98s&\x124\xb8\xd7Ǟ\x86\v\xd0Hu)Vg\xdb\x02\xb1\xc0\xa9X\xe6V$\x93)e\xabC\x87H\xe7r.\xdeнx\v\a\xe3e.\xc6\f\xc8\xde\x16\x86y'cV_-\xe2\xfd\x9c)\x9f\xe6l\xccm:H\xd8l0is\xa5\x8d\xb4\xb5\xbc\x8e\rt\x89\x99\x98D\xc3μ8\x9f\xf3\xf1F\xee\xc7[8 o\xeb\x82\xcc:!\xb3\x923\xf9\xf8\xc5\xd1e\xa9rT\x93\xc1\xf8TQ\x9b\x14\xb2\x8ex}꽳\x95\x01j\xccz7\xb2\x8ei\x1ay\xa9\xac\xf7\xfaf@G\x8e9\x97\x90v\xa2\xb4\xd6qz`\xb3)\x8dQ\xd1\xd8gq\xa0\xbd\xa4\x82ƒ\x91z\xcb\xe9P\x1e[\xb3\xa27\xf0\x81e\x87nC{\xfa\xd3N\xaac\xd4`\xba\xa832ס\x17\xfdr\xb1\x01\xf8\x8b\xac\x93^5D}\x05\x9a\x1f\xcb\xe2D\xd5(p\xd1\xed\xf22\x01\x88\n\x8f\xf6\xa78\xfd$\x9f\xf0}ԛ\xed0\xef\xa1\xd7<\x92\xbe#&\x92_\x1cNv\xb9}\xb8\x1b\xc0\x84\xde\x19R\xe1ԬP\xcb\xe0\x85\xa0I\xef\xd1y[y\xd7\xf3\x8b\x00UXJ͍T\xa7+Д\xa4`\x86\xb6\xcf{\x87\x8aNg\x92ʟ\xee\xd2;\xbb\x8b\xa6\xa7\x88\x85W\v)\xf6\xe4)=1n)|\xd6\xfcax\xbf?Y*\x91\xf6\xbeu\x8c\xf4\xfe\\\xa9\xac\x90U\xde`7\x00\v\xc4\x1b\xaa\x98\xbc\xffj\xf7\xc6\xda\x13y\xb2&\xfb\xecM>\xefF\xd5ٚ\xf0\xf8\xcf\xe7Ϥ\x12g\xd8\x1e\x7f\x94\uec399Jt[{\x8f\xc5fނ\xa2\x0eu,a\x9bS̀\xf1\xc7\xde\xf5\x805\xe5i\x03)\xa4Q\xc64\xf8\x84\xf23\x8a\tMJA\xcf\xe0\xf4\xa5n\bG\x99\xf3ݩ.\x90\xb5\x99\x8d\x93w>B\xbdN\xfdp\x00խ5ϊ\x1b\x83\xa2;e\xael\xb1\x10~cT\xc4M\x8f\x14\xe6,3\x90)\xccQ\x18\xce\nZ\xd3\xc5*n\xac\x11\x99\xedи\v\x9d\xe0\x13\x05\x12j\x04\xfd\xa9Bv\x94t\x18\xde\x15\x89\x8bU\xe3\xd6\x1b\x8c\x00\r\xfc\xa9\x0f\x81j\x88eq\xa0S0UK\xeeh\x10\x97\xba9\x89\xf5\xdaa\xb5n\xbaE^Ҝ\x1c\xbbY%\x9b\xa0\x1d\xce81\xa9\xf9\x13h\xa0\x1b\x1ex\xac\x1dY\xf8\x88\x15\xd0d\xe4\xaf\xdaZ\xbe\xe7\x88\xd3\x1a\xf5\x80\x99Bc\xe7\x8b\xf6\xfc\x8eB$\xb1\xb8\x1c\xe3\xf5f\xb5\xdccN?c)z\bPӭ\xa5\x9bj&\xbbr\x87\x89\x1cV\xeb؍\x9a\xa3\x89g+\xcd\xf8\x12\x93\xc6I\x92\xee\x9a3\xa4\xba\xf4\xabm\x9bE\xe4\xab{\xf5\xa8W\vK}6\xc9\bT\xf8]\x13\x8f\x8b\xbe\x14%Q\xefN\xbc\x9d\xf0\xf90\xcf\xe8i<#\x95\x0e\x9e(\xf5{\x7f'\x84_&\xb5w⭤6\x85\xea\r\xf8\xdf8q\x93\xce4\xea\x10\xb6\x93Sx\xddyFFN\xcb\xf5k\x10O\x89\xbe\xf6}\xf4\xf1\x96=\x1a,\t\xa8O\xc0<ϹE3\x92\xf4\x8a\xe0\xfa\xea\xccg\x15\x85\x00\xfb,\xdce\xe7\x14\xa5\xb0:1\xd8> VZ\xc0}u\xdes\x89\x12&\x7f\xf8\x06\xfa.D\xebL\x01\xf8\x97\x05\xe1\x13\x80\xfa\x18\xf2\xb2@\xfcBҥ\x04\xe4\a\x84K\t\xca\xcfB\x8c\x86\xcd'\x03\xf3\t \x87\xa1\xfb\xc9\xe0|\x02ı\xf0\xfdx\x80>\x01hJ\x95Pz\x90>Q\xff-\x96\x8d\xf9\x853|\xe6\x83\xf6\xf3\x81\xfb\xc4\xe0\xfdL\xa8l\xe9\xe8[\x81\xee\xa9\xc1/\v\xe6/\xa0sg^\xa5\a\xf5'_\x1d\x02\xfe\x8b\x03\xfb\x93P;A\xff\xd4\xe0\xfe$\xc4ŧ\x00\xd5\xeb\xec$\xd8\xe9\xe0\x7f\x9a9\x91 a\xb3MB\xed\xd1'Q\x8c.\xb8\x1d\xf6\xff\xd4\xea\xd01ԙ\xdf`B\flnW\x19_B\xe8\x82\nK\xc00\x84\x10\xbb\xa9C V]XRԛo\xc6\xc81\x15\x11\xf4\xa6\xe8\xc4\xf1FiG\x1b\xd5V\xeff\xf5\xc2\xd9ds\x99\xa8\x93\x86q\x1fjX\x14¿?|\xfa\xe8t\xac\x97A\x92do\xa7\x84X\xf6\bL\xe8\x91s\x03\x9f\x1a\bn*\x94\xcc\x1c\xac\x83/.\r\xd4\xe5F#d\fj\u070eK?r{\x8b\xc6\xeaE\xea~*4f\xb1\xa7U\x90E\x91_\xcdYo\x81*>\xaaV_\x8f\xd0x.\x8e,\x13J*\xcdV\x95\xe5\xd4\xd3\x1e\x925\xe9\x83|\xc5p۬^\xb7\xe9u\xed/\x15\x99mdK\x7fϱ<\x90\x00-\xa0\xc2=\xc9[\x87\x00tɎO:\x04e\x9a\\+A\xd4l,\xf6f#\xde\xc55\xe9\x94\xeb\x92i\xfd,U\x1eIu\xbd\x00S\xab\xaf\x17\xa0\xfa5\xe4\b\x89\xd9\xde\xf4\xf4\ue565\xbe\xbfN\x8aZM\x02\x05\xba\v\xcb\xd2-\xe4\xb5<\x80T\xb1I\xc2o\xde@\"\xd3g\xe2!\t\xc2\xea\x15\xab\xd5Y\xe2$\xdaƼ\xbfP\xc8\xfbf\x95\xc0\xa3\x87\xa6}?FRp{\xbbSW\xfb\x8f\xc0\xb4\ue543\x15\xb2\x10\x1a\xed`uK(\x1f\xeb[\xcf誧\\f\x8f\xa82)v|\xff\x8b\x96\xe2⅚4\x81\xbbg \xed\x94x\x8c\xee'\x9e\xe4\xfa̠Ƈc\xcc\xdc\x1dP_\xbe\xfcH\xf3\x8e\xd9\xcd\xee\x9b\xf7\x95\x9b$\xeb\x92)\x8d\xf4JO-\xdfiK\xff{\x90\xcf\x03\x98.m\xdb\xcaU\xb6rx\n\xc9\xccs\x1bE7\xab\x05<y\xead\xacC\xbaP\xcf`\xf45ޫ\x15\xd0\xf2\x86'\xe1\x12\x92a\xb3\xf9\xf2\x06N\xeb&=[;m\xa5~,\x034*\x90\x93\xa28\xc6\xd0\x11!q\xd7AݬFI\x12Ү\xd4,\xdc-\xe8\x0f\x15\xa9\x94\xbd\x1a\xc6\xdf(EI\xeap\xe2A\f\xa5\xf1\xb5>\x1bn(\x9f\xe1\xd3\xed\xb0GX\x01Bر\xbb/y2\xfcjW\x8bgl\xedt\fN\x92\xb7h\xb6\x98\xb1J\xb7L\xe4\x06\x9cߟ\x1e\x81\xea.f9^\x91\xe8\x1e\x99!\xeb\x90\xe9\xba\xe3\xc6^TyM2t.οx\xa6\xfb3(:\xf7m\xce\xd0\x7f\xd8\xc3ު\xa8\xf2\xd6\xfdd\xb5A\xf8\xcct}\xceEԐm\xc0YUB\xac\xac\xb3\xc9\xf8\x84\x02\xa4\xb0\xc7ZP\xca\xd6rDoZC\xb0}\"P\xdbP|\x1e\xbe*\v\xc9\xf2Pm\xe0\x87\x17n\x8b$\aQ\xdb\x1b#/\xf5\x04L\xaa\xf4'\x9eƈ0\x94.\xc7\xfc\x1b\xa0K\n\xd7Q\xa0Il\x8b\xb2\x9ch\xea}\xee\xb9\xf9Ҵ\f\xf3\x84\x15{\xa9\xb89\x1c[\xa4\xb8$>\t:\v\x816RD\xf3\xd8\xe1\x9d^\x83m\xbc\xca\xd6\xf6/:ߎ\xfb\xa9\xd4k\b\xfb_yD\xc7\xc5M\xed\xb5m\x1d\xf9\xf9Wm\x86SmM\xdb\xff\x96\x11N\xf3\xae\x86\xd6\uf321(!\xe6s\x84|\xb8\x1b\xeb\x19\bk\xa4a\x05\x88\xea\xb8EE\x8a\x88\x85\x06I\xf7\xf3i_U5\xb1.8\xc4Ȝߣ\x9a\xc5\xccK\xe9\v0\xab{\x8ea\xa6\xab\x8c.g\xdcUEq\x1a\x11\x15\xd7\xff\xech\xe6\xec\xc8\xf6\x98;\x98^\x02g\xf0{\x1f\xe9\xd2_3\xe8\xff\xefe\xb7\xcdj\xa4 \x9e\x19\xf6\xb3\xd5&u=\x8cW'\xce\xe1'\xc7(\xdcc\xdb\xdc\xca\xe9Zr\xb5\x8a\xdfTV\x95\xad\xa28\xd8ɊB_\x92\xc2\xd8\x1e\xe1\xdebB\xe1\x9d\xdf\xc6\x12\x927\xe4H\x9dJ\xef#]^2\x87\xaa\x86\v$g\xa1\x8e\xces\xa0]d\xd3%\xee\xabį\x1ez\xea\xf4z\x1f\xe9\xf2\xeay\xf5\"\xd4#\x10_E\f{T\xac\x9eAߞ\x87\xe6c\xd9\xf6\x9c\xd9p\x89\xa6\xed\rGԚ\xedC\x88Ӯ\x1f{\x14\x14ȉ\xb2\xdc\xe7H\x9aS\xaf:3p\xe3\xf6c\xb1\xcc\xd0>D\xfb\x82p\x8eE\xab\xd5elf\x17rO\x87mئ\xfe2c_븐&\xdfJ\xaeRj#?\xd4\r\x896\xbeԕ\xeb\xe0\bQP\xbe\xe0{N\xce\x141i\xcfԖ\xedq\x9d\xd1]\xeaY<$\xf1\x96\x16\x87?[\xec32=\x8b\xda_\xdam}\x8a\xd02\xc3g\xcaɢ\xce\xfd\x1dІ\xab\x89Ba:\x01\x85\xf1b\xb3h\xa4\x96\n>@>7\xd2v\xdb0)\xfd\xbcq\xd4\f\xb7\x91_y\x0fa\xf8>\xfa\x1e\xd9/t\xa7Ց\v\xfa\x0f\x85\xdd]H\xd5w^4~\xeb\x02\xd4U\x8c\xb3\xea\xe5\xae\xd7<`\xd1(\x95P\x9b\x1a\xe6W\xa8\r\x1d\xc0\x05؞:\xf3\xa4\t˄\xfaF\xe2\xdaɁI\xacf\x9c\x9a,n\xa2\xfd(\xb3\xc7\x19$?\xd5\r\x03z~\x8e\x16\xf4\x93E\xadT\x92n\xedn?\x8d\xcd\xf3\xee\x8aͅ\xbdM\xda\xcft(\xbc\xbbnOj\x82-\x92\xbb\x90\xa3U\U000944e6\xbd\xb8\x1e\xeb\xcdҩ5\x1d\xef.pϊ\xbf\xca\"\xc2\xec\x01-~\fm-)Te\x8f\xf1n!\x1dN\xc75\xa1\xd25\n\x92\x8e!\xb1o\x85\x83,r\xaat\xdd3\x95\x17\xa8\x83Qé\x1e\x86\x1c\x02+\xfc\x950\xbc\xf0\xc7\x17\x8e\x1eai\xdd.\xbb\x03`H\x9e\x944\xd2Q\xe6\x98@\x80\x9fd^G}\xeb!\xda\xce ;\x94ج\x96\xc5\xf8\xd7\xf0o\xc4bA\x17Ď4\xb0\x8b>\x1fm01\xad\xfd\xe5ٌ\x8b\x9f\x89\x96\tx~nZ\atɍ\xf4\xbc\b\x05c\x1d\xbeG\x81B#\rq\x8a̯\x1b\t\x02>\x8b\xfeD\xac\xd2^1|\xb3\x9a\xa4\xc6=\xb5\tth\a\xa0j\xae\x8fmw\x18\xf34?\xe20\"\xe9Ώ\xc7\xdc\x16\xeeēak\xb8\x13\xf7J\xee\xc9ō<\xacm\xbcȳ{\xa6\xa8x\xbf8\xb9\x97DZ\x8c>\b\xb7\xd0G\x1e\xbd'\r5N\xf1(;J\x8f\xc0\x1c\xd1}\xb3\xa6\xba\x8d\v'-4\xe5ؖ\xdc\xfeF\x9d^\xea\xe68\xd2\x01\xdc\xe6\x9d\x1bڃ\x8c\xa1x\x8cwa\x92)\x8aڬq\xb7\x93\xca\xe7\xbc\xd7kRq.\xc0\x18\x81K\xa6\te\xa7\xa0*I\x82\xa9\b\xb5.\xa5i\xd6r\xbb\x8fFY\x93\xc4V\a\x1fى\xdc+.X\x96Q\xfc\x1a\xaf\xb5a\x05\x9eY\xb1۵\x97\x04\x13\xf3\x9fGr\x99\x1d\x82ߵ\xdbO.\xe5\xf6t`g\xe9F}\x05\xfa\xb7E\x14\xf1u\x1a\fٓEAۯvl\xe4\xc2穥\x9b\xbe\xd6{\xb9\x1bs7{\x98}\xa9\x1b\x8f9?\x1e9\xeb\xf5n-ɢP\xc9\xef\xf3۠}_bev`bOB\xa5d\xb5?\x04\xb9\x1c\xf1\x13F\xe0\xe6\x94\x18\x95P\x16՞Dݧ\x12M\xa5D\xab\xf8\xc7\xd7n\xfa\x84?\xb5\xa7\xb1\x8e\x8e\xd4\u05cb\xd5[a\xfcփ5Y\x18k\xcf\v['{\xe5\xcb<\x15\x97\x14R\xa7}b#@\x9b\xdab+\x06eI\x87\x00j?\x9e\x84\xa3\xf1\xa7\xd9:\xa1\xa6\x15j:&\x95\x9c\xb8\x9b\xd5$\xb3?7-\x87BL\x8bX\xc7Ҧ(\xb1\x83=To\xe0\xef\x8b2\x87\xe6Pe\x1b\xa9\xa5x\xbaa\x8aL\xb3\xe7\x83u܌\x05\xc4E\xa3hVKP\xb7\xd0\xeax\xee\f\x82\x0f\x9d\xc6>\xda<\x16\x01\xf7\xe3\x8cq\xe3\xc1oYu\x16\xe7\xadB։*\xd3\xe6R\x91y]i\xd3\xe3^\xd0鈥\xb0Q2Ze1\biw\x02\xd8\xdd\xe1\xeb\xd5r\x8b`F+N,=O\xf5\xd2\xfa!%~Ь\xc4\xedHB}\xae(E\x12\x1a\x88\xde\xe7\x1f@\x04\xf8\x03߹╌F\xfd\xc7\xff\xf5\xb0\x99\xf7\fg\x90\xbf\x9ctM\xad\xd7Y\xfb\x98\xf0\x9eJ\x983\x16\x8dH\x01\xdc\x17H\x06\x94F\xecz\xbd\x97\x8b&\xc9\xd3H\b|\x06\x8f\xaf#\xddƖ\x82\xa9\xa8\x9f\x1b\x02\xe8\xf3ē{\b\xd5\xd6\xdb2\x84\xean\xaf\x0e\xec\x9d\x17\xbbg\xa6\x04\x9d\x82=\x83\xcd\x7f\xf8f\x91(\x9d\x87\x10\x89\xd3\r@B\x13\xb9\v\x06\xd8\xc8\xfa\xbbi\x87\xe9\xc2\x18G\xfc\x97^\xe8\xeeL\x81\xba\xe8*7\xf8\xd1*м5\xb7\xfd\x9b\xfc/M\x06\x9de\x19\x92<\xdb\xcdm\xf4\x83+\xc0\xbc\x81\x8b\v\xfbGYT\x8a\x15\xfe\xcfL\ngL\xe8\x1b\xf8\xfb?V\x0e*\xe6~>\xea\x1b\xf8\xfb?V\xff=\x00M\xf9\xb3Μ\x91\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec<\xfdo\xdbƒ\xbf\xeb\xaf\x18\xe8\x1d`\xbb\x95h;}\xe8k\x05\x14\x81\xe3$\xbd\xa0I\xe3\x8b\xdd\x148\xdbw]\x91#i\x9f\xc9]vwi[i\xfb\xbf\x1ff?HJ\\\xea\xc3\xc8ݽ\x1fb\x06hE\xee\xce\xce\xf7\xce\xcc~\f\xc6\xe3\xf1\x80\x95\xfc#*ͥ\x98\x00+9>\x1a\x14\xf4K'w\xdf\xe9\x84\xcb\xe3\xfb\xd3\xc1\x1d\x17\xd9\x04\xce+md\xf1\x01\xb5\xacT\x8a/q\xc6\x057\\\x8aA\x81\x86ḛ\xc9\x00\x80\t!\r\xa3ך~\x02\xa4R\x18%\xf3\x1c\xd5x\x8e\"\xb9\xab\xa68\xadx\x9e\xa1\xb2\xc0\xc3\xd0\xf7'\xc9?\x92\x93\x01@\xaa\xd0v\xbf\xe2\x05jÊr\x02\xa2\xca\xf3\x01\x80`\x05N`\xcaһ\xaa\xd4F*6\xc7\\\xa6\xb6\xb1N\xee1G%\x13.\a\xbaĔ\x86\x9e+Y\x95\x13h>8\b\x1e-G\xd2\v\v\xec\xd2\x01{\xeb\x81\xd9\xef9\xd7\xe6\xa7\xfe6o\xb96\xb6]\x99W\x8a\xe5}h\xd9&z!\x95\xf9\xb9\x19z\fSM\xf4\x00h.\xe6U\xceTO\xf7\x01\x80Ne\x89\x13\xb0\xbdK\x96b6\x00\xf0<\xb3\x84\x8c\x81e\x99\x95\x02\xcb/\x14\x17\x06չ̫\"p\x7f\f\x19\xeaT\xf1\x92\x9a\x04Z\xc0\x13\x03\x81\x1aІ\x99J\x83\xae\xd2\x050\rg\xf7\x8c\xe7l\x9a\xe3\xf1/\x82\x85\xff\xb7\x18\x03\xfcSKq\xc1\xccb\x02\x89땔\v\xa6\xc3W\xe2\xf0\x04.Zo̒\b\xd0Fq1\x8f\xa1\xf4\x96i\xf3\x91\xe5<\xab\xa5\x0e\\\x83Y \xe4L\x1b0\xf4\x82~9\x0e\x01\xb1\b!p\b\x1e\x98\xf6\xe3\x00\xdc;(\x98\xf5b\x9aw\xc6\xf2M\x1dڄ\n|\\\x83\xe2\xf0\xa77\x1e\xfb\x16ؠ\xf8IGiW\xe0\x9eͱ\x0f\xd8\n+^\xe2\x8cU\xb9i\x93\xca\xe6\r\xb1\x11\xb2JL\x93\xcc\xf5\xf2_\x1d%/W\u07b9Q\xa7R\xe6\xc8Ġiu\x7fj\x7f\xe8t\x81\x855^\xfa%K\x14g\x17o>~s\xb9\xf2\x1ab\x8a\xb4f\x14$8֒\xcd\x02\x15\xc2Gk\x7fNnړV\xc3\x04\x90\xd3\x7fbj\x1a!\x96J\x96\xa8\f\x0f\xc6➖\x93j\xbd]\xc3\xe9\x80\xd0v\xad #\xef\x84N\x8f\xbc\xbd`\xe6)\x059\x03\xb3\xe0\x1a\x14\x96\n5\n\xd3fox\xe4\f\x98\xf0\xe8%p\x89\x8a\xc0\x80^\xc8*\xcfȩݣ2\xa00\x95s\xc1?հ5\x18\xe9\x95נw\x11\xcdc\xedS\xb0\x9cT\xb5\xc2\x110\x91A\xc1\x96\xa0\x90\x98\x00\x95h\xc1\xb3Mt\x02\xefH߹\x98\xc9\t,\x8c)\xf5\xe4\xf8x\xceMpΩ,\x8aJp\xb3<\xb6~\x96O+#\x95>\xce\xf0\x1e\xf3c\xcd\xe7c\xa6\xd2\x057\x98\x9aJ\xe11+\xf9آ.\x88`\x9d\x14\xd9ߔw\xe7\xfa`\x05\u05ceպ\x7f\xd6kn\x90\x00yL\xa7\x05\xae\xab#\xb4a4\x17s˝\x0f\xaf.\xaf \fm\x85\xb1\x024\xa8E\xd3Q7\" \x86q1Ce\xfb\xc1L\xc9\xc2\xc2D\x91\x95\x92\vc\x7f\xa49G\xb1\xce~]M\vnH\xee\xbfW\xa8\r\xc9*\x81s;c\xc1\x14\xa1*\xc90\xb3\x04\xde\b8g\x05\xe6\xe7L\xe3\xff\xba\x00\x88\xd3zL\x8c\xddM\x04\xedɶ\xf9#(\x13ϵև0\x17\xf6\xc8+jŗ%\xa6+\xf6\x93\xa1\xe6\x8a4\xdc0\x83d<l\x05\"\x04\x13\x8fB[i\x1a7nzX\x9a\xa2\xd6\xefd\x86\xeb_\xd6P>\xab\x1b\xae\xe0X\xa2*\xb8&\xd3\xd70\x93j}\xc6`\xb5\an?\xc1S%\x9do(\xaa\xa2\x8b\xc8\x18> \xcbދ|\xd9\xf3\xe9WŽg\xdfA\x90\xf4ϡx\xb9\x14\xe9\x05*.\xb3-ĿXk^\xb3`!\x1f`f\xd5Z\x98|I>H/E\xea\xc1w`\x02\x9c]\xbc\xf1\xca\xe2\r\xc8ۛ\xe7U\x02g\xder\xe5\fN \xe3\x9a\x02\x00m\x81v\x99E\xe1\x19}\x9f\x80Q\xd5^䧲 \x0f\xdc\xf5\xeb\x1d\xcaϛ\x96+D\x93\x9c\xc9\xf0P\x18M\xaa\xe9\xe3(xP\xdc\x18\\\xf7\xe9\xf4X\xff\xccu3M1E \x1cxk\xfd3 \x87\xa0ь<\xb8f\x04\xa6\xba\xf45d`\x06\x0f\xdc,`\xfe\x89\x97\xc0\x8c7\x1e;\x13CN\x0eao\xde\xf5\x1b\f=,\x9fK\xc5\xcd\"\xa2\xaa\x1d\x06\x9e\x85\xb6!\xba\nH[\x16\x84\x8f\x89\v\x7f\x9c\xf0\xf5`\r\xa0\x7f\x88aD\xe2\b>i\x93\xd9ILH\x81]\xe2\xfa-\x89\x9e\xb1\xe5S\xcf'\x82\xdc\xf3\x89Ɗ~ڠh\xf4\xcfJ`\aN\x1d\xbc\xa5\x8616Y\b\t\xbc1\x1a\nd\x82&\xb3\fK\x14Y\x1f\xa7\xa4\xb0:\xd0\xc8\tN\xc7\xdf[\xffD\xa4[Ν\x8e\xffn_\x10\xc1#g\x8b3\xa6#\xb1Cx\x8c\x84)j\xd3F,\x81\x13\x8b\x90^\x1d\xed\xe0@\xaf\xa9\xdf\xc1 \x02\x10\n.xQ\x15\x138\x89~vl\xa5\xd0e\x8e\xaaӢg\xe2\xf1\x99ߌϻ\x1cog,\x9b\xd4{\xa3<WDv.Ō\xcfId\xc4\xcaR\xc9{\x9e\xa1\x1a\xd3\xec\xc7g<\xf5\x98T\xca\x19\xfc\x8cc\x9e\xe9d/R\x14f(\fg\xf9d\v&uC\x1a\xd40.\xbc\x1a5\xef)\x96S\x85\x0f\x98\xc9seu\xae\xd1~\xac\x9c\xa1\xaa\x9dʊ\xd3\xda\xd3Q\xdc\xe12\xf6z\r\xf7\xab\x05\xc2\x1d.ɍ\x12\xca\x1aS\x85\xc6\xce%\x98SxJʙ\x00\xbc\xab\xb4!\xd4֣\x80\xf0gӰ\xd0\xfb\x0e\x97\xc9S\x8cզ1\xdbQ>\xa0\xc48 \xacp\x86\n\x85\x89\x86lTwP\x02\rښF&SM\x11s\x8a\xa5\xd1\xc7\xf2\x1e\xd5=Ǉ\xe3\a\xa9\uee18\x8f\x89\xe1c??\x1e\x13*\xfa\xf8o\xf6?Q\x8c\x00\xae\u07bf|?\x81\xb3,\x03i\x16\xa8Hj\xb3*\x0f\x8a\xd6\xca^F@\x81\xde\b*\x9e=?x\n_\xa4\x95\x15\xcbw\xe0\r\xc5q|\xb6\x84\x87\x05Z\xa4\x88E\x97N*R\x01\xc5\xc1$\xec\xc2K\xd3M\xaaq\x9f\xdb\xcd\x1f\xdb\x7f\x14vP|\xd8EiL괏\x99yo5\x19l$,\xa4\xc9\\d<e\x06\xf5\xaam\x90\x1b`\xb5\xe3\xdb=\b\xf4\xd1\x15Ac\x062)\x0e\fh\xcfC\x16\x004\xa3\xd0,H/\xa3<㢅B\xdd\xe5a!5B\x99\xb3\x14\v\x14\x06TEAU\xc1L\xba 'Q$\x83}8?cyN\xd4m\xe1\xd6k߬\x0e\x9b\x98pJZ\xa3e\xe9\x15\xf8P\aO\xf1(\xc7\x11\v\x9c\x12{\x9ec\x97\xe9US+\xfáN*\xcb\xe5\x8b(\xa9\x1dr\xcf}Ӛܶ\xfa\a\x02\x1bR\xcc\"Fj\x9b\xbd\r\x89$\xf1T\x96\x1c3˩N$\xe9\xf2\xf9\fsl\xaaG\xeb\x7fu\xb6\xda\x01?\x02rG]\xae\xae\xd7\xdf\xd6\xff\u061c\xf1\x96\xf2\x92\xeb\x82RfpOe@\x04\xa9\xa0\x90\xf7\x94\xbd\tV\xea\x854@\xc9#\xe5r\x94E\xf7\xc0\xecAPs\x87!r\xe5\xa0pM<$W\xe2\xcdLa)57Rq\x8cL\xb0\xdb4\xbam\x8e;\x88:\xa4\x98!T\x13\xad\xb9\xa0\xc6~\xab\xfd\xaf\xf8\x80\xfd]\xf2&\xef\x17\xc0\xee\xe3\x02yQT\x86My\xceMd\xce^\xa1\xffM\xabi')\nٝ\x9cE4\xbf\x03\x17\xbc-\xd4([e/\x95\xa4\xc2\x05f..\x9d\xa2\x8bx\xad~\x93bѼٟhU\xc2\xf0|ep|,\xb9B\n\xa0\x03\xdbtSc\xf3\x95\xdd2\xaf\xe6\x16\x17\xb6\xce\x19ztU\x96RQ\xaag\xc9#t\xef>\xb3\xaf\xc9q\xce\xf2\x7f\x97y\xb6\x8b\x06\x86\xb6\x1do\xc3\x1c\x1cX\xc8<#\xfdd\xb9\x96\xce\xf3g\x9bs\x05/\xb7\x119\xd9t\x11D`\x15\xbc\x00\x85s\xa6\xb2\x1cu\x90+W\xa0\x90RӘ\x96\xb5\xc5\xc0\xcd\x01ս\x9c+\xf0\xb2n\xf1\x9dr\xfe'\x99k\x11\xad\xd8t\x18u@\x95\x9d`\xa75ƶw\xd0PO\xf8\x04~$\xb5\x12L\xa4}ֺ\xca\x13\xab\x9a\x95\xa6\xca \xf9>Y\xf9Y\x9b\xe5+E\xa1\x8d\xbe~\x95\x13ΏS\xc5!焆\xd3~\x96\xe7n\x98\x04\xae\x1at{*\x02\xf4O!\x85\xff\x98u\f\xc1ہNB\x99\x9e\n\x91-\xb2\x93\x83\xbd3\xe9-<\x1b\xb7\xa8\xd9\xdf\xc5m\xf0V\xee\xa5/\xf9M\x06\x1bu\xe0}\xbbm\xed\xbb}\x8e\xe6\xcbx\x1a\x8d\xe1b\xaeA \x95\xf9\x98ꆏ63J\xa5\x10d\xffF\x02\xab\xf3\xbd\x03\xbd&\xc8dOӟV\xe9\x1d\x9a\x1d\xd4\xf9\x85m\x18\xf4\xd9u#\xb4*\x8d6\x99߆\xc6V\x96\x03\xa4\xec\x1c\xd5.\xb8\x9c\x9fQ\xc3\xda\x0118?\x83i%2\x8a\xd0\x1cF\x0f\v\x14\xb4h\xc8g\xcb\xf8X\xf4\\\xbd\xbd\f\\\xb5ET\xbf\x8c\x11x\x1b\xa7\xc1%\xb2\x13\x98.\r>\x85\xc8R\xe1\x8c?\xee@\xe4\x85m\x18\x18^2\xb3\x00.4\xcf\x10X\x84\xfd\xae\x1e\x1d\x85\n\xb5P\xe0\xbdO\xa5>\xf3\xa4\xef\xd0\xd9ǈ\xea\x84`2\xd8̄:qhf\x1b\x9e.`\xba!o\x19\xf42`u\xaaoG\xc4\xed\x00\xd4ꎝ<\xea|&\x0224\xa7\xbaq7\xeb\xf1^9\x9a\xf7\x90\xc6\xda\xc5\xfd\xf5\xc7\x13\xf5\x99'w_o\xb7\xf5\f\xa9vP\xbc\x17+\x1dj\x9c\x03\xcbi\xc6q/)4\xca\xd9\x14\xf3\x9e\xb8w\v\xda\xdbQ\xa7ǎ\xf4\xea1\xd4\xfdzۭ\x11\xb1\xdeͯ\xd3rmh\xf2\xb5X\xfb\x1a\x8fT\x83\x1e\x80\x8d֓\x06\xfaI\xb0\xfd\x86fB8\xfb\xf9%fq\x06\xd0\xc3\r\x16\x1b\x90^C\xfbl\r\xb5\xf6p~\x91q;\xd2\xe0̢.ƹ\xca\xf6\b\x18\xd5&\xdcLO!h\x89\x8a\xd1\x10\xb6\xb1\u009c\xca\n[\xa0\xbab\x19\x13\xf5R\xed\x86\xf6\xdbE\xbb\xa5P\xd7\xc3\"\xc2\xc0;E\xc7+zai ?\xb9\x83L}\x0eY\x96\xb9\x9d\x82e\xbf\xecvp\x8b\xed'pt/rj14\xeb\xbeNP6xͭKҋ\x9eE\x83\xf6c\xe3\x02\x8dV\xbf\xc3B\xba[\xd9\bCص\x1cx#F\xf0\xb34o\xc4h+\xc8W\x8f\\\x13:\"\x83\x97\x12\xf5\xcf\xd2\xd87\x9f\x8da\x0eͽ\xd8\xe5\xbaXS /\xae\x98\xadݶW\xde5-gm\x01I\x16\x825_\b\xda\x1bAٝ\xe3\x8b\xfd\xe8\arCP\xc5p+\xc8)\x82\x90b\x8cEi\x96\x84Cg\f\xcfN\xa9V\xb8\xb9]\fQt\xa8\x1c퇺\xa2\xfa\x89C\xd4\xed\xf0ؔs5\x7fYe\x99Fu>\xc5\f\xcey\n\x05\xaa\xb9\x8d5\xd2\xc56!o\xf5k{\xeaBhj\xe9\xd8в?\x0e\xd9V\x84m\xfeƵX64\xda\x10\xbd샳\x9d\x88ޒ\xa3\xda\xc0\xad]\x97\x87\xf6\xe2\xea\x8aݴ\xd0 ugP\xb0\x92,\xe7\x0f\x9a\x12\xacr\xfd\x05%\xe3J'p\xb6a`ڽ\x97\xe3J/\x9fY\xb7\a \xd8\\\x03I\xea\x9e\xe5\xddM)\xed?r[\x020\xb73*a\xb4>sSY\x80j\xc8\xe4\xe6\xed\xea\x02\xe1?\xbc\xc3\xe5p4\xd8ݾ\x87o\xc4\xd0M}\x1dk\xaa\xe7I\x19\xdb\xe5\xd0\xfc\rm\xaf\xe1\xd3\u0080\xadڴ\xa5\x81\b\xbb!C\\6\x19l\x95\xf9\xcf\xeb}\xba\xb1\x9c\xad\xces\x91\xe6U|Eneh\x1b\xb7\u0082\xddc'\xfe\xabk\xb1\xb4U&\xcf[}z\x80\x12\xaf\xfd2\x00\x13Α\xd5s\xf7\x97h\xf2K4\xf9%\x9a\xfc\x12M~\x89&\xbfD\x93_\xa2\xc9/\xd1\xe4\x97h\xf2sG\x93\x0f\xc8\xe7\x8b]*\xed\xbfچa\x86,\x15\xa7=\x93\xf5v\xa9\xba\xde\xc9\n\xe9v\xb9G!B\xa7@\xea+\xa1\xcea\xb8¢c\x84_-\xe2\xad\xfd\n= k-! 5\"~\xdb\x18\u0082\xcf\x17\xb4e\xd0Q:\x02\xbf?z\xc6U\xaf\xef\x95\x02a\xba\xb4\xa1+\xf0\x19h\xbcG\xc5|\xbcK}5}p\xf06/\rpa\xbey\xf6\x7f\xba\xed0,YL\x06\x1b\xa5y\xe1\x9b5\xf2\xf4\xbf嬽V\x17\x96\v\x06{8\x8a\xdf+iؖ\xe1\xff\x83\xdaԫ69\xb7\a\x13\xfc\x1ap\xa8\xd0\xdb\xcd\x7f\xd3\xe5\x8azŸM\xcaRi걮\x8b\xdc\xeeT\xd5\x15m\xdf/\xed\x0es\x9e\xb2<\xf7\xa1*\xed\xd6P\xf1\xbd*~[\x87?75\xd83\xff\xd8\x1c\xa0\"m\x80\xec[\xf1\xe80\xea\xe0UӼ\xe6\xd7\x03\x05\xa5\vV\x96薪Z\xbb\x97\xa2\x10\x81\x16\xe6\x1ds\x1d\xa3\xf01E\xcc|\xacK̟\xc0\a\x9c\xd1jٌ\xf1\\\xfb\xb5u\x7f\xa2\x8c6\xbf\xf4\x80%\xb7\xf7+S\x82\x8e\xe1Q\x1a\xf4\xc0\x94\xdd\x17\xec\x96ϊ\xd5%^7\xc2\x13\x96w]Ǟ\x8f4\xfc\xe0\tS\xd9\\\xc9\a\xb3\xb8Z(ԴK\xe1\x02U\xba\x9bD~\x8cv\fv\xe4\xc0\x06M\xb4\u070e\x82\x04\x98\xa2y@\x14`\x1ed\xd0R\x12\xb2\x1e\xd12T\xe9\xd0\x19\x01\x9b\xca{\xf4+]\x04\xf1\x17\x81\x8f\xa5ݕ\xe2\xf0\xe8\x01\x9eJ\xe1&\xfe\x98Mh4I\xfb\\\x037\xc9\xe7wQ\xe0\x15+ڕ\x89\xe5\xfbY\x9f\xb8\xb7\xc1m\xda\xf4\nwMdo\t\x93 \xa1\x82=\x12U\xa0\xf9\xa7\xdac\xf8Ma\xcd\"තR$$\xaa\x94\x90\xb2\xef\xb5\x01l\x97\xd2\x053tNn\x02\xffux\xf3\xf5\x9f\xe3\xa3燇\xd7'\xe3\xefo\xbf>\xbcI\xec\xff|u\xf4\xfc\xe8\xcf\xf0\xe3룣\xc3\xc3\xeb\x9f\xde\xfdxu\xf1\xea\x96\x1f\xfdy-\xaa\xe2\xce\xfd\xfa\xf3\xf0\x1a_\xdd\xee\b\xe4\xe8\xe8\xf9\xbfE\xd1y\x1c7\x9b\x87\xc7\\\x98\xb1Tc\xc7\xf8^\x1a\xbc\x1bx\x82q\xfd\x1a\xef\x19d\xe7͢\xf1\xf5Q\x88^\xf5:\xb6c'\x1d?B\xbf\x85\xf4\x80\fv\xd3\xf6hߝ\xf4\x18\x8eS\xb1\t\x9c\x9e\x9c|~\xcb\xda0\xf97\x1e\xfb5ő(\xd2H\td\x85\xe1\x1f\xbb=6\x1c\xab\xf2\xf0cl'\x16\xa6R)\xd4%qV\xcc=\x86\xdb\x0eU5('\x83=\x8d\xa5\xd7\t\xc4S\xb91\xc8\xf6\x1e\x9c\xb5o!\x00\x1a\xec\xc0j\x17\x17L\x06\xbd\\\x8d\x9e\x05\xbc\xb4\xbdj\xee\x12\xc3\xe4T\xa3\xbao\x1d.\\\x01\tq8\x83\xddb\x8d\x9d\xcf\x14\x0e[\x87\n)\x83\x13P\t\x1b{٤(\x81\x1b\x01/iA\x926\x9bg\x13\xb2\xa4\xe8\xa6/\xaeA\xc8\a\xeaނgA\x84\xcd}\xb4%\xdfgJtV\xc5~z\xe0yN\x05\x0e\xbf9/\x02\x97\xb6\xf7)̗t2\x9f\xd63\x9f%'\xc9p\xb0[\xfc\xf0\xf9\x8f,\xd6nCo\xe1\xeay\xdd\xd0fq\xfe\xa4^x\xb5\xe6s\x0e\xb4\v\x15\x92\xc1\xceՐU\x11֣5IgF\x1b\xf0rw&\x88\xf2\x19F\xbbbL\x189\xad\x94\xea˟듮v3\f\x1d\x96\f\xb7^$0\x1e\x8f]YH\x1bU\xa5\xb6t\x1cN\xf1ؑ2\xae\xban\xc9o\xc5ԄDS^\xf3\x85U\xa7\"vSS\xb8\xa8\xa0aT\x02\xf0Z*\xc0GV\x949\xc6\xc3Pr\x03\xf0ZJo_\x0e\xb1?\xe8\v\x1c\x1fÇ\xa6\xfa\xd9X\x9c\xcf<#\x87yC\xfa&\x0ft\xe0\x913\xce$\x00\xfcI\xc8\a\x11C\xd5\xe2\xc1T\xc4\xdc\xe8\xdfͰ\xbeV\xe2f8\x82\x9bᅒs[\x8a\x10\xf3\x1b_A\xb8\x19\xbeĹb\x19f7\xc30\xdc\u05f6h\xf6\x8eVc\x7f\xc2\xe5\x0f4H\x1c\xfeJ\xfbKWy[\xfe\xe0\no\xe1\x1b-S\\-K\xfc\x81\x96\xe5\xda/߱r;\xf4\x96J_\xdf\xd2A\xec\xfbӤQ\xbc\xdf\xe8f\x88\xc9Ͱ\xe1\xc8H\x16\xa4\xbe\xa5Y\xde\f\xfb\"\x9d\x06\xd5\xc9\xcd\xd0\"{3\x84\x15\x92'7CB\x8b^+i䴚Mn\x86\xb4\xe5N\x8fNG\n\xcb\x11e\xe9?4\xa3\xde\f\x7f\x8b\x93 \x02\xc5\xee\x00\x88\xd5;\r\x7f\xc5Pۜ\xc1\x81\xbd\x9b\xe3J1\xa1\xed\x90t\xedE\xbcݚ\x99v\xbb\xc5/\xfb\xa8\x89\xe9\x01\n`j(a{:\x99\xb8\xbfǄ\n\xf6\ue50b/\xe1\xfak\x1b\xa6\x94H\xe0\x06\xa0\x94H\x8b\fU\xbe\\\x8d\x90\xd2\x05\x13s:\x1c\xec\xb620k\xf6tJ\xf8\x8ela\x04f\x13\xd4J\x87k\x0f\xece&u&J~\xc5\xca \x80'\xa04i\x95&~\x86f\xb5\xb2Ba\xc8\xd84w\x98\xec\xe1\xc3}\f\x86Z\xb3\xf9n\x82\xf3m-\x86\xb0\xa8\n&@!\xcb\b\xcf\x00'\x9c\xc7\xea\x1b\x8e\x9e\xe0\x92ٔ\xf6i\x93\xa4\x1b9zQ\x15lI\xd3a\xb3\x10j'\xa1>f\x14\xec\xf1-\x8a9\xdd\xc6\xf2ͳ\x7f|\xfb\xddSy\x11\xe2\x90\x1fQ\xa0\xdap\x00e\x8d-\xddn\xed\xc5&\xa2/\t\xf75$\xf3\xba\xcd`\xe3\xe2\xe2\x8a\xfe\xd3\x057\x14vÔQ@R\x95\xc4'\x9a\x10\xb8І\xf6{\x8f\xa8<\xb7\xd7 \xbc\xf6\xeb\xf9\x12N\x9f\x8d`\xeaE\xd1\xf5\xe8\u05cf\xb7I\x97\xc4M\x90\xbf\x1f\xad\xe1\xcf5\x90\xa8\xe5\x8cN\f\xa1\xdd\fJ\x17\x7f\x94~\xf7\xf6\xb6\x99xm6ƚ\xeem\xd6\xc1\x85\xf9\xf6\xef=m\xb6$\x1f\xdb\xd2\x0fz\x142\xbd\xa3\x8e\xb8\xa6MX\xc2ȍ\xcf\x15+\xe8\fp\nܞ\v\x9eqT\xbb\x18\x10\xf1\xcb\x03\f\xd7\\Լ>\xd0ދ\xb6L\xeaBɬJQ\xf5/?ӎ\xb1\xd69i/6\xe2\x00\x15\xa6\x97>Z\x87Pt\xa9\v\xfc\"V6\xf4\xfcu\x87\xe3\xc3M\x1c!\xd4uS|\xfb<^\xbdX`\xa9\xa0\xfd\xdc\xf1\xc3I>\x9e\x87y\xc5\x14\x13\x061\xa3\x1b,\xc8ax\x18\xe1^\x1er\x1c\xcd\xdd-[|\a\x15N\xeb0\x9cH\xf5\xf7\xc0\xd8\t{\a\x87sz\xf2l\x83\x86խz\x9a4E\x8e\xeb\xb3\xf1\x7f\xb2\xf1\xa7\xdbC\xff?'\xe3\xef\xff{4\xb9\xfd\xaa\xf5\xf3\xb6\xaf6\xb1\x83k\x8b\xe5j=\xaa\xea\xa7O9[U,:\x9ah\r\xf0JѭE\xafY\xaeq\x04\xbf\b;\xf9\xf51\xaa\xbf\x9eI\x89\xe8\x90@\xc5c\"\xfbَ\xd1\xffݏ\xfdT\x96X\x9e\xed\xc2\x10jH\x847\x86\xc1[w\x03\xd1B\n\x170\x932\xf1\xf1y\x92\xca\xe2\xb8\xfe\xde\xc7\x1a\xb0I\xc4;&\x96\xd08\xdbĎ\xb5n\x11\x9a\x8eJ\x01K\x95\xd4\x1a껚z\xe1\xe6\xfc\x0e\x9b\xdbۜk\x9fbʨ\xb0\xcdԔ\x1b\xc5Բ\xa1FCʄ\xbff`VŶ\xe1\xbb\xe7P#B\"d\x86\xdd9\xe2\xc8y\xfcp\x0e\xd1H\xc80\x95b\x96s\x9b\x1c\xf5\xc2\xe4\x05->0:\xaeOf\xacp\x8e\x8f\xc0M\xbd-\x8bk8̄>=}\xf6\xcde5\xcdd\xc1\xb8x]\x98\xe3\xa3燿W,\xa73C\x19m\xeaz]\x98\xa3\xed\xb6\xfa\xcd\xe9\xb7[\xed\xf0\xf0\xdaY\xdb\xed\xe1\xf5\xd8\xff\xdfW\xe1\xd5\xd1s\xaa\x14n\xfa~\xf4\x15\xa1ֲ\xe1\xdb\xebqc\xc0\t\x95\x1b[ߎ\x9eh\xce\xf1\xc2N0\x8bnx\x1dm\xe6\x03\xb6\xe877\xb9D?9\xd1G?\xf5\xa4M\x1b\nu\x9b\x17\xf8WJ\xae\x94\x9e\x8d\vV\x8e\xefp\x19\xf1c=\xa3wAP3Z\xae_\xdf\xf0C\\\xa3;\x950\xfb\x80\xf7|\x87ˈ\x86o;=B\x1aS\x17\xf8\xe8\xc7o!,;V\xbeY,1\x9b\xd1az.\"\x8b\x8euu$\x92!\xbd\xb8|{\xa0닉\"`\x1f\xe8\xf2=\xba\xb0\xc9V\xf4C\xa8\x95Wڠ\x8a\x94\xb4\xea\x89\xd0&6\x90K\x11\x0f}\xfc%i\xe4\xfa\\\x89\x8cJ\x1eHgT)\xc9q\x89Ls\t^S\xddـ)\x13\x9d*XS\xf3⢯\xe0\xb5\xc1R\x1a\x89\xc63\xd3\x15i6\xc2ܘ\x8f\xd6WL\xb5\tۗ\uf0fep\xb5?\x99{j\t\xd8\xe9uS\xddޑ\x13\xab\x1d\xe2\xdchi)\x0e\xfa\x97\x88l\xee\x12\n\xe6\xd9\xff\x1f\x1f\xbc\xbb\xdbB\xfc\xbbv\x86뻴\xf2\xd4\x1e\xcb<\x88)\xb4\x9f#\xf7\xc1\xd1ޡ\xba\x05C{\xabj\x90\x88O\x9aV\x93\xa3h\xb5<\x19\xec\x16\x9a\x8d\x9b\xc0!\xf2\xad{\x11\xecNtU;p\xfe\x97\xc0\xf7m{\x1fF\xbe\x02Σn\xc4\xee\xb25\xed\x95\xe4d_%\xda\\\xf5r*\xf0bi\xe2\x9fרzѴ\xaeik-\xb8\xfa\xdd\n#\n\xeaȎ\xf4\x86\r\x06^\xf7\xd2\x05\xa6w\xf6\x8a\xcb֎\xa0\x15\x06\xd1\xd5\nTI\x17T1\xe0}[5\xdb=\x0et\xf4\xfa\x85\x94\xd1)R\x9axk\xc4-\xe7\xfd\xa9\xf7.cwK\xbd\xb7\xb0\x7f\x97Ի%\xde\xfa\xb2\xdd\x1d\xa4\xf1.\xd2-\x88\xa5\xf6kVY\xad\xd7\xf2\xa3d\x9b\t\xedwV{\x11\x1b5\x9c\xe6t\xfd.\xca\xf6\xde\xdfF\xe0)\x12U1u;\x99\xbc\xea\x90\x16yE\vP\xa3@\xa1O\xb1\x9e$\xabz3\xc0rW\xa3\xf9\xb0\xda#\xd0\xd3ީ\x10\xd9h\x10\x85۹\xd2$x\x0f\xb7\xb5'x\vF\xaa&\xfa+Jەz\x1b\x17\x8c4,ߕ\x01Wu\xe3\x18\xed{\xef\xd2Xs\b;\x9b~\x1f\xd8\x7f9\x87ЛaD?t^\xba\xd2fkt?\xfd\xb4\xdfT\xd3:\xef\x9e\xc0\x1f\x7f\r\x9az\x8a\xab\xd5cֺ\u06dd\xaew\x9b\xc0p\xb8r7\xbc\xfd\xd9d\xcc\x13\xb8\xbe\x1d\x04K\xf3\x17\xc3\xe9\t\\\xdf\x0e\xfeg\x00\xa6&\x84\x89\x91_\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XM\x8f\xdb6\x10\xbd\xfbW\f\xd2\xc3^b9A\x8b\xb6\xd0-\xf1\xb6\xc0\xa2\xc9\u0088ӽ\x049\xd0\xe2\xd8b\x96\"Urdw[\xf4\xbf\x17CQ\xdf\xf2z7M-_$\x0e\x87\x8fo\x86\x8fC.\x96\xcb\xe5B\x94\xea\x0e\x9dW֤ J\x85\x7f\x12\x1a~\xf3\xc9\xfd\xcf>Qvu|\xbd\xb8WF\xa6\xb0\xae<\xd9\xe2\x03z[\xb9\f\xafq\xaf\x8c\"e͢@\x12R\x90H\x17\x00\xc2\x18K\x82?{~\x05Ȭ!g\xb5F\xb7<\xa0I\xee\xab\x1d\xee*\xa5%\xba\xe0\xbc\x19\xfa\xf8*\xf9)y\xb5\x00\xc8\x1c\x86\xee\x1fU\x81\x9eDQ\xa6`*\xad\x17\x00F\x14\x98\x02\x0f$\xed\xc9h+\xa4O\x8e\xa8\xd1\xd9Dم/1\xe3\x11\x0f\xceVe\n]C\xdd1\xa2\xa9gr-H\\G\x1f\xe1\xb3V\x9e~\x9b4\xbdS\x9eBs\xa9+'\xf4h\xec\xd0\xe2\x959TZ\xb8a\xdb\x02\xc0g\xb6\xc4\x14nE\x81\xbe\x14\x19\xca\x05@\x9cl\x80\xb2\x04!e\xa0O\xe8\x8dS\x86Э\xad\xae\x8a\x86\xb6%H\xf4\x99S%\x9bԈ\xa1q\x0f\x9e\x04U\x1e|\x95\xe5 <\xdc\xe2iuc6\xce\x1e\x1c\xfa\x1a\x17\xc0\x17o\xcdFP\x9eBR\x9b'e.<\xc6V\xa6$\x85mh\x88\x9f\xe8\x81\x01{r\xca\x1c\xe6 p@\xe0\x94\xa3\x01\xca\x11\xe4\x00\xd0Ix\x06\xe5\b\xe5\xd9\xe1C{\x1b\xd5hV\xe3Xs\xccۮ5\x10)\b\xe7`\xb4\x8c\x82\xdd\a$%\xb3\xea\t\r\xc1\x91\x19DȴPE\x87R\xf9\x16h;\x06\xc0\u07ba\x19\xa8%f\t\tw@jǉV5\xd2\xf1\xd7K\xa4\xb1\xfd\xd7\x01\xbd\bps\xb7\x8e\xed5\xb4\xee\xfd9\xa0\x8c\x95x\x0e\x8153\x00B\xca$\xdcmH\x8c\x95_\xc3\xc9[\x91\xddW%l\xc9:q@xg\xb3\xb0\xf8\xcfr\xe2l1\x83\x89\xa3\xb6\v\x9e\xa2\xa3\xc6\xcf(ۇ\x83\x9c\x87\xdb\xf3\xddh[2ѥ\x81\xef7\a\x9c\xcf\xde:6\xc7\xd7\xe1\xc5g9\x16A&\xf9͖h\xdeln\xee\xbe\xdf\x0e>Ð\xad\xbe \x81CO֡\xef\xf8\x11A\x1a~/Csa\x8f(\x81lh\xae\ti\x9d\x028,\xadWd\xdd\x03(C\x16\x04\x18<5\xa9\xb8\xb7\x0eD\xe3_¦\xcdջо\xe6%\x95\xb4\xceJgKt\xa4\x1aY\xad\x9f\xdeV\xd2\xfb:\x9a\xcf\x15O\xb9\xb6\x02\xc9{H\x9cM\x14G\x94\x91\xa5:C\x94g\xd8\x0e=\x1a\xea\a\xady\xec\x1e\x84\x01\xbb\xfb\x82\x19%\xb0E\xc7n\xc0\xe7\xb6Ғ\xb7\x9e#:\x02\x87\x99=\x18\xf5W\xeb\xdb7\x1ciA\x185\xbe{\x82\x18\x1b\xa1\xe1(t\x85/A\x18\t\x85x\x00\x87<\nT\xa6\xe7/\x98\xf8\x04\xde[\x87\xa0\xccަ\x90\x13\x95>]\xad\x0e\x8a\x9a-4\xb3EQ\x19E\x0f\xab\xb0\x1b\xaa]E\xd6\xf9\x95\xc4#\xea\x95W\x87\xa5pY\xae\b3\xaa\x1c\xaeD\xa9\x96\x01\xba\xe1\t\xfb\xa4\x90߹\xb8\xe9\xfa\xab\x01\xd6I\xea\xd6\xff\xb0\xc9=\x12\x01\xde\xe9XlD\xecZO\xb4#\x9a?1;\x1f~\xd9~\x84f\xe8\x10\x8c\x81S\x88\xbcw\x1d}\x17\x02&L\x99=\xba\xd0\x0f\xf6\xce\xd6B\x87F\x96V\x19\n/\x99Vh\xc6\xf4\xfbjW(\xe2\xb8\xffQ\xa1'\x8eU\x02\xebPW\xc0\x0e\xa1*ym\xc9\x04n\f\xacE\x81z-<\xfe\xef\x01`\xa6\xfd\x92\x89}Z\b\xfa%Q\xf7c/id\xad\xd7Д.g\xe2\xd5W\x80m\x89\x19\x87\x8e\xd9\xe3nj\xaf\xa2b\xd6\v\xb8o\xdb-\xd7\xf3K\x96\x9fY\xe5\x1c\x1b\x8d0\xbd\x9d\xeb\xd3\x003=\x81\xaf\x9d\x03˖h5\xb2\xff\xe8\xa6\xf3)G\x87\xfd>}\xbd\xe2\xf2\xc2:\x1c\xcd\xe9\x91\x00\xf0?\x13&C}a&\xeb`\xd4˶\\P'\xaf\xcd\xdeÙ\xe7ɖ\xe5y\b;k5\x8a\xb1@y#J\x9f[\xba\xb9\xbe\x80c\xdb\x1a64*\xc9\t\xb8W\xe8\x1a2\x1bg\xcd;C\x9cxe\x01\x9b\xa7\xf1Y\xe4Ղ\xd3\xd6;\x97\xd0\x0f\xad\xfb\x99\x10\xbaw\x8cr\xa9\xc8\x01F9\xf1\bP\x95A)^\xc2)WY\xde1\xe0\xbf\xc1\x84Fe݅\t}\x1cZO'\x14C\xf0\xd4\x1d\xf3\xc9\x007w\xeb'A\xdbܭ\xfb\xa0\x9e\x86g\xe2\x18\xceVZ\xd6%pCPT\x9e\xc0\xa3f\x9dg\xd3X.\x9c\x14\xe5qێ笕|0\xa2Pٲ<.#\x88\x99Ѵء~\x06+\xbc,\x95\xc3\xd1v\xb6\x84ݜ\xfe\x8cl\xba\xa57n\x18&\xeb\xa8u\xbe\xfc\x1f\xb6v\xb5\xf6\xe3\xc2\x1e\x8a\xe5tq6\x94\x03i\x0f\xc6ML\xb3\xca9>\xd2ģ\x9e\xdd\x7f\xa5\xb8g\xb6(5\x0e\x0fԏ\xa7\xd7z\xda#TPN\xd6\xc8H\x15\xbd\xe5ܤ\xcc\xc4'\x84\x95\x1e\x87G\x99\xf4\xfc\xd6.Bi\x97Y\xc7نG4\xc0\x9b\x98P\x1a\xe5г\x9f\xa6\xcb\u07baBP]e/\xd9\xd9Ă\xaf\f\xc4Nc\n\xe4*|z\xbe\xf1\xce\xed\xbd8\xe0\x05\x92\xde\xd7V\x1c-\xd1t\x01\xb1\xb3\xd5\xcc\xdeq\xe5c\x14\x93\xe7\xe0\xe0\x13\xd6\x05\x10\xb7|v\x9bр\xc7\xcftS\x14\x007t\x15\xdd\xd4]\x05\x81\xc82,\x89\x8f\x139\x0e2\x0f*CJ\xf7\xc5`R\x17\U000bfc15!\x94u\xf9L͵A\x80\xa6x\xb4\xd8>\v\xe8\x11Z\xc2%\xc6\x05^6l3\xb7\x90Z\x86ί$~\xd0T\xc5t\x88%߳\xcc|}\x13\x89\x9ai\xda8,\x85\x9bm\x9a\xdc\xd7tϲY*\xb3\x1d\x7f\rKd\xaeS(dP>\x8b͈\xe1\x12\xa1\xd1\fr\xab\x1b\x15\xb0$4\x98\xaa\xd8\xd5\xe5\xc9\xee\x81Џ딉Wh\xb2\xa1\rK硗\xa4\xc1\xd94.\xe7U\x8e\x9f\xd0\xe9ښ\x99U\xd3\xd7\fe\xe8\xc7\x1ff-\xea\xac\xe3\xd3\xdf\x01\xddbj\x10\xa6\xfc\xf6\x81\xe6\x87\xff\xef#\x9c\xd9D\xe2Fһ;\xbb\x10\xad\xed\xc0\xf8\t\xda\xcdJ=q\t\xad\x02$\x8bs3\xfd\xf6\xfa;\xcb\xc1\xe4\xa3GwD\xd9\xf3\x1d\x8f\x17\xfd/ծ=4\xa7\xf0\xf7?\x8bn/n\xe6u;\xbe\x16~\xf1bp\xdb\x1b^3k\xeakZ\x9f§\xcf|\xb1\x1b.H\xe2\r\x86O\xe1\xd3\xe7ſ\x03\x00v\xa7}\xd2H\x17\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XK\x8f\xdb6\x10\xbe\xfbW\f\xd2\xc3^b9A\x8b\xb6\xd0-\xf1\xb6\xc0\xa2\xc9\u0088ӽ\x049\xd0\xd2\xd8b\x96\"Y>\xbc\xdd\x16\xfd\xefŐ\xa2\xde^\xaf\xd3\xc6\xf2E\xe4<>~3\x1c\x0e\xb5X.\x97\v\xa6\xf9\x1d\x1a˕́i\x8e\x7f:\x94\xf4f\xb3\xfb\x9fm\xc6\xd5\xea\xf8zq\xcfe\x99\xc3\xda[\xa7\xea\x0fh\x957\x05^\xe3\x9eK\uee12\x8b\x1a\x1d+\x99c\xf9\x02\x80I\xa9\x1c\xa3aK\xaf\x00\x85\x92\xce(!\xd0,\x0f(\xb3{\xbfÝ\xe7\xa2D\x13\x8c'\xd7\xc7W\xd9O٫\x05@a0\xa8\x7f\xe45Z\xc7j\x9d\x83\xf4B,\x00$\xab1\ar\xe4\xb5P\xac\xb4\xd9\x11\x05\x1a\x95q\xb5\xb0\x1a\v\xf2w0\xca\xeb\x1c\xba\x89\xa8\xd6`\x89\xeb\xb8f\x8e\xfd\x1e,\x84A\xc1\xad\xfbm4\xf1\x8e[\x17&\xb5\xf0\x86\x89\x81\xd70n\xb9<x\xc1L\x7ff\x01`\v\xa51\x87[V\xa3լ\xc0r\x01\xd0,1@X\x02+\xcb@\x1a\x13\x1båC\xb3V\xc2\u05c9\xac%\x94h\v\xc35\x89D\xa4\x10\x8d\x83u\xccy\v\xd6\x17\x150\v\xb7\xf8\xb0\xba\x91\x1b\xa3\x0e\x06m\xc4\x04\xf0\xc5*\xb9a\xae\xca!\x8b♮\x98\xc5f\x96\x88\xc8a\x1b&\x9a!\xf7Hp\xad3\\\x1e\xe6\x00P\x10\xe0\xa1B\t\xaeB({p\x1e\x98%H\xc6ay\xd2y\x98o\xe3؈E\x14k\x8ar\xab\x1aa\x94\xcc\xe1\x1c\x88\x96MP\xfb\x80C\x13\xa3֡tp$\xf6\x10\n\xc1x\r\x0f\x95\xb2\bV2m+\xe5\x80\xdb\x06\xec,D\x8dE\x163\xb9\xb5\xdfHE\x84\xe3\xd1sT\x91\xfc7\x02\xb8\xb9[7\xf3\x11Z\xf7~\t(\xa9J\xec\xc2\xd8\xf3\r{\xa3\xea\x19\x00!Q2R\x1b\x12\xa3ʯ\xe1\xe4-+\uef46\xadS\x86\x1d\x10ީ\"l\xf3yDN\xcdࡈ킕\xc6H\xb21\xca\uf843\xd3P{\xb6S\x05\xcb&\xd5g`\xfb\xcd\x01\xe736\xc6\xe5\xf8:\xbcآ\xc2:\x14CzS\x1a\xe5\x9b\xcd\xcd\xdd\xf7\xdb\xc10\f\x99\xeaJ\x0f\xd4ꈶ\xa3E\xed\x81\xc1z{\x03w!\x93\xb6)y\x9c\n2\x91\x90\xd6(\x80A\xad,w\xca<f\xed\xa86J\xa3q<\xd5\xc1\xf8\xf4*\x7fot\x04슰G)(\xa9\xe47ؚ\xaa\x86e\xb3\xdc\x18fnɿA\x8b\xd2\xf5\xd9O\x0f-F\x82\xda}\xc1\xc2e\xb0ECf\xc0Vʋ\x92N\x8a#\x1a\a\x06\vu\x90\xfc\xafֶM\x8b\x15\xccaS\x98\xbb'TQ\xc9\x04\x1c\x99\xf0\xf8\x12\x98,\xa1f\x8f`\x90\xbc\x80\x97={A\xc4f\xf0^\x19\x04.\xf7*\x87\xca9m\xf3\xd5\xea\xc0]:\xf1\nU\xd7^r\xf7\xb8\n\x87\x17\xdfy\xa7\x8c]\x95xD\xb1\xb2\xfc\xb0d\xa6\xa8\xb8\xc3\xc2y\x83+\xa6\xf92@\x97\xb4`\x9b\xd5\xe5w\xa69#\xed\xd5\x00\xeb$\a\xe3?\x9cJOD\x80\x0e'\xaa\x15\xacQ\x8d\v툦!b\xe7\xc3/ۏ\x90\\\x87`\f\x8cB\xc3{\xa7h\xbb\x10\x10a\\\xee\xd1\x04\xbdP\x11B\x98Q\x96Zq\xe9\xc2K!8\xca1\xfd\xd6\xefj\xee(\xee\x7fx\xb4\x8eb\x95\xc1:\xb4\x01\xb0C\xf0\x9a6I\x99\xc1\x8d\x845\xabQ\xac\x99\xc5o\x1e\x00b\xda.\x89\xd8煠\xdf\xc1t?\xb2\x927\xac\xf5&R\xafq\"^\xddV\xdej,(p\xc4\x1d)\xf1=o\x8a\xde^\x19`\xbdM\xdfm\xd5\xd3ە\x9e\xd9\xf27\x16\x1a\xe1y;\xa7\x93`\xc9^\x85\x8e\xc6\xc1\xc6\xe2:1\n \x92\xf2C\x85\x06\xfb:]\xd1!\xc3d\x01Gkz\x82|\xfa\x17L\x16(άd\x1d\x84z\x99V17iLvH+\xd0\xfa4\x80\x9dR\x02ٸ4\x8dz\x813P\xb6C\xe9>\x9d\x83Ve\xd3v\x02\xb1|\xaf\xa9Q\x99\x98\xa6\xb6\xb9\f\xf2\xc3\"\x7f\x11\x85m\xaf\xf0,蛻\xf5\\\x0e\xcc\xe2%d\x13\x9b0>\x90\xa8#t\xec\x1e%\xa8\xfdE\xc0\x8f\x03;gЏ\x9c\xce,azVN,BӉ\x8d\xfb\x8e\fnܕ\x05\x1e\xdbݦ\x90\xb61\xbd`Q\x94\xa2\xdcਬ/a7\xb7\x17G2\xf3M\xe9p\xb6\xeb\x00\xd3\xf8\x90\xc4g\x95\xb1\xd0\xdf勓T\xf7\nY\x10M\xf9Rxc\xa8\xf7nn$j\xffU\xa5\xacP\xb5\x168\xbc\xe9=\x1d\xfa\xf5T#\xf4\n\xa6\x8c\xb8\x1c]VF\xe5`b\x11B\x9e6\xce)\xe2\x9d\xd5h \xb40\x852Ԇ⑲Y\u009eq\x81e߮\x9d&\xc3^\x99\x9a\xb9\xd8\x14.\xc9\xd4D\x82\xee\xb1l'0\ag<>?\x9b\xe8|\xb2\x96\x1d\xf0\fA\xef\xa3\x14ŉ%\x15`;\xe5'U\xf2\xca6\xd1\xcb.AAW\x813\x10n\xe9\x921\xb3)\x9f\xbe|LQ@܉=U\xe6\x80\x15\x05j\x87\xb1Rv\x19\a^:.\xc2`\xefj5c\xb2V^:,_\xa6j+;\xfb\x9c\xbc5\xf3\xa0\xe4E\xb4h\xba/=M\v]3\x12-{/D\xd0I\xdc$\xb7-\xf8\a\xee*>>\x9f\xe8i\xe1\xb2\x03m@\xad\xcaˀ\xd2ǀsHIfn\xa7\xb7\xa1<\xb5\xd5\xe9A\xe9멃%}\xad\x98\x19}\xd3\xc4sfjcP33;5\xf9\xea\xd1=˴\x9bg\x15\x7f\r\xbbxN)\xf4\x15X^\xc4e\x83\xe1\x1c\x9d\x8d\x18TJ\xa42\xa5\x1c\x13 }\xbdCC\x9c\xee\x1e\x1d\xdaDnʁ'Z\x84\x14\x94\xceB\xbb\x97\x82\xa9iTN\x17az\x82ҵ\x923\x99\xd1/k\\\xba\x1f\x7f\x98\x95\x88[\x83\xaea\a43\x12a\xc1o\x1fݼ\xfb\xff\xee\xe1\xc4\xf9F\xffD\xe7\xcd\xf5\x998\xa5\x83\xf3\xe6:\xe5>/\xe9>\xb1\xe7hƱI\xefTO'V!u\x0f\x93\xce8\xbb$\xbd\x86\x9f\xce\u0381\x1f\b\x9f=\x13\xc3\t\x98ji\xb68\x15\x8e\xff\xff\x1c\x9b\r\xd4dТ9bٳ\xdd\\H\xfa#~\xd7^\xb1s\xf8\xfb\x9fE\xd7ˤuݎ\xbf\xfa\xbex1\xf8\xa0\x1b^\v%\xe3\xd7X\x9bç\xcf\xf4\xfd6\xdc\\\x9a\xef\x1d6\x87O\x9f\x17\xff\x0e\x00\x9d\xa6\xfaB%\x17\x00\x00"),
 	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96Ms\xe36\x0f\xc7\xef\xfa\x14\x98}\x0e{y$\xefN\x0f\xed\xe8\xd6\xcd\xee!\xd36\xe3I2\xb9tz\xa0I\xd8\xe2F\"Y\x00t\xeav\xfa\xdd;$%\xbf\xc8v6=\x947\x91 \xf0\xe7\x0f\x04Ī\xae\xebJ\x05\xfb\x84\xc4ֻ\x16T\xb0\xf8\x87\xa0K_\xdc<\xff\xc0\x8d\xf5\x8b\xed\xc7\xea\xd9:\xd3\xc2Md\xf1\xc3=\xb2\x8f\xa4\xf13\xae\xad\xb3b\xbd\xab\x06\x14e\x94\xa8\xb6\x02P\xceyQi\x9a\xd3'\x80\xf6N\xc8\xf7=R\xbdA\xd7<\xc7\x15\xae\xa2\xed\rRv>\x85\xde~h\xbeo>T\x00\x9a0o\u007f\xb4\x03\xb2\xa8!\xb4\xe0b\xdfW\x00N\r\u0602\xc1\x1e\x05WJ?\xc7@\xf8{D\x16n\xb6\xd8#\xf9\xc6\xfa\x8a\x03\xea\x14xC>\x86\x16\x0e\ve\xff(\xaa\x1c\xe8sv\xf5)\xbb\xba/\xae\xf2joY~\xbaf\xf1\xb3\x1d\xadB\x1fI\xf5\x97\x05e\x03\xb6n\x13{E\x17M*\x00\xd6>`\vwIVP\x1aM\x050\xf2\xc82kP\xc6dª_\x92u\x82t\xe3\xfb8Ldk0Țl\x90L\xf0\xb1\xc3|D\xf0k\x90\x0e\xa1\x84\x03\xf1\xb0\xc2Q\x81\xc9\xfb\x00\xbe\xb2wK%]\vM\xe2\xd5\x14\xd3$d4(\xa8?ͧe\x97\x04\xb3\x90u\x9bk\x12X\x94D\x9eD\xe4\xb8\xd6;\xa0#\xbe\xa7\x02\xb2}\x13:ŧ\xd1\x1f\xf2µ\xc8\xc5f\xfb\xb1\x90\xd6\x1d\x0e\xaa\x1dm}@\xf7\xe3\xf2\xf6黇\x93i8\xd5z!\xb5`\x19Ԥ4\x81+\xd4\xc0;\x04O0x\x9a\xa8r\xb3w\x1a\xc8\a$\xb1\xd3\xd5*㨪\x8efg\x12\xde'\x95\xc5\nL*'\xe4\fm\xbc\x04hƃ\x15\x98\x96\x810\x102\xbaR`'\x8e!\x19)\a~\xf5\x15\xb54\xf0\x80\x94\xdc\x00w>\xf6&U\xe1\x16I\x80P\xfb\x8d\xb3\u007f\xee}s:g\n\xda+9\xe4g\x1a\xf9\xd29\xd5\xc3V\xf5\x11\xff\x0f\xca\x19\x18\xd4\x0e\bS\x14\x88\xee\xc8_6\xe1\x06~I\x98\xac[\xfb\x16:\x91\xc0\xedb\xb1\xb12u\x13\xed\x87!:+\xbbEn\fv\x15\xc5\x13/\fn\xb1_\xb0\xddԊtg\x05\xb5D\u0085\n\xb6\xce\xd2]\xee(\xcd`\xfeGc\xff\xe1\xf7'Z\xcf.H\x19\xb9\xd0_\xc9@*\xf3\x92\xf6\xb2\xb5\x9c\xe2\x00:M%:\xf7_\x1e\x1ea\n\x9d\x931\xa7\x9f\xb9\x1f6\xf2!\x05\t\x98uk\xa4\x92\xc45\xf9!\xfbDg\x82\xb7N\xf2\x87\xee-\xba9~\x8e\xab\xc1\nOW2媁\x9b\xdcbSQ\xc7`\x94\xa0i\xe0\xd6\xc1\x8d\x1a\xb0\xbfQ\x8c\xffy\x02\x12i\xae\x13ط\xa5\xe0\xf8\xef07.Ԏ\x16\xa6\xf6}%_\x17\x8a\xf6!\xa0N\x19L\x10\xd3n\xbb\xb6:\x97\a\xac=\xc1Kgu7\x15\xed\x8c\xee\xbe\xc0\x9b\x93\x85\xcb\x05\x9dơM\xceW\xae\x1e\x1er\xee,\xe1\xec\x16\xd6p\xd6s_璛\xe1\xbf$S:\xf1\xc8FG\"trԟեMoe\x81D\x9e\xcefg\xa2\xbed\xa3\xfc\x04P\xd61(\xb7\x1b7\x82tJ\xe0\x05)\x95\x81\xf61\xf5\x194`\xe2\x19\xbf\x11\xcb\xf1\xbf$\x90\xd7\xc8ܜ\xd9Y\xc1ႦW\xb2\x93Fz^\xa8U\x8f-\bE\xbc\x92YE\xa4v\xb3\xb5\xfc\xcf\xfa\x06\x82e\xb2\xb9\x94\x83\xfd\u007f\xfa\x9bIȸ]\x1c\xce#\xd5p\x87/\x17foݒ\xfc\x86\x90\xe7W>-.\v\xbd\xfdc\xe0\r\x94.^ʳIN\xfd\xce\x1cQd\xf1\xa46\xc7\\9\xae\xf6\xfd\xbb\x85\xbf\xfe\xae\x0e\xf7Zi\x8dA\xd0\xdc\xcd_i\xefޝ<\xb7\xf2\xa7\xf6\xae\xbc\x8c\xb8\x85_\u007f\xabJ(4O\xd3\xeb)M\xfe\x13\x00\x00\xff\xff--\nM\xde\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x10\xbd\xfbW\f\xd2CZ \x92\x13\xf4\xd0·v\x93âi\x10\xd8\xe9^\x8a\x1ehj,\xb1K\x91,g\xe8\xcd\xf6\xd7\x17CJ\xfe\x90\xe5\xdd͡\xbc\x89\x1c\x0e\x1f\x1f\xdf<R\x8b\xaa\xaa\x16*\x98;\x8cd\xbc[\x81\n\x06\xbf2:\xf9\xa2\xfa\xfeg\xaa\x8d_\xee\xdf-\xee\x8dkVp\x93\x88}\xbfF\xf2)j|\x8f;\xe3\f\x1b\xef\x16=\xb2j\x14\xab\xd5\x02@9\xe7YI7\xc9'\x80\xf6\x8e\xa3\xb7\x16cբ\xab\xef\xd3\x16\xb7\xc9\xd8\x06cN>.\xbd\u007f[\xffT\xbf]\x00\xe8\x88y\xfa\x17\xd3#\xb1\xea\xc3\n\\\xb2v\x01\xe0T\x8f+h\xfc\x83\xb3^5\x11\xffIHL\xf5\x1e-F_\x1b\xbf\xa0\x80Z\x16m\xa3Oa\x05ǁ2w\x00T6\xf3~H\xb3.i\xf2\x885Ŀ͍~4CD\xb0)*{\t\"\x0f\x92qm\xb2*^\f/\x00H\xfb\x80+\xf8$0\x82\xd2\xd8,\x00\x86\xbdgXհ\xbb\xfd\xbb\x92Jwث\x82\x17\xc0\at\xbf|\xbe\xbd\xfbqs\xd6\r\xd0 \xe9h\x02g\x06'\x98\xc1\x10(\x18\x10\x00\xfb\x03(P\x0eTd\xb3S\x9aa\x17}\x0f[\xa5\xefS8d\x05\xf0ۿQ3\x10\xfb\xa8Z|\x03\x94t\aJ\xf2\x95P\xb0\xbe\x85\x9d\xb1X\x1f&\x85\xe8\x03F6#˥\x9d\x88\xeb\xa4w\x02\xfc\xb5\xec\xadDA#\xaaB\x02\xeep\xe4\a\x9b\x81\x0e\xf0;\xe0\xce\x10D\f\x11\t]\xd1\xd9Yb\x90 \xe5\x86\x1d\u0530\xc1(i\x80:\x9fl#b\xdccd\x88\xa8}\xeb̿\x87\xdc$\fɢV\xf1(\x87c3\x8e1:ea\xafl\xc27\xa0\\\x03\xbdz\x84\x88\x99\xa7\xe4N\xf2\xe5\x10\xaa\xe1w\x1f\x11\x8c\xdb\xf9\x15t́V\xcbekx,*\xed\xfb>9Ï\xcb\\\x1ff\x9b\xd8GZ6\xb8G\xbb$\xd3V*\xea\xce0jN\x11\x97*\x98*Cw\xb9\xb0\xea\xbe\xf9.\x0eeH\xafϰ\xf2\xa3Ȍ8\x1aמ\fd\xcd?q\x02\xa2\xfa\"\x982\xb5\xec\xe2H\xb4t\t;\xeb\x0f\x9b/0.\x9d\x0fc\xca~Q\xcea\"\x1d\x8f@\b3n\x87\xb1\x1cbV\x9e\xe4D\xd7\x04o\x1c\xe7\x0fm\r\xba)\xfd\x94\xb6\xbda\x1a\xc5,gU\xc3Mv\x1a\xd8\"\xa4\xd0(Ʀ\x86[\a7\xaaG{\xa3\b\xff\xf7\x03\x10\xa6\xa9\x12b_v\x04\xa7&9\r.\xac\x9d\f\x8cNv\xe5\xbc&\xa5\xbe\t\xa8\xe5\xf4\x84@\x99ivF\xe7Ҁ\x9d\x8f\xa0\x8e\x95?\x10X\x9fe\x9e\xaf\xdc\fN\xc5\x16y\xda;\xc1\xf2%\a\xc9\xf2\x0f\x9d:7\x9a\xef\xb1nk\xf1\n\x1a\x80\x14\xf7\xf8\xa1\xbe\xc8x\x1d\x03̪w\x16\xc9(b\xa1Ax\x15+\x10\x93:\xc5t\xb9\xb44t\xa9\x9f_\xa0\x82_3揾}r\xfc\xc6;\x16\xb9?\x19t\xe7m\xeaq\xe3T\xa0\xce?\x13{\xcbؿ,r\xbc\x90\x0f\x97\xd4e\xe0\x1a\xc5\xca\xf1\xfa&\x86\x805R\xb2W\x97\xbb\xd9\xdc~\xcb>\xae\x84?\xc9ԕ\xda\x19[\xbe#\x9f\x17\x82ܲ\xa3\x10dJ\xb98\x10\xe4\xed\x11\x1d2\xd2\xd1\xc3\x1e\fw\xb3\x19\x01\x1e:\xa3\xbb<1\xabH\xec\x91\xc8k\x93\xcd\xe6\xdb\xe1K\xf1\x99\x883J\xae\xb2\xc2g\xba\x05\xfcE\xf7\x15˸\xb6@5\x94\xf1\x8bl\x87\x15'\xfa\x06\xe3\xc9\xf1#\xd5:ň\x8e\x87,\xf9\"\x9eNx\xa9\xf3\x8c\xe5\xfa\xc7\xfa\xe33\xf6\xf3\xfe\x18\x99\x9f\x9aʸ\x82&D\xacȴ\xf2|\x9011\xa0l\f\x97d\x94v\xfe\x9c9'j\xf6D\xf1k01\xdb\xec3\x10?\x1c\x02\x8bK\xa2+7\xe0\xf4\xc1\x96\x13\"\xe5ׅV\xd3w\x8d\xb4-B\x83\x16\x19\x1b\xd8>\x16\xbb\u007f$\xc6\xfe\x12\xf7\xce\xc7^\xf1\n\xe4f\xac\xd8\xcc\xc8H\x1e\xd5jkq\x05\x1c\xd35\x95\xcdn<t\x8af\xca\xf0lϟ%fN\x18\x87b|R\x19pՔ+\xf8\x84\x0f3\xbd\x9f\xa3\xd7H\x84\x97etu'\xb3Ep\xd1I\xf2|iNX\x1a^\xc5Cϱd\x94\xd6\x18\x18\x9bO\xd3_\x8dW\xaf\xce\xfe\x1d\xf2\xa7\xf6\xae1\xe5/\t\xfe\xfckQ\xb2bs7\xfe\x12H\xe7\u007f\x01\x00\x00\xff\xff\x1d\xc1\x89\xa5\x9f\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Z\xdds\x1b\xb7\x11\u007f\xe7_\xb1\xe3<\xa8\x991\x8f\x89\xdbi;|s\xa4\xa6\xa36\x915\x96\xad\x17\x8f\x1f\xc0\xc3\xf2\x0e\xd1\x1d\x80\x028\xcal&\xff{g\xf1q\xbc\x0f\x90\x944ur/6\xf1\xb1\xf8a\xbfw\xa1\xc5r\xb9\\0-\xee\xd1X\xa1\xe4\x1a\x98\x16\xf8š\xa4_\xb6x\xf8\xbb-\x84Z\xed\xbe_<\b\xc9\xd7p\xd9Y\xa7\xda\xf7hUgJ\xbc\u00ad\x90\xc2\t%\x17-:ƙc\xeb\x05\x00\x93R9FÖ~\x02\x94J:\xa3\x9a\x06ͲBY<t\x1b\xdct\xa2\xe1h<\xf1t\xf4\xee\xbb\xe2o\xc5w\v\x80Ҡ\xdf\xfeA\xb4h\x1dk\xf5\x1ad\xd74\v\x00\xc9Z\\\x83V|\xa7\x9a\xae\xc5\r+\x1f:m\x8b\x1d6hT!\xd4\xc2j,\xe9\xd0ʨN\xaf\xe10\x11\xf6F@\xe12\xb7\x8a\xdf{2?x2~\xa6\x11\xd6\xfd;7\xfb\x93\xb0ί\xd0MgX3\a\xe1'\xad\x90U\xd703\x9b^\x00\xd8Ri\\\xc3\r\xc1ЬD\xbe\x00\x88w\xf7\xb0\x96\xc08\xf7\xdcdͭ\x11ҡ\xb9$\n\x89\x8bK\xe0hK#\xb4\xf3ܺU\x1c\x02@\b\b\xc1:\xe6:\v\xb6+k`\x16n\xf0qu-o\x8d\xaa\f\xda\x00\x0f\xe0\x17\xab\xe4-s\xf5\x1a\x8a\xb0\xbc\xd05\xb3\x18g\x03{\xef\xfcD\x1cr{\x02m\x9d\x11\xb2\xca\xc1 \x19\xc1c\x8d\x12\\-,\x84\xdb\xc2#\xb3\x04\xc78\u007f\xcb\xfc\xc1~\xbe\x17\xf1\b\xc1%)@\xbf5@\xe0\xcca\x0e@\xcfOP[p5\x12\xe7\xbd\xc61!\x85\xac\xfcP\x90\x048\x05\x1b\xf4\x10\x91C\xa73\xc84\x96\x85V\xbc\x90\x89\xe8\b\xd6\xcdd\xf4\x1coh\xfd\xff\x1b\xd5\bЭ\xe2/\x80\xf2\xacs\xc3\xe2ѩ\xf7ás\aG\xdd4\xa8\x95\x15N\x99=\b\x8e҉\xad@\x03[e\x86js\x04\x02\xed\xbd\xee7\x8d\xa0\xbc?\x90\xbd\xbez\xaa\xc6\xd6\xe8\xd7$vt\xbaQ\x8c\xa3!\x86\xd4L\xf2\x06I\xd7\x188ä\xdd\xf6\aNQ\xa5m\x1f\xf6z̞\x8f\x89\xde`\xe69\xe2\x89\x1c\xbbsʰ\n\xe1'UzgHFfpde\xb6V]\xc3a\x93N\x01\xb0N\x99\xac\xc9\x11\xe2\xb0+\xd2Md'\x96?>\xf38\xfa\x01\xed\xe4\xfa\x8b\x99\xdb\x1e\xd1~[aޞ\xc3\xf4\xee\xfb\xe0@\xcb\x1a[\xb6\x8e+\x95F\xf9\xf6\xf6\xfa\xfe\xcfw\xa3a\x00m\x94F\xe3Dr\xe8\xe1\x1bı\xc1(\x8cY}A\x04\xc3*\xe0\x14\xc0\xd0\x06\xab\bc\xc8#\x86 \x0eaIu\rZ\x94nȒ\xf4\xa9-0\tj\xf3\v\x96\xae\x80;4D&\t\xa6Tr\x87Ɓ\xc1RUR\xfc\xb7\xa7mI\xd7\xe8І9\x8cq\xe5\xf0y\xd7/Y\x03;\xd6t\xf8\x1a\x98\xe4в=\x18\xa4S\xa0\x93\x03z~\x89-\xe0ge\x10\x84ܪ5\xd4\xcei\xbb^\xad*\xe1R\xfc.U\xdbvR\xb8\xfdʇb\xb1\xe9\x9c2v\xc5q\x87\xcdʊj\xc9LY\v\x87\xa5\xeb\f\xae\x98\x16K\x0f]\xfa\x18^\xb4\xfc\x1b\x13#\xbe\xbd\x18a\x9d)F\xf8|x=!\x01\n\xb0 ,\xb0\xb85\xdc\xe2\xc0\xe8\xe4 \xdf\xff\xe3\xee\x03\xa4\xa3\xbd0\xa6\xdc\xf7|?l\xb4\a\x11\x10Ä\xdcbt0[\xa3ZO\x13%\xd7JH\xe7\u007f\x94\x8d@9e\xbf\xed6\xadp$\xf7\xffth\x1dɪ\x80K\x9fԐ\xc3\xec4i./\xe0Z\xc2%k\xb1\xb9d\x16\xbf\xba\x00\x88\xd3vI\x8c}\x9a\b\x86\xf9\xd8tq\xe0\xda`\"%MG\xe45Ʉ\xee4\x96$=b \xed\x14[\x11=\x14\xb9s6]^\x8c\b\xe7\r\x97\xbe\xacw\x9a.\x82\\p\x99\xecI\xd8\xe4\xc0\xa7&\x87\x19VΈ\x024S/\xdb\xef\x19F.\x1b\x1dl1\xa3pD\f\xf4I\xc5\xf1\xcc=n\x14\xc7\x1cl\xda\n\xaefA[)\xe3#\u007f\xd4I9?\x85>%\x9f\x05L+~\x06W<\x91\x81\xc1-\x1a\x94%&\xc7u*\x9d\xc9 \x1b&\x1as\x8cǕ\x02Nx\xf5,ⷷ\xd7ɓ'&F\xecn~\xee\x19\xfeз\x15\xd8p\x1f\xe8Ο}q\xbd\r\x87y\x9f\xe6\x140\xd0\x02Cb\xda\a\t\x10\xd2:d\x1c\xd46K\x91\xca' \xc37\x18w\xbc\x0e\x1e,\xba\xcaCh!\xde\x03#\xdf)8\xfc\xeb\xee\xdd\xcd\xea\x9f9\xd6\xf7\xb7\x00V\x96h}^\xee\xb0E\xe9^\xf7\xa5\x02G+\frJ\xfc\xb1h\x99\x14[\xb4\xae\x88g\xa0\xb1\x9f\xde|\xces\x0f\xe0Ge\x00\xbf\xb0V7\xf8\x1aD\xe0x\uf593\xd2\b\x1b\xd8\xd1S\x84G\xe1j1\r\xa6=\aH\xbd\xe2\xb5\x1f\xfdu\x1d{@P\xf1\xba\x1dB#\x1ep\r\xaf|Zs\x80\xf9+\xd9\xceo\xaf\x8eP\xfdS0\xedW\xb4\xe8U\x00\xd7\xc7\xe1\xa1\xd1\x1d@\x06\xcb3\xa2\xaa\xf0\x90UM?\x1fT\xc8U\u007f\v\xca\x10\a\xa4\x1a\x90\xf0\x84Iz\xc1Q\"\x9f\x81\xfe\xf4\xe6\xf3Q\xc4c~\x81\x90\x1c\xbf\xc0\x1b\x10\xb1\xd8Ҋ\u007f[\xc0\a\xaf\x1d{\xe9\xd8\x17:\xa9\xac\x95\xc5c\x9cU\xb2ه<w\x87`\x15\x95n\xd84ː\aqxd{\xe2B\x12\x1c\xe9\x1b\x03͌;\xa9\xad)\xfb\xf9\xf0\xee\xea\xdd: #\x85\xaa\xbc'\xa6\xa8\xb9\x15\x94\xcdP\x1a\x13b\xb1\xd7\xc6Y0O\x9f\xed\x82\xfa8\x05e\xcdd\x85\xe1\xbe\bێ\xa2cq\xf1\x12;\x9e\xa7$\xe9ˤ&S\xc7\xf1\x87\x05\xf7'^\xceg\xd0O\xb8ܰ\xca8y\xb9\x87n\x83F\xa2C\u007f?\xaeJKW+Q;\xbbR;4;\x81\x8f\xabGe\x1e\x84\xac\x96\xa4\x9aˠ\x03v\xe5\v\xe7\xd57\xfe\x9f\x17\xdf\xc5\xd7\xd8O\xbdШ\xf6\xff\x9a\xb7\xa2s\xec\xeaE\x97J9\xec\xd3\xe3\xd8\xc5]̬\xa6{\xc9,\x1ekQ֩8\x89>\xf6\x881\tʄyp\xcdL\ueffa*\x13C;C\x88\xf6\xcb\xd8\xf6[2\xc9\xe9\xffVXG\xe3/\xe2`'\x9ed\xbe\x1f\xaf\xaf~\x1f\x05\xefċl\xf5H\x02\x1etd\xd8\xe58\x93\x98\xbd\x1f-N\xa9c&c\xed\xd7<+3t\xacʤb\xc3\xf6䩄\xed$\aƭ\x18VY`\x06\x81A\xcb4I\xee\x01\xf7\xcb\x10\xe25\x13\x14\x9f)\x04\xf7}\x0e`Z7\"\x1b\x8ac \x8fIh\xe4\x04\x15ڬ\xb2\xc7\ue795ð\xafsF\n\x1f\aK\x93\f\xcet\x96\\\x9d\xb3\xd4Q\xbfi\x8e\x16e\xd7Ρ,\xe1Ai\xc12\xe3\x06\xad\x13ef\xe2\xd5<\xd38!\xac\xc0\xcb3<\x88-\xe8L\xf1\x12E\x112\xbd\xbe\x80\xf1]\xc7\\\x85p\xbc<8\n\x91*t\xca[\xc7\x10\x97\xf9Rr\xb2\x86J\xabɐV|1ed\xa6\xf3\x98&G\x9d\xd1!\xd2y}\xed\x1b\xdeϨ\xb0C#?\xf24\xf8S\x97\xda\xfbTL\xbc\xb4\xc6.\x15\xe5\xe9㧕\xd3⽜\xef\xf0\xed,ã\xba\x8b\x96\xacw\xd0\xf6\x8fg\xe4\x8ad\x18\x90\v;}\x04#j\xc8}\x12M9\xfe\x96\x89\x069\xa4\xb7\x9d\xe9\x9e\f\xd5!\x95\rn\xc9\xdd\a\xd3K\xa5i\x84\xd7'\xaa5\x82\xf5}\xa2\v{\x82fg\x91\xfb\x9eF\x86\t\xf3\xe4u\xabL\xcb\\\xe8k.\xb3De\xd74l\xd3\xe0\x1a\x9c\xe9\xe6\xd3',\xb1EkYu\xce\x14\u007f\x0e\xabB\xc5\x1e\xb7\x00ۨ\xce\xf5%\xfb\xc8=^بS\xcf\xeb\x1ad\x8b\xe1\xb1:3*VlLڛ\xc6\xef\x19:\x82Ã\xa0G\xb5\xc1|\xd0\u007f\x89O\x00\xf0\x0fZ\xe7\x10Қ\x9c\x81\xf5\xde뤅\xc1\t\xa7|\x83\x8f\x99\xd1\xd9C\xdcp\xf22\x99Lf\xeeGo\rϺ\u007f<\xe8\x1c\v\xe22\xa8U\x93\x8cY9ր\xec\xda\r\x1a\xe2\xc3f\xefЎ\xddy\xae?\xe3\xeb\xba\x03\x1b\a\xfb\x93\xfc\x02\xa5X\xaa\x96L\xfa>*Y\x97S\xc0\x85\xd5\r\xdbg\b\xa7\x8b\xf8܍\x8c\x8b\\\xc0A\x9f\x93Qk4~\xea\xb9}%\x8f\xe9J\xc9#\x95F\xb2g!\xdd_\xffr\"\xd3\x13\xd2a5\t\x0eq\x9e\xd8\xf9\x03\x9d\xf2uN8\x91\xc4Xɴ\xad\x95\xbb\xbe:\xa3\x05w\xfd\xc2d\r\xb3\xe79\xec\xa9EUȉ\xaa\xf7-\xcf2\xd5\xf1\x13\xf09\xa8\xa3\xc5g\xa2P||\xceŠ;\xd4̐\xa5\xfb7\x81\xcb\xe9\xa3\xd5k\xb0\xc27:)\xf3\f\xa9hhCX\nN\x94Z)\x83\x19\x97\t\xf3\xb02\n\"c\xf8\xbfg\xfc\xc8\xea\xc9l\xd0#\xe7\x03ڱY>\x1c\xe96\xfdC\xd0\x1a~\xfdmqHlXI\xc5\x13\xf2\x9b\xe9\x1fYĔ3\xfdՄ\xffY*\x19*\t\xbb\x86O\x9f\x17\xe9\xd9\xf2>\xfd1\x04\r\xfe/\x00\x00\xff\xff\xb0\xddǼ\x99\"\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\x1b\xb9\x11\xbe\xf3Wti\x0f\xcaV\x99\xc3]'\x95\xa4x\xb3\xa5lJɮ\xac2e]\\>\x80\x83&\a\xab\x19`\x82Ɛf\xb6\xf6\xbf\xa7\x1a\x0fr^$%U\xb4\x9e\x8b-\xa0\xd1\xf8\xf0\xa1_hN\xa6\xd3\xe9D\xd4\xea\x01-)\xa3\xe7 j\x85_\x1dj\xfe\x8b\xb2ǿS\xa6\xccl\xf3\xe3\xe4Qi9\x87\xab\x86\x9c\xa9>\"\x99\xc6\xe6x\x8d+\xa5\x95SFO*tB\n'\xe6\x13\x00\xa1\xb5q\x82\x87\x89\xff\x04ȍv֔%\xda\xe9\x1au\xf6\xd8,q٨R\xa2\xf5\xca\xd3֛\x1f\xb2\xbfe?L\x00r\x8b~\xf9\xbd\xaa\x90\x9c\xa8\xea9\xe8\xa6,'\x00ZT8\x87\xdaȍ)\x9b\n-\x923\x16)\xdb`\x89\xd6d\xcaL\xa8Ɯw][\xd3\xd4s8L\x84\xc5\x11Q8͝\x91\x0f^\xcfǠ\xc7O\x95\x8aܿG\xa7\u007fV\xe4\xbcH]6V\x94#8\xfc,)\xbdnJa\x87\xf3\x13\x00\xcaM\x8ds\xb8e(\xb5\xc8QN\x00\"\x01\x1e\xda\x14\x84\x94\x9eRQ\xdeY\xa5\x1d\xda+V\x91\xa8\x9c\x82Dʭ\xaa\x9d\xa7l\xaf\a\xcc\n\\\x81\xbc\xa5\xa7[(\xad\xf4\xda\x0f\x05\b\xe0\f,\x11\"\x12\xe9\x95\x01\xfcJF\xdf\tW\xcc!c\xe2\xb2\xda\xc8L'\x9dQ&p~\xdb\x1bu;>\a9\xab\xf4\xfa\x18\xb2\xff3\xa8\x0e\x9e;#\x9f\x88\xe4\xbe@/\x93\xd04ui\x84D˛\x17B\xcb\x12\x81-\x17\x9c\x15\x9aVh\x8f\xa0H\xcb\xeewu\x17ɧ\xa4\xaf5\xf3\x1cv\x9eCE\x90\xedl\xff\xd0\x1e:\xb7\uf751q\x01D\xa3\x06r\xc25\x04\xd4\xe4\x05\b\x82[\xdc\xcen\xf4\x9d5k\x8bD#0\xbcxV\x17\x82\xba8\x16~\xe2uq\xac\x8c\xad\x84\x9b\x83\xd2\xee\xaf\u007f9\x8e-.ʜq\xa2|\xbfsH\x1d\xa4\xf7\xfdဖ\x9dm\x1d\xaf\xff\x9b\xc0]2\xa4k\xa3\xbb\xbc\xbe\uf34e\x81m)M\x818\x1b\x04ю\xd6w\xeb\xae>)\\\x18\bӛ\x1fC(\xcb\v\xac\xc4<J\x9a\x1a\xf5\xbb\xbb\x9b\x87?/:\xc3\x00\xb555Z\xa7Rt\r_+\xab\xb4F\xa1\xcb\xec%+\fR 9\x9d \x05\xa7\bc(#\x86\xe0,\x8a\xc0bm\x91P\x87\x04\xd3Q\f,$4\x98寘\xbb\f\x16hY\rPa\x9a\xd2G\xa0\rZ\a\x16s\xb3\xd6\xea\xbf{\xddľǛ\x96\xc2a\f\xf1\x87\xcf\xc7`-J؈\xb2\xc17 \xb4\x84J\xec\xc0\"\xef\x02\x8dn\xe9\xf3\"\x94\xc1/l!J\xaf\xcc\x1c\n\xe7j\x9a\xcffk\xe5R6\xcdMU5Z\xb9\xdd\xcc'F\xb5l\x9c\xb14\x93\xb8\xc1rFj=\x156/\x94\xc3\xdc5\x16g\xa2VS\x0f]\xfb\x8c\x9aU\xf2;\x1b\xf3/]v\xb0\x0e\x9c.|>ם\xb8\x01Nv\xa0\bD\\\x1aNq :\x85\xec\x8f\xffX\xdcC\xda\xda_F\x9f}\xcf\xfba!\x1d\xae\x80\tSz\xc5A\x97/qeM\xe5u\xa2\x96\xb5Q\xda\xf9?\xf2R\xa1\xee\xd3OͲR\x8e\xef\xfd?\r\x92\xe3\xbb\xca\xe0ʗ\x18\x1c/\x9b\x9a-Wfp\xa3\xe1JTX^\t\xc2W\xbf\x00f\x9a\xa6L\xecӮ\xa0]\x1d\xf5\x85\x03k\xad\x89T\xc1\x1c\xb9\xaf~U\xb2\xa81\xe7\xebc\x06y\xa9Z\xa9\xdc\xfb\x06\x87\x1f\x10\x03\xf9\xac\xa3z\xdcu\xf9[\x8a\xfc\xb1\xa9\x17\xceX\xb1ƟM\xd0\xd9\x17\xeaa{?\xb6&\x81ӭ\x9c\x17\x94\x03\x05ɁR\x802-\xde\x16h\xb1\xbd\xc6bmH9cw\xac8d\xcbl\xa0\xe1\xc8E\xf8#\x1by\xe6\x18\x1c\xee\xbdCX\\\xa1E\x9dc\x8a\x10\xa7*\x99\x91S\xb4\x12\xfa\x10\xe2q\xea\xe1D\xf4\x1c\x05\xfc\xee\xee&E\xcc\xc4p\x84\xee\x86\xfb\x9e\xa1\x87\xbf\x95\xc2R\xfa\x84r~\xef˛U\xd8\xcc\xc7\x0eg@@\xad0T\xa4\xfb`\fJ\x93C!\xc1\xacF5\xf2\xa3\x01\xd8\xc1,\xc6\x15oB\xa4\x88!\xe9\x10\u0099z\x10\x1c\xa3\x94\x84\u007f->\xdc\xce\xfe9\xc6\xfc\xfe\x14 \xf2\x1c\x89|\xbe\xc6\n\xb5{\xb3\xcf\xd9\x12IY\x94\\\xb8`V\t\xadVH.\x8b{\xa0\xa5\xcfo\xbf\x8c\xb3\a\U00013c40_EU\x97\xf8\x06T`|\x1f\xfe\x92\xcd(\nt\xec5\xc2V\xb9B\xf5\x93֞\x01\xb6\xaex\xec\xad?\xae\x13\x8f\b&\x1e\xb7A(\xd5#\xce\xe1\xc2W\x82\a\x98\xbf\xb1c\xfd~qD럂\x03]\xb0\xd0E\x00\xb7\xcfwm\x8f<\x80t\x85p\xe0\xacZ\xaf\xf1P\x88\xf6?\x1f\xbc9$~\x0f\xc62\x03ڴTx\xc5|{!\x1e\xa1\x1c\x80\xfe\xfc\xf6\xcbQ\xc4]\xbe@i\x89_\xe1-(\x1d\xb8\xa9\x8d\xfc>\x83{o\x1d;\xed\xc4W\xde)/\f\xe11f\x8d.w\xa1\xda\xdf \x90\xa9\x10\xb6X\x96\xd3PoH؊\x1d\xb3\x90.\x8e\xedM@-\xac;i\xad\xa9ʸ\xffp\xfda\x1e\x90\xb1A\xad}\xbc\xe3\xec\xb4R\\5p\xb9\x10r\x9e\xb7\xc6A\xd2L\x1f5\xc1|\x9c\x81\xbc\x10z\x8d\xe1\xbc\b\xab\x86\xb3Pv\xf9\x12?\x1e\xa6\xfe\xf4\x8d\x94\x00\xfd\xc0\xf1͒\xe8\x13\x0f\xe7+\xd5'\x1c\xae\xfd\xd6:y\xb8\xc7f\x89V\xa3C\u007f>ir\xe2\xa3\xe5X;\x9a\x99\rڍ\xc2\xedlk\xec\xa3\xd2\xeb)\x9b\xe64\xd8\x00\xcd\xfc\x93y\xf6\x9d\xff\xe7\xc5g\xf1\xaf\xeb\xa7\x1e\xa8\xf3\xe8\u007f\xcdS\xf1>4{ѡR\xad\xf8\xf4<v\xb9\x88\x05L\u007f-\xbbŶPy\x91\x1e\x011\xc6\x1eq&\xc5\x15\xa7\f\xa1Y\xe8ݫ\x9b2\x13\xdaXF\xb4\x9b\xc6f\xd7Th\xc9\xff'E\x8e\xc7_\xc4`\xa3\x9e侟n\xae\xff\x18\x03oԋ|\xf5H\xa1\x1bl\xa467\x92\xa9\\)\xb4g겏\x1d\xe1TW\x8eԅ{\x99g\x15\x86\xa4EM\x85q7\xd7gp,\xf6\x82\t\xc3\xe1\x02b9\x98t\xf5\xda:\xcf\xc2\xd3\xee8\x9dA\xf4\xa9%\x9a0\x9d\xe9y\xb9b\xcc{:\x9d\xb0!Z\xd4M5\x842\x85GS+12\xce\xe7V\xf9\xc8\xc4\xc50\xfb\x9f`\"\x90z\x86\x83ؠ\x19ym\xc4;\t\u0557\x1f\xe1\n\xdf\xdf\xccx\xd8z\xee]\xf1\xe3\x94K\xc9.\xc2\xe9\xf8\x1b\xaa'S\x1b9\xe9\x93\xd66\xf3\xde\xe4\xc1H{\x13\x9d\xd6`\x1b\xef\xf0\x81\xe9\xfbN\xcfyb\x86^Wd6D:\x97:`\\\xe6\xbf\xf8\x91\x99\x1b.\xa1\xbb\xbd\xfeӷ|5\\\xe1;:VF\xabW\x15\xfa\x97[h\xd3m\x05\xa5M\xc6n\x14Z\xfa\xc2R\x9f]X\x1dJ_\xe0r\xfd\xbd\x12\xaaD\t\xfb_\x1b|K\x99|k\xe3r\xac\x9eK\x8a\x1aB\xe9_\xe1#\xa0\x87\xebR\xb7P\n\x87SV1\x90\xd0MY\x8ae\x89sp\xb6\x19N\x9fp\xa0\n\x89\xc4\xfa\x9c\a\xfd\x12\xa4\xc2\xdb7.\x01\xb14\x8d\xdb?~\xa3+E*.)Z\xc1\xf3\x1e\xe0\x85\xa0sP\xeeXf\xcc\xe2\xf6N}\xda\xe4\xe0D\xb0\xba\xc5\xed\xc8\xe8\xa0{۞\xbcJ&42\xf7\x93\xb7\x8eg\x11\x107:\xc7A\x14\x83\u0094ɺ\x8d\x13%\xe8\xa6Z\xa2e\"|\xcb81\x92B\xc3X7\xc1\xbfB\x0eL\x1e4\xa4h\x17T\xc5wU.\xb4o\xae\xb1\xfd:\x03RQ]\x8a݈\xdet\x12_h\xb0\xf9\xb2\x1f\x1d,&y!\xbb\xbf\x9f{n\x17d\xdf\x12\x1f/\x83\xc6\x1a\xecc\xb7\xd0\xee\x96\xf7\xe6\xf7\xbf\x05\xbc\xce\x0e'J\x1frº\xa7\x86\xbdEG\xf8\\\xc4\xf3\xaa\xc7\xe3];t\r\x03Uw\x9b?2F\x8d\x125\x18\xf4\xc8eKw\xec \xb6G\x9a\xe5\xbe?>\x87\xdf~\x9f\x1cҝȹ\xd6Ey\xdb\xff!8V#\xe9w]\xffgnt\xf8!\x96\xe6\xf0\xf9\xcb\x04b\xab\xf1!\xfdX˃\xff\v\x00\x00\xff\xff\xd7w>\xba>\x1f\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc<Ms㶒w\xff\x8a.\xefa\x92*K\xce\xd4\x1ev˷YgRq%\x99q\xd9S\x93\xc3\xd6\x1e \xb2%!\x06\x01\x06\x00\xe5Ѿz\xff\xfdU\x03\xe0\x97\b\x92\x90lM&\x8f7Q@\x03\xfd\x81\xfe&.\x16\x8b\xc5\x05+\xf9gԆ+y\x03\xac\xe4\xf8Ţ\xa4_f\xf9\xf4\xdff\xc9\xd5\xf5\xee\xed\xc5\x13\x97\xf9\r\xdcVƪ\xe2\x01\x8d\xaat\x86?\xe2\x9aKn\xb9\x92\x17\x05Z\x963\xcbn.\x00\x98\x94\xca2zm\xe8'@\xa6\xa4\xd5J\bԋ\r\xca\xe5S\xb5\xc2U\xc5E\x8e\xda\x01\xaf\x97\xde\xfd\xb0\xfc\xaf\xe5\x0f\x17\x00\x99F7\xfd\x13/\xd0XV\x947 +!.\x00$+\xf0\x064\x1a\xab4\x9a\xe5\x0e\x05j\xb5\xe4\xea\u0094\x98\xd1b\x1b\xad\xaa\xf2\x06\xda?\xfc\x9c\xb0\x11\x8fă\x9f\xee\xde\bn\xec/ݷ\xbfrc\xdd?\xa5\xa84\x13\xedb\xee\xa5\xe1rS\t\xa6\x9b\xd7\x17\x00&S%\xde\xc0\aZ\xa6d\x19\xe6\x17\x00\x01'\xb7\xec\"\xecz\xf7փȶX0\xbf\x1f\x00U\xa2|w\u007f\xf7\xf9?\x1f{\xaf\x01r4\x99\xe6\xa5u\x94\t{\x03n\x80\xc1g\x87\x1bm\xc01\x01\xec\x96Y\xd0Xj4(\xad\x01\xbbE`e)x\xe6\x88\xd8@\x04P\xebf\x96\x81\xb5VE\vmŲ\xa7\xaa\x04\xab\x80\x81ez\x83\x16~\xa9V\xa8%Z4\x90\x89\xcaX\xd4\xcb\x06V\xa9U\x89\xda\xf2\x9a\xb0\xfe\xe9\xc8Q\xe7\xed\x01.o\b]?\nr\x12 \xf4[\x0e$\xc3<P\x88vk\xb7ܴ\xa8\x1d\xa2\x13Pb\x12\xd4\xea\x0f\xcc\xec\x12\x1eQ\x13\x180[U\x89\x9c\xe4n\x87\x9a\x88\x93\xa9\x8d\xe4\xff\xdf\xc06\x84(-*\x98\xc5\xc0\xef\xf6\xe1Ң\x96L\xc0\x8e\x89\n\xaf\x80\xc9\x1c\n\xb6\a\x8d\xb4\nT\xb2\x03\xcf\r1K\xf8ͱG\xae\xd5\rl\xad-\xcd\xcd\xf5\xf5\x86\xdb\xfa\xfcd\xaa(*\xc9\xed\xfe\xda\x1d\x05\xbe\xaa\xac\xd2\xe6:\xc7\x1d\x8ak\xc37\v\xa6\xb3-\xb7\x98\xd9J\xe35+\xf9\xc2m]\xba3\xb4,\xf2\xffh\xd8\xf6\xa6\xb7W\xbb'\xc93Vs\xb9\xe9\xfc\xe1\xc4|\x82\x03$\xf0^\x96\xfcT\x8fEKhzE\xd4yx\xff\xf8\xa9+g\xdc\x1cR\xdfѽ#|-\v\x88`\\\xaeQ{&:i#\x98(\xf3RqiݏLp\x94\x87\xe47ժ\xe0\x96\xf8\xfeg\x85\x86\x04Z-\xe1\xd6)\x15X!Te\xce,\xe6K\xb8\x93p\xcb\n\x14\xb7\xcc\xe0\xd9\x19@\x946\v\"l\x1a\v\xba\xfa\xf0p\xb0\xa7Z\xe7\x8fZy\x8d\xf0+\x9c\xfe\xc7\x12\xb3މ\xa1i|\x1d\x8e9\xac\x95\xee)\a\x9a\xb2\xec\x01\x8d\x1fZz\xfc\xe9'\rv\xf8\xcf\xc1V\xfe\xa7\x19H\xf2C\x9b\xa8$\xff\xb3B\xa7\xe2\xfc\x89ŁJ\x19\x80\x84z\u007fN,\x96\x83\xffGhJ\x0f~\xc9D\x95c\xdeh\xdb\x01.\a;~?\x98\xe0\xcc\x11\xe3\x92\xe4\x9f\xd4?m[\xb6\xff\x92:\x8d\xec\x98i\x04\x92@.=<\xe0\xd2!\x1b\xa54=\xdcb\x11\xd9\xdc$v\xe0\xec\x1c[\t\xbc\x01\xab+\x1c\xa1\fӚ\xedG\bS\xdb\xe6T\xba4\xe3\x83B\x10<î\xa1\xf0\xa4\xf1F\x86\xe9\xe1\x8e\xe0\x1b\xa7\n7\xa4\xcej,\xef\x95\xe0\xd9~\x964\xb1I\xf5q\v\x87\xaf\x96\xe0\x15nَ\xabJGp\xa2#Ic\x9fZK\xdajSE\xca,@\xc9O\xc38J\xad\xadROs\xcc\xff\x99ƴj\x1b2\xe7\xd6ո\xe8\xc0\xee`EW\b\xf8\x05\xb3\xcaF\xb6\t\x90W\u0382(\r\xa52v\x9c\xf1\xe3\xca\a\xbc>\x18\x93Z\x98\x92\x9a\x01fAW֬#D{zSI\xa4\xbd\x16ĺv\xacV\x95\x1f{h\xe0:\x14\x8fS\x04V\xcc`\x0e*\x88}%Є\xb5r\xc7\xfeV\xb1\\\x8d\x82n\x90\xf7\xae\x86`+\x14`P`f\x95\x1eR2\x85\x9e\xfeIQ\x96#t\x8c\xa8;\xfc\xb7\x88M\x80\x04\x12\xf3\xe7-϶\xde\v \xd9tp Wh\x9c\xe6 Ou?\x86$\xcc\xf1>,2\xa5;\xdag\xe6L\x1d\u008b\xe9\x93\xf6Iз\xed3\xa3y\a\x9a%\xbc\x8f\x9a\xce\xf6\xf9\xf7$lmJN\x10ڻ\xc1\xd4\xd7\x15Z\x17U\x91\xb7\u007f\xb7\x06,J\xbb\xbf\x02n\xeb\xb7s\x10\x99\x10\x9d\xf5\xffƌ9^\xe2\xef\x0eg\xbe\xaa\xc4Ore\x0e\"q\xa5Y\xfeo\xc8\x14g,\x1e\x83\xadHfȯ\xddYW\xc0\xd7\rC\xf2+XsaQ\x1fp\xe6E\xe7\xe55\x88\x91b\xef\xe8)\x98Ͷ\uffd0gc\xda\fT\"]\x0e'{\x9f\xb8\x0e\x12\xfa\x86y\x06.\xb8\xf8\x95k,|\\\xfc\xc9Q\xb3}\xe3\x02\x8aw\x1f~\xc4|\x8a<\x90&y\x03D\xde\x1dl\xb6\xbbtp\xf4S\xd1\b\xaeO\x134\xf9\x8c\xc7\x150x½\xf7X\x98\x04b\x0e\xb3\xceߍ\x86OC\xe2\xb8ԋw\x8fq\xef\xc0\x84\\\xca\xec\xecTQ\xf0\xcf\x13F\xfc\xfd\xd8\xd3# \xed)D\xb8\x9e\x92\xf4\xc2\x11\xc2E\xde\xe9\xc4\x03\x97\x17\xabu\xd1<r\x90\xaeHꧦ\xfd\th6l\xeb\xe4\x0f\x1dc\xdf\x18\xcf\":\x05[^&\"\xea҇\x06\xddi\xa93c\x9f\x99\xe0y\xb3\x90\x97\xfb;9\xee\r\xf7\x9f\x0f\xca\xde\xc9+\x1f\x92\x19'%?*4\x1f\x94uo\xceBN\xbf\xf1\x13\x88\xe9'\xba\xe3%\xbd\xda&:tSl\t\xc2\xed\x9f;\x9fIi\xd8\xc3\r\xdcI\n\\\x02=\\\xc2\xd4/7m\x1f\xfaOQ\x19\x97C\x93J.\x9c\xa9\\\xc6V\xf2\xc4N\x04\xa9t\x8f#í5\x8b\xfa\x05\x13\xc1~\"K\xe2\xe7\xfb\x14\xb0`\x19\xe6u\xb4\xe9\x12\x97\xcc\xe2\x86gP\xa0\xdeL\x19\x8e\xeeS\x92~O\xdbB\xa2\xd6\xf5ϑ\x12\x96f\xda\xeb'\xa8\xee|~3\v:\xb9\t\xa3jf\xcf\x0e\x1d\xc9W\x8e\x0f\x9d\xc7șX\xe7\u007f\xccR\x97\xe5\xb9+.1q\u007f\x84\xc6?\x82\x17C\xdb\xef7\xe6-d\xc1J:\xbf\xff 3\xe7\x04\xfa\x9fP2\xae\x13\xce\xf0;W'\x12؛\x1b2c\xddeh\x05n\x80\xf8\xbbcb\x98\t\x8f \xa7H\xb7\xa0\xf0\x86\\\xad\a\x1e\xcb\x15<o\x95\xf16u\xcdQ\xc4R6\xfd\x87\x1b\xb8|\xc2\xfd\xe5\xd5@\x0f\\\xde\xc9Ko\xe0\x8fV7\x8d\xb7\xa0\xa4\xd8å\x9b{\xf9\x12'(Q\x12\x93\x86\xc9h\x9e\xbb}zb\xd1\xcdu\xb7I\xee\xe0\xe6N\xed:I\x0eKe\xec\xcf\xf1\x84\xdd\xc8~\xee\xeb\x19}\xdf4\x92\xf7\x9a\xf5\xd9C\x0e\xabQ\xaa\xe4ɭ-\xea\x90\xc4\U000cad8e\x00^\x18\x1b\xcd%\xe9\x9a\x04\x1dk2\xabD\xe0\x19\xa9\xf05\x8f\x94-\x1e\xe35\x12]\x8e\xf4\xb7\xdf\u007f\xe9\xe4\x18\xe9\x84\xd2\xef.\"\xaf\xed\xd5f\xaa(\xd8a\x95/i\xab\xb7~f-\xd3\x01\x90\xe7\xbe\xdeT\xee\\\xa6\xbb{\xb5\f\xb9\xfa\xde3\xb7[.\x81\xd5\xc7\x1fu\x10(\x06\xa5\x9a\xd7D\xfe\xd92\x03+D\xd9\xe4ƿ\x05{]py\xe7\x16\x80\xb7\xafnߡ%\xd7I\xec\xacI\xdd0\xb4y\xe1,N\xaak\xa4rxޢƞT\f\x13\xde\xe41&\x82\x94\xcav\xf3\n\x04\xb7T\xf9\x1b\x03k\xae\x8d\xedn4U\xe0*\x93*\x0eGr\x98\xb0\xfb\xc4\vT\x95=\x81\a\xef\xdbٽ\x02m\xc1\xbe\xf0\xa2*\x80\x15\xaaJ0\xee\xfe!\xfb\u008b\xa6\x8a\x1a8\xf0̸m\xeaI.\xc3b\x15q\xa9\x14hSY\xbc\xc25\xa9\xa3LI\xc3s\xd4u\x95\xdfs\x96+:\xb8k\xc6E\x15+\xdfĞc\xc3T\xf9^듢ԏ~f'k\xb8U\xcf}\x02%\x93`\xcbv\b|\r\xdc\x02ʌ\xf8\x82ګl\xb7D \x86#M\xb2X\xa6)xzPVE\x1a\x01\x16\xeeds9\x99\x14\xeb\x0e\xff\x89qq\x0e\xb6\x91\xe4\x9d~4~og\u007f\x95\xa3\xd1(\x95t\x13\xb6Bx@\x96\xef\xeb\xf3\xc1\xac\xa5P\xd5ɀ\x02]ɮF<\xc3\xc98&\xbe\v\xbbx\xcd\xc0\x8dK\x9e\xc0\u0603|>\xb7]o\x87@\x9c\xd5ۡ\x05\x1aCwJj\xe6\xae\a\x80Le\xed8\xbb\xbd7Rs\x84\xe7\xb3B\nP1\xf7I/2\x9f\xc1\x8f\xf6\xbdK#e\xf0(vǻ.I\x9c\xedNH\xcf\x12}Y\xb4\xed\n\v\x97\x14\xd4;\\T\xf2I\xaag\xb9p1\xa5\x99\xcd\xd67\x8b\x9f\xac8\xbe\xa6\xd2\xe8\x8bW\xba\b\xd4\xf6\xf7\fJ!\x99\xcdG\x05\xc6SR0\xa7\x86|\x1b\xebȟ\xb3\xbb\x98Z\u007fbr\xa89\xde\xfa\xfe\xd3\xd4ަ\xbb\xf8\xac\x8e\xff\xf0\xbcE\xbbE]7\xb6.\\\x0foL\xad\xb6\xa5\xc9\xd6\x15n\x9a\x9dH~jo\xca7\xe1\x1d\xb4?\xc5}eY\tqE\x82\xcd*a}+\xaa\xae\"B\x94\xd4\x03\xb4RJ ;l\x8bM)\xa2ϕ\xce\xfb\xfd`M\xe9\xban\bS\xf5\"\x11\f=/}\xd7g\xb7.ۯ\x81\xbb\xecO\xbdӿ\xbcU,\xa1\xbc=SԞn\xa0\x9b\xa2\xd7Pl\xba\x14ke0\x8c\v\x9d\x95\xdf\x14\xf9f\n\xd1\xe3\xe5\xe7\x90lE\xcbvo\x97\xfd\u007f\xac\n\xc5h\x97Y\x88\xa0\xf2\xbcm\xf2\x04\xce\xf2ʜ\xefx^1ѓ\xc0\x0e\xcdZ҂\xd2 \xb9\x88ա\x88\xe6\xf5\xfc\x1e\x8d\xe1c\xe9\xf3\xd1G\x9f\xd5iw'\xadf}r\xa5\xba_\x89\x1e\xd1\xe0Ǧf\xd3[\xf2\xd2k\xd1\xd3\xc5\xe3c*Ї\xf5\xe5Q\xa0\xf3u\xe7\x14Ou\xa6\xc6|Be9\xb1\xab\xe8\xc5\t\xe8\x94\xda\xf1I\x15\xe3\xd9ƛ\xc4:q\xbf\x02<\r\xf2\x88\xeap\x12q\xe6+\xc1G\xd7\u007fC\xbdu\x12\x8f\xe4\xaao\xa4\x9e;\tx\xb4\xd6;Uŝ&y\xa4\u009b^\xbb\x9d\x04\xed\xea\xba\xf3\x15\xdb\xd7\xeb\xcbz\r\x17y\\\xd5\xccV]_\xe4B'\xd4U\x8f\xa9\xa6\xceR\xec\xc4\xcaiS\x19\x1dY\xf7\xd8zi\xbf\x1e:\x024\xa5J:R\x05\x1d\x818Y\x1bM\xad}\x8e\xc0\x9e1\xbb\x93R2\xf1g\xe3u\xff\xc6ʒ\xcb͐\xf3\xa9\xf21)\x1b\x83\xd2iw͞pt\x9d\xe3^X\x11[\xd2\u007f\x90\x18\tA\xea\xa4\x13\x97V-\xe1\x9d\xdc\x0f\xe0\xbaf\xe8\xa8\xcb\xdd\xffb\x85\xb6\xf5̅\xe8~\x95\xe1\xc0vA\x85\x0f\x9cL<\x10\xa6\x81c\x1f3E\x99\xa2t\xcfߝ\x8b8>\x1e\f鈴\xa6\xfd\xe7\x98\xeb\xcc\xed\xf6D\xff\xb9\xa8\x84\xe5e\xf4\x10\x97Z\xed\xb8K\x8amq\xdf\xd0\xf3\x0f徇X\xed\x1d\xa4\x8f\x0f\xcd\xf9Z\x1e\x84\x02,v*\x9eQ\b`f\x88~\xe6\xbf\t\xcc\xd4\xc2}\xe6C\x9c\xac\xe5!|;x\xe5\xce`,@\x95\xf5\xd7j\x05\x81q\xdf\x15\x9aH\x02`ԺL{\xb8\xde\x19w\xef\xfe\xacP\xefA\xed\\\x154\xb8<3\r\xc7^S\x98J\xb4\x1d\x1eA\x01\xfa/Q\x0f<\xffVc\xc0;\xe9mp\x14\xec\xc1\x1e\x1d\x1cRZm\xb4C\xfa\x99\x02\x99\x91\xa1Q\xa8R5\xb3\xe3\xf20ijR\xbbu\xcf\x1b\xfb\x1c\x1f\xfd\xcc\xfa\x1dg\x89\x80N\x8f\x81&@\xa6vߦe\xecg\xbbm\xcf\x15\v\xcdEC\xc9n`Z7\xed9\xbah\x8f\xe8\x9e=\"*:..J&SJ\x97\xecY\xa2\xa33\xc6G爐N\x8b\x91f@\x1et\xbf\xa6\xf4\xb5&\x15\x99\x92K\x14)E\xa5\xf9\xba\xe6t\xbfjB\x9fjB\xf1cn\xa7\t\xfd\xa8\xc7\xf5\xa1&\xd0\xf0L\xd1ә\xe2\xa7sDP獡f\xa3\xa8Yə\xfc\xfb\xe4\x1cy]M\xfd\xa0r\xbcW\xda\xce9\xfc\xf7\x87\xe3#\x15\xacN\x10\xa4D\x0e\xb2\x1e\x1aA\xca\xf9\xf2\xc1\x8f?\r\xa9x\xb1)\xac\u007f\xffy\x0e\x9f\x87f\xe04\"\xe4\x92\xd6\xf1Y\x04\x0f\x9a\xefp1\x92\x95f\xab,|\xb7\xe3,\\(\xa2\xaa<\x04!\xfa\xfbs`\xf9h\x99\xad\x12\x11\xf5c{\xb8\xf2l۩\xe7<c]Vԝ;\x89\x0e\xf6D\x9e\x90\a\xe4\x0e\x9a3\x92\x92\x8b\xab\x8e\xeb\xfdu\xca\x14\x89\x9f<\x9f\xfc\xb1\xb3'\xcfȁu\xb1$\x85{\xb5\x90\xb4t9\xa1@1\xab\xa4\x13\xbeK\x9c\xb6,\x89_˞\xfc\x9d\xec<\xb1\"\x84\x1a\xfbD6\xe53ؿ\x94\x9e\x13\xfa\xd8d[\xcc+\x81\t\xb7\xd7<v\x86\xce\xdf_S\x03\x8e\x9dIտ\xc1\x86\xe8\xda\xe9t%\x93ֿ)'\x10=@&\xf6D\xa0vAz\xdb\xedo\xd4\xc8\xc8R\x9b*\xcbИu%\xea\xf2\xaf\xbb%\r\xf3zx\xb4[\xb1\xc6\xe1\x88;v\xe2nۢsG\xd0E\x02gLDMN\xa8Ȍ\x95\xb6\xd2A̳Jk\x87\xb2\xffO\xad\a\xf7\x1a\xf5\xc0\x8e+\xadПӻInZBn\x873\xdc\xeda:\xf7[s\x9dD\xdd\xeb_\xbc\x9f3\xbc\x97\f\\\xe7\xa4iZ\x84\xf2e\a\xb6\a\xe3\xfc~\x02\x8d9\xe0\x0e%(\xe9\xfao1\x9fj\x1f\xfb\xe4\x02c\xbdC\xfd\xc64p*\x13\xbaQ\x1e-Ӷ\xd9\xfaP\"\xd6J\x17\xcc\xde@\xce,.h\xf6i\x162~5\x93\xd6\xf39L\xd7\xc8\x1b\x1c]\xd7}\xeb\xd8+Dh\xbf-\xd0\x18\xb6\xa9\xef\x1bzF\x8d\xb0AI$\x9e\xba|\xa6\xed`\x0e'\xb8i\xa4 j\xb1\xccV,,\xe0-e\x93ݍe\x06\xfd\x95f4\x84mF\xcf\r\x97\x167\x83\xbcj\xe8\x9e~@f\x0e\xaf\xc0\x1b\x10\xe2\xa7\xee\xd8\x10\x15{\x1a\xf8ϭ\x99㩿1\xcdr\x8dS\x1e\x82r+\x1fu\x8fV\xb9efN]\xdeӘ泂Ρl4\xe5\xc3Ȟ\xe2m\xce\v\xf8\x80ϑ\xb7?9\xa1w\x99\x8e\xf8QZ\xc0\x9d\xbc\xd7jC\xc1G\xe4\xcf\xdb\xfa\x8cE\xfe\xbbg\xdar&\xc4\xde/2\xba\xfaQ\xb4\v[\x99\xf5\xde\xfd\xb06\x98\xe1ҟ?\xd7k\xbfR\x95\xed\n\xeb\x1b\xd3\xcaq<\x93\xea\xa0-ჲX'\xc9x\x1f(7\xb0Bc\x17\xb8^+m}\xf0\xb4X\x00_\a\xfd\x19\v\n\x18\x17\xce\x05\xf0\xf7\xea\x91_\xd0\x14j\x1b\x83\xa440\xb9\a\xed\x84\xd5\xf9\x0e\x05\xdb\xfbV8\x96e\x15\x1d\xcfkcY\xccμ\xc8\xe3t>G\x10\xb2\x91<B߁\xea\x8eo\xbeګ\x8a\x15j\xd7\xebK\u007f{ҹ\xcf\x00\xbcf\x88\x16\b\xc0},\xd0\xf9\n\t\f\x9d\xb2x<;\xa5\x13\xdc\xff\xca2q7\xee?\xf5\x1b_\x9b\xc15\x02n\xfa\x10\x8d\xde\x05b\xe3m=\xdc\xd4S\x89gٖ\xc9\r\x89\x8fV\xd5f[\x8b\xe0\x98\x02\x1d\xcb_T\xee\xfe\xb2RT\x1b\x12\xeb\x90l\xb6\x95\x96\x9d\\IH?\xe7\xedv\xa7\x80N\x93p\xca\xfd\xebY\xbc9\a\xb07\xf8e\x96\xdd-Lv\xfd۵ȻF\xa5\xbeO\xb1͟\x0f\x86\x1f44\x92\x95n!\x06{\x1a!\xcew|]\xdf?\xbb\x12\xf8\xfd`\xc4WnL|fZr\xb9\x99C\xfe\xf70,\xe2\x9a\x04\b\x11\xe7$\x82D\xe3\xae\x1c\xe5\x9cԛ\x1c\xb9b\xb1qX^\xe0\x9eD\xcf\xd0\xe0\xa5\x13\xe4\xbcC\xe4\xb0RxӺ\xf5,˰\xb4\xa1a\xb8{\xbb\xf2\xe5\xa5\xfbQ_\x9f\xec~fJz\xb5`n\xe0\u007f\xff\xef\xa2F\xe8s}K2\xbd\xfcW\x00\x00\x00\xff\xff9\xa5\x94,\x8aZ\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec\x1d\xcbn\x1c\xb9\xf1\xae\xaf((\a'\x80f\xb4F\x0e\tt\xf3\xcaZDX\xc76,\xad.A\x0e\x9c\xee\x9ai\xae\xba\xc9^\x92=\xf2d\xb1\xff\x1e\x14\x1f\xfd\x9a~\xb0\xc7\x12\xb2Y\x88\x17k\xd8d\xb1XU\xac\aY\xa4\xcfV\xab\xd5\x19+\xf9\x03*ͥ\xb8\x02Vr\xfcjP\xd0/\xbd~\xfc\xbb^sy\xb9\u007f{\xf6\xc8Ez\x05ו6\xb2\xf8\x82ZV*\xc1\xf7\xb8\xe5\x82\x1b.\xc5Y\x81\x86\xa5̰\xab3\x00&\x844\x8c\xaa5\xfd\x04H\xa40J\xe69\xaa\xd5\x0e\xc5\xfa\xb1\xda\xe0\xa6\xe2y\x8a\xca\x02\x0fC\xef\xbf[\xffm\xfd\xdd\x19@\xa2\xd0v\xbf\xe7\x05jÊ\xf2\nD\x95\xe7g\x00\x82\x15x\x05:\xc90\xadr\xd4\xeb=\xe6\xa8\xe4\x9a\xcb3]bB\xa3픬\xca+h>\xb8N\x1e\x137\x8b;\xdf\xdfV\xe5\\\x9b\x1f;\xd5\x1f\xb86\xf6S\x99W\x8a\xe5\xad\xf1l\xad\xe6bW\xe5L5\xf5g\x00:\x91%^\xc1G\x1a\xaad\t\xa6g\x00~bv\xe8\x15\xb04\xb5\xa4b\xf9gŅAu-\xf3\xaa\b$ZA\x8a:Q\xbc4\x96\x14w\x86\x99J\x83܂ɰ=\x0e\x95\x9f\xb5\x14\x9f\x99ɮ`\xadm\xbbu\x991\x1d\xbe:\x129\x00\xbe\xca\x1c\b7m\x14\x17\xbb\xa1\xd1\xde\xc1\xb5\x92\x02\xf0k\xa9P\x13ʐZΊ\x1d<e(\xc0HP\x95\xb0\xa8|ϒǪ\x1c@\xa4\xc4d\xdd\xc3\xd3cҭ\x9c\xc3\xe5>Cș6`x\x81\xc0\xfc\x80\xf0Ĵ\xc5a+\x15\x98\x8c\xeby\x9a\x10\x90\x0e\xb6\x0e\x9d\x0f\xfdj\x87P\xca\fztZ\xa0\x82T\xaf\x8f$\xb2\x03\xf3\xdd\x0e\x87\x81\xb9\xcf\xfb\xb7Nn\x92\f\vv\xe5[\xca\x12ŻϷ\x0f\u007f\xbd\xebTCO\x0e\xfc,\x81k`\xf0`\x85\x1a\x94_~`2f@!q\r\x85\xa1\x16\xa5\xc2U\xa0LZ\x83\x04\x90\nJT\\\xa6<\t\x14\xb5\x9du&\xab<\x85\r\x12q\xd7u\x87R\xc9\x12\x95\xe1aٸ\xd2R\x13\xad\xda\x1e\xc6ohR\xae\x95\x93\"\xd4Vp\xfcb\xc0\xd4\xd3\xc1\xc96\xd7\r\xfe\x96\xc0\x1d\xc0@\x8d\x98\x00\xb9\xf9\x19\x13\xb3\x86;T\x04&`\x9dH\xb1GE\x14H\xe4N\xf0\xff\u05305I\xac\xb1\x82dЯ\xe5\xa6\xd8\xc5'X\x0e{\x96Wx\x01L\xa4P\xb0\x03(\xa4Q\xa0\x12-x\xb6\x89^\xc3?\xa5B\xe0b+\xaf 3\xa6\xd4W\x97\x97;n\x82zLdQT\x82\x9bå\xd5t|S\x19\xa9\xf4e\x8a{\xcc/5߭\x98J2n01\x95\xc2KV\xf2\x95E]X\x15\xb9.\xd2?\x05\x8e\xea7\x1d\\\x8f֊+V\x89Mp\x80\xb4\x99\x13\x18\xd7\xd5͢!4U\x11u\xbe\xdc\xdcݷ\x85\x89\xeb>\xf5-\xdd[\x12ְ\x80\b\xc6\xc5\x16\xfdj\xdc*YX\x98(\xd2Rra\xec\x8f$\xe7(\xfa\xe4\xd7զ\xe0\x86\xf8\xfeK\x85\xda\x10\xaf\xd6pmm\x06\xc9aU\xd2\xeaI\xd7p+\xe0\x9a\x15\x98_3\x8d/\xce\x00\xa2\xb4^\x11a\xe3X\xd06w\xfdƎj\xad\x0f\xc14\x8d\xf0+\xac\xf1\xbb\x12\x93Β\xa1~|\xcb\x13\xbb0\xac\xe6\xabU@O\xfb\xb92\xbcj\xc1\xab\x1ejޯ\x9f\xd46\xb16\xe1\b&x\x15\xb3>\xfa2BM\xfb\t\x8b\x92\x96\xeb\f\x8a\xf7\xbe\x19\xa1H4Jk\x17$\x18ˠޤ\xd7jp\xa4T\xecp\x19\x12\xbd\xf6<\xf5Z㈚\xd3\x14\xa5\x92h~'X\xa93i\xc8.\xc8\xca\f\xb5\xeaM\xe0\xfa\xee\xb6\xd7)\xf0\xd9s\xddڽJcJSxb\xbc\xbf~B!y\xb8\xbe\xbb\x85\ar#0\xc0\x04g\xfd\xc0TJX5\xf8\x05Yz\xb8\x97?i\x84\xb4\xb2\xda ز\x8b\x11\xc0\x1b\xdc\xd2bSH0\xa8\x03*E\xb2\xa7-j\xb22kk\xa4Sܲ*7^\xb9p\ro\xbf\x83\x82\x8b\xca\xe01\xdfa\x9a\xf7\x8eH\x16\x9c\x9b\x8d\xbe\x97_P\x1b\x9eD\x10\xf4\xfd`\xc7\x16Q\x9f24\x19*\xd2t\xf6\x835\x1e\xa3s\xafIo\xd8#\xf9\x1f\x1b'Nd\x88\xf2\x1cJ\x99\xc2ލ\x04\x9bC@zj\xc2\x1b)sdC\"\x88_\x93\xbcJ1\xad}\xc6A!\xeb\xcd\xf6樓\xf5\xae\x19\x17\xb4dɗ%TE\xfdud\x9e\xd6\xf83\x85@Z\x97\v\a\x13\xb8\xf3\xf16#\xab\x97\n7X\x8c\xe09\xcbb\xb0^<\xdb\xe4x\x05FUC\x8a#\xc0`J\xb1\xc3\x04\xcdB\x04\xb2\x84du\x1fo\x1bs\x9e \x11\xab\xb6\x80\x96j\x964#\xf3\xfb?$X&\xe5c\f\x91\xfeA\xed\x1aK\x0f\x89\r\xf4`\x83\x19\xdbs\xa9t\xdf]į\x98T\x06\xc7\xd6\x113\x90\xf2\xed\x16\x15\xc1\xb2\xd1I\x1d\xccL\x11kZ\xdfRQӌ?\x9aW\xc3tb\x9e\xa5\xc6\xd8T\xac]\x1b\x85\n\x16qR\x87U\t\\\xa4|\xcfӊ\xe5\xc0\x856L$n~\xac\xc6ox~0'\x10G\xf8;k\x16fA\\\xea\xb8\tR \xf9\xf6\x85T\xc3\xc2\x11\xca1\x98q2l\x18i@9fۛ\xa2($\xf6\xa8\xa4\xd6\x1e5z\xe7\xa2\xe1\x94\xf3\xb0s\xb6\xc1\x1c4\xe6\x98\x18\xa9\xc6\xc9\x13#\x04\xae\xc4\xea\xcf\x11\xca\x0ehҮ!\x9eU\xa2M!K\x9d\xf1$s\xce0I\x99\x85\x05\xa9Dm5\x06+\xcb\xfc05i\x88\x91\f?\u061c\xd2hJ\x84\xfa\xe8\xc3\x1dS$M\x89\xd4\xc1M\x99\xd1\xc6]\xaa\xd7b\xf3J\xf4\x0e\x9a⛄\xfd\xf6\xa8\xfb\xf3\v;\x91\x9bS\xb0|\xbb\x05,Js\xb8\x00nBm\fTr\xb0\x1a<\xfe`\x8c;m\xb5\xdc\xf6{?\xfbjy\x16\xae\xd5h\xfcA\x98f\x8d՝\xb7U\x8b\x18\xf6\xa1\xdd\xf3\x02\xf8\xb6fXz\x01[\x9e\x1b\xb4\xbe\xd4\x1c\xa2-Gg\x96s\xcfI\xa0X\xdbK\xa5`&\xc9n\xea\xfd\x81\x88\x1e=Z\xf5\x018\xbf<\xc40\x96\a\x11 \xa1v*\xec\x96\x12WX\xb8\xad\xaa{\xbb>\x9a\x1a\xeb\x01\xbe\xfb\xf8\x1e\xd39\x92A\xbc\xa4\x1eM\xea]\xcf\xd3i\xa3`'\x18\x05\xb25)\xeb\xa6\xd51\x9eې\xbc\x00\x06\x8fxp\x9e\xd5`p9T\x88\xb5\xac\x06\xa9\xd0\xee\x8eZ5\xf2\x88\a\v\xcaowF\xc1[\"*\xae<\xe2!\xb6i\x8f\xa8\x84\x9f\xdf\xf0qԥ\n;\x8b\x98\xa5Ԕ\x9a\xa8~퀑q\x93\x85eJ)\x94@\xf1\x13\xa7]3\xac\xb3\xc7\xff\x88\x877ڱ\x8fVM\xc6\xcb\x05\x14 \x85\r\x1a\xed\n\v\x9b\xdb\x0f,\xe7i=\x98]'\v ފ\v\xf8(\r\xfds\xf3\x95kBQ\xa4\xf0^\xa2\xfe(\x8d\xadyQ\x12\xbbI\x9cH`\xd7\xd9.K\xe1\xcc\x02\xd1e\xd1\xf8\r\x0eք\x92\x88\xd6l\xe3\x1an\x05\xc5g\x8e>Kؔa@ΡUT\xdan\x8f\v)V\xd6L\x87\xd1\x16\x00m\xe3\xe5Y%U\x87S\x17\v!\x0e\xa2\xe8ѻ'k\xe5\xbe\x1c\x1d,L\x15\x85e\xce\x12L\xc3v\xa5=\xc5`\x06w<\x81\x02\xd5\x0e\xa1$\xbb\x11/T\v4\xb9+'Ha\xbck\x11\x8a7\vi\x1cb+Z\xf5\x91-\x03\x9b\xa3\x9a\x8f\x1cYL7\x8f\x9b\xa55\xef\xd6\x1f\x8a\xa2~\xfb||\x99eYȯc\x1f\xc4!\xe9\u070f\x82\xd9\xcd\xde_ɼZ\xf1\xfe-\xce\x1a2\xae\xf4\x1a\xde\xd9\xec\x80\x1c\xdb\xfd\xc3.ak\xa8(\x90\x84\t\xd7@r\xb2g9\xb9\x0f\xa4\xbc\x05`\xee\x9c\t\xb9=\xf2\xa0\xe2T\xccS&\xb5\xb3\xf9[\x8e\xb9=*<\u007f\xc4\xc3\xf9ő\xf6:\xbf\x15\xe7q0I\xe7\x1f)\xad\xdak\x91\"?\xc0\xb9\xfdvn\x1d\xb3%K\xe4\x04\xe7m\x81TG7\xb5g\xf9KB\x01\x8a\xb5\x83\xd7B\x9d\xeb\x13or\xe1\xe7f\x11-ӥ\xd4#\xc7N#h}\x96ڸ\r\xc0\x8e\xbb=\xb0C\x18\x13\xfd\xf9]C`[\x83\n\xb4\x91*\x9c.\x93\xda\xedm\x90\x13\xe7\xf5<\xef\x89\xd5\xf5n\xa4\x03LA\xe6y\xa3!\x9cN?w\xc7\xce\xf4\xf7<\xcc\xc4:K\x16v\xa9d\x82ZϋR\xa4\xe5\x98ٰ\xad7k\x99\v\u07b6Q\xaa9f+9\x94e\xae8\x91\xf6\x84\xc0\xe6\xe6kkߙ\xd4\x10\xfd\x8e\x11\xe5Sp\x04\x9b5V\x14\xac\x9f\xe9\x10\x8d\xee\xb5\xeb\x1d\x16\xa0\a\xe6\x02&\xb5\xab\xacRY\xe67{\x91\xfc\xbd9\x1e\x05\x17\xb7v x\xfbb\xce\n\x04U\x8e\xa7\x862ס\u007fÐ\xba\"6~\x85pn/\xedY\x8d\xc2\x0eg\x8fO2\xe29\x05\xe4L\viڛ5~\xa47\x1a\xb6\\i\xd3 \xbc\x00*\xd7\xf68\xf9ecLq\xa3\xd4\xc9!\xe6'\u05fb\xb5\xad\x98\xc9'\x9fe\xb2$\xb0\x0e\xc4\xcf\xd8\x1e\x81o\x81\x1b@\x91\xc8J\xd8\r/R\x174\xcc\x02\x88\x8e\x89ΘD\xda\xccVgQ\x15\xf1\x04YY\xe9\xe4bvw\xac\xdd\xe5\a\xc6\xe3v\xa7\xe04\xb6\x9a\xa9\x8c\x92\xa1\xd2M\x93\xf1\xa9%\xedt\xa2\x82}\xe5EU\x00+\x88-K\xe2ƭKJ\t\xb9G\x8e\xd7O\x8c\x1b\x9f\x8e\xe9\x0eV\x97i\xd3D\x16e\x8e\x06C\xbaI\"\x85\xe6)\xd6\xee\x83\xe7\xff`\xf2\xceXa\xb0e<\xaf\xd4\x02\x1d\xbd\x983K\xe36\xaf\x9e\x9e?\x18\x8bGde\x89\x19\xb9\xe9\xbe\xc0i\x9e\xb7\x1f\xa5Z\xe62\u007fV\xf8\xfc\xaei\xa98I\xa9\x9c\xf3NgaZ\xef\xb5\xeb\x9dz\xe1e\xe20\xe6\x9e\xceB\xb5\x98\xbc\xba\xa7uyuO_\xdd\xd3W\xf7\xb4W^\xdd\xd3W\xf7\xf4\xd5=\x1d.\xaf\xeei\xab\xbc\xba\xa7\xd1\xf6#\x06Õݹ\x9dh\x10\x85Ud\n\xc6\x1c\xda3c\xf9L\xa3\xeb\xbc\xd2\x06Ւ\f\xe9\xdb\xe1\x9e\x039\xf4\x89k\xb2\xb2\xd7\x1dǤ\xa6I]i\x8c^\x9d2MK2,&w\xb1%\xc2\v\x8fN\x83\x1e϶\x8fM\xa0\x9bK\x9b\xeb\xe6\x8e\xd7\xe9j\xee\xaf\x11\x82\x18\x19\x86\xf7\xdcs\x17\xa6\xda9W\xdd\xdc7\x1b\a\x04\x8c\u007f\x97y\xe5\x91im3\xc9lӉ\xf8c\x16>вw\xb8\xd0%\xa6\xea$~\xff\xaei\x19\x91m6\x9ec\xe6O-Ѱ\xfd\xdbu\xf7\x8b\x91>\xe3ldfO\xdcd\xee2\x17\x85\xaeb\xd7Nk\x0fr\xea/R\xf6i<\x02Q*\x10<w\f\b\x10:\xe4\x87O\xa5\v\x91O^\xe7\xf3\x81Z|^\xda\xd2l\xb4:\u007fhު|C\x0eڲ\v\x02\xb3\xf9f1HCL\x96\xd9p\xfe\xd8\f\xd4%\xb9e\xb11xD\x1eY|\xf6X\x1cy\xc0^\u007f\x8e\xcd\x19\x8b\xf6\xdab\xf3\xc3^&+,2\x17\xac\x95\xe15\v\xf2\xc4\f\xb0h\x82\xc5e{E\xe7x\xb52\xb7\xe6\xa95\x91\xd95\x9c\xaf5\vr(\x9f+&K+\n\xd7\xe8ܬ:\xe3j~'\xf1\x9b2\xb2\x9e?\xf7\xfb9\xfd\xfc\xe9\xfc\xaa\xa8\xac\xaa\xa8X`\x1e稼\xa9\xa5\xd9RQT]\x9a\x19Ug=M\f\x1c\x95\x0fu\x9c\xeb45\x95\xd9,\xa8\xf1\f\xa7)\xb0C\xb9O\x11yM\x13 \xdb\x19O\x8b݀Yi\x9ai0\xfcDA(\xf3\xb66\xff_H\xe0\xb7NZ\xaa\x8e\v\x1c\x13\x95|\xeau!\xde\a\xafoȭ\x1e\x8f\xf1\x9c\xb3}\x82[=\x02\xf2v\vE\x95\x1b^歷\nL\x86\ax\xe2yN\xea\xfcgi\xaf^n\x0e\x16ڧ/\xb5\x00\x8f\x81\xec\x06\bL\xc3\x13\xe69\xfd{D\x85Ľȑ\xc8\x15\x92\xcd\x19\xdf\x06\xf7\x17\xe4\xfds\x1e\x17.\xd7\xcf\xdeK\xb5\xf6\xac H\xe1^\xfd\t\xe1״\xb3\xeb|t[\xf7K\x85\xea\x00r\x8f\xaa\xf6jFŬ\xb9\xaf䗦\xae\xf2F\x95x\x9d\xe4ޅ骖\xf1\xd5P/hx'\x9c\x99\xed\xe3ja\x91\x0ei\x82\xa3)\xd5I\xb1\xd0\x18\b!k\b#\xfdc|\xe9%\x17x^\"Tz\x8e`)ʭx\x89\x80\xe9\xa5B\xa6\xa5AӒ\xa3˨\v8/\x11:-\t\x9e\x16y\x80\xf1\x17l^\xeab\xcd\v\x04Q'\x87Q\x8bH\x17{qfq0\x151\xbf\x99\x8b2G\x1eW\x04\xc8\xd1\v2\xc3\x01U\x04ģ\x8b1\xb3!U\xcc:\xe8\a]\xdf|\xcd%\xfa\x18\u007f\xd1YR\xec\x11|\xdc1\xcf\xfc\xf5\x95\xc8k+\x91\x87@1\xd8G^OY~-%\x92\xce'\x06[\x93CG^?Y\x14n\x9d\x18pMB\x9c\xban2\x1drMo\xa7\xf5\xaf\x99\x9c\xe0NDH\xd8l\x93o>\x11\x90*E5{\xb8\xb2D4g\x85\xb2\x17\x13u\xc7\xef\xbd:\x10\xdeĢV탛1\xee\xc8\xfa\x16|\x02?r\x91:ސ\x10\xb6\xfc\v\xfbj\xa1\xbd'S;>\xe3b\xd4x\x9b\xbdC#\x8d%#5j\x83\"{\xb8\xad\xd7pÒ\xacn8\x02ю\x9c1\r[\xa9\nf\xe0\xbc>\x8d\xbb\f=\xa9\xe6|\r\xf0\x83\xac\x0fB[\xaf܌\xc0ռ(\xf3\x03E?p\xde\x05\xf4m\xa23*~ڿ\x04\xe7\x1fD\x8b\x88\x80\xef\xba=\x06\x8e}\xc3shI.\xab\xb4\x1ea\x82\xddL\x1c\xe0\xf3\x83\xf5\xa6\xec#PI\xf3X\x96\xf7\x95B$\xdc{Kk\x04\xe4\u0603\x82\x8bH6~8\xac\x8dTl\x87\x1f\xa4{k1\x86f\xdd\x1e\x9d\xe76\xbd\xae\n\xa9\"\xfe\xeeר$\xbb\xb9\xf5\x016\x19d~\xb55g\xe9\x84\xed\x98\x12\x9bY\xe7\xc6\xe4\x11\x93\xbb\xbf\xff\xe0&dx\x81\xeb\xf7\x95;\xa8_\x95Li$J\x87\x89\xbaN\x9bq;\x97\xc9'ȥ\xa7\xc3\xf7\xfdy(\xb4\x19k6'\xe0\xa4\xd9\xec;O\x1f\x06\xd2ň\xfc\xc3p\xcfV \xdbb\xe2\xd4Ѿ\u070e\xc2bZ˄[]d\xb7\x84l\xa2\xd8\xcb=\x157eQ&TF\xa5\xf1ӓ@\xf5%,T}+\x1c\xa7f^\xe2\xfc\xe9\xa8c`\xf0\x90\xfa \xfd\xd7k>d\xf7\x84'\x90v\xafT\x86\xbd-\xae\xeb\xb7I\x8fI7\xb3\xfe\xc7\xd7\xfe\xb0\xef\xba\x1a~\x0etU\xbfPz\x16AY\xf7\ng̣\xaf\xee\xb9΄\x95\xa6R\u07bc&\x95\xb2\xef\xe6\x11\x10t\xcfʝ\xf6\xeck\xf3\x04\xf6\f/\x9bG\xb1\x9bh\u007f\xf6\t\xee\x01\xfe\xd5\x0fȎ\xbe\xa8ꬫ{\"{E\xf0Oc\xe7\xe0:\xb0\xef\f\xce\xcc\xf43\xb5\xa9\x93|=\xa1m\xc7\xf0>\xe1\xdd\x18\xea\xc3Y\x9b+\xf8\x88O\x03\xb57\x82&q|\xa6\xe6R31\xb5{\x04CO^ONq_\xf7\xb2y\xb1\x03ڢ\xab\xe6z\xcd{\t7,\xcf[\x10]\x0e\xec\x10[\xff̷n\x03'\xa19\xfd\xe5\xa8Ũ\xe2\x9aTZc\nkpI\x1dUjT{L[B\xe2mx\xbb\xa6\xda4\xcfE¯\xbf\x9d5\xab\x92%\t\x96\xc6'v\xb5\xffk\x80\xf3s\xfb#\xbc\xfco\u007f&R8G[_\xc1\xbf\xfe}\x06\xde\x00?\x84\xe7\xfd\xa9\xf2\xbf\x01\x00\x00\xff\xff\x9dJq\x1dHa\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x8f\xdb6\x13\xbe\xebW\f\xf2\x1e\xf2\x16\x88\xe4\x04=\xb4Э\xdd\xe4\xb0\xe86\b\xec$\x97 \a\x9a\x1cK\xecJ\xa4\xca\x19\xda\xd9\xfe\xfab(\xc9\x1f\xb2\xecu\x02\xd4\xdcÊ\x1c\xce\xc73\xcf\f\xc9,\xcf\xf3Lu\xf63\x06\xb2ޕ\xa0:\x8b\xdf\x18\x9d|Q\xf1\xf8+\x15\xd6/\xb6o\xb2G\xebL\tw\x91طK$\x1f\x83Ʒ\xb8\xb1β\xf5.k\x91\x95Q\xac\xca\f@9\xe7Y\xc94\xc9'\x80\xf6\x8e\x83o\x1a\fy\x85\xaex\x8ck\\G\xdb\x18\fI\xf9hz\xfb\xba\xf8\xa5x\x9d\x01\xe8\x80i\xfbG\xdb\"\xb1j\xbb\x12\\l\x9a\f\xc0\xa9\x16K0~\xe7\x1a\xafL\xc0\xbf#\x12S\xb1\xc5\x06\x83/\xacϨC-F\xab\xe0cW\xc2a\xa1\xdf;8\xd4\a\xf3vP\xb3\xecդ\x95\xc6\x12\xff1\xb7\xfa`\a\x89\xae\x89A5\xe7N\xa4E\xb2\xae\x8a\x8d\ng\xcb\x19\x00i\xdfa\t\xefU\x8b\xd4)\x8d&\x03\x18bOn\xe5Ct\xdb7\xbd*]c\x9b\xf0\x94/ߡ\xfb\xed\xc3\xfd\xe7\x9fW'\xd3\x00\x06I\a\xdb\t\\g>\x83%P0x\x00\xec\xf7N\x81r\xa0\x02ۍ\xd2\f\x9b\xe0[X+\xfd\x18\xbb\xbdV\x00\xbf\xfe\v5\x03\xb1\x0f\xaa\xc2W@QנD_/\n\x8d\xaf`c\x1b,\xf6\x9b\xba\xe0;\flG\x94\xfbqD\xae\xa3ى\xe3/%\xb6^\n\x8c\xb0\n\t\xb8\xc6\x11\x1f4\x03\x1c\xe07\xc0\xb5%\b\xd8\x05$t=\xcfN\x14\x83\b)7DP\xc0\n\x83\xa8\x01\xaa}l\x8c\x90q\x8b\x81!\xa0\xf6\x95\xb3\xff\xecu\x93 $F\x1b\xc5#\x1d\x0e?\xeb\x18\x83S\rlU\x13\xf1\x15(g\xa0UO\x100\xe1\x14ݑ\xbe$B\x05\xfc\xe9\x03\x82u\x1b_B\xcd\xdcQ\xb9XT\x96ǢҾm\xa3\xb3\xfc\xb4H\xf5aב}\xa0\x85\xc1-6\v\xb2U\xae\x82\xae-\xa3\xe6\x18p\xa1:\x9b'ם\x04LEk\xfe\x17\x862\xa4\x97'\xbe\xf2\x93Ќ8XW\x1d-$\xce_ɀ\xb0\xbe'L\xbf\xb5\x0f\xf4\x00\xb4uUJ\xc9\xf2\xdd\xea#\x8c\xa6S2N\x94\ue673\xdfH\x87\x14\b`\xd6m0\xa4}=\xf3D':\xd3y\xeb8\x19ЍE7\x85\x9f⺵L#\x99%W\x05ܥN\x03k\x84\xd8\x19\xc5h\n\xb8wp\xa7Zl\xee\x14\xe1\x7f\x9e\x00A\x9ar\x01\xf6\xb6\x14\x1c7\xc9\xc3O\xb4\x94\x03jG\vc'\xbb\x90\xafI\xa9\xaf:Ԓ=\x01Pvڍթ4`\xe3\x03\xa8C\xe5\x0f\x00\x1e\xaa\xf6r\xe5\xca`\x15*\xe4\xe9\xecė\x8fIH\xcc\xefju\xdah\xfe\x8fEUH\xaf\xa0\xc1\x91\xbe{\xfctj\xff\xba\x0f\xf3\xec\x9d\xf5d$\xb1\xc0 \xb8J+\x90&u\xecӹi\x19\xe8b;o \x87ߓ\xcf\x0f\xbe\xca\xce\x16\x8f\xd6\xef\xbcc\xa1\xfbU\xa1{g\xf0\xdbU\x89\xfd\xf9p\x93\xbeϾ\x89-\xae\x9c\xea\xa8\xf6\xcf\xd9flo\x93\x1c\x0f\xf8\xfd\xa17\x1d9,Q\x8e\x06\xbc\f\xca \xb0D\x8a\xcdEsw\xab\xfb\xef\x89\xe3\x82\xf8U\xa4.\xd4\xe28ҙ\xfb<\xb1$+#\xb1d\x8b\x10K\xfe\x97\xbbLp\xc8H\x87\x9e\xb8\xb3\\\xcfj\x04\xd8\xd5Vש\xcb%VJ\xbb%\xf2ڦ\xe6\xf5\xa3\xee'\xb6\xdcP\x1c{f\x1d\a\xd2O\xecjO\b\xe9\xc0٦\xe5\xb1X\xd2-en\xecjt\xfbr\xbb\xc0\xdc\x1f\x88Hړ\r8S\xeby\xea\x013\xd3\x12\xc5\xd9\xf4\x85\xa6z\xc9@>4\xba\xec\x06\x1dĊ\xe3\xa4I]m\xcdI~\xc4\\\xc7\x10\xd0\xf1\xa0Eh\xa4\xa6\x1b\x8a춾8\xe6\xe8\xd3\xf2\xa1̮f~4\xf0i\xf9 \xf7\x1fV\xd6\xf5\xdet\x01s\xb2\x95C\x03\xb2&-Z\xa6g\xc0\xe8\xffN/|7d\x14\xbfu6\xa4\x83\xe8\x19\x17\xdf\xed\x05\x05\xa9ĮtG\x98`\xd3+DJ\xf7/\xad\xa67?\x19k\x04\x83\r2\x1aX?\xa5(\xe9\x89\x18\xdbs\xbf7>\xb4\x8aK\x90\xbbC\xcev\x86F\xf2\xecP\xeb\x06K\xe0\x10\xf1{\x02\xefjE\xf8L\xcc\x1fDf\x8e\x18\xfb\xf62\x89\xbe\xc8n;\xb6rx\x8f\xbb\x99\xd9\x0f\xc1k$Bs{$\xb3Ep6Ir\xc76G(\r\xef\x86a\xe6P2Jk\xec\x18\xcd\xfb\xe9c\xecŋ\x93\xd7U\xfa\xd4ޙ\xf4\xbc\xa4\x12\xbe|\x95'\x94\x1c9fx(P\t_\xbef\xff\x0e\x00\xb5\xbew\xf9\xc1\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4W\xddo\xdcD\x10\x7f\xf7_1*H\xfd \xf6\xb5\xe2\x01\xf0\v\n)HQ\xa1DM\x95\x97P\xa4\xb9ݱo\x9b\xf5\xeev?\x0eR\xc4\xff\x8efm\xc7v|9\xc2\x03w\xf7p;\xdf\x1f\xbf\x19\xaf\x8b\xb2,\vt\xea\x8a|P\xd6ԀNџ\x91\f\x9fBu\xf3m\xa8\x94\xdd\xec_\x157\xca\xc8\x1a\xceR\x88\xb6{G\xc1&/\xe855ʨ\xa8\xac):\x8a(1b]\x00\xa016\"\x93\x03\x1f\x01\x845\xd1[\xadɗ-\x99\xea&mi\x9b\x94\x96\xe4\xb3\xf1\xd1\xf5\xfee\xf5M\xf5\xb2\x00\x10\x9e\xb2\xfa{\xd5Q\x88ع\x1aLҺ\x000\xd8Q\rN\xa7V\x19aM\xa3\xdaP\xedI\x93\xb7\x95\xb2Ep$\xd8c\xebmr5L\x8c^q\x88\xa6\xcf\xe4\"\xdb8\xcb62Y\xab\x10߬X?\xab\x103\xdb\xe9\xe4Q\xdf\xf3\x9d9A\x996i\xf4K^\x01\x10\x84uT\xc3[\xec(8\x14$\v\x80!\xd9\x1cJ\t(e.\x1f\xea\v\xafL$\x7ffu\xeaƲ\x95 )\b\xaf\x1c\x8b\x8ca\xe5\xf0\xb3_\x80\x8f\xc1\x9a\v\x8c\xbb\x1a*N\xbd\xea\xfd\xbf\x99\x048\xeb\x1af\x84x\xcb\x11\x85\xe8\x95i\x8f\xf8`\xbd\xa3>\xdeN\x02,;*>\xeceff\x84J\xb5j\xf3\xc2\xe2i;z胖\x18{B\xefp\xff*\x1f\x82\xd8Q\x97Q\xc7'\xebȜ^\x9c_}}\xb9 á$\xfb\xd6\xc3\xcej\x19 \xee\x88Qڨ6\xf9\f=h\xac\a\x84\xab\f\xa1\xa1\xb1՝9\xe7\xad#\x1f\xd5\b\xa9\xfe;\x1b\xa3\x19\xf5\x9e\xf3\xa7\x1c_/\x05\x92\xe7\x87z\xef\x030H\x0e)\x81m \xeeT\x00O\xceS \xd3O\xd4\xc20\xb0\x10\x1a\xb0ۏ$b\x05\x97\xe4\xd9\f\x84\x9dMZrB{\xf2\x11<\t\xdb\x1a\xf5\xf9\xcev\x80h\xb3S\x8d\x91\x06|O\xdf\fD\x83\x1a\xf6\xa8\x13\x9d\x00\x1a\t\x1dނ'\xf6\x02\xc9\xcc\xece\x91P\xc1/\xd6\x13(\xd3\xd8\x1av1\xbaPo6\xad\x8a\xe3\xfa\x10\xb6\xeb\x92Q\xf1v\x937\x81ڦh}\xd8Hړ\xde\x04Ֆ\xe8\xc5NE\x121yڠSe\x0e\xddp¡\xea\xe4\x17~X8\xe1\xe9\"\xd6\x15\xce\xfa\x1fOȱ\x0e\xf0<\x80\n\x80\x83j\x9f\xe8Th&qu\xde\xfdx\xf9\x1eF\u05f9\x19\v\xa30\xd4}R\fS\v\xb8`\xca4\xe4\xb3\x1e4\xdev\xb9\xe2d\xa4\xb3\xca\xc4|\x10Z\x91\xb9_\xfe\x90\xb6\x9d\x8a\xdc\xf7O\x89B\xe4^Up\x96w*l\t\x92\xe3A\x90\x15\x9c\x1b8Î\xf4\x19\x06\xfa\xdf\x1b\xc0\x95\x0e%\x17\xf6q-\x98?\x0e\xa6\x0f[\xa9\x87\xaa\xcd\x18\xe3\xda~\xa0_\xf3q\xbdt$\x16c\xb3\x1c\xda\x1d\x1aI\x92эy-\xebq\x85\x8c\x9f\xfb\x83\xfc\xf00\x0fϭF\xb5\xf7\xa9\xb0X\xdb\x0f\xe9\x1e)\u0381\x1c\x87e\xa4\x0e%\xc5u\xcc\xeb\x88Y\x87\x128RZ\xfe)#t\x92$\xef\x9eC\xa1>\x1e\xcc\xf9Ja\x98\x16\xad\x04\xf1\xca1\x13㏝\r\x04*R\x17VF\xa1\xc7\xfe2\x1btN+n\x9e\xad\xe0'\xeby\xbe\xa2\xf5\x14N8\xf3@\x80\x9e\xf8\xdf\xcc\xc7\x01\xbb\xd9_\x16\x1d\xd4%(\x13\xed\t`\x13\xf3\xc8\xdd1\x9e\x86\xc9\x12t\xe8\x9c2\xed\xbaz\x00\xe7\rP\xe7\xe2\xed\t\xa88\x8b\xb1\xcf\f\x94\x01\xd4z\x16S\x05\xa7˼\x0e\x98̏\xff\x01\x8cS\f\x11o(\x80\xf3$H\x92\xe1z\xeeɃ5\x9c5F.\xb4\xb1q\x1da\x8e\xe3?c\x8coM\xb8\xd5TC\xf4\x89\x8aú\xe8=\xde\xde\xe3M7\x89\x7f\xc1\xcaŝ\xe0\b^^\x12\f\x92\t\xad\a`\xb02\n\xac\xdeX\xbfΜL\xea\xd6A\x94\xf0\x03\x8a\x9b\xe4\xce#u\xa7\xe2\xa0\xc9\x12\xde\xf5\xe08*\xf3\x9a4\xc5\xe3\"\xbf\xe6\x85uɦ\x0ep\xaf\xf8\xcaF\x97\x06]\xd8\xd9\x18\xc9\x1f\x90a\xf3\xc7$\x8e4q\xbao=\xaa\x13,8v\xa2IZߖ\x9f\x12j\xd5(\x92\x19\xbe\xcb\xce<nd\xfbΜ\x00Um5]\xaa7b\x87\xa6\xa5\x92K\x8c-\x95Bc\b\xeb\xf69䚘\x1a~\xbf\xc6\xf2\xf3\xcb\xf2\xbb\x0fϮ\xcb\xe1ߋ\x91\xf4\xfc\xfbg\xbfUG\xf9\xcf_l\xaa\xaf\xbe||\xe1\xf8\xe9\xa9<݃o\t\xab+\xf2\x921\xbb\xd7\x1e٫+b\xe0\x8b\x97\x9cM\xd9P\x949%m\xefn15\xfc\xf5w\x11\"ƔW1\nA.\x0e\xfbv\xfe\x8e\xf2\xe4\xc9\xe2\xd5#\x1f\x855\xfd;C\xa8\xe1\xfa\x03\xbfe0.\xe5p\xa5\f5\\\x7f(\xfe\x19\x00\xb0n\x13y\xd5\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Z\xdfs\xe3\xb6\xf1\x7f\xd7_\xb1\xe3<\xf8\x9b\x99\x13\x95\xe4\xdbi;z\xcb\xd9M\xc7m\xe2s\xcfν\xdc\xdc\x03D,E\xc4$\x80bA\xf9\xd4L\xfe\xf7\xce\xe2\x87D\x8a\x94d\xbb\xbd\xf4\xa4\x993\t\xec\xe2\x83\xc5\xfe\x86f\xf3\xf9|&\xac\xfa\x80\x8e\x94\xd1K\x10V\xe1g\x8f\x9a\x9f\xa8x\xfc3\x15\xca,6\xdf\xce\x1e\x95\x96K\xb8\xeaț\xf6=\x92\xe9\\\x89\xd7X)\xad\xbc2z֢\x17Rx\xb1\x9c\x01\b\xad\x8d\x17\xfc\x9a\xf8\x11\xa04\xda;\xd34\xe8\xe6k\xd4\xc5c\xb7\xc2U\xa7\x1a\x89.0\xcfKo\xbe)\xfeT|3\x03(\x1d\x06\xf2\a\xd5\"y\xd1\xda%\xe8\xaeif\x00Z\xb4\xb8\x04k\xe4\xc64]\x8b+Q>v\x96\x8a\r6\xe8L\xa1̌,\x96\xbc\xe8ڙ\xce.a?\x10i\x13\xa0\xb8\x99;#?\x046o\x03\x9b0\xd2(\xf2\x7f\x9f\x1a\xfdQ\x91\x0f3l\xd39ьA\x84ARz\xdd5\u008d\x86g\x00T\x1a\x8bK\xb8\x15-\x92\x15%\xca\x19@\xda{\x805\a!e\x90\xa6h\xee\x9c\xd2\x1e\xdd\x15s\xc8R\x9c\x83D*\x9d\xb2<%\xa0\x87\b\x10\"B /|G@]Y\x83 \xb8ŧō\xbesf\xed\x90\"<\x80_\xc8\xe8;\xe1\xeb%\x14qzakA\x98FYDK\xb8\x0f\x03\xe9\x95\xdf2h\xf2N\xe9\xf5\x14\f>#x\xaaQ\x83\xaf\x15A<\x11x\x12\xc4p\x9cGyt\xe10\xbe;\xe24-\"\xb8b\x05ؑF\bRx\x9c\x02\xb0\x93'\x98\n|\x8d,\xf9\xa0qBi\xa5\xd7\xe1U\xd4\x16\xf0\x06V\x18 \xa2\x84\xceN \xb3X\x16\xd6\xc8Bg\xa6i\x0e?\xf7\x96z\xa6lx\xfe\x7f\x1bU\x1a\xe6?\x83\x0e\xbc\x02ʋ֍\x93\xd3`\\\xf5C\xffչ\x85\x93n:\xb4\x86\x947n\vJ\xa2\xf6\xaaR\xe8\xa02\xae\xaf6G 0\xed͎(M\x8aP\xde\xef\xd9\xde\\?\x13\xd1C\x8daN\x16Gg\x1b#$:\x16H-\xb4l\x10ؓ\x81wBS\x85\xee\b\xaaL\xf6\xb0\xb5C\xf1\xfc\x9c\xf9\xf5F^r<Ib\xf7\xde8\xb1F\xf8є\xc1\x19\xb2\x919\x1cX\x19զk$\xac\xf2*\x00䍛49V\xa1H\x95\xf8f\xb6\a\x96?\\\xf38\xfa\x1e\xef\xec\xfa\x8b\x91\xdb\x1e\xf0\xfe~\x8d\xd3\xf6\x1c\xa5\xb6\xf96<PYc\x1b\xa2\b?\x19\x8b\xfa\xfb\xbb\x9b\x0f\xff\x7f?x\r`\x9d\xb1\xe8\xbc\xca\x0e=~zq\xac\xf7\x16\x86\xa2\xbed\x86q\x16H\x0e`H\xd1*\xe2;\x94\tC<\x0eE\xe0\xd0:$Ծ/\x92\xfc1\x15\b\rf\xf5\v\x96\xbe\x80{t\xec\xd1\xf3\xc1\x94Fo\xd0ypX\x9a\xb5V\xff\xda\xf1&\xd65^\xb4\x11\x1eS\\\xd9\x7f\x82\xebע\x81\x8dh:|\x03BKh\xc5\x16\x1c\xf2*\xd0\xe9\x1e\xbf0\x85\n\xf8\xc98\x04\xa5+\xb3\x84\xda{K\xcb\xc5b\xad|\x8eߥi\xdbN+\xbf]\xb0\vrj\xd5y\xe3h!q\x83͂\xd4z.\\Y+\x8f\xa5\xef\x1c.\x84U\xf3\x00]\xf3\x86\xa9h\xe5W.E|\xba\x1c`\x1d)F\xfc\x86\xf0z\xe2\x048\xc0\x82\"\x10\x894nt/\xe8\xec \xdf\xff\xe5\xfe\x01\xf2\xd2A\xf3\aL!\xc9}OH\xfb#`\x81)]ar0\x953m8f\xd4\xd2\x1a\xa5}x(\x1b\x85\xfaP\xfcԭZ\xe5\xf9\xdc\xff\xd9!y>\xab\x02\xaeBRÎ\xba\xb3\xac\xb9\xb2\x80\x1b\rW\xa2\xc5\xe6J\x10~\xf1\x03`IӜ\x05\xfb\xbc#\xe8\xe7c\xfb\x7f\xcce\x99\xa4\xd6\x1b\xc8Iӑ\xf3:Ȅ\xee-\x96|z,@\xa6T\x95J\x1e\x8aݹ8L\x9c\x8a\x01\xe3i\xc3\xe5Ϥw:\x9ct\x80\xec\xed\x14MƦ{>5;\xcc\xe8\xfbFL\x01\x9aL\x9c\xbd쎦\x1f\xb9(9\xd8\xe1\x9eN\x1c\x03\x7fK\xa1Kl\xce\xec\xe4*L\xea\xe9\\-\xfc.oH\x01;\x01Z!\xa3\xb0\xf68\x8c\x951\r\x8aCW\xa5\x8d\xc43(n\x8d\xc4)\xf11\xe9\x1e\x12g\x9e\xec\x17;\xadǻ\xe5\xaf\xd1/\x12\x905\xf2\f\xae\xb4\xa2\x00\x87\x15:\xd4\xec\r\xccٴj\xc4\x13\x06\t\xcf\x18\xe3q\xe5<\x15]&\x11\x7f\x7fw\x93#J\x16b\xc2\xee\xc7랑\x0f\x7f+\x85\x8d\f\x01\xf7\xfcڗ7U\x14\x14\xf3bA\t\xb0\nK\x1c\x04+P\x9a<\n\t\xa6\x9a\xe4\xc8e\x1c\xb0\x03r\x98(\xdeDO\x9a\\\xf6>\xc4y\xa14\b\xf6\xe1J\xc2\xdf\xee\xdf\xdd.\xfe:%\xfa\xdd.@\x94%\x123\x12\x1e[\xd4\xfeͮd\x91Hʡ\xe4\x02\x04\x8bVhU!\xf9\"\xad\x81\x8e>~\xf7iZz\x00?\x18\a\xf8Y\xb4\xb6\xc17\xa0\xa2\xc4w\xe1!+\r\xab6\x8bc\xc7\x11\x9e\x94\xaf\x95\x9eM\xb2\x04\xc1\xb5D\xda\xf6Sخ\x17\x8f\b&m\xb7Ch\xd4#.\xe1\x82\xdd`\x0f\xe6\xafl;\xbf]\x1c\xe1\xfa\x7f\xd1\xc5\\\xf0\xa4\x8b\bn\x97\x0f\xf4\x8dn\x0f2Z\x9eS\xeb5\uecfb\xc3\x7fL\x82\x1b\xd4\xfek0\x8e%\xa0M\x8fE`\xac(;l\x94#\xd0\x1f\xbf\xfbt\x14\xf1\x9e\x0f\xcb\v\x94\x96\xf8\x19\xbe\x03\x95\x8a>k\xe4\xd7\x05<\x04\xed\xd8j/>\xb3\x0f)kCxL\xb2F7[\xdes-6\bd\xb8\x84Ħ\x99\xc7|L\u0093ز\x14\xf2\xc1\xb1\x1a\v\xb0\xc2\xf9\x93ښ\xb3\xb0\x87w\xd7\xef\x96\x11\x19+\xd4Z3\x1c\x8eޕ⬊ө0\x18\xb5Q\xd1\x11\x8e\xd4\x05~\f\xb3\xac\x85^s~\x15\x0e\xa9\xea8M*.g\x13D\xe7\xecx\x9c\x1aM\x9bpH\x91\x0e\x1d\xc7\xff,\xc9x\xe6\xe6Xɞ\xb3\xb9~\xb5srs\xdc)r\x1a=\x86\xfdIS\x12o\xadD\xebia6\xe86\n\x9f\x16O\xc6=*\xbd\x9e\xb3jΣ\x0eЂ\xa1\xd0\xe2\xab\xf0߫\xf7\x12j\xfd\xe7nhЃ\xf8\x92\xbb\xe2uh\xf1\xaaM\xe5\\\xfa\xf9q\xec\xf2>ex\x87\xb4l\x16O\xb5*\xeb\\$%\x1f;\xc9\x12\xd8\x02[!\xa3k\x16z\xfb\xc5U\x99\x05\xda9F\xb4\x9d\xa7\xf6\xe3\\h\xc9\x7f\x93\"\xcf\xef_%\xc1N=\xcb|\x7f\xbe\xb9\xfe}\x14\xbcS\xaf\xb2\xd5#\x85\x00\x7f\x87ݖ\xe5\xec\xe4F\xdf\x0f&\xe7\xd4q\"s\xde\xcd)f/\x00\xea\xc5z\"\x15\xeb\xb7IO%l'%0\xd8ƃX\x13\b\x87 \xa0\x15\x96O\xee\x11\xb7\xf3\x18\xe2\xadP.\xe5\xe3)\xe7Y!\bk\x1b5\x19\x8a\xbd\xe9'\xa1I\x12\x82\xc2V\x8a\x97\x9cC\xbf\xbf\xb4<\r?w\x9cxj>\x833\x1d._OUA\x83\xbe\xd7\x18-\xea\xae\x1dC\x99ã\xb1JL\xbcwH^\x95\x13\x03\x17\x17\xb3\x17\x1cV,\x7f\xce\xc8 \xb5\xc2\x15\x8d\xf2\xa8t\x14l=)\x80s9\x11\xba\xae#\x96p\xaa<8\n\x91\xab6\xce[\x87\x10簚*O\x0f\xe6piu\xf0\xca\x1ay\xf0f\xb2\x03\x9a\a\a\x1dړj\xc5\x19ww`*'+\xfd0?kT\xf4\xa7>_3\x98\xea\xf5\xb5~i8O\x1f^\xf1\x9c>ޫ1Eh\xab9\x99ԝ\xaf!D\xb67\xbe~HkLU\xc9\xd0c\x17)\xb9\x9c\r\xdcP\x86$\x9as\xfcJ\xa8\x06ebI\xc5!\xcd\x04\xd7>\x97\x15V\x9c\xacE\xd3˥i\x82\xb7KT\xb9\x83\x12\xfaU\x97t\x82gG(C\xab|B\b\xe3\xe4\xb52\xae\x15>\xf6W\xe7\x93L\xf9.M\xac\x1a\\\x82w\x1d>_\u0379\xabD$\xd6\xe7L\xf1\xa78\x8b\xf5Fd\x12\x10+\xd3\x1d\xe9h\\Rҩ\xe2%X\xecd1<\x00\xc2\xf5r\xd6ުk\x9a@\x93J\xbe]\x89\x15/&\xb9҃\x15\x8e\x97y\xadO\x00\b\x17k\xe7\x10\xf2\x9c)\x03\xdby\xaf\x93\x16v\xca)\xdf\xe2\xd3\xc4\xdb\x7ft\xd8Mĭ9\x8cn\n\xf7\x9fyV\xfdI\xc2\x1f\x82\x99L\x11\x85\x96\x16\xca\x17\xc9,a8'\xb64\rj\xd3d\a`\xbch@w\xed\n\x1d\xcbn\xb5\xf5H\xc3\x100\xe2\t\xa9\x16܋\xbeG\x9f\xcf<rJ\xe5m)4\xf7\x90\x82Ez\x03R\x91m\xc4v\x82\xb1\xcd\b\xb9Zc\x83d\xb7\xb1\xb7\x81\xec\b,\xba0\xf4\xd2^T\xc0tm\xf4\x84)\xf6}\x80\xd2\xfe\x8f\x7f\x98\x9c\x11\r\x8bo\x1a\xd6\a\x01%\x8d\xb38\xdfn\xfd\xf4\xf2\xff\xf9\n'\x12\x1f\xd2\xc2Rm\xfc\xcd\xf5\x19-\xb8\xdfM\xcc\x164\xbaZ\xc4\x1d\xb7\xa4\n#\x8e\xd0\xf3G\xc5KTux}}\x0e\xea`\xf2\x99ȕ.\xce\xc7h\x00\xee\xd1\n\xc7\xde!\xdcg\\\x1d^\xb8\xbd\x01R\xdc\xe7\n\xd9jL_c\xeb\x828\xa0q:f\x1cN\xb8Y\x18\x87\xa2A\xe0\x19\xc2\xff=cΤ\x9e\x8c^\x06\xe4\xb2\xc7;5\xfa\xfbo\xbaU\xae`i\t\xbf\xfe6\xdb'C\u070f\xb4\x1e\xe5\xed\xe1\x0fD..\x06\xbf\xf8\b\x8f\xa5ѱ\xfa\xa0%|\xfc\xc4?\xeb\bW\xae\xa9*\xa6%|\xfc4\xfb\xf7\x00t<\xff3U#\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s\xe3\xb6\x11\x7fק\xd8q\x1e\xdc̜\xa8$\xed\xb4\x1d\xbd\xdd\xf9\x9a\x8e\xdb\xe4\xce=9\xf7rs\x0f\x10\xb1\x12\x11\x93\x00\x8a\x05\xa5S3\xf9\xee\x9d\xc5\x1f\x89\x14)\xc9v\xebDҌM`\xf1\xc3\x0f\x8b\xdd\xc5b9\x99N\xa7\x13a\xd5Gt\xa4\x8c\x9e\x83\xb0\n\xbfx\xd4\xfcD\xc5\xc3_\xa9Pf\xb6\xf9v\U000a0d1c\xc3MK\xde4\x1f\x90L\xebJ|\x8b+\xa5\x95WFO\x1a\xf4B\n/\xe6\x13\x00\xa1\xb5\U000426c9\x1f\x01J\xa3\xbd3u\x8dn\xbaF]<\xb4K\\\xb6\xaa\x96\xe8\x02x\x9ez\xf3M\xf1\x97\xe2\x9b\t@\xe90\f\xbfW\r\x92\x17\x8d\x9d\x83n\xebz\x02\xa0E\x83s\xb0FnL\xdd6萼qH\xc5\x06kt\xa6PfB\x16K\x9eu\xedLk\xe7p舃\x13\xa3\xb8\x9a;#?\x06\x9c\x0f\x11'tՊ\xfc?G\xbb\x7fP䃈\xad['\xea\x11\x1e\xa1\x97\x94^\xb7\xb5p\xc3\xfe\t\x00\x95\xc6\xe2\x1cމ\x06Ɋ\x12\xe5\x04 ) P\x9b\x82\x902\xa8T\xd4wNi\x8f\xee\x86!\xb2*\xa7 \x91J\xa7,\x8btp\xc0\xac\xc0W\xc8S\x06u\v\xa5\x95^\x87\xa6\xa8*\xf0\x06\x96\b\x89\tO\xcbߟ\xc9\xe8;\xe1\xab9\x14\xac\xb8\xc2\x1aY茙d\xf8\xb93Sj\xf5;^\ay\xa7\xf4\xfa\x14\xb3\xff3\xa9\xd4\x1d\xf9\xdc\x19\xf9H&\xf7\x15\x06\x99̦\xb5\xb5\x11\x12\x1dk\xa4\x12Z\xd6\bl\xb9\xe0\x9dдBw\x82E\x1ev\xbf\xb3\x98D\"\x93\x9f2^\xa7\xe7)\xday\x8a*\xa2l\xea\x8c\xd3\x7f\xec6]\x9a\xf7\xce\xc84\x00\x92Q\x03y\xe1[\x02j\xcb\n\x04\xc1;\xdc\xcen\xf5\x9d3k\x87D#4\x82xa+A}\x1e\x8b\xd0\xf1\xb2<V\xc65\xc2\xcfAi\xff\xe7?\x9d\xe6\x96\x06\x15\xdexQ\xbf\xd9y\xa4\x1e\xd3\xfb\xe3\xe6\xa85v\xb65\xbaߏ\ue499\xbe5\xba\xaf\xd77G\xadcd;\xa09\x10\x17\x83 \xdaC}\xbd\xee\xe3I\xe1cC\x9ct\xf3mx\xa0\xb2\xc2&\xc4t~2\x16\xf5\xeb\xbbۏ\x7f\\\xf4\x9a\x01\xac3\x16\x9dW9\xba\xc6o\xe7T\xe9\xb4B_\xb3\xd7\f\x18\xa5@\xf2q\x82\x14\xe3ClC\x998DgQ\x04\x0e\xadCB\x1d\x0f\x98\x1e0\xb0\x90\xd0`\x96?c\xe9\vX\xa0\xe3\xd0\nT\x99\xb6\x0e\x11h\x83\u0383\xc3Ҭ\xb5\xfa\xcf\x1e\x9b\xd8\xf7x\xd2ZxL!\xfe\xf0eM;-j؈\xba\xc5W \xb4\x84F\xec\xc0!\xcf\x02\xad\xee\xe0\x05\x11*\xe0G6h\xa5Wf\x0e\x95\xf7\x96\xe6\xb3\xd9Z\xf9|\x9a\x96\xa6iZ\xad\xfcn\xc6Aѩe덣\x99\xc4\r\xd63R\xeb\xa9pe\xa5<\x96\xbeu8\x13VM\x03u\xcd\v\xa6\xa2\x91_\xb9t\xfe\xd2u\x8f\xeb\xc0\xe9\xe2/\x9cugv\x80\x0f;P\x04\"\r\x8d\v=(:\x87\xec\x0f\x7f[\xdcC\x9e:lF\x0f\x14\x92\xde\x0f\x03\xe9\xb0\x05\xac0\xa5W\x1ct+E\xb0r\xa6\tیZZ\xa3\xb4\x0f\x0fe\xadP\x1f\xab\x9f\xdae\xa3<\xef\xfb\xbf[$\xcf{U\xc0MH1\xf8\xe8h-[\xae,\xe0VÍh\xb0\xbe\x11\x84/\xbe\x01\xaci\x9a\xb2b\x1f\xb7\x05\xdd\xec\xe8\xf0a\x94y\xd2Z\xa7#g0'\xf6\xeb8+YX,y\xfbX\x83<T\xadT\x19|\x83\xc3\x0f\x88A\x16S\xf4\xa0\xc7]\x97\xbfKQ>\xb4v\xe1\x8d\x13k\xfc\xc1D\xccc\xa1#no\xc6\xc6dr\xbas\xe6Ep`Bb\x1f\x89\xba\xdf:\x0f\xdeV\xe8\xb0;ơ5\xa4\xbcq;\x06f\x04\x94\xfd5\x9d\xd9\b\xfe\x95B\x97X_X\xc9M\x10\xeaX]%\xfc>\x97I'v:\xab\xd9\f\xc9\x1bkO\xf3X\x1aS\xa38\x8eV\xd6\xc8\v,\xf8\xdc\t\x9e\xe9p\x85\x0eu\x899T\x9dK\xa9\x06\x98\xd0\xcd,\x86\x1cO\xdb\xc0\xb90>J\xf8\xf5\xddm\x0e\xddy\xab\x13u?\x9c\xf7\xc2>\xf1o\xa5\xb0\x96\xe1d\xbb<\xf7\xf5\xed*N\xc6X\xac'\x01Va\x89\xbdS\x01\x94&\x8fB\x82Y\x8d\"\xf2\xed\x05\xd8\xd3\x1d\xa6\x11\xafb\xc8J\xb1\xf1p\x96x\xa14\b\x0e\x96J\xc2?\x16\xef\xdf\xcd\xfe>\xa6\xf9\xfd*@\x94%\x12\x03\t\x8f\rj\xffj\x9f<H$\xe5Pr\x06\x85E#\xb4Z!\xf9\"́\x8e>}\xf7y\\{\x00\xdf\x1b\a\xf8E4\xb6\xc6W\xa0\xa2\xc6\xf7q8\xdb\f; \xabc\x8f\b[\xe5+\xa5'\xa3\x90 \xf8\x16\x91\x96\xbd\r\xcb\xf5\xe2\x01\xc1\xa4\xe5\xb6\b\xb5z\xc09\\q\xb8\xe9\xd0\xfc\x85=\xfc\u05eb\x13\xa8\x7f\x88\x9e|\xc5BW\x91\xdc\xfe\xe0톆\x03\xc9\xe8sN\xad\xd7xȈ\x8f?<\x047\xa8\xfd\xd7`\x1ck@\x9b\x0eD\x00\xe60\x11\x03#\xca\x01\xe9O\xdf}>\xc9\xf8\x80\xc3\xfa\x02\xa5%~\x81\xef@\xe9\xa8\x1bk\xe4\xd7\x05\xdc\xf3\xbf\xb4\xd3^|\xe1\x80TV\x86\xf0\x94f\x8d\xaew\xbc\xe6Jl\x10\xc84\b[\xac\xebiL|$lŎ\xb5\x907\x8e\xcdX\x80\x15Ο\xb5֜\xeeܿ\x7f\xfb~\x1e\x99\xb1A\xad5\xd3\xe1cr\xa58}\xe1\xbc%tFkTt\x02\x91ڀ\xc74\xcbJ\xe85'2a\x93V-\xe7#\xc5\xf5dd\xd0%?\x1e\xe6 \xe3.\x1cr\x91\xe3\xc0\xf1\xbb\x9d\xe6\x8f\\\x1c\x1b\xd9c\x16\u05fd\xf4\x9d]\x1c\x17H\x9cF\x8fa}Ҕ\xc4K+\xd1z\x9a\x99\r\xba\x8d\xc2\xedlk܃\xd2\xeb)\x9b\xe64\xda\x00͘\n;\n\x7f\x9e\xbd\x96p\xcd\x7f\xec\x82zՇ\x97\\\x15\xcfC\xb3g-*'\xad\x8f?Ǯ\x17)\x93:\x1e\xcbn\xb1\xadTY\xe5\xdbH\x8a\xb1\xa3\x90\xc0\x1e\xd8\b\x19C\xb3л\x177eVh\xeb\x98\xd1n\x9a\xaanS\xa1%\xffO\x8a<\xb7?K\x83\xadz\x94\xfb\xfet\xfb\xf6\xb71\xf0V=\xcbWOd\xdc\xfc\xe3\xb4\xf2V\xb2*W\n\xdd|rv\xa1\x1fz\xc29\xc1\x1dIP\xf72\xc5\xe4\tDI\vK\x95\xf1\xb7o/\xf0X\xec\x053\x87\xc3\x06\xa4t0c\x1d\u0557\x9eħ[\xfa\xba\xc0(\x17\xc3X4s\xbaP|\xf3\xd5\xd8\x05\xa0W\x92\x1b\xb2E\xdd6C*Sx0V\x89\x91v\xce~U9\xd2qu\xf5\x14MD\xa5^\xd0A\xaa\x14)\x1a\xe46iOآӡ\xca\x19~ؙ\x01$<g\xaf\xf8\xbe©d\x9f\xe1\x14\x96c\x17\xb3#\x19k\xe4QK\xdf'\x8e:\x0fFz\xd4ѫQ\x9e\xf5;N\x85ۣK\xc7\xf9\xbbn\x18\x90\xed*F:\x9fKqf\xf5?\xdcvK\xc3)t\xff\xa5\xc3\xf9]\xbe\x19\x8e\b\xa5%'\x93ի\x06\xc3\xcd-\xf0\x80\xad\xa0<\xc9؎B\a/\x0e\r\xb5\xae\xd28\x892$\xb8\x9c\x7f\xaf\x84\xaaQfL\xe2\xe4\x13\x81B\x8d\xe5z,\x9f\xcb@-\xa1\f\xe5\x80\x11\xd2\xc3q\xb9lɕ\x95)C\f$\xf8m\x8cX\xd68\a\xefZ|\xbcyr%\x84H\xac/yЏQ\x8a\xa9\x8b<\x04\xc4Ҵ\xa7\xee\xe0ה\xac\xa0x\n\x99Pľ@\xe5\x8ee\xc6,n\xef\xd4\xe7M\xee\\\xb0z\x87ۑ\xd6\x7f\xb5؎\\w\xa60\xa8/\x1f\xbe\xd3l>\xa3\x03\xbf\x0ff36(T9P>Ii\x89\xc3%\xbd%1\xa8L\x9d=\x82\xeb\xee\xa0\xdbf\x89\x8e\x95\x17\xea\xddY\x8b9\x9c\fP!\xdd\\\x0e\xda? \xa4ݗ\x11*\xdd\xc5J\xa1\xb9\xde\x11l\xde\x1b\x90\x8al-v#\xb8\xb9\xf0\x1e\x92\x136y\xf6\xbd\x83\x95%p\xe0\xe2H\xe8{j\xe5d_\xcf\x1f\xeb\x1c\x7f;\xd0\xff\fK\xfd\xfd\xcf\xe1\xfd\xc6\xcb\xccp&]\"/\x9c\xdfǐ\v\xb6\xb0\xe8\t_\x8a\x92\x01z<Fv\xc3\xdd0\xb8\xf5\xa7\xf9-\xe3ڨ\xa2\x06\x8d\x81\xb9\xec`\xa7\xf2g\xb7\xa5]\xe6\v\a\xcd\xe1\x97_'\x87#\x92\xcbG֣|w\xfc\x16\xfb\xea\xaa\xf7R:<\x96FǷ\xc84\x87O\x9f\xf9\xbd3G&\x99.14\x87O\x9f'\xff\x1d\x00\x06\x95S\x17\xfb\x1f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc=]\x8f䶑\xef\xfd+\xea\xe6\x02lbLk\xb2w\x0fw\x98C\x10l\xc6\x1b\xa4\x91x=\xd8Y\xac\x1f\f?\xb0\xa5\xeanf$R&\xa9\x9e\xed3\xfc\xdf\x0fE\x91\xfa\xa4$vόc\xdf\xf4\x02\x86%\xb2\xc8*\xd6\x17\xab\x8a\xd4j\xbd^\xafX\xc9?\xa3\xd2\\\x8a[`%\xc7/\x06\x05\xfd\x9fN\x1e\xff['\\\xde\x1c߮\x1e\xb9\xc8n\xe1\xae\xd2F\x16\x1fQ\xcbJ\xa5\xf85\xee\xb8\xe0\x86K\xb1*а\x8c\x19v\xbb\x02`BH\xc3豦\xff\x05H\xa50J\xe69\xaa\xf5\x1eE\xf2Xmq[\xf1<Ce\x81\xfb\xa1\x8f\x7fL\xfe+\xf9\xe3\n Uh\xbb\x7f\xe2\x05jÊ\xf2\x16D\x95\xe7+\x00\xc1\n\xbc\x05\x85\xdaH\x85:9b\x8eJ&\\\xaet\x89)\r\xb6W\xb2*o\xa1}Q\xf7q\x13\xa9\x91\xf8Xw\xb7Or\xae\xcd\u07fbO\xff\xc1\xb5\xb1oʼR,o\a\xb3\x0f5\x17\xfb*g\xaay\xbc\x02Щ,\xf1\x16>\xb0\x02u\xc9R\xccV\x00\x0e';\xec\xda\xcd\xfa\xf8\xb6\x06\x91\x1e\xb0\xb0t\xa2\xff\x93%\x8aw\xf7\x9b\xcf\xff\xf9\xd0{\f\x90\xa1N\x15/\x89\f\xcd܀k`\xf0\xd9\xe2F\x13\xb0\x8b\x00\xe6\xc0\f(,\x15j\x14F\x839 \xb0\xb2\xccyj\x89\xd8@\x04\x90\xbb\xa6\x97\x86\x9d\x92E\vm\xcb\xd2Ǫ\x04#\x81\x81aj\x8f\x06\xfe^mQ\t4\xa8!\xcd+mP%\r\xacR\xc9\x12\x95ឰ\xf5\xaf\xc3G\x9d\xa7\x03\\\xde\x10\xbau+Ȉ\x81\xb0\x9e\xb2#\x19f\x8eB4[s\xe0\xbaEm\x88\x8eC\x89\t\x90\xdb\x7fbj\x12x@E`@\x1fd\x95g\xc4wGTD\x9cT\xee\x05\xff\xdf\x06\xb6&DiМ\x19t\xeb\xdd\xfe\xb80\xa8\x04\xcb\xe1\xc8\xf2\n\xaf\x81\x89\f\nv\x02\x854\nT\xa2\x03\xcf6\xd1\t|c\x97G\xec\xe4-\x1c\x8c)\xf5\xed\xcd͞\x1b/?\xa9,\x8aJps\xba\xb1\xa2\xc0\xb7\x95\x91J\xdfdx\xc4\xfcF\xf3\xfd\x9a\xa9\xf4\xc0\r\xa6\xa6Rx\xc3J\xbe\xb6S\x17\x84\xb0N\x8a\xecߛe{ӛ\xab9\x11\xe7i\xa3\xb8\xd8w^X6\x9fY\x01b\xf8\x9a\x97\xea\xae5\xa2-\xa1\xb9\xd8\xdb%\xf9\xf8\xfe\xe1S\x97ϸ\xee\x01\x05G\xf7\xb6\xa3n\x97\x80\b\xc6\xc5\x0e\x95\xedWs\x1b\xc1D\x91\x95\x92\vc\aHs\x8ebH~]m\vnh\xdd\x7f\xacP\x13C\xcb\x04\xee\xacR\x81-BUf\xcc`\x96\xc0F\xc0\x1d+0\xbfc\x1a_}\x01\x88\xd2zM\x84\x8d[\x82\xae>l\xff\xea\xc65\xd5:/\xbc\xf2\x9aX/'\xfd\x0f%\xa6=\x89\xa1n|\xe7\xc4\x1cvR\xf5\x94\x03)\xb3V`\xa7\x85\x96~\xb5\xf4\x93\x06\x1b\xbe\x19L\xe5/MC\xe2\x1fZ\xc2J\xf0\x1f+\xb4*\xae\x96X\x1c\xa9\x94\x11H\xf0\xf3\xb3lџ\xe4\fM\xe9_\xcaD\x8a\xf9\xc2,\xefl\xa3\x0e\x03\x91\x86\xa4\x99\xf9a\xb7\b\xdaȲ$6z矎`B\xadZ\x0fL\x03\xf1\x1e\xd9:}\xc0\fNhlw\xedz\x92\xb8p\x83\x85\xbev\xd3\xd3@\xfc[\xca,\x00\xf2(\xf3\xaahf\xa2\xadrA\x91i\xe0\xc2.j=w̠<X\xbe\xde\x10`\xdf<\x83-\ue9a6\x8an\xf4\xbc\xe6\a\xa6\x10r\xdc\x19\x02\\\xe6,\xc5):o\xa5̑\r\x15+~I\xf3*\xc3̎\xbf@\xef\xf7ݶN\xb1\xe4<\xb5\fQ2C\xdaT_{\x045q\xcaN\xaab\x04\x13\x80i؈\x0e\xack\xcfR\x96\xbc\xf5r\x10^\xb4\x1c\xdc5\xf4p\x1d\x89\xc6X\xf20\x06\xb3l\x06\xd6\xe1`\xdb\x1co\xc1\xa8jL\xf0\xba/S\x8a\x9d&\b\xd7\xf8\x03\xb1\xd4k;\x90\xe12\x8c\v\"$9(D\x05Ѿ%2\x8c@\xc2o\x860\xde{\x8c\xa5K\xd3~\xc0Y\x8da\xb2\xa4i\x98c\x04\x14~\xe5T\xe1\xdap\xb1\xf7X\xde˜\xa7\xa7E҄:y\x83\x80\xba\x8b!l\xf1\xc0\x8e\\Vj\x04\x13\xac\xd1 \xe1yl}\xbd\xd6\xdeK\xd86P\xb2\xcb0\x0eRk\xc71\xcf\x1e0ǔ\x8c\xf0\x18U\x96evS\xc1\xf2\xfbI\x8b\xb50Ā^\x7f\xed\x8d\b\x05+[<[\xcdDJ\xa9y\xfc}b\xb7\x12?\\\x87\x06\x965\x0e\xa0\x1b\x90\x8d\x89\xb1\xec\xe3Ֆ\a\x06E\xa5\r\x14̤\x87\xb0=\xec\x10\xfa\x1at\x95\x1eH\x15j\xc3L\xa5\x13k\b\xfe\xf4\xb1\x12\x82\f\x8dT\x8do\x91\x10\xe3\xff۟2ܱ*7cN\x86\x1aom5C=U\xcc`{\xa2yr\x05%3\a\x8f:\xcd\xda\xdb\x1a\xb9\x03i\x0e\xa8\x9a\xd9밲\x11o\xc8&\xe6\x06\xad]:\xd5$\xd1\xc9e\x8c2\xf2\x89\xe8\xdfA\xcaG};\xbf\xb4\x7f\xa36\xad\a\n\xa9ݡz\xa6\xf7+\xe36\x04[\x04\xfc\x82ie0d\x9a\xb3J9\n\x97R\x1b\xbf c\x84\xa6\xfd(\xe7\xdaL\xa9\xb7Y\xf52\xe5\xf6y\x19'D{.\xa0\x14Hs-ȡi\xdb*Y\xd5mC\xab\xe6(\x1e\xa6\bl\x99\xc6\f\xa4ӏU\x8eڍ\x95Y=\xd1Z\xa0\x90L\f\x90\xafwM9\xdbb\xdeHɘ\x921\U0010cdea\x13t\f\xd8\u05fe\xa2l\x11\x9b\x01i\xa5\xfe\xe9\xc0I\x82iCC\xbci\x15.d\x12k\a\x916ݧ)$\x17\xd7>B\xa7E\xcbT\x8c\xe1\x19\xd3\xd6s\xda\xf9\xa4mz\x8eM\x90{n\xe4\fL\xf8\x7fJX.\x86\x9c\x17M\xd9ͨ\xeb\xcb2-\xf1*G\x9d\xc0f\aX\x94\xe6t\r\xdc\xf8\xa7K\x10Y\x9ew\xc6\xff\r/\xcc\xf9\x1c\xbf\x19\xf6|Q\x8e\x9f]\x95%\x88\xb4*\xcd\xf0\xbf\xc1E\xb1\xc6\xc2;i\xd1\v\xf2\x8fn\xafk\xe0\xbbfA\xb2k\xe7\x9e\fV\xe6Y\xf2\xf2\x12Ĉ\xb1w\xf4\xb3\xfe\xe2\xfb/\x14\xd8m\x82\xc9\x00\x91t\x19v\x06\xde\xddM\xf6\r\xf3\x02\\2\xeb?V\\aA\xf1\xe5\x04>\x1d\xb0\xf7\xc4\xfa\x97\xef>|\x8d\xd9\x1c\xd7Er\xde\b\x91w\x83\xc9v\x87v;\xc2X4\x9c\xeb\xd3\xec\xaem\xd8S_\x03\x83G<\xd5\x1e\v\x05\x93KT\x8c\x06\x9a\xd8g\x0f\x7f\nm\x14\xd92\xd9#\x9e,\x18\x17\x16^\xec\x1d\xcb\n.\xae\x8b\x81\x8d\xe1\"\x01iN.XWS\x92\x1e4\xfb\x95h\x1epJ\xa6\xd1EKk}\x96\"\xf1?O\xfb\v\xd0l\x96\xad\x8dF\xd7\v\xfb\x86\xf6zuPL\x1fx\x19\x05\xd9\x1aN\xe2,+->\xc8\xff\x99\xe5<k\xe6X\xef\xab6\xe2z\x15\x05\x10>H\xb3\x11\xd7\xf5\u07bd\x8e\xfb}-Q\x7f\x90\xc6>y\x15r\xd6\x13\xbf\x80\x98uG+^\x14IT\xecDt\xe8f\v\"\x98\xbb\xfe\xb7\xd9Y>k\x96\x87S\x8c\x8f6.\x8e\x1e\xf4\xd2\r7o\x1f\xfa\x7fvG\xbd\xa5P\xa0X[S\x99\x84F\xb2\xa4ի\bx\x94KR\xbd\x15\x19O\xad\x19\xb4\x1e0\x12\xec'\xf2\xbc,jDO\x856\x18\x9b\xf9ݦ\xcd\xc10\x83{\x9eB\x81j\x8f\xabE\x80\xf6_I\xfa=n\n\x91Z\xf7\"\x0e\x8b3\xed\xfeϩ\xeeAr*\xf4[\x93\xe4F\xb4\xf2\x8b\xbd\xd8t&\xccp)F\xd6\xc4Z\xffc\x91\xba\xb1!\xad\x8bע'\xbd\x9d\x89\x11\xcb1\nx\x91\xfc\xfeDf\xce2\xf4\xcfP2\xae\"d\xf8\x9dMy\xe7\xd8\xeb\xeb\x82F\xddah\x04\xae\x81\xd6\xf7\xc8\xf2qRo\xfcG\nV\x00\xe6֫\xa0\xd9\r=\x96kx:H\x8d\xc4\buli\x11$\xd7p\xf5\x88\xa7\xab\xeb\x91\x1e\xb8ڈ\xab\xda\xc0\x9f\xadn\x1aoA\x8a\xfc\x04W\xb6\xef\xd5s\x9c\xa0HN\x8cj&\x82)\xbb\t\xb6\xe8\xa6\xed\xda|\x9dss\x93\xd53\xf9\x90bf\x7f\v\a\xec&\xe6s\xef{\xf4}\xd3@\xdckq\x8f\xebbX\x8dR\x15\x19\xb0\x9dA\xd5\xc9бf\a\x90\xac\x9e\xa5+{8\x04&\xdb\x04\xe8\x98\x0f!Z\x02\xcf\xc2\x04\x97\xbe\x8d\x99\xe29^#\xd1e\xa9\xcd\x00\xa3\xf7_:1F&l\xc0\xb4\x87\xc8K{\xb5\x94\x9bgÂ\x85\xa8\xa9\xde\xd5==O;@V̙\xdaW\xa4Xbm\x7f\x87\x87('\rO\xdc\x1c\xb8\x00\xe6Sq\xa8\x1cC\xb1\x89\xbcn\xe8G\x99\xe3-\xa2\xf0\xe4[T\r\xd1<x\xa6lv\x7f\x05\x176\xce\x7f\vo_ܾ7\xda\x12/\xf1\xe0\xef\x1aR7\v\xda<\xb0\x16'\n$\xd0\x02\xc1\xd3\x01\x15\xf6\xb8b\x1c\xf0&\x8f1\x12$\x85w;q\x05\x82[\xca썆\x1dW\xba\xd9QڙGB\xact,;\x9c\xb9\u0084\x1d\x15\xce\xc9\xca\\\xb0\x06\xef\xdbލ\x12 l\v\xf6\x85\x17U\x01\xac\x90\x950\xb1\x0e\xf5\x0e\f/\x9a\x82\x10\xb7\x02O\x8c\x9b&\xf1H\x9a\x91\xf6Z\xa9,\xca\x1cM\xac\xf7[\x97A\x10\xdd5\xcfP\xf9\x82%½\"f\x02\x06;\xc6\xf3*\x94\xbey\x01\x1aK\xf1^\xa9\x8bv\xa9\xdf\xd6=\x1bf\"\xe3\xfb\xd4'P\x14P\"\xc1\x81\x1d\x91\x02^\xdc\x00\x8a\x94օ\n\xafHe\xdb!\x1c1\xc4>T\xb95\xf5\x17\xa7\xe0釢*\xe2\b\xb0\xb6\x92\xcd\xc5lP\xac\xfd\xadᯌ篱l\xc4y\x8e\xb9/X\xba\xef\xda\u07bf\x88h4J%\x12d\x9d\xaf\xff\x88,;y\xf9\xa0ڛ\xa2\xa4J\x01\x921U\x89\xaeF|\x05\xc98g\x7f\xe7f\xb1\xd82\xd2]\xa6\x7fT\x8c|\xbb:kQ7\x82\xb7\xabɄ\x05\xf1\xaa\xde\x0e\r\xd0\x18:}\x01\x1bnz\x00\xc8\xf7\xf1\x8e3\x81nM\xd1\x19\x9e\xcf\x16\x81eT\x1bC{2k>\x9d\x1fMel\x8e\x18\xaf\xe6\xbaD\xad\xec%\xae\b\xc0\x97u[ײ\xb6AAu\xc4u%\x1e\x85|\x12k\xbb\xa7ԋ\xd1z\xff3\x17+\x8e_Ri\xf4\xd9+\x12n\xc7\xfe\xbe\x82R\x88^\xe6Ȇ\xcb\\\xb0\xa4\x86\xea\x8a\xfcՅ\xb3\x98\x1b\x7f\xa6\xb3\xcb9\xdeե\xf4~\xc3\x18\x10\x96\x81\xb4\a{u\xfc\x87\xa7\x03\xdaj\x1dW\xa3\xbf\xb6\xc7\x11BN\x84\xdf[6\xe5\xf1[l\xab\xe2\x88\x7f\xbc7eC\xe5\xbe2ȋ\x7f\xd8W\xa6\x04\xe05\xb8\n$\xaaԶҔ\xac\xce̍\xcd\x15\xa2r\x11_\x88\xba\x11˅\xa8\x83\x92\xd2PN\xd5\xe1<_\x18v#\\!+0qj\xd3\xe1\x10\x8cE\xf6\x17\xc7W\vK\x15\x86j\x13\xfe\x16~S\f\x16\x82)Ŏ節톕\xe5\xfa+\x8a_kL\x15\x1a}#\xf4ۛl\xbbN\x15f:i\x8f\xa6\x90\x89\xcb\xea\xb9҉\x860P2\x1f\xf0\x95m\xf9gx\xe2y\x962\x95\xe9\xff\xe9d\xfcm\xac\xab\xa1\xe1\x9bΛ\x00DGí\xad\x1c\xeff\xb6)_m\x01Xp~\x99\xeb\xc8\xda=\x1d\x06\xd1\x06\x85\xf9l\xeb\xa5\xefrƋ\x90:\x93\xbb\x96\x87\xefe\xa6\xdb \xdf\x10\x84\xbe\x86\xbb\x87\r\xd4\xff\xf3 X\xa9\x0f2\x18\x1e \xc4)\xac\xc3S;i̠*-\xec\xfe`\xc1\x19^OT\xa06\u074c\x94\xc9*\xdaj\xce\xea\xd9(\x99\n\xa9)>\xaa-\x89\x14\xab\x0e\x1f\xf5e\xaba\x00\xc7[F\xfaAF\x80\xfd\xa1\x91\xfa\x00Ґ\x1fZN\xea3ů\x85dg\xaa\xedN\x99H\x9f`^\xecc\xe85V\xc4]\x8ayH\xcd\x01\x02w\xec\xe2WE\xbe\x85Ҏ\xe9\x82\x0ero\x99-x=\xbeM\xfao\x8ct\xe5\x1d6V7\x82I\x156M\xe4\x8d6@\\d\xfcȳ\x8a\xe5=\x0e\f*'R\xa5\x82\xe7\xa1\xcc.\xcb\xdb\xfe=\x1a÷\x16\x01\x96'\xe7\xd2m~\x031L\x8b\x84\xda\fHxN\xedG/\x89\x91\xac\xa6R\x98\xe7%;&\xd9\xeb\x19\xd5\x1d\xf3\xe5\x18\xe7\xd4t\f+6&\x81.Wr\xc4\xec\xfd\x16\xaa6.\xa8\xd5\xf0U\x183Pa\xa1BcV\xce\xfd\xcfS-z\xfa\xb15\x18\x8b\xa5l\x91\x95\x17\xfd\x9a\x8ay\x90g\xd4[D\x11g\xb9\xb6\xa2G\x9a\x98\x8a\nW\xc1\xb0\x8a\xa9\x90Y\xac\xa3\bTH\xacά\xd3p\xa5*3u\x11\xb3\x10C5\x13\xf1\xd5\x10\xb3\xa0m\xa5\xc4r\rĬ\x1e:c\xad\xe7l\x9b\xff[\xdetN\xab\x9a\xc5:\x86gmJ#*\x15ΩOX\xa4X\x8f\xef\xe3k\x11\x9aZ\x83\x89qϭ@\xe8W\x18L\x00\x8d\xa9;\x98\xa8+\x98\x808[m\x10[M0\x01{\xc1\xec\xcer\xc9\xcc\xcb\xc6\xeb\xfe\x86\x95%\x17\xfb\xdbե\xfc1\xcb\x1b=\xbe\xf80\x18\xb3\xc7\x1c]縷\xad\b\rY\xdfV0n\xeb=f\xe0\xc2\xc8\x04މ\xd3\b\xae=\xb7\x11\x80靺\x96\xcfJ\xda\t\xe7\xddsZ\x16l\x17\x94\x8b+\xe8ph\x89\x1a&\xcfY\x94\x8fU\x1e\xa2\xf9,Qm\x1f+\x19\x84\xc5\x18\xfbɴ\xaa?\xbbMU\xa2V\x82).L\xd9\x04%H\xac\xc24\xb7l|z\xa3,\x8dd~\f\x1e\xb2b{r\xd3\xcc\xe0\f\xc3`\xf3b]\xf7Φ\x87\x98\xe3Hŗt\xf2?\xe8\x95;YS\xa8\xab\xdc\xe6;\x8a\x9a\xadH\xee:\xd1\xed!\x81\x12xO\xd7\x01\x04\r\x19˕ͥp1\xa2+\x18\xf6\x88P*L1C\x91\xba;\"h\xc2u>ؒ,\xac\x1eU\x95[t\xc2\xf9\xdfI\x83\xb5\xb8̴\xcazb\x99'=爥\r\xba\xbeKn\xaf\x83\x17~9@\xe6ލm\xe7\xe2\x9c\xf2\xa7\x83\xcc\xdb\x1a\xa5\x11B\xc9j҉1oH\x8d\xecs\xb9m\x90\xe2\u0095\xf4_}uՌB1;M\xf7\x03\x88zC\x9e\x1e\x98b\xa9\x99\x8b\x99\xd3n\xe0\xea\xcf\x1d\x10\xbe4\xae\xe9k#zT\xc4c\xef\x88\x01ll\xca$LN\xe5N{\xfcB\x1c\xa1\xd1$\x97\x9a\\\x85{\xfc\x12E\xeez\xb8q\xf8\xd8/\x03\xd7A\f\xe6g\x16\x8eܺ\x16\x96\xa1\xa2\xe6\xf6\xc96\xf5\x1b J]Re\xbdW\xacCΜ\x80\xe8T-\\\xfd\ue9f7?_]\xd3\x7f\xff\xe3\xe7++\xa0Z\xd2\xe9FR\b\x8d\xdfI\xb1\x037ڗ\xb0\x8c4rҜ\x9d\xf5\x9c\xf5FC\xcaJ\xba\xb2\x05lHX\xdb\xf5ߞ\xec\xed\x0fM\xe0t\x12(\x85\xdc\xfb\xacZ\xab\x91\xab\xdf\xfd\xf4ǟ\xafڙ\xd5\xc20\x14\x82I\xb05\xf6\x7f\xb5\x05\x00\x8c\x8a*\xbaS\x86\xab\xaf:\x90\x1dE-\xa5֙\xba\x9a\x84ɲ\x8c\xd8\xe2\x8a\x1a\x81\xaev;\xfe\x85\xcc\x00\x1eQuB\xdf\x17r\xef\x9c\xe3\xbc\xf6\x13\x0f\xbe\xab\xe7\x1fx5cV\x9f\x11Œ\xaa\x17\x81\n\xe8\xbe\x1e7\x7f;h\xdeM\xd5\xceG\xb4Fp\xa1\xe6\x86\xcb\"Z\x05Y\xc42\xe8V\x97J\x1e\xb95\x8d\a<5\x1e\xce?%\x17-\xb3\x7f\xfb\xb1\xf1x\x93Ap.\x98\x96x\xc2<\xa7\xd3\xeb#\xf4\xd3\xfa\n\x9fT\xae\x91\xf6\x95d\xf2\xbdyw7\xb5\\[\xaf8\x00\xd3\x1eu\xb6Z\xa0\xa0KN\xc8\r#\xfby\xa1\xf9\x1cŜ\xacΫ\x9f\xfdX\x11K\xcb#\xaa6\bфX\xc3\f\xfe\xa9\xf1;@\xeez[\x12\x92\xe6Q,\xae\xf5\xe1ᝨ#\xb9A\xb0\x8396V\xa7\x8d?\xd2\xdd5\xc4\xcc\x13M\x83P\x85lz_`ׇȄ[\r\xc8\xfd\xe2\xd1\xc8\xf3\xe3\x913\x9c\x11\xc3\x1f\x17\xc6$/\x8fJ\u0380\x8c=a\xb6\xb4\x94Q\xb1\xc9\x01a^0:\xb9\x14\x9f\\\xb4\x1a\xfe\xe7ix\x06\x1a\xb1Q\xcaՋ\x9d\x10;#Ny^\xa42\x9aL1'\xc1zDz\xa9x\xe5+F,_#fyY\xd4r\x01\xe4\xe0\x84\xd7r\xdcrQ_\x9d\xb5\xf6s>M\xfb7\xe7\x86-G0\xa3\xcebͺeq3\xed\x98ש\x89\x9e\x13ˌ\xa2aO.^.\x9e\xf9J\x11\xcd\u05c8i\xbenTs1\xae\xb9\xc89\xaf\xe3\xef\xfb\x8a\xc1\x0f2\xc3{\xa9L\x80\x8bz\xacq?l\x1f\xd8fw\u00922\xcf@\xf8\xa6#\xc8Pg\xa7\x9d\x1f\x7f\x19R\xe1m\xb9\x1b\xff\xfe\xf3\x12>\xeel\xd3\xfd\xe7\x05D(%\xee#\xa6#\x88\x00\xd4\xdf\xe2\xa2]\xd1\r\xfc\xfeș\xbb\xffSV\x99ۄ\xa8?\xbc\x06\x96\x0f\xf6\x0e\xad8D\xeb\xb6=\\\xe9&\x88\xb6\xc2\xe2\t}霃>\x02K7\f\xd0ݒ\x16\x90-0\xb5\xf5\x1aTK\x00B\xfe\xb2\x85\x03\x91\xd7\xfa\\|\xa1OM\x9e Lگ\xd2\x1d1\xb2\xad\xa5n钬\xce6x\x8bJz\x81P\xf3r\x1eY\xe0\x13Q\xe4\xf3\x1cb\x05\b5u\rL\xccU/\xffRz\xce\xe8c\xba\a\x9c\x94\xda\xedj\x96\xb6\x1f]\xb3\xc0%\xae\xfe.\xd06;`\xef\v\x0f\xea\x9e-B\x86t\xae(k-Wݟ\x9b\xda\r\xa3@\aA\xa2\x8b\xa2)\x10\"\xdd\xedx\xb4\x04\xb8\x9a<y\xd4\x19\x9e\xeeͳ\t\x01\r\xb8\xdb\xd1}\xc8R\xa4\xbd\x16\xf6 \xa0\xbf>69O\x8d9:|+\xe8PL\xa50\x92nM\xfb\x90\xea\x0e\x11q\x04\x16<Y\xc9c\xf7\xa4j+\x87i\x1d\x89v\xb4F|\xd7ÖN^\xe9p\x1dlɔ\xe1,\xcfO\xf6x\x96>\x8f\x16t/xV\xe5\x18qO\xf1C\xa7\xe9\xf2M\xc5\x1e\xf0\b&t\xcd\\S\xaf\xe8)\xeax\xaa\x7f'\xb2\x93W\a\x99\xd4`\x00j\x17\xa4\x9dHQ_8\x98R\x84AWi\x8aZ\xef\xaa\xdcY\xfff!\\\xf3\xe0a.\x8fC\xb2:C\xd8\xc9]\xe1\x91w\x8e>t\xdbv\xb8\x8aί\x11\n\xaeD}sO;\xc6\frɈ;r\xba\x928\xc4\t\x1a\r\xe5\xe9l\xdaԁ\xd6.4\xef\xce\xca6\x86\xb3\v\xd9\xc6\xee\x99\xd6|/\x82\x02?\x1a\xba\x1d\xa8\v\x9c\x02\x94u\xcc\x13\x15\xb6e\xbe\xc9\xeaLE8o\x81]\xd5\xf7\xe6~\x8a\xc0#\"7\xa8\x8e\xc8\xdc\x15ޖ A\x88\x10\xa0\xa9'\x99\x8f꺩\xd57%\xb8\xfa}\x9b\xbe\xf0No\x88\xb8MU\xa5qy^\x8a\xe7r\x01\x95F\xbf\x7fq)\x05\a>Y\x9dwrp\r\x1f\xddL'^{\x17{\xb3{wd\xdc\xfaF\xab\v\f\x1cq\xc8_\x1c\x83\xbck\xbeVᲩ\xb7\xab\xe7\xee*\x17F\x1f\xac\xf9?\xe6&Ӧu\x1f\xf1d\x85\x85\xe6>1n\xc3\xf3\x9d/p\f2\xeb\xee\xbb\a\x16\x98_E/\x14\x13P\x99\xben\x13\xf2\xe1\xc9:n\xe5\x1a>b\xc1\xcadfM&6iSk\x12-9\xb3\x13\xeb\x8a\x11\xa3\x14q&EH\xdb7\x99\x96fs\x02~w2\xd0*]\n\xcb]\x8f,\x13p\xbd@&\xf0w\xc4r xn\t\xecJ\x17!\xe54\x01\xb4*\x13x0\x8a\x97\xa0\xb0\x90G\xf2\xcb\x0ft\x93\xaf]\x06P\xe8ja\x0eX\x00KS\xa92w\x14t\x96\xe7&\xc6\"\xd5\xda\x19\x05\xa4\xe8ܺM\x97\x01S\xc9\x05f\xe7\xcb<\xd1c\xe2\x95\xc5m\xe2\x9d\xc5qu\x81\xfcy\x15\xd7%\xc2\xe6>\x82\xc7\xee\x83\x1d'\x94t\x97\x9f7\xf7A\xe00d\x9dVk\xf3\xf6\xce\x7f\xebs\xc4\x1e\xb4:\xcby\x0f\xbbY\xb3\x92\xfa\x84[:\"\xeeC\x03\x13\xd2٣\xdaw\x81.}\x9a\x89:n\xce\xc8N\xbb\xd6w\xf6pS\xa5\xac.\f^8\xfcMe\xa6;X\x17\xe4\xdd\xfdf³\xf8\x1aw\xa8T\xb8\x94hN.\xeb\x1b@\xf2\xdc]\x90]\xef$\xec1\xfc\xde5\x1f\x01\xa8~\xf3A\xb7\xe4\xac];{\xbe\xd8\xf5ov\a\x9e\x05\xeasܮe\xc8\xe1C\x96\x1e\x9a\xa4(\xdd\x16.\xa9\xb6\xc1δ\xc3F\xe4U\xeePQ\x91\x92\x1b\xa9.j\xf2\x9fa\xd1\xd73\x93\xa5\xd1\x1d\xd2qs\xdf\x14\x05f<\xbcq\xf2\xa8\x10䢛\xfc\x95*\vy\na\x8d\xb1n\xd6.\xf0\xaaK\xba\xc0\xeb\xe9\xd9M*\x8cpP|\xed\xac\xe9\x87a\xa9߄\xe0\xe8@\x10\xaa'\"\xfd\x00\x94+*qw\xa0TJ\xd9]A\xfd\x8e\xbew\xe4\xb7-\x8e\x80\xab8\x87ԝ\xf0\xed}Vk^p\xef\xc6=짔T\xe6\nt\xe8\x02\x83\xeeƏ\xfc\xa2\xd0G\x9a\xe8\xf7\xc4ts\xc88K:\xb0\xeb{\x10\xac\xbe#\x03\x85\x19\xe0\x11\x05\x15\xea\xd0\x16\x11\x9bX[H\xd7Q\x1e\x98\xf60\xa8\xde\xe8\x06\x0eU\x06\xd8s\x99\x0f\x86)\xd3L},Ct\xdf?3\xb7@\xdf\x13ZS\xefՙ\x9at\xc6\xce\xd8+8\xf4\x02\x81\xedU .\x8d`\xef\xef y\xa6؎\xed\r\x05j\xcd\xf6\xde\xc8Zg`\x8f\x82H\x1cT1.\x19\xd5ށ\"w\xddձՙ\xc0RC\xf5\"v\x80zK\xd4T\xb3\x06@\xba\xef;Q\x13\xb6\x9f\xdcZrap?\xf2{\xdc\xfd+\xe4\xd0K\xb1@\b\x17\xab\xa8ۺ\x9c\xa3\x9d\xa2\xbb\xb0\x95\xd95%V\xa3O2\xb5\xaa|\x04\xd5n\xd8i\xe4\xe4\x9cŲ_XX\x98\xe2=\xb5\U0006112eP6\xc1\x04'\xc4ъ\xec\x03>\x05\x9e\x12)0\xf3\xf60 Jk؈{%\xf7TN\x11x\xe9\x04+\xa8\x1f\xef}\xf4\xa5\x1e$\xd0b\xf2\x85\xff,\xd1Ydu\xb3\\\xa2\xack\xd6f\x91\xb8\xa8E\x93\x98\x98m\xe9\xea\x84\x0e\x1f\xbf\xd1\xee\x02\xa7\xb0\x9e)\x1d\xb4\x84r\xee裆\xbc\x0f\x94ӽ\\ڬq\xb7\x93\x8a\xe2u\xf9\t\xd6k\xbaK\xa7V\xad\x01\xb8\xc4T6\xf6Z\x7f\x7f\x8c\x02\xb2>\xfb\xebg\xd6\x1c\x06W\x96\x8f\xed\x8d\xea\x05\xa3\xcbX\x80\v\x96\xa6\xe4\xcd\xe0\x8d6,\x14\xa5yV\xa0\xc1:\"\x8e\xff&\x12\xb8=\x92o\xba\xed=S\x8b\xaaآ\"n\xf6\xc1A\xfb\xf1\xab\xa3W\x1a\xc1\xca,\xfa\xd7\xf3}@KرP\xc4g^]\xd0\xcfH\xc3\xf2\x89s\xfe#\x1c>5\x8d=\x02\xb6\xfb\x18\x8d\xdegl\x92\xd5TE\x11\u05fe+\xadYz`bO\xec\xa3d\xb5?x\x16\x9cҭ\x13@\xb3\x8a&\x05e^퉭-A\x15\x9aJ\x89N8\xc1\xd5\xfdd\xedt\xe7\x80Γ\xf0\x05\xe3\xeeK\xc2\xe8\xdbɩ\x12\x10\x87ŵ\xbb\xa1\x8a\xec\x7f'f\xfc\xc2\x02\x10\xe5܌\x90\xbd\x1b\xf7\x9app\x1c\xb6A\x90C\xdf&\xd8h\xd9\u05c8\xa0\xc1\x82\xbe\x9d\xf3;\xa6|\x0f*\xc3ӽ\x8c\x80\xbd \x9dr\x9c\x14\xdc\xeb\a\xfaC{\x05\xbf_x:\x9c~\x85\x89\xa8E7\xe4ٮH\x10b+n\xd3\xfeH\x04\xfavI\xbe\xae\xf3W\x11S\xdft\x9aO)u\x9fJ\xa8\xc3\xc0A\x98\x8d\xe8v\xfcN\x97DK.\xd0I\x0e\x8d\x8f.\xc3\x16\x8b\x87o?\x85H\x93\xbc;\x0f\x91\x89D_(\xadw\x11\xae\x13\xae\xe4\x05\xee\xa4\xe7\xa0\xf0<\xc2N\xe5\xa2\x7f\xb8\xe4#\xc6\xf9\x89\xb3\xbeb\x04_\xeb\xde\xc6,\x82Z\xfd\x9dܼ\x8e&m\x1c\x84\xe8\xc6\xfd\xd7j\xe8\x19\v\xbdD\x95\xf3)\x12\xb9-\xf7d\xf9\x15o\xa7\xdd\xe1?\xee.\xde\xd4\v\xd4i\xb7O\xdd-vs\xfb\nm\xb1[\x88\xcef\x8e \x02\xfc\x9e\xef\xfc\x97\xb4\xb79\xfea\x15m\xdafY \x8a\n!s\xf6\xc4\x14}\x02p\t\xf9\xef\\\xb3@\\\xc1A\bD\x16F \xa1\x8d5\xf8\x8dNTd\xc1Or\xe2\"$\xbf\xe5\xf0\xdf\xec\xbe$\xb6\x10\x94\xa1\xd1C\x1b\x17\xca:Dv#\xb9'mL\x8e\xa5)\x96\xc6\xddn\xd4\xfdN\xfc\xd5U\xefC\xf0\xf6\x7fS)\xea<\xa0\xbe\x85\xef\x7fXy\x84\xdc\a\xcd\xf5-|\xff\xc3\xea\xff\x06\x00Q\xa8\xc1\aT\x7f\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=Ks\x1b=r\xf7\xf9\x15]\xca\xc1I\x95H\xad+\x87\xa4x\xf3\xcaފ\x12\x7f\xb6\xcb\xd2\xfa\xb2\xb5\ap\xa6)\xe2\xd3\f0\v`(3[\xfb\xdfS\x8dǼ8\x0f\fE%_\xbe\x12GU6\x87@O\xa3\xbb\xd1\xe8\x170\xc9j\xb5JX\xc9\x7f\xa0\xd2\\\x8a\r\xb0\x92\xe3O\x83\x82\xbe\xe9\xf5ӿ\xeb5\x977\x87\xf7\xc9\x13\x17\xd9\x06n+md\xf1\x1d\xb5\xacT\x8a\x1fq\xc7\x057\\\x8a\xa4@\xc32f\xd8&\x01`BH\xc3趦\xaf\x00\xa9\x14F\xc9<G\xb5zD\xb1~\xaa\xb6\xb8\xadx\x9e\xa1\xb2\xc0ã\x0f\x7fX\xff\xdb\xfa\x0f\t@\xaa\xd0v\x7f\xe0\x05jÊr\x03\xa2\xca\xf3\x04@\xb0\x027\xa0\xd3=fU\x8ez}\xc0\x1c\x95\\s\x99\xe8\x12Szڣ\x92U\xb9\x81\xe6\a\xd7\xc9c\xe2Fq\xef\xfb\xdb[9\xd7\xe6\xbf:\xb7?sm\xecOe^)\x96\xb7\x9eg\xefj.\x1e\xab\x9c\xa9\xe6~\x02\xa0SY\xe2\x06\xbe\xb0\x02u\xc9R\xcc\x12\x00?0\xfb\xe8\x15\xb0,\xb3\xa4b\xf97ŅAu+\xf3\xaa\b$ZA\x86:U\xbc\xa4&\x1b\xb87\xccT\x1a\xe4\x0e\xcc\x1e\xdbϡ\xebW-\xc57f\xf6\x1bXk\xdbn]\xee\x99\x0e\xbf\xd2h\x03\x00\x7f\xcb\x1c\t7m\x14\x17\x8fCO\xfb\x00\xb7J\n\xc0\x9f\xa5BM(Cf9+\x1e\xe1y\x8f\x02\x8c\x04U\t\x8b\xca\x1fY\xfaT\x95\x03\x88\x94\x98\xae{xzL\xba7\xe7py\xd8#\xe4L\x1b0\xbc@`\xfe\x81\xf0̴\xc5a'\x15\x98=\xd7\xf34! \x1dl\x1d:\x9f\xfb\xb7\x1dB\x193\xe8\xd1i\x81\nR\xbd>\x91\xc8\x0e\xcc\x0f\x8f8\f\xcc=\xf2\xf0\xde~!\x8c\v;A\xe8\x9b,Q|\xf8v\xf7\xe3_\xef;\xb7\xa1K\x8d \x92\xc050\xf8a\x85\x1a\x94\x9f~`\xf6̀B\xe2\x1a\nC-J\x85\xab@\x99\xac\x06\t \x15\x94\xa8\xb8\xccx\x1a(j;뽬\xf2\f\xb6H\xc4]\xd7\x1dJ%KT\x86\x87i㮖\x9ah\xdd\xeda\xfc\x8e\x06\xe5Z9)Bm\x05\xc7O\x06\xcc,\xe7\n\xe6d\x9b\xeb\x06\x7f;\xe5;\x80\x81\x1a1\x01r\xfb+\xa6f\r\xf7\xa8\bL\xc0:\x95\u200a(\x90\xcaG\xc1\xff\xbb\x86\xadIb\xe9\xa193\xe8\xe7rs\xd9\xc9'X\x0e\a\x96Wx\rLdP\xb0#(\xa4\xa7@%Z\xf0l\x13\xbd\x86_\xa4B\xe0b'7\xb07\xa6ԛ\x9b\x9bGn\x82zLeQT\x82\x9b\xe3\x8d\xd5t|[\x19\xa9\xf4M\x86\a\xcco4\x7f\\1\x95\xee\xb9\xc1\xd4T\noX\xc9W\x16uA\x03\xd6\xeb\"\xfb\xa7\xc0Q\xfd\xae\x83\xeb\xc9\\q\x7fV\x89Mp\x80\xb4\x99\x13\x18\xd7\xd5\r\xb4!4\x17\x8f\x96%\xdf?\xdd?\xb4\x85\x89\a}\x11>\x8e\xeeMGݰ\x80\b\xc6\xc5\x0e\xfdl\xdc)YX\x98(\xb2Rra\xec\x974\xe7(\xfa\xe4\xd7ն\xe0\x86\xf8\xfe\xb7\n\xb5!^\xad\xe1֮\x19$\x87UI\xb3'[Ý\x80[V`~\xcb4\xbe:\x03\x88\xd2zE\x84\x8dcA{\xb9k>\x04e\xe3\xa9\xd6\xfa!,M#\xfc\ns\xfc\xbeĴ3e\xa8\x1f\xdf\xf1\xd4N\f\xab\xf9j\x15\xd0\xd3~S\xb36\xa8\x1ej\u07bf?\x82\x89\x13\x9e\xd85\xe1\x04&x\x15\xb3Nz\xb7ǨI\x97\xc1\xa2\xa4\xe9:\x83\xe2\x83oF(\x92\x88e\xb5\t\x12\x16ˠޤ\xd7jp\xa2T\xe8\x8fZ\x96J\x1ex\x86\xd905\xa7)JW\xcaD\x8a\xf9\xd0/=\xa4omÖē\xea%\f\xb6\x0e\xd7-\x826\xb2,1[\xc3\a\x7fs\x10*\xe1\xcd\f\xec\x99\x06\x9a.4t\xbd\xc7\f\x8eh,\x04\r\xa5\x92)\xad\xe1\xe2\x11\xb8\xc1B_{,5p\xa3\x93A\x90P\xca\f\x0ed\x8c\x04\x84\xf45(,\xe4!ȡ`\xa5\xdeKC0\xec\xb3\r{Ba\xf5&\x8al\f*w\x06\x83\x1b;f`m\x94S\x91h\xc4b+e\x8el\x88Y\xa9\xe6\xf7\x1e\aZ\x80eeb\x88~\x7f\xd7\xeb\x14&\x94\x1f\x9650*\x8d\x19\xc9\xca3\xe3}E\x15>4\xf1n\xef\xef\xe0\x87%Q\x80\t\xce\xf4\x02S)A:\f\xbe#ˎ\x0f\xf2\xcf\x1a!\xab\xac\xda\rF\xc3\xf5\b\xe0-\xeehYQH0\xa8\x03*E\x93\\[\xdbGVfm\xad\xa1\fw\xacʍ\xd7\xe2\\\xc3\xfb?@\xc1Ee&\xa998\xc9\xe8σs\xa3\xd1\x0f\xf2;j\xc3{\xfai\x90\xa0\x1f\a;\xb6\x88\xfa\xbcG\xb3GEK\x8a\xfd\xc1\xae҃p\x01\xb6\r\xe9I\x9a\x80yѣY\xcc\xf2\xbc%\x93\x1a\xb6ǀ\xf4y\xe2\x83?Ӽ\xca0\xab\x8ds\x1d1\xdaO'\x9d\xac\x1bø \xddHN\x03\xa1*\xea_\xa7\xe6+Sh\xe7+\x17\x0ef\x98\x1b\xdb\x115I\x97\x9d\xbd\xc3xβ\x18\xac\xbbĶ9n\xc0\xa8\n\x93q\x18L)v\x9c\xa0Yp\xf5\x96\x90\xac\xee㍐\x9c\xa7HĪM\rK5K\x9aA\xa0\xf0\xff\x91`{)\x9fb\x88\xf4\x1fԮ1\xa9 \xb5\x1e5lq\xcf\x0e\\*ݷ\xcb\xf1'\xa6\x95\xe9\x18\xf3\xed\x8b\x19\xc8\xf8n\x87\n\x85q*\xb6\xf6\x1a\xa7\x885\xbd\xb0\xd1\x15\x985ڠ7\xae\x86\xe9\xc4<K\x8d\xb1\xa1X\x03b\x14*X.\x93:\xacJ\xe0\"\xe3\a\x9eU,\a.\xb4\xa1\xb5Ď\x8f\xd5\xf8\r\x8foV N\xf0wfC\x18\x05q\xa9c\x8fI\x81\xe4D\x15R\r\vG\xf8\x9c\x82\x19\xe5(l\x19->r̈j>\x8ab\x1d\x1e\x95\xcc\x1a\x82\x8d\u07b9n8\xe5\\\x99\x9cm1\a\x8d9\xa6F\xaaq\xf2\xc4\b\xc12\xfd9B\xd9\x01M\xda]\x88g\x95hs\xd1J\xbd\xe7\xe9\xdey\x1d$ev\xfd\x81L\xa23\x89XY\xe6ǩAGIF\xa4\xd2X\xa4>b\x15\xc9)݃4\x9dG\xf6\xbawk\xa5&\xaa\xd7b\xf3F\xf46ѹ\xe8K\xeb\"\xaaߝt\xbf\xbc\xb0\x13\xb99\xea5\xdc\xed\x00\x8b\xd2\x1c\xaf\xc90\xf7wc\xa0\x92\x81\xd5\xe0\xf1;c\xdcy\xb3\xe5\xae\xdf\xfb\xe2\xb3\xe5\"\\\xab\xd1\xf8\x9d0\xcd.V\xf7~\xadZİ\xcf\xed\x9e\xd7\xc0w5òk\xd8\xf1\xdcP\x94jna\xed\x18:\xb3\x9c\xbb$\x81b\xd7^\xba\nf\xd2\xfd\xa7:\x10\x13ѣG\xab>\x00\xe0m\x1f\xc6\xf2 \x02$\xd4F\x85\x8ddp\x85\x85\x8b\t\x92\x93ھc\xcd\xf7\x0f_>b6'\xa5\v$\xf5dP\x1fz\x96N\x1b\x05;\xc0(\x90\xadAY3\xad\xf6\U0006cded\xaf\x81\xc1\x13\x1e\x9de5\xe8\\\x0e]\xc4ZV\x83THq-+\x8c\x04˂\xf2q\xe5(xKD\xc5\a\x88\xf1\x18۴GT\xc2\xcfG\xd6\x1cu\xe9F\bWE\x83l\x11\xd5\xcf\x1d\n\xf2Fw_\xa0\x94\xfa\x14?s\xd85ÚP\xb7c\xfc;\x8aS\xe76\x00\xab\xf7\xbcL\x06\x00\x8d\\\xa4\xb0A\xa3\x9da!\x8b\xf0\x83\xe5<\xabq\xb5\x9e\xd2\x02\x88w\xe2\x1a\xbeHC\xff|\xfa\xc9)\x8eH\x92\xf4Q\xa2\xfe\"\x8d\xbd\xf3\xaa$v\x838\x93\xc0\xae\xb3\x9d\x96\xc2-\vD\x97E\xcfop\xb0\x86\x0fͦ\x9am\\S\xba@*O\x9f\x05\x10\t\x8cGΡUTڐ\xb3*\xa4X\xd9e:<m\x01\xd06^\x9eURu8u\xbd\x10\xe2 \x8a\x1e\xbd\a\xb2\x0e\x1d\xf2'\x19\x9c\xa9Ka\x99S\xa6:\x84+m\xba\x88\x19|\xe4)\x14\xa8\x1e\x11JZ7\xe2\x85j\x81&?[\n\xe3M\x8b\xf0\xf1\xcbB/c6v\xadh\xd6G\xb6\fl\x8ej>\x92\x1b\xba\xc4(\xed\xf2n\xed\xa1(\xea\xb7\v\x11\x96\xad,\v\xf9\xd5\xd1\x00-$iZ0(XI:\xe0ﴼZ\xf1\xfeG\x14\x0e%\xe3JS\x9a\x84r\x1c9\xb6\xfb\x87(a\xebQQ \t\x13\xae\x81\xe4\xe4\xc0r\n\xa4\x91\xf2\x16\x80\xb9\xb5g\b˾\x05u\x9dD\xc0\x85\xe7\xbd\xd4H\x02\x05;\x8eyF\xe3\xbez\xc2\xe3\xd5\xf5\x89\xf6\xba\xba\x13Wq0I\xe7\x9f(\xad\xdaj\x91\"?\u0095\xfd\xed\xcaf\x0f\x96L\x913\x8c\xb7\x05R\x1dݔ<\xd3M\xb2@\xb4\xc8U\x0fV\vu\xaeK\v\xc8e^'\x17\x92\xe9Rj\xb3\x99l\xd1C\xeb\x9b\xd4\xc6\x05\x00;\xe6\xf6@\x84p\x06\xaa\xf5\xfe|\xd4\x10\xd8Π\xa2d\x9f\ni|R\xbb\xbd\x009q\xbe.\b\x1a\xbf\x98jE#\x1d`\n\r\\5\x1a\xc2Em\xae\\~\x9f\xfe?\x0f3\xa5\x9eN\x8c|2r^\x94\"W\x8e\x0eyO\xe9X\ak\x99s\xdevQ\xaa9&\x94|\x9e)N\xa4\x8di\xd7\x1bا\x9f\xad\xb83\xa3\xb2,L\xa3D\xf9\x1c\x1c\xe9\xa2\xea\t\xd6/)\x89F\xf7\xd6\xf5\x0e\x13\xd0\x03\xb3^\x0eS\x8f\x95U*ѐۢ\xfe[3<\n.\ueb1c\xc2\xfbW3V $\x19\xf1\\W\xe66\xf4o\x18R\xdf\x10\v\rcJ\xc2>\xefQa\x87\xb3\xa7\x99\x8cxN\x01\x19\xd3\x142n\x05k\xfc\x93\xdei\xd8q\xa5k\x17\x1c\xe3\xec*/\x01\x1a*\xbd\x04\x913$@\x8aO\x94\x9f?\x93/_]\xefz\xe0\x14\xd0}\xf6\xe5<\xd1\x10\xa1!\xfe\x9e\x1d\x90\xa2^\xdc\x00\x8aTVT\xd4f\xbd+[D\xb0\x00\xa2c\xa2[L\"\xd7\xcc\xe6BQ\x15\xf1\x04YY\xe9\xe4b6:\xd6\\+\xf8\x13\xe3\xf9k\xb2\xd5\xd7Z\x9c\xc9\xd6PZ\x12\xf45\ts\xc1~\xf2\xa2*\x80\x15Ėh\xb8`\xed\x16*J\tE^\x8e\xd7T\x9ab\x93~\x04\x9bց\x05\x10\x8d\x84T\x16e\x8e\x06C\xb9I*\x85\xe6\x19\xd6\xe6\x83\xe7\xff`\x95\xd4\xd8\xc5`\xc7x^)\\\xbf\x1eg\x96\xfam^=E\xb5^`\xb6.Ade\x97\xae\xe4\x82O\x8f]?J\xb5\xccd\xfe\xa6\xf0\xf2\xa6i\xa98I\xa9\x9c\xb3NgaZ\xeb\xb5k\x9dz\xe1e\xe28f\x9e\xceB%+\xe1\xcd<}3O\xdf\xcc\xd37\xf3\xf4\xcd<}3O\xdf\xcc\xd37\xf3\xf4\xcd<\xfd_0Oc0\\\xd9¨\xe4\x85XE\x96`̡=\xf3,_it\x9bWڠ\xfa\x88%\x8a\fE:j\xd2\f\x15\x1a\rt\x1e\xa8\xa4\x1fO\x12\xa6\xae\xff\xca\xee;\xcdj\x1b\xd1\x17\x9bnY\xfa\x84\x19Te\xeb\x87\xcc>\nƷ#\xe8*\xdd\xdb\xcd\a{\x04\x8f\xdewI\x85\xa7r\a\xf4\x9f?R1\xaex\xd4u\n\xe2\xdeH\xc5\x1e\xf16gZ\x8f\x960\xc9\x1d|\xa3\xad\x7fڠ\xf0{\ans\xc6igJ\xbdXԵ[q\x8e\u009dqy\x8eP[@\xe5\xc4=\xba\x06\xbb\xdb\xc6\xde+\xa1qd\xeb@\x84\xc0\xcc\xed.\xe0\xc3\x0f^,\n\rʧr\xd0\xe5v2W\xc84@XR\xd0A\xb5\xdar\x86\x18R_\x8e:\xb3\xe5\x94sE\x94ݝ\x04u\xf1\xa2\xd5\x1ac\xa2gdx\xbc\x9f\xcb\xda\xe61\xda\x15x\xddJH\xeb\x15\x06\x8c\xd7\xc9b[~v\x11\x89&\xe8\x98n\nȝ!f\xd1\xdb2\xc6\xec=\xff\xec\x9e\xe0\xf4\x88\xd9\b\xe1o\x9e\x96\x11\xb5\x87\xe3\x15\x87\x8e\x86\xb4\r\xf4\xf0~\xdd\xfd\xc5H_\x7f8\b\x12\xe0\x99\x9b=ixa\x8f\x04\x10\x8f\xedM\x0eAN\x8d\x1c\xa4\xf1\bD\xda\x10\xc0s'\xcd\x01B\x87\xfc\xf0Վ\x81\xe5\xebsI9\xef\xb6\xf7S\xe4c\xedzT\xedw\xebF\xa4\xba%~\xf36\xc6\v*\x12'\xa5qy\xf5a\f\xd2~{\xd8t\xcd\xe1p5\xe1\f\xd4%\x95\x86\xb1\x11\x99\x88\xaa\xc2\xf8Z\xc28\xf2\xd0\x15_A8\xab2\xc2\x15(\xbah8\x17\xab\x11\x8c\xac\fl\xd5\xfb͂<\xb3\x1e0\x9a`q\xb5\x7f\x1drMU\xfc\xd5þ\xdb̀\x84\xc9:\xbf\xd3B\x18\xaaޛ\x059T\xdd\x17S\xb3\x17\x85kt\xa5^]\x7f7\v\xf6e\xf5y\xb3zm\xa1,\xcc-\xab\xe1\x13\xe7\xf5MW\xdbE\xd5\xd8Ey\x86\xf38\xb7\xaa\xc6\xc6Q^Z;\x17E\xd5μi\xa11V'W\xd7\xc0M<8\xaa:\xee\xb4\xf2m\x02\xe2|M\xdcx\xbd[\x12?\xbfm%\\D\x95\xdb\x04\xc8v\xfd\xdbb3`V\x9af\x1a\f\x9f\f\x12\xbf\xd6\xe6\xff\x17\x12\xf8\xd2AK\xd51\x81G\x10\xea\xc8\xf9\xd7^\x17\x12\x96`\xf5\r\x99Ճ\x10\xa11\xb6\xcf0\xabG@\xde\xed\xa0\xa8r\xc3˼uD\x88\xd9\xe3\x11\x9ey\x9e\x93W\xfd\xab\xb4\x1bq\xb7d\xce |\xfd^\v\xf0\x98XuFB1\x96g\xccs\xfa\xf7\x84\n\xa9;\b'\x95+\xa4Eh<)\xe2\x8fK\xf0g\x8a\\\xdb9\xe1v)S\xf1#\x16t\xeeG8ea\x9d,^\x18\xa6\x8d]\xab\x98\xac\xa4\xc2\xdf*TG\x90\aT\xb5U\x93\xccn\xb5\nSSWy\xa3J\xbcN\xa2\xa9\xdfW-\xa3\x10\x9b\t\r\x1f\x84[f\xfb\xb8ZX\xa8\xdb\xceє\xea$_h\f\x84\x905\x84\xe4|[\xba?\xb8\xf1\x96=6\\\xc8U\xba\x84\xb3\x14eVL\xcb\xd0y\x0e\xd3k\xb9LK\x9d\xa68V/؎\xd5!օ\\\xa7%\xceS\xe4J\xb1́\xea\r\xebb.ԫ8Qg\xbbQ\x8bH\x17\xbb\x8d\xaaC\xb8\x18gj\x16\"\xccm\x9b:\xb1\xb8\"@\x8en\x97\x1av\xa8\" v\\\xae(\x97*\x02\xe8\x89\xd3\xf5\xe2MO\x11\xfao\xb1lĸ)\xf1\xceU\xccf\xa6\xc8ML\xb3\xf6a<\xf6\xad\xa5~\n\xf9\xa5fn4\x9d;\xf3*\xdeٚ|\xf4\x87Wp\xb7\xcet\xb8&!Nm>\x9av\xb9&\xc1\x9el::Ü\x88\x90\xb0\xd9&/\xce\bH\x95\xa1\x9aM\xae,\x11\xcdY\xa1\xec\x88\xe3\xd7\xde\xf3[\x99\xc0\xc6mqX\xb6\x137cܑ\xf5\x99\b)Й\xa0\x8e7$\x84-\xfb\x82~\xb0Y\xb4\xc6\xf0\x19\x17\xa3\xc6\xda\xec%\x8d4\x96\x8c\xd4hF\x87\xb5\xd9R\a\xbd\x86O,\xdd\xd7\rG \xda'ә\x82;\xa9\nf\xe0\xaa\xce\xc6݄\x9et\xe7j\r\xf0'Y'Bk\xa8\xa3\x1b\xf14/\xca\xfcH\x9b\x02\xe0\xaa\v\xe8e\xa23*~ڟ\v\xf8\x8b<\xe0\xc7Q\xff\xbf\xc3\xee\xfb^\x97\x81\xc4/\xb1\x9d\xa2\tޫ\x1b\x84\t\x03\xa7\x13\x863\x1aC]\x8e\x17\x9d&1L\xa7;f㹾V\x1f\x85\xa5\xd4\xdcHu\xbc\x06M\xfe33T\xd5\xe5\xddK:\xebO*\x7f\xa6W\xf7\xb4\xc8\x11Ф\x0e\x84\x84\\\x8aG\xf2\x19\x0f\x8c[ʿZ\x06:\xe0\xe3\xcf-\\\xc0\x17\xdfc\x88-\xfe\xd4\xc24\x97U6;b:\xafL\x1c\xe1\xdb\x0f{\x9a\x80=\xab-mj\x1d\xbc\x11\xeb\x1d\xcb:g\xe7\x7f\x1e\x019v\xc0\xea\xa5h\xe6*;>Kw\xf6l\fͺ=\xbcGg\xb3\xb7a\x11\t\x15]~\x8b\xe6 L\xa8O\xfc\xee\x03l\xf6!\x9dȲ\x93\xc0ur\x86\x026\x8a\tM\xaaGG\x8c\xf1\xa1n\f\x85\xcc\xf8\xeeX\x17\xb8\xdb,\xd7\xd1;f\xa1\x92m\x9c{\xae\vM\x84gōq'\xac7C\xbb\xb6\xbb=\xf0'\xa3\xca8\xfaIa\xc6R\x03\xa9\u008c\xce,f\xf9\xa8=\xf2A\xf8\x82{\x8f\"wa*<P\x90\xa6\x1e\xac?\x91\xcebK\x87\xba^\x93\xb0\xd9eeL\x9d\x06\xe3\x80xW\x1f0\xd8\x10φ\f\xe8\xd8l\xd5*\xe2!D\xde\xe9\xe6$\xfe\x1b7\xbaU\xd3m\xf4a\xe1\xfd\x01\xebd\xb1\xe9\xdd\xe1\x98\x13\xa5\x9ao\x81&\xba\xc58\xc73K\xa6\x11\x88\xf4@=v\xe2^/\xa8At\xba\xc7T\xa1\xb1sll\x84\xf5ٯ$6\xef\xc6\xe4`\x9d\x9c\x1fyXvf\xdf\xe0\xc1qMז\x06\\p\x84Y\xf78\xa5\x9a\xeb\v\x8e\x8d\x8b\xf0\xb1f\r\xach\x9d\x18c vi[\xdbi\x8bI[\xf7\xecQ\xb6\x16\xac\xf1\x95\xd9א\xfc.\t\xcbE_\xf2\xa2){'^Wh} \xed\x8c\xd3\xdb\x16\x9d\xb7\xf7\x1bg\xccr\x89\xbf\x13\xaf)\xf1\x93\\\x99\x83\x18}\x9e\xdeo\x94)\xd1g\xe7u\x18\xd2\xc97M\x9c\x9bא\xe6E\xf3\xe5\x12Ĉ\x8d\xb4\xf7\xe3#ӭ{t\xb9Xr\xe5R\t\x96H\xc9{\xbdD\xcbYɖ\b\x98>\x1d\xb38\xe1\xb2D\x14\x16$^N\bx\xb1\xe4\xcb\xd2\x04\xcc\x02E\x12\xae@\xfb3\x86y\xc1d\xcc\u0084L$D\x9f\x948;)s\x069c\x933'ļP\x82敒4K\x135\x91 \x87δ\x9bJ\xd6D\x82\x1d\xae\x92\x1bM\xd8DB\x8dL\xeb,кgIX\xdc\xd2\x1e>qi\x9e\xb8TςtOD\xb8\xf3\x9c\x11\xb5R!s\x03Z\x9e\xfeYȋ\xce\xec\xbdP\x1a\xe8\xf5RA祃fAr\xbd<%4\vt\xfc,\xba3\x8d\xa0HI\x8cj\x16\xaa\xf3\xbe\x8a|\xd24\xe8\x88\xc7/\xadN\x1d\xf7\x85\xf9\x8dhD\xa3\xe6\xe5x\xd7ɤM\xc2\xfd\xe9\x03\x01\x95\x10M\xab\x03QMt\xb0\xde\xc67E\xa2\xb9X\xae7\xb6g\x0eՋ;P\xaf\xb6\xef\xd7\xc9\vg\xa1UǨ\xa3Q\xfa\x16\xaa\xb8\x14\xc2\x7f\xde\x7f\xfd\xe2j\x9f\xbd\xccZ{\xddYZ\x139\r\x8f\xdd\t\xa9\xd7\xf0\xb5\x81\xe2JXKf\xf66N%\xde\x19\xb0\x85x\x9309\xbd\f\xcb\x05^\x89m\xfa\x89\xdbwy%/Zb\xa6\x02\x99\x96\x1a4O\xd9 1\x92\x18\x9b4\v\xc1F/o>\xe8\xd8\xf2\xe1\x9cD\xce(\xbdx\x8b\\\x96s-z\x83\xae\xd9\x12dqh\xac\xeb\xe42\x87\x00\xac\xfc+Ϣ\x1aZ\x93\xe4\x92\xcb\x11\t\xdcB\xea\xd0+J\xbb\x84\xa1\xf7.\xfa\xe4TPڳ \xdb\x05\xd8D\xe9\xc6_\xb9\xae7\x04_ݐ\x9e\xba)\x99\xd6\xcfRe#)\xd43Gnז\x85C\xff\x11ބF\xb8{#\xdbW\xfaY\xce\xc4\x1d\xfc\xef\x9f\r\xcc8Z\x86\\\xa9\a\xb2DĢ\xc7\x1bgБ\x996Ӏ\x04&\xb9\xc0\xaayш\x94\xb6\x99\x8f\aJ|l\x92H^\xde7}\xfaѨ\x9c\xdb\x17\x85vW\x9e\t\xb8\xd6Fr\xf0B\x8eJ\xa3E\\\xb7\x84\x99\xde>\xad\x04\x1a\xb4o\xb6\xced\xfa\x84*\x95b\xc7\x1f\xe9ſW/\xd4ڑ\x92pA\xb2ω\xd4\xe4\xc9\n\xb3R\x12\x81\xe84\x8a\xc6ļ!\xf3\xe1\xe13\xcdgf\xdf|\xb8\xfeX\xb9\x89\xb7*\x99\xd2H\x8f\xf7\x14\xf5\x9d\xb6\xe3ĥC\x15\xa9\xb4\xa0\xfd*\xd0&S\xac\x90\x02Yn;\xfc:9\x83\x7f\x87N\x95EHN\xeb\x88\x11\xfe\x18\xee\xd9\n3zÙ\x869\xb5\xab]\xeeFa1\xade\xcam\x19\x8e\xdd\rag\xceT.qR\xa0gEy\x8a\xf1\x13\x82Ui\xfc\xfa,\xe8\xa8\x04_\n\xa1\xef\x84K\xd4n\x92I\x12\xfe\xf9\xa4c`\xf0P\x81\x06\x95\xfe\xf4\x9a\x9f\x80\a\x90\xc2\xdb=\x9a\xd2롂\xc9\x12.\xbc\rw\x9d,\x9c\x14\xe3\x16\xf9\xf0\\]\r\xbf\x80vU\xbf\x137\x89\xa0\xac{\x1d\xe9&\x19\xa5^\x18\x8e\x7f\xb5|\xcaJz\x1b\xb5?\x84\xabR\xf6\x05\x82\x04\xc4Z\xfb\xe7\xbeh\xb8y\xe9\xfa\f/\x9bװ\x87u<\xe2\xa5\xef' \xa1ye\xf1 \xa2\xfe\xf5\xad\x053\xee\xa5\xec+R/\xe7\xb1sp\x1e\xd8\x17.Ό\xf4\x1b\xb5\t\x83\f\x84\xb6\x1d\x83\xe7\x17Ɛ\xc4Y\xae+\xf8\x82\xcf\x03w?\t\x92\xc9S\xafߝQ\x85\x99\x8d\xc6\x0e\xfb\a\x13C<Խ\xec\xf9\xb5zf\xb4\xcdC\\\xf3\xdeY\x13\x94sk \xba\xc3\xc0\x86\x14\xdd?\xf3\x9dsSR\x1aӿ$ъkb$\xe3\nkpJ\x9d\xdcԨ\x0e\x98\xb5\x84\xc4WI\xb5\xefT\xdb:m\xb6\x81\xbf\xff#if%KS,\x8dOPo\x92\xfa=\xeepue\xbf\x94y\xa5X\uefe6R\xb8\xf8\x97\xde\xc0_\xfe\x9a\xd0\xec\xa6\x12'\x1f`\xd0\x1b\xf8\xcb_\x93\xff\x19\x00#6\xf7\x12\xba\x83\x00\x00"),
 	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VO\x8f\xeb4\x10\xbf\xe7S\x8c\x1e\x87w!\xe9{\xe2\x00\xca\r\x15\x0e+`\xb5\xda>\xed\x05qp\x9di;\xacc\x9b\xf1\xb8K\xf9\xf4\xc8v\xb2m\x93\x94]\x90\xf0-\xf6\xfc\xf9\xcdo\xfed\xaa\xba\xae+\xe5\xe9\t9\x90\xb3-(O\xf8\xa7\xa0M_\xa1y\xfe.4\xe4V\xc7\xcf\xd53ٮ\x85u\f\xe2\xfaG\f.\xb2\xc6\x1fpG\x96\x84\x9c\xadz\x14\xd5)Qm\x05\xa0\xacu\xa2\xd2uH\x9f\x00\xdaYag\fr\xbdG\xdb<\xc7-n#\x99\x0e9\x1b\x1f]\x1f?5\xdf6\x9f*\x00͘տP\x8fAT\xef[\xb0ј\n\xc0\xaa\x1e[\b\xc8II\x94\xc4\xc0\xf8G\xc4 \xa19\xa2Av\r\xb9*x\xd4\xc9\xf1\x9e]\xf4-\x9c\x1f\x8a\xfe\x00\xaa\x04\xb4ɦ6\xd9\xd4c1\x95_\r\x05\xf9\xe9\x96\xc4\xcf4Hy\x13Y\x99e@Y \x1c\x1c\xcb\xfd\xd9i\r!py!\xbb\x8fF\xf1\xa2r\x05\x10\xb4\xf3\xd8B\xd6\xf5JcW\x01\fLe[\xf5\xc0\xc5\xf1s1\xa7\x0fث\xe2\x04\xc0y\xb4\xdf?\xdc=}\xb3\xb9\xba\x06\xe80h&/\x99\xef\x85Ȁ\x02(\x18P\x808PZc\b\xa0#3Z\x81\x82\x12\xc8\xee\x1c\xf79G\xaf\xa6\x01\xd4\xd6E\x019 <eʇȚW\x11\xcf\xce#\v\x8dl\fj\xe7껸\x9d`\xfd\x98\xc2)RХ\xb2Ð=\r\x94`70\x00n\ar\xa0\x00\x8c\x9e1\xa0\x95)\xca\xcc\xcf\x0e\x94\x05\xb7\xfd\x1d\xb54\x03\x0f!%+\x9a.U\xeb\x11Y\x80Q\xbb\xbd\xa5\xbf^m\x87DHrj\x94\x8cur>d\x05\xd9*\x03Ge\"~\r\xcavЫ\x130&/\x10텽,\x12\x1a\xf8\xc51f2[8\x88\xf8ЮV{\x92\xb1\xeb\xb4\xeb\xfbhIN\xab\xdc@\xb4\x8d\xe28\xac:<\xa2Y\x05\xda\u05ca\xf5\x81\x04\xb5Dƕ\xf2Tg\xe86w^\xd3w_\xf1Ч\xe1\xe3\x15V9\xa5\xca\n\xc2d\xf7\x17\x0f\xb9!\xfe!\x03\xa9\x1dJ}\x14\xd5\x12ř\xe8t\x95\xd8y\xfcq\xf3\x05F\xd79\x19S\xf63\xefg\xc5pNA\"\x8c\xec\x0e\xb9$qǮ\xcf6\xd1vޑ-ե\r\xa1\x9d\xd2\x1f\xe2\xb6'\tc\xed\xa6\\5\xb0Σ\b\xb6\b\xd1wJ\xb0k\xe0\xce\xc2Z\xf5h\xd6*\xe0\xff\x9e\x80\xc4t\xa8\x13\xb1\xefK\xc1\xe5\x14\x9d\n\x17\xd6.\x1e\xc61w#_\vݽ\xf1\xa8S\x06\x13\x89I\x9bv\xa4s{\xc0\xce1\xa8%\x95\xe6]H\xb2ƿ\xc42L\x92\x82f2_R\u007f\xbe\x8dfy\x9c䗃\n8\xbd\x9c`zH2S\xff\x86v\xa8O\xda`1Q\xa6\t\xbe\r%\x1d\xb4\xb1\x9f\xfb\xac\xe1\x1e_\x16n\x1fإɚ\xe7\xfa\xf5\xb9Q\x1bP\xfe7{\xb2\xb3p\xa7\x91\x15\xa9\xfc\x0f\xbb\x1c\xd5\x17\x03z0\x04\x1c\xadM};\x9b\x90\x19\xc8t\x92\xcfdH\xb0_@\xb3\x88\xe7\xce\xee\\\xde\x04Tr\xac\xa4\xf4\x13\x0e\xc9\x1e\xfc\x14\\\v\x06o纜\xf9\xf0z\x17\xa1\xe5\xe4?\xe9\u007fSN\xe3\x86\x18\x17}\xd7\x19\xd5\xe2C\xf2\xb8\xc4\xf8r\u007f\r(\xa31jk\xb0\x05\xe18\xd7.\xba\x8aY\x9d\xa6U3\x96\xday\x9fz\xa3\x80f\n\xa9O^\x0ehou\x03\xbc\xa8锿\xf2\f\xdb\xd3-\xd5\xf5\xebr8o\xa9R\xba-\xa4\xd9]\v-p\xf6.R\x16\xb3WJzq\xf3\x98\x11\xb2\xb9\x94\x1dg\xc6Uk\x8c\x8b\xc8<\x86\x9b\x10\x16\x93=\xbb\xcc滋\xf0\x828V\xfb1\xe0\xf3\xe8M\x9b\x9a\x17\xec\xee\xa7+\xee\x87\x0fW\xbbj\xfe\xd4\xcevT6t\xf8\xf5\xb7\xaaX\xc5\xeei\\0\xd3\xe5\xdf\x01\x00\x00\xff\xff\xfb\xb1p\x12\x1b\f\x00\x00"),
 	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WO\x8f۶\x13\xbd\xfbS\f\x92\xc3^\"9\xc1\xef\xf0+t)\x82M\x0fA\xf3g\x11o\xf7R\xf4@\x93#\x8b]\x8aTgHm\xddO_\f)\xad\xbd\xb6\x9cl\x8aV\x17C\x149|\xf3\u07bc!\xbd\xaa\xaaj\xa5\x06{\x87\xc46\xf8\x06\xd4`\xf1ψ^\u07b8\xbe\xff\x81k\x1b\xd6\xe3\x9bս\xf5\xa6\x81\xeb\xc41\xf4_\x90C\"\x8dﰵ\xdeF\x1b\xfc\xaaǨ\x8c\x8a\xaaY\x01(\xefCT2\xcc\xf2\n\xa0\x83\x8f\x14\x9cC\xaav\xe8\xeb\xfb\xb4\xc5m\xb2\xce \xe5\xe0\xf3\xd6\xe3\xeb\xfa\xff\xf5\xeb\x15\x80&\xcc\xcbom\x8f\x1cU?4\xe0\x93s+\x00\xafzl`\f.\xf5\xc8^\r܅\xe8\x82.\x9b\xd5#:\xa4P۰\xe2\x01\xb5콣\x90\x86\x06\x0e\x1fJ\x88\tW\xc9\xe9.G\xdbL\xd1>L\xd1\xf2\x04g9\xfe\xfc\x95I\x1f,\xc7<qp\x89\x94\xbb\x88,\xcf\xe1.P\xfctؽ\x82\x91]\xf9b\xfd.9E\x97֯\x00X\x87\x01\x1b\xc8\xcb\a\xa5Ѭ\x00&\xe2r\xb8j\xa6\xe6M\x89\xa8;\xecU\xd9\a \f\xe8\xdf\u07bc\xbf\xfb\xdf\xe6\xc90\x80A\xd6d\x87\x98\xe9_N\x11,\x83\x82\x19\t<tH\bw\x99O\xe0\x18\by\x02\xfd\x18\x14`\xc6\xcf\xf5\xe3\xe0@a@\x8avN\xbe<G\x85w4z\x82\xebJ\xa0\x97Y`\xa4\xe2\x90!v8\xa7\x8ff\xca\x16B\v\xb1\xb3\f\x84\x03!\xa3\x8f\a!\x0fOhAy\b\xdb\xdfQ\xc7\x1a6H\x12F\xb4I\xceH\xa1\x8eH\x11\bu\xd8y\xfb\xd7cl\x86\x18\xf2\xa6NE\x9c4?<\xd6G$\xaf\x1c\x8c\xca%|\x05\xca\x1b\xe8\xd5\x1e\be\x17H\xfe(^\x9e\xc25|\f\x84`}\x1b\x1a\xe8b\x1c\xb8Y\xafw6Άӡ\uf4f7q\xbf\xceޱ\xdb\x14\x03\xf1\xda\xe0\x88n\xcdvW)ҝ\x8d\xa8c\"\\\xab\xc1V\x19\xba/>\xe8\xcdK\x9a,\xcaWO\xb0ƽT\x11G\xb2~w\xf4!\x1b\xe1+\n\x88\aJ!\x94\xa5%\x8b\x03\xd12$\xec|\xf9is\v\xf3\xd6Y\x8cS\xf63\uf1c5|\x90@\b\xb3\xbeE*\"\xb6\x14\xfa\x1c\x13\xbd\x19\x82\xf51\xbfhgџ\xd2\xcfi\xdb\xdb(\xba\xff\x91\x90\xa3hU\xc3u\xeeB\xb0EH\x83Q\x11M\r\xef=\\\xab\x1eݵb\xfc\xcf\x05\x10\xa6\xb9\x12b\x9f'\xc1q\x03=\x9d\\X;6\xd8\xd4\xde.\xe8\xb5\xec\xe4̀\xfa\x89\x81$\x8am\xed\xe4\xec6\xd0\t\xafj\xf6\xf9r\xbc\xfa\xc9\xf4e\x83C\xe9\xfe\xadݝ\x8e\x02(c\xf2١\xdc\xcdŵ_!l!\xef뼓\x14j\x1bH\x10\x8d\xd6 Us\x9e\x13\x92DS\xc2\x16\x9d\xe1\xfa,\xe4\x05\xces*\x84F4V\xee\x1c\xe8S$\x8f\x13\xf3᧬/\x94\x1f\x02\xe4ң~\xea\xb1>\xa27\xb9\xa9\x9f\xa1\t\xb9\x86\x19\r<\xd8\xd8\x15s\xb8\xe3C\xeay*\xc8s\x8f\xfb\xa5\xe1\x13\xec\xb7\x1d\xca\xcc\xd2N\x11\x185a\x14\x1c\x8cN\xcc+ά\x01>&\xce\xf6R\x8b\x11AZ\x845\xf3\xea{ܟ\x13\r\xdf\x12w:\xef\xbf\r\xf9J\xce\xc5\x190a\x8b\x84>.Z\\\xee\x1e\xe41bv\xb9\t\x9a\xc5\xe0\x1a\x87\xc8\xeb0\"\x8d\x16\x1f\xd6\x0f\x81\xee\xad\xdfUBxU\n\x81\xd7\xf9ް~\x99\u007f.\xa4|\xfb\xf9\xdd\xe7\x06\xde\x1a\x03!vH\xa2Z\x9b\xdc\\hG\xa7ݫ\xdcq_A\xb2\xe6ǫ\u007f\xc2K\x18\x8as\x9e\xc1\xcd&W\xff^N\xee\fJ(\xda\x14U\x02\x81\xf4M\x11\xbb\x9f\xd4,\xfda\xa9\x10gL\xdb\x10\x1c\xaa\xf3ғ\xeek\t\xcd9\xa4Jv\xf8\x1e\x9b\xcd\xce\xfd\x86\xc9n\xa6ibx\xc9j^6\x17B\xb9\x97\xe4[\x8a\xda\xe1%\xa3/p\xbc\x9cJ\xf5\xb8\xc1\xb3ZtT1\xf1\xf77\xe9\xbcl\x9a\xb9\x9d\x1a\xb5N$\x05=\xc5\\\xb8\xd0\xfc;\x8dz\xe8\x14/\xb8\xed\x19\xa8od\xe5,\x83\xb3-\xea\xbdvX\x02Bh\x17\xaa\xe9\xbb ˃>\xf5K\xa5\xf5vT֩\xadÅo\xbfxu\xf1\xebE\xf1\x17\xf5<\x1bd\xb9\xb5\x98\x06\"\xa5\x12{\xaa\xb2i䠾\xd2\xd2\\\xd0|:\xfd\xdb\xf1\xe2œ\u007f\x0e\xf9U\a_\xceDn\xe0\xd7\xdfV%*\x9a\xbb\xf9\xa2/\x83\u007f\a\x00\x00\xff\xff\xe4\xf3S\x85\xb2\r\x00\x00"),
 }
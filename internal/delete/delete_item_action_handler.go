@@ -36,13 +36,14 @@ import (
 
 // Context provides the necessary environment to run DeleteItemAction plugins
 type Context struct {
-	Backup          *velerov1api.Backup
-	BackupReader    io.Reader
-	Actions         []velero.DeleteItemAction
-	Filesystem      filesystem.Interface
-	Log             logrus.FieldLogger
-	DiscoveryHelper discovery.Helper
-	resolvedActions []framework.DeleteItemResolvedAction
+	Backup                 *velerov1api.Backup
+	BackupReader           io.Reader
+	NamespaceBackupReaders map[string]io.Reader
+	Actions                []velero.DeleteItemAction
+	Filesystem             filesystem.Interface
+	Log                    logrus.FieldLogger
+	DiscoveryHelper        discovery.Helper
+	resolvedActions        []framework.DeleteItemResolvedAction
 }
 
 func InvokeDeleteActions(ctx *Context) error {
@@ -59,11 +60,17 @@ func InvokeDeleteActions(ctx *Context) error {
 	}
 
 	// get items out of backup tarball into a temp directory
-	dir, err := archive.NewExtractor(ctx.Log, ctx.Filesystem).UnzipAndExtractBackup(ctx.BackupReader)
+	extractor := archive.NewExtractor(ctx.Log, ctx.Filesystem)
+	dir, err := extractor.UnzipAndExtractBackup(ctx.BackupReader)
 	if err != nil {
 		return errors.Wrapf(err, "error extracting backup")
 	}
 	defer ctx.Filesystem.RemoveAll(dir)
+	for namespace, reader := range ctx.NamespaceBackupReaders {
+		if err := extractor.UnzipAndExtractBackupInto(reader, dir); err != nil {
+			return errors.Wrapf(err, "error extracting archive of namespace %s", namespace)
+		}
+	}
 	ctx.Log.Debugf("Downloaded and extracted the backup file to: %s", dir)
 
 	backupResources, err := archive.NewParser(ctx.Log, ctx.Filesystem).Parse(dir)
@@ -0,0 +1,66 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidateFallback returns an error if the fallback of a backup storage location is invalid.
+func ValidateFallback(location *velerov1api.BackupStorageLocation) error {
+	fallback := location.Spec.Fallback
+	if fallback == nil {
+		return nil
+	}
+	if fallback.Location == "" {
+		return errors.New("fallback location name is empty")
+	}
+	if fallback.Location == location.Name {
+		return errors.New("fallback location can't be the location itself")
+	}
+	return nil
+}
+
+// GetFallbackLocation returns the location that new backups for a backup storage location are
+// stored in instead, i.e. its fallback location if it's unavailable and has one. It returns nil
+// if the backups are stored in the location itself, and an error if the fallback location
+// can't be used.
+func GetFallbackLocation(ctx context.Context, kbClient client.Client, location *velerov1api.BackupStorageLocation) (*velerov1api.BackupStorageLocation, error) {
+	if location.Status.Phase != velerov1api.BackupStorageLocationPhaseUnavailable || location.Spec.Fallback == nil {
+		return nil, nil
+	}
+	if err := ValidateFallback(location); err != nil {
+		return nil, err
+	}
+
+	fallback := &velerov1api.BackupStorageLocation{}
+	if err := kbClient.Get(ctx, client.ObjectKey{Namespace: location.Namespace, Name: location.Spec.Fallback.Location}, fallback); err != nil {
+		return nil, errors.Wrapf(err, "error getting fallback location %s", location.Spec.Fallback.Location)
+	}
+	if fallback.Status.Phase != velerov1api.BackupStorageLocationPhaseAvailable {
+		return nil, errors.Errorf("fallback location %s isn't available", fallback.Name)
+	}
+	if fallback.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		return nil, errors.Errorf("fallback location %s is in read-only mode", fallback.Name)
+	}
+	return fallback, nil
+}
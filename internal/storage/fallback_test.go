@@ -0,0 +1,103 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateFallback(t *testing.T) {
+	assert.NoError(t, ValidateFallback(builder.ForBackupStorageLocation("velero", "primary").Result()))
+	assert.NoError(t, ValidateFallback(builder.ForBackupStorageLocation("velero", "primary").Fallback("secondary", false).Result()))
+	assert.EqualError(t, ValidateFallback(builder.ForBackupStorageLocation("velero", "primary").Fallback("", false).Result()), "fallback location name is empty")
+	assert.EqualError(t, ValidateFallback(builder.ForBackupStorageLocation("velero", "primary").Fallback("primary", false).Result()), "fallback location can't be the location itself")
+}
+
+func TestGetFallbackLocation(t *testing.T) {
+	tests := []struct {
+		name             string
+		location         *velerov1api.BackupStorageLocation
+		fallback         *velerov1api.BackupStorageLocation
+		expectedLocation string
+		expectedErr      string
+	}{
+		{
+			name:     "available location isn't replaced",
+			location: builder.ForBackupStorageLocation("velero", "primary").Fallback("secondary", false).Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+			fallback: builder.ForBackupStorageLocation("velero", "secondary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+		},
+		{
+			name:     "unavailable location without fallback isn't replaced",
+			location: builder.ForBackupStorageLocation("velero", "primary").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+		},
+		{
+			name:             "unavailable location is replaced by its fallback",
+			location:         builder.ForBackupStorageLocation("velero", "primary").Fallback("secondary", false).Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+			fallback:         builder.ForBackupStorageLocation("velero", "secondary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+			expectedLocation: "secondary",
+		},
+		{
+			name:        "missing fallback can't be used",
+			location:    builder.ForBackupStorageLocation("velero", "primary").Fallback("secondary", false).Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+			expectedErr: "error getting fallback location secondary: backupstoragelocations.velero.io \"secondary\" not found",
+		},
+		{
+			name:        "unavailable fallback can't be used",
+			location:    builder.ForBackupStorageLocation("velero", "primary").Fallback("secondary", false).Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+			fallback:    builder.ForBackupStorageLocation("velero", "secondary").Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+			expectedErr: "fallback location secondary isn't available",
+		},
+		{
+			name:        "read-only fallback can't be used",
+			location:    builder.ForBackupStorageLocation("velero", "primary").Fallback("secondary", false).Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result(),
+			fallback:    builder.ForBackupStorageLocation("velero", "secondary").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result(),
+			expectedErr: "fallback location secondary is in read-only mode",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objects := []runtime.Object{test.location}
+			if test.fallback != nil {
+				objects = append(objects, test.fallback)
+			}
+			client := velerotest.NewFakeControllerRuntimeClient(t, objects...)
+
+			fallback, err := GetFallbackLocation(context.Background(), client, test.location)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if test.expectedLocation == "" {
+				assert.Nil(t, fallback)
+				return
+			}
+			require.NotNil(t, fallback)
+			assert.Equal(t, test.expectedLocation, fallback.Name)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// ValidatePlacement returns an error if the placement rules of a backup storage location
+// are invalid.
+func ValidatePlacement(placement *velerov1api.BackupStorageLocationPlacement) error {
+	if placement == nil {
+		return nil
+	}
+	if placement.Weight < 0 {
+		return errors.Errorf("placement weight %d is negative", placement.Weight)
+	}
+	if _, err := metav1.LabelSelectorAsSelector(placement.NamespaceSelector); err != nil {
+		return errors.Wrap(err, "invalid placement namespace selector")
+	}
+	if _, err := metav1.LabelSelectorAsSelector(placement.BackupSelector); err != nil {
+		return errors.Wrap(err, "invalid placement backup selector")
+	}
+	return nil
+}
+
+// SelectBackupStorageLocation chooses the default backup storage location of a backup that
+// doesn't specify one, among the given locations. Default locations whose placement rules
+// match the backup are candidates, and the one with the highest weight is chosen, or the
+// first one by name if several have the same weight. It returns nil if no default location
+// matches, and the reason for the choice otherwise.
+func SelectBackupStorageLocation(ctx context.Context, kbClient client.Client, backup *velerov1api.Backup, locations []velerov1api.BackupStorageLocation) (*velerov1api.BackupStorageLocation, string, error) {
+	var candidates []*velerov1api.BackupStorageLocation
+	for i := range locations {
+		location := &locations[i]
+		if !location.Spec.Default {
+			continue
+		}
+
+		matched, err := placementMatches(ctx, kbClient, location.Spec.Placement, backup)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "error evaluating the placement rules of backup storage location %s", location.Name)
+		}
+		if matched {
+			candidates = append(candidates, location)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, "", nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if weightOf(candidates[i]) != weightOf(candidates[j]) {
+			return weightOf(candidates[i]) > weightOf(candidates[j])
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	return candidates[0], placementReason(candidates[0], len(candidates)), nil
+}
+
+// PlacementMatches returns true if the placement rules of the backup storage location match
+// the backup, or it has none.
+func PlacementMatches(ctx context.Context, kbClient client.Client, location *velerov1api.BackupStorageLocation, backup *velerov1api.Backup) (bool, error) {
+	matched, err := placementMatches(ctx, kbClient, location.Spec.Placement, backup)
+	return matched, errors.Wrapf(err, "error evaluating the placement rules of backup storage location %s", location.Name)
+}
+
+// placementMatches returns true if the placement rules match the backup. Invalid rules,
+// which the backup storage location controller reports, match no backups.
+func placementMatches(ctx context.Context, kbClient client.Client, placement *velerov1api.BackupStorageLocationPlacement, backup *velerov1api.Backup) (bool, error) {
+	if placement == nil {
+		return true, nil
+	}
+
+	if placement.BackupSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(placement.BackupSelector)
+		if err != nil {
+			return false, nil
+		}
+		if !selector.Matches(labels.Set(backup.Labels)) {
+			return false, nil
+		}
+	}
+
+	if placement.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(placement.NamespaceSelector)
+		if err != nil {
+			return false, nil
+		}
+		if selector.Empty() {
+			return true, nil
+		}
+
+		namespaces := backup.Spec.IncludedNamespaces
+		if len(namespaces) == 0 {
+			return false, nil
+		}
+		for _, name := range namespaces {
+			// the backup of all namespaces can't match a selector that doesn't match all of them
+			if name == "*" {
+				return false, nil
+			}
+
+			namespace := &corev1api.Namespace{}
+			if err := kbClient.Get(ctx, client.ObjectKey{Name: name}, namespace); err != nil {
+				if apierrors.IsNotFound(err) {
+					return false, nil
+				}
+				return false, errors.Wrapf(err, "error getting namespace %s", name)
+			}
+			if !selector.Matches(labels.Set(namespace.Labels)) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func weightOf(location *velerov1api.BackupStorageLocation) int32 {
+	if location.Spec.Placement == nil {
+		return 0
+	}
+	return location.Spec.Placement.Weight
+}
+
+func placementReason(location *velerov1api.BackupStorageLocation, candidates int) string {
+	placement := location.Spec.Placement
+	if placement == nil || (placement.NamespaceSelector == nil && placement.BackupSelector == nil) {
+		if candidates == 1 {
+			return fmt.Sprintf("default backup storage location %s", location.Name)
+		}
+		return fmt.Sprintf("default backup storage location %s chosen by weight (%d) and name among %d matching default locations", location.Name, weightOf(location), candidates)
+	}
+
+	var rules []string
+	if placement.NamespaceSelector != nil {
+		rules = append(rules, fmt.Sprintf("namespace selector %q", metav1.FormatLabelSelector(placement.NamespaceSelector)))
+	}
+	if placement.BackupSelector != nil {
+		rules = append(rules, fmt.Sprintf("backup selector %q", metav1.FormatLabelSelector(placement.BackupSelector)))
+	}
+	reason := fmt.Sprintf("placement rules of default backup storage location %s matched: %s", location.Name, strings.Join(rules, ", "))
+	if candidates > 1 {
+		reason += fmt.Sprintf("; chosen by weight (%d) and name among %d matching default locations", weightOf(location), candidates)
+	}
+	return reason
+}
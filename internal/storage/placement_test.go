@@ -0,0 +1,154 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidatePlacement(t *testing.T) {
+	assert.NoError(t, ValidatePlacement(nil))
+	assert.NoError(t, ValidatePlacement(&velerov1api.BackupStorageLocationPlacement{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		Weight:            10,
+	}))
+	assert.EqualError(t, ValidatePlacement(&velerov1api.BackupStorageLocationPlacement{Weight: -1}), "placement weight -1 is negative")
+	assert.Error(t, ValidatePlacement(&velerov1api.BackupStorageLocationPlacement{
+		BackupSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Bogus"}}},
+	}))
+}
+
+func TestSelectBackupStorageLocation(t *testing.T) {
+	teamA := &velerov1api.BackupStorageLocationPlacement{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+	}
+	teamB := &velerov1api.BackupStorageLocationPlacement{
+		BackupSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+		Weight:         10,
+	}
+
+	tests := []struct {
+		name             string
+		backup           *velerov1api.Backup
+		locations        []*velerov1api.BackupStorageLocation
+		expectedLocation string
+		expectedReason   string
+	}{
+		{
+			name:   "no default location",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+			},
+		},
+		{
+			name:   "single default location without placement rules",
+			backup: builder.ForBackup("velero", "backup-1").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "loc-1").Result(),
+				builder.ForBackupStorageLocation("velero", "loc-2").Default(true).Result(),
+			},
+			expectedLocation: "loc-2",
+			expectedReason:   "default backup storage location loc-2",
+		},
+		{
+			name:   "locations with the same weight are chosen by name",
+			backup: builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1", "ns-2").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "catch-all").Default(true).Result(),
+				builder.ForBackupStorageLocation("velero", "team-a").Default(true).Placement(teamA).Result(),
+			},
+			expectedLocation: "catch-all",
+			expectedReason:   "default backup storage location catch-all chosen by weight (0) and name among 2 matching default locations",
+		},
+		{
+			name:   "namespace selector doesn't match a namespace",
+			backup: builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1", "ns-3").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "team-a").Default(true).Placement(teamA).Result(),
+			},
+		},
+		{
+			name:   "namespace selector doesn't match backups of all namespaces",
+			backup: builder.ForBackup("velero", "backup-1").IncludedNamespaces("*").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "team-a").Default(true).Placement(teamA).Result(),
+			},
+		},
+		{
+			name:   "namespace selector matches a backup of team a's namespaces",
+			backup: builder.ForBackup("velero", "backup-1").IncludedNamespaces("ns-1").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "team-a").Default(true).Placement(teamA).Result(),
+				builder.ForBackupStorageLocation("velero", "team-b").Default(true).Placement(teamB).Result(),
+			},
+			expectedLocation: "team-a",
+			expectedReason:   `placement rules of default backup storage location team-a matched: namespace selector "team=a"`,
+		},
+		{
+			name:   "the matching location with the highest weight is chosen",
+			backup: builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels("team", "b")).IncludedNamespaces("ns-1").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "team-a").Default(true).Placement(teamA).Result(),
+				builder.ForBackupStorageLocation("velero", "team-b").Default(true).Placement(teamB).Result(),
+			},
+			expectedLocation: "team-b",
+			expectedReason:   `placement rules of default backup storage location team-b matched: backup selector "team=b"; chosen by weight (10) and name among 2 matching default locations`,
+		},
+		{
+			name:   "locations that aren't default are ignored",
+			backup: builder.ForBackup("velero", "backup-1").ObjectMeta(builder.WithLabels("team", "b")).Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "team-b").Placement(teamB).Result(),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForNamespace("ns-1").ObjectMeta(builder.WithLabels("team", "a")).Result(),
+				builder.ForNamespace("ns-2").ObjectMeta(builder.WithLabels("team", "a")).Result(),
+				builder.ForNamespace("ns-3").ObjectMeta(builder.WithLabels("team", "b")).Result(),
+			)
+
+			var locations []velerov1api.BackupStorageLocation
+			for _, location := range test.locations {
+				locations = append(locations, *location)
+			}
+
+			location, reason, err := SelectBackupStorageLocation(context.Background(), client, test.backup, locations)
+			require.NoError(t, err)
+			if test.expectedLocation == "" {
+				assert.Nil(t, location)
+				return
+			}
+			require.NotNil(t, location)
+			assert.Equal(t, test.expectedLocation, location.Name)
+			assert.Equal(t, test.expectedReason, reason)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DefaultQuotaWarningThresholdPercent is the percentage of the quota of a backup storage location
+// above which its QuotaWarning condition is set, if its quota doesn't define one.
+const DefaultQuotaWarningThresholdPercent = 80
+
+// QuotaExceeded returns true and a message if the usage of a backup storage location, as of its
+// latest measurement, exceeds its quota.
+func QuotaExceeded(location *velerov1api.BackupStorageLocation) (bool, string) {
+	quota := location.Spec.Quota
+	usage := location.Status.Usage
+	if quota == nil || quota.Limit == nil || usage == nil || usage.TotalBytes == nil {
+		return false, ""
+	}
+	if *usage.TotalBytes < quota.Limit.Value() {
+		return false, ""
+	}
+	return true, fmt.Sprintf("backup storage location %s exceeds its quota: %s of %s used", location.Name, formatBytes(*usage.TotalBytes), quota.Limit.String())
+}
+
+// QuotaEnforcement returns how the quota of a backup storage location is enforced.
+func QuotaEnforcement(location *velerov1api.BackupStorageLocation) velerov1api.QuotaEnforcement {
+	if location.Spec.Quota == nil || location.Spec.Quota.Enforcement == "" {
+		return velerov1api.QuotaEnforcementRefuse
+	}
+	return location.Spec.Quota.Enforcement
+}
+
+// SetUsageConditions updates the usage conditions of a backup storage location after its usage
+// was measured. previous is the usage of the previous measurement, or nil if there wasn't one.
+// The quota conditions are Unknown if the size of the location's data couldn't be measured.
+func SetUsageConditions(location *velerov1api.BackupStorageLocation, previous *velerov1api.BackupStorageLocationUsage) {
+	conditions := &location.Status.Conditions
+	quota := location.Spec.Quota
+	usage := location.Status.Usage
+	if usage == nil {
+		return
+	}
+
+	if quota == nil || quota.Limit == nil {
+		meta.RemoveStatusCondition(conditions, velerov1api.BackupStorageLocationConditionQuotaExceeded)
+		meta.RemoveStatusCondition(conditions, velerov1api.BackupStorageLocationConditionQuotaWarning)
+	} else if usage.TotalBytes == nil {
+		for _, conditionType := range []string{
+			velerov1api.BackupStorageLocationConditionQuotaExceeded,
+			velerov1api.BackupStorageLocationConditionQuotaWarning,
+		} {
+			meta.SetStatusCondition(conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionUnknown,
+				ObservedGeneration: location.Generation,
+				Reason:             "UsageUnknown",
+				Message:            "the object store plugin of the location can't list the sizes of objects",
+			})
+		}
+	} else {
+		limit := quota.Limit.Value()
+		used := fmt.Sprintf("%s of %s used", formatBytes(*usage.TotalBytes), quota.Limit.String())
+
+		exceeded := metav1.Condition{
+			Type:               velerov1api.BackupStorageLocationConditionQuotaExceeded,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: location.Generation,
+			Reason:             "UsageBelowLimit",
+			Message:            used,
+		}
+		if *usage.TotalBytes >= limit {
+			exceeded.Status = metav1.ConditionTrue
+			exceeded.Reason = "UsageAboveLimit"
+		}
+		meta.SetStatusCondition(conditions, exceeded)
+
+		threshold := quota.WarningThresholdPercent
+		if threshold == 0 {
+			threshold = DefaultQuotaWarningThresholdPercent
+		}
+		warning := metav1.Condition{
+			Type:               velerov1api.BackupStorageLocationConditionQuotaWarning,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: location.Generation,
+			Reason:             "UsageBelowThreshold",
+			Message:            fmt.Sprintf("%s, the warning threshold is %d%%", used, threshold),
+		}
+		if *usage.TotalBytes*100 >= limit*int64(threshold) {
+			warning.Status = metav1.ConditionTrue
+			warning.Reason = "UsageAboveThreshold"
+		}
+		meta.SetStatusCondition(conditions, warning)
+	}
+
+	if quota == nil || quota.GrowthThresholdPercent == 0 {
+		meta.RemoveStatusCondition(conditions, velerov1api.BackupStorageLocationConditionUnexpectedGrowth)
+	} else if usage.TotalBytes != nil && previous != nil && previous.TotalBytes != nil && *previous.TotalBytes > 0 {
+		growth := (*usage.TotalBytes - *previous.TotalBytes) * 100 / *previous.TotalBytes
+		condition := metav1.Condition{
+			Type:               velerov1api.BackupStorageLocationConditionUnexpectedGrowth,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: location.Generation,
+			Reason:             "UsageGrowthBelowThreshold",
+			Message: fmt.Sprintf("usage grew by %d%% from %s to %s since the previous measurement, the growth threshold is %d%%",
+				growth, formatBytes(*previous.TotalBytes), formatBytes(*usage.TotalBytes), quota.GrowthThresholdPercent),
+		}
+		if growth > int64(quota.GrowthThresholdPercent) {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "UsageGrowthAboveThreshold"
+		}
+		meta.SetStatusCondition(conditions, condition)
+	}
+}
+
+func formatBytes(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
@@ -0,0 +1,190 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func quotaLimit(s string) *resource.Quantity {
+	limit := resource.MustParse(s)
+	return &limit
+}
+
+func bytes(n int64) *int64 {
+	return &n
+}
+
+func TestQuotaExceeded(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "loc-1").Result()
+
+	exceeded, _ := QuotaExceeded(location)
+	assert.False(t, exceeded)
+
+	location.Spec.Quota = &velerov1api.BackupStorageLocationQuota{Limit: quotaLimit("1Ki")}
+	exceeded, _ = QuotaExceeded(location)
+	assert.False(t, exceeded)
+
+	location.Status.Usage = &velerov1api.BackupStorageLocationUsage{}
+	exceeded, _ = QuotaExceeded(location)
+	assert.False(t, exceeded)
+
+	location.Status.Usage.TotalBytes = bytes(1000)
+	exceeded, _ = QuotaExceeded(location)
+	assert.False(t, exceeded)
+
+	location.Status.Usage.TotalBytes = bytes(2048)
+	exceeded, message := QuotaExceeded(location)
+	assert.True(t, exceeded)
+	assert.Equal(t, "backup storage location loc-1 exceeds its quota: 2Ki of 1Ki used", message)
+}
+
+func TestQuotaEnforcement(t *testing.T) {
+	location := builder.ForBackupStorageLocation("velero", "loc-1").Result()
+	assert.Equal(t, velerov1api.QuotaEnforcementRefuse, QuotaEnforcement(location))
+
+	location.Spec.Quota = &velerov1api.BackupStorageLocationQuota{Enforcement: velerov1api.QuotaEnforcementWarn}
+	assert.Equal(t, velerov1api.QuotaEnforcementWarn, QuotaEnforcement(location))
+}
+
+func TestSetUsageConditions(t *testing.T) {
+	tests := []struct {
+		name               string
+		quota              *velerov1api.BackupStorageLocationQuota
+		previous           *int64
+		usage              *int64
+		existingConditions []metav1.Condition
+		expected           map[string]metav1.ConditionStatus
+	}{
+		{
+			name:     "no quota",
+			usage:    bytes(100),
+			expected: map[string]metav1.ConditionStatus{},
+		},
+		{
+			name:  "quota removed",
+			usage: bytes(100),
+			existingConditions: []metav1.Condition{
+				{Type: velerov1api.BackupStorageLocationConditionQuotaExceeded, Status: metav1.ConditionTrue, Reason: "UsageAboveLimit"},
+			},
+			expected: map[string]metav1.ConditionStatus{},
+		},
+		{
+			name:  "usage below the warning threshold",
+			quota: &velerov1api.BackupStorageLocationQuota{Limit: quotaLimit("1000")},
+			usage: bytes(799),
+			expected: map[string]metav1.ConditionStatus{
+				velerov1api.BackupStorageLocationConditionQuotaExceeded: metav1.ConditionFalse,
+				velerov1api.BackupStorageLocationConditionQuotaWarning:  metav1.ConditionFalse,
+			},
+		},
+		{
+			name:  "usage above the default warning threshold",
+			quota: &velerov1api.BackupStorageLocationQuota{Limit: quotaLimit("1000")},
+			usage: bytes(800),
+			expected: map[string]metav1.ConditionStatus{
+				velerov1api.BackupStorageLocationConditionQuotaExceeded: metav1.ConditionFalse,
+				velerov1api.BackupStorageLocationConditionQuotaWarning:  metav1.ConditionTrue,
+			},
+		},
+		{
+			name:  "usage below a custom warning threshold",
+			quota: &velerov1api.BackupStorageLocationQuota{Limit: quotaLimit("1000"), WarningThresholdPercent: 90},
+			usage: bytes(800),
+			expected: map[string]metav1.ConditionStatus{
+				velerov1api.BackupStorageLocationConditionQuotaExceeded: metav1.ConditionFalse,
+				velerov1api.BackupStorageLocationConditionQuotaWarning:  metav1.ConditionFalse,
+			},
+		},
+		{
+			name:  "usage above the limit",
+			quota: &velerov1api.BackupStorageLocationQuota{Limit: quotaLimit("1000")},
+			usage: bytes(1000),
+			expected: map[string]metav1.ConditionStatus{
+				velerov1api.BackupStorageLocationConditionQuotaExceeded: metav1.ConditionTrue,
+				velerov1api.BackupStorageLocationConditionQuotaWarning:  metav1.ConditionTrue,
+			},
+		},
+		{
+			name:     "usage grew more than the growth threshold",
+			quota:    &velerov1api.BackupStorageLocationQuota{GrowthThresholdPercent: 50},
+			previous: bytes(100),
+			usage:    bytes(151),
+			expected: map[string]metav1.ConditionStatus{
+				velerov1api.BackupStorageLocationConditionUnexpectedGrowth: metav1.ConditionTrue,
+			},
+		},
+		{
+			name:     "usage grew less than the growth threshold",
+			quota:    &velerov1api.BackupStorageLocationQuota{GrowthThresholdPercent: 50},
+			previous: bytes(100),
+			usage:    bytes(150),
+			expected: map[string]metav1.ConditionStatus{
+				velerov1api.BackupStorageLocationConditionUnexpectedGrowth: metav1.ConditionFalse,
+			},
+		},
+		{
+			name:     "growth isn't checked without a previous measurement",
+			quota:    &velerov1api.BackupStorageLocationQuota{GrowthThresholdPercent: 50},
+			usage:    bytes(1000),
+			expected: map[string]metav1.ConditionStatus{},
+		},
+		{
+			name:     "unknown usage",
+			quota:    &velerov1api.BackupStorageLocationQuota{Limit: quotaLimit("1000"), GrowthThresholdPercent: 50},
+			previous: bytes(100),
+			expected: map[string]metav1.ConditionStatus{
+				velerov1api.BackupStorageLocationConditionQuotaExceeded: metav1.ConditionUnknown,
+				velerov1api.BackupStorageLocationConditionQuotaWarning:  metav1.ConditionUnknown,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation("velero", "loc-1").Result()
+			location.Spec.Quota = test.quota
+			location.Status.Conditions = test.existingConditions
+			location.Status.Usage = &velerov1api.BackupStorageLocationUsage{TotalBytes: test.usage}
+
+			var previous *velerov1api.BackupStorageLocationUsage
+			if test.previous != nil {
+				previous = &velerov1api.BackupStorageLocationUsage{TotalBytes: test.previous}
+			}
+
+			SetUsageConditions(location, previous)
+
+			require.Len(t, location.Status.Conditions, len(test.expected))
+			for conditionType, status := range test.expected {
+				condition := meta.FindStatusCondition(location.Status.Conditions, conditionType)
+				require.NotNil(t, condition, conditionType)
+				assert.Equal(t, status, condition.Status, conditionType)
+				assert.NotEmpty(t, condition.Reason)
+				assert.NotEmpty(t, condition.Message)
+			}
+		})
+	}
+}
@@ -86,6 +86,14 @@ type BackupSpec struct {
 	// +nullable
 	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
 
+	// IncludeClusterDependencies specifies whether the cluster-scoped resources
+	// that backed up resources depend on, such as the ClusterRoles of RoleBindings
+	// and the StorageClasses of PersistentVolumeClaims, should be included in the
+	// backup. It only applies when IncludeClusterResources is unset.
+	// +optional
+	// +nullable
+	IncludeClusterDependencies *bool `json:"includeClusterDependencies,omitempty"`
+
 	// Hooks represent custom behaviors that should be executed at different phases of the backup.
 	// +optional
 	Hooks BackupHooks `json:"hooks,omitempty"`
@@ -116,6 +124,107 @@ type BackupSpec struct {
 	// The default value is 10 minute.
 	// +optional
 	CSISnapshotTimeout metav1.Duration `json:"csiSnapshotTimeout,omitempty"`
+
+	// SnapshotMoveData specifies whether the data of the CSI VolumeSnapshots
+	// taken for the backup should be moved to the backup repository, so that
+	// it can be restored when the snapshots are no longer available.
+	// +optional
+	// +nullable
+	SnapshotMoveData *bool `json:"snapshotMoveData,omitempty"`
+
+	// Cancel requests that the backup be stopped. A backup that has not
+	// finished yet stops processing items, cancels its pod volume backups,
+	// removes the snapshots it has taken and ends in the Canceled phase.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// Transforms modify the items they select before the items are written to
+	// the backup, for example to redact credentials. An item is modified by
+	// every transform that selects it, in order, and the names of the
+	// transforms are recorded in the item's velero.io/backup-transforms
+	// annotation.
+	// +optional
+	// +nullable
+	Transforms []BackupTransform `json:"transforms,omitempty"`
+}
+
+// BackupTransform modifies the items selected by its namespaces, resources,
+// label selector and Secret types before they're written to the backup.
+type BackupTransform struct {
+	// Name is the name of this transform.
+	Name string `json:"name"`
+
+	// IncludedNamespaces specifies the namespaces to which this transform applies. If empty, it applies
+	// to all namespaces.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+
+	// ExcludedNamespaces specifies the namespaces to which this transform does not apply.
+	// +optional
+	// +nullable
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// IncludedResources specifies the resources to which this transform applies. If empty, it applies
+	// to all resources.
+	// +optional
+	// +nullable
+	IncludedResources []string `json:"includedResources,omitempty"`
+
+	// ExcludedResources specifies the resources to which this transform does not apply.
+	// +optional
+	// +nullable
+	ExcludedResources []string `json:"excludedResources,omitempty"`
+
+	// LabelSelector, if specified, filters the resources to which this transform applies.
+	// +optional
+	// +nullable
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// SecretTypes, if specified, limits this transform to Secrets of these types,
+	// such as "kubernetes.io/dockerconfigjson".
+	// +optional
+	// +nullable
+	SecretTypes []string `json:"secretTypes,omitempty"`
+
+	// Patches are JSON patch operations applied to the selected items. Operations
+	// whose path doesn't exist in an item are skipped.
+	// +optional
+	// +nullable
+	Patches []BackupTransformPatch `json:"patches,omitempty"`
+
+	// MetadataOnly specifies that only the apiVersion, kind and metadata of the
+	// selected items are backed up.
+	// +optional
+	MetadataOnly bool `json:"metadataOnly,omitempty"`
+}
+
+// BackupTransformOperation is a JSON patch operation of a backup transform.
+// +kubebuilder:validation:Enum=remove;replace
+type BackupTransformOperation string
+
+const (
+	// BackupTransformOperationRemove removes the value at the patch's path.
+	BackupTransformOperationRemove BackupTransformOperation = "remove"
+
+	// BackupTransformOperationReplace replaces the value at the patch's path
+	// with the patch's value.
+	BackupTransformOperationReplace BackupTransformOperation = "replace"
+)
+
+// BackupTransformPatch is a JSON patch operation applied to the items a backup
+// transform selects.
+type BackupTransformPatch struct {
+	// Operation is the JSON patch operation.
+	Operation BackupTransformOperation `json:"op"`
+
+	// Path is the JSON pointer to the value the operation applies to, such as
+	// "/data/password".
+	Path string `json:"path"`
+
+	// Value is the string that replaces the value at Path for the replace operation.
+	// +optional
+	Value string `json:"value,omitempty"`
 }
 
 // BackupHooks contains custom behaviors that should be executed at different phases of the backup.
@@ -213,7 +322,7 @@ const (
 
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Canceled;Deleting
 type BackupPhase string
 
 const (
@@ -251,6 +360,10 @@ const (
 	// prevented it from completing successfully.
 	BackupPhaseFailed BackupPhase = "Failed"
 
+	// BackupPhaseCanceled means the backup was canceled before it finished.
+	// It has not been uploaded to object storage.
+	BackupPhaseCanceled BackupPhase = "Canceled"
+
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
 )
@@ -339,6 +452,68 @@ type BackupStatus struct {
 	// completed CSI VolumeSnapshots for this backup.
 	// +optional
 	CSIVolumeSnapshotsCompleted int `json:"csiVolumeSnapshotsCompleted,omitempty"`
+
+	// DataUploadsAttempted is the total number of attempted uploads
+	// of CSI snapshot data to the backup repository for this backup.
+	// +optional
+	DataUploadsAttempted int `json:"dataUploadsAttempted,omitempty"`
+
+	// DataUploadsCompleted is the total number of successfully completed
+	// uploads of CSI snapshot data to the backup repository for this backup.
+	// +optional
+	DataUploadsCompleted int `json:"dataUploadsCompleted,omitempty"`
+
+	// ResumeCount is the number of times the backup was resumed after the Velero
+	// server restarted while it was in progress.
+	// +optional
+	ResumeCount int `json:"resumeCount,omitempty"`
+
+	// Compression is the algorithm the backup's contents were compressed with.
+	// Backups without it were compressed with gzip.
+	// +optional
+	Compression CompressionAlgorithm `json:"compression,omitempty"`
+
+	// ItemsTransformed is the number of items that were modified by the
+	// backup's transforms before they were written to the backup.
+	// +optional
+	ItemsTransformed int `json:"itemsTransformed,omitempty"`
+
+	// ClusterDependencies is the list of cluster-scoped resources that were included
+	// in the backup because backed up resources depend on them, formatted as
+	// resource.group/name.
+	// +optional
+	// +nullable
+	ClusterDependencies []string `json:"clusterDependencies,omitempty"`
+
+	// DamagedVolumeBackups is the list of the PodVolumeBackups and DataUploads of the backup
+	// whose data the verification of their backup repository found to be damaged, formatted
+	// as kind/name.
+	// +optional
+	// +nullable
+	DamagedVolumeBackups []string `json:"damagedVolumeBackups,omitempty"`
+
+	// ObjectLock is the object lock that protects the objects of the backup in its
+	// storage location from being deleted or overwritten.
+	// +optional
+	// +nullable
+	ObjectLock *BackupObjectLock `json:"objectLock,omitempty"`
+}
+
+// BackupObjectLock is the object lock that protects the objects of a backup.
+type BackupObjectLock struct {
+	// Mode is the retention mode of the objects.
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+
+	// RetainUntil is the time until which the objects are protected.
+	// +optional
+	// +nullable
+	RetainUntil *metav1.Time `json:"retainUntil,omitempty"`
+
+	// LegalHold is true if the objects are protected by a legal hold, regardless of
+	// their retention, until the hold is removed.
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
 }
 
 // BackupProgress stores information about the progress of a Backup's execution.
@@ -41,6 +41,25 @@ type BackupRepositorySpec struct {
 
 	// MaintenanceFrequency is how often maintenance should be run.
 	MaintenanceFrequency metav1.Duration `json:"maintenanceFrequency"`
+
+	// VerificationFrequency is how often the data in the repository should be
+	// verified. Periodic verification is disabled if it's zero.
+	// +optional
+	VerificationFrequency metav1.Duration `json:"verificationFrequency,omitempty"`
+
+	// VerificationReadDataPercent is the percentage of the data in the repository
+	// that's read back and checked when it's verified. Otherwise verification only
+	// checks that the data the repository's snapshots refer to is present.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	VerificationReadDataPercent int `json:"verificationReadDataPercent,omitempty"`
+
+	// KeySecret is the name of the Secret, in the BackupRepository's namespace, that holds
+	// the repository's password. The repository uses the key shared by all the repositories
+	// if it's empty.
+	// +optional
+	KeySecret string `json:"keySecret,omitempty"`
 }
 
 // BackupRepositoryPhase represents the lifecycle phase of a BackupRepository.
@@ -70,6 +89,250 @@ type BackupRepositoryStatus struct {
 	// +optional
 	// +nullable
 	LastMaintenanceTime *metav1.Time `json:"lastMaintenanceTime,omitempty"`
+
+	// MaintenanceJob is the name of the Job that's currently running maintenance on the
+	// BackupRepository, if any.
+	// +optional
+	MaintenanceJob string `json:"maintenanceJob,omitempty"`
+
+	// RecentMaintenance is the results of the most recent maintenance runs, oldest first.
+	// +optional
+	RecentMaintenance []BackupRepositoryMaintenanceStatus `json:"recentMaintenance,omitempty"`
+
+	// VerificationJob is the name of the Job that's currently verifying the data in the
+	// BackupRepository, if any.
+	// +optional
+	VerificationJob string `json:"verificationJob,omitempty"`
+
+	// LastVerification is the result of the latest verification of the data in the BackupRepository.
+	// +optional
+	// +nullable
+	LastVerification *BackupRepositoryVerificationStatus `json:"lastVerification,omitempty"`
+
+	// Stats is the storage usage of the BackupRepository, as of its latest maintenance.
+	// +optional
+	// +nullable
+	Stats *BackupRepositoryStats `json:"stats,omitempty"`
+
+	// KeyGeneration is the generation of the key the repository is encrypted with. It's
+	// incremented each time the key is rotated.
+	// +optional
+	KeyGeneration int `json:"keyGeneration,omitempty"`
+
+	// KeyRotationJob is the name of the Job that's currently rotating the key of the
+	// BackupRepository, if any.
+	// +optional
+	KeyRotationJob string `json:"keyRotationJob,omitempty"`
+
+	// LastKeyRotationTime is the last time the key of the BackupRepository was rotated.
+	// +optional
+	// +nullable
+	LastKeyRotationTime *metav1.Time `json:"lastKeyRotationTime,omitempty"`
+
+	// MigrationJob is the name of the Job that's currently migrating the BackupRepository
+	// to another backup storage location, if any.
+	// +optional
+	MigrationJob string `json:"migrationJob,omitempty"`
+
+	// LastMigration is the result of the latest migration of the BackupRepository to another
+	// backup storage location.
+	// +optional
+	// +nullable
+	LastMigration *BackupRepositoryMigrationStatus `json:"lastMigration,omitempty"`
+}
+
+// BackupRepositoryMaintenanceResult is the result of a maintenance run.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type BackupRepositoryMaintenanceResult string
+
+const (
+	BackupRepositoryMaintenanceSucceeded BackupRepositoryMaintenanceResult = "Succeeded"
+	BackupRepositoryMaintenanceFailed    BackupRepositoryMaintenanceResult = "Failed"
+)
+
+// BackupRepositoryMaintenanceStatus is the status of a maintenance run of a BackupRepository.
+type BackupRepositoryMaintenanceStatus struct {
+	// Job is the name of the Job that ran the maintenance.
+	// +optional
+	Job string `json:"job,omitempty"`
+
+	// Result is the result of the maintenance.
+	// +optional
+	Result BackupRepositoryMaintenanceResult `json:"result,omitempty"`
+
+	// StartTimestamp is the time the maintenance started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompleteTimestamp is the time the maintenance finished.
+	// +optional
+	// +nullable
+	CompleteTimestamp *metav1.Time `json:"completeTimestamp,omitempty"`
+
+	// ReclaimedBytes is how much the storage used by the repository shrank during the
+	// maintenance. It's omitted if the size of the repository couldn't be measured.
+	// +optional
+	ReclaimedBytes *int64 `json:"reclaimedBytes,omitempty"`
+
+	// Message is a message about the result of the maintenance, such as the error it failed with.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupRepositoryStats is the storage usage of a BackupRepository.
+type BackupRepositoryStats struct {
+	// CollectionTimestamp is the time the statistics were collected.
+	// +optional
+	// +nullable
+	CollectionTimestamp *metav1.Time `json:"collectionTimestamp,omitempty"`
+
+	// TotalBytes is the size of the storage used by the repository. It's omitted if the
+	// repository can't measure it.
+	// +optional
+	TotalBytes *int64 `json:"totalBytes,omitempty"`
+
+	// UniqueBytes is the size of the deduplicated data of the repository's snapshots, as stored.
+	// +optional
+	UniqueBytes int64 `json:"uniqueBytes,omitempty"`
+
+	// LogicalBytes is the size of the data of the repository's snapshots before deduplication.
+	// It's omitted if the size of some of the snapshots is unknown.
+	// +optional
+	LogicalBytes *int64 `json:"logicalBytes,omitempty"`
+
+	// SnapshotCount is the number of snapshots in the repository.
+	// +optional
+	SnapshotCount int `json:"snapshotCount,omitempty"`
+
+	// Backups is the storage usage of each backup with data in the repository.
+	// +optional
+	Backups []BackupRepositoryBackupStats `json:"backups,omitempty"`
+}
+
+// BackupRepositoryBackupStats is the storage usage of the data a backup has in a BackupRepository.
+type BackupRepositoryBackupStats struct {
+	// Backup is the name of the backup.
+	Backup string `json:"backup"`
+
+	// SnapshotCount is the number of the backup's snapshots in the repository.
+	// +optional
+	SnapshotCount int `json:"snapshotCount,omitempty"`
+
+	// LogicalBytes is the size of the data of the backup's snapshots before deduplication.
+	// +optional
+	LogicalBytes int64 `json:"logicalBytes,omitempty"`
+
+	// AddedBytes is the size of the data the backup's snapshots added to the repository,
+	// i.e. that no earlier snapshot had stored. It's omitted if the repository can't
+	// attribute its data to snapshots.
+	// +optional
+	AddedBytes *int64 `json:"addedBytes,omitempty"`
+}
+
+// BackupRepositoryVerificationResult is the result of a verification of a BackupRepository.
+// +kubebuilder:validation:Enum=Passed;Damaged;Failed
+type BackupRepositoryVerificationResult string
+
+const (
+	// BackupRepositoryVerificationPassed means that no damage was found in the repository.
+	BackupRepositoryVerificationPassed BackupRepositoryVerificationResult = "Passed"
+
+	// BackupRepositoryVerificationDamaged means that some of the repository's data is
+	// missing or unreadable.
+	BackupRepositoryVerificationDamaged BackupRepositoryVerificationResult = "Damaged"
+
+	// BackupRepositoryVerificationFailed means that the repository couldn't be verified.
+	BackupRepositoryVerificationFailed BackupRepositoryVerificationResult = "Failed"
+)
+
+// BackupRepositoryVerificationStatus is the status of a verification of a BackupRepository.
+type BackupRepositoryVerificationStatus struct {
+	// Job is the name of the Job that ran the verification.
+	// +optional
+	Job string `json:"job,omitempty"`
+
+	// Result is the result of the verification.
+	// +optional
+	Result BackupRepositoryVerificationResult `json:"result,omitempty"`
+
+	// ReadDataPercent is the percentage of the repository's data that was read back.
+	// +optional
+	ReadDataPercent int `json:"readDataPercent,omitempty"`
+
+	// StartTimestamp is the time the verification started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompleteTimestamp is the time the verification finished.
+	// +optional
+	// +nullable
+	CompleteTimestamp *metav1.Time `json:"completeTimestamp,omitempty"`
+
+	// DamagedSnapshots are the IDs of the snapshots whose data is damaged. It's empty
+	// for a damaged repository if the damage can't be attributed to particular snapshots,
+	// in which case all of the repository's snapshots are considered damaged.
+	// +optional
+	DamagedSnapshots []string `json:"damagedSnapshots,omitempty"`
+
+	// Message is a message about the result of the verification, such as the damage found
+	// or the error it failed with.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BackupRepositoryMigrationResult is the result of a migration of a BackupRepository.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type BackupRepositoryMigrationResult string
+
+const (
+	BackupRepositoryMigrationSucceeded BackupRepositoryMigrationResult = "Succeeded"
+	BackupRepositoryMigrationFailed    BackupRepositoryMigrationResult = "Failed"
+)
+
+// BackupRepositoryMigrationStatus is the status of a migration of a BackupRepository to
+// another backup storage location.
+type BackupRepositoryMigrationStatus struct {
+	// Job is the name of the Job that ran the migration.
+	// +optional
+	Job string `json:"job,omitempty"`
+
+	// TargetLocation is the name of the BackupStorageLocation the repository was migrated to.
+	// +optional
+	TargetLocation string `json:"targetLocation,omitempty"`
+
+	// TargetRepository is the name of the BackupRepository for the copy of the repository
+	// in the target location.
+	// +optional
+	TargetRepository string `json:"targetRepository,omitempty"`
+
+	// Result is the result of the migration.
+	// +optional
+	Result BackupRepositoryMigrationResult `json:"result,omitempty"`
+
+	// Snapshots is the number of snapshots that were copied to the target location.
+	// +optional
+	Snapshots int `json:"snapshots,omitempty"`
+
+	// Backups is the number of backups whose pod volume backups were updated to refer to
+	// the copy of the repository.
+	// +optional
+	Backups int `json:"backups,omitempty"`
+
+	// StartTimestamp is the time the migration started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompleteTimestamp is the time the migration finished.
+	// +optional
+	// +nullable
+	CompleteTimestamp *metav1.Time `json:"completeTimestamp,omitempty"`
+
+	// Message is a message about the result of the migration, such as the error it failed with.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // TODO(2.0) After converting all resources to use the runtime-controller client,
@@ -18,6 +18,7 @@ package v1
 
 import (
 	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -37,10 +38,19 @@ type BackupStorageLocationSpec struct {
 
 	StorageType `json:",inline"`
 
-	// Default indicates this location is the default backup storage location.
+	// Default indicates this location is a default backup storage location. Backups that
+	// don't specify a storage location are stored in a default location whose placement
+	// rules match them.
 	// +optional
 	Default bool `json:"default,omitempty"`
 
+	// Placement defines which backups that don't specify a storage location are stored
+	// in this location when it's a default location. A default location without placement
+	// rules matches all backups.
+	// +optional
+	// +nullable
+	Placement *BackupStorageLocationPlacement `json:"placement,omitempty"`
+
 	// AccessMode defines the permissions for the backup storage location.
 	// +optional
 	AccessMode BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
@@ -54,8 +64,208 @@ type BackupStorageLocationSpec struct {
 	// +optional
 	// +nullable
 	ValidationFrequency *metav1.Duration `json:"validationFrequency,omitempty"`
+
+	// Compression defines how the contents of backups written to this location are compressed.
+	// If not set, backup contents are compressed with gzip at the default level.
+	// +optional
+	// +nullable
+	Compression *CompressionConfig `json:"compression,omitempty"`
+
+	// Quota defines limits on the storage used by the location. The usage of the location
+	// is measured periodically, and reported in its status.
+	// +optional
+	// +nullable
+	Quota *BackupStorageLocationQuota `json:"quota,omitempty"`
+
+	// Fallback defines another location that new backups are stored in while this location
+	// is unavailable.
+	// +optional
+	// +nullable
+	Fallback *BackupStorageLocationFallback `json:"fallback,omitempty"`
+
+	// Immutability defines how the objects of the backups stored in the location are
+	// protected from being deleted or overwritten until the backups expire. It requires
+	// an object store plugin that supports object lock.
+	// +optional
+	// +nullable
+	Immutability *BackupStorageLocationImmutability `json:"immutability,omitempty"`
+}
+
+// CompressionAlgorithm is the algorithm used to compress backup contents.
+// +kubebuilder:validation:Enum=gzip;zstd;none
+type CompressionAlgorithm string
+
+const (
+	// CompressionAlgorithmGzip compresses backup contents with gzip.
+	CompressionAlgorithmGzip CompressionAlgorithm = "gzip"
+
+	// CompressionAlgorithmZstd compresses backup contents with zstd.
+	CompressionAlgorithmZstd CompressionAlgorithm = "zstd"
+
+	// CompressionAlgorithmNone stores backup contents uncompressed.
+	CompressionAlgorithmNone CompressionAlgorithm = "none"
+)
+
+// CompressionConfig defines how backup contents are compressed.
+type CompressionConfig struct {
+	// Algorithm is the compression algorithm. Valid values are gzip, zstd and none.
+	// +optional
+	Algorithm CompressionAlgorithm `json:"algorithm,omitempty"`
+
+	// Level is the compression level. Its meaning depends on the algorithm: 1-9 for
+	// gzip and 1-4 for zstd, from fastest to best compression. 0 means the algorithm's
+	// default level.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Level int `json:"level,omitempty"`
+}
+
+// BackupStorageLocationPlacement defines the rules that choose a default backup storage
+// location for backups that don't specify one.
+type BackupStorageLocationPlacement struct {
+	// NamespaceSelector matches backups whose included namespaces all have matching labels.
+	// Backups of all namespaces only match an empty selector.
+	// +optional
+	// +nullable
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// BackupSelector matches backups with matching labels.
+	// +optional
+	// +nullable
+	BackupSelector *metav1.LabelSelector `json:"backupSelector,omitempty"`
+
+	// Weight is the priority of the location among the default locations matching a backup.
+	// The backup is stored in the matching location with the highest weight, or the first
+	// one by name if several have the same weight.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// BackupStorageLocationFallback defines the location that backups are stored in while a backup
+// storage location is unavailable.
+type BackupStorageLocationFallback struct {
+	// Location is the name of the fallback backup storage location.
+	Location string `json:"location"`
+
+	// CopyBack defines whether the backups stored in the fallback location are copied back to
+	// this location, and deleted from the fallback location, once this location is available
+	// again. Backups with pod volume or moved snapshot data stay in the fallback location, since
+	// their data is in its backup repositories.
+	// +optional
+	CopyBack bool `json:"copyBack,omitempty"`
+}
+
+// ObjectLockMode is the retention mode of locked objects.
+// +kubebuilder:validation:Enum=Governance;Compliance
+type ObjectLockMode string
+
+const (
+	// ObjectLockModeGovernance protects objects from being deleted or overwritten, except by
+	// users with special permissions in the object storage.
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+
+	// ObjectLockModeCompliance protects objects from being deleted or overwritten by any user.
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
+// BackupStorageLocationImmutability defines how the objects of backups are protected from
+// being deleted or overwritten.
+type BackupStorageLocationImmutability struct {
+	// Mode is the retention mode of the objects: Governance protects them from users without
+	// special permissions in the object storage, and Compliance from all users. The objects are
+	// retained until the backup expires. Defaults to Governance.
+	// +optional
+	Mode ObjectLockMode `json:"mode,omitempty"`
+
+	// LegalHold defines whether a legal hold is also placed on the objects, which protects
+	// them regardless of their retention until it's removed in the object storage.
+	// +optional
+	LegalHold bool `json:"legalHold,omitempty"`
+}
+
+// QuotaEnforcement defines what happens to new backups when a location's usage exceeds its quota.
+// +kubebuilder:validation:Enum=Refuse;Warn
+type QuotaEnforcement string
+
+const (
+	// QuotaEnforcementRefuse fails the validation of new backups.
+	QuotaEnforcementRefuse QuotaEnforcement = "Refuse"
+
+	// QuotaEnforcementWarn adds a warning to new backups.
+	QuotaEnforcementWarn QuotaEnforcement = "Warn"
+)
+
+// BackupStorageLocationQuota defines limits on the storage used by a backup storage location.
+type BackupStorageLocationQuota struct {
+	// Limit is the maximum size of the data stored in the location, including its backup
+	// repositories.
+	// +optional
+	// +nullable
+	Limit *resource.Quantity `json:"limit,omitempty"`
+
+	// WarningThresholdPercent is the percentage of the limit above which the QuotaWarning
+	// condition of the location is set. Defaults to 80.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	WarningThresholdPercent int `json:"warningThresholdPercent,omitempty"`
+
+	// GrowthThresholdPercent is the growth of the usage between two measurements, in percent,
+	// above which the UnexpectedGrowth condition of the location is set. 0 disables it.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GrowthThresholdPercent int `json:"growthThresholdPercent,omitempty"`
+
+	// Enforcement defines what happens to new backups when the usage exceeds the limit: Refuse
+	// fails their validation, and Warn adds a warning to them. Defaults to Refuse.
+	// +optional
+	Enforcement QuotaEnforcement `json:"enforcement,omitempty"`
+}
+
+// BackupStorageLocationUsage is the storage used by a backup storage location.
+type BackupStorageLocationUsage struct {
+	// MeasurementTimestamp is the time the usage was measured.
+	// +optional
+	// +nullable
+	MeasurementTimestamp *metav1.Time `json:"measurementTimestamp,omitempty"`
+
+	// TotalBytes is the size of the data stored in the location. It's unset if the location's
+	// object store plugin can't list the sizes of objects.
+	// +optional
+	// +nullable
+	TotalBytes *int64 `json:"totalBytes,omitempty"`
+
+	// BackupBytes is the size of the backups, restores and backup checkpoints stored in the
+	// location. It's unset if the location's object store plugin can't list the sizes of objects.
+	// +optional
+	// +nullable
+	BackupBytes *int64 `json:"backupBytes,omitempty"`
+
+	// RepositoryBytes is the size of the backup repositories in the location, as of their
+	// latest maintenance.
+	// +optional
+	RepositoryBytes int64 `json:"repositoryBytes,omitempty"`
+
+	// Objects is the number of backup and restore objects stored in the location.
+	// +optional
+	Objects int `json:"objects,omitempty"`
 }
 
+const (
+	// BackupStorageLocationConditionQuotaWarning is set when the usage of a location exceeds
+	// the warning threshold of its quota.
+	BackupStorageLocationConditionQuotaWarning = "QuotaWarning"
+
+	// BackupStorageLocationConditionQuotaExceeded is set when the usage of a location exceeds
+	// its quota.
+	BackupStorageLocationConditionQuotaExceeded = "QuotaExceeded"
+
+	// BackupStorageLocationConditionUnexpectedGrowth is set when the usage of a location grew
+	// more than the growth threshold of its quota between two measurements.
+	BackupStorageLocationConditionUnexpectedGrowth = "UnexpectedGrowth"
+)
+
 // BackupStorageLocationStatus defines the observed state of BackupStorageLocation
 type BackupStorageLocationStatus struct {
 	// Phase is the current state of the BackupStorageLocation.
@@ -86,6 +296,17 @@ type BackupStorageLocationStatus struct {
 	// +optional
 	LastSyncedRevision types.UID `json:"lastSyncedRevision,omitempty"`
 
+	// Usage is the storage used by the location, as of its latest measurement.
+	// +optional
+	// +nullable
+	Usage *BackupStorageLocationUsage `json:"usage,omitempty"`
+
+	// Conditions are the conditions of the location's usage.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
 	// AccessMode is an unused field.
 	//
 	// Deprecated: there is now an AccessMode field on the Spec and this field
@@ -50,4 +50,8 @@ const (
 	// UploadProgressFeatureFlag is the feature flag string that defines whether or not upload progress monitoring is enabled
 	// and whether or not ItemSnapshotters should be invoked
 	UploadProgressFeatureFlag = "EnableUploadProgress"
+
+	// SplitArchivesFeatureFlag is the feature flag string that defines whether or not backups are written
+	// as one archive per namespace plus an index, so restores can fetch only the namespaces they need
+	SplitArchivesFeatureFlag = "EnableSplitArchives"
 )
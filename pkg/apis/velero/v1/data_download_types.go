@@ -0,0 +1,136 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataDownloadSpec is the specification for a DataDownload.
+type DataDownloadSpec struct {
+	// TargetPVC is the name of the restored PersistentVolumeClaim the data is
+	// downloaded for. It must select the volume with the
+	// velero.io/dynamic-pv-restore label.
+	TargetPVC string `json:"targetPVC"`
+
+	// TargetNamespace is the namespace of the restored PersistentVolumeClaim.
+	TargetNamespace string `json:"targetNamespace"`
+
+	// SnapshotID is the identifier of the snapshot of the data in the backup
+	// repository.
+	SnapshotID string `json:"snapshotID"`
+
+	// SourceNamespace is the namespace the data was backed up from, which
+	// identifies the backup repository.
+	SourceNamespace string `json:"sourceNamespace"`
+
+	// BackupStorageLocation is the name of the backup storage location
+	// where the backup repository is stored.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// Cancel requests that the data download be stopped.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// DataDownloadPhase represents the lifecycle phase of a DataDownload.
+// +kubebuilder:validation:Enum=New;Accepted;Prepared;InProgress;Completed;Failed;Canceled
+type DataDownloadPhase string
+
+const (
+	DataDownloadPhaseNew        DataDownloadPhase = "New"
+	DataDownloadPhaseAccepted   DataDownloadPhase = "Accepted"
+	DataDownloadPhasePrepared   DataDownloadPhase = "Prepared"
+	DataDownloadPhaseInProgress DataDownloadPhase = "InProgress"
+	DataDownloadPhaseCompleted  DataDownloadPhase = "Completed"
+	DataDownloadPhaseFailed     DataDownloadPhase = "Failed"
+	DataDownloadPhaseCanceled   DataDownloadPhase = "Canceled"
+)
+
+// DataDownloadStatus is the current status of a DataDownload.
+type DataDownloadStatus struct {
+	// Phase is the current state of the DataDownload.
+	// +optional
+	Phase DataDownloadPhase `json:"phase,omitempty"`
+
+	// Node is the name of the node the data is downloaded on. It's the node
+	// that accepted the DataDownload until the volume is mounted, and then
+	// the node it's mounted on.
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// Message is a message about the data download's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTimestamp records the time the data download was accepted.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the data download was completed.
+	// Completion time is recorded even on failed data downloads.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress holds the total number of bytes of the snapshot and the
+	// current number of downloaded bytes.
+	// +optional
+	Progress PodVolumeOperationProgress `json:"progress,omitempty"`
+}
+
+// DataDownload is only accessed through the controller-runtime client, so no
+// clientset, informers or listers are generated for it.
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Data download status such as New/InProgress"
+// +kubebuilder:printcolumn:name="Created",type="date",JSONPath=".status.startTimestamp",description="Time when the data download was started"
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.targetNamespace",description="Namespace of the persistent volume claim the data is downloaded for"
+// +kubebuilder:printcolumn:name="PVC",type="string",JSONPath=".spec.targetPVC",description="Name of the persistent volume claim the data is downloaded for"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".status.node",description="Name of the node the data is downloaded on"
+// +kubebuilder:printcolumn:name="Storage Location",type="string",JSONPath=".spec.backupStorageLocation",description="Name of the Backup Storage Location the data is downloaded from"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DataDownload restores the data a DataUpload moved to the backup repository
+// into a new volume for a restored PersistentVolumeClaim.
+type DataDownload struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DataDownloadSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status DataDownloadStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=datadownloads,verbs=get;list;watch;create;update;patch;delete
+
+// DataDownloadList is a list of DataDownloads.
+type DataDownloadList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DataDownload `json:"items"`
+}
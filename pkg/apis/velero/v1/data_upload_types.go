@@ -0,0 +1,140 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataUploadSpec is the specification for a DataUpload.
+type DataUploadSpec struct {
+	// VolumeSnapshot is the name of the CSI VolumeSnapshot whose data is
+	// uploaded. It's in the source namespace.
+	VolumeSnapshot string `json:"volumeSnapshot"`
+
+	// SourcePVC is the name of the PersistentVolumeClaim the VolumeSnapshot
+	// was taken of.
+	SourcePVC string `json:"sourcePVC"`
+
+	// SourceNamespace is the namespace of the PersistentVolumeClaim and the
+	// VolumeSnapshot.
+	SourceNamespace string `json:"sourceNamespace"`
+
+	// BackupStorageLocation is the name of the backup storage location
+	// where the backup repository is stored.
+	BackupStorageLocation string `json:"backupStorageLocation"`
+
+	// Cancel requests that the data upload be stopped.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// DataUploadPhase represents the lifecycle phase of a DataUpload.
+// +kubebuilder:validation:Enum=New;Accepted;Prepared;InProgress;Completed;Failed;Canceled
+type DataUploadPhase string
+
+const (
+	DataUploadPhaseNew        DataUploadPhase = "New"
+	DataUploadPhaseAccepted   DataUploadPhase = "Accepted"
+	DataUploadPhasePrepared   DataUploadPhase = "Prepared"
+	DataUploadPhaseInProgress DataUploadPhase = "InProgress"
+	DataUploadPhaseCompleted  DataUploadPhase = "Completed"
+	DataUploadPhaseFailed     DataUploadPhase = "Failed"
+	DataUploadPhaseCanceled   DataUploadPhase = "Canceled"
+)
+
+// DataUploadStatus is the current status of a DataUpload.
+type DataUploadStatus struct {
+	// Phase is the current state of the DataUpload.
+	// +optional
+	Phase DataUploadPhase `json:"phase,omitempty"`
+
+	// Node is the name of the node the data is uploaded from. It's the node
+	// that accepted the DataUpload until the snapshot is mounted, and then
+	// the node it's mounted on.
+	// +optional
+	Node string `json:"node,omitempty"`
+
+	// Path is the full path of the mounted snapshot within the node agent pod.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// SnapshotID is the identifier of the snapshot of the data in the backup
+	// repository.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// Message is a message about the data upload's status.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTimestamp records the time the data upload was accepted.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the data upload was completed.
+	// Completion time is recorded even on failed data uploads.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// Progress holds the total number of bytes of the snapshot and the
+	// current number of uploaded bytes.
+	// +optional
+	Progress PodVolumeOperationProgress `json:"progress,omitempty"`
+}
+
+// DataUpload is only accessed through the controller-runtime client, so no
+// clientset, informers or listers are generated for it.
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Data upload status such as New/InProgress"
+// +kubebuilder:printcolumn:name="Created",type="date",JSONPath=".status.startTimestamp",description="Time when the data upload was started"
+// +kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".spec.sourceNamespace",description="Namespace of the persistent volume claim whose snapshot is uploaded"
+// +kubebuilder:printcolumn:name="PVC",type="string",JSONPath=".spec.sourcePVC",description="Name of the persistent volume claim whose snapshot is uploaded"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".status.node",description="Name of the node the data is uploaded from"
+// +kubebuilder:printcolumn:name="Storage Location",type="string",JSONPath=".spec.backupStorageLocation",description="Name of the Backup Storage Location the data is uploaded to"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DataUpload moves the data of a CSI VolumeSnapshot to the backup repository.
+type DataUpload struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DataUploadSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status DataUploadStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=get;list;watch;create;update;patch;delete
+
+// DataUploadList is a list of DataUploads.
+type DataUploadList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DataUpload `json:"items"`
+}
@@ -25,12 +25,14 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemSnapshots;BackupResourceList;RestoreLog;RestoreResults;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupIndex;BackupNamespaceContents;BackupVolumeSnapshots;BackupItemSnapshots;BackupResourceList;RestoreLog;RestoreResults;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents
 type DownloadTargetKind string
 
 const (
 	DownloadTargetKindBackupLog                       DownloadTargetKind = "BackupLog"
 	DownloadTargetKindBackupContents                  DownloadTargetKind = "BackupContents"
+	DownloadTargetKindBackupIndex                     DownloadTargetKind = "BackupIndex"
+	DownloadTargetKindBackupNamespaceContents         DownloadTargetKind = "BackupNamespaceContents"
 	DownloadTargetKindBackupVolumeSnapshots           DownloadTargetKind = "BackupVolumeSnapshots"
 	DownloadTargetKindBackupItemSnapshots             DownloadTargetKind = "BackupItemSnapshots"
 	DownloadTargetKindBackupResourceList              DownloadTargetKind = "BackupResourceList"
@@ -48,6 +50,10 @@ type DownloadTarget struct {
 
 	// Name is the name of the kubernetes resource with which the file is associated.
 	Name string `json:"name"`
+
+	// Namespace is the namespace whose archive is downloaded when Kind is BackupNamespaceContents.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // DownloadRequestPhase represents the lifecycle phase of a DownloadRequest.
@@ -38,6 +38,18 @@ const (
 	// PVCUIDLabel is the label key used to identify a PVC by uid.
 	PVCUIDLabel = "velero.io/pvc-uid"
 
+	// DataUploadLabel is the label key used to identify the resources Velero
+	// creates to upload the data of a CSI snapshot by the DataUpload's name.
+	DataUploadLabel = "velero.io/data-upload"
+
+	// DataDownloadLabel is the label key used to identify the resources Velero
+	// creates to download data into a volume by the DataDownload's name.
+	DataDownloadLabel = "velero.io/data-download"
+
+	// DynamicPVRestoreLabel is the label key used to bind a restored PVC to the
+	// PV that Velero downloaded its data into.
+	DynamicPVRestoreLabel = "velero.io/dynamic-pv-restore"
+
 	// PodVolumeOperationTimeoutAnnotation is the annotation key used to apply
 	// a backup/restore-specific timeout value for pod volume operations (i.e.
 	// pod volume backups/restores).
@@ -47,6 +59,14 @@ const (
 	// location of a backup.
 	StorageLocationLabel = "velero.io/storage-location"
 
+	// StorageLocationReasonAnnotation is the annotation key used to record
+	// why a backup is stored in its storage location.
+	StorageLocationReasonAnnotation = "velero.io/storage-location-reason"
+
+	// FailoverFromAnnotation is the annotation key used to record the unavailable
+	// backup storage location a backup was stored in the fallback location of.
+	FailoverFromAnnotation = "velero.io/failover-from"
+
 	// VolumeNamespaceLabel is the label key used to identify which
 	// namespace a repository stores backups for.
 	VolumeNamespaceLabel = "velero.io/volume-namespace"
@@ -54,6 +74,32 @@ const (
 	// RepositoryTypeLabel is the label key used to identify the type of a repository
 	RepositoryTypeLabel = "velero.io/repository-type"
 
+	// BackupRepositoryNameLabel is the label key used to identify the backup repository
+	// a maintenance job runs for.
+	BackupRepositoryNameLabel = "velero.io/repository-name"
+
+	// BackupRepositoryJobLabel is the label key used to identify what a job that runs for a
+	// backup repository does, i.e. maintenance or verification.
+	BackupRepositoryJobLabel = "velero.io/repository-job"
+
+	// VerifyBackupRepositoryAnnotation is the annotation key used to request the verification
+	// of a backup repository. Its value is the percentage of the repository's data to read
+	// back, or empty to use the one in the repository's spec.
+	VerifyBackupRepositoryAnnotation = "velero.io/verify-repository"
+
+	// RotateBackupRepositoryKeyAnnotation is the annotation key used to request the rotation
+	// of the key of a backup repository.
+	RotateBackupRepositoryKeyAnnotation = "velero.io/rotate-repository-key"
+
+	// MigrateBackupRepositoryAnnotation is the annotation key used to request the migration
+	// of a backup repository to another backup storage location. Its value is the name of
+	// the location.
+	MigrateBackupRepositoryAnnotation = "velero.io/migrate-to-location"
+
+	// MigratedFromBackupRepositoryAnnotation is the annotation key on a backup repository
+	// that was migrated from another one. Its value is the name of the other repository.
+	MigratedFromBackupRepositoryAnnotation = "velero.io/migrated-from"
+
 	// SourceClusterK8sVersionAnnotation is the label key used to identify the k8s
 	// git version of the backup , i.e. v1.16.4
 	SourceClusterK8sGitVersionAnnotation = "velero.io/source-cluster-k8s-gitversion"
@@ -65,4 +111,8 @@ const (
 	// SourceClusterK8sMajorVersionAnnotation is the label key used to identify the k8s
 	// minor version of the backup , i.e. 16
 	SourceClusterK8sMinorVersionAnnotation = "velero.io/source-cluster-k8s-minor-version"
+
+	// BackupTransformsAnnotation is the annotation key used to record the names
+	// of the backup transforms that modified an item, comma-separated.
+	BackupTransformsAnnotation = "velero.io/backup-transforms"
 )
@@ -0,0 +1,79 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PluginConfigSpec defines the configuration handed to a single plugin.
+type PluginConfigSpec struct {
+	// PluginKind is the kind of the plugin this configuration is for.
+	// +kubebuilder:validation:Enum=BackupItemAction;RestoreItemAction;DeleteItemAction;ObjectStore;VolumeSnapshotter;ItemSnapshotter
+	PluginKind string `json:"pluginKind"`
+
+	// PluginName is the fully-qualified name of the plugin this configuration
+	// is for, e.g. velero.io/change-storage-class.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/.+$`
+	PluginName string `json:"pluginName"`
+
+	// Config is the configuration data for the plugin.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+
+	// IncludedNamespaces is a slice of namespaces whose items this configuration
+	// applies to. For restores, these are the namespaces items are restored into,
+	// after the restore's namespace mapping. If empty, it applies to items in all
+	// namespaces. A configuration scoped to a namespace takes precedence over one
+	// that is not.
+	// +optional
+	// +nullable
+	IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+}
+
+// PluginConfig is only accessed through the controller-runtime client, so no
+// clientset, informers or listers are generated for it.
+// +kubebuilder:object:root=true
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.pluginKind",description="Plugin kind"
+// +kubebuilder:printcolumn:name="Plugin",type="string",JSONPath=".spec.pluginName",description="Plugin name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// PluginConfig holds the configuration for a Velero plugin.
+type PluginConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec PluginConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=pluginconfigs,verbs=get;list;watch;create;update;patch;delete
+
+// PluginConfigList is a list of PluginConfigs.
+type PluginConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PluginConfig `json:"items"`
+}
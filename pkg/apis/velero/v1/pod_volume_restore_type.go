@@ -43,17 +43,23 @@ type PodVolumeRestoreSpec struct {
 
 	// SnapshotID is the ID of the volume snapshot to be restored.
 	SnapshotID string `json:"snapshotID"`
+
+	// Cancel requests that the pod volume restore be stopped.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // PodVolumeRestorePhase represents the lifecycle phase of a PodVolumeRestore.
-// +kubebuilder:validation:Enum=New;InProgress;Completed;Failed
+// +kubebuilder:validation:Enum=New;Queued;InProgress;Completed;Failed;Canceled
 type PodVolumeRestorePhase string
 
 const (
 	PodVolumeRestorePhaseNew        PodVolumeRestorePhase = "New"
+	PodVolumeRestorePhaseQueued     PodVolumeRestorePhase = "Queued"
 	PodVolumeRestorePhaseInProgress PodVolumeRestorePhase = "InProgress"
 	PodVolumeRestorePhaseCompleted  PodVolumeRestorePhase = "Completed"
 	PodVolumeRestorePhaseFailed     PodVolumeRestorePhase = "Failed"
+	PodVolumeRestorePhaseCanceled   PodVolumeRestorePhase = "Canceled"
 )
 
 // PodVolumeRestoreStatus is the current status of a PodVolumeRestore.
@@ -56,6 +56,9 @@ func CustomResources() map[string]typeInfo {
 		"BackupStorageLocation":  newTypeInfo("backupstoragelocations", &BackupStorageLocation{}, &BackupStorageLocationList{}),
 		"VolumeSnapshotLocation": newTypeInfo("volumesnapshotlocations", &VolumeSnapshotLocation{}, &VolumeSnapshotLocationList{}),
 		"ServerStatusRequest":    newTypeInfo("serverstatusrequests", &ServerStatusRequest{}, &ServerStatusRequestList{}),
+		"PluginConfig":           newTypeInfo("pluginconfigs", &PluginConfig{}, &PluginConfigList{}),
+		"DataUpload":             newTypeInfo("datauploads", &DataUpload{}, &DataUploadList{}),
+		"DataDownload":           newTypeInfo("datadownloads", &DataDownload{}, &DataDownloadList{}),
 	}
 }
 
@@ -57,6 +57,33 @@ type RestoreSpec struct {
 	// +nullable
 	ExcludedResources []string `json:"excludedResources,omitempty"`
 
+	// IncludedItems is a slice of patterns of the items to restore, in the
+	// form resource[.group]/name for any namespace or cluster-scoped items,
+	// or resource[.group]/namespace/name, such as configmaps/app-* or
+	// secrets/ns1/db-creds. Namespaces and names may contain * and ?
+	// wildcards; namespaces are the items' namespaces in the backup. If
+	// empty, all items are included. The PersistentVolumeClaims of included
+	// Pods, and the PersistentVolumes, CSI VolumeSnapshots and Restic backed
+	// up Pods of included PersistentVolumeClaims, are included too.
+	// +optional
+	// +nullable
+	IncludedItems []string `json:"includedItems,omitempty"`
+
+	// ExcludedItems is a slice of patterns, in the same form as
+	// IncludedItems, of the items that are not included in the restore.
+	// +optional
+	// +nullable
+	ExcludedItems []string `json:"excludedItems,omitempty"`
+
+	// FieldSelectors maps resources, in the form resource[.group], to field
+	// selectors that the items of the resource must match to be restored,
+	// such as status.phase=Running or metadata.name!=default. Fields are
+	// selected by their path in the item. Items of other resources aren't
+	// filtered by fields.
+	// +optional
+	// +nullable
+	FieldSelectors map[string]string `json:"fieldSelectors,omitempty"`
+
 	// NamespaceMapping is a map of source namespace names
 	// to target namespace names to restore into. Any source
 	// namespaces not included in the map will be restored into
@@ -64,6 +91,15 @@ type RestoreSpec struct {
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
+	// NamespaceMappingRules map the source namespaces in the backup that
+	// match a pattern to target namespace names. They're resolved against
+	// the namespaces in the backup when the restore is validated, and the
+	// resulting mappings are added to NamespaceMapping. Entries already in
+	// NamespaceMapping take precedence, and the first matching rule is used.
+	// +optional
+	// +nullable
+	NamespaceMappingRules []NamespaceMappingRule `json:"namespaceMappingRules,omitempty"`
+
 	// LabelSelector is a metav1.LabelSelector to filter with
 	// when restoring individual objects from the backup. If empty
 	// or nil, all objects are included. Optional.
@@ -97,6 +133,13 @@ type RestoreSpec struct {
 	// +nullable
 	PreserveNodePorts *bool `json:"preserveNodePorts,omitempty"`
 
+	// ServicePolicy specifies how the ClusterIPs and load balancer settings
+	// of Services are restored. If nil, ClusterIPs are reassigned and load
+	// balancer settings are restored as they were backed up.
+	// +optional
+	// +nullable
+	ServicePolicy *ServiceRestorePolicy `json:"servicePolicy,omitempty"`
+
 	// IncludeClusterResources specifies whether cluster-scoped resources
 	// should be included for consideration in the restore. If null, defaults
 	// to true.
@@ -112,6 +155,53 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
+
+	// WebhookRestorePolicy specifies when ValidatingWebhookConfigurations,
+	// MutatingWebhookConfigurations and APIServices are restored. Deferred,
+	// the default, restores them after all other items have been restored and
+	// the post-restore hooks have finished. ServiceReady restores each of them
+	// as soon as the Services it references have ready endpoints, and the rest
+	// after the post-restore hooks have finished. Immediate restores them in
+	// restore order.
+	// +optional
+	WebhookRestorePolicy WebhookRestorePolicy `json:"webhookRestorePolicy,omitempty"`
+
+	// Cancel requests that the restore be stopped. A restore that has not
+	// finished yet stops restoring items, cancels its pod volume restores
+	// and ends in the Canceled phase. Items restored before the cancellation
+	// are left in place.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// RollbackOnFailure specifies whether the items the restore created or
+	// patched should be rolled back if the restore fails or partially fails.
+	// +optional
+	RollbackOnFailure bool `json:"rollbackOnFailure,omitempty"`
+
+	// Rollback requests that the items the restore created be deleted, and the
+	// items it patched be reverted to their state before the restore. It takes
+	// effect once the restore has finished.
+	// +optional
+	Rollback bool `json:"rollback,omitempty"`
+}
+
+// NamespaceMappingRule maps the source namespaces that match a pattern to target namespaces.
+type NamespaceMappingRule struct {
+	// Pattern matches the whole name of source namespaces. It's a glob
+	// pattern in which "*" matches any sequence of characters and "?"
+	// matches a single character, or a regular expression if Regex is set.
+	Pattern string `json:"pattern"`
+
+	// Regex specifies whether Pattern is a regular expression.
+	// +optional
+	Regex bool `json:"regex,omitempty"`
+
+	// Target is the template of the target namespace name. "${1}", "${2}"
+	// and so on are replaced with the text matched by the pattern's capture
+	// groups, or by its wildcards for a glob pattern, and "${0}" with the
+	// whole source namespace name. For example the pattern "*" with the
+	// target "${1}-dr" adds a "-dr" suffix to every namespace.
+	Target string `json:"target"`
 }
 
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
@@ -220,7 +310,7 @@ type InitRestoreHook struct {
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;Completed;PartiallyFailed;Failed;Canceled
 type RestorePhase string
 
 const (
@@ -247,6 +337,9 @@ const (
 	// The failing error is recorded in status.FailureReason.
 	RestorePhaseFailed RestorePhase = "Failed"
 
+	// RestorePhaseCanceled means the restore was canceled before it finished.
+	RestorePhaseCanceled RestorePhase = "Canceled"
+
 	// PolicyTypeNone means velero will not overwrite the resource
 	// in cluster with the one in backup whether changed/unchanged.
 	PolicyTypeNone PolicyType = "none"
@@ -301,6 +394,70 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	Progress *RestoreProgress `json:"progress,omitempty"`
+
+	// Rollback contains information about the rollback of the restore, if
+	// it was rolled back.
+	// +optional
+	// +nullable
+	Rollback *RestoreRollbackStatus `json:"rollback,omitempty"`
+}
+
+// RestoreRollbackPhase is a string representation of the lifecycle phase
+// of the rollback of a Velero restore
+// +kubebuilder:validation:Enum=InProgress;Completed;PartiallyFailed;Failed
+type RestoreRollbackPhase string
+
+const (
+	// RestoreRollbackPhaseInProgress means the rollback is currently executing.
+	RestoreRollbackPhaseInProgress RestoreRollbackPhase = "InProgress"
+
+	// RestoreRollbackPhaseCompleted means all of the restore's changes were
+	// rolled back.
+	RestoreRollbackPhaseCompleted RestoreRollbackPhase = "Completed"
+
+	// RestoreRollbackPhasePartiallyFailed means the rollback ran to completion
+	// but some of the restore's changes could not be rolled back. They're
+	// listed in status.rollback.errors.
+	RestoreRollbackPhasePartiallyFailed RestoreRollbackPhase = "PartiallyFailed"
+
+	// RestoreRollbackPhaseFailed means the rollback was unable to execute.
+	// The failing error is recorded in status.rollback.failureReason.
+	RestoreRollbackPhaseFailed RestoreRollbackPhase = "Failed"
+)
+
+// RestoreRollbackStatus captures the status of the rollback of a Velero restore
+type RestoreRollbackStatus struct {
+	// Phase is the current state of the rollback.
+	// +optional
+	Phase RestoreRollbackPhase `json:"phase,omitempty"`
+
+	// StartTimestamp records the time the rollback was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the rollback was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// ItemsDeleted is the number of items created by the restore that were deleted.
+	// +optional
+	ItemsDeleted int `json:"itemsDeleted,omitempty"`
+
+	// ItemsReverted is the number of items patched by the restore that were
+	// reverted to their state before the restore.
+	// +optional
+	ItemsReverted int `json:"itemsReverted,omitempty"`
+
+	// Errors lists the items that could not be rolled back, and why.
+	// +optional
+	// +nullable
+	Errors []string `json:"errors,omitempty"`
+
+	// FailureReason is an error that caused the entire rollback to fail.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
@@ -347,3 +504,89 @@ type RestoreList struct {
 
 // PolicyType helps specify the ExistingResourcePolicy
 type PolicyType string
+
+// ServiceRestorePolicy specifies how the ClusterIPs and load balancer settings of Services
+// are restored.
+type ServiceRestorePolicy struct {
+	// ClusterIPPolicy specifies whether the ClusterIPs of Services are
+	// reassigned by the cluster, the default, or preserved when they're
+	// not in use in the target cluster.
+	// +optional
+	ClusterIPPolicy ServiceClusterIPPolicy `json:"clusterIPPolicy,omitempty"`
+
+	// LoadBalancerAnnotationPolicy specifies what's done with the cloud
+	// provider load balancer annotations of LoadBalancer Services. Keep,
+	// the default, restores them as they were backed up. Strip removes
+	// them. Remap renames them according to LoadBalancerAnnotationMapping
+	// and removes the ones that aren't mapped.
+	// +optional
+	LoadBalancerAnnotationPolicy LoadBalancerAnnotationPolicy `json:"loadBalancerAnnotationPolicy,omitempty"`
+
+	// LoadBalancerAnnotationMapping maps the keys of load balancer
+	// annotations in the backup to the keys they're restored as, when the
+	// LoadBalancerAnnotationPolicy is Remap.
+	// +optional
+	LoadBalancerAnnotationMapping map[string]string `json:"loadBalancerAnnotationMapping,omitempty"`
+
+	// PreserveLoadBalancerIP specifies whether the loadBalancerIP of
+	// LoadBalancer Services is restored. If null, defaults to true.
+	// +optional
+	// +nullable
+	PreserveLoadBalancerIP *bool `json:"preserveLoadBalancerIP,omitempty"`
+}
+
+// ServiceClusterIPPolicy is how the ClusterIPs of Services are restored.
+// +kubebuilder:validation:Enum=Reassign;PreserveIfAvailable
+type ServiceClusterIPPolicy string
+
+const (
+	// ServiceClusterIPPolicyReassign means the ClusterIPs of Services are
+	// cleared so that the cluster assigns new ones.
+	ServiceClusterIPPolicyReassign ServiceClusterIPPolicy = "Reassign"
+
+	// ServiceClusterIPPolicyPreserveIfAvailable means the ClusterIPs of
+	// Services are preserved unless another Service in the target cluster
+	// uses them or the target cluster rejects them, in which case they're
+	// reassigned.
+	ServiceClusterIPPolicyPreserveIfAvailable ServiceClusterIPPolicy = "PreserveIfAvailable"
+)
+
+// LoadBalancerAnnotationPolicy is what's done with the cloud provider load balancer
+// annotations of LoadBalancer Services when they're restored.
+// +kubebuilder:validation:Enum=Keep;Strip;Remap
+type LoadBalancerAnnotationPolicy string
+
+const (
+	// LoadBalancerAnnotationPolicyKeep means load balancer annotations are
+	// restored as they were backed up.
+	LoadBalancerAnnotationPolicyKeep LoadBalancerAnnotationPolicy = "Keep"
+
+	// LoadBalancerAnnotationPolicyStrip means load balancer annotations are
+	// removed.
+	LoadBalancerAnnotationPolicyStrip LoadBalancerAnnotationPolicy = "Strip"
+
+	// LoadBalancerAnnotationPolicyRemap means load balancer annotations are
+	// renamed according to the LoadBalancerAnnotationMapping, and the ones
+	// that aren't mapped are removed.
+	LoadBalancerAnnotationPolicyRemap LoadBalancerAnnotationPolicy = "Remap"
+)
+
+// WebhookRestorePolicy is when admission webhook configurations and APIServices are restored.
+// +kubebuilder:validation:Enum=Deferred;ServiceReady;Immediate
+type WebhookRestorePolicy string
+
+const (
+	// WebhookRestorePolicyDeferred means admission webhook configurations and
+	// APIServices are restored after all other items have been restored and
+	// the post-restore hooks have finished.
+	WebhookRestorePolicyDeferred WebhookRestorePolicy = "Deferred"
+
+	// WebhookRestorePolicyServiceReady means admission webhook configurations
+	// and APIServices are restored as soon as the Services they reference have
+	// ready endpoints, or once the post-restore hooks have finished.
+	WebhookRestorePolicyServiceReady WebhookRestorePolicy = "ServiceReady"
+
+	// WebhookRestorePolicyImmediate means admission webhook configurations and
+	// APIServices are restored in restore order, like other items.
+	WebhookRestorePolicyImmediate WebhookRestorePolicy = "Immediate"
+)
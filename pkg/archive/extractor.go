@@ -18,12 +18,12 @@ package archive
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"io"
 	"path/filepath"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
@@ -41,11 +41,12 @@ func NewExtractor(log logrus.FieldLogger, fs filesystem.Interface) *Extractor {
 	}
 }
 
-// UnzipAndExtractBackup extracts a reader on a gzipped tarball to a local temp directory
+// UnzipAndExtractBackup extracts a reader on a compressed tarball to a local temp directory.
+// The compression algorithm is detected from the data.
 func (e *Extractor) UnzipAndExtractBackup(src io.Reader) (string, error) {
-	gzr, err := gzip.NewReader(src)
+	gzr, err := compression.NewReader(src)
 	if err != nil {
-		e.log.Infof("error creating gzip reader: %v", err)
+		e.log.Infof("error creating decompressing reader: %v", err)
 		return "", err
 	}
 	defer gzr.Close()
@@ -53,6 +54,19 @@ func (e *Extractor) UnzipAndExtractBackup(src io.Reader) (string, error) {
 	return e.readBackup(tar.NewReader(gzr))
 }
 
+// UnzipAndExtractBackupInto extracts a reader on a compressed tarball into dir, which must exist.
+// It's used to extract the archives of a backup in the split archive format into one directory.
+func (e *Extractor) UnzipAndExtractBackupInto(src io.Reader, dir string) error {
+	gzr, err := compression.NewReader(src)
+	if err != nil {
+		e.log.Infof("error creating decompressing reader: %v", err)
+		return err
+	}
+	defer gzr.Close()
+
+	return e.readBackupInto(tar.NewReader(gzr), dir)
+}
+
 func (e *Extractor) writeFile(target string, tarRdr *tar.Reader) error {
 	file, err := e.fs.Create(target)
 	if err != nil {
@@ -73,6 +87,14 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 		return "", err
 	}
 
+	if err := e.readBackupInto(tarRdr, dir); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+func (e *Extractor) readBackupInto(tarRdr *tar.Reader, dir string) error {
 	for {
 		header, err := tarRdr.Next()
 
@@ -81,7 +103,7 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 		}
 		if err != nil {
 			e.log.Infof("error reading tar: %v", err)
-			return "", err
+			return err
 		}
 
 		target := filepath.Join(dir, header.Name)
@@ -91,7 +113,7 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 			err := e.fs.MkdirAll(target, header.FileInfo().Mode())
 			if err != nil {
 				e.log.Infof("mkdirall error: %v", err)
-				return "", err
+				return err
 			}
 
 		case tar.TypeReg:
@@ -99,16 +121,16 @@ func (e *Extractor) readBackup(tarRdr *tar.Reader) (string, error) {
 			err := e.fs.MkdirAll(filepath.Dir(target), header.FileInfo().Mode())
 			if err != nil {
 				e.log.Infof("mkdirall error: %v", err)
-				return "", err
+				return err
 			}
 
 			// create the file
 			if err := e.writeFile(target, tarRdr); err != nil {
 				e.log.Infof("error copying: %v", err)
-				return "", err
+				return err
 			}
 		}
 	}
 
-	return dir, nil
+	return nil
 }
@@ -0,0 +1,49 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"sort"
+
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// Index describes the archives of a backup in the split archive format. In that format
+// the backup's main tarball holds the metadata and cluster-scoped items, and the items
+// of each namespace are in a separate tarball.
+type Index struct {
+	// Namespaces maps each namespace that has its own archive to a description of it.
+	Namespaces map[string]IndexEntry `json:"namespaces"`
+}
+
+// IndexEntry describes the archive of a namespace.
+type IndexEntry struct {
+	// Files is the number of files in the archive.
+	Files int `json:"files"`
+}
+
+// NamespacesFor returns the sorted namespaces in the index that namespaces includes.
+func (i *Index) NamespacesFor(namespaces *collections.IncludesExcludes) []string {
+	var res []string
+	for ns := range i.Namespaces {
+		if namespaces.ShouldInclude(ns) {
+			res = append(res, ns)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,70 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"archive/tar"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
+)
+
+// Namespaces returns the namespaces in a compressed backup tarball: the namespaces of its
+// namespaced items, and the namespaces it holds as cluster-scoped items. Only the tarball's
+// headers are read.
+func Namespaces(src io.Reader) (sets.String, error) {
+	r, err := compression.NewReader(src)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating decompressing reader")
+	}
+	defer r.Close()
+
+	namespaces := sets.NewString()
+	tarRdr := tar.NewReader(r)
+	for {
+		header, err := tarRdr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading tar")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// items are at resources/<resource>[/<version>-preferredversion]/namespaces/<namespace>/<name>.json
+		// or, for cluster-scoped items, at resources/<resource>[/<version>-preferredversion]/cluster/<name>.json
+		parts := strings.Split(header.Name, "/")
+		if len(parts) < 4 || parts[0] != velerov1api.ResourcesDir {
+			continue
+		}
+		switch {
+		case len(parts) >= 5 && parts[len(parts)-3] == velerov1api.NamespaceScopedDir:
+			namespaces.Insert(parts[len(parts)-2])
+		case parts[1] == "namespaces" && parts[len(parts)-2] == velerov1api.ClusterScopedDir:
+			namespaces.Insert(strings.TrimSuffix(parts[len(parts)-1], ".json"))
+		}
+	}
+
+	return namespaces, nil
+}
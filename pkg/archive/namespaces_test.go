@@ -0,0 +1,48 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNamespaces(t *testing.T) {
+	tarball := test.NewTarWriter(t).
+		AddItems("namespaces", builder.ForNamespace("ns-1").Result(), builder.ForNamespace("ns-2").Result()).
+		AddItems("pods", builder.ForPod("ns-2", "pod-1").Result(), builder.ForPod("ns-3", "pod-2").Result()).
+		AddItems("deployments.apps", builder.ForDeployment("ns-4", "deploy-1").Result()).
+		AddItems("persistentvolumes", builder.ForPersistentVolume("pv-1").Result()).
+		Add("resources/pods/v1-preferredversion/namespaces/ns-5/pod-3.json", builder.ForPod("ns-5", "pod-3").Result()).
+		Add("metadata/version", []byte("1.1.0")).
+		Done()
+
+	namespaces, err := Namespaces(tarball)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1", "ns-2", "ns-3", "ns-4", "ns-5"}, namespaces.List())
+}
+
+func TestNamespacesInvalidTarball(t *testing.T) {
+	_, err := Namespaces(strings.NewReader("not a tarball"))
+	assert.Error(t, err)
+}
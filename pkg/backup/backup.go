@@ -18,7 +18,6 @@ package backup
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -26,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
@@ -41,6 +41,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/features"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
@@ -50,6 +51,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 // BackupVersion is the current backup major version for Velero.
@@ -59,6 +61,10 @@ const BackupVersion = 1
 // BackupFormatVersion is the current backup version for Velero, including major, minor, and patch.
 const BackupFormatVersion = "1.1.0"
 
+// SplitArchivesBackupFormatVersion is the backup version for Velero of backups written with
+// one archive per namespace, when the EnableSplitArchives feature flag is enabled.
+const SplitArchivesBackupFormatVersion = "1.2.0"
+
 // Backupper performs backups.
 type Backupper interface {
 	// Backup takes a backup using the specification in the velerov1api.Backup and writes backup and log data
@@ -187,14 +193,36 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	backupItemActionResolver framework.BackupItemActionResolver,
 	itemSnapshotterResolver framework.ItemSnapshotterResolver,
 	volumeSnapshotterGetter VolumeSnapshotterGetter) error {
-	gzippedData := gzip.NewWriter(backupFile)
-	defer gzippedData.Close()
+	compressionConfig := backupRequest.compression()
+	compressedData, err := compression.NewWriter(backupFile, compressionConfig)
+	if err != nil {
+		return errors.Wrap(err, "error creating compressing writer")
+	}
+	defer compressedData.Close()
 
-	tw := tar.NewWriter(gzippedData)
+	if compressionConfig != nil {
+		backupRequest.Status.Compression = compression.Algorithm(compressionConfig)
+	}
+
+	tw := tar.NewWriter(compressedData)
 	defer tw.Close()
 
+	// items are written to the main tarball, unless the backup is split into one archive per namespace
+	var itemWriter tarWriter = tw
+	var namespaceArchives *namespaceArchivesWriter
+	formatVersion := BackupFormatVersion
+	if features.IsEnabled(velerov1api.SplitArchivesFeatureFlag) {
+		namespaceArchives = newNamespaceArchivesWriter(tw, compressionConfig)
+		// the archives' temp files are only kept if the backup finishes
+		defer namespaceArchives.remove()
+
+		itemWriter = namespaceArchives
+		formatVersion = SplitArchivesBackupFormatVersion
+		backupRequest.Status.FormatVersion = formatVersion
+	}
+
 	log.Info("Writing backup version file")
-	if err := kb.writeBackupVersion(tw); err != nil {
+	if err := kb.writeBackupVersion(tw, formatVersion); err != nil {
 		return errors.WithStack(err)
 	}
 
@@ -207,13 +235,18 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	log.Infof("Excluding resources: %s", backupRequest.ResourceIncludesExcludes.ExcludesString())
 	log.Infof("Backing up all pod volumes using Restic: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.DefaultVolumesToRestic))
 
-	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Debugf("Error from getResourceHooks")
 		return err
 	}
 
+	backupRequest.itemTransforms, err = getItemTransforms(backupRequest.Spec.Transforms, kb.discoveryHelper)
+	if err != nil {
+		log.WithError(errors.WithStack(err)).Debugf("Error from getItemTransforms")
+		return err
+	}
+
 	backupRequest.ResolvedActions, err = backupItemActionResolver.ResolveActions(kb.discoveryHelper, log)
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Debugf("Error from backupItemActionResolver.ResolveActions")
@@ -228,6 +261,11 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 
 	backupRequest.BackedUpItems = map[itemKey]struct{}{}
 
+	resumedGroupResources, err := backupRequest.writeResumedItems(itemWriter, log)
+	if err != nil {
+		return errors.Wrap(err, "error writing items backed up before the backup was interrupted")
+	}
+
 	podVolumeTimeout := kb.resticTimeout
 	if val := backupRequest.Annotations[velerov1api.PodVolumeOperationTimeoutAnnotation]; val != "" {
 		parsed, err := time.ParseDuration(val)
@@ -238,7 +276,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	ctx, cancelFunc := context.WithTimeout(backupRequest.ctx(), podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticBackupper podvolume.Backupper
@@ -279,7 +317,7 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 
 	itemBackupper := &itemBackupper{
 		backupRequest:           backupRequest,
-		tarWriter:               tw,
+		tarWriter:               itemWriter,
 		dynamicFactory:          kb.dynamicFactory,
 		discoveryHelper:         kb.discoveryHelper,
 		resticBackupper:         resticBackupper,
@@ -336,10 +374,23 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}()
 
+	if backupRequest.ResumeFrom != nil {
+		log.Infof("Resuming backup from checkpoint taken at %s, reusing %d pod volume backups and %d volume snapshots",
+			backupRequest.ResumeFrom.Time, len(backupRequest.ResumeFrom.PodVolumeBackups), len(backupRequest.ResumeFrom.VolumeSnapshots))
+	}
+
 	backedUpGroupResources := map[schema.GroupResource]bool{}
+	for _, gr := range resumedGroupResources {
+		backedUpGroupResources[gr] = true
+	}
 	totalItems := len(items)
 
 	for i, item := range items {
+		if backupRequest.ctx().Err() != nil {
+			log.Info("Backup was canceled, skipping remaining items")
+			break
+		}
+
 		log.WithFields(map[string]interface{}{
 			"progress":  "",
 			"resource":  item.groupResource.String(),
@@ -386,11 +437,17 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 			"namespace": item.namespace,
 			"name":      item.name,
 		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", len(backupRequest.BackedUpItems), totalItems)
+
+		backupRequest.checkpoint(log)
 	}
 
 	// no more progress updates will be sent on the 'update' channel
 	quit <- struct{}{}
 
+	if err := backupRequest.ctx().Err(); err != nil {
+		return errors.Wrap(err, "backup was canceled")
+	}
+
 	// back up CRD for resource if found. We should only need to do this if we've backed up at least
 	// one item for the resource and IncludeClusterResources is nil. If IncludeClusterResources is false
 	// we don't want to back it up, and if it's true it will already be included.
@@ -400,6 +457,8 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}
 
+	sort.Strings(backupRequest.Status.ClusterDependencies)
+
 	// do a final update on progress since we may have just added some CRDs and may not have updated
 	// for the last few processed items.
 	backupRequest.Status.Progress.TotalItems = len(backupRequest.BackedUpItems)
@@ -412,6 +471,14 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 
 	log.WithField("progress", "").Infof("Backed up a total of %d items", len(backupRequest.BackedUpItems))
 
+	if namespaceArchives != nil {
+		if err := namespaceArchives.Close(); err != nil {
+			return errors.Wrap(err, "error closing namespace archives")
+		}
+		backupRequest.NamespaceArchives = namespaceArchives.paths()
+		backupRequest.ArchiveIndex = namespaceArchives.index()
+	}
+
 	return nil
 }
 
@@ -472,9 +539,9 @@ func (kb *kubernetesBackupper) backupCRD(log logrus.FieldLogger, gr schema.Group
 	kb.backupItem(log, gvr.GroupResource(), itemBackupper, unstructured, gvr)
 }
 
-func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer) error {
+func (kb *kubernetesBackupper) writeBackupVersion(tw *tar.Writer, formatVersion string) error {
 	versionFile := filepath.Join(velerov1api.MetadataDir, "version")
-	versionString := fmt.Sprintf("%s\n", BackupFormatVersion)
+	versionString := fmt.Sprintf("%s\n", formatVersion)
 
 	hdr := &tar.Header{
 		Name:     versionFile,
@@ -51,6 +51,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	testutil "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -115,6 +116,59 @@ func TestBackedUpItemsMatchesTarballContents(t *testing.T) {
 	assertTarballContents(t, backupFile, append(expectedFiles, "metadata/version")...)
 }
 
+// TestBackupCompression verifies that the backup tarball is compressed with the
+// algorithm configured on the backup's storage location, and that it's recorded in
+// the backup's status.
+func TestBackupCompression(t *testing.T) {
+	tests := []struct {
+		name                string
+		compression         *velerov1.CompressionConfig
+		wantMagic           []byte
+		wantStatusAlgorithm velerov1.CompressionAlgorithm
+	}{
+		{
+			name:      "gzip by default",
+			wantMagic: []byte{0x1f, 0x8b},
+		},
+		{
+			name:                "zstd",
+			compression:         &velerov1.CompressionConfig{Algorithm: velerov1.CompressionAlgorithmZstd, Level: 1},
+			wantMagic:           []byte{0x28, 0xb5, 0x2f, 0xfd},
+			wantStatusAlgorithm: velerov1.CompressionAlgorithmZstd,
+		},
+		{
+			name:                "none",
+			compression:         &velerov1.CompressionConfig{Algorithm: velerov1.CompressionAlgorithmNone},
+			wantMagic:           []byte("metadata/version"),
+			wantStatusAlgorithm: velerov1.CompressionAlgorithmNone,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			req := &Request{
+				Backup:          defaultBackup().Result(),
+				StorageLocation: builder.ForBackupStorageLocation("velero", "default").Result(),
+			}
+			req.StorageLocation.Spec.Compression = tc.compression
+			backupFile := bytes.NewBuffer([]byte{})
+
+			h.addItems(t, test.Pods(builder.ForPod("foo", "bar").Result()))
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			assert.True(t, bytes.HasPrefix(backupFile.Bytes(), tc.wantMagic))
+			assert.Equal(t, tc.wantStatusAlgorithm, req.Status.Compression)
+			assertTarballContents(t, backupFile,
+				"metadata/version",
+				"resources/pods/namespaces/foo/bar.json",
+				"resources/pods/v1-preferredversion/namespaces/foo/bar.json",
+			)
+		})
+	}
+}
+
 // TestBackupProgressIsUpdated verifies that after a backup has run, its
 // status.progress fields are updated to reflect the total number of items
 // backed up. It validates this by comparing their values to the length of
@@ -2722,6 +2776,74 @@ func TestBackupWithRestic(t *testing.T) {
 	}
 }
 
+// TestBackupResumesCheckpointedItems verifies that a resumed backup writes the items of its
+// checkpoint as they were rather than backing them up again, and that the checkpoints it saves
+// include them.
+func TestBackupResumesCheckpointedItems(t *testing.T) {
+	var (
+		h          = newHarness(t)
+		backupFile = bytes.NewBuffer([]byte{})
+		action     = new(recordResourcesAction)
+		saved      *Checkpoint
+	)
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").Result(),
+		builder.ForPod("ns-1", "pod-2").Result(),
+	))
+
+	resumed := []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"pod-1","namespace":"ns-1","labels":{"resumed":"true"}}}`)
+	req := &Request{
+		Backup:             defaultBackup().Result(),
+		CheckpointInterval: time.Nanosecond,
+		SaveCheckpoint: func(checkpoint *Checkpoint) error {
+			saved = checkpoint
+			return nil
+		},
+		ResumeFrom: &Checkpoint{
+			BackedUpItems: []CheckpointItem{{
+				Resource:  "v1/Pod",
+				Namespace: "ns-1",
+				Name:      "pod-1",
+				Paths:     []string{"resources/pods/namespaces/ns-1/pod-1.json", "resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json"},
+				Content:   resumed,
+			}},
+		},
+	}
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, []biav1.BackupItemAction{action}, nil))
+
+	// the action only runs for the item that wasn't in the checkpoint
+	assert.Equal(t, []string{"ns-1/pod-2"}, action.ids)
+	assert.Len(t, req.BackedUpItems, 2)
+
+	assertTarballFileContents(t, bytes.NewReader(backupFile.Bytes()), map[string]unstructuredObject{
+		"resources/pods/namespaces/ns-1/pod-1.json": {
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"name":      "pod-1",
+				"namespace": "ns-1",
+				"labels":    map[string]interface{}{"resumed": "true"},
+			},
+		},
+	})
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/pods/namespaces/ns-1/pod-1.json",
+		"resources/pods/namespaces/ns-1/pod-2.json",
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-2.json",
+	)
+
+	require.NotNil(t, saved)
+	var names []string
+	for _, item := range saved.BackedUpItems {
+		names = append(names, item.Name)
+	}
+	assert.Equal(t, []string{"pod-1", "pod-2"}, names)
+}
+
 // pluggableAction is a backup item action that can be plugged with an Execute
 // function body at runtime.
 type pluggableAction struct {
@@ -2818,12 +2940,12 @@ func toUnstructuredOrFail(t *testing.T, obj interface{}) map[string]interface{}
 	return res
 }
 
-// assertTarballContents verifies that the gzipped tarball stored in the provided
+// assertTarballContents verifies that the compressed tarball stored in the provided
 // backupFile contains exactly the file names specified.
 func assertTarballContents(t *testing.T, backupFile io.Reader, items ...string) {
 	t.Helper()
 
-	gzr, err := gzip.NewReader(backupFile)
+	gzr, err := compression.NewReader(backupFile)
 	require.NoError(t, err)
 
 	r := tar.NewReader(gzr)
@@ -0,0 +1,282 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// Checkpoint records the progress of a running backup so that the backup can be
+// resumed if the Velero server restarts before it finishes.
+type Checkpoint struct {
+	// Time is when the checkpoint was taken.
+	Time time.Time `json:"time"`
+
+	// BackedUpItems are the items that had been backed up, with their content in the backup.
+	BackedUpItems []CheckpointItem `json:"backedUpItems,omitempty"`
+
+	// PodVolumeBackups are the pod volume backups that had completed.
+	PodVolumeBackups []*velerov1api.PodVolumeBackup `json:"podVolumeBackups,omitempty"`
+
+	// VolumeSnapshots are the native volume snapshots that had been taken.
+	VolumeSnapshots []*volume.Snapshot `json:"volumeSnapshots,omitempty"`
+}
+
+// CheckpointItem is an item that had been backed up when a checkpoint was taken. A resumed
+// backup writes its content to the backup again rather than backing the item up again, so the
+// item's hooks and actions don't run twice and it matches the volume data that's reused.
+type CheckpointItem struct {
+	// Resource is the item's API version and kind, e.g. "apps/v1/Deployment".
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+
+	// Paths are the paths the item was written to in the backup, all with Content.
+	Paths   []string        `json:"paths"`
+	Content json.RawMessage `json:"content"`
+}
+
+// CheckpointSaver persists a backup's checkpoint.
+type CheckpointSaver func(checkpoint *Checkpoint) error
+
+// EncodeCheckpoint writes checkpoint to w as gzipped JSON.
+func EncodeCheckpoint(checkpoint *Checkpoint, w io.Writer) error {
+	gzw := gzip.NewWriter(w)
+	if err := json.NewEncoder(gzw).Encode(checkpoint); err != nil {
+		gzw.Close()
+		return errors.Wrap(err, "error encoding checkpoint")
+	}
+	return errors.Wrap(gzw.Close(), "error closing gzip writer")
+}
+
+// DecodeCheckpoint reads a checkpoint written by EncodeCheckpoint from r.
+func DecodeCheckpoint(r io.Reader) (*Checkpoint, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	checkpoint := new(Checkpoint)
+	if err := json.NewDecoder(gzr).Decode(checkpoint); err != nil {
+		return nil, errors.Wrap(err, "error decoding checkpoint")
+	}
+	return checkpoint, nil
+}
+
+// newCheckpoint returns a checkpoint of the backup's current progress. Only completed
+// pod volume backups and volume snapshots are included since they're the only ones that
+// can be reused.
+func (r *Request) newCheckpoint(now time.Time) *Checkpoint {
+	checkpoint := &Checkpoint{
+		Time:          now,
+		BackedUpItems: r.checkpointItems,
+	}
+
+	for _, pvb := range r.PodVolumeBackups {
+		if pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted && pvb.Status.SnapshotID != "" {
+			checkpoint.PodVolumeBackups = append(checkpoint.PodVolumeBackups, pvb)
+		}
+	}
+
+	for _, snapshot := range r.VolumeSnapshots {
+		if snapshot.Status.Phase == volume.SnapshotPhaseCompleted {
+			checkpoint.VolumeSnapshots = append(checkpoint.VolumeSnapshots, snapshot)
+		}
+	}
+
+	return checkpoint
+}
+
+// checkpointsEnabled returns whether the backup's progress is checkpointed.
+func (r *Request) checkpointsEnabled() bool {
+	return r.SaveCheckpoint != nil && r.CheckpointInterval > 0
+}
+
+// itemWritten records an item that was written to the backup at paths, so that it's included
+// in the backup's checkpoints. It does nothing if checkpoints aren't enabled, since the item's
+// content is kept in memory until the backup finishes and is saved with every checkpoint.
+func (r *Request) itemWritten(key itemKey, paths []string, content []byte) {
+	if !r.checkpointsEnabled() {
+		return
+	}
+
+	r.checkpointItems = append(r.checkpointItems, CheckpointItem{
+		Resource:  key.resource,
+		Namespace: key.namespace,
+		Name:      key.name,
+		Paths:     paths,
+		Content:   content,
+	})
+}
+
+// volumeSnapshotTaken makes the next checkpoint be saved without waiting for CheckpointInterval
+// to pass, so that a volume snapshot that was just taken isn't taken again if the backup is
+// resumed.
+func (r *Request) volumeSnapshotTaken() {
+	r.checkpointDue = true
+}
+
+// checkpoint saves the backup's progress if checkpoints are enabled and at least
+// CheckpointInterval has passed since the last checkpoint, or a volume snapshot was taken
+// since then. Failing to save a checkpoint doesn't fail the backup, it only means less of
+// it can be reused if it's resumed.
+func (r *Request) checkpoint(log logrus.FieldLogger) {
+	if !r.checkpointsEnabled() {
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(r.lastCheckpoint) < r.CheckpointInterval && !r.checkpointDue {
+		return
+	}
+	r.lastCheckpoint = now
+	r.checkpointDue = false
+
+	if err := r.SaveCheckpoint(r.newCheckpoint(now)); err != nil {
+		log.WithError(err).Warn("Error saving backup checkpoint")
+	}
+}
+
+// resumedPodVolumeBackups returns the completed pod volume backups of the given pod's volumes
+// that were recorded in ResumeFrom, and the remaining volumes that still need to be backed up.
+// A pod volume backup is only reused if it was taken from the same pod, identified by UID.
+func (r *Request) resumedPodVolumeBackups(pod *corev1api.Pod, volumes []string) ([]*velerov1api.PodVolumeBackup, []string) {
+	if r.ResumeFrom == nil {
+		return nil, volumes
+	}
+
+	var (
+		reused    []*velerov1api.PodVolumeBackup
+		remaining []string
+	)
+	for _, volumeName := range volumes {
+		var found *velerov1api.PodVolumeBackup
+		for _, pvb := range r.ResumeFrom.PodVolumeBackups {
+			if pvb.Spec.Pod.UID == pod.UID && pvb.Spec.Volume == volumeName {
+				found = pvb
+				break
+			}
+		}
+
+		if found != nil {
+			reused = append(reused, found)
+		} else {
+			remaining = append(remaining, volumeName)
+		}
+	}
+
+	return reused, remaining
+}
+
+// resumedVolumeSnapshot returns the volume snapshot of the given persistent volume that was
+// recorded in ResumeFrom, or nil if there isn't one.
+func (r *Request) resumedVolumeSnapshot(pvName, volumeID, location string) *volume.Snapshot {
+	if r.ResumeFrom == nil {
+		return nil
+	}
+
+	for _, snapshot := range r.ResumeFrom.VolumeSnapshots {
+		if snapshot.Spec.PersistentVolumeName == pvName && snapshot.Spec.ProviderVolumeID == volumeID && snapshot.Spec.Location == location {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// writeResumedItems writes the items that had been backed up according to ResumeFrom to w, and
+// marks them as backed up so that they're skipped when they're collected again. The pod volume
+// backups of the pods and the volume snapshots of the persistent volumes among them are added to
+// the backup, since the items aren't backed up again to add them. It returns the group resources
+// of the items.
+func (r *Request) writeResumedItems(w tarWriter, log logrus.FieldLogger) ([]schema.GroupResource, error) {
+	if r.ResumeFrom == nil {
+		return nil, nil
+	}
+
+	var groupResources []schema.GroupResource
+	seen := map[schema.GroupResource]bool{}
+	for _, item := range r.ResumeFrom.BackedUpItems {
+		for _, path := range item.Paths {
+			hdr := &tar.Header{
+				Name:     path,
+				Size:     int64(len(item.Content)),
+				Typeflag: tar.TypeReg,
+				Mode:     0755,
+				ModTime:  time.Now(),
+			}
+			if err := w.WriteHeader(hdr); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			if _, err := w.Write(item.Content); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+
+		key := itemKey{resource: item.Resource, namespace: item.Namespace, name: item.Name}
+		r.BackedUpItems[key] = struct{}{}
+		r.itemWritten(key, item.Paths, item.Content)
+
+		if gr, ok := itemPathGroupResource(item.Paths); ok && !seen[gr] {
+			seen[gr] = true
+			groupResources = append(groupResources, gr)
+		}
+
+		switch item.Resource {
+		case "v1/Pod":
+			for _, pvb := range r.ResumeFrom.PodVolumeBackups {
+				if pvb.Spec.Pod.Namespace == item.Namespace && pvb.Spec.Pod.Name == item.Name {
+					r.PodVolumeBackups = append(r.PodVolumeBackups, pvb)
+				}
+			}
+		case "v1/PersistentVolume":
+			for _, snapshot := range r.ResumeFrom.VolumeSnapshots {
+				if snapshot.Spec.PersistentVolumeName == item.Name {
+					r.VolumeSnapshots = append(r.VolumeSnapshots, snapshot)
+				}
+			}
+		}
+	}
+
+	log.Infof("Resumed %d items backed up before the backup was interrupted", len(r.ResumeFrom.BackedUpItems))
+	return groupResources, nil
+}
+
+// itemPathGroupResource returns the group resource of an item from the paths it was written
+// to in the backup, e.g. "resources/deployments.apps/namespaces/ns-1/deploy-1.json".
+func itemPathGroupResource(paths []string) (schema.GroupResource, bool) {
+	for _, path := range paths {
+		parts := strings.Split(path, "/")
+		if len(parts) > 2 && parts[0] == velerov1api.ResourcesDir {
+			return schema.ParseGroupResource(parts[1]), true
+		}
+	}
+	return schema.GroupResource{}, false
+}
@@ -0,0 +1,247 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func podVolumeBackup(name string, podUID types.UID, volumeName string, phase velerov1api.PodVolumeBackupPhase, snapshotID string) *velerov1api.PodVolumeBackup {
+	pvb := builder.ForPodVolumeBackup("velero", name).Volume(volumeName).Phase(phase).SnapshotID(snapshotID).Result()
+	pvb.Spec.Pod.UID = podUID
+	return pvb
+}
+
+func TestCheckpointEncodeDecode(t *testing.T) {
+	checkpoint := &Checkpoint{
+		Time:             time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC),
+		BackedUpItems:    []CheckpointItem{{Resource: "v1/Pod", Namespace: "ns-1", Name: "pod-1", Paths: []string{"resources/pods/namespaces/ns-1/pod-1.json"}, Content: []byte(`{"kind":"Pod"}`)}},
+		PodVolumeBackups: []*velerov1api.PodVolumeBackup{podVolumeBackup("pvb-1", "uid-1", "data", velerov1api.PodVolumeBackupPhaseCompleted, "snap-1")},
+		VolumeSnapshots: []*volume.Snapshot{{
+			Spec:   volume.SnapshotSpec{PersistentVolumeName: "pv-1", ProviderVolumeID: "vol-1", Location: "default"},
+			Status: volume.SnapshotStatus{ProviderSnapshotID: "snap-2", Phase: volume.SnapshotPhaseCompleted},
+		}},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, EncodeCheckpoint(checkpoint, buf))
+
+	decoded, err := DecodeCheckpoint(buf)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint.Time, decoded.Time)
+	assert.Equal(t, checkpoint.BackedUpItems, decoded.BackedUpItems)
+	assert.Equal(t, checkpoint.VolumeSnapshots, decoded.VolumeSnapshots)
+	require.Len(t, decoded.PodVolumeBackups, 1)
+	assert.Equal(t, "snap-1", decoded.PodVolumeBackups[0].Status.SnapshotID)
+
+	_, err = DecodeCheckpoint(bytes.NewBufferString("not gzip"))
+	assert.Error(t, err)
+}
+
+func TestRequestNewCheckpoint(t *testing.T) {
+	completed := podVolumeBackup("pvb-1", "uid-1", "data", velerov1api.PodVolumeBackupPhaseCompleted, "snap-1")
+	completedSnapshot := &volume.Snapshot{Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseCompleted, ProviderSnapshotID: "snap-2"}}
+
+	req := &Request{
+		BackedUpItems:      map[itemKey]struct{}{{resource: "v1/Pod", namespace: "ns-1", name: "pod-1"}: {}},
+		SaveCheckpoint:     func(*Checkpoint) error { return nil },
+		CheckpointInterval: time.Minute,
+		PodVolumeBackups: []*velerov1api.PodVolumeBackup{
+			completed,
+			podVolumeBackup("pvb-2", "uid-1", "logs", velerov1api.PodVolumeBackupPhaseFailed, ""),
+		},
+		VolumeSnapshots: []*volume.Snapshot{
+			completedSnapshot,
+			{Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseFailed}},
+		},
+	}
+
+	req.itemWritten(itemKey{resource: "v1/Pod", namespace: "ns-1", name: "pod-1"}, []string{"resources/pods/namespaces/ns-1/pod-1.json"}, []byte(`{"kind":"Pod"}`))
+
+	now := time.Now()
+	checkpoint := req.newCheckpoint(now)
+	assert.Equal(t, now, checkpoint.Time)
+	assert.Equal(t, []CheckpointItem{{
+		Resource:  "v1/Pod",
+		Namespace: "ns-1",
+		Name:      "pod-1",
+		Paths:     []string{"resources/pods/namespaces/ns-1/pod-1.json"},
+		Content:   []byte(`{"kind":"Pod"}`),
+	}}, checkpoint.BackedUpItems)
+	assert.Equal(t, []*velerov1api.PodVolumeBackup{completed}, checkpoint.PodVolumeBackups)
+	assert.Equal(t, []*volume.Snapshot{completedSnapshot}, checkpoint.VolumeSnapshots)
+}
+
+func TestRequestCheckpointIsRateLimited(t *testing.T) {
+	var saved int
+	req := &Request{
+		BackedUpItems:      map[itemKey]struct{}{},
+		CheckpointInterval: time.Hour,
+		SaveCheckpoint: func(*Checkpoint) error {
+			saved++
+			return nil
+		},
+	}
+
+	req.checkpoint(velerotest.NewLogger())
+	req.checkpoint(velerotest.NewLogger())
+	assert.Equal(t, 1, saved)
+
+	req.lastCheckpoint = time.Now().Add(-2 * time.Hour)
+	req.checkpoint(velerotest.NewLogger())
+	assert.Equal(t, 2, saved)
+
+	// a checkpoint is saved right after a volume snapshot is taken
+	req.volumeSnapshotTaken()
+	req.checkpoint(velerotest.NewLogger())
+	req.checkpoint(velerotest.NewLogger())
+	assert.Equal(t, 3, saved)
+}
+
+func TestRequestItemWrittenWithoutCheckpoints(t *testing.T) {
+	req := &Request{}
+	req.itemWritten(itemKey{resource: "v1/Pod", namespace: "ns-1", name: "pod-1"}, []string{"resources/pods/namespaces/ns-1/pod-1.json"}, []byte(`{}`))
+	assert.Empty(t, req.checkpointItems)
+}
+
+func TestRequestWriteResumedItems(t *testing.T) {
+	pod := podVolumeBackup("pvb-1", "uid-1", "data", velerov1api.PodVolumeBackupPhaseCompleted, "snap-1")
+	pod.Spec.Pod.Namespace, pod.Spec.Pod.Name = "ns-1", "pod-1"
+	otherPod := podVolumeBackup("pvb-2", "uid-2", "data", velerov1api.PodVolumeBackupPhaseCompleted, "snap-2")
+	otherPod.Spec.Pod.Namespace, otherPod.Spec.Pod.Name = "ns-1", "pod-2"
+	snapshot := &volume.Snapshot{Spec: volume.SnapshotSpec{PersistentVolumeName: "pv-1"}, Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseCompleted}}
+
+	req := &Request{
+		BackedUpItems: map[itemKey]struct{}{},
+		ResumeFrom: &Checkpoint{
+			BackedUpItems: []CheckpointItem{
+				{
+					Resource:  "v1/Pod",
+					Namespace: "ns-1",
+					Name:      "pod-1",
+					Paths:     []string{"resources/pods/namespaces/ns-1/pod-1.json", "resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json"},
+					Content:   []byte(`{"kind":"Pod"}`),
+				},
+				{
+					Resource: "v1/PersistentVolume",
+					Name:     "pv-1",
+					Paths:    []string{"resources/persistentvolumes/cluster/pv-1.json"},
+					Content:  []byte(`{"kind":"PersistentVolume"}`),
+				},
+			},
+			PodVolumeBackups: []*velerov1api.PodVolumeBackup{pod, otherPod},
+			VolumeSnapshots:  []*volume.Snapshot{snapshot},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	groupResources, err := req.writeResumedItems(tw, velerotest.NewLogger())
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	assert.Equal(t, []schema.GroupResource{{Resource: "pods"}, {Resource: "persistentvolumes"}}, groupResources)
+	assert.Equal(t, map[itemKey]struct{}{
+		{resource: "v1/Pod", namespace: "ns-1", name: "pod-1"}: {},
+		{resource: "v1/PersistentVolume", name: "pv-1"}:        {},
+	}, req.BackedUpItems)
+	assert.Equal(t, []*velerov1api.PodVolumeBackup{pod}, req.PodVolumeBackups)
+	assert.Equal(t, []*volume.Snapshot{snapshot}, req.VolumeSnapshots)
+
+	files := map[string]string{}
+	tr := tar.NewReader(buf)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+	assert.Equal(t, map[string]string{
+		"resources/pods/namespaces/ns-1/pod-1.json":                     `{"kind":"Pod"}`,
+		"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json": `{"kind":"Pod"}`,
+		"resources/persistentvolumes/cluster/pv-1.json":                 `{"kind":"PersistentVolume"}`,
+	}, files)
+}
+
+func TestRequestResumedPodVolumeBackups(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Result()
+	pod.UID = "uid-1"
+	data := podVolumeBackup("pvb-1", "uid-1", "data", velerov1api.PodVolumeBackupPhaseCompleted, "snap-1")
+
+	tests := []struct {
+		name              string
+		resumeFrom        *Checkpoint
+		expectedReused    []*velerov1api.PodVolumeBackup
+		expectedRemaining []string
+	}{
+		{
+			name:              "not resumed",
+			expectedRemaining: []string{"data", "logs"},
+		},
+		{
+			name:              "completed volume is reused",
+			resumeFrom:        &Checkpoint{PodVolumeBackups: []*velerov1api.PodVolumeBackup{data}},
+			expectedReused:    []*velerov1api.PodVolumeBackup{data},
+			expectedRemaining: []string{"logs"},
+		},
+		{
+			name:              "volume of a replaced pod is not reused",
+			resumeFrom:        &Checkpoint{PodVolumeBackups: []*velerov1api.PodVolumeBackup{podVolumeBackup("pvb-1", "uid-0", "data", velerov1api.PodVolumeBackupPhaseCompleted, "snap-1")}},
+			expectedRemaining: []string{"data", "logs"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &Request{ResumeFrom: tc.resumeFrom}
+			reused, remaining := req.resumedPodVolumeBackups(pod, []string{"data", "logs"})
+			assert.Equal(t, tc.expectedReused, reused)
+			assert.Equal(t, tc.expectedRemaining, remaining)
+		})
+	}
+}
+
+func TestRequestResumedVolumeSnapshot(t *testing.T) {
+	snapshot := &volume.Snapshot{Spec: volume.SnapshotSpec{PersistentVolumeName: "pv-1", ProviderVolumeID: "vol-1", Location: "default"}}
+
+	req := &Request{}
+	assert.Nil(t, req.resumedVolumeSnapshot("pv-1", "vol-1", "default"))
+
+	req.ResumeFrom = &Checkpoint{VolumeSnapshots: []*volume.Snapshot{snapshot}}
+	assert.Equal(t, snapshot, req.resumedVolumeSnapshot("pv-1", "vol-1", "default"))
+	assert.Nil(t, req.resumedVolumeSnapshot("pv-1", "vol-2", "default"))
+	assert.Nil(t, req.resumedVolumeSnapshot("pv-1", "vol-1", "other"))
+}
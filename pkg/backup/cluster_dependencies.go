@@ -0,0 +1,120 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// podSpecPaths are the paths of the pod specs of the resources that have one.
+var podSpecPaths = map[schema.GroupResource][]string{
+	{Group: "", Resource: "pods"}:                   {"spec"},
+	{Group: "", Resource: "replicationcontrollers"}: {"spec", "template", "spec"},
+	{Group: "apps", Resource: "daemonsets"}:         {"spec", "template", "spec"},
+	{Group: "apps", Resource: "deployments"}:        {"spec", "template", "spec"},
+	{Group: "apps", Resource: "replicasets"}:        {"spec", "template", "spec"},
+	{Group: "apps", Resource: "statefulsets"}:       {"spec", "template", "spec"},
+	{Group: "batch", Resource: "cronjobs"}:          {"spec", "jobTemplate", "spec", "template", "spec"},
+	{Group: "batch", Resource: "jobs"}:              {"spec", "template", "spec"},
+	{Group: "extensions", Resource: "daemonsets"}:   {"spec", "template", "spec"},
+	{Group: "extensions", Resource: "deployments"}:  {"spec", "template", "spec"},
+	{Group: "extensions", Resource: "replicasets"}:  {"spec", "template", "spec"},
+}
+
+// clusterDependencies returns the cluster-scoped items that an item references and that it
+// requires to be restored, such as the StorageClass of a PersistentVolumeClaim or the
+// ClusterRole of a RoleBinding.
+func clusterDependencies(groupResource schema.GroupResource, obj runtime.Unstructured) []velero.ResourceIdentifier {
+	content := obj.UnstructuredContent()
+
+	var dependencies []velero.ResourceIdentifier
+	add := func(groupResource schema.GroupResource, content map[string]interface{}, fields ...string) {
+		name, _, _ := unstructured.NestedString(content, fields...)
+		if name != "" {
+			dependencies = append(dependencies, velero.ResourceIdentifier{GroupResource: groupResource, Name: name})
+		}
+	}
+
+	if path, ok := podSpecPaths[groupResource]; ok {
+		if podSpec, found, _ := unstructured.NestedMap(content, path...); found {
+			add(kuberesource.PriorityClasses, podSpec, "priorityClassName")
+			add(kuberesource.RuntimeClasses, podSpec, "runtimeClassName")
+		}
+	}
+
+	switch groupResource {
+	case kuberesource.PersistentVolumeClaims, kuberesource.PersistentVolumes:
+		add(kuberesource.StorageClasses, content, "spec", "storageClassName")
+	case schema.GroupResource{Group: "apps", Resource: "statefulsets"}:
+		templates, _, _ := unstructured.NestedSlice(content, "spec", "volumeClaimTemplates")
+		for _, template := range templates {
+			if template, ok := template.(map[string]interface{}); ok {
+				add(kuberesource.StorageClasses, template, "spec", "storageClassName")
+			}
+		}
+	case kuberesource.RoleBindings:
+		if kind, _, _ := unstructured.NestedString(content, "roleRef", "kind"); kind == "ClusterRole" {
+			add(kuberesource.ClusterRoles, content, "roleRef", "name")
+		}
+	case schema.GroupResource{Group: "networking.k8s.io", Resource: "ingresses"}, schema.GroupResource{Group: "extensions", Resource: "ingresses"}:
+		add(kuberesource.IngressClasses, content, "spec", "ingressClassName")
+	case kuberesource.VolumeSnapshots:
+		add(kuberesource.VolumeSnapshotClasses, content, "spec", "volumeSnapshotClassName")
+	}
+
+	return dependencies
+}
+
+// backupClusterDependencies backs up the cluster-scoped items that an item depends on, and
+// records the ones that weren't already backed up in the backup's status. Dependencies that
+// can't be found are skipped.
+func (ib *itemBackupper) backupClusterDependencies(log logrus.FieldLogger, groupResource schema.GroupResource, obj runtime.Unstructured) error {
+	for _, dependency := range clusterDependencies(groupResource, obj) {
+		item, gvr, err := ib.getAdditionalItem(log, dependency)
+		if err != nil {
+			log.WithError(err).Warnf("Unable to get cluster-scoped dependency %s %s, can't back it up", dependency.GroupResource, dependency.Name)
+			continue
+		}
+		if item == nil {
+			continue
+		}
+
+		key := itemKey{resource: resourceKey(item), name: item.GetName()}
+		if _, exists := ib.backupRequest.BackedUpItems[key]; exists {
+			continue
+		}
+
+		backedUp, err := ib.backupItem(log, item, gvr.GroupResource(), gvr)
+		if err != nil {
+			return err
+		}
+		if backedUp {
+			log.Infof("Backed up cluster-scoped dependency %s %s", dependency.GroupResource, dependency.Name)
+			ib.backupRequest.Status.ClusterDependencies = append(ib.backupRequest.Status.ClusterDependencies, fmt.Sprintf("%s/%s", gvr.GroupResource(), item.GetName()))
+		}
+	}
+
+	return nil
+}
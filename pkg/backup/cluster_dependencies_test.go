@@ -0,0 +1,148 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestBackupClusterDependencies runs backups of a namespace whose items reference
+// cluster-scoped resources, and verifies that only the referenced cluster-scoped
+// resources are included when cluster dependencies are included.
+func TestBackupClusterDependencies(t *testing.T) {
+	roleBinding := func(name, roleKind, roleName string) *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: name},
+			RoleRef:    rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: roleKind, Name: roleName},
+		}
+	}
+	clusterRole := func(name string) *rbacv1.ClusterRole {
+		return &rbacv1.ClusterRole{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}
+	}
+	priorityClass := func(name string) *schedulingv1.PriorityClass {
+		return &schedulingv1.PriorityClass{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "scheduling.k8s.io/v1", Kind: "PriorityClass"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}
+	}
+	apiResources := func() []*test.APIResource {
+		return []*test.APIResource{
+			test.Pods(
+				builder.ForPod("foo", "pod-1").PriorityClassName("high").Result(),
+				builder.ForPod("bar", "pod-2").PriorityClassName("low").Result(),
+			),
+			test.PVCs(
+				builder.ForPersistentVolumeClaim("foo", "pvc-1").StorageClass("fast").Result(),
+			),
+			test.RoleBindings(
+				roleBinding("view", "ClusterRole", "view"),
+				roleBinding("local", "Role", "local"),
+			),
+			test.StorageClasses(
+				builder.ForStorageClass("fast").Result(),
+				builder.ForStorageClass("slow").Result(),
+			),
+			test.PriorityClasses(
+				priorityClass("high"),
+				priorityClass("low"),
+			),
+			test.ClusterRoles(
+				clusterRole("view"),
+				clusterRole("edit"),
+			),
+		}
+	}
+	namespacedItems := []string{
+		"resources/pods/namespaces/foo/pod-1.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/pod-1.json",
+		"resources/persistentvolumeclaims/namespaces/foo/pvc-1.json",
+		"resources/persistentvolumeclaims/v1-preferredversion/namespaces/foo/pvc-1.json",
+		"resources/rolebindings.rbac.authorization.k8s.io/namespaces/foo/local.json",
+		"resources/rolebindings.rbac.authorization.k8s.io/namespaces/foo/view.json",
+		"resources/rolebindings.rbac.authorization.k8s.io/v1-preferredversion/namespaces/foo/local.json",
+		"resources/rolebindings.rbac.authorization.k8s.io/v1-preferredversion/namespaces/foo/view.json",
+	}
+
+	tests := []struct {
+		name                    string
+		backup                  *velerov1.Backup
+		want                    []string
+		wantClusterDependencies []string
+	}{
+		{
+			name:   "cluster-scoped resources aren't included by default",
+			backup: defaultBackup().IncludedNamespaces("foo").Result(),
+			want:   namespacedItems,
+		},
+		{
+			name:   "referenced cluster-scoped resources are included when cluster dependencies are included",
+			backup: defaultBackup().IncludedNamespaces("foo").IncludeClusterDependencies(true).Result(),
+			want: append([]string{
+				"resources/clusterroles.rbac.authorization.k8s.io/cluster/view.json",
+				"resources/clusterroles.rbac.authorization.k8s.io/v1-preferredversion/cluster/view.json",
+				"resources/priorityclasses.scheduling.k8s.io/cluster/high.json",
+				"resources/priorityclasses.scheduling.k8s.io/v1-preferredversion/cluster/high.json",
+				"resources/storageclasses.storage.k8s.io/cluster/fast.json",
+				"resources/storageclasses.storage.k8s.io/v1-preferredversion/cluster/fast.json",
+			}, namespacedItems...),
+			wantClusterDependencies: []string{
+				"clusterroles.rbac.authorization.k8s.io/view",
+				"priorityclasses.scheduling.k8s.io/high",
+				"storageclasses.storage.k8s.io/fast",
+			},
+		},
+		{
+			name:   "cluster dependencies aren't included when cluster-scoped resources are excluded",
+			backup: defaultBackup().IncludedNamespaces("foo").IncludeClusterResources(false).IncludeClusterDependencies(true).Result(),
+			want:   namespacedItems,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				req        = &Request{Backup: tc.backup}
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			for _, resource := range apiResources() {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			assertTarballContents(t, backupFile, append(tc.want, "metadata/version")...)
+			assert.Equal(t, tc.wantClusterDependencies, req.Status.ClusterDependencies)
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
@@ -194,6 +195,12 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	name = metadata.GetName()
 	namespace = metadata.GetNamespace()
 
+	if ib.backupRequest.includeClusterDependencies() {
+		if err := ib.backupClusterDependencies(log, groupResource, obj); err != nil {
+			backupErrs = append(backupErrs, err)
+		}
+	}
+
 	if groupResource == kuberesource.PersistentVolumes {
 		if err := ib.takePVSnapshot(obj, log); err != nil {
 			backupErrs = append(backupErrs, err)
@@ -203,7 +210,13 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	if groupResource == kuberesource.Pods && pod != nil {
 		// this function will return partial results, so process podVolumeBackups
 		// even if there are errors.
-		podVolumeBackups, errs := ib.backupPodVolumes(log, pod, resticVolumesToBackup)
+		reusedPodVolumeBackups, remainingVolumes := ib.backupRequest.resumedPodVolumeBackups(pod, resticVolumesToBackup)
+		for _, pvb := range reusedPodVolumeBackups {
+			log.WithField("podVolume", pvb.Spec.Volume).Info("Reusing pod volume backup completed before the backup was interrupted")
+		}
+		ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, reusedPodVolumeBackups...)
+
+		podVolumeBackups, errs := ib.backupPodVolumes(log, pod, remainingVolumes)
 
 		ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, podVolumeBackups...)
 		backupErrs = append(backupErrs, errs...)
@@ -237,6 +250,15 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		filePath = filepath.Join(velerov1api.ResourcesDir, groupResource.String(), versionPath, velerov1api.ClusterScopedDir, name+".json")
 	}
 
+	obj, transforms, err := transformItem(ib.backupRequest.itemTransforms, groupResource, obj)
+	if err != nil {
+		return false, err
+	}
+	if len(transforms) > 0 {
+		log.Infof("Transformed item with transforms %s", strings.Join(transforms, ", "))
+		ib.backupRequest.Status.ItemsTransformed++
+	}
+
 	itemBytes, err := json.Marshal(obj.UnstructuredContent())
 	if err != nil {
 		return false, errors.WithStack(err)
@@ -257,6 +279,7 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	if _, err := ib.tarWriter.Write(itemBytes); err != nil {
 		return false, errors.WithStack(err)
 	}
+	paths := []string{filePath}
 
 	// backing up the preferred version backup without API Group version on path -  this is for backward compatibility
 
@@ -283,8 +306,11 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 		if _, err := ib.tarWriter.Write(itemBytes); err != nil {
 			return false, errors.WithStack(err)
 		}
+		paths = append(paths, filePath)
 	}
 
+	ib.backupRequest.itemWritten(key, paths, itemBytes)
+
 	return true, nil
 }
 
@@ -323,28 +349,13 @@ func (ib *itemBackupper) executeActions(
 		obj = updatedItem
 
 		for _, additionalItem := range additionalItemIdentifiers {
-			gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
-			if err != nil {
-				return nil, err
-			}
-
-			client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
+			item, gvr, err := ib.getAdditionalItem(log, additionalItem)
 			if err != nil {
 				return nil, err
 			}
-
-			item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
-			if apierrors.IsNotFound(err) {
-				log.WithFields(logrus.Fields{
-					"groupResource": additionalItem.GroupResource,
-					"namespace":     additionalItem.Namespace,
-					"name":          additionalItem.Name,
-				}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
+			if item == nil {
 				continue
 			}
-			if err != nil {
-				return nil, errors.WithStack(err)
-			}
 
 			if _, err = ib.backupItem(log, item, gvr.GroupResource(), gvr); err != nil {
 				return nil, err
@@ -355,6 +366,35 @@ func (ib *itemBackupper) executeActions(
 	return obj, nil
 }
 
+// getAdditionalItem gets an item that's backed up along with another item from the
+// Kubernetes API. It returns a nil item if the item doesn't exist.
+func (ib *itemBackupper) getAdditionalItem(log logrus.FieldLogger, additionalItem velero.ResourceIdentifier) (*unstructured.Unstructured, schema.GroupVersionResource, error) {
+	gvr, resource, err := ib.discoveryHelper.ResourceFor(additionalItem.GroupResource.WithVersion(""))
+	if err != nil {
+		return nil, gvr, err
+	}
+
+	client, err := ib.dynamicFactory.ClientForGroupVersionResource(gvr.GroupVersion(), resource, additionalItem.Namespace)
+	if err != nil {
+		return nil, gvr, err
+	}
+
+	item, err := client.Get(additionalItem.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		log.WithFields(logrus.Fields{
+			"groupResource": additionalItem.GroupResource,
+			"namespace":     additionalItem.Namespace,
+			"name":          additionalItem.Name,
+		}).Warnf("Additional item was not found in Kubernetes API, can't back it up")
+		return nil, gvr, nil
+	}
+	if err != nil {
+		return nil, gvr, errors.WithStack(err)
+	}
+
+	return item, gvr, nil
+}
+
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeSnapshotLocation) (velero.VolumeSnapshotter, error) {
@@ -481,6 +521,12 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	log = log.WithField("volumeID", volumeID)
 
+	if snapshot := ib.backupRequest.resumedVolumeSnapshot(pv.Name, volumeID, location); snapshot != nil {
+		log.Info("Reusing volume snapshot taken before the backup was interrupted")
+		ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+		return nil
+	}
+
 	// create tags from the backup's labels
 	tags := map[string]string{}
 	for k, v := range ib.backupRequest.GetLabels() {
@@ -506,6 +552,7 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	} else {
 		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
 		snapshot.Status.ProviderSnapshotID = snapshotID
+		ib.backupRequest.volumeSnapshotTaken()
 	}
 	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
 
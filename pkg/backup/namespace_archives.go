@@ -0,0 +1,215 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
+)
+
+// namespaceArchivesWriter is a tarWriter that writes the items of each namespace to a separate
+// compressed tarball in a temp file, and everything else to the backup's main tarball. Items are
+// mostly written one namespace after another, so only the archive of the namespace being written
+// is kept open. An archive that's written to again after another namespace's is reopened and a
+// new compressed stream is appended to it, which the decompressors read as one.
+type namespaceArchivesWriter struct {
+	main        tarWriter
+	compression *velerov1api.CompressionConfig
+	archives    map[string]*namespaceArchive
+	open        *namespaceArchive
+	current     tarWriter
+	closed      bool
+}
+
+type namespaceArchive struct {
+	path  string
+	files int
+
+	file *os.File
+	cw   io.WriteCloser
+	tw   *tar.Writer
+}
+
+func newNamespaceArchivesWriter(main tarWriter, compression *velerov1api.CompressionConfig) *namespaceArchivesWriter {
+	return &namespaceArchivesWriter{
+		main:        main,
+		compression: compression,
+		archives:    map[string]*namespaceArchive{},
+	}
+}
+
+// namespaceForPath returns the namespace of the item at the given path in a backup tarball,
+// or "" if the path isn't a namespaced item's. Namespaced items are at
+// resources/<resource>[/<version>-preferredversion]/namespaces/<namespace>/<name>.json.
+func namespaceForPath(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 5 || parts[0] != velerov1api.ResourcesDir || parts[len(parts)-3] != velerov1api.NamespaceScopedDir {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
+
+func (w *namespaceArchivesWriter) WriteHeader(hdr *tar.Header) error {
+	namespace := namespaceForPath(hdr.Name)
+	if namespace == "" {
+		w.current = w.main
+		return w.main.WriteHeader(hdr)
+	}
+
+	a, ok := w.archives[namespace]
+	if !ok {
+		file, err := ioutil.TempFile("", "")
+		if err != nil {
+			return errors.Wrapf(err, "error creating temp file for the archive of namespace %s", namespace)
+		}
+		if err := file.Close(); err != nil {
+			os.Remove(file.Name())
+			return errors.Wrapf(err, "error closing temp file for the archive of namespace %s", namespace)
+		}
+		a = &namespaceArchive{path: file.Name()}
+		w.archives[namespace] = a
+	}
+
+	if w.open != a {
+		if w.open != nil {
+			if err := w.open.suspend(); err != nil {
+				return errors.Wrap(err, "error suspending namespace archive")
+			}
+			w.open = nil
+		}
+		if err := a.resume(w.compression); err != nil {
+			return errors.Wrapf(err, "error opening the archive of namespace %s", namespace)
+		}
+		w.open = a
+	}
+
+	w.current = a.tw
+	a.files++
+	return a.tw.WriteHeader(hdr)
+}
+
+func (w *namespaceArchivesWriter) Write(b []byte) (int, error) {
+	if w.current == nil {
+		return 0, errors.New("write before header")
+	}
+	return w.current.Write(b)
+}
+
+// Close finishes the namespaces' archives, leaving their temp files closed. It doesn't close
+// the main tarball.
+func (w *namespaceArchivesWriter) Close() error {
+	for namespace, a := range w.archives {
+		if w.open != a {
+			if err := a.resume(w.compression); err != nil {
+				return errors.Wrapf(err, "error opening the archive of namespace %s", namespace)
+			}
+			w.open = a
+		}
+		if err := a.finish(); err != nil {
+			return errors.Wrapf(err, "error closing the archive of namespace %s", namespace)
+		}
+		w.open = nil
+	}
+	w.current = nil
+	w.closed = true
+	return nil
+}
+
+// remove closes and removes the namespaces' temp files, unless Close has finished the archives.
+// It's used to clean up after a backup fails.
+func (w *namespaceArchivesWriter) remove() {
+	if w.closed {
+		return
+	}
+	if w.open != nil {
+		w.open.file.Close()
+		w.open = nil
+	}
+	for _, a := range w.archives {
+		os.Remove(a.path)
+	}
+	w.archives = map[string]*namespaceArchive{}
+}
+
+// paths returns the temp files holding the namespaces' archives, keyed by namespace.
+func (w *namespaceArchivesWriter) paths() map[string]string {
+	paths := make(map[string]string, len(w.archives))
+	for namespace, a := range w.archives {
+		paths[namespace] = a.path
+	}
+	return paths
+}
+
+// resume opens the archive's temp file for appending and starts a new compressed stream in it.
+func (a *namespaceArchive) resume(config *velerov1api.CompressionConfig) error {
+	file, err := os.OpenFile(a.path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	cw, err := compression.NewWriter(file, config)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	a.file, a.cw, a.tw = file, cw, tar.NewWriter(cw)
+	return nil
+}
+
+// suspend ends the archive's compressed stream without ending the tarball, and closes its
+// temp file, so that the archive can be resumed.
+func (a *namespaceArchive) suspend() error {
+	return a.close(a.tw.Flush)
+}
+
+// finish ends the tarball and the archive's compressed stream, and closes its temp file.
+func (a *namespaceArchive) finish() error {
+	return a.close(a.tw.Close)
+}
+
+func (a *namespaceArchive) close(closeTar func() error) error {
+	defer func() {
+		a.file, a.cw, a.tw = nil, nil, nil
+	}()
+
+	if err := closeTar(); err != nil {
+		a.file.Close()
+		return errors.WithStack(err)
+	}
+	if err := a.cw.Close(); err != nil {
+		a.file.Close()
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(a.file.Close())
+}
+
+// index returns the index of the namespaces' archives.
+func (w *namespaceArchivesWriter) index() *archive.Index {
+	index := &archive.Index{Namespaces: make(map[string]archive.IndexEntry, len(w.archives))}
+	for namespace, a := range w.archives {
+		index.Namespaces[namespace] = archive.IndexEntry{Files: a.files}
+	}
+	return index
+}
@@ -0,0 +1,155 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
+)
+
+func TestNamespaceForPath(t *testing.T) {
+	tests := map[string]string{
+		"metadata/version":                                                             "",
+		"resources/namespaces/cluster/ns-1.json":                                       "",
+		"resources/persistentvolumes/cluster/pv-1.json":                                "",
+		"resources/pods/namespaces/ns-1/pod-1.json":                                    "ns-1",
+		"resources/deployments.apps/v1-preferredversion/namespaces/ns-2/deploy-1.json": "ns-2",
+	}
+
+	for path, expected := range tests {
+		t.Run(path, func(t *testing.T) {
+			assert.Equal(t, expected, namespaceForPath(path))
+		})
+	}
+}
+
+func TestNamespaceArchivesWriter(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression *velerov1api.CompressionConfig
+	}{
+		{name: "gzip"},
+		{name: "zstd", compression: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd}},
+		{name: "none", compression: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmNone}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mainBuf := new(bytes.Buffer)
+			mainTW := tar.NewWriter(mainBuf)
+			w := newNamespaceArchivesWriter(mainTW, tc.compression)
+
+			write := func(path, content string) {
+				require.NoError(t, w.WriteHeader(&tar.Header{Name: path, Size: int64(len(content)), Typeflag: tar.TypeReg, Mode: 0755}))
+				_, err := w.Write([]byte(content))
+				require.NoError(t, err)
+			}
+
+			write("resources/namespaces/cluster/ns-1.json", "ns-1")
+			write("resources/pods/namespaces/ns-1/pod-1.json", "pod-1")
+			write("resources/pods/namespaces/ns-1/pod-2.json", "pod-2")
+			write("resources/pods/namespaces/ns-2/pod-3.json", "pod-3")
+			// ns-1's archive is resumed after ns-2's was written to
+			write("resources/secrets/namespaces/ns-1/secret-1.json", "secret-1")
+
+			require.NoError(t, w.Close())
+			require.NoError(t, mainTW.Close())
+
+			paths := w.paths()
+			defer func() {
+				for _, path := range paths {
+					os.Remove(path)
+				}
+			}()
+
+			assert.Equal(t, &archive.Index{Namespaces: map[string]archive.IndexEntry{
+				"ns-1": {Files: 3},
+				"ns-2": {Files: 1},
+			}}, w.index())
+
+			assert.Equal(t, []string{"resources/namespaces/cluster/ns-1.json"}, tarFileNames(t, mainBuf))
+
+			require.Len(t, paths, 2)
+			for namespace, expected := range map[string][]string{
+				"ns-1": {"resources/pods/namespaces/ns-1/pod-1.json", "resources/pods/namespaces/ns-1/pod-2.json", "resources/secrets/namespaces/ns-1/secret-1.json"},
+				"ns-2": {"resources/pods/namespaces/ns-2/pod-3.json"},
+			} {
+				file, err := os.Open(paths[namespace])
+				require.NoError(t, err)
+				r, err := compression.NewReader(file)
+				require.NoError(t, err)
+				assert.Equal(t, expected, tarFileNames(t, r))
+				r.Close()
+				file.Close()
+			}
+
+			// removing the archives does nothing once they're closed
+			w.remove()
+			for _, path := range paths {
+				assert.FileExists(t, path)
+			}
+		})
+	}
+}
+
+func TestNamespaceArchivesWriterRemove(t *testing.T) {
+	w := newNamespaceArchivesWriter(tar.NewWriter(new(bytes.Buffer)), nil)
+
+	for _, path := range []string{"resources/pods/namespaces/ns-1/pod-1.json", "resources/pods/namespaces/ns-2/pod-2.json"} {
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: path, Size: 1, Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := w.Write([]byte("a"))
+		require.NoError(t, err)
+	}
+
+	paths := w.paths()
+	require.Len(t, paths, 2)
+
+	w.remove()
+	for _, path := range paths {
+		assert.NoFileExists(t, path)
+	}
+}
+
+func TestNamespaceArchivesWriterWriteBeforeHeader(t *testing.T) {
+	w := newNamespaceArchivesWriter(tar.NewWriter(new(bytes.Buffer)), nil)
+	_, err := w.Write([]byte("data"))
+	assert.Error(t, err)
+}
+
+func tarFileNames(t *testing.T, r io.Reader) []string {
+	var names []string
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	return names
+}
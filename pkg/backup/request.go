@@ -17,14 +17,18 @@ limitations under the License.
 package backup
 
 import (
+	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -45,12 +49,61 @@ type Request struct {
 	NamespaceIncludesExcludes *collections.IncludesExcludes
 	ResourceIncludesExcludes  *collections.IncludesExcludes
 	ResourceHooks             []hook.ResourceHook
+	itemTransforms            []itemTransform
 	ResolvedActions           []framework.BackupItemResolvedAction
 	ResolvedItemSnapshotters  []framework.ItemSnapshotterResolvedAction
 	VolumeSnapshots           []*volume.Snapshot
 	PodVolumeBackups          []*velerov1api.PodVolumeBackup
 	BackedUpItems             map[itemKey]struct{}
 	CSISnapshots              []*snapshotv1api.VolumeSnapshot
+	DataUploads               []*velerov1api.DataUpload
+
+	// NamespaceArchives are the paths of the temp files holding the archive of each namespace, and
+	// ArchiveIndex is their index, when the backup is written in the split archive format. The
+	// caller must remove the files.
+	NamespaceArchives map[string]string
+	ArchiveIndex      *archive.Index
+
+	// Context is canceled when the backup is canceled. If nil, the backup can't be canceled.
+	Context context.Context
+
+	// SaveCheckpoint, if set, is called every CheckpointInterval while items are backed up
+	// to persist the backup's progress.
+	SaveCheckpoint     CheckpointSaver
+	CheckpointInterval time.Duration
+	lastCheckpoint     time.Time
+	checkpointDue      bool
+	checkpointItems    []CheckpointItem
+
+	// ResumeFrom, if set, is the last checkpoint of an earlier run of this backup that was
+	// interrupted. Its backed up items are written to the backup as they were rather than
+	// backed up again, and its completed pod volume backups and volume snapshots are reused
+	// rather than taken again.
+	ResumeFrom *Checkpoint
+}
+
+// ctx returns r.Context, or a context that is never canceled if it is nil.
+func (r *Request) ctx() context.Context {
+	if r.Context == nil {
+		return context.Background()
+	}
+	return r.Context
+}
+
+// compression returns the compression configuration of the backup's storage location,
+// or nil if it doesn't have one.
+func (r *Request) compression() *velerov1api.CompressionConfig {
+	if r.StorageLocation == nil {
+		return nil
+	}
+	return r.StorageLocation.Spec.Compression
+}
+
+// includeClusterDependencies returns whether the cluster-scoped resources that backed up
+// resources depend on are included in the backup, when cluster-scoped resources aren't
+// explicitly included or excluded.
+func (r *Request) includeClusterDependencies() bool {
+	return r.Spec.IncludeClusterResources == nil && boolptr.IsSetToTrue(r.Spec.IncludeClusterDependencies)
 }
 
 // BackupResourceList returns the list of backed up resources grouped by the API
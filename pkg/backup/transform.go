@@ -0,0 +1,260 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"encoding/json"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// itemTransform is a backup transform with its selectors and patches resolved.
+type itemTransform struct {
+	name          string
+	namespaces    *collections.IncludesExcludes
+	resources     *collections.IncludesExcludes
+	labelSelector labels.Selector
+	secretTypes   sets.String
+	patches       []jsonpatch.Patch
+	metadataOnly  bool
+	// redacts is true if the transform drops or replaces data outside of the item's
+	// metadata, which the item's last applied configuration and managed fields keep a
+	// copy of.
+	redacts bool
+}
+
+func getItemTransforms(specs []velerov1api.BackupTransform, discoveryHelper discovery.Helper) ([]itemTransform, error) {
+	transforms := make([]itemTransform, 0, len(specs))
+
+	for _, spec := range specs {
+		t := itemTransform{
+			name:         spec.Name,
+			namespaces:   collections.NewIncludesExcludes().Includes(spec.IncludedNamespaces...).Excludes(spec.ExcludedNamespaces...),
+			resources:    collections.GetResourceIncludesExcludes(discoveryHelper, spec.IncludedResources, spec.ExcludedResources),
+			secretTypes:  sets.NewString(spec.SecretTypes...),
+			metadataOnly: spec.MetadataOnly,
+			redacts:      spec.MetadataOnly,
+		}
+
+		if spec.LabelSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(spec.LabelSelector)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid label selector of transform %s", spec.Name)
+			}
+			t.labelSelector = selector
+		}
+
+		// each operation is a patch of its own, so that the operations whose path
+		// doesn't exist in an item can be skipped
+		for _, p := range spec.Patches {
+			patch, err := decodeTransformPatch(p)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid patch of transform %s", spec.Name)
+			}
+			t.patches = append(t.patches, patch)
+
+			if p.Path != "/metadata" && !strings.HasPrefix(p.Path, "/metadata/") {
+				t.redacts = true
+			}
+		}
+
+		transforms = append(transforms, t)
+	}
+
+	return transforms, nil
+}
+
+// ValidateTransforms checks that transforms have unique names that can be recorded in
+// the velero.io/backup-transforms annotation, and valid label selectors and patches.
+func ValidateTransforms(transforms []velerov1api.BackupTransform) []error {
+	var errs []error
+	names := sets.NewString()
+
+	for _, spec := range transforms {
+		switch {
+		case spec.Name == "":
+			errs = append(errs, errors.New("name must not be empty"))
+		case strings.Contains(spec.Name, ","):
+			errs = append(errs, errors.Errorf("name %q must not contain commas", spec.Name))
+		case names.Has(spec.Name):
+			errs = append(errs, errors.Errorf("name %s is used by more than one transform", spec.Name))
+		}
+		names.Insert(spec.Name)
+
+		if spec.LabelSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(spec.LabelSelector); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid label selector of transform %s", spec.Name))
+			}
+		}
+		for _, p := range spec.Patches {
+			if _, err := decodeTransformPatch(p); err != nil {
+				errs = append(errs, errors.Wrapf(err, "invalid patch of transform %s", spec.Name))
+			}
+		}
+		if len(spec.Patches) == 0 && !spec.MetadataOnly {
+			errs = append(errs, errors.Errorf("transform %s has no patches and isn't metadataOnly", spec.Name))
+		}
+	}
+
+	return errs
+}
+
+func decodeTransformPatch(p velerov1api.BackupTransformPatch) (jsonpatch.Patch, error) {
+	if !strings.HasPrefix(p.Path, "/") {
+		return nil, errors.Errorf("path %q must start with /", p.Path)
+	}
+
+	// a replace is a remove followed by an add, since the JSON patch library adds a
+	// missing value rather than failing to replace it
+	ops := []map[string]interface{}{
+		{"op": velerov1api.BackupTransformOperationRemove, "path": p.Path},
+	}
+	switch p.Operation {
+	case velerov1api.BackupTransformOperationRemove:
+	case velerov1api.BackupTransformOperationReplace:
+		ops = append(ops, map[string]interface{}{"op": "add", "path": p.Path, "value": p.Value})
+	default:
+		return nil, errors.Errorf("unsupported operation %q", p.Operation)
+	}
+
+	patchBytes, err := json.Marshal(ops)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	patch, err := jsonpatch.DecodePatch(patchBytes)
+	return patch, errors.WithStack(err)
+}
+
+func (t *itemTransform) appliesTo(groupResource schema.GroupResource, obj *unstructured.Unstructured) bool {
+	if obj.GetNamespace() != "" && !t.namespaces.ShouldInclude(obj.GetNamespace()) {
+		return false
+	}
+	if !t.resources.ShouldInclude(groupResource.String()) {
+		return false
+	}
+	if t.labelSelector != nil && !t.labelSelector.Matches(labels.Set(obj.GetLabels())) {
+		return false
+	}
+	if t.secretTypes.Len() > 0 {
+		if groupResource != kuberesource.Secrets {
+			return false
+		}
+		secretType, _, _ := unstructured.NestedString(obj.Object, "type")
+		if !t.secretTypes.Has(secretType) {
+			return false
+		}
+	}
+	return true
+}
+
+// transformItem applies the transforms that select obj to it. It returns the transformed
+// item and the names of the transforms that changed it, or obj itself if none did.
+func transformItem(transforms []itemTransform, groupResource schema.GroupResource, obj runtime.Unstructured) (runtime.Unstructured, []string, error) {
+	item := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+
+	var applied []string
+	for i := range transforms {
+		t := &transforms[i]
+		if !t.appliesTo(groupResource, item) {
+			continue
+		}
+
+		// transform a copy, so that the item the caller holds isn't modified
+		transformed := item.DeepCopy()
+
+		if t.metadataOnly {
+			for field := range transformed.Object {
+				if field != "apiVersion" && field != "kind" && field != "metadata" {
+					delete(transformed.Object, field)
+				}
+			}
+		}
+
+		if len(t.patches) > 0 {
+			itemBytes, err := json.Marshal(transformed.Object)
+			if err != nil {
+				return nil, nil, errors.WithStack(err)
+			}
+			for _, patch := range t.patches {
+				patched, err := patch.Apply(itemBytes)
+				if errors.Cause(err) == jsonpatch.ErrMissing {
+					continue
+				}
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "error applying transform %s", t.name)
+				}
+				itemBytes = patched
+			}
+
+			res := make(map[string]interface{})
+			if err := json.Unmarshal(itemBytes, &res); err != nil {
+				return nil, nil, errors.WithStack(err)
+			}
+			transformed.Object = res
+		}
+
+		// kubectl apply and server-side apply keep copies of the item's fields in its
+		// metadata, which would otherwise hold the data the transform redacted
+		if t.redacts {
+			annotations := transformed.GetAnnotations()
+			if _, ok := annotations[corev1api.LastAppliedConfigAnnotation]; ok {
+				delete(annotations, corev1api.LastAppliedConfigAnnotation)
+				if len(annotations) == 0 {
+					annotations = nil
+				}
+				transformed.SetAnnotations(annotations)
+			}
+			unstructured.RemoveNestedField(transformed.Object, "metadata", "managedFields")
+		}
+
+		// only record the transforms that changed the item, so that restores don't warn
+		// about items that are complete
+		if equality.Semantic.DeepEqual(item.Object, transformed.Object) {
+			continue
+		}
+		item = transformed
+		applied = append(applied, t.name)
+	}
+
+	if len(applied) == 0 {
+		return obj, nil, nil
+	}
+
+	annotations := item.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[velerov1api.BackupTransformsAnnotation] = strings.Join(applied, ",")
+	item.SetAnnotations(annotations)
+
+	return item, applied, nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestValidateTransforms(t *testing.T) {
+	tests := []struct {
+		name       string
+		transforms []velerov1.BackupTransform
+		wantErrs   []string
+	}{
+		{
+			name: "valid transforms",
+			transforms: []velerov1.BackupTransform{
+				{Name: "redact", Patches: []velerov1.BackupTransformPatch{{Operation: velerov1.BackupTransformOperationReplace, Path: "/data/password", Value: "x"}}},
+				{Name: "metadata-only", MetadataOnly: true, SecretTypes: []string{"kubernetes.io/tls"}},
+			},
+		},
+		{
+			name: "invalid names",
+			transforms: []velerov1.BackupTransform{
+				{Name: "", MetadataOnly: true},
+				{Name: "a,b", MetadataOnly: true},
+				{Name: "c", MetadataOnly: true},
+				{Name: "c", MetadataOnly: true},
+			},
+			wantErrs: []string{
+				"name must not be empty",
+				`name "a,b" must not contain commas`,
+				"name c is used by more than one transform",
+			},
+		},
+		{
+			name: "invalid patches, label selectors and transforms that do nothing",
+			transforms: []velerov1.BackupTransform{
+				{Name: "a", Patches: []velerov1.BackupTransformPatch{{Operation: "add", Path: "/data/x"}}},
+				{Name: "b", Patches: []velerov1.BackupTransformPatch{{Operation: velerov1.BackupTransformOperationRemove, Path: "data"}}},
+				{
+					Name:          "c",
+					MetadataOnly:  true,
+					LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "k", Operator: "Bogus"}}},
+				},
+				{Name: "d"},
+			},
+			wantErrs: []string{
+				`invalid patch of transform a: unsupported operation "add"`,
+				`invalid patch of transform b: path "data" must start with /`,
+				`invalid label selector of transform c: "Bogus" is not a valid pod selector operator`,
+				"transform d has no patches and isn't metadataOnly",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errMsgs []string
+			for _, err := range ValidateTransforms(tc.transforms) {
+				errMsgs = append(errMsgs, err.Error())
+			}
+			assert.Equal(t, tc.wantErrs, errMsgs)
+		})
+	}
+}
+
+// TestBackupTransforms runs a backup with transforms and verifies that the items they
+// select are modified and annotated in the backup tarball, and that other items aren't.
+func TestBackupTransforms(t *testing.T) {
+	h := newHarness(t)
+	h.addItems(t, test.Secrets(
+		builder.ForSecret("ns-1", "db").Data(map[string][]byte{"user": []byte("admin"), "password": []byte("secret")}).Result(),
+		builder.ForSecret("ns-1", "registry").Type(corev1api.SecretTypeDockerConfigJson).Data(map[string][]byte{".dockerconfigjson": []byte("{}")}).Result(),
+		builder.ForSecret("ns-2", "db").Data(map[string][]byte{"password": []byte("secret")}).Result(),
+	))
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").Result(),
+	))
+
+	backup := defaultBackup().Transforms(
+		velerov1.BackupTransform{
+			Name:               "redact-passwords",
+			IncludedNamespaces: []string{"ns-1"},
+			IncludedResources:  []string{"secrets"},
+			Patches: []velerov1.BackupTransformPatch{
+				{Operation: velerov1.BackupTransformOperationReplace, Path: "/data/password", Value: "cmVkYWN0ZWQ="},
+				{Operation: velerov1.BackupTransformOperationRemove, Path: "/data/token"},
+			},
+		},
+		velerov1.BackupTransform{
+			Name:         "registry-credentials",
+			SecretTypes:  []string{string(corev1api.SecretTypeDockerConfigJson)},
+			MetadataOnly: true,
+		},
+	).Result()
+
+	req := &Request{Backup: backup}
+	backupFile := bytes.NewBuffer([]byte{})
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	registry := toUnstructuredOrFail(t, builder.ForSecret("ns-1", "registry").
+		ObjectMeta(builder.WithAnnotations(velerov1.BackupTransformsAnnotation, "registry-credentials")).Result())
+	delete(registry, "type")
+	delete(registry, "data")
+
+	assertTarballFileContents(t, backupFile, map[string]unstructuredObject{
+		"resources/secrets/namespaces/ns-1/db.json": toUnstructuredOrFail(t, builder.ForSecret("ns-1", "db").
+			ObjectMeta(builder.WithAnnotations(velerov1.BackupTransformsAnnotation, "redact-passwords")).
+			Data(map[string][]byte{"user": []byte("admin"), "password": []byte("redacted")}).Result()),
+		"resources/secrets/namespaces/ns-1/registry.json": registry,
+		"resources/secrets/namespaces/ns-2/db.json": toUnstructuredOrFail(t, builder.ForSecret("ns-2", "db").
+			Data(map[string][]byte{"password": []byte("secret")}).Result()),
+		"resources/pods/namespaces/ns-1/pod-1.json": toUnstructuredOrFail(t, builder.ForPod("ns-1", "pod-1").Result()),
+	})
+	assert.Equal(t, 2, req.Status.ItemsTransformed)
+}
+
+func TestTransformItemStripsAppliedConfiguration(t *testing.T) {
+	// a Secret created by kubectl apply, which keeps a copy of its data in its metadata
+	secret := builder.ForSecret("ns-1", "db").
+		ObjectMeta(
+			builder.WithLabels("app", "db"),
+			builder.WithAnnotations(corev1api.LastAppliedConfigAnnotation, `{"apiVersion":"v1","data":{"password":"c2VjcmV0"},"kind":"Secret"}`),
+		).
+		Data(map[string][]byte{"password": []byte("secret")}).
+		Result()
+	secret.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl-client-side-apply", Operation: metav1.ManagedFieldsOperationUpdate}}
+
+	tests := []struct {
+		name          string
+		transform     velerov1.BackupTransform
+		wantStripped  bool
+		wantTransform bool
+	}{
+		{
+			name: "patching data strips the last applied configuration and managed fields",
+			transform: velerov1.BackupTransform{
+				Name:    "redact-passwords",
+				Patches: []velerov1.BackupTransformPatch{{Operation: velerov1.BackupTransformOperationReplace, Path: "/data/password", Value: "cmVkYWN0ZWQ="}},
+			},
+			wantStripped:  true,
+			wantTransform: true,
+		},
+		{
+			name:          "metadata only strips the last applied configuration and managed fields",
+			transform:     velerov1.BackupTransform{Name: "metadata-only", MetadataOnly: true},
+			wantStripped:  true,
+			wantTransform: true,
+		},
+		{
+			name: "patching metadata keeps the last applied configuration and managed fields",
+			transform: velerov1.BackupTransform{
+				Name:    "drop-labels",
+				Patches: []velerov1.BackupTransformPatch{{Operation: velerov1.BackupTransformOperationRemove, Path: "/metadata/labels/app"}},
+			},
+			wantStripped:  false,
+			wantTransform: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			transforms, err := getItemTransforms([]velerov1.BackupTransform{tc.transform}, nil)
+			require.NoError(t, err)
+
+			item, applied, err := transformItem(transforms, kuberesource.Secrets, &unstructured.Unstructured{Object: toUnstructuredOrFail(t, secret)})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantTransform, len(applied) == 1)
+
+			obj := item.UnstructuredContent()
+			_, hasAppliedConfig, _ := unstructured.NestedString(obj, "metadata", "annotations", corev1api.LastAppliedConfigAnnotation)
+			_, hasManagedFields, _ := unstructured.NestedSlice(obj, "metadata", "managedFields")
+			assert.Equal(t, !tc.wantStripped, hasAppliedConfig)
+			assert.Equal(t, !tc.wantStripped, hasManagedFields)
+		})
+	}
+}
@@ -150,6 +150,12 @@ func (b *BackupBuilder) ExcludedResources(resources ...string) *BackupBuilder {
 	return b
 }
 
+// IncludeClusterDependencies sets the Backup's "include cluster dependencies" flag.
+func (b *BackupBuilder) IncludeClusterDependencies(val bool) *BackupBuilder {
+	b.object.Spec.IncludeClusterDependencies = &val
+	return b
+}
+
 // IncludeClusterResources sets the Backup's "include cluster resources" flag.
 func (b *BackupBuilder) IncludeClusterResources(val bool) *BackupBuilder {
 	b.object.Spec.IncludeClusterResources = &val
@@ -228,6 +234,12 @@ func (b *BackupBuilder) Hooks(hooks velerov1api.BackupHooks) *BackupBuilder {
 	return b
 }
 
+// Transforms appends to the Backup's transforms.
+func (b *BackupBuilder) Transforms(transforms ...velerov1api.BackupTransform) *BackupBuilder {
+	b.object.Spec.Transforms = append(b.object.Spec.Transforms, transforms...)
+	return b
+}
+
 // OrderedResources sets the Backup's OrderedResources
 func (b *BackupBuilder) OrderedResources(orders map[string]string) *BackupBuilder {
 	b.object.Spec.OrderedResources = orders
@@ -239,3 +251,9 @@ func (b *BackupBuilder) CSISnapshotTimeout(timeout time.Duration) *BackupBuilder
 	b.object.Spec.CSISnapshotTimeout.Duration = timeout
 	return b
 }
+
+// SnapshotMoveData sets the Backup's "SnapshotMoveData" flag.
+func (b *BackupBuilder) SnapshotMoveData(val bool) *BackupBuilder {
+	b.object.Spec.SnapshotMoveData = &val
+	return b
+}
@@ -99,6 +99,21 @@ func (b *BackupStorageLocationBuilder) Default(isDefault bool) *BackupStorageLoc
 	return b
 }
 
+// Placement sets the BackupStorageLocation's placement rules.
+func (b *BackupStorageLocationBuilder) Placement(placement *velerov1api.BackupStorageLocationPlacement) *BackupStorageLocationBuilder {
+	b.object.Spec.Placement = placement
+	return b
+}
+
+// Fallback sets the BackupStorageLocation's fallback location.
+func (b *BackupStorageLocationBuilder) Fallback(location string, copyBack bool) *BackupStorageLocationBuilder {
+	b.object.Spec.Fallback = &velerov1api.BackupStorageLocationFallback{
+		Location: location,
+		CopyBack: copyBack,
+	}
+	return b
+}
+
 // AccessMode sets the BackupStorageLocation's access mode.
 func (b *BackupStorageLocationBuilder) AccessMode(accessMode velerov1api.BackupStorageLocationAccessMode) *BackupStorageLocationBuilder {
 	b.object.Spec.AccessMode = accessMode
@@ -60,3 +60,9 @@ func (b *DownloadRequestBuilder) Target(targetKind velerov1api.DownloadTargetKin
 	b.object.Spec.Target.Name = targetName
 	return b
 }
+
+// TargetNamespace sets the namespace whose archive the DownloadRequest downloads.
+func (b *DownloadRequestBuilder) TargetNamespace(namespace string) *DownloadRequestBuilder {
+	b.object.Spec.Target.Namespace = namespace
+	return b
+}
@@ -45,3 +45,12 @@ func ForNode(name string) *NodeBuilder {
 func (b *NodeBuilder) Result() *corev1api.Node {
 	return b.object
 }
+
+// ObjectMeta applies functional options to the Node's ObjectMeta.
+func (b *NodeBuilder) ObjectMeta(opts ...ObjectMetaOpt) *NodeBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
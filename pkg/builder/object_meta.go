@@ -148,6 +148,19 @@ func WithUID(val string) func(obj metav1.Object) {
 	}
 }
 
+// WithOwnerReference is a functional option that adds an owner reference to the specified
+// owner to an object.
+func WithOwnerReference(apiVersion, kind, name, uid string) func(obj metav1.Object) {
+	return func(obj metav1.Object) {
+		obj.SetOwnerReferences(append(obj.GetOwnerReferences(), metav1.OwnerReference{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+			UID:        types.UID(uid),
+		}))
+	}
+}
+
 // WithGenerateName is a functional option that applies the specified generate name to an object.
 func WithGenerateName(val string) func(obj metav1.Object) {
 	return func(obj metav1.Object) {
@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// PluginConfigBuilder builds PluginConfig objects.
+type PluginConfigBuilder struct {
+	object *velerov1api.PluginConfig
+}
+
+// ForPluginConfig is the constructor for a PluginConfigBuilder.
+func ForPluginConfig(ns, name string) *PluginConfigBuilder {
+	return &PluginConfigBuilder{
+		object: &velerov1api.PluginConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "PluginConfig",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built PluginConfig.
+func (b *PluginConfigBuilder) Result() *velerov1api.PluginConfig {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the PluginConfig's ObjectMeta.
+func (b *PluginConfigBuilder) ObjectMeta(opts ...ObjectMetaOpt) *PluginConfigBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// Plugin sets the kind and name of the plugin the PluginConfig is for.
+func (b *PluginConfigBuilder) Plugin(kind, name string) *PluginConfigBuilder {
+	b.object.Spec.PluginKind = kind
+	b.object.Spec.PluginName = name
+	return b
+}
+
+// Config sets the PluginConfig's configuration data.
+func (b *PluginConfigBuilder) Config(vals ...string) *PluginConfigBuilder {
+	b.object.Spec.Config = setMapEntries(b.object.Spec.Config, vals...)
+	return b
+}
+
+// IncludedNamespaces appends to the PluginConfig's included namespaces.
+func (b *PluginConfigBuilder) IncludedNamespaces(namespaces ...string) *PluginConfigBuilder {
+	b.object.Spec.IncludedNamespaces = append(b.object.Spec.IncludedNamespaces, namespaces...)
+	return b
+}
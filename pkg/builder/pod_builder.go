@@ -76,6 +76,12 @@ func (b *PodBuilder) NodeName(val string) *PodBuilder {
 	return b
 }
 
+// PriorityClassName sets the pod's priority class name
+func (b *PodBuilder) PriorityClassName(val string) *PodBuilder {
+	b.object.Spec.PriorityClassName = val
+	return b
+}
+
 func (b *PodBuilder) InitContainers(containers ...*corev1api.Container) *PodBuilder {
 	for _, c := range containers {
 		b.object.Spec.InitContainers = append(b.object.Spec.InitContainers, *c)
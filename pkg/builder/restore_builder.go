@@ -101,6 +101,30 @@ func (b *RestoreBuilder) ExistingResourcePolicy(policy string) *RestoreBuilder {
 	return b
 }
 
+// WebhookRestorePolicy sets the Restore's webhook restore policy.
+func (b *RestoreBuilder) WebhookRestorePolicy(policy velerov1api.WebhookRestorePolicy) *RestoreBuilder {
+	b.object.Spec.WebhookRestorePolicy = policy
+	return b
+}
+
+// IncludedItems appends to the Restore's included item patterns.
+func (b *RestoreBuilder) IncludedItems(patterns ...string) *RestoreBuilder {
+	b.object.Spec.IncludedItems = append(b.object.Spec.IncludedItems, patterns...)
+	return b
+}
+
+// ExcludedItems appends to the Restore's excluded item patterns.
+func (b *RestoreBuilder) ExcludedItems(patterns ...string) *RestoreBuilder {
+	b.object.Spec.ExcludedItems = append(b.object.Spec.ExcludedItems, patterns...)
+	return b
+}
+
+// ServicePolicy sets the Restore's service policy.
+func (b *RestoreBuilder) ServicePolicy(policy *velerov1api.ServiceRestorePolicy) *RestoreBuilder {
+	b.object.Spec.ServicePolicy = policy
+	return b
+}
+
 // IncludeClusterResources sets the Restore's "include cluster resources" flag.
 func (b *RestoreBuilder) IncludeClusterResources(val bool) *RestoreBuilder {
 	b.object.Spec.IncludeClusterResources = &val
@@ -119,6 +143,15 @@ func (b *RestoreBuilder) OrLabelSelector(orSelectors []*metav1.LabelSelector) *R
 	return b
 }
 
+// FieldSelector sets the field selector of a resource's items.
+func (b *RestoreBuilder) FieldSelector(resource, selector string) *RestoreBuilder {
+	if b.object.Spec.FieldSelectors == nil {
+		b.object.Spec.FieldSelectors = make(map[string]string)
+	}
+	b.object.Spec.FieldSelectors[resource] = selector
+	return b
+}
+
 // NamespaceMappings sets the Restore's namespace mappings.
 func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	if b.object.Spec.NamespaceMapping == nil {
@@ -136,6 +169,12 @@ func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	return b
 }
 
+// NamespaceMappingRules appends to the Restore's namespace mapping rules.
+func (b *RestoreBuilder) NamespaceMappingRules(rules ...velerov1api.NamespaceMappingRule) *RestoreBuilder {
+	b.object.Spec.NamespaceMappingRules = append(b.object.Spec.NamespaceMappingRules, rules...)
+	return b
+}
+
 // Phase sets the Restore's phase.
 func (b *RestoreBuilder) Phase(phase velerov1api.RestorePhase) *RestoreBuilder {
 	b.object.Status.Phase = phase
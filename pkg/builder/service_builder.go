@@ -55,3 +55,22 @@ func (s *ServiceBuilder) ObjectMeta(opts ...ObjectMetaOpt) *ServiceBuilder {
 
 	return s
 }
+
+// Type sets the Service's type.
+func (s *ServiceBuilder) Type(serviceType corev1api.ServiceType) *ServiceBuilder {
+	s.object.Spec.Type = serviceType
+	return s
+}
+
+// ClusterIP sets the Service's ClusterIP and ClusterIPs.
+func (s *ServiceBuilder) ClusterIP(ip string) *ServiceBuilder {
+	s.object.Spec.ClusterIP = ip
+	s.object.Spec.ClusterIPs = []string{ip}
+	return s
+}
+
+// LoadBalancerIP sets the Service's loadBalancerIP.
+func (s *ServiceBuilder) LoadBalancerIP(ip string) *ServiceBuilder {
+	s.object.Spec.LoadBalancerIP = ip
+	return s
+}
@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewCancelCommand creates a new command that cancels running backups.
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewCancelOptions("backup")
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Cancel running backups",
+		Long: `Cancel backups that are new or in progress.
+
+A canceled backup stops backing up items, cancels its pod volume backups, deletes the
+volume snapshots it has taken, and ends in the Canceled phase. It is not uploaded to
+object storage.`,
+		Example: `  # Cancel a backup named "backup-1".
+  velero backup cancel backup-1
+
+  # Cancel a backup named "backup-1" without prompting for confirmation.
+  velero backup cancel backup-1 --confirm
+
+  # Cancel all backups triggered by schedule "schedule-1".
+  velero backup cancel --selector velero.io/schedule-name=schedule-1`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate(c, f, args))
+			cmd.CheckError(RunCancel(o))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// RunCancel requests cancellation of the backups selected by o.
+func RunCancel(o *cli.CancelOptions) error {
+	if !o.Confirm && !cli.GetConfirmation() {
+		// Don't do anything unless we get confirmation
+		return nil
+	}
+
+	var (
+		backups []*velerov1api.Backup
+		errs    []error
+	)
+
+	if len(o.Names) > 0 {
+		for _, name := range o.Names {
+			backup, err := o.Client.VeleroV1().Backups(o.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			backups = append(backups, backup)
+		}
+	} else {
+		res, err := o.Client.VeleroV1().Backups(o.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: o.Selector.String()})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for i := range res.Items {
+			backups = append(backups, &res.Items[i])
+		}
+	}
+
+	if len(backups) == 0 && len(errs) == 0 {
+		fmt.Println("No backups found")
+		return nil
+	}
+
+	for _, backup := range backups {
+		switch backup.Status.Phase {
+		case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress:
+		default:
+			fmt.Printf("Backup %q is not running (phase %s), skipping.\n", backup.Name, backup.Status.Phase)
+			continue
+		}
+
+		patch := []byte(`{"spec":{"cancel":true}}`)
+		if _, err := o.Client.VeleroV1().Backups(o.Namespace).Patch(context.TODO(), backup.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error canceling backup %q", backup.Name))
+			continue
+		}
+
+		fmt.Printf("Request to cancel backup %q submitted successfully.\nRun `velero backup describe %s` to check when it has stopped.\n", backup.Name, backup.Name)
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
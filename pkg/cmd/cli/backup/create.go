@@ -82,33 +82,36 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 }
 
 type CreateOptions struct {
-	Name                    string
-	TTL                     time.Duration
-	SnapshotVolumes         flag.OptionalBool
-	DefaultVolumesToRestic  flag.OptionalBool
-	IncludeNamespaces       flag.StringArray
-	ExcludeNamespaces       flag.StringArray
-	IncludeResources        flag.StringArray
-	ExcludeResources        flag.StringArray
-	Labels                  flag.Map
-	Selector                flag.LabelSelector
-	IncludeClusterResources flag.OptionalBool
-	Wait                    bool
-	StorageLocation         string
-	SnapshotLocations       []string
-	FromSchedule            string
-	OrderedResources        string
-	CSISnapshotTimeout      time.Duration
+	Name                       string
+	TTL                        time.Duration
+	SnapshotVolumes            flag.OptionalBool
+	DefaultVolumesToRestic     flag.OptionalBool
+	IncludeNamespaces          flag.StringArray
+	ExcludeNamespaces          flag.StringArray
+	IncludeResources           flag.StringArray
+	ExcludeResources           flag.StringArray
+	Labels                     flag.Map
+	Selector                   flag.LabelSelector
+	IncludeClusterResources    flag.OptionalBool
+	IncludeClusterDependencies flag.OptionalBool
+	Wait                       bool
+	StorageLocation            string
+	SnapshotLocations          []string
+	FromSchedule               string
+	OrderedResources           string
+	CSISnapshotTimeout         time.Duration
+	SnapshotMoveData           flag.OptionalBool
 
 	client veleroclient.Interface
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		IncludeNamespaces:       flag.NewStringArray("*"),
-		Labels:                  flag.NewMap(),
-		SnapshotVolumes:         flag.NewOptionalBool(nil),
-		IncludeClusterResources: flag.NewOptionalBool(nil),
+		IncludeNamespaces:          flag.NewStringArray("*"),
+		Labels:                     flag.NewMap(),
+		SnapshotVolumes:            flag.NewOptionalBool(nil),
+		IncludeClusterResources:    flag.NewOptionalBool(nil),
+		IncludeClusterDependencies: flag.NewOptionalBool(nil),
 	}
 }
 
@@ -132,8 +135,14 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the backup")
 	f.NoOptDefVal = "true"
 
+	f = flags.VarPF(&o.IncludeClusterDependencies, "include-cluster-dependencies", "", "Include the cluster-scoped resources that backed up resources depend on, such as ClusterRoles and StorageClasses, in the backup. Only applies if --include-cluster-resources is not set.")
+	f.NoOptDefVal = "true"
+
 	f = flags.VarPF(&o.DefaultVolumesToRestic, "default-volumes-to-restic", "", "Use restic by default to backup all pod volumes")
 	f.NoOptDefVal = "true"
+
+	f = flags.VarPF(&o.SnapshotMoveData, "snapshot-move-data", "", "Move the data of CSI snapshots to the backup repository, so that the volumes can be restored without the snapshots. Requires the EnableCSI feature and the node agent.")
+	f.NoOptDefVal = "true"
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -350,9 +359,15 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.IncludeClusterResources.Value != nil {
 			backupBuilder.IncludeClusterResources(*o.IncludeClusterResources.Value)
 		}
+		if o.IncludeClusterDependencies.Value != nil {
+			backupBuilder.IncludeClusterDependencies(*o.IncludeClusterDependencies.Value)
+		}
 		if o.DefaultVolumesToRestic.Value != nil {
 			backupBuilder.DefaultVolumesToRestic(*o.DefaultVolumesToRestic.Value)
 		}
+		if o.SnapshotMoveData.Value != nil {
+			backupBuilder.SnapshotMoveData(*o.SnapshotMoveData.Value)
+		}
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
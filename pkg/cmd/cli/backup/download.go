@@ -17,8 +17,13 @@ limitations under the License.
 package backup
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"time"
@@ -27,11 +32,16 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 func NewDownloadCommand(f client.Factory) *cobra.Command {
@@ -45,11 +55,10 @@ func NewDownloadCommand(f client.Factory) *cobra.Command {
 	c := &cobra.Command{
 		Use:   "download NAME",
 		Short: "Download all Kubernetes manifests for a backup",
-		Long:  "Download all Kubernetes manifests for a backup. Contents of persistent volume snapshots are not included.",
+		Long:  "Download all Kubernetes manifests for a backup. Contents of persistent volume snapshots are not included. The archives of a backup split into one archive per namespace are merged into one.",
 		Args:  cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
-			cmd.CheckError(o.Complete(args))
-			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Complete(args, f))
 			cmd.CheckError(o.Run(c, f))
 		},
 	}
@@ -67,6 +76,7 @@ type DownloadOptions struct {
 	InsecureSkipTLSVerify bool
 	writeOptions          int
 	caCertFile            string
+	backup                *velerov1api.Backup
 }
 
 func NewDownloadOptions() *DownloadOptions {
@@ -76,7 +86,7 @@ func NewDownloadOptions() *DownloadOptions {
 }
 
 func (o *DownloadOptions) BindFlags(flags *pflag.FlagSet) {
-	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Path to output file. Defaults to <NAME>-data.tar.gz in the current directory, or <NAME>-data.tar.zst or <NAME>-data.tar if the backup is compressed with zstd or not compressed.")
 	flags.BoolVar(&o.Force, "force", o.Force, "Forces the download and will overwrite file if it exists already.")
 	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process download request.")
 	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
@@ -84,19 +94,16 @@ func (o *DownloadOptions) BindFlags(flags *pflag.FlagSet) {
 
 }
 
-func (o *DownloadOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
-	veleroClient, err := f.Client()
-	cmd.CheckError(err)
+func (o *DownloadOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
 
-	if _, err := veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{}); err != nil {
+	veleroClient, err := f.Client()
+	if err != nil {
+		return err
+	}
+	if o.backup, err = veleroClient.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.Name, metav1.GetOptions{}); err != nil {
 		return err
 	}
-
-	return nil
-}
-
-func (o *DownloadOptions) Complete(args []string) error {
-	o.Name = args[0]
 
 	o.writeOptions = os.O_RDWR | os.O_CREATE | os.O_EXCL
 	if o.Force {
@@ -108,7 +115,7 @@ func (o *DownloadOptions) Complete(args []string) error {
 		if err != nil {
 			return errors.Wrapf(err, "error getting current directory")
 		}
-		o.Output = filepath.Join(path, fmt.Sprintf("%s-data.tar.gz", o.Name))
+		o.Output = filepath.Join(path, fmt.Sprintf("%s-data.tar%s", o.Name, compression.Extension(o.backup.Status.Compression)))
 	}
 
 	return nil
@@ -124,7 +131,11 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	}
 	defer backupDest.Close()
 
-	err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), o.Name, velerov1api.DownloadTargetKindBackupContents, backupDest, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	if o.backup.Status.FormatVersion == pkgbackup.SplitArchivesBackupFormatVersion {
+		err = o.downloadSplitArchives(kbClient, f.Namespace(), backupDest)
+	} else {
+		err = downloadrequest.Stream(context.Background(), kbClient, f.Namespace(), o.Name, velerov1api.DownloadTargetKindBackupContents, backupDest, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	}
 	if err != nil {
 		os.Remove(o.Output)
 		cmd.CheckError(err)
@@ -133,3 +144,105 @@ func (o *DownloadOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Backup %s has been successfully downloaded to %s\n", o.Name, backupDest.Name())
 	return nil
 }
+
+// downloadSplitArchives downloads the main archive of a backup in the split archive format and the
+// archives of its namespaces, and writes them to w merged into one archive, compressed like the
+// backup.
+func (o *DownloadOptions) downloadSplitArchives(kbClient kbclient.Client, namespace string, w io.Writer) error {
+	stream := func(target velerov1api.DownloadTarget, w io.Writer) error {
+		return downloadrequest.StreamTarget(context.Background(), kbClient, namespace, target, w, o.Timeout, o.InsecureSkipTLSVerify, o.caCertFile)
+	}
+
+	indexJSON := new(bytes.Buffer)
+	if err := stream(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupIndex, Name: o.Name}, indexJSON); err != nil {
+		return errors.Wrap(err, "error downloading backup index")
+	}
+	index := new(archive.Index)
+	if err := json.NewDecoder(indexJSON).Decode(index); err != nil {
+		return errors.Wrap(err, "error decoding backup index")
+	}
+
+	tempDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		return errors.Wrap(err, "error creating temp dir")
+	}
+	defer os.RemoveAll(tempDir)
+
+	targets := []velerov1api.DownloadTarget{{Kind: velerov1api.DownloadTargetKindBackupContents, Name: o.Name}}
+	for _, ns := range index.NamespacesFor(collections.NewIncludesExcludes()) {
+		targets = append(targets, velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupNamespaceContents, Name: o.Name, Namespace: ns})
+	}
+
+	var archives []string
+	for i, target := range targets {
+		file, err := os.Create(filepath.Join(tempDir, fmt.Sprintf("archive-%d", i)))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = stream(target, file)
+		file.Close()
+		if err != nil {
+			if target.Namespace != "" {
+				return errors.Wrapf(err, "error downloading the archive of namespace %s", target.Namespace)
+			}
+			return err
+		}
+		archives = append(archives, file.Name())
+	}
+
+	return mergeArchives(w, &velerov1api.CompressionConfig{Algorithm: o.backup.Status.Compression}, archives...)
+}
+
+// mergeArchives writes the entries of the compressed tarballs at paths to w as one tarball,
+// compressed as configured by config.
+func mergeArchives(w io.Writer, config *velerov1api.CompressionConfig, paths ...string) error {
+	cw, err := compression.NewWriter(w, config)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(cw)
+
+	for _, path := range paths {
+		if err := copyArchive(tw, path); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(cw.Close())
+}
+
+// copyArchive writes the entries of the compressed tarball at path to tw.
+func copyArchive(tw *tar.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer file.Close()
+
+	cr, err := compression.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer cr.Close()
+
+	tr := tar.NewReader(cr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error reading archive")
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return errors.WithStack(err)
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
+)
+
+func writeArchive(t *testing.T, path string, config *velerov1api.CompressionConfig, files map[string]string) {
+	t.Helper()
+
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	cw, err := compression.NewWriter(file, config)
+	require.NoError(t, err)
+	tw := tar.NewWriter(cw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(content)), Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, cw.Close())
+}
+
+func TestMergeArchives(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	zstd := &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd}
+	main := filepath.Join(dir, "main")
+	writeArchive(t, main, zstd, map[string]string{
+		"metadata/version": "1.2.0",
+		"resources/persistentvolumes/cluster/pv-1.json": "pv-1",
+	})
+	namespace := filepath.Join(dir, "ns-1")
+	writeArchive(t, namespace, zstd, map[string]string{
+		"resources/pods/namespaces/ns-1/pod-1.json": "pod-1",
+	})
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, mergeArchives(buf, zstd, main, namespace))
+
+	cr, err := compression.NewReader(buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(cr)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+
+	assert.Equal(t, map[string]string{
+		"metadata/version": "1.2.0",
+		"resources/persistentvolumes/cluster/pv-1.json": "pv-1",
+		"resources/pods/namespaces/ns-1/pod-1.json":     "pod-1",
+	}, files)
+
+	assert.Error(t, mergeArchives(new(bytes.Buffer), zstd, filepath.Join(dir, "missing")))
+}
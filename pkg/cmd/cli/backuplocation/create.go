@@ -37,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -72,6 +73,15 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	Compression                           *flag.Enum
+	CompressionLevel                      int
+	PlacementNamespaceSelector            flag.LabelSelector
+	PlacementBackupSelector               flag.LabelSelector
+	PlacementWeight                       int32
+	FallbackLocation                      string
+	FallbackCopyBack                      bool
+	ImmutabilityMode                      *flag.Enum
+	LegalHold                             bool
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -84,6 +94,17 @@ func NewCreateOptions() *CreateOptions {
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadOnly),
 		),
+		Compression: flag.NewEnum(
+			"",
+			string(velerov1api.CompressionAlgorithmGzip),
+			string(velerov1api.CompressionAlgorithmZstd),
+			string(velerov1api.CompressionAlgorithmNone),
+		),
+		ImmutabilityMode: flag.NewEnum(
+			"",
+			string(velerov1api.ObjectLockModeGovernance),
+			string(velerov1api.ObjectLockModeCompliance),
+		),
 	}
 }
 
@@ -92,6 +113,11 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.Bucket, "bucket", o.Bucket, "Name of the object storage bucket where backups should be stored.")
 	flags.Var(&o.Credential, "credential", "The credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
+	flags.Var(&o.PlacementNamespaceSelector, "placement-namespace-selector", "Only store backups whose included namespaces all match this label selector in this location when it's a default location. Optional.")
+	flags.Var(&o.PlacementBackupSelector, "placement-backup-selector", "Only store backups whose labels match this label selector in this location when it's a default location. Optional.")
+	flags.Int32Var(&o.PlacementWeight, "placement-weight", o.PlacementWeight, "Priority of this location among the default locations matching a backup. Optional.")
+	flags.StringVar(&o.FallbackLocation, "fallback-location", o.FallbackLocation, "Name of the backup storage location to store new backups in while this location is unavailable. Optional.")
+	flags.BoolVar(&o.FallbackCopyBack, "fallback-copy-back", o.FallbackCopyBack, "Copy the backups stored in the fallback location back to this location once it's available again. Optional.")
 	flags.StringVar(&o.Prefix, "prefix", o.Prefix, "Prefix under which all Velero data should be stored within the bucket. Optional.")
 	flags.DurationVar(&o.BackupSyncPeriod, "backup-sync-period", o.BackupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. Optional. Set this to `0s` to disable sync. Default: 1 minute.")
 	flags.DurationVar(&o.ValidationFrequency, "validation-frequency", o.ValidationFrequency, "How often to verify if the backup storage location is valid. Optional. Set this to `0s` to disable sync. Default 1 minute.")
@@ -103,6 +129,18 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 		"access-mode",
 		fmt.Sprintf("Access mode for the backup storage location. Valid values are %s", strings.Join(o.AccessMode.AllowedValues(), ",")),
 	)
+	flags.Var(
+		o.Compression,
+		"compression",
+		fmt.Sprintf("Algorithm used to compress the contents of backups stored in this location. Valid values are %s. Optional. Default: gzip.", strings.Join(o.Compression.AllowedValues(), ",")),
+	)
+	flags.IntVar(&o.CompressionLevel, "compression-level", o.CompressionLevel, "Compression level, from 1 (fastest) to 9 for gzip or 4 for zstd (best compression). Optional. Default: the algorithm's default level.")
+	flags.Var(
+		o.ImmutabilityMode,
+		"immutability-mode",
+		fmt.Sprintf("Object lock mode protecting the backups stored in this location until they expire. The bucket must have object lock enabled. Valid values are %s. Optional.", strings.Join(o.ImmutabilityMode.AllowedValues(), ",")),
+	)
+	flags.BoolVar(&o.LegalHold, "legal-hold", o.LegalHold, "Place a legal hold on the backups stored in this location, which prevents their deletion until it's released in the object store. Optional.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -126,9 +164,71 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if err := compression.Validate(o.compressionConfig()); err != nil {
+		return err
+	}
+
+	if o.PlacementWeight < 0 {
+		return errors.New("--placement-weight must be non-negative")
+	}
+
+	if o.FallbackCopyBack && o.FallbackLocation == "" {
+		return errors.New("--fallback-copy-back requires --fallback-location")
+	}
+
+	if o.FallbackLocation != "" && o.FallbackLocation == o.Name {
+		return errors.New("--fallback-location can't be the location itself")
+	}
+
 	return nil
 }
 
+// fallback returns the fallback location set by the flags, or nil if none was set.
+func (o *CreateOptions) fallback() *velerov1api.BackupStorageLocationFallback {
+	if o.FallbackLocation == "" {
+		return nil
+	}
+	return &velerov1api.BackupStorageLocationFallback{
+		Location: o.FallbackLocation,
+		CopyBack: o.FallbackCopyBack,
+	}
+}
+
+// immutability returns the object lock set by the flags, or nil if none was set.
+func (o *CreateOptions) immutability() *velerov1api.BackupStorageLocationImmutability {
+	if o.ImmutabilityMode.String() == "" && !o.LegalHold {
+		return nil
+	}
+	return &velerov1api.BackupStorageLocationImmutability{
+		Mode:      velerov1api.ObjectLockMode(o.ImmutabilityMode.String()),
+		LegalHold: o.LegalHold,
+	}
+}
+
+// placement returns the placement rules set by the flags, or nil if none was set.
+func (o *CreateOptions) placement() *velerov1api.BackupStorageLocationPlacement {
+	if o.PlacementNamespaceSelector.LabelSelector == nil && o.PlacementBackupSelector.LabelSelector == nil && o.PlacementWeight == 0 {
+		return nil
+	}
+	return &velerov1api.BackupStorageLocationPlacement{
+		NamespaceSelector: o.PlacementNamespaceSelector.LabelSelector,
+		BackupSelector:    o.PlacementBackupSelector.LabelSelector,
+		Weight:            o.PlacementWeight,
+	}
+}
+
+// compressionConfig returns the compression configuration set by the flags, or nil if
+// none was set.
+func (o *CreateOptions) compressionConfig() *velerov1api.CompressionConfig {
+	if o.Compression.String() == "" && o.CompressionLevel == 0 {
+		return nil
+	}
+	return &velerov1api.CompressionConfig{
+		Algorithm: velerov1api.CompressionAlgorithm(o.Compression.String()),
+		Level:     o.CompressionLevel,
+	}
+}
+
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
 	o.Name = args[0]
 	return nil
@@ -162,9 +262,13 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 					CACert: caCertData,
 				},
 			},
-			Config:     o.Config.Data(),
-			Default:    o.DefaultBackupStorageLocation,
-			AccessMode: velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Config:       o.Config.Data(),
+			Default:      o.DefaultBackupStorageLocation,
+			Placement:    o.placement(),
+			AccessMode:   velerov1api.BackupStorageLocationAccessMode(o.AccessMode.String()),
+			Compression:  o.compressionConfig(),
+			Fallback:     o.fallback(),
+			Immutability: o.immutability(),
 		},
 	}
 
@@ -202,21 +306,10 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		return err
 	}
 
-	if o.DefaultBackupStorageLocation {
-		// There is one and only one default backup storage location.
-		// Disable any existing default backup storage location.
-		locations := new(velerov1api.BackupStorageLocationList)
-		if err := kbClient.List(context.Background(), locations, &kbclient.ListOptions{Namespace: f.Namespace()}); err != nil {
-			return errors.WithStack(err)
-		}
-		for _, location := range locations.Items {
-			if location.Spec.Default {
-				location.Spec.Default = false
-				if err := kbClient.Update(context.Background(), &location, &kbclient.UpdateOptions{}); err != nil {
-					return errors.WithStack(err)
-				}
-				break
-			}
+	if o.DefaultBackupStorageLocation && backupStorageLocation.Spec.Placement == nil {
+		// A new default location without placement rules replaces the existing ones.
+		if err := unsetDefaultLocationsWithoutPlacement(kbClient, f.Namespace(), backupStorageLocation.Name); err != nil {
+			return err
 		}
 	}
 
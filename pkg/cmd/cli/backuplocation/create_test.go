@@ -23,6 +23,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 func TestBuildBackupStorageLocationSetsNamespace(t *testing.T) {
@@ -87,3 +89,66 @@ func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, map[string]string{"key": "value"}, bsl.Labels)
 }
+
+func TestBuildBackupStorageLocationSetsCompression(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Compression)
+
+	assert.NoError(t, o.Compression.Set("zstd"))
+	o.CompressionLevel = 3
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 3}, bsl.Spec.Compression)
+}
+
+func TestBuildBackupStorageLocationSetsPlacement(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Placement)
+
+	assert.NoError(t, o.PlacementNamespaceSelector.Set("team=a"))
+	o.PlacementWeight = 10
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.NotNil(t, bsl.Spec.Placement)
+	assert.Equal(t, "team=a", metav1.FormatLabelSelector(bsl.Spec.Placement.NamespaceSelector))
+	assert.Nil(t, bsl.Spec.Placement.BackupSelector)
+	assert.Equal(t, int32(10), bsl.Spec.Placement.Weight)
+}
+
+func TestBuildBackupStorageLocationSetsFallback(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Fallback)
+
+	o.FallbackLocation = "secondary"
+	o.FallbackCopyBack = true
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupStorageLocationFallback{Location: "secondary", CopyBack: true}, bsl.Spec.Fallback)
+}
+
+func TestBuildBackupStorageLocationSetsImmutability(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.Immutability)
+
+	assert.NoError(t, o.ImmutabilityMode.Set("Compliance"))
+	o.LegalHold = true
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupStorageLocationImmutability{Mode: velerov1api.ObjectLockModeCompliance, LegalHold: true}, bsl.Spec.Immutability)
+}
@@ -89,7 +89,7 @@ func NewGetCommand(f client.Factory, use string) *cobra.Command {
 		},
 	}
 
-	c.Flags().BoolVar(&showDefaultOnly, "default", false, "Displays the current default backup storage location.")
+	c.Flags().BoolVar(&showDefaultOnly, "default", false, "Displays the current default backup storage locations.")
 	c.Flags().StringVarP(&listOptions.LabelSelector, "selector", "l", listOptions.LabelSelector, "Only show items matching this label selector.")
 
 	output.BindFlags(c.Flags())
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -59,6 +60,11 @@ type SetOptions struct {
 	CACertFile                   string
 	Credential                   flag.Map
 	DefaultBackupStorageLocation bool
+	PlacementNamespaceSelector   flag.LabelSelector
+	PlacementBackupSelector      flag.LabelSelector
+	PlacementWeight              int32
+	FallbackLocation             string
+	FallbackCopyBack             bool
 }
 
 func NewSetOptions() *SetOptions {
@@ -71,6 +77,11 @@ func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
 	flags.Var(&o.Credential, "credential", "Sets the credential to be used by this location as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. Optional, one value only.")
 	flags.BoolVar(&o.DefaultBackupStorageLocation, "default", o.DefaultBackupStorageLocation, "Sets this new location to be the new default backup storage location. Optional.")
+	flags.Var(&o.PlacementNamespaceSelector, "placement-namespace-selector", "Only store backups whose included namespaces all match this label selector in this location when it's a default location. Set it to an empty string to remove it. Optional.")
+	flags.Var(&o.PlacementBackupSelector, "placement-backup-selector", "Only store backups whose labels match this label selector in this location when it's a default location. Set it to an empty string to remove it. Optional.")
+	flags.Int32Var(&o.PlacementWeight, "placement-weight", o.PlacementWeight, "Priority of this location among the default locations matching a backup. Optional.")
+	flags.StringVar(&o.FallbackLocation, "fallback-location", o.FallbackLocation, "Name of the backup storage location to store new backups in while this location is unavailable. Set it to an empty string to remove it. Optional.")
+	flags.BoolVar(&o.FallbackCopyBack, "fallback-copy-back", o.FallbackCopyBack, "Copy the backups stored in the fallback location back to this location once it's available again. Optional.")
 }
 
 func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -78,6 +89,14 @@ func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory)
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if o.PlacementWeight < 0 {
+		return errors.New("--placement-weight must be non-negative")
+	}
+
+	if o.FallbackLocation != "" && o.FallbackLocation == args[0] {
+		return errors.New("--fallback-location can't be the location itself")
+	}
+
 	return nil
 }
 
@@ -86,6 +105,60 @@ func (o *SetOptions) Complete(args []string, f client.Factory) error {
 	return nil
 }
 
+// setPlacement updates the placement rules of the location set by the flags.
+func (o *SetOptions) setPlacement(c *cobra.Command, location *velerov1api.BackupStorageLocation) {
+	placement := location.Spec.Placement
+	if placement == nil {
+		placement = &velerov1api.BackupStorageLocationPlacement{}
+	}
+
+	if c.Flags().Changed("placement-namespace-selector") {
+		placement.NamespaceSelector = nonEmptySelector(o.PlacementNamespaceSelector.LabelSelector)
+	}
+	if c.Flags().Changed("placement-backup-selector") {
+		placement.BackupSelector = nonEmptySelector(o.PlacementBackupSelector.LabelSelector)
+	}
+	if c.Flags().Changed("placement-weight") {
+		placement.Weight = o.PlacementWeight
+	}
+
+	if placement.NamespaceSelector == nil && placement.BackupSelector == nil && placement.Weight == 0 {
+		placement = nil
+	}
+	location.Spec.Placement = placement
+}
+
+// setFallback updates the fallback location of the location set by the flags.
+func (o *SetOptions) setFallback(c *cobra.Command, location *velerov1api.BackupStorageLocation) error {
+	fallback := location.Spec.Fallback
+	if fallback == nil {
+		fallback = &velerov1api.BackupStorageLocationFallback{}
+	}
+
+	if c.Flags().Changed("fallback-location") {
+		fallback.Location = o.FallbackLocation
+	}
+	if c.Flags().Changed("fallback-copy-back") {
+		fallback.CopyBack = o.FallbackCopyBack
+	}
+
+	if fallback.Location == "" {
+		if fallback.CopyBack {
+			return errors.New("--fallback-copy-back requires a fallback location")
+		}
+		fallback = nil
+	}
+	location.Spec.Fallback = fallback
+	return nil
+}
+
+func nonEmptySelector(selector *metav1.LabelSelector) *metav1.LabelSelector {
+	if selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0) {
+		return nil
+	}
+	return selector
+}
+
 func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 	kbClient, err := f.KubebuilderClient()
 	if err != nil {
@@ -113,26 +186,15 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 		return errors.WithStack(err)
 	}
 
-	if o.DefaultBackupStorageLocation {
-		// There is one and only one default backup storage location.
-		// Disable the origin default backup storage location.
-		locations := new(velerov1api.BackupStorageLocationList)
-		if err := kbClient.List(context.Background(), locations, &kbclient.ListOptions{Namespace: f.Namespace()}); err != nil {
-			return errors.WithStack(err)
-		}
-		for _, location := range locations.Items {
-			if !location.Spec.Default {
-				continue
-			}
-			if location.Name == o.Name {
-				// Do not update if the origin default BSL is the current one.
-				break
-			}
-			location.Spec.Default = false
-			if err := kbClient.Update(context.Background(), &location, &kbclient.UpdateOptions{}); err != nil {
-				return errors.WithStack(err)
-			}
-			break
+	o.setPlacement(c, location)
+	if err := o.setFallback(c, location); err != nil {
+		return err
+	}
+
+	if o.DefaultBackupStorageLocation && location.Spec.Placement == nil {
+		// A default location without placement rules replaces the other ones.
+		if err := unsetDefaultLocationsWithoutPlacement(kbClient, f.Namespace(), o.Name); err != nil {
+			return err
 		}
 	}
 
@@ -151,3 +213,23 @@ func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
 	fmt.Printf("Backup storage location %q configured successfully.\n", o.Name)
 	return nil
 }
+
+// unsetDefaultLocationsWithoutPlacement unsets the default flag of the default locations without
+// placement rules other than the named one.
+func unsetDefaultLocationsWithoutPlacement(kbClient kbclient.Client, namespace, name string) error {
+	locations := new(velerov1api.BackupStorageLocationList)
+	if err := kbClient.List(context.Background(), locations, &kbclient.ListOptions{Namespace: namespace}); err != nil {
+		return errors.WithStack(err)
+	}
+	for i := range locations.Items {
+		location := &locations.Items[i]
+		if !location.Spec.Default || location.Spec.Placement != nil || location.Name == name {
+			continue
+		}
+		location.Spec.Default = false
+		if err := kbClient.Update(context.Background(), location, &kbclient.UpdateOptions{}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+// CancelOptions contains parameters used for canceling backups or restores.
+type CancelOptions struct {
+	Names            []string
+	Selector         flag.LabelSelector
+	Confirm          bool
+	Client           clientset.Interface
+	Namespace        string
+	singularTypeName string
+}
+
+func NewCancelOptions(singularTypeName string) *CancelOptions {
+	return &CancelOptions{singularTypeName: singularTypeName}
+}
+
+// Complete fills in the correct values for all the options.
+func (o *CancelOptions) Complete(f client.Factory, args []string) error {
+	o.Namespace = f.Namespace()
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.Client = client
+	o.Names = args
+	return nil
+}
+
+// Validate validates the fields of the CancelOptions struct.
+func (o *CancelOptions) Validate(c *cobra.Command, f client.Factory, args []string) error {
+	if o.Client == nil {
+		return errors.New("Velero client is not set; unable to proceed")
+	}
+	if !xor(len(o.Names) > 0, o.Selector.LabelSelector != nil) {
+		return errors.New("you must specify exactly one of: specific " + o.singularTypeName + " name(s) or the --selector flag")
+	}
+
+	return nil
+}
+
+// BindFlags binds options for this command to flags.
+func (o *CancelOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Confirm, "confirm", o.Confirm, "Confirm cancellation")
+	flags.VarP(&o.Selector, "selector", "l", "Cancel all "+o.singularTypeName+"s matching this label selector.")
+}
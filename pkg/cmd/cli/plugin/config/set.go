@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/restore"
+)
+
+func NewSetCommand(f client.Factory, use string) *cobra.Command {
+	o := NewSetOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Create or update the configuration for a plugin",
+		Long: `Create or update the configuration for a plugin.
+
+Configuration keys given with --config are added to any existing configuration. A key
+with an empty value is removed.`,
+		Example: `  # Map the "gp2" storage class to "gp3" when restoring.
+  velero plugin config set change-storage-class \
+      --kind RestoreItemAction \
+      --plugin velero.io/change-storage-class \
+      --config gp2=gp3
+
+  # Only apply the mapping to items restored into the "app" namespace.
+  velero plugin config set change-storage-class-app \
+      --kind RestoreItemAction \
+      --plugin velero.io/change-storage-class \
+      --config gp2=io2 \
+      --include-namespaces app`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type SetOptions struct {
+	Name              string
+	Kind              string
+	Plugin            string
+	Config            flag.Map
+	IncludeNamespaces flag.StringArray
+}
+
+func NewSetOptions() *SetOptions {
+	return &SetOptions{
+		Config: flag.NewMap(),
+	}
+}
+
+func (o *SetOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Kind, "kind", o.Kind, "Kind of the plugin to configure, e.g. RestoreItemAction. Required when creating new configuration.")
+	flags.StringVar(&o.Plugin, "plugin", o.Plugin, "Name of the plugin to configure, e.g. velero.io/change-storage-class. Required when creating new configuration.")
+	flags.Var(&o.Config, "config", "Configuration key-value pairs for the plugin, e.g. key1=value1,key2=value2.")
+	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces whose items the configuration applies to. If not set, it applies to items in all namespaces.")
+}
+
+func (o *SetOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *SetOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if o.Kind == "" {
+		return nil
+	}
+
+	if _, ok := common.AllPluginKinds()[o.Kind]; !ok {
+		return errors.Errorf("invalid plugin kind %q", o.Kind)
+	}
+	return nil
+}
+
+func (o *SetOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	config := new(velerov1api.PluginConfig)
+	err = kbClient.Get(context.Background(), kbclient.ObjectKey{
+		Namespace: f.Namespace(),
+		Name:      o.Name,
+	}, config)
+
+	create := apierrors.IsNotFound(err)
+	switch {
+	case create:
+		if o.Kind == "" || o.Plugin == "" {
+			return errors.New("--kind and --plugin are required when creating new plugin configuration")
+		}
+		config = builder.ForPluginConfig(f.Namespace(), o.Name).Result()
+	case err != nil:
+		return errors.WithStack(err)
+	}
+
+	if o.Kind != "" {
+		config.Spec.PluginKind = o.Kind
+	}
+	if o.Plugin != "" {
+		config.Spec.PluginName = o.Plugin
+	}
+	if c.Flags().Changed("include-namespaces") {
+		config.Spec.IncludedNamespaces = o.IncludeNamespaces
+	}
+
+	for key, value := range o.Config.Data() {
+		if value == "" {
+			delete(config.Spec.Config, key)
+			continue
+		}
+		if config.Spec.Config == nil {
+			config.Spec.Config = make(map[string]string)
+		}
+		config.Spec.Config[key] = value
+	}
+
+	// the configuration of the plugins included in Velero is validated before it's saved,
+	// while other plugins validate theirs when they read it
+	if schema, ok := restore.PluginConfigSchemaFor(common.PluginKind(config.Spec.PluginKind), config.Spec.PluginName); ok {
+		if err := framework.ValidatePluginConfig(config.Spec.Config, schema); err != nil {
+			return err
+		}
+	}
+
+	if create {
+		err = kbClient.Create(context.Background(), config, &kbclient.CreateOptions{})
+	} else {
+		err = kbClient.Update(context.Background(), config, &kbclient.UpdateOptions{})
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Plugin configuration %q configured successfully.\n", o.Name)
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli/plugin/config"
 )
 
 func NewCommand(f client.Factory) *cobra.Command {
@@ -33,6 +34,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewAddCommand(f),
 		NewRemoveCommand(f),
 		NewGetCommand(f, "get"),
+		config.NewCommand(f),
 	)
 
 	return c
@@ -0,0 +1,46 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	resticrepo "github.com/vmware-tanzu/velero/pkg/cmd/cli/restic/repo"
+)
+
+func NewCommand(f client.Factory) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "repo",
+		Short: "Work with backup repositories",
+		Long:  "Work with the restic and kopia repositories that pod volume and snapshot data is backed up to",
+	}
+
+	c.AddCommand(
+		resticrepo.NewGetCommand(f, "get"),
+		NewDescribeCommand(f, "describe"),
+		NewRotateKeyCommand(f, "rotate-key"),
+		resticrepo.NewVerifyCommand(f, "verify"),
+		resticrepo.NewMigrateCommand(f, "migrate"),
+		resticrepo.NewMaintainCommand(f),
+		resticrepo.NewCheckCommand(f),
+		resticrepo.NewRekeyCommand(f),
+		resticrepo.NewCopyCommand(f),
+	)
+
+	return c
+}
@@ -0,0 +1,72 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewRotateKeyCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Rotate the key of a restic repository",
+		Long: `Request the rotation of the key of a restic repository. The Velero server changes the
+repository's password to a new random one in a job, and stores it in the repository's own key
+secret. A repository that uses the key shared by all the repositories gets its own key secret.`,
+		Example: `  # Rotate the key of a repository.
+  velero repo rotate-key default-default-restic-abcde`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(rotateKey(f, args[0]))
+		},
+	}
+
+	return c
+}
+
+func rotateKey(f client.Factory, name string) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, repo); err != nil {
+		return errors.WithStack(err)
+	}
+
+	original := repo.DeepCopy()
+	if repo.Annotations == nil {
+		repo.Annotations = map[string]string{}
+	}
+	repo.Annotations[velerov1api.RotateBackupRepositoryKeyAnnotation] = ""
+	if err := kbClient.Patch(context.Background(), repo, kbclient.MergeFrom(original)); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Key rotation of restic repository %q requested. Run `velero repo describe %s` to see its result.\n", name, name)
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+// NewCheckCommand returns the command that verification jobs run to verify the data in a backup
+// repository. It reports its result in the termination message of the job's container.
+func NewCheckCommand(f client.Factory) *cobra.Command {
+	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
+	formatFlag := logging.NewFormatFlag()
+	var readDataPercent int
+
+	c := &cobra.Command{
+		Use:    "check NAME",
+		Short:  "Check the integrity of a restic repository",
+		Long:   "Check the integrity of a restic repository",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			logger := logging.DefaultLogger(logLevelFlag.Parse(), formatFlag.Parse()).WithField("backupRepository", args[0])
+
+			result := check(f, args[0], readDataPercent, logger)
+			if err := writeTerminationMessage(result); err != nil {
+				logger.WithError(err).Error("Error writing verification result")
+			}
+			if result.Error != "" {
+				logger.Error(result.Error)
+				os.Exit(1)
+			}
+			if result.Damage != "" {
+				logger.WithField("damagedSnapshots", result.DamagedSnapshots).Warnf("Repository is damaged: %s", result.Damage)
+				return
+			}
+			logger.Info("Repository verification passed")
+		},
+	}
+
+	c.Flags().IntVar(&readDataPercent, "read-data-percent", readDataPercent, "The percentage of the repository's data to read back.")
+	c.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
+	c.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+
+	return c
+}
+
+func check(f client.Factory, name string, readDataPercent int, logger logrus.FieldLogger) repository.VerificationResult {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return repository.VerificationResult{Error: err.Error()}
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, repo); err != nil {
+		return repository.VerificationResult{Error: err.Error()}
+	}
+
+	repoManager, err := newRepositoryManager(f, kbClient, logger)
+	if err != nil {
+		return repository.VerificationResult{Error: err.Error()}
+	}
+
+	logger.WithField("readDataPercent", readDataPercent).Info("Verifying repository")
+	return repository.RunVerification(repoManager, repo, readDataPercent, logger)
+}
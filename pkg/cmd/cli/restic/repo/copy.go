@@ -0,0 +1,125 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+// NewCopyCommand returns the command that migration jobs run to migrate a backup repository to
+// another backup storage location. It reports its result in the termination message of the
+// job's container.
+func NewCopyCommand(f client.Factory) *cobra.Command {
+	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
+	formatFlag := logging.NewFormatFlag()
+	var targetLocation string
+	pluginDir := "/plugins"
+
+	c := &cobra.Command{
+		Use:    "copy NAME",
+		Short:  "Copy a restic repository to another backup storage location",
+		Long:   "Copy a restic repository to another backup storage location",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			logLevel := logLevelFlag.Parse()
+			logger := logging.DefaultLogger(logLevel, formatFlag.Parse()).WithField("backupRepository", args[0])
+
+			result := copyRepo(f, args[0], targetLocation, pluginDir, logLevel, logger)
+			if err := writeTerminationMessage(result); err != nil {
+				logger.WithError(err).Error("Error writing migration result")
+			}
+			if result.Error != "" {
+				logger.Error(result.Error)
+				os.Exit(1)
+			}
+			logger.WithFields(logrus.Fields{
+				"targetRepository": result.TargetRepository,
+				"snapshots":        result.Snapshots,
+				"backups":          result.Backups,
+			}).Info("Repository migrated")
+		},
+	}
+
+	c.Flags().StringVar(&targetLocation, "to-location", targetLocation, "The backup storage location to copy the repository to.")
+	c.Flags().StringVar(&pluginDir, "plugin-dir", pluginDir, "Directory containing Velero plugins")
+	c.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
+	c.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+
+	return c
+}
+
+func copyRepo(f client.Factory, name, targetLocation, pluginDir string, logLevel logrus.Level, logger logrus.FieldLogger) repository.MigrationResult {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return repository.MigrationResult{Error: err.Error()}
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, repo); err != nil {
+		return repository.MigrationResult{Error: err.Error()}
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: targetLocation}, location); err != nil {
+		return repository.MigrationResult{Error: errors.Wrapf(err, "error getting backup storage location %s", targetLocation).Error()}
+	}
+
+	// the backup metadata in the target location is accessed with its object store plugin
+	registry := process.NewRegistry(pluginDir, logger, logLevel)
+	if err := registry.DiscoverPlugins(); err != nil {
+		return repository.MigrationResult{Error: err.Error()}
+	}
+	pluginManager := clientmgmt.NewManager(logger, logLevel, registry, framework.NewPluginConfigGetter(kbClient, f.Namespace()))
+	defer pluginManager.CleanupClients()
+
+	credentialFileStore, err := credentials.NewNamespacedFileStore(kbClient, f.Namespace(), credentialsDirectory, filesystem.NewFileSystem())
+	if err != nil {
+		return repository.MigrationResult{Error: err.Error()}
+	}
+	backupStore, err := persistence.NewObjectBackupStoreGetter(credentialFileStore).Get(location, pluginManager, logger)
+	if err != nil {
+		return repository.MigrationResult{Error: errors.Wrapf(err, "error getting backup store of backup storage location %s", targetLocation).Error()}
+	}
+
+	repoManager, err := newRepositoryManager(f, kbClient, logger)
+	if err != nil {
+		return repository.MigrationResult{Error: err.Error()}
+	}
+
+	logger.WithField("targetLocation", targetLocation).Info("Migrating repository")
+	return repository.MigrateRepo(context.Background(), kbClient, repoManager, repo, location, backupStore, logger)
+}
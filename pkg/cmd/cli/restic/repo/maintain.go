@@ -0,0 +1,145 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	corev1api "k8s.io/api/core/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+// credentialsDirectory is the path on disk where credential files are written to.
+const credentialsDirectory = "/tmp/credentials"
+
+// NewMaintainCommand returns the command that maintenance jobs run to maintain a backup
+// repository. It reports its result in the termination message of the job's container, and
+// records the storage usage of the repository in its status.
+func NewMaintainCommand(f client.Factory) *cobra.Command {
+	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
+	formatFlag := logging.NewFormatFlag()
+
+	c := &cobra.Command{
+		Use:    "maintain NAME",
+		Short:  "Run maintenance on a restic repository",
+		Long:   "Run maintenance on a restic repository",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			logger := logging.DefaultLogger(logLevelFlag.Parse(), formatFlag.Parse()).WithField("backupRepository", args[0])
+
+			result := maintain(f, args[0], logger)
+			if err := writeTerminationMessage(result); err != nil {
+				logger.WithError(err).Error("Error writing maintenance result")
+			}
+			if result.Error != "" {
+				logger.Error(result.Error)
+				os.Exit(1)
+			}
+			logger.Info("Repository maintenance completed")
+		},
+	}
+
+	c.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
+	c.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+
+	return c
+}
+
+func maintain(f client.Factory, name string, logger logrus.FieldLogger) repository.MaintenanceResult {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return repository.MaintenanceResult{Error: err.Error()}
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, repo); err != nil {
+		return repository.MaintenanceResult{Error: err.Error()}
+	}
+
+	repoManager, err := newRepositoryManager(f, kbClient, logger)
+	if err != nil {
+		return repository.MaintenanceResult{Error: err.Error()}
+	}
+
+	logger.Info("Running repository maintenance")
+	result := repository.RunMaintenance(repoManager, repo, logger)
+	if result.Error != "" {
+		return result
+	}
+
+	// the statistics are too large for the termination message, so they're recorded directly, and
+	// failing to collect them doesn't fail the maintenance
+	logger.Info("Collecting repository statistics")
+	if err := updateStats(context.Background(), kbClient, repoManager, repo); err != nil {
+		logger.WithError(err).Warn("Error updating repository statistics")
+	}
+	return result
+}
+
+// updateStats records the storage usage of repo in its status.
+func updateStats(ctx context.Context, kbClient kbclient.Client, repoManager repository.Manager, repo *velerov1api.BackupRepository) error {
+	stats, err := repository.CollectStats(ctx, kbClient, repoManager, repo, time.Now())
+	if err != nil {
+		return err
+	}
+
+	original := repo.DeepCopy()
+	repo.Status.Stats = stats
+	return errors.Wrap(kbClient.Patch(ctx, repo, kbclient.MergeFrom(original)), "error patching repository status")
+}
+
+// newRepositoryManager returns a repository manager that works with the repositories in the
+// factory's namespace, as the Velero server's does.
+func newRepositoryManager(f client.Factory, kbClient kbclient.Client, logger logrus.FieldLogger) (repository.Manager, error) {
+	credentialFileStore, err := credentials.NewNamespacedFileStore(kbClient, f.Namespace(), credentialsDirectory, filesystem.NewFileSystem())
+	if err != nil {
+		return nil, err
+	}
+	credentialSecretStore, err := credentials.NewNamespacedSecretStore(kbClient, f.Namespace())
+	if err != nil {
+		return nil, err
+	}
+
+	return repository.NewManager(f.Namespace(), kbClient, repository.NewRepoLocker(),
+		repository.NewRepositoryEnsurer(kbClient, logger), credentialFileStore, credentialSecretStore, logger), nil
+}
+
+// writeTerminationMessage writes the result of a job's command to the termination message of its
+// container, where the Velero server reads it from.
+func writeTerminationMessage(result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(corev1api.TerminationMessagePathDefault, data, 0644)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/label"
+)
+
+func NewMigrateCommand(f client.Factory, use string) *cobra.Command {
+	o := NewMigrateOptions()
+
+	c := &cobra.Command{
+		Use:   use + " [NAME...]",
+		Short: "Migrate restic repositories to another backup storage location",
+		Long: `Request the migration of restic repositories to another backup storage location. The Velero
+server copies each repository to the location in a job, checks that all of its snapshots are in
+the copy, and updates the pod volume backups of the backups in the location's bucket to refer to
+the copy.
+
+Copy the backups to the location's bucket first. The backups in the cluster keep referring to the
+repositories in the original location, which are kept.`,
+		Example: `  # Migrate a repository to the backup storage location "new-bucket".
+  velero repo migrate default-default-restic-abcde --to-location new-bucket
+
+  # Migrate all the repositories in the backup storage location "default" to "new-bucket".
+  velero repo migrate --from-location default --to-location new-bucket`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type MigrateOptions struct {
+	Names        []string
+	ToLocation   string
+	FromLocation string
+}
+
+func NewMigrateOptions() *MigrateOptions {
+	return &MigrateOptions{}
+}
+
+func (o *MigrateOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.ToLocation, "to-location", o.ToLocation, "The backup storage location to migrate the repositories to. Required.")
+	flags.StringVar(&o.FromLocation, "from-location", o.FromLocation, "Migrate all the repositories in this backup storage location. Optional.")
+}
+
+func (o *MigrateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if o.ToLocation == "" {
+		return errors.New("--to-location is required")
+	}
+	if len(o.Names) == 0 && o.FromLocation == "" {
+		return errors.New("repository names or --from-location must be specified")
+	}
+	if len(o.Names) > 0 && o.FromLocation != "" {
+		return errors.New("repository names and --from-location can't be specified together")
+	}
+	if o.FromLocation == o.ToLocation {
+		return errors.New("--from-location and --to-location must be different")
+	}
+
+	return nil
+}
+
+func (o *MigrateOptions) Complete(args []string, f client.Factory) error {
+	o.Names = args
+	return nil
+}
+
+func (o *MigrateOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.ToLocation}, location); err != nil {
+		return errors.WithStack(err)
+	}
+
+	var repos []velerov1api.BackupRepository
+	if o.FromLocation != "" {
+		list := &velerov1api.BackupRepositoryList{}
+		if err := kbClient.List(context.Background(), list, kbclient.InNamespace(f.Namespace()),
+			kbclient.MatchingLabels{velerov1api.StorageLocationLabel: label.GetValidName(o.FromLocation)}); err != nil {
+			return errors.WithStack(err)
+		}
+		repos = list.Items
+		if len(repos) == 0 {
+			return errors.Errorf("backup storage location %q has no restic repositories", o.FromLocation)
+		}
+	} else {
+		for _, name := range o.Names {
+			repo := velerov1api.BackupRepository{}
+			if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, &repo); err != nil {
+				return errors.WithStack(err)
+			}
+			repos = append(repos, repo)
+		}
+	}
+
+	for i := range repos {
+		repo := &repos[i]
+		if repo.Spec.BackupStorageLocation == o.ToLocation {
+			fmt.Printf("Restic repository %q is in backup storage location %q already.\n", repo.Name, o.ToLocation)
+			continue
+		}
+
+		original := repo.DeepCopy()
+		if repo.Annotations == nil {
+			repo.Annotations = map[string]string{}
+		}
+		repo.Annotations[velerov1api.MigrateBackupRepositoryAnnotation] = o.ToLocation
+		if err := kbClient.Patch(context.Background(), repo, kbclient.MergeFrom(original)); err != nil {
+			return errors.WithStack(err)
+		}
+
+		fmt.Printf("Migration of restic repository %q to backup storage location %q requested. Run `velero repo describe %s` to see its result.\n", repo.Name, o.ToLocation, repo.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+// NewRekeyCommand returns the command that key rotation jobs run to rotate the key of a backup
+// repository. It reports its result in the termination message of the job's container.
+func NewRekeyCommand(f client.Factory) *cobra.Command {
+	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
+	formatFlag := logging.NewFormatFlag()
+
+	c := &cobra.Command{
+		Use:    "rekey NAME",
+		Short:  "Rotate the key of a restic repository",
+		Long:   "Rotate the key of a restic repository",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			logger := logging.DefaultLogger(logLevelFlag.Parse(), formatFlag.Parse()).WithField("backupRepository", args[0])
+
+			result := rekey(f, args[0], logger)
+			if err := writeTerminationMessage(result); err != nil {
+				logger.WithError(err).Error("Error writing key rotation result")
+			}
+			if result.Error != "" {
+				logger.Error(result.Error)
+				os.Exit(1)
+			}
+			logger.WithField("keyGeneration", result.KeyGeneration).Info("Repository key rotated")
+		},
+	}
+
+	c.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
+	c.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+
+	return c
+}
+
+func rekey(f client.Factory, name string, logger logrus.FieldLogger) repository.KeyRotationResult {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return repository.KeyRotationResult{Error: err.Error()}
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, repo); err != nil {
+		return repository.KeyRotationResult{Error: err.Error()}
+	}
+
+	repoManager, err := newRepositoryManager(f, kbClient, logger)
+	if err != nil {
+		return repository.KeyRotationResult{Error: err.Error()}
+	}
+
+	logger.Info("Rotating repository key")
+	return repository.RotateKey(context.Background(), kbClient, repoManager, repo, logger)
+}
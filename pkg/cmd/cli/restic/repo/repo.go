@@ -17,6 +17,8 @@ limitations under the License.
 package repo
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -29,9 +31,20 @@ func NewRepositoryCommand(f client.Factory) *cobra.Command {
 		Long:  "Work with restic repositories",
 	}
 
-	c.AddCommand(
-		NewGetCommand(f, "get"),
-	)
+	c.AddCommand(NewGetCommand(f, "get"))
+
+	// the other repository commands moved to `velero repo`, and are kept here as deprecated aliases
+	for _, cmd := range []*cobra.Command{
+		NewVerifyCommand(f, "verify"),
+		NewMigrateCommand(f, "migrate"),
+		NewMaintainCommand(f),
+		NewCheckCommand(f),
+		NewRekeyCommand(f),
+		NewCopyCommand(f),
+	} {
+		cmd.Deprecated = fmt.Sprintf("use `velero repo %s` instead", cmd.Name())
+		c.AddCommand(cmd)
+	}
 
 	return c
 }
@@ -0,0 +1,116 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewVerifyCommand(f client.Factory, use string) *cobra.Command {
+	o := NewVerifyOptions()
+
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Verify the data in a restic repository",
+		Long: `Request the verification of the data in a restic repository. The Velero server runs the
+verification in a job, and records its result in the repository's status. Backups with data
+in a damaged repository are flagged in their status.`,
+		Example: `  # Verify that the data of the snapshots in a repository is present.
+  velero repo verify default-default-restic-abcde
+
+  # Also read back 10% of the data in the repository.
+  velero repo verify default-default-restic-abcde --read-data-percent 10`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate(c, args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+type VerifyOptions struct {
+	Name            string
+	ReadDataPercent int
+}
+
+func NewVerifyOptions() *VerifyOptions {
+	return &VerifyOptions{
+		ReadDataPercent: -1,
+	}
+}
+
+func (o *VerifyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.IntVar(&o.ReadDataPercent, "read-data-percent", o.ReadDataPercent, "The percentage of the repository's data to read back. Defaults to the one in the repository's spec. Optional.")
+}
+
+func (o *VerifyOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
+	if c.Flags().Changed("read-data-percent") && (o.ReadDataPercent < 0 || o.ReadDataPercent > 100) {
+		return errors.New("--read-data-percent must be between 0 and 100")
+	}
+
+	return nil
+}
+
+func (o *VerifyOptions) Complete(args []string, f client.Factory) error {
+	o.Name = args[0]
+	return nil
+}
+
+func (o *VerifyOptions) Run(c *cobra.Command, f client.Factory) error {
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+
+	repo := &velerov1api.BackupRepository{}
+	if err := kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.Name}, repo); err != nil {
+		return errors.WithStack(err)
+	}
+
+	var readDataPercent string
+	if c.Flags().Changed("read-data-percent") {
+		readDataPercent = strconv.Itoa(o.ReadDataPercent)
+	}
+
+	original := repo.DeepCopy()
+	if repo.Annotations == nil {
+		repo.Annotations = map[string]string{}
+	}
+	repo.Annotations[velerov1api.VerifyBackupRepositoryAnnotation] = readDataPercent
+	if err := kbClient.Patch(context.Background(), repo, kbclient.MergeFrom(original)); err != nil {
+		return errors.WithStack(err)
+	}
+
+	fmt.Printf("Verification of restic repository %q requested. Run `velero repo describe %s` to see its result.\n", o.Name, o.Name)
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"strings"
 	"time"
 
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
@@ -50,7 +51,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/signals"
 	"github.com/vmware-tanzu/velero/pkg/controller"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 )
@@ -66,11 +70,15 @@ const (
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
 	defaultCredentialsDirectory = "/tmp/credentials"
+
+	// defaultNodeAgentConfig is the name of the ConfigMap holding the node agent configuration
+	defaultNodeAgentConfig = "node-agent-config"
 )
 
 func NewServerCommand(f client.Factory) *cobra.Command {
 	logLevelFlag := logging.LogLevelFlag(logrus.InfoLevel)
 	formatFlag := logging.NewFormatFlag()
+	nodeAgentConfig := defaultNodeAgentConfig
 
 	command := &cobra.Command{
 		Use:    "server",
@@ -85,7 +93,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 			logger.Infof("Starting Velero restic server %s (%s)", buildinfo.Version, buildinfo.FormattedGitSHA())
 
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
-			s, err := newResticServer(logger, f, defaultMetricsAddress)
+			s, err := newResticServer(logger, f, defaultMetricsAddress, nodeAgentConfig)
 			cmd.CheckError(err)
 
 			s.run()
@@ -94,6 +102,7 @@ func NewServerCommand(f client.Factory) *cobra.Command {
 
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(formatFlag.AllowedValues(), ", ")))
+	command.Flags().StringVar(&nodeAgentConfig, "node-agent-config", nodeAgentConfig, "Name of the ConfigMap in the Velero namespace holding the node agent configuration, e.g. the number of pod volume backups and restores allowed to run at once on each node. Optional.")
 
 	return command
 }
@@ -108,9 +117,11 @@ type resticServer struct {
 	metricsAddress string
 	namespace      string
 	nodeName       string
+	loadLimiter    *nodeagent.LoadLimiter
+	dataMoverImage string
 }
 
-func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress string) (*resticServer, error) {
+func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAddress, nodeAgentConfig string) (*resticServer, error) {
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	clientConfig, err := factory.ClientConfig()
@@ -123,6 +134,7 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 	velerov1api.AddToScheme(scheme)
 	v1.AddToScheme(scheme)
 	storagev1api.AddToScheme(scheme)
+	snapshotv1api.AddToScheme(scheme)
 
 	nodeName := os.Getenv("NODE_NAME")
 
@@ -163,9 +175,38 @@ func newResticServer(logger logrus.FieldLogger, factory client.Factory, metricAd
 		return nil, err
 	}
 
+	if s.loadLimiter, err = s.newLoadLimiter(client, nodeAgentConfig); err != nil {
+		return nil, err
+	}
+
+	// the pods exposing volumes for data movement run the node agent's image, so without it data
+	// movement is left to the other nodes rather than keeping this node from backing up pod volumes
+	if s.dataMoverImage, err = nodeagent.GetImage(s.ctx, client, s.namespace); err != nil {
+		s.logger.WithError(err).Warn("Unable to get the node agent's image, data movement is disabled on this node")
+	}
+
 	return s, nil
 }
 
+// newLoadLimiter returns a LoadLimiter enforcing the pod volume concurrency configured for this
+// node in the node agent ConfigMap.
+func (s *resticServer) newLoadLimiter(client kubernetes.Interface, nodeAgentConfig string) (*nodeagent.LoadLimiter, error) {
+	configs, err := nodeagent.GetConfigs(s.ctx, s.namespace, nodeAgentConfig, client.CoreV1())
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := client.CoreV1().Nodes().Get(s.ctx, s.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting node %s", s.nodeName)
+	}
+
+	concurrency := nodeagent.GetLoadConcurrency(configs, node, s.logger)
+	s.logger.Infof("Running at most %d pod volume backups and restores at once on node %s", concurrency, s.nodeName)
+
+	return nodeagent.NewLoadLimiter(concurrency), nil
+}
+
 func (s *resticServer) run() {
 	signals.CancelOnShutdown(s.cancelFunc, s.logger)
 
@@ -210,15 +251,27 @@ func (s *resticServer) run() {
 		NodeName:         s.nodeName,
 		FileSystem:       filesystem.NewFileSystem(),
 		Log:              s.logger,
+		LoadLimiter:      s.loadLimiter,
 	}
 	if err := pvbReconciler.SetupWithManager(s.mgr); err != nil {
 		s.logger.Fatal(err, "unable to create controller", "controller", controller.PodVolumeBackup)
 	}
 
-	if err = controller.NewPodVolumeRestoreReconciler(s.logger, s.mgr.GetClient(), credentialGetter).SetupWithManager(s.mgr); err != nil {
+	if err = controller.NewPodVolumeRestoreReconciler(s.logger, s.mgr.GetClient(), credentialGetter, s.loadLimiter).SetupWithManager(s.mgr); err != nil {
 		s.logger.WithError(err).Fatal("Unable to create the pod volume restore controller")
 	}
 
+	if s.dataMoverImage != "" {
+		repoEnsurer := repository.NewRepositoryEnsurer(s.mgr.GetClient(), s.logger)
+		if err = controller.NewDataUploadReconciler(s.logger, s.mgr.GetClient(), credentialGetter, repoEnsurer, s.loadLimiter, s.nodeName, s.dataMoverImage).SetupWithManager(s.mgr); err != nil {
+			s.logger.WithError(err).Fatal("Unable to create the data upload controller")
+		}
+
+		if err = controller.NewDataDownloadReconciler(s.logger, s.mgr.GetClient(), credentialGetter, repoEnsurer, s.loadLimiter, s.nodeName, s.dataMoverImage).SetupWithManager(s.mgr); err != nil {
+			s.logger.WithError(err).Fatal("Unable to create the data download controller")
+		}
+	}
+
 	s.logger.Info("Controllers starting...")
 
 	if err := s.mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -286,6 +339,10 @@ func (s *resticServer) markInProgressCRsFailed() {
 	s.markInProgressPVBsFailed(client)
 
 	s.markInProgressPVRsFailed(client)
+
+	s.markInProgressDataUploadsFailed(client)
+
+	s.markInProgressDataDownloadsFailed(client)
 }
 
 func (s *resticServer) markInProgressPVBsFailed(client ctrlclient.Client) {
@@ -352,3 +409,61 @@ func (s *resticServer) markInProgressPVRsFailed(client ctrlclient.Client) {
 		s.logger.WithField("podvolumerestore", pvr.GetName()).Warn(pvr.Status.Message)
 	}
 }
+
+func (s *resticServer) markInProgressDataUploadsFailed(client ctrlclient.Client) {
+	dataUploads := &velerov1api.DataUploadList{}
+	if err := client.List(s.ctx, dataUploads, &ctrlclient.MatchingFields{"metadata.namespace": s.namespace}); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("failed to list datauploads")
+		return
+	}
+	for _, du := range dataUploads.Items {
+		if du.Status.Phase != velerov1api.DataUploadPhaseInProgress {
+			s.logger.Debugf("the status of dataupload %q is %q, skip", du.GetName(), du.Status.Phase)
+			continue
+		}
+		if du.Status.Node != s.nodeName {
+			s.logger.Debugf("the node of dataupload %q is %q, not %q, skip", du.GetName(), du.Status.Node, s.nodeName)
+			continue
+		}
+		original := du.DeepCopy()
+		du.Status.Phase = velerov1api.DataUploadPhaseFailed
+		du.Status.Message = fmt.Sprintf("get a dataupload with status %q during the server starting, mark it as %q", velerov1api.DataUploadPhaseInProgress, du.Status.Phase)
+		du.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		if err := client.Patch(s.ctx, &du, ctrlclient.MergeFrom(original)); err != nil {
+			s.logger.WithError(errors.WithStack(err)).Errorf("failed to patch dataupload %q", du.GetName())
+			continue
+		}
+		s.logger.WithField("dataupload", du.GetName()).Warn(du.Status.Message)
+
+		datamover.CleanUpSnapshot(s.ctx, client, &du, s.logger)
+	}
+}
+
+func (s *resticServer) markInProgressDataDownloadsFailed(client ctrlclient.Client) {
+	dataDownloads := &velerov1api.DataDownloadList{}
+	if err := client.List(s.ctx, dataDownloads, &ctrlclient.MatchingFields{"metadata.namespace": s.namespace}); err != nil {
+		s.logger.WithError(errors.WithStack(err)).Error("failed to list datadownloads")
+		return
+	}
+	for _, dd := range dataDownloads.Items {
+		if dd.Status.Phase != velerov1api.DataDownloadPhaseInProgress {
+			s.logger.Debugf("the status of datadownload %q is %q, skip", dd.GetName(), dd.Status.Phase)
+			continue
+		}
+		if dd.Status.Node != s.nodeName {
+			s.logger.Debugf("the node of datadownload %q is %q, not %q, skip", dd.GetName(), dd.Status.Node, s.nodeName)
+			continue
+		}
+		original := dd.DeepCopy()
+		dd.Status.Phase = velerov1api.DataDownloadPhaseFailed
+		dd.Status.Message = fmt.Sprintf("get a datadownload with status %q during the server starting, mark it as %q", velerov1api.DataDownloadPhaseInProgress, dd.Status.Phase)
+		dd.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		if err := client.Patch(s.ctx, &dd, ctrlclient.MergeFrom(original)); err != nil {
+			s.logger.WithError(errors.WithStack(err)).Errorf("failed to patch datadownload %q", dd.GetName())
+			continue
+		}
+		s.logger.WithField("datadownload", dd.GetName()).Warn(dd.Status.Message)
+
+		datamover.CleanUpVolume(s.ctx, client, &dd, s.logger)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+)
+
+// NewCancelCommand creates and returns a new cobra command for canceling running restores.
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	o := cli.NewCancelOptions("restore")
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s [NAMES]", use),
+		Short: "Cancel running restores",
+		Long: `Cancel restores that are new or in progress.
+
+A canceled restore stops restoring items, cancels its pod volume restores, and ends in the
+Canceled phase. Items restored before the cancellation are left in the cluster.`,
+		Example: `  # Cancel a restore named "restore-1".
+  velero restore cancel restore-1
+
+  # Cancel a restore named "restore-1" without prompting for confirmation.
+  velero restore cancel restore-1 --confirm
+
+  # Cancel all restores labelled with "foo=bar".
+  velero restore cancel --selector foo=bar`,
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Validate(c, f, args))
+			cmd.CheckError(RunCancel(o))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// RunCancel requests cancellation of the restores selected by o.
+func RunCancel(o *cli.CancelOptions) error {
+	if !o.Confirm && !cli.GetConfirmation() {
+		// Don't do anything unless we get confirmation
+		return nil
+	}
+
+	var (
+		restores []*velerov1api.Restore
+		errs     []error
+	)
+
+	if len(o.Names) > 0 {
+		for _, name := range o.Names {
+			restore, err := o.Client.VeleroV1().Restores(o.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				errs = append(errs, errors.WithStack(err))
+				continue
+			}
+			restores = append(restores, restore)
+		}
+	} else {
+		res, err := o.Client.VeleroV1().Restores(o.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: o.Selector.String()})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for i := range res.Items {
+			restores = append(restores, &res.Items[i])
+		}
+	}
+
+	if len(restores) == 0 && len(errs) == 0 {
+		fmt.Println("No restores found")
+		return nil
+	}
+
+	for _, restore := range restores {
+		switch restore.Status.Phase {
+		case "", velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress:
+		default:
+			fmt.Printf("Restore %q is not running (phase %s), skipping.\n", restore.Name, restore.Status.Phase)
+			continue
+		}
+
+		patch := []byte(`{"spec":{"cancel":true}}`)
+		if _, err := o.Client.VeleroV1().Restores(o.Namespace).Patch(context.TODO(), restore.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "error canceling restore %q", restore.Name))
+			continue
+		}
+
+		fmt.Printf("Request to cancel restore %q submitted successfully.\nRun `velero restore describe %s` to check when it has stopped.\n", restore.Name, restore.Name)
+	}
+
+	return kubeerrs.NewAggregate(errs)
+}
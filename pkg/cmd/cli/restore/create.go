@@ -20,12 +20,14 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/cache"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -35,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
 	v1 "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
@@ -83,15 +86,26 @@ type CreateOptions struct {
 	IncludeNamespaces       flag.StringArray
 	ExcludeNamespaces       flag.StringArray
 	ExistingResourcePolicy  string
+	WebhookRestorePolicy    string
+	ServiceClusterIPPolicy  string
+	LoadBalancerAnnotations string
+	LoadBalancerMappings    flag.Map
+	PreserveLoadBalancerIP  flag.OptionalBool
 	IncludeResources        flag.StringArray
 	ExcludeResources        flag.StringArray
+	IncludeItems            flag.StringArray
+	ExcludeItems            flag.StringArray
+	FieldSelectors          flag.Map
 	StatusIncludeResources  flag.StringArray
 	StatusExcludeResources  flag.StringArray
 	NamespaceMappings       flag.Map
+	NamespaceMappingRules   []string
+	NamespaceMappingRegexes []string
 	Selector                flag.LabelSelector
 	IncludeClusterResources flag.OptionalBool
 	Wait                    bool
 	AllowPartiallyFailed    flag.OptionalBool
+	RollbackOnFailure       bool
 
 	client veleroclient.Interface
 }
@@ -101,8 +115,11 @@ func NewCreateOptions() *CreateOptions {
 		Labels:                  flag.NewMap(),
 		IncludeNamespaces:       flag.NewStringArray("*"),
 		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		FieldSelectors:          flag.NewMap().WithEntryDelimiter(';').WithKeyValueDelimiter(':'),
 		RestoreVolumes:          flag.NewOptionalBool(nil),
 		PreserveNodePorts:       flag.NewOptionalBool(nil),
+		LoadBalancerMappings:    flag.NewMap(),
+		PreserveLoadBalancerIP:  flag.NewOptionalBool(nil),
 		IncludeClusterResources: flag.NewOptionalBool(nil),
 	}
 }
@@ -113,10 +130,16 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the restore (use '*' for all namespaces)")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the restore.")
 	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	flags.StringArrayVar(&o.NamespaceMappingRules, "namespace-mapping-rule", nil, "Map the namespaces in the backup that match a glob pattern to the namespaces named by a template, in the form pattern:target. ${1}, ${2}, ... in the target are replaced with the text the pattern's wildcards matched, such as 'app-*:${1}-dr'. Can be specified multiple times; the first matching rule is used.")
+	flags.StringArrayVar(&o.NamespaceMappingRegexes, "namespace-mapping-regex", nil, "Map the namespaces in the backup that match a regular expression to the namespaces named by a template, in the form regex:target. ${1}, ${2}, ... in the target are replaced with the text the regular expression's capture groups matched. Can be specified multiple times; the first matching rule is used.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the restore.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
+	flags.Var(&o.IncludeItems, "include-items", "Items to include in the restore, formatted as resource[.group]/name or resource[.group]/namespace/name, such as configmaps/app-* or secrets/ns1/db-creds. Names and namespaces may contain * and ? wildcards.")
+	flags.Var(&o.ExcludeItems, "exclude-items", "Items to exclude from the restore, formatted as resource[.group]/name or resource[.group]/namespace/name.")
+	flags.Var(&o.FieldSelectors, "field-selectors", "Field selectors that the items of resources must match to be restored, formatted as resource[.group]:selector and separated by semicolons, such as 'pods:status.phase=Running;secrets:type=Opaque'.")
 	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none or update")
+	flags.StringVar(&o.WebhookRestorePolicy, "webhook-restore-policy", "", "When to restore admission webhook configurations and APIServices, can be - Deferred (after all other items and post-restore hooks, the default), ServiceReady (as soon as the services they reference are ready) or Immediate (in restore order)")
 	flags.Var(&o.StatusIncludeResources, "status-include-resources", "Resources to include in the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.Var(&o.StatusExcludeResources, "status-exclude-resources", "Resources to exclude from the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
@@ -130,12 +153,20 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	// like a normal bool flag
 	f.NoOptDefVal = "true"
 
+	flags.StringVar(&o.ServiceClusterIPPolicy, "service-cluster-ip-policy", "", "How to restore the ClusterIPs of Services, can be - Reassign (let the cluster assign new ones, the default) or PreserveIfAvailable (keep them unless another Service uses them)")
+	flags.StringVar(&o.LoadBalancerAnnotations, "load-balancer-annotation-policy", "", "What to do with the cloud provider load balancer annotations of LoadBalancer Services, can be - Keep (the default), Strip or Remap (rename them according to --load-balancer-annotation-mappings and remove the rest)")
+	flags.Var(&o.LoadBalancerMappings, "load-balancer-annotation-mappings", "Load balancer annotation mappings from key in the backup to restored key in the form src1=dst1,src2=dst2,... Requires --load-balancer-annotation-policy Remap.")
+	f = flags.VarPF(&o.PreserveLoadBalancerIP, "preserve-load-balancer-ip", "", "Whether to restore the loadBalancerIP of LoadBalancer Services. Defaults to true.")
+	f.NoOptDefVal = "true"
+
 	f = flags.VarPF(&o.IncludeClusterResources, "include-cluster-resources", "", "Include cluster-scoped resources in the restore.")
 	f.NoOptDefVal = "true"
 
 	f = flags.VarPF(&o.AllowPartiallyFailed, "allow-partially-failed", "", "If using --from-schedule, whether to consider PartiallyFailed backups when looking for the most recent one. This flag has no effect if not using --from-schedule.")
 	f.NoOptDefVal = "true"
 
+	flags.BoolVar(&o.RollbackOnFailure, "rollback-on-failure", o.RollbackOnFailure, "Delete the items the restore created, and revert the items it patched, if the restore fails or partially fails.")
+
 	flags.BoolVarP(&o.Wait, "wait", "w", o.Wait, "Wait for the operation to complete.")
 }
 
@@ -182,6 +213,40 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("existing-resource-policy has invalid value, it accepts only none, update as value")
 	}
 
+	switch api.WebhookRestorePolicy(o.WebhookRestorePolicy) {
+	case "", api.WebhookRestorePolicyDeferred, api.WebhookRestorePolicyServiceReady, api.WebhookRestorePolicyImmediate:
+	default:
+		return errors.New("webhook-restore-policy has invalid value, it accepts only Deferred, ServiceReady, Immediate as value")
+	}
+
+	switch api.ServiceClusterIPPolicy(o.ServiceClusterIPPolicy) {
+	case "", api.ServiceClusterIPPolicyReassign, api.ServiceClusterIPPolicyPreserveIfAvailable:
+	default:
+		return errors.New("service-cluster-ip-policy has invalid value, it accepts only Reassign, PreserveIfAvailable as value")
+	}
+
+	switch api.LoadBalancerAnnotationPolicy(o.LoadBalancerAnnotations) {
+	case "", api.LoadBalancerAnnotationPolicyKeep, api.LoadBalancerAnnotationPolicyStrip, api.LoadBalancerAnnotationPolicyRemap:
+	default:
+		return errors.New("load-balancer-annotation-policy has invalid value, it accepts only Keep, Strip, Remap as value")
+	}
+
+	if len(o.LoadBalancerMappings.Data()) > 0 && api.LoadBalancerAnnotationPolicy(o.LoadBalancerAnnotations) != api.LoadBalancerAnnotationPolicyRemap {
+		return errors.New("load-balancer-annotation-mappings can only be used with --load-balancer-annotation-policy Remap")
+	}
+
+	if errs := pkgrestore.ValidateItemPatterns(o.IncludeItems, o.ExcludeItems); len(errs) > 0 {
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	if errs := pkgrestore.ValidateFieldSelectors(o.FieldSelectors.Data()); len(errs) > 0 {
+		return kubeerrs.NewAggregate(errs)
+	}
+
+	if _, err := o.namespaceMappingRules(); err != nil {
+		return err
+	}
+
 	switch {
 	case o.BackupName != "":
 		if _, err := o.client.VeleroV1().Backups(f.Namespace()).Get(context.TODO(), o.BackupName, metav1.GetOptions{}); err != nil {
@@ -261,6 +326,11 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		}
 	}
 
+	namespaceMappingRules, err := o.namespaceMappingRules()
+	if err != nil {
+		return err
+	}
+
 	restore := &api.Restore{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: f.Namespace(),
@@ -274,15 +344,30 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			ExcludedNamespaces:      o.ExcludeNamespaces,
 			IncludedResources:       o.IncludeResources,
 			ExcludedResources:       o.ExcludeResources,
+			IncludedItems:           o.IncludeItems,
+			ExcludedItems:           o.ExcludeItems,
+			FieldSelectors:          o.FieldSelectors.Data(),
 			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy),
+			WebhookRestorePolicy:    api.WebhookRestorePolicy(o.WebhookRestorePolicy),
 			NamespaceMapping:        o.NamespaceMappings.Data(),
+			NamespaceMappingRules:   namespaceMappingRules,
 			LabelSelector:           o.Selector.LabelSelector,
 			RestorePVs:              o.RestoreVolumes.Value,
 			PreserveNodePorts:       o.PreserveNodePorts.Value,
 			IncludeClusterResources: o.IncludeClusterResources.Value,
+			RollbackOnFailure:       o.RollbackOnFailure,
 		},
 	}
 
+	if o.ServiceClusterIPPolicy != "" || o.LoadBalancerAnnotations != "" || o.PreserveLoadBalancerIP.Value != nil {
+		restore.Spec.ServicePolicy = &api.ServiceRestorePolicy{
+			ClusterIPPolicy:               api.ServiceClusterIPPolicy(o.ServiceClusterIPPolicy),
+			LoadBalancerAnnotationPolicy:  api.LoadBalancerAnnotationPolicy(o.LoadBalancerAnnotations),
+			LoadBalancerAnnotationMapping: o.LoadBalancerMappings.Data(),
+			PreserveLoadBalancerIP:        o.PreserveLoadBalancerIP.Value,
+		}
+	}
+
 	if len([]string(o.StatusIncludeResources)) > 0 {
 		restore.Spec.RestoreStatus = &api.RestoreStatusSpec{
 			IncludedResources: o.StatusIncludeResources,
@@ -334,7 +419,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		go restoreInformer.Run(stop)
 	}
 
-	restore, err := o.client.VeleroV1().Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
+	restore, err = o.client.VeleroV1().Restores(restore.Namespace).Create(context.TODO(), restore, metav1.CreateOptions{})
 	if err != nil {
 		return err
 	}
@@ -376,3 +461,31 @@ func isResourcePolicyValid(resourcePolicy string) bool {
 	}
 	return false
 }
+
+// namespaceMappingRules returns the namespace mapping rules given by the --namespace-mapping-rule
+// and --namespace-mapping-regex flags, in that order.
+func (o *CreateOptions) namespaceMappingRules() ([]api.NamespaceMappingRule, error) {
+	var rules []api.NamespaceMappingRule
+	for _, flag := range []struct {
+		name   string
+		values []string
+		regex  bool
+	}{
+		{name: "namespace-mapping-rule", values: o.NamespaceMappingRules},
+		{name: "namespace-mapping-regex", values: o.NamespaceMappingRegexes, regex: true},
+	} {
+		for _, value := range flag.values {
+			// namespace names and targets can't contain ':', so the last one separates them
+			i := strings.LastIndex(value, ":")
+			if i <= 0 || i == len(value)-1 {
+				return nil, errors.Errorf("invalid --%s %q, it must be in the form pattern:target", flag.name, value)
+			}
+			rules = append(rules, api.NamespaceMappingRule{
+				Pattern: value[:i],
+				Regex:   flag.regex,
+				Target:  value[i+1:],
+			})
+		}
+	}
+	return rules, nil
+}
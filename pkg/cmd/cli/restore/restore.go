@@ -35,6 +35,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 		NewLogsCommand(f),
 		NewDescribeCommand(f, "describe"),
 		NewDeleteCommand(f, "delete"),
+		NewCancelCommand(f, "cancel"),
+		NewRollbackCommand(f, "rollback"),
 	)
 
 	return c
@@ -0,0 +1,119 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/cli"
+	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+)
+
+// NewRollbackCommand creates and returns a new cobra command for rolling back restores.
+func NewRollbackCommand(f client.Factory, use string) *cobra.Command {
+	o := &RollbackOptions{}
+
+	c := &cobra.Command{
+		Use:   fmt.Sprintf("%s NAME", use),
+		Short: "Roll back a restore",
+		Long: `Roll back the changes a restore made to the cluster.
+
+The items the restore created are deleted, in the reverse of the order they were restored in,
+and the items it patched because of --existing-resource-policy=update are reverted to their state
+before the restore. Items that were replaced or changed by others since the restore may not be
+rolled back; they're listed when describing the restore. A restore that is still running is
+rolled back once it finishes.`,
+		Example: `  # Roll back a restore named "restore-1".
+  velero restore rollback restore-1
+
+  # Roll back a restore named "restore-1" without prompting for confirmation.
+  velero restore rollback restore-1 --confirm`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(f, args))
+			cmd.CheckError(o.Run())
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
+
+// RollbackOptions contains parameters used for rolling back a restore.
+type RollbackOptions struct {
+	Name      string
+	Confirm   bool
+	Client    clientset.Interface
+	Namespace string
+}
+
+// BindFlags binds options for this command to flags.
+func (o *RollbackOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Confirm, "confirm", o.Confirm, "Confirm rollback")
+}
+
+// Complete fills in the correct values for all the options.
+func (o *RollbackOptions) Complete(f client.Factory, args []string) error {
+	o.Namespace = f.Namespace()
+	client, err := f.Client()
+	if err != nil {
+		return err
+	}
+	o.Client = client
+	o.Name = args[0]
+	return nil
+}
+
+// Run requests the rollback of the restore.
+func (o *RollbackOptions) Run() error {
+	restore, err := o.Client.VeleroV1().Restores(o.Namespace).Get(context.TODO(), o.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if restore.Status.Phase == velerov1api.RestorePhaseFailedValidation {
+		return errors.Errorf("restore %q failed validation and made no changes to roll back", restore.Name)
+	}
+	if restore.Spec.Rollback || restore.Status.Rollback != nil {
+		fmt.Printf("Restore %q has already been rolled back or is being rolled back.\n", restore.Name)
+		return nil
+	}
+
+	if !o.Confirm && !cli.GetConfirmation() {
+		// Don't do anything unless we get confirmation
+		return nil
+	}
+
+	patch := []byte(`{"spec":{"rollback":true}}`)
+	if _, err := o.Client.VeleroV1().Restores(o.Namespace).Patch(context.TODO(), restore.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "error rolling back restore %q", restore.Name)
+	}
+
+	fmt.Printf("Request to roll back restore %q submitted successfully.\nRun `velero restore describe %s` to check the rollback's status.\n", restore.Name, restore.Name)
+	return nil
+}
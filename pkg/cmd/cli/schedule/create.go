@@ -133,19 +133,21 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		},
 		Spec: api.ScheduleSpec{
 			Template: api.BackupSpec{
-				IncludedNamespaces:      o.BackupOptions.IncludeNamespaces,
-				ExcludedNamespaces:      o.BackupOptions.ExcludeNamespaces,
-				IncludedResources:       o.BackupOptions.IncludeResources,
-				ExcludedResources:       o.BackupOptions.ExcludeResources,
-				IncludeClusterResources: o.BackupOptions.IncludeClusterResources.Value,
-				LabelSelector:           o.BackupOptions.Selector.LabelSelector,
-				SnapshotVolumes:         o.BackupOptions.SnapshotVolumes.Value,
-				TTL:                     metav1.Duration{Duration: o.BackupOptions.TTL},
-				StorageLocation:         o.BackupOptions.StorageLocation,
-				VolumeSnapshotLocations: o.BackupOptions.SnapshotLocations,
-				DefaultVolumesToRestic:  o.BackupOptions.DefaultVolumesToRestic.Value,
-				OrderedResources:        orders,
-				CSISnapshotTimeout:      metav1.Duration{Duration: o.BackupOptions.CSISnapshotTimeout},
+				IncludedNamespaces:         o.BackupOptions.IncludeNamespaces,
+				ExcludedNamespaces:         o.BackupOptions.ExcludeNamespaces,
+				IncludedResources:          o.BackupOptions.IncludeResources,
+				ExcludedResources:          o.BackupOptions.ExcludeResources,
+				IncludeClusterResources:    o.BackupOptions.IncludeClusterResources.Value,
+				IncludeClusterDependencies: o.BackupOptions.IncludeClusterDependencies.Value,
+				LabelSelector:              o.BackupOptions.Selector.LabelSelector,
+				SnapshotVolumes:            o.BackupOptions.SnapshotVolumes.Value,
+				TTL:                        metav1.Duration{Duration: o.BackupOptions.TTL},
+				StorageLocation:            o.BackupOptions.StorageLocation,
+				VolumeSnapshotLocations:    o.BackupOptions.SnapshotLocations,
+				DefaultVolumesToRestic:     o.BackupOptions.DefaultVolumesToRestic.Value,
+				OrderedResources:           orders,
+				CSISnapshotTimeout:         metav1.Duration{Duration: o.BackupOptions.CSISnapshotTimeout},
+				SnapshotMoveData:           o.BackupOptions.SnapshotMoveData.Value,
 			},
 			Schedule:                   o.Schedule,
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
@@ -141,7 +141,7 @@ func newInitRestoreHookPodAction(logger logrus.FieldLogger) (interface{}, error)
 
 func newResticRestoreItemAction(f client.Factory) plugincommon.HandlerInitializer {
 	return func(logger logrus.FieldLogger) (interface{}, error) {
-		client, err := f.KubeClient()
+		kbClient, err := f.KubebuilderClient()
 		if err != nil {
 			return nil, err
 		}
@@ -151,7 +151,7 @@ func newResticRestoreItemAction(f client.Factory) plugincommon.HandlerInitialize
 			return nil, err
 		}
 
-		return restore.NewResticRestoreAction(logger, client.CoreV1().ConfigMaps(f.Namespace()), veleroClient.VeleroV1().PodVolumeBackups(f.Namespace())), nil
+		return restore.NewResticRestoreAction(logger, veleroplugin.NewPluginConfigGetter(kbClient, f.Namespace()), veleroClient.VeleroV1().PodVolumeBackups(f.Namespace())), nil
 	}
 }
 
@@ -182,9 +182,14 @@ func newChangeStorageClassRestoreItemAction(f client.Factory) plugincommon.Handl
 			return nil, err
 		}
 
+		kbClient, err := f.KubebuilderClient()
+		if err != nil {
+			return nil, err
+		}
+
 		return restore.NewChangeStorageClassAction(
 			logger,
-			client.CoreV1().ConfigMaps(f.Namespace()),
+			veleroplugin.NewPluginConfigGetter(kbClient, f.Namespace()),
 			client.StorageV1().StorageClasses(),
 		), nil
 	}
@@ -205,9 +210,14 @@ func newChangePVCNodeSelectorItemAction(f client.Factory) plugincommon.HandlerIn
 			return nil, err
 		}
 
+		kbClient, err := f.KubebuilderClient()
+		if err != nil {
+			return nil, err
+		}
+
 		return restore.NewChangePVCNodeSelectorAction(
 			logger,
-			client.CoreV1().ConfigMaps(f.Namespace()),
+			veleroplugin.NewPluginConfigGetter(kbClient, f.Namespace()),
 			client.CoreV1().Nodes(),
 		), nil
 	}
@@ -34,6 +34,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -70,10 +72,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/podexec"
 	"github.com/vmware-tanzu/velero/pkg/restic"
 	"github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -112,6 +116,18 @@ const (
 
 	defaultCSISnapshotTimeout = 10 * time.Minute
 
+	// maxBackupResumes is how many times a backup interrupted by server restarts is resumed
+	// before it's marked as failed
+	maxBackupResumes = 3
+
+	// defaultNodeAgentWaitTimeout is how long a pod volume backup waits for the node agent
+	// on the pod's node to be running
+	defaultNodeAgentWaitTimeout = 2 * time.Minute
+
+	// defaultDataUploadTimeout is how long a backup waits for the node agents to move its
+	// snapshot data to the backup repository
+	defaultDataUploadTimeout = 4 * time.Hour
+
 	// defaultCredentialsDirectory is the path on disk where credential
 	// files will be written to
 	defaultCredentialsDirectory = "/tmp/credentials"
@@ -120,8 +136,10 @@ const (
 type serverConfig struct {
 	// TODO(2.0) Deprecate defaultBackupLocation
 	pluginDir, metricsAddress, defaultBackupLocation                        string
+	remotePluginsConfig                                                     string
 	backupSyncPeriod, podVolumeOperationTimeout, resourceTerminatingTimeout time.Duration
 	defaultBackupTTL, storeValidationFrequency, defaultCSISnapshotTimeout   time.Duration
+	nodeAgentWaitTimeout, backupCheckpointInterval, dataUploadTimeout       time.Duration
 	restoreResourcePriorities                                               []string
 	defaultVolumeSnapshotLocations                                          map[string]string
 	restoreOnly                                                             bool
@@ -132,9 +150,16 @@ type serverConfig struct {
 	profilerAddress                                                         string
 	formatFlag                                                              *logging.FormatFlag
 	repoMaintenanceFrequency                                                time.Duration
+	repoVerificationFrequency                                               time.Duration
+	perRepositoryKeys                                                       bool
 	garbageCollectionFrequency                                              time.Duration
+	storageUsageMeasurementFrequency                                        time.Duration
 	defaultVolumesToRestic                                                  bool
 	uploaderType                                                            string
+	maintenanceJobCPURequest, maintenanceJobMemRequest                      string
+	maintenanceJobCPULimit, maintenanceJobMemLimit                          string
+	maintenanceJobNodeSelector                                              map[string]string
+	keepLatestMaintenanceJobs, maxConcurrentMaintenanceJobs                 int
 }
 
 type controllerRunInfo struct {
@@ -145,6 +170,7 @@ type controllerRunInfo struct {
 func NewCommand(f client.Factory) *cobra.Command {
 	var (
 		volumeSnapshotLocations = flag.NewMap().WithKeyValueDelimiter(':')
+		maintenanceNodeSelector = flag.NewMap()
 		logLevelFlag            = logging.LogLevelFlag(logrus.InfoLevel)
 		config                  = serverConfig{
 			pluginDir:                      "/plugins",
@@ -156,6 +182,8 @@ func NewCommand(f client.Factory) *cobra.Command {
 			defaultCSISnapshotTimeout:      defaultCSISnapshotTimeout,
 			storeValidationFrequency:       defaultStoreValidationFrequency,
 			podVolumeOperationTimeout:      defaultPodVolumeOperationTimeout,
+			nodeAgentWaitTimeout:           defaultNodeAgentWaitTimeout,
+			dataUploadTimeout:              defaultDataUploadTimeout,
 			restoreResourcePriorities:      defaultRestorePriorities,
 			clientQPS:                      defaultClientQPS,
 			clientBurst:                    defaultClientBurst,
@@ -165,6 +193,12 @@ func NewCommand(f client.Factory) *cobra.Command {
 			formatFlag:                     logging.NewFormatFlag(),
 			defaultVolumesToRestic:         restic.DefaultVolumesToRestic,
 			uploaderType:                   uploader.ResticType,
+			maintenanceJobCPURequest:       "0",
+			maintenanceJobMemRequest:       "0",
+			maintenanceJobCPULimit:         "0",
+			maintenanceJobMemLimit:         "0",
+			keepLatestMaintenanceJobs:      repository.DefaultKeepLatestMaintenanceJobs,
+			maxConcurrentMaintenanceJobs:   repository.DefaultMaxConcurrentMaintenanceJobs,
 		}
 	)
 
@@ -199,6 +233,7 @@ func NewCommand(f client.Factory) *cobra.Command {
 			if volumeSnapshotLocations.Data() != nil {
 				config.defaultVolumeSnapshotLocations = volumeSnapshotLocations.Data()
 			}
+			config.maintenanceJobNodeSelector = maintenanceNodeSelector.Data()
 
 			f.SetBasename(fmt.Sprintf("%s-%s", c.Parent().Name(), c.Name()))
 
@@ -212,9 +247,13 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().Var(logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(logLevelFlag.AllowedValues(), ", ")))
 	command.Flags().Var(config.formatFlag, "log-format", fmt.Sprintf("The format for log output. Valid values are %s.", strings.Join(config.formatFlag.AllowedValues(), ", ")))
 	command.Flags().StringVar(&config.pluginDir, "plugin-dir", config.pluginDir, "Directory containing Velero plugins")
+	command.Flags().StringVar(&config.remotePluginsConfig, "remote-plugins-config", config.remotePluginsConfig, "Path to a file listing plugin servers to reach over gRPC, e.g. plugins running as sidecars or separate deployments. Optional.")
 	command.Flags().StringVar(&config.metricsAddress, "metrics-address", config.metricsAddress, "The address to expose prometheus metrics")
 	command.Flags().DurationVar(&config.backupSyncPeriod, "backup-sync-period", config.backupSyncPeriod, "How often to ensure all Velero backups in object storage exist as Backup API objects in the cluster. This is the default sync period if none is explicitly specified for a backup storage location.")
 	command.Flags().DurationVar(&config.podVolumeOperationTimeout, "restic-timeout", config.podVolumeOperationTimeout, "How long backups/restores of pod volumes should be allowed to run before timing out.")
+	command.Flags().DurationVar(&config.nodeAgentWaitTimeout, "node-agent-wait-timeout", config.nodeAgentWaitTimeout, "How long pod volume backups wait for the node agent on the pod's node to be running before failing. Set this to 0 to not wait.")
+	command.Flags().DurationVar(&config.backupCheckpointInterval, "backup-checkpoint-interval", config.backupCheckpointInterval, "How often running backups save their progress to object storage, so that backups interrupted by a server restart are resumed instead of failed. Checkpoints hold every item backed up so far and are saved whole each time, so they grow with the backup. Checkpointing and resuming are disabled by default.")
+	command.Flags().DurationVar(&config.dataUploadTimeout, "data-upload-timeout", config.dataUploadTimeout, "How long backups wait for the node agents to move CSI snapshot data to the backup repository before canceling the data uploads.")
 	command.Flags().BoolVar(&config.restoreOnly, "restore-only", config.restoreOnly, "Run in a mode where only restores are allowed; backups, schedules, and garbage-collection are all disabled. DEPRECATED: this flag will be removed in v2.0. Use read-only backup storage locations instead.")
 	command.Flags().StringSliceVar(&config.disabledControllers, "disable-controllers", config.disabledControllers, fmt.Sprintf("List of controllers to disable on startup. Valid values are %s", strings.Join(controller.DisableableControllers, ",")))
 	command.Flags().StringSliceVar(&config.restoreResourcePriorities, "restore-resource-priorities", config.restoreResourcePriorities, "Desired order of resource restores; any resource not in the list will be restored alphabetically after the prioritized resources.")
@@ -228,9 +267,19 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().DurationVar(&config.resourceTerminatingTimeout, "terminating-resource-timeout", config.resourceTerminatingTimeout, "How long to wait on persistent volumes and namespaces to terminate during a restore before timing out.")
 	command.Flags().DurationVar(&config.defaultBackupTTL, "default-backup-ttl", config.defaultBackupTTL, "How long to wait by default before backups can be garbage collected.")
 	command.Flags().DurationVar(&config.repoMaintenanceFrequency, "default-restic-prune-frequency", config.repoMaintenanceFrequency, "How often 'prune' is run for backup repositories by default.")
+	command.Flags().DurationVar(&config.repoVerificationFrequency, "default-repo-verification-frequency", config.repoVerificationFrequency, "How often the data in backup repositories is verified by default. Periodic verification is disabled if it's zero.")
+	command.Flags().BoolVar(&config.perRepositoryKeys, "per-repository-keys", config.perRepositoryKeys, "Encrypt each new backup repository with its own key instead of the key shared by all of them.")
 	command.Flags().DurationVar(&config.garbageCollectionFrequency, "garbage-collection-frequency", config.garbageCollectionFrequency, "How often garbage collection is run for expired backups.")
+	command.Flags().DurationVar(&config.storageUsageMeasurementFrequency, "storage-usage-measurement-frequency", config.storageUsageMeasurementFrequency, "How often the storage used by backup storage locations is measured. Default 1 hour.")
 	command.Flags().BoolVar(&config.defaultVolumesToRestic, "default-volumes-to-restic", config.defaultVolumesToRestic, "Backup all volumes with restic by default.")
 	command.Flags().StringVar(&config.uploaderType, "uploader-type", config.uploaderType, "Type of uploader to handle the transfer of data of pod volumes")
+	command.Flags().StringVar(&config.maintenanceJobCPURequest, "maintenance-job-cpu-request", config.maintenanceJobCPURequest, "CPU request for the jobs that run backup repository maintenance. A value of \"0\" is treated as unbounded.")
+	command.Flags().StringVar(&config.maintenanceJobMemRequest, "maintenance-job-mem-request", config.maintenanceJobMemRequest, "Memory request for the jobs that run backup repository maintenance. A value of \"0\" is treated as unbounded.")
+	command.Flags().StringVar(&config.maintenanceJobCPULimit, "maintenance-job-cpu-limit", config.maintenanceJobCPULimit, "CPU limit for the jobs that run backup repository maintenance. A value of \"0\" is treated as unbounded.")
+	command.Flags().StringVar(&config.maintenanceJobMemLimit, "maintenance-job-mem-limit", config.maintenanceJobMemLimit, "Memory limit for the jobs that run backup repository maintenance. A value of \"0\" is treated as unbounded.")
+	command.Flags().Var(&maintenanceNodeSelector, "maintenance-job-node-selector", "Node selector of the jobs that run backup repository maintenance (key1=value1,key2=value2,...)")
+	command.Flags().IntVar(&config.keepLatestMaintenanceJobs, "keep-latest-maintenance-jobs", config.keepLatestMaintenanceJobs, "How many finished maintenance jobs to keep for each backup repository.")
+	command.Flags().IntVar(&config.maxConcurrentMaintenanceJobs, "max-concurrent-maintenance-jobs", config.maxConcurrentMaintenanceJobs, "How many backup repository maintenance jobs can run at the same time. Set to 0 for no limit.")
 
 	return command
 }
@@ -252,6 +301,7 @@ type server struct {
 	logger                              logrus.FieldLogger
 	logLevel                            logrus.Level
 	pluginRegistry                      process.Registry
+	remotePlugins                       []process.RemoteEndpoint
 	repoManager                         repository.Manager
 	repoLocker                          *repository.RepoLocker
 	repoEnsurer                         *repository.RepositoryEnsurer
@@ -296,7 +346,14 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
-	pluginRegistry := process.NewRegistry(config.pluginDir, logger, logger.Level)
+	var remotePlugins []process.RemoteEndpoint
+	if config.remotePluginsConfig != "" {
+		if remotePlugins, err = process.LoadRemoteEndpoints(config.remotePluginsConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	pluginRegistry := process.NewRegistry(config.pluginDir, logger, logger.Level, remotePlugins...)
 	if err := pluginRegistry.DiscoverPlugins(); err != nil {
 		return nil, err
 	}
@@ -325,6 +382,8 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 	scheme := runtime.NewScheme()
 	velerov1api.AddToScheme(scheme)
 	corev1api.AddToScheme(scheme)
+	appsv1api.AddToScheme(scheme)
+	batchv1api.AddToScheme(scheme)
 	snapshotv1api.AddToScheme(scheme)
 
 	ctrl.SetLogger(logrusr.NewLogger(logger))
@@ -367,6 +426,7 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		logger:                              logger,
 		logLevel:                            logger.Level,
 		pluginRegistry:                      pluginRegistry,
+		remotePlugins:                       remotePlugins,
 		config:                              config,
 		mgr:                                 mgr,
 		credentialFileStore:                 credentialFileStore,
@@ -402,7 +462,7 @@ func (s *server) run() error {
 		return err
 	}
 
-	markInProgressCRsFailed(s.ctx, s.mgr.GetConfig(), s.mgr.GetScheme(), s.namespace, s.logger)
+	markInProgressCRsFailed(s.ctx, s.mgr.GetConfig(), s.mgr.GetScheme(), s.namespace, s.config.backupCheckpointInterval > 0, s.logger)
 
 	if err := s.runControllers(s.config.defaultVolumeSnapshotLocations); err != nil {
 		return err
@@ -602,8 +662,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	// Initialize manual backup metrics
 	s.metrics.InitSchedule("")
 
+	pluginConfigGetter := framework.NewPluginConfigGetter(s.mgr.GetClient(), s.namespace)
 	newPluginManager := func(logger logrus.FieldLogger) clientmgmt.Manager {
-		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry)
+		return clientmgmt.NewManager(logger, s.logLevel, s.pluginRegistry, pluginConfigGetter, s.remotePlugins...)
 	}
 
 	backupStoreGetter := persistence.NewObjectBackupStoreGetter(s.credentialFileStore)
@@ -620,7 +681,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
 			podvolume.NewBackupperFactory(s.repoLocker, s.repoEnsurer, s.veleroClient, s.kubeClient.CoreV1(),
 				s.kubeClient.CoreV1(), s.kubeClient.CoreV1(),
-				s.sharedInformerFactory.Velero().V1().BackupRepositories().Informer().HasSynced, s.logger),
+				s.sharedInformerFactory.Velero().V1().BackupRepositories().Informer().HasSynced, s.config.nodeAgentWaitTimeout, s.logger),
 			s.config.podVolumeOperationTimeout,
 			s.config.defaultVolumesToRestic,
 			s.config.clientPageSize,
@@ -642,6 +703,8 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.defaultVolumesToRestic,
 			s.config.defaultBackupTTL,
 			s.config.defaultCSISnapshotTimeout,
+			s.config.backupCheckpointInterval,
+			s.config.dataUploadTimeout,
 			s.sharedInformerFactory.Velero().V1().VolumeSnapshotLocations().Lister(),
 			defaultVolumeSnapshotLocations,
 			s.metrics,
@@ -711,13 +774,15 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := map[string]struct{}{
-		controller.ServerStatusRequest: {},
-		controller.DownloadRequest:     {},
-		controller.Schedule:            {},
-		controller.ResticRepo:          {},
-		controller.BackupDeletion:      {},
-		controller.GarbageCollection:   {},
-		controller.BackupSync:          {},
+		controller.BackupCopyBack:             {},
+		controller.ServerStatusRequest:        {},
+		controller.DownloadRequest:            {},
+		controller.Schedule:                   {},
+		controller.ResticRepo:                 {},
+		controller.BackupDeletion:             {},
+		controller.GarbageCollection:          {},
+		controller.BackupSync:                 {},
+		controller.BackupStorageLocationUsage: {},
 	}
 
 	if s.config.restoreOnly {
@@ -788,7 +853,29 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.ResticRepo]; ok {
-		if err := controller.NewResticRepoReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.config.repoMaintenanceFrequency, s.repoManager).SetupWithManager(s.mgr); err != nil {
+		resources, err := kube.ParseResourceRequirements(s.config.maintenanceJobCPURequest, s.config.maintenanceJobMemRequest, s.config.maintenanceJobCPULimit, s.config.maintenanceJobMemLimit)
+		if err != nil {
+			s.logger.Fatal(err, "invalid resource requirements for maintenance jobs")
+		}
+		// repository jobs run with the image and credentials of the deployment this server's pod is part of
+		serverDeployment := repository.DefaultServerDeployment
+		if podName, err := os.Hostname(); err != nil {
+			s.logger.WithError(err).Warnf("Unable to get the server's pod name, assuming the server deployment is %s", serverDeployment)
+		} else if name, err := repository.GetServerDeployment(s.ctx, s.mgr.GetAPIReader(), s.namespace, podName); err != nil {
+			s.logger.WithError(err).Warnf("Unable to find the server deployment, assuming it's %s", serverDeployment)
+		} else {
+			serverDeployment = name
+		}
+		maintenanceJobConfig := repository.MaintenanceJobConfig{
+			Resources:         resources,
+			NodeSelector:      s.config.maintenanceJobNodeSelector,
+			KeepLatestJobs:    s.config.keepLatestMaintenanceJobs,
+			MaxConcurrentJobs: s.config.maxConcurrentMaintenanceJobs,
+			LogLevel:          s.logLevel.String(),
+			LogFormat:         s.config.formatFlag.String(),
+			ServerDeployment:  serverDeployment,
+		}
+		if err := controller.NewResticRepoReconciler(s.namespace, s.logger, s.mgr.GetClient(), s.config.repoMaintenanceFrequency, s.config.repoVerificationFrequency, s.config.perRepositoryKeys, maintenanceJobConfig, s.repoManager).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.ResticRepo)
 		}
 	}
@@ -859,6 +946,32 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.BackupStorageLocationUsage]; ok {
+		r := controller.NewBackupStorageLocationUsageReconciler(
+			s.mgr.GetClient(),
+			s.config.storageUsageMeasurementFrequency,
+			newPluginManager,
+			backupStoreGetter,
+			s.metrics,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupStorageLocationUsage)
+		}
+	}
+
+	if _, ok := enabledRuntimeControllers[controller.BackupCopyBack]; ok {
+		r := controller.NewBackupCopyBackReconciler(
+			s.mgr.GetClient(),
+			newPluginManager,
+			backupStoreGetter,
+			s.logger,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.BackupCopyBack)
+		}
+	}
+
 	// TODO(2.0): presuming all controllers and resources are converted to runtime-controller
 	// by v2.0, the block from this line and including the `s.mgr.Start() will be
 	// deprecated, since the manager auto-starts all the caches. Until then, we need to start the
@@ -946,8 +1059,9 @@ func (w *CSIInformerFactoryWrapper) WaitForCacheSync(stopCh <-chan struct{}) map
 }
 
 // if there is a restarting during the reconciling of backups/restores/etc, these CRs may be stuck in progress status
-// markInProgressCRsFailed tries to mark the in progress CRs as failed when starting the server to avoid the issue
-func markInProgressCRsFailed(ctx context.Context, cfg *rest.Config, scheme *runtime.Scheme, namespace string, log logrus.FieldLogger) {
+// markInProgressCRsFailed tries to mark the in progress CRs as failed when starting the server to avoid the issue.
+// If resumeBackups is true, in progress backups are resumed instead, up to maxBackupResumes times.
+func markInProgressCRsFailed(ctx context.Context, cfg *rest.Config, scheme *runtime.Scheme, namespace string, resumeBackups bool, log logrus.FieldLogger) {
 	// the function is called before starting the controller manager, the embedded client isn't ready to use, so create a new one here
 	client, err := ctrlclient.New(cfg, ctrlclient.Options{Scheme: scheme})
 	if err != nil {
@@ -955,12 +1069,12 @@ func markInProgressCRsFailed(ctx context.Context, cfg *rest.Config, scheme *runt
 		return
 	}
 
-	markInProgressBackupsFailed(ctx, client, namespace, log)
+	markInProgressBackupsFailed(ctx, client, namespace, resumeBackups, log)
 
 	markInProgressRestoresFailed(ctx, client, namespace, log)
 }
 
-func markInProgressBackupsFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
+func markInProgressBackupsFailed(ctx context.Context, client ctrlclient.Client, namespace string, resumeBackups bool, log logrus.FieldLogger) {
 	backups := &velerov1api.BackupList{}
 	if err := client.List(ctx, backups, &ctrlclient.MatchingFields{"metadata.namespace": namespace}); err != nil {
 		log.WithError(errors.WithStack(err)).Error("failed to list backups")
@@ -973,6 +1087,18 @@ func markInProgressBackupsFailed(ctx context.Context, client ctrlclient.Client,
 			continue
 		}
 		updated := backup.DeepCopy()
+		if resumeBackups && backup.Status.ResumeCount < maxBackupResumes {
+			// the backup controller only processes new backups, so move the backup back to New
+			// and let it resume from its checkpoint
+			updated.Status.Phase = velerov1api.BackupPhaseNew
+			updated.Status.ResumeCount++
+			if err := client.Patch(ctx, updated, ctrlclient.MergeFrom(&backup)); err != nil {
+				log.WithError(errors.WithStack(err)).Errorf("failed to patch backup %q", backup.GetName())
+				continue
+			}
+			log.WithField("backup", backup.GetName()).Warnf("get a backup with status %q during the server starting, resume it (attempt %d of %d)", velerov1api.BackupPhaseInProgress, updated.Status.ResumeCount, maxBackupResumes)
+			continue
+		}
 		updated.Status.Phase = velerov1api.BackupPhaseFailed
 		updated.Status.FailureReason = fmt.Sprintf("get a backup with status %q during the server starting, mark it as %q", velerov1api.BackupPhaseInProgress, updated.Status.Phase)
 		updated.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
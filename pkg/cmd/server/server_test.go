@@ -17,12 +17,16 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/controller"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
@@ -154,3 +158,52 @@ func TestRemoveControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkInProgressBackupsFailed(t *testing.T) {
+	resumable := builder.ForBackup(v1.DefaultNamespace, "resumable").Phase(v1.BackupPhaseInProgress).Result()
+	exhausted := builder.ForBackup(v1.DefaultNamespace, "exhausted").Phase(v1.BackupPhaseInProgress).Result()
+	exhausted.Status.ResumeCount = maxBackupResumes
+	completed := builder.ForBackup(v1.DefaultNamespace, "completed").Phase(v1.BackupPhaseCompleted).Result()
+
+	tests := []struct {
+		name           string
+		resumeBackups  bool
+		expectedPhases map[string]v1.BackupPhase
+		expectedResume map[string]int
+	}{
+		{
+			name:          "in progress backups are resumed until they were resumed too many times",
+			resumeBackups: true,
+			expectedPhases: map[string]v1.BackupPhase{
+				"resumable": v1.BackupPhaseNew,
+				"exhausted": v1.BackupPhaseFailed,
+				"completed": v1.BackupPhaseCompleted,
+			},
+			expectedResume: map[string]int{"resumable": 1, "exhausted": maxBackupResumes},
+		},
+		{
+			name: "in progress backups are failed when they aren't resumed",
+			expectedPhases: map[string]v1.BackupPhase{
+				"resumable": v1.BackupPhaseFailed,
+				"exhausted": v1.BackupPhaseFailed,
+				"completed": v1.BackupPhaseCompleted,
+			},
+			expectedResume: map[string]int{"exhausted": maxBackupResumes},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, resumable.DeepCopy(), exhausted.DeepCopy(), completed.DeepCopy())
+
+			markInProgressBackupsFailed(context.Background(), client, v1.DefaultNamespace, test.resumeBackups, velerotest.NewLogger())
+
+			for name, phase := range test.expectedPhases {
+				backup := &v1.Backup{}
+				require.NoError(t, client.Get(context.Background(), ctrlclient.ObjectKey{Namespace: v1.DefaultNamespace, Name: name}, backup))
+				assert.Equal(t, phase, backup.Status.Phase, name)
+				assert.Equal(t, test.expectedResume[name], backup.Status.ResumeCount, name)
+			}
+		})
+	}
+}
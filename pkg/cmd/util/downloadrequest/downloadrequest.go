@@ -17,7 +17,6 @@ limitations under the License.
 package downloadrequest
 
 import (
-	"compress/gzip"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -35,6 +34,7 @@ import (
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 // ErrNotFound is exported for external packages to check for when a file is
@@ -43,13 +43,19 @@ var ErrNotFound = errors.New("file not found")
 var ErrDownloadRequestDownloadURLTimeout = errors.New("download request download url timeout, check velero server logs for errors. backup storage location may not be available")
 
 func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name string, kind velerov1api.DownloadTargetKind, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
+	return StreamTarget(ctx, kbClient, namespace, velerov1api.DownloadTarget{Kind: kind, Name: name}, w, timeout, insecureSkipTLSVerify, caCertFile)
+}
+
+// StreamTarget is like Stream, for targets that need more than a kind and a name, such as the
+// archive of a namespace of a backup.
+func StreamTarget(ctx context.Context, kbClient kbclient.Client, namespace string, target velerov1api.DownloadTarget, w io.Writer, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) error {
 	uuid, err := uuid.NewRandom()
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	reqName := fmt.Sprintf("%s-%s", name, uuid.String())
-	created := builder.ForDownloadRequest(namespace, reqName).Target(kind, name).Result()
+	reqName := fmt.Sprintf("%s-%s", target.Name, uuid.String())
+	created := builder.ForDownloadRequest(namespace, reqName).Target(target.Kind, target.Name).TargetNamespace(target.Namespace).Result()
 
 	if err := kbClient.Create(context.Background(), created, &kbclient.CreateOptions{}); err != nil {
 		return errors.WithStack(err)
@@ -153,14 +159,14 @@ func Stream(ctx context.Context, kbClient kbclient.Client, namespace, name strin
 	}
 
 	reader := resp.Body
-	if kind != velerov1api.DownloadTargetKindBackupContents {
+	if target.Kind != velerov1api.DownloadTargetKindBackupContents && target.Kind != velerov1api.DownloadTargetKindBackupNamespaceContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		decompressingReader, err := compression.NewReader(resp.Body)
 		if err != nil {
 			return err
 		}
-		defer gzipReader.Close()
-		reader = gzipReader
+		defer decompressingReader.Close()
+		reader = decompressingReader
 	}
 
 	_, err = io.Copy(w, reader)
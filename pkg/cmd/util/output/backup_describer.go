@@ -35,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	clientset "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -65,6 +66,8 @@ func DescribeBackup(
 			phaseString = color.RedString(phaseString)
 		case velerov1api.BackupPhaseCompleted:
 			phaseString = color.GreenString(phaseString)
+		case velerov1api.BackupPhaseCanceled:
+			phaseString = color.YellowString(phaseString)
 		case velerov1api.BackupPhaseDeleting:
 		case velerov1api.BackupPhaseInProgress:
 		case velerov1api.BackupPhaseNew:
@@ -86,6 +89,14 @@ func DescribeBackup(
 			}
 		}
 
+		if len(status.DamagedVolumeBackups) > 0 {
+			d.Println()
+			d.Printf("Damaged volume backups (found by repository verification):\n")
+			for _, vb := range status.DamagedVolumeBackups {
+				d.Printf("\t%s\n", color.RedString(vb))
+			}
+		}
+
 		d.Println()
 		d.Printf("Errors:\t%d\n", status.Errors)
 		d.Printf("Warnings:\t%d\n", status.Warnings)
@@ -147,6 +158,9 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Printf("\tExcluded:\t%s\n", s)
 
 	d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(spec.IncludeClusterResources, "excluded", "included", "auto"))
+	if spec.IncludeClusterResources == nil && boolptr.IsSetToTrue(spec.IncludeClusterDependencies) {
+		d.Printf("\tCluster-scoped dependencies:\tincluded\n")
+	}
 
 	d.Println()
 	s = "<none>"
@@ -167,6 +181,9 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	d.Println()
 	d.Printf("CSISnapshotTimeout:\t%s\n", &spec.CSISnapshotTimeout.Duration)
 
+	d.Println()
+	d.Printf("Snapshot Move Data:\t%s\n", BoolPointerString(spec.SnapshotMoveData, "false", "true", "false"))
+
 	d.Println()
 	if len(spec.Hooks.Resources) == 0 {
 		d.Printf("Hooks:\t<none>\n")
@@ -236,6 +253,42 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 		}
 	}
 
+	if len(spec.Transforms) > 0 {
+		d.Println()
+		d.Printf("Transforms:\n")
+		for _, transform := range spec.Transforms {
+			d.Printf("\t%s:\n", transform.Name)
+			s := "*"
+			if len(transform.IncludedNamespaces) > 0 {
+				s = strings.Join(transform.IncludedNamespaces, ", ")
+			}
+			if len(transform.ExcludedNamespaces) > 0 {
+				s += fmt.Sprintf(" (excluding %s)", strings.Join(transform.ExcludedNamespaces, ", "))
+			}
+			d.Printf("\t\tNamespaces:\t%s\n", s)
+			s = "*"
+			if len(transform.IncludedResources) > 0 {
+				s = strings.Join(transform.IncludedResources, ", ")
+			}
+			if len(transform.ExcludedResources) > 0 {
+				s += fmt.Sprintf(" (excluding %s)", strings.Join(transform.ExcludedResources, ", "))
+			}
+			d.Printf("\t\tResources:\t%s\n", s)
+			if transform.LabelSelector != nil {
+				d.Printf("\t\tLabel selector:\t%s\n", metav1.FormatLabelSelector(transform.LabelSelector))
+			}
+			if len(transform.SecretTypes) > 0 {
+				d.Printf("\t\tSecret types:\t%s\n", strings.Join(transform.SecretTypes, ", "))
+			}
+			if transform.MetadataOnly {
+				d.Printf("\t\tMetadata only:\ttrue\n")
+			}
+			for _, patch := range transform.Patches {
+				d.Printf("\t\tPatch:\t%s %s\n", patch.Operation, patch.Path)
+			}
+		}
+	}
+
 	if spec.OrderedResources != nil {
 		d.Println()
 		d.Printf("OrderedResources:\n")
@@ -251,6 +304,9 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	d.Printf("Backup Format Version:\t%s\n", status.FormatVersion)
+	if status.Compression != "" {
+		d.Printf("Compression:\t%s\n", status.Compression)
+	}
 
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
@@ -264,12 +320,18 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	} else {
 		d.Printf("Completed:\t%s\n", status.CompletionTimestamp.Time)
 	}
+	if status.ResumeCount > 0 {
+		d.Printf("Resumed:\t%d time(s) after a server restart\n", status.ResumeCount)
+	}
 
 	d.Println()
 	// Expiration can't be 0, it is always set to a 30-day default. It can be nil
 	// if the controller hasn't processed this Backup yet, in which case this will
 	// just display `<nil>`, though this should be temporary.
 	d.Printf("Expiration:\t%s\n", status.Expiration)
+	if status.ObjectLock != nil {
+		describeBackupObjectLock(d, status.ObjectLock)
+	}
 	d.Println()
 
 	if backup.Status.Progress != nil {
@@ -280,6 +342,17 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 			d.Printf("Total items to be backed up:\t%d\n", backup.Status.Progress.TotalItems)
 			d.Printf("Items backed up:\t%d\n", backup.Status.Progress.ItemsBackedUp)
 		}
+		if status.ItemsTransformed > 0 {
+			d.Printf("Items transformed:\t%d\n", status.ItemsTransformed)
+		}
+		if len(status.ClusterDependencies) > 0 {
+			d.Printf("Cluster-scoped dependencies included:\t%d\n", len(status.ClusterDependencies))
+			if details {
+				for _, dependency := range status.ClusterDependencies {
+					d.Printf("\t%s\n", dependency)
+				}
+			}
+		}
 
 		d.Println()
 	}
@@ -289,6 +362,11 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Println()
 	}
 
+	if status.DataUploadsAttempted > 0 {
+		d.Printf("Snapshot Data Moved:\t%d of %d volumes completed successfully\n", status.DataUploadsCompleted, status.DataUploadsAttempted)
+		d.Println()
+	}
+
 	if status.VolumeSnapshotsAttempted > 0 {
 		if !details {
 			d.Printf("Velero-Native Snapshots:\t%d of %d snapshots completed successfully (specify --details for more information)\n", status.VolumeSnapshotsCompleted, status.VolumeSnapshotsAttempted)
@@ -317,6 +395,21 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 	d.Printf("Velero-Native Snapshots: <none included>\n")
 }
 
+// describeBackupObjectLock describes the object lock the backup was protected with when it
+// completed. The lock in the object store may have been extended or released since. Only the
+// objects of the backup are locked, not its data in the backup repositories.
+func describeBackupObjectLock(d *Describer, lock *velerov1api.BackupObjectLock) {
+	var details []string
+	if lock.RetainUntil != nil {
+		details = append(details, fmt.Sprintf("%s mode, retained until %s", lock.Mode, lock.RetainUntil.Time))
+	}
+	if lock.LegalHold {
+		details = append(details, "legal hold")
+	}
+	d.Printf("Object Lock:\t%s\n", strings.Join(details, ", "))
+	d.Printf("\t(pod volume and moved snapshot data in the backup repositories isn't locked)\n")
+}
+
 func describeBackupResourceList(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
 	buf := new(bytes.Buffer)
 	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupResourceList, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
@@ -416,7 +509,9 @@ func DescribePodVolumeBackups(d *Describer, backups []velerov1api.PodVolumeBacku
 	for _, phase := range []string{
 		string(velerov1api.PodVolumeBackupPhaseCompleted),
 		string(velerov1api.PodVolumeBackupPhaseFailed),
+		string(velerov1api.PodVolumeBackupPhaseCanceled),
 		"In Progress",
+		string(velerov1api.PodVolumeBackupPhaseQueued),
 		string(velerov1api.PodVolumeBackupPhaseNew),
 	} {
 		if len(backupsByPhase[phase]) == 0 {
@@ -452,7 +547,9 @@ func groupByPhase(backups []velerov1api.PodVolumeBackup) map[string][]velerov1ap
 	phaseToGroup := map[velerov1api.PodVolumeBackupPhase]string{
 		velerov1api.PodVolumeBackupPhaseCompleted:  string(velerov1api.PodVolumeBackupPhaseCompleted),
 		velerov1api.PodVolumeBackupPhaseFailed:     string(velerov1api.PodVolumeBackupPhaseFailed),
+		velerov1api.PodVolumeBackupPhaseCanceled:   string(velerov1api.PodVolumeBackupPhaseCanceled),
 		velerov1api.PodVolumeBackupPhaseInProgress: "In Progress",
+		velerov1api.PodVolumeBackupPhaseQueued:     string(velerov1api.PodVolumeBackupPhaseQueued),
 		velerov1api.PodVolumeBackupPhaseNew:        string(velerov1api.PodVolumeBackupPhaseNew),
 		"":                                         string(velerov1api.PodVolumeBackupPhaseNew),
 	}
@@ -212,6 +212,16 @@ func printTable(cmd *cobra.Command, obj runtime.Object) (bool, error) {
 			ColumnDefinitions: pluginColumns,
 			Rows:              printPluginList(obj.(*velerov1api.ServerStatusRequest)),
 		}
+	case *velerov1api.PluginConfig:
+		table = &metav1.Table{
+			ColumnDefinitions: pluginConfigColumns,
+			Rows:              printPluginConfig(obj.(*velerov1api.PluginConfig)),
+		}
+	case *velerov1api.PluginConfigList:
+		table = &metav1.Table{
+			ColumnDefinitions: pluginConfigColumns,
+			Rows:              printPluginConfigList(obj.(*velerov1api.PluginConfigList)),
+		}
 	default:
 		return false, errors.Errorf("type %T is not supported", obj)
 	}
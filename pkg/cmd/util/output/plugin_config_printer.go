@@ -0,0 +1,80 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+var (
+	pluginConfigColumns = []metav1.TableColumnDefinition{
+		// name needs Type and Format defined for the decorator to identify it:
+		// https://github.com/kubernetes/kubernetes/blob/v1.15.3/pkg/printers/tableprinter.go#L204
+		{Name: "Name", Type: "string", Format: "name"},
+		{Name: "Kind"},
+		{Name: "Plugin"},
+		{Name: "Namespaces"},
+		{Name: "Config"},
+	}
+)
+
+func printPluginConfigList(list *v1.PluginConfigList) []metav1.TableRow {
+	rows := make([]metav1.TableRow, 0, len(list.Items))
+
+	for i := range list.Items {
+		rows = append(rows, printPluginConfig(&list.Items[i])...)
+	}
+	return rows
+}
+
+func printPluginConfig(config *v1.PluginConfig) []metav1.TableRow {
+	row := metav1.TableRow{
+		Object: runtime.RawExtension{Object: config},
+	}
+
+	namespaces := "*"
+	if len(config.Spec.IncludedNamespaces) > 0 {
+		namespaces = strings.Join(config.Spec.IncludedNamespaces, ",")
+	}
+
+	keys := make([]string, 0, len(config.Spec.Config))
+	for key := range config.Spec.Config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]string, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, key+"="+config.Spec.Config[key])
+	}
+
+	row.Cells = append(row.Cells,
+		config.Name,
+		config.Spec.PluginKind,
+		config.Spec.PluginName,
+		namespaces,
+		strings.Join(entries, ","),
+	)
+
+	return []metav1.TableRow{row}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/fatih/color"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DescribeResticRepo describes a backup repository and its storage usage in human-readable format.
+func DescribeResticRepo(repo *v1.BackupRepository) string {
+	return Describe(func(d *Describer) {
+		d.DescribeMetadata(repo.ObjectMeta)
+
+		d.Println()
+		phase := repo.Status.Phase
+		if phase == "" {
+			phase = v1.BackupRepositoryPhaseNew
+		}
+		phaseString := string(phase)
+		switch phase {
+		case v1.BackupRepositoryPhaseReady:
+			phaseString = color.GreenString(phaseString)
+		case v1.BackupRepositoryPhaseNotReady:
+			phaseString = color.RedString(phaseString)
+		}
+		d.Printf("Phase:\t%s\n", phaseString)
+		if repo.Status.Message != "" {
+			d.Printf("Message:\t%s\n", repo.Status.Message)
+		}
+
+		d.Println()
+		repoType := repo.Spec.RepositoryType
+		if repoType == "" {
+			repoType = v1.BackupRepositoryTypeRestic
+		}
+		d.Printf("Repository Type:\t%s\n", repoType)
+		d.Printf("Volume Namespace:\t%s\n", repo.Spec.VolumeNamespace)
+		d.Printf("Backup Storage Location:\t%s\n", repo.Spec.BackupStorageLocation)
+
+		d.Println()
+		lastMaintenance := "<never>"
+		if repo.Status.LastMaintenanceTime != nil && !repo.Status.LastMaintenanceTime.IsZero() {
+			lastMaintenance = repo.Status.LastMaintenanceTime.String()
+		}
+		d.Printf("Maintenance Frequency:\t%s\n", repo.Spec.MaintenanceFrequency.Duration)
+		d.Printf("Last Maintenance:\t%s\n", lastMaintenance)
+
+		lastVerification := "<never>"
+		if last := repo.Status.LastVerification; last != nil {
+			lastVerification = string(last.Result)
+			if last.CompleteTimestamp != nil {
+				lastVerification = fmt.Sprintf("%s at %s", last.Result, last.CompleteTimestamp.String())
+			}
+		}
+		d.Printf("Last Verification:\t%s\n", lastVerification)
+
+		d.Println()
+		keySecret := repo.Spec.KeySecret
+		if keySecret == "" {
+			keySecret = "<shared by all repositories>"
+		}
+		keyGeneration := repo.Status.KeyGeneration
+		if keyGeneration == 0 {
+			keyGeneration = 1
+		}
+		lastKeyRotation := "<never>"
+		if repo.Status.LastKeyRotationTime != nil && !repo.Status.LastKeyRotationTime.IsZero() {
+			lastKeyRotation = repo.Status.LastKeyRotationTime.String()
+		}
+		d.Printf("Key Secret:\t%s\n", keySecret)
+		d.Printf("Key Generation:\t%d\n", keyGeneration)
+		d.Printf("Last Key Rotation:\t%s\n", lastKeyRotation)
+
+		d.Println()
+		if migratedFrom := repo.Annotations[v1.MigratedFromBackupRepositoryAnnotation]; migratedFrom != "" {
+			d.Printf("Migrated From:\t%s\n", migratedFrom)
+		}
+		DescribeResticRepoMigration(d, repo.Status.LastMigration)
+
+		d.Println()
+		DescribeResticRepoStats(d, repo.Status.Stats)
+	})
+}
+
+// DescribeResticRepoMigration describes the latest migration of a backup repository to another
+// backup storage location.
+func DescribeResticRepoMigration(d *Describer, migration *v1.BackupRepositoryMigrationStatus) {
+	if migration == nil {
+		d.Printf("Last Migration:\t<never>\n")
+		return
+	}
+
+	result := string(migration.Result)
+	switch migration.Result {
+	case v1.BackupRepositoryMigrationSucceeded:
+		result = color.GreenString(result)
+	case v1.BackupRepositoryMigrationFailed:
+		result = color.RedString(result)
+	}
+
+	d.Printf("Last Migration:\n")
+	d.Printf("\tTarget Location:\t%s\n", migration.TargetLocation)
+	if migration.TargetRepository != "" {
+		d.Printf("\tTarget Repository:\t%s\n", migration.TargetRepository)
+	}
+	d.Printf("\tResult:\t%s\n", result)
+	d.Printf("\tSnapshots Copied:\t%d\n", migration.Snapshots)
+	d.Printf("\tBackups Updated:\t%d\n", migration.Backups)
+	if migration.CompleteTimestamp != nil {
+		d.Printf("\tCompleted:\t%s\n", migration.CompleteTimestamp.String())
+	}
+	if migration.Message != "" {
+		d.Printf("\tMessage:\t%s\n", migration.Message)
+	}
+}
+
+// DescribeResticRepoStats describes the storage usage of a backup repository, and of each backup
+// with data in it.
+func DescribeResticRepoStats(d *Describer, stats *v1.BackupRepositoryStats) {
+	if stats == nil {
+		d.Printf("Storage Usage:\t<not collected yet, statistics are collected by repository maintenance>\n")
+		return
+	}
+
+	if stats.CollectionTimestamp != nil {
+		d.Printf("Storage Usage (as of %s):\n", stats.CollectionTimestamp.String())
+	} else {
+		d.Printf("Storage Usage:\n")
+	}
+	d.Printf("\tTotal:\t%s\n", formatOptionalBytes(stats.TotalBytes))
+	d.Printf("\tUnique Data:\t%s\n", formatBytes(stats.UniqueBytes))
+	d.Printf("\tLogical Data:\t%s\n", formatOptionalBytes(stats.LogicalBytes))
+	if stats.LogicalBytes != nil && stats.UniqueBytes > 0 {
+		d.Printf("\tDeduplication Ratio:\t%.2f\n", float64(*stats.LogicalBytes)/float64(stats.UniqueBytes))
+	}
+	d.Printf("\tSnapshots:\t%d\n", stats.SnapshotCount)
+
+	d.Println()
+	if len(stats.Backups) == 0 {
+		d.Printf("Backups:\t<none>\n")
+		return
+	}
+	d.Printf("Backups:\n")
+	d.Printf("\tName\tSnapshots\tLogical Data\tAdded Data\n")
+	for _, backup := range stats.Backups {
+		d.Printf("\t%s\t%d\t%s\t%s\n", backup.Backup, backup.SnapshotCount, formatBytes(backup.LogicalBytes), formatOptionalBytes(backup.AddedBytes))
+	}
+}
+
+// formatOptionalBytes formats a number of bytes like formatBytes, or as unknown if it's nil.
+func formatOptionalBytes(bytes *int64) string {
+	if bytes == nil {
+		return "<unknown>"
+	}
+	return formatBytes(*bytes)
+}
+
+// formatBytes formats a number of bytes with a binary unit, e.g. 1.5 GiB.
+func formatBytes(bytes int64) string {
+	if bytes < 0 {
+		bytes = 0
+	}
+	return humanize.IBytes(uint64(bytes))
+}
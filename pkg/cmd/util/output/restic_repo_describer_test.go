@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestDescribeResticRepoStats(t *testing.T) {
+	added := int64(512 * 1024 * 1024)
+	total := int64(3 * 1024 * 1024 * 1024)
+	logical := int64(5 * 1024 * 1024 * 1024)
+	tests := []struct {
+		name     string
+		stats    *v1.BackupRepositoryStats
+		expected string
+	}{
+		{
+			name:     "statistics weren't collected",
+			expected: "Storage Usage:  <not collected yet, statistics are collected by repository maintenance>\n",
+		},
+		{
+			name: "statistics of a repository with backups",
+			stats: &v1.BackupRepositoryStats{
+				TotalBytes:    &total,
+				UniqueBytes:   2 * 1024 * 1024 * 1024,
+				LogicalBytes:  &logical,
+				SnapshotCount: 3,
+				Backups: []v1.BackupRepositoryBackupStats{
+					{Backup: "backup-1", SnapshotCount: 2, LogicalBytes: 1024, AddedBytes: &added},
+					{Backup: "backup-2", SnapshotCount: 1, LogicalBytes: 2048},
+				},
+			},
+			expected: `Storage Usage:
+  Total:                3.0 GiB
+  Unique Data:          2.0 GiB
+  Logical Data:         5.0 GiB
+  Deduplication Ratio:  2.50
+  Snapshots:            3
+
+Backups:
+  Name      Snapshots  Logical Data  Added Data
+  backup-1  2          1.0 KiB       512 MiB
+  backup-2  1          2.0 KiB       <unknown>
+`,
+		},
+		{
+			name: "statistics of a repository that can't measure its storage",
+			stats: &v1.BackupRepositoryStats{
+				UniqueBytes:   2 * 1024 * 1024 * 1024,
+				SnapshotCount: 3,
+			},
+			expected: `Storage Usage:
+  Total:         <unknown>
+  Unique Data:   2.0 GiB
+  Logical Data:  <unknown>
+  Snapshots:     3
+
+Backups:  <none>
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := Describe(func(d *Describer) {
+				DescribeResticRepoStats(d, test.stats)
+			})
+			assert.Equal(t, test.expected, s)
+		})
+	}
+}
+
+func TestDescribeResticRepoMigration(t *testing.T) {
+	color.NoColor = true
+
+	tests := []struct {
+		name      string
+		migration *v1.BackupRepositoryMigrationStatus
+		expected  string
+	}{
+		{
+			name:     "repository wasn't migrated",
+			expected: "Last Migration:  <never>\n",
+		},
+		{
+			name: "succeeded",
+			migration: &v1.BackupRepositoryMigrationStatus{
+				TargetLocation:   "location-2",
+				TargetRepository: "ns-1-location-2-restic-xyz12",
+				Result:           v1.BackupRepositoryMigrationSucceeded,
+				Snapshots:        3,
+				Backups:          2,
+			},
+			expected: `Last Migration:
+  Target Location:    location-2
+  Target Repository:  ns-1-location-2-restic-xyz12
+  Result:             Succeeded
+  Snapshots Copied:   3
+  Backups Updated:    2
+`,
+		},
+		{
+			name: "failed",
+			migration: &v1.BackupRepositoryMigrationStatus{
+				TargetLocation: "location-2",
+				Result:         v1.BackupRepositoryMigrationFailed,
+				Message:        "backup storage location location-2 is read-only",
+			},
+			expected: `Last Migration:
+  Target Location:   location-2
+  Result:            Failed
+  Snapshots Copied:  0
+  Backups Updated:   0
+  Message:           backup storage location location-2 is read-only
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := Describe(func(d *Describer) {
+				DescribeResticRepoMigration(d, test.migration)
+			})
+			assert.Equal(t, test.expected, s)
+		})
+	}
+}
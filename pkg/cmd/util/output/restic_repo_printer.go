@@ -30,6 +30,7 @@ var (
 		{Name: "Name", Type: "string", Format: "name"},
 		{Name: "Status"},
 		{Name: "Last Maintenance"},
+		{Name: "Size"},
 	}
 )
 
@@ -59,10 +60,16 @@ func printResticRepo(repo *v1.BackupRepository) []metav1.TableRow {
 		lastMaintenance = repo.Status.LastMaintenanceTime.String()
 	}
 
+	size := "<unknown>"
+	if repo.Status.Stats != nil {
+		size = formatOptionalBytes(repo.Status.Stats.TotalBytes)
+	}
+
 	row.Cells = append(row.Cells,
 		repo.Name,
 		status,
 		lastMaintenance,
+		size,
 	)
 
 	return []metav1.TableRow{row}
@@ -25,6 +25,7 @@ import (
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/fatih/color"
@@ -50,6 +51,8 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			phaseString = color.GreenString(phaseString)
 		case velerov1api.RestorePhaseFailedValidation, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
 			phaseString = color.RedString(phaseString)
+		case velerov1api.RestorePhaseCanceled:
+			phaseString = color.YellowString(phaseString)
 		}
 
 		resultsNote := ""
@@ -90,7 +93,12 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			}
 		}
 
-		describeRestoreResults(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+		describeRestoreResults(ctx, kbClient, d, restore, details, insecureSkipTLSVerify, caCertFile)
+
+		if restore.Status.Rollback != nil {
+			d.Println()
+			describeRestoreRollback(d, restore.Status.Rollback)
+		}
 
 		d.Println()
 		d.Printf("Backup:\t%s\n", restore.Spec.BackupName)
@@ -130,9 +138,49 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 
 		d.Printf("\tCluster-scoped:\t%s\n", BoolPointerString(restore.Spec.IncludeClusterResources, "excluded", "included", "auto"))
 
+		if len(restore.Spec.IncludedItems) > 0 || len(restore.Spec.ExcludedItems) > 0 {
+			d.Println()
+			d.Printf("Items:\n")
+			s = "*"
+			if len(restore.Spec.IncludedItems) > 0 {
+				s = strings.Join(restore.Spec.IncludedItems, ", ")
+			}
+			d.Printf("\tIncluded:\t%s\n", s)
+			s = "<none>"
+			if len(restore.Spec.ExcludedItems) > 0 {
+				s = strings.Join(restore.Spec.ExcludedItems, ", ")
+			}
+			d.Printf("\tExcluded:\t%s\n", s)
+		}
+
+		if len(restore.Spec.FieldSelectors) > 0 {
+			d.Println()
+			d.Printf("Field selectors:\n")
+			resources := make([]string, 0, len(restore.Spec.FieldSelectors))
+			for resource := range restore.Spec.FieldSelectors {
+				resources = append(resources, resource)
+			}
+			sort.Strings(resources)
+			for _, resource := range resources {
+				d.Printf("\t%s:\t%s\n", resource, restore.Spec.FieldSelectors[resource])
+			}
+		}
+
 		d.Println()
 		d.DescribeMap("Namespace mappings", restore.Spec.NamespaceMapping)
 
+		if len(restore.Spec.NamespaceMappingRules) > 0 {
+			d.Println()
+			d.Printf("Namespace mapping rules:\n")
+			for _, rule := range restore.Spec.NamespaceMappingRules {
+				kind := "glob"
+				if rule.Regex {
+					kind = "regex"
+				}
+				d.Printf("\t%s (%s):\t%s\n", rule.Pattern, kind, rule.Target)
+			}
+		}
+
 		d.Println()
 		s = "<none>"
 		if restore.Spec.LabelSelector != nil {
@@ -155,14 +203,64 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		}
 		d.Printf("Existing Resource Policy: \t%s\n", s)
 
+		d.Println()
+		s = string(velerov1api.WebhookRestorePolicyDeferred)
+		if restore.Spec.WebhookRestorePolicy != "" {
+			s = string(restore.Spec.WebhookRestorePolicy)
+		}
+		d.Printf("Webhook Restore Policy:\t%s\n", s)
+
+		if policy := restore.Spec.ServicePolicy; policy != nil {
+			d.Println()
+			d.Printf("Service Policy:\n")
+			s = string(velerov1api.ServiceClusterIPPolicyReassign)
+			if policy.ClusterIPPolicy != "" {
+				s = string(policy.ClusterIPPolicy)
+			}
+			d.Printf("\tCluster IPs:\t%s\n", s)
+			s = string(velerov1api.LoadBalancerAnnotationPolicyKeep)
+			if policy.LoadBalancerAnnotationPolicy != "" {
+				s = string(policy.LoadBalancerAnnotationPolicy)
+			}
+			d.Printf("\tLoad balancer annotations:\t%s\n", s)
+			for _, key := range sets.StringKeySet(policy.LoadBalancerAnnotationMapping).List() {
+				d.Printf("\t\t%s:\t%s\n", key, policy.LoadBalancerAnnotationMapping[key])
+			}
+			d.Printf("\tPreserve load balancer IP:\t%s\n", BoolPointerString(policy.PreserveLoadBalancerIP, "false", "true", "true"))
+		}
+
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
+		d.Println()
+		d.Printf("Rollback on failure:\t%t\n", restore.Spec.RollbackOnFailure)
+
 	})
 }
 
-func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
-	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 {
+func describeRestoreRollback(d *Describer, rollback *velerov1api.RestoreRollbackStatus) {
+	phaseString := string(rollback.Phase)
+	switch rollback.Phase {
+	case velerov1api.RestoreRollbackPhaseCompleted:
+		phaseString = color.GreenString(phaseString)
+	case velerov1api.RestoreRollbackPhasePartiallyFailed, velerov1api.RestoreRollbackPhaseFailed:
+		phaseString = color.RedString(phaseString)
+	}
+
+	d.Printf("Rollback:\n")
+	d.Printf("\tPhase:\t%s\n", phaseString)
+	if rollback.FailureReason != "" {
+		d.Printf("\tFailure reason:\t%s\n", rollback.FailureReason)
+	}
+	d.Printf("\tItems deleted:\t%d\n", rollback.ItemsDeleted)
+	d.Printf("\tItems reverted:\t%d\n", rollback.ItemsReverted)
+	d.DescribeSlice(1, "Not rolled back", rollback.Errors)
+}
+
+func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
+	describeServices := details && restore.Spec.ServicePolicy != nil && restore.Status.Phase != "" &&
+		restore.Status.Phase != velerov1api.RestorePhaseNew && restore.Status.Phase != velerov1api.RestorePhaseInProgress
+	if restore.Status.Warnings == 0 && restore.Status.Errors == 0 && !describeServices {
 		return
 	}
 
@@ -187,6 +285,28 @@ func describeRestoreResults(ctx context.Context, kbClient kbclient.Client, d *De
 		d.Println()
 		describeRestoreResult(d, "Errors", resultMap["errors"])
 	}
+	if describeServices {
+		d.Println()
+		describeServiceResults(d, resultMap["services"])
+	}
+}
+
+// describeServiceResults describes what the restore's service policy did to each Service.
+func describeServiceResults(d *Describer, result pkgrestore.Result) {
+	if len(result.Namespaces) == 0 {
+		d.Printf("Services:\t<none>\n")
+		return
+	}
+
+	d.Printf("Services:\n")
+	namespaces := make([]string, 0, len(result.Namespaces))
+	for ns := range result.Namespaces {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	for _, ns := range namespaces {
+		d.DescribeSlice(1, ns, result.Namespaces[ns])
+	}
 }
 
 func describeRestoreResult(d *Describer, name string, result pkgrestore.Result) {
@@ -218,7 +338,9 @@ func describePodVolumeRestores(d *Describer, restores []velerov1api.PodVolumeRes
 	for _, phase := range []string{
 		string(velerov1api.PodVolumeRestorePhaseCompleted),
 		string(velerov1api.PodVolumeRestorePhaseFailed),
+		string(velerov1api.PodVolumeRestorePhaseCanceled),
 		"In Progress",
+		string(velerov1api.PodVolumeRestorePhaseQueued),
 		string(velerov1api.PodVolumeRestorePhaseNew),
 	} {
 		if len(restoresByPhase[phase]) == 0 {
@@ -254,7 +376,9 @@ func groupRestoresByPhase(restores []velerov1api.PodVolumeRestore) map[string][]
 	phaseToGroup := map[velerov1api.PodVolumeRestorePhase]string{
 		velerov1api.PodVolumeRestorePhaseCompleted:  string(velerov1api.PodVolumeRestorePhaseCompleted),
 		velerov1api.PodVolumeRestorePhaseFailed:     string(velerov1api.PodVolumeRestorePhaseFailed),
+		velerov1api.PodVolumeRestorePhaseCanceled:   string(velerov1api.PodVolumeRestorePhaseCanceled),
 		velerov1api.PodVolumeRestorePhaseInProgress: "In Progress",
+		velerov1api.PodVolumeRestorePhaseQueued:     string(velerov1api.PodVolumeRestorePhaseQueued),
 		velerov1api.PodVolumeRestorePhaseNew:        string(velerov1api.PodVolumeRestorePhaseNew),
 		"":                                          string(velerov1api.PodVolumeRestorePhaseNew),
 	}
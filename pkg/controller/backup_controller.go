@@ -63,8 +63,10 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -74,6 +76,10 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// dataUploadPollInterval is how often a backup checks whether its data uploads have
+// finished.
+var dataUploadPollInterval = 5 * time.Second
+
 type backupController struct {
 	*genericController
 	discoveryHelper             discovery.Helper
@@ -89,6 +95,8 @@ type backupController struct {
 	defaultVolumesToRestic      bool
 	defaultBackupTTL            time.Duration
 	defaultCSISnapshotTimeout   time.Duration
+	checkpointInterval          time.Duration
+	dataUploadTimeout           time.Duration
 	snapshotLocationLister      velerov1listers.VolumeSnapshotLocationLister
 	defaultSnapshotLocations    map[string]string
 	metrics                     *metrics.ServerMetrics
@@ -115,6 +123,8 @@ func NewBackupController(
 	defaultVolumesToRestic bool,
 	defaultBackupTTL time.Duration,
 	defaultCSISnapshotTimeout time.Duration,
+	checkpointInterval time.Duration,
+	dataUploadTimeout time.Duration,
 	volumeSnapshotLocationLister velerov1listers.VolumeSnapshotLocationLister,
 	defaultSnapshotLocations map[string]string,
 	metrics *metrics.ServerMetrics,
@@ -141,6 +151,8 @@ func NewBackupController(
 		defaultVolumesToRestic:      defaultVolumesToRestic,
 		defaultBackupTTL:            defaultBackupTTL,
 		defaultCSISnapshotTimeout:   defaultCSISnapshotTimeout,
+		checkpointInterval:          checkpointInterval,
+		dataUploadTimeout:           dataUploadTimeout,
 		snapshotLocationLister:      volumeSnapshotLocationLister,
 		defaultSnapshotLocations:    defaultSnapshotLocations,
 		metrics:                     metrics,
@@ -262,13 +274,27 @@ func (c *backupController) processBackup(key string) error {
 		return nil
 	}
 
+	if original.Spec.Cancel {
+		log.Info("Backup was canceled before it started")
+		updated := original.DeepCopy()
+		updated.Status.Phase = velerov1api.BackupPhaseCanceled
+		updated.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		if _, err := patchBackup(original, updated, c.client); err != nil {
+			return errors.Wrapf(err, "error updating Backup status to %s", updated.Status.Phase)
+		}
+		return nil
+	}
+
 	log.Debug("Preparing backup request")
 	request := c.prepareBackupRequest(original)
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
 	} else {
 		request.Status.Phase = velerov1api.BackupPhaseInProgress
-		request.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
+		// a resumed backup keeps the start time of its first run
+		if request.Status.ResumeCount == 0 || request.Status.StartTimestamp == nil {
+			request.Status.StartTimestamp = &metav1.Time{Time: c.clock.Now()}
+		}
 	}
 
 	// update status
@@ -294,7 +320,9 @@ func (c *backupController) processBackup(key string) error {
 	c.metrics.RegisterBackupAttempt(backupScheduleName)
 
 	// execution & upload of backup
-	if err := c.runBackup(request); err != nil {
+	if err := c.runBackup(request); err == errCanceled {
+		log.Info("backup canceled")
+	} else if err != nil {
 		// even though runBackup sets the backup's phase prior
 		// to uploading artifacts to object storage, we have to
 		// check for an error again here and update the phase if
@@ -379,17 +407,34 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 
 	// find which storage location to use
 	var serverSpecified bool
+	var storageLocationReason string
 	if request.Spec.StorageLocation == "" {
-		// when the user doesn't specify a location, use the server default unless there is an existing BSL marked as default
+		// when the user doesn't specify a location, use the server default unless there are existing BSLs marked as default
+		// whose placement rules match the backup
 		// TODO(2.0) c.defaultBackupLocation will be deprecated
 		request.Spec.StorageLocation = c.defaultBackupLocation
+		storageLocationReason = "server default backup storage location"
 
 		locationList, err := storage.ListBackupStorageLocations(context.Background(), c.kbClient, request.Namespace)
 		if err == nil {
-			for _, location := range locationList.Items {
-				if location.Spec.Default {
-					request.Spec.StorageLocation = location.Name
-					break
+			location, reason, err := storage.SelectBackupStorageLocation(context.Background(), c.kbClient, request.Backup, locationList.Items)
+			if err != nil {
+				request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error choosing a default backup storage location: %v", err))
+			} else if location != nil {
+				request.Spec.StorageLocation = location.Name
+				storageLocationReason = reason
+			} else {
+				// the server default is only used if its own placement rules don't exclude the backup
+				for i := range locationList.Items {
+					serverDefault := &locationList.Items[i]
+					if serverDefault.Name != c.defaultBackupLocation {
+						continue
+					}
+					if matched, err := storage.PlacementMatches(context.Background(), c.kbClient, serverDefault, request.Backup); err != nil {
+						request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error choosing a default backup storage location: %v", err))
+					} else if !matched {
+						request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("no default backup storage location's placement rules match the backup, including the server default '%s'. Please specify a backup storage location (see `velero backup-location -h` for options) and create a new backup.", serverDefault.Name))
+					}
 				}
 			}
 		}
@@ -413,12 +458,34 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("error getting backup storage location: %v", err))
 		}
 	} else {
+		// store the backup in the fallback location of an unavailable location
+		if fallback, err := storage.GetFallbackLocation(context.Background(), c.kbClient, storageLocation); err != nil {
+			c.logger.WithError(err).WithField("backupStorageLocation", storageLocation.Name).Warn("Backup storage location is unavailable and its fallback location can't be used")
+		} else if fallback != nil {
+			if request.Annotations == nil {
+				request.Annotations = make(map[string]string)
+			}
+			request.Annotations[velerov1api.FailoverFromAnnotation] = storageLocation.Name
+			request.Spec.StorageLocation = fallback.Name
+			storageLocation = fallback
+		}
 		request.StorageLocation = storageLocation
 
 		if request.StorageLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
 			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
 				fmt.Sprintf("backup can't be created because backup storage location %s is currently in read-only mode", request.StorageLocation.Name))
 		}
+
+		if exceeded, message := storage.QuotaExceeded(request.StorageLocation); exceeded &&
+			storage.QuotaEnforcement(request.StorageLocation) == velerov1api.QuotaEnforcementRefuse {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+				fmt.Sprintf("backup can't be created because %s", message))
+		}
+
+		if err := compression.Validate(request.StorageLocation.Spec.Compression); err != nil {
+			request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+				fmt.Sprintf("backup storage location %s has an invalid compression configuration: %v", request.StorageLocation.Name, err))
+		}
 	}
 
 	// add the storage location as a label for easy filtering later.
@@ -427,6 +494,14 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 	}
 	request.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(request.Spec.StorageLocation)
 
+	// record why the backup is stored in the storage location if it didn't specify one
+	if storageLocationReason != "" {
+		if request.Annotations == nil {
+			request.Annotations = make(map[string]string)
+		}
+		request.Annotations[velerov1api.StorageLocationReasonAnnotation] = storageLocationReason
+	}
+
 	// validate and get the backup's VolumeSnapshotLocations, and store the
 	// VolumeSnapshotLocation API objs on the request
 	if locs, errs := c.validateAndGetSnapshotLocations(request.Backup); len(errs) > 0 {
@@ -439,6 +514,11 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		}
 	}
 
+	if boolptr.IsSetToTrue(request.Spec.SnapshotMoveData) && !features.IsEnabled(velerov1api.CSIFeatureFlag) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors,
+			fmt.Sprintf("snapshot data can only be moved when the %s feature is enabled", velerov1api.CSIFeatureFlag))
+	}
+
 	// Getting all information of cluster version - useful for future skip-level migration
 	if request.Annotations == nil {
 		request.Annotations = make(map[string]string)
@@ -473,6 +553,17 @@ func (c *backupController) prepareBackupRequest(backup *velerov1api.Backup) *pkg
 		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("encountered labelSelector as well as orLabelSelectors in backup spec, only one can be specified"))
 	}
 
+	// cluster-scoped dependencies are only included when cluster-scoped resources
+	// aren't explicitly included or excluded
+	if boolptr.IsSetToTrue(request.Spec.IncludeClusterDependencies) && boolptr.IsSetToFalse(request.Spec.IncludeClusterResources) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, "includeClusterDependencies can't be set when includeClusterResources is false")
+	}
+
+	// validate the transforms
+	for _, err := range pkgbackup.ValidateTransforms(request.Spec.Transforms) {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, fmt.Sprintf("Invalid transform: %v", err))
+	}
+
 	return request
 }
 
@@ -644,14 +735,63 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return errors.Errorf("backup already exists in object storage")
 	}
 
+	if exceeded, message := storage.QuotaExceeded(backup.StorageLocation); exceeded {
+		backupLog.Warn(message)
+	}
+
+	if primary := backup.Annotations[velerov1api.FailoverFromAnnotation]; primary != "" {
+		backupLog.Warnf("Backup storage location %s is unavailable, storing the backup in its fallback location %s", primary, backup.StorageLocation.Name)
+	}
+
+	if c.checkpointInterval > 0 {
+		if backup.Status.ResumeCount > 0 {
+			backup.ResumeFrom = c.resumeCheckpoint(backup, backupStore, backupLog)
+		}
+
+		backup.CheckpointInterval = c.checkpointInterval
+		backup.SaveCheckpoint = func(checkpoint *pkgbackup.Checkpoint) error {
+			buf := new(bytes.Buffer)
+			if err := pkgbackup.EncodeCheckpoint(checkpoint, buf); err != nil {
+				return err
+			}
+			return backupStore.PutBackupCheckpoint(backup.Name, buf)
+		}
+		// the checkpoint is only needed while the backup is running
+		defer func() {
+			if err := backupStore.DeleteBackupCheckpoint(backup.Name); err != nil {
+				c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("Error deleting backup checkpoint")
+			}
+		}()
+	}
+
 	backupItemActionsResolver := framework.NewBackupItemActionResolver(actions)
 	itemSnapshottersResolver := framework.NewItemSnapshotterResolver(itemSnapshotters)
 
+	// Stop the backup if it is canceled while it's running.
+	ctx, cancelBackup := withCancelRequest(context.Background(), cancelPollInterval, func() bool {
+		current, err := c.lister.Backups(backup.Namespace).Get(backup.Name)
+		return err == nil && current.Spec.Cancel
+	})
+	defer cancelBackup()
+	backup.Context = ctx
+
 	var fatalErrs []error
 	if err := c.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver,
 		itemSnapshottersResolver, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
+	for _, path := range backup.NamespaceArchives {
+		defer removeFile(path, backupLog)
+	}
+
+	if ctx.Err() != nil {
+		backupLog.Info("Backup was canceled, cleaning up")
+		c.cleanUpCanceledBackup(backup, pluginManager, backupLog)
+
+		backup.Status.Phase = velerov1api.BackupPhaseCanceled
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		return errCanceled
+	}
 
 	// Empty slices here so that they can be passed in to the persistBackup call later, regardless of whether or not CSI's enabled.
 	// This way, we only make the Lister call if the feature flag's on.
@@ -675,6 +815,18 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 			}
 
 			backup.CSISnapshots = volumeSnapshots
+
+			if boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData) {
+				backup.DataUploads = c.moveSnapshotData(ctx, backup, volumeSnapshots, backupLog)
+				if ctx.Err() != nil {
+					backupLog.Info("Backup was canceled, cleaning up")
+					c.cleanUpCanceledBackup(backup, pluginManager, backupLog)
+
+					backup.Status.Phase = velerov1api.BackupPhaseCanceled
+					backup.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+					return errCanceled
+				}
+			}
 		}
 
 		if c.volumeSnapshotContentLister != nil {
@@ -724,6 +876,13 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		}
 	}
 
+	backup.Status.DataUploadsAttempted = len(backup.DataUploads)
+	for _, du := range backup.DataUploads {
+		if du.Status.Phase == velerov1api.DataUploadPhaseCompleted {
+			backup.Status.DataUploadsCompleted++
+		}
+	}
+
 	backup.Status.Warnings = logCounter.GetCount(logrus.WarnLevel)
 	backup.Status.Errors = logCounter.GetCount(logrus.ErrorLevel)
 
@@ -754,8 +913,22 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 		return err
 	}
 
+	// the lock is recorded before the backup is persisted, so that its metadata has it
+	backup.Status.ObjectLock = backupObjectLock(backup.StorageLocation, backup.Backup)
+
 	if errs := persistBackup(backup, backupFile, logFile, backupStore, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)), volumeSnapshots, volumeSnapshotContents, volumeSnapshotClasses); len(errs) > 0 {
 		fatalErrs = append(fatalErrs, errs...)
+	} else if err := lockBackup(backupStore, backup.StorageLocation, backup.Name, backup.Status.ObjectLock, c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup))); err != nil {
+		fatalErrs = append(fatalErrs, err)
+
+		// the metadata is locked last, so it's still replaced to record that the backup failed,
+		// rather than be synced as completed. The objects that were locked stay locked.
+		backup.Status.Phase = velerov1api.BackupPhaseFailed
+		backup.Status.FailureReason = err.Error()
+		backup.Status.ObjectLock = nil
+		if err := putBackupMetadata(backup.Backup, backupStore); err != nil {
+			c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Error("Error updating the metadata of the failed backup")
+		}
 	}
 
 	c.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Backup completed")
@@ -765,6 +938,172 @@ func (c *backupController) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// backupObjectLock returns the lock that protects the objects of a backup stored in a location,
+// or nil if the location isn't immutable, or the backup failed or doesn't expire.
+func backupObjectLock(location *velerov1api.BackupStorageLocation, backup *velerov1api.Backup) *velerov1api.BackupObjectLock {
+	immutability := location.Spec.Immutability
+	if immutability == nil || backup.Status.Phase == velerov1api.BackupPhaseFailed || backup.Status.Expiration == nil {
+		return nil
+	}
+
+	mode := immutability.Mode
+	if mode == "" {
+		mode = velerov1api.ObjectLockModeGovernance
+	}
+	return &velerov1api.BackupObjectLock{
+		Mode:        mode,
+		RetainUntil: backup.Status.Expiration.DeepCopy(),
+		LegalHold:   immutability.LegalHold,
+	}
+}
+
+// lockBackup protects the objects of a backup in an immutable location from being deleted or
+// overwritten with lock, which is nil if they aren't protected.
+func lockBackup(backupStore persistence.BackupStore, location *velerov1api.BackupStorageLocation, name string, lock *velerov1api.BackupObjectLock, log logrus.FieldLogger) error {
+	if lock == nil {
+		return nil
+	}
+
+	retention := velero.ObjectRetention{
+		Mode:        string(lock.Mode),
+		RetainUntil: lock.RetainUntil.Time,
+		LegalHold:   lock.LegalHold,
+	}
+
+	log.WithFields(logrus.Fields{
+		"mode":        lock.Mode,
+		"retainUntil": retention.RetainUntil,
+		"legalHold":   retention.LegalHold,
+	}).Info("Locking the objects of the backup")
+	if err := backupStore.LockBackup(name, retention); err != nil {
+		if errors.Is(err, velero.ErrObjectLockNotSupported) {
+			return errors.Errorf("backup storage location %s is immutable, but its object store doesn't support object lock", location.Name)
+		}
+		return errors.Wrap(err, "error locking the objects of the backup")
+	}
+	return nil
+}
+
+// putBackupMetadata replaces the metadata of a backup in a backup store.
+func putBackupMetadata(backup *velerov1api.Backup, backupStore persistence.BackupStore) error {
+	backupJSON := new(bytes.Buffer)
+	if err := encode.EncodeTo(backup, "json", backupJSON); err != nil {
+		return errors.Wrap(err, "error encoding backup")
+	}
+	return backupStore.PutBackupMetadata(backup.Name, backupJSON)
+}
+
+// cleanUpCanceledBackup cancels the backup's unfinished pod volume backups and deletes the
+// volume snapshots it has taken. Errors are logged to log, since there's nothing more to do
+// about them than let the user know.
+func (c *backupController) cleanUpCanceledBackup(backup *pkgbackup.Request, pluginManager clientmgmt.Manager, log logrus.FieldLogger) {
+	c.cancelPodVolumeBackups(backup.Backup, log)
+
+	volumeSnapshotters := make(map[string]velero.VolumeSnapshotter)
+	for _, snapshot := range backup.VolumeSnapshots {
+		if snapshot.Status.ProviderSnapshotID == "" {
+			continue
+		}
+
+		volumeSnapshotter, ok := volumeSnapshotters[snapshot.Spec.Location]
+		if !ok {
+			var err error
+			if volumeSnapshotter, err = volumeSnapshottersForVSL(context.Background(), backup.Namespace, snapshot.Spec.Location, c.kbClient, pluginManager); err != nil {
+				log.WithError(err).Error("Error getting volume snapshotter to delete snapshots")
+				continue
+			}
+			volumeSnapshotters[snapshot.Spec.Location] = volumeSnapshotter
+		}
+
+		log.Infof("Deleting snapshot %s", snapshot.Status.ProviderSnapshotID)
+		if err := volumeSnapshotter.DeleteSnapshot(snapshot.Status.ProviderSnapshotID); err != nil {
+			log.WithError(err).Errorf("Error deleting snapshot %s", snapshot.Status.ProviderSnapshotID)
+		}
+	}
+
+	if features.IsEnabled(velerov1api.CSIFeatureFlag) && c.volumeSnapshotLister != nil {
+		// VolumeSnapshots are deleted along with their VolumeSnapshotContents, and so the
+		// storage snapshots, unless their class retains them.
+		volumeSnapshots, err := c.volumeSnapshotLister.List(label.NewSelectorForBackup(backup.Name))
+		if err != nil {
+			log.WithError(err).Error("Error listing CSI volume snapshots to delete")
+		}
+		for _, vs := range volumeSnapshots {
+			log.Infof("Deleting CSI volume snapshot %s/%s", vs.Namespace, vs.Name)
+			if err := c.volumeSnapshotClient.SnapshotV1().VolumeSnapshots(vs.Namespace).Delete(context.TODO(), vs.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				log.WithError(err).Errorf("Error deleting CSI volume snapshot %s/%s", vs.Namespace, vs.Name)
+			}
+		}
+	}
+}
+
+// cancelPodVolumeBackups cancels the backup's pod volume backups that haven't finished.
+func (c *backupController) cancelPodVolumeBackups(backup *velerov1api.Backup, log logrus.FieldLogger) {
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := c.kbClient.List(context.Background(), pvbs, kbclient.InNamespace(backup.Namespace), kbclient.MatchingLabels{
+		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+	}); err != nil {
+		log.WithError(err).Error("Error listing pod volume backups to cancel")
+	}
+	for i := range pvbs.Items {
+		pvb := &pvbs.Items[i]
+		switch pvb.Status.Phase {
+		case velerov1api.PodVolumeBackupPhaseCompleted, velerov1api.PodVolumeBackupPhaseFailed, velerov1api.PodVolumeBackupPhaseCanceled:
+			continue
+		}
+
+		log.Infof("Canceling pod volume backup %s", pvb.Name)
+		original := pvb.DeepCopy()
+		pvb.Spec.Cancel = true
+		if err := c.kbClient.Patch(context.Background(), pvb, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(err).Errorf("Error canceling pod volume backup %s", pvb.Name)
+		}
+	}
+}
+
+// resumeCheckpoint returns the checkpoint saved by the interrupted run of backup, with the
+// pod volume backups that completed after it was saved added to it. The interrupted run's pod
+// volume backups that are still running are canceled, since they'll be taken again.
+func (c *backupController) resumeCheckpoint(backup *pkgbackup.Request, backupStore persistence.BackupStore, log logrus.FieldLogger) *pkgbackup.Checkpoint {
+	checkpoint := &pkgbackup.Checkpoint{}
+
+	res, err := backupStore.GetBackupCheckpoint(backup.Name)
+	if err != nil {
+		log.WithError(err).Warn("Error getting backup checkpoint, backing up all pod volumes and volume snapshots again")
+	} else if res == nil {
+		log.Info("No checkpoint found for the backup, backing up all pod volumes and volume snapshots again")
+	} else {
+		defer res.Close()
+		if saved, err := pkgbackup.DecodeCheckpoint(res); err != nil {
+			log.WithError(err).Warn("Error reading backup checkpoint, backing up all pod volumes and volume snapshots again")
+		} else {
+			checkpoint = saved
+		}
+	}
+
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := c.kbClient.List(context.Background(), pvbs, kbclient.InNamespace(backup.Namespace), kbclient.MatchingLabels{
+		velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+	}); err != nil {
+		log.WithError(err).Warn("Error listing pod volume backups of the interrupted backup")
+	}
+
+	checkpointed := sets.NewString()
+	for _, pvb := range checkpoint.PodVolumeBackups {
+		checkpointed.Insert(pvb.Name)
+	}
+	for i := range pvbs.Items {
+		pvb := &pvbs.Items[i]
+		if pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted && pvb.Status.SnapshotID != "" && !checkpointed.Has(pvb.Name) {
+			checkpoint.PodVolumeBackups = append(checkpoint.PodVolumeBackups, pvb)
+		}
+	}
+
+	c.cancelPodVolumeBackups(backup.Backup, log)
+
+	return checkpoint
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -835,11 +1174,39 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	// the data uploads file is only written for backups that moved snapshot data
+	var dataUploads io.Reader
+	if len(backup.DataUploads) > 0 {
+		dataUploadsJSON, errs := encodeToJSONGzip(backup.DataUploads, "data uploads list")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		} else {
+			dataUploads = dataUploadsJSON
+		}
+	}
+
 	backupResourceList, errs := encodeToJSONGzip(backup.BackupResourceList(), "backup resources list")
 	if errs != nil {
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	// the index and archives of the namespaces, if the backup is in the split archive format
+	var index io.Reader
+	var namespaceContents map[string]io.Reader
+	if backup.ArchiveIndex != nil {
+		indexJSON, errs := encodeToJSONGzip(backup.ArchiveIndex, "archive index")
+		if errs != nil {
+			persistErrs = append(persistErrs, errs...)
+		} else {
+			index = indexJSON
+		}
+
+		namespaceContents = make(map[string]io.Reader, len(backup.NamespaceArchives))
+		for namespace, path := range backup.NamespaceArchives {
+			namespaceContents[namespace] = &fileReader{path: path}
+		}
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -849,6 +1216,9 @@ func persistBackup(backup *pkgbackup.Request,
 		csiSnapshotJSON = nil
 		csiSnapshotContentsJSON = nil
 		csiSnapshotClassesJSON = nil
+		dataUploads = nil
+		index = nil
+		namespaceContents = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -862,6 +1232,10 @@ func persistBackup(backup *pkgbackup.Request,
 		CSIVolumeSnapshots:        csiSnapshotJSON,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 		CSIVolumeSnapshotClasses:  csiSnapshotClassesJSON,
+		DataUploads:               dataUploads,
+		Index:                     index,
+		NamespaceContents:         namespaceContents,
+		Compression:               backup.Status.Compression,
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
@@ -883,6 +1257,42 @@ func closeAndRemoveFile(file *os.File, log logrus.FieldLogger) {
 	}
 }
 
+func removeFile(path string, log logrus.FieldLogger) {
+	if err := os.Remove(path); err != nil {
+		log.WithError(err).WithField("file", path).Error("error removing file")
+	}
+}
+
+// fileReader reads the file at path, which it only opens when it's first read and closes once
+// it's read to the end, so that a backup's namespace archives aren't all open while they're
+// uploaded.
+type fileReader struct {
+	path string
+	file *os.File
+	done bool
+}
+
+func (r *fileReader) Read(p []byte) (int, error) {
+	if r.done {
+		return 0, io.EOF
+	}
+	if r.file == nil {
+		file, err := os.Open(r.path)
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		r.file = file
+	}
+
+	n, err := r.file.Read(p)
+	if err != nil {
+		r.file.Close()
+		r.file = nil
+		r.done = true
+	}
+	return n, err
+}
+
 // encodeToJSONGzip takes arbitrary Go data and encodes it to GZip compressed JSON in a buffer, as well as a description of the data to put into an error should encoding fail.
 func encodeToJSONGzip(data interface{}, desc string) (*bytes.Buffer, []error) {
 	buf := new(bytes.Buffer)
@@ -940,6 +1350,99 @@ func (c *backupController) checkVolumeSnapshotReadyToUse(ctx context.Context, vo
 	return eg.Wait()
 }
 
+// moveSnapshotData creates a DataUpload for each of the backup's ready VolumeSnapshots, with
+// which the node agents move the snapshot data to the backup repository, and waits for them to
+// finish. Unfinished DataUploads are canceled when the backup is canceled or they time out.
+// It returns the DataUploads in their final state.
+func (c *backupController) moveSnapshotData(ctx context.Context, backup *pkgbackup.Request,
+	volumeSnapshots []*snapshotv1api.VolumeSnapshot, log logrus.FieldLogger) []*velerov1api.DataUpload {
+	var dataUploads []*velerov1api.DataUpload
+	for _, vs := range volumeSnapshots {
+		if vs.Status == nil || !boolptr.IsSetToTrue(vs.Status.ReadyToUse) || vs.Spec.Source.PersistentVolumeClaimName == nil {
+			log.Errorf("Unable to move the data of volume snapshot %s/%s, it isn't a ready snapshot of a persistent volume claim", vs.Namespace, vs.Name)
+			continue
+		}
+
+		du := &velerov1api.DataUpload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    backup.Namespace,
+				GenerateName: backup.Name + "-",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: velerov1api.SchemeGroupVersion.String(),
+						Kind:       "Backup",
+						Name:       backup.Name,
+						UID:        backup.UID,
+						Controller: boolptr.True(),
+					},
+				},
+				Labels: map[string]string{
+					velerov1api.BackupNameLabel: label.GetValidName(backup.Name),
+					velerov1api.BackupUIDLabel:  string(backup.UID),
+				},
+			},
+			Spec: velerov1api.DataUploadSpec{
+				VolumeSnapshot:        vs.Name,
+				SourcePVC:             *vs.Spec.Source.PersistentVolumeClaimName,
+				SourceNamespace:       vs.Namespace,
+				BackupStorageLocation: backup.Spec.StorageLocation,
+			},
+		}
+		if err := c.kbClient.Create(ctx, du); err != nil {
+			log.WithError(err).Errorf("Error creating data upload for volume snapshot %s/%s", vs.Namespace, vs.Name)
+			continue
+		}
+		dataUploads = append(dataUploads, du)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, c.dataUploadTimeout)
+	defer cancel()
+	err := wait.PollImmediateUntil(dataUploadPollInterval, func() (bool, error) {
+		done := true
+		for _, du := range dataUploads {
+			if dataUploadFinished(du) {
+				continue
+			}
+			if err := c.kbClient.Get(waitCtx, kbclient.ObjectKeyFromObject(du), du); err != nil {
+				log.WithError(err).Warnf("Error getting data upload %s", du.Name)
+			}
+			if !dataUploadFinished(du) {
+				done = false
+			}
+		}
+		return done, nil
+	}, waitCtx.Done())
+	if err != nil {
+		log.Warn("Canceling the data uploads that didn't finish")
+		for _, du := range dataUploads {
+			if dataUploadFinished(du) {
+				continue
+			}
+			original := du.DeepCopy()
+			du.Spec.Cancel = true
+			if err := c.kbClient.Patch(context.Background(), du, kbclient.MergeFrom(original)); err != nil {
+				log.WithError(err).Errorf("Error canceling data upload %s", du.Name)
+			}
+		}
+	}
+
+	for _, du := range dataUploads {
+		if du.Status.Phase != velerov1api.DataUploadPhaseCompleted {
+			log.Errorf("Data upload %s of volume snapshot %s/%s didn't complete: phase %q, %s", du.Name, du.Spec.SourceNamespace, du.Spec.VolumeSnapshot, du.Status.Phase, du.Status.Message)
+		}
+	}
+
+	return dataUploads
+}
+
+func dataUploadFinished(du *velerov1api.DataUpload) bool {
+	switch du.Status.Phase {
+	case velerov1api.DataUploadPhaseCompleted, velerov1api.DataUploadPhaseFailed, velerov1api.DataUploadPhaseCanceled:
+		return true
+	}
+	return false
+}
+
 // deleteVolumeSnapshot delete VolumeSnapshot created during backup.
 // This is used to avoid deleting namespace in cluster triggers the VolumeSnapshot deletion,
 // which will cause snapshot deletion on cloud provider, then backup cannot restore the PV.
@@ -21,18 +21,22 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 
 	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/version"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
@@ -172,6 +177,19 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: builder.ForBackupStorageLocation("velero", "read-only").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
 			expectedErrs:   []string{"backup can't be created because backup storage location read-only is currently in read-only mode"},
 		},
+		{
+			name:   "backup for a backup location over its quota fails validation",
+			backup: defaultBackup().StorageLocation("over-quota").Result(),
+			backupLocation: func() *velerov1api.BackupStorageLocation {
+				location := builder.ForBackupStorageLocation("velero", "over-quota").Result()
+				limit := resource.MustParse("1Ki")
+				location.Spec.Quota = &velerov1api.BackupStorageLocationQuota{Limit: &limit}
+				used := int64(2048)
+				location.Status.Usage = &velerov1api.BackupStorageLocationUsage{TotalBytes: &used}
+				return location
+			}(),
+			expectedErrs: []string{"backup can't be created because backup storage location over-quota exceeds its quota: 2Ki of 1Ki used"},
+		},
 		{
 			name: "labelSelector as well as orLabelSelectors both are specified in backup request fails validation",
 			backup: defaultBackup().LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).OrLabelSelector([]*metav1.LabelSelector{{MatchLabels: map[string]string{"a1": "b1"}}, {MatchLabels: map[string]string{"a2": "b2"}},
@@ -179,6 +197,12 @@ func TestProcessBackupValidationFailures(t *testing.T) {
 			backupLocation: defaultBackupLocation,
 			expectedErrs:   []string{"encountered labelSelector as well as orLabelSelectors in backup spec, only one can be specified"},
 		},
+		{
+			name:           "moving snapshot data without the CSI feature fails validation",
+			backup:         defaultBackup().SnapshotMoveData(true).Result(),
+			backupLocation: defaultBackupLocation,
+			expectedErrs:   []string{"snapshot data can only be moved when the EnableCSI feature is enabled"},
+		},
 	}
 
 	for _, test := range tests {
@@ -287,6 +311,508 @@ func TestBackupLocationLabel(t *testing.T) {
 	}
 }
 
+func TestBackupLocationPlacement(t *testing.T) {
+	teamA := &velerov1api.BackupStorageLocationPlacement{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		Weight:            10,
+	}
+
+	tests := []struct {
+		name                     string
+		backup                   *velerov1api.Backup
+		locations                []*velerov1api.BackupStorageLocation
+		expectedBackupLocation   string
+		expectedReason           string
+		expectedValidationErrors []string
+	}{
+		{
+			name:                   "backup specifying a location keeps it",
+			backup:                 defaultBackup().StorageLocation("team-a").IncludedNamespaces("ns-2").Result(),
+			expectedBackupLocation: "team-a",
+		},
+		{
+			name:                   "backup matching placement rules gets the matching location",
+			backup:                 defaultBackup().IncludedNamespaces("ns-1").Result(),
+			expectedBackupLocation: "team-a",
+			expectedReason:         `placement rules of default backup storage location team-a matched: namespace selector "team=a"; chosen by weight (10) and name among 2 matching default locations`,
+		},
+		{
+			name:                   "backup not matching placement rules gets the default location without rules",
+			backup:                 defaultBackup().IncludedNamespaces("ns-2").Result(),
+			expectedBackupLocation: "default",
+			expectedReason:         "default backup storage location default",
+		},
+		{
+			name:   "backup not matching any placement rules gets the server default without rules",
+			backup: defaultBackup().IncludedNamespaces("ns-2").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Result(),
+				builder.ForBackupStorageLocation("velero", "team-a").Default(true).Placement(teamA).Result(),
+			},
+			expectedBackupLocation: "default",
+			expectedReason:         "server default backup storage location",
+		},
+		{
+			name:   "backup excluded by the placement rules of the server default fails validation",
+			backup: defaultBackup().IncludedNamespaces("ns-2").Result(),
+			locations: []*velerov1api.BackupStorageLocation{
+				builder.ForBackupStorageLocation("velero", "default").Placement(teamA).Result(),
+			},
+			expectedBackupLocation: "default",
+			expectedReason:         "server default backup storage location",
+			expectedValidationErrors: []string{
+				"no default backup storage location's placement rules match the backup, including the server default 'default'. Please specify a backup storage location (see `velero backup-location -h` for options) and create a new backup.",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+
+			var (
+				clientset       = fake.NewSimpleClientset(test.backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+			)
+
+			locations := test.locations
+			if locations == nil {
+				locations = []*velerov1api.BackupStorageLocation{
+					builder.ForBackupStorageLocation("velero", "default").Default(true).Result(),
+					builder.ForBackupStorageLocation("velero", "team-a").Default(true).Placement(teamA).Result(),
+				}
+			}
+			objs := []runtime.Object{
+				builder.ForNamespace("ns-1").ObjectMeta(builder.WithLabels("team", "a")).Result(),
+				builder.ForNamespace("ns-2").ObjectMeta(builder.WithLabels("team", "b")).Result(),
+			}
+			for _, location := range locations {
+				objs = append(objs, location)
+			}
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, objs...)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               fakeClient,
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  "default",
+				clock:                  &clock.RealClock{},
+				formatFlag:             formatFlag,
+			}
+
+			res := c.prepareBackupRequest(test.backup)
+			require.NotNil(t, res)
+			assert.Equal(t, test.expectedValidationErrors, res.Status.ValidationErrors)
+			assert.Equal(t, test.expectedBackupLocation, res.Spec.StorageLocation)
+			require.NotNil(t, res.StorageLocation)
+			assert.Equal(t, test.expectedBackupLocation, res.StorageLocation.Name)
+			assert.Equal(t, test.expectedReason, res.Annotations[velerov1api.StorageLocationReasonAnnotation])
+		})
+	}
+}
+
+func TestBackupLocationFailover(t *testing.T) {
+	tests := []struct {
+		name                   string
+		primaryPhase           velerov1api.BackupStorageLocationPhase
+		fallbackPhase          velerov1api.BackupStorageLocationPhase
+		expectedBackupLocation string
+		expectedFailoverFrom   string
+	}{
+		{
+			name:                   "backup for an available location is stored in it",
+			primaryPhase:           velerov1api.BackupStorageLocationPhaseAvailable,
+			fallbackPhase:          velerov1api.BackupStorageLocationPhaseAvailable,
+			expectedBackupLocation: "primary",
+		},
+		{
+			name:                   "backup for an unavailable location is stored in its fallback",
+			primaryPhase:           velerov1api.BackupStorageLocationPhaseUnavailable,
+			fallbackPhase:          velerov1api.BackupStorageLocationPhaseAvailable,
+			expectedBackupLocation: "secondary",
+			expectedFailoverFrom:   "primary",
+		},
+		{
+			name:                   "backup for an unavailable location with an unavailable fallback is stored in it",
+			primaryPhase:           velerov1api.BackupStorageLocationPhaseUnavailable,
+			fallbackPhase:          velerov1api.BackupStorageLocationPhaseUnavailable,
+			expectedBackupLocation: "primary",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+			backup := defaultBackup().StorageLocation("primary").Result()
+
+			var (
+				clientset       = fake.NewSimpleClientset(backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+				fakeClient      = velerotest.NewFakeControllerRuntimeClient(t,
+					builder.ForBackupStorageLocation("velero", "primary").Fallback("secondary", true).Phase(test.primaryPhase).Result(),
+					builder.ForBackupStorageLocation("velero", "secondary").Phase(test.fallbackPhase).Result(),
+				)
+			)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               fakeClient,
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  "default",
+				clock:                  &clock.RealClock{},
+				formatFlag:             formatFlag,
+			}
+
+			res := c.prepareBackupRequest(backup)
+			require.NotNil(t, res)
+			assert.Empty(t, res.Status.ValidationErrors)
+			assert.Equal(t, test.expectedBackupLocation, res.Spec.StorageLocation)
+			require.NotNil(t, res.StorageLocation)
+			assert.Equal(t, test.expectedBackupLocation, res.StorageLocation.Name)
+			assert.Equal(t, test.expectedBackupLocation, res.Labels[velerov1api.StorageLocationLabel])
+			assert.Equal(t, test.expectedFailoverFrom, res.Annotations[velerov1api.FailoverFromAnnotation])
+		})
+	}
+}
+
+func TestLockBackup(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		immutability      *velerov1api.BackupStorageLocationImmutability
+		phase             velerov1api.BackupPhase
+		lockErr           error
+		expectedRetention *velero.ObjectRetention
+		expectedLock      *velerov1api.BackupObjectLock
+		expectedErr       string
+	}{
+		{
+			name:  "backup in a mutable location isn't locked",
+			phase: velerov1api.BackupPhaseCompleted,
+		},
+		{
+			name:         "failed backup isn't locked",
+			immutability: &velerov1api.BackupStorageLocationImmutability{},
+			phase:        velerov1api.BackupPhaseFailed,
+		},
+		{
+			name:              "backup in an immutable location is locked until it expires",
+			immutability:      &velerov1api.BackupStorageLocationImmutability{},
+			phase:             velerov1api.BackupPhaseCompleted,
+			expectedRetention: &velero.ObjectRetention{Mode: velero.ObjectRetentionModeGovernance, RetainUntil: expiration},
+			expectedLock:      &velerov1api.BackupObjectLock{Mode: velerov1api.ObjectLockModeGovernance, RetainUntil: &metav1.Time{Time: expiration}},
+		},
+		{
+			name:              "backup in an immutable location with a legal hold is locked in compliance mode",
+			immutability:      &velerov1api.BackupStorageLocationImmutability{Mode: velerov1api.ObjectLockModeCompliance, LegalHold: true},
+			phase:             velerov1api.BackupPhasePartiallyFailed,
+			expectedRetention: &velero.ObjectRetention{Mode: velero.ObjectRetentionModeCompliance, RetainUntil: expiration, LegalHold: true},
+			expectedLock:      &velerov1api.BackupObjectLock{Mode: velerov1api.ObjectLockModeCompliance, RetainUntil: &metav1.Time{Time: expiration}, LegalHold: true},
+		},
+		{
+			name:              "backup in an immutable location without object lock support fails",
+			immutability:      &velerov1api.BackupStorageLocationImmutability{},
+			phase:             velerov1api.BackupPhaseCompleted,
+			lockErr:           velero.ErrObjectLockNotSupported,
+			expectedRetention: &velero.ObjectRetention{Mode: velero.ObjectRetentionModeGovernance, RetainUntil: expiration},
+			expectedLock:      &velerov1api.BackupObjectLock{Mode: velerov1api.ObjectLockModeGovernance, RetainUntil: &metav1.Time{Time: expiration}},
+			expectedErr:       "backup storage location loc-1 is immutable, but its object store doesn't support object lock",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Result()
+			location.Spec.Immutability = test.immutability
+			backup := defaultBackup().Phase(test.phase).Expiration(expiration).Result()
+
+			lock := backupObjectLock(location, backup)
+			assert.Equal(t, test.expectedLock, lock)
+
+			backupStore := &persistencemocks.BackupStore{}
+			if test.expectedRetention != nil {
+				backupStore.On("LockBackup", backup.Name, *test.expectedRetention).Return(test.lockErr)
+			}
+
+			err := lockBackup(backupStore, location, backup.Name, lock, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcessBackupLocksBackup(t *testing.T) {
+	tests := []struct {
+		name                 string
+		lockErr              error
+		expectedPhase        velerov1api.BackupPhase
+		expectedFailedUpdate bool
+	}{
+		{
+			name:          "the lock of a backup is in its persisted metadata",
+			expectedPhase: velerov1api.BackupPhaseCompleted,
+		},
+		{
+			name:                 "the persisted metadata of a backup that can't be locked is updated to failed",
+			lockErr:              errors.New("lock failed"),
+			expectedPhase:        velerov1api.BackupPhaseFailed,
+			expectedFailedUpdate: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formatFlag := logging.FormatText
+			backup := defaultBackup().StorageLocation("immutable").Result()
+			location := builder.ForBackupStorageLocation("velero", "immutable").Bucket("store-1").Result()
+			location.Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{Mode: velerov1api.ObjectLockModeCompliance}
+
+			var (
+				clientset       = fake.NewSimpleClientset(backup)
+				sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+				logger          = logging.DefaultLogger(logrus.DebugLevel, formatFlag)
+				pluginManager   = new(pluginmocks.Manager)
+				backupStore     = new(persistencemocks.BackupStore)
+				backupper       = new(fakeBackupper)
+			)
+
+			apiServer := velerotest.NewAPIServer(t)
+			discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+			require.NoError(t, err)
+
+			c := &backupController{
+				genericController:      newGenericController("backup-test", logger),
+				discoveryHelper:        discoveryHelper,
+				client:                 clientset.VeleroV1(),
+				lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+				kbClient:               velerotest.NewFakeControllerRuntimeClient(t, location),
+				snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+				defaultBackupLocation:  location.Name,
+				backupTracker:          NewBackupTracker(),
+				metrics:                metrics.NewServerMetrics(),
+				clock:                  clock.NewFakeClock(time.Now()),
+				newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+				backupper:              backupper,
+				formatFlag:             formatFlag,
+			}
+
+			pluginManager.On("GetBackupItemActions").Return(nil, nil)
+			pluginManager.On("CleanupClients").Return(nil)
+			pluginManager.On("GetItemSnapshotters").Return(nil, nil)
+			backupper.On("BackupWithResolvers", mock.Anything, mock.Anything, mock.Anything, framework.BackupItemActionResolver{}, framework.ItemSnapshotterResolver{}, pluginManager).Return(nil)
+			backupStore.On("BackupExists", "store-1", backup.Name).Return(false, nil)
+
+			hasObjectLock := func(info persistence.BackupInfo) bool {
+				buf := new(bytes.Buffer)
+				buf.ReadFrom(info.Metadata)
+				return strings.Contains(buf.String(), `"mode": "Compliance"`)
+			}
+			backupStore.On("PutBackup", mock.MatchedBy(hasObjectLock)).Return(nil)
+			backupStore.On("LockBackup", backup.Name, mock.Anything).Return(test.lockErr)
+
+			isFailed := func(metadata io.Reader) bool {
+				buf := new(bytes.Buffer)
+				buf.ReadFrom(metadata)
+				return strings.Contains(buf.String(), `"phase": "Failed"`) && !strings.Contains(buf.String(), `"objectLock"`)
+			}
+			if test.expectedFailedUpdate {
+				backupStore.On("PutBackupMetadata", backup.Name, mock.MatchedBy(isFailed)).Return(nil)
+			}
+
+			require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+			require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)))
+
+			res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPhase, res.Status.Phase)
+			backupStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResumeCheckpoint(t *testing.T) {
+	podVolumeBackup := func(name string, phase velerov1api.PodVolumeBackupPhase, snapshotID string) *velerov1api.PodVolumeBackup {
+		return builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")).
+			Phase(phase).
+			SnapshotID(snapshotID).
+			Result()
+	}
+
+	checkpoint := &pkgbackup.Checkpoint{
+		BackedUpItems: []pkgbackup.CheckpointItem{{
+			Resource:  "v1/Pod",
+			Namespace: "ns-1",
+			Name:      "pod-1",
+			Paths:     []string{"resources/pods/namespaces/ns-1/pod-1.json"},
+			Content:   []byte(`{"kind":"Pod"}`),
+		}},
+		PodVolumeBackups: []*velerov1api.PodVolumeBackup{podVolumeBackup("pvb-1", velerov1api.PodVolumeBackupPhaseCompleted, "snap-1")},
+	}
+	encoded := new(bytes.Buffer)
+	require.NoError(t, pkgbackup.EncodeCheckpoint(checkpoint, encoded))
+
+	tests := []struct {
+		name               string
+		checkpoint         []byte
+		expectedItems      []string
+		expectedSnapshotID []string
+	}{
+		{
+			name:               "checkpoint is resumed with the pod volume backups completed after it",
+			checkpoint:         encoded.Bytes(),
+			expectedItems:      []string{"pod-1"},
+			expectedSnapshotID: []string{"snap-1", "snap-2"},
+		},
+		{
+			name:               "missing checkpoint resumes the completed pod volume backups",
+			expectedSnapshotID: []string{"snap-1", "snap-2"},
+		},
+		{
+			name:               "invalid checkpoint resumes the completed pod volume backups",
+			checkpoint:         []byte("not a checkpoint"),
+			expectedSnapshotID: []string{"snap-1", "snap-2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t,
+				podVolumeBackup("pvb-1", velerov1api.PodVolumeBackupPhaseCompleted, "snap-1"),
+				podVolumeBackup("pvb-2", velerov1api.PodVolumeBackupPhaseCompleted, "snap-2"),
+				podVolumeBackup("pvb-3", velerov1api.PodVolumeBackupPhaseInProgress, ""),
+			)
+			c := &backupController{genericController: newGenericController("backup-test", velerotest.NewLogger()), kbClient: fakeClient}
+
+			backupStore := &persistencemocks.BackupStore{}
+			if test.checkpoint != nil {
+				backupStore.On("GetBackupCheckpoint", "backup-1").Return(ioutil.NopCloser(bytes.NewReader(test.checkpoint)), nil)
+			} else {
+				backupStore.On("GetBackupCheckpoint", "backup-1").Return(nil, nil)
+			}
+
+			backup := &pkgbackup.Request{Backup: defaultBackup().Result()}
+			resumed := c.resumeCheckpoint(backup, backupStore, velerotest.NewLogger())
+
+			var items, snapshotIDs []string
+			for _, item := range resumed.BackedUpItems {
+				items = append(items, item.Name)
+			}
+			for _, pvb := range resumed.PodVolumeBackups {
+				snapshotIDs = append(snapshotIDs, pvb.Status.SnapshotID)
+			}
+			assert.Equal(t, test.expectedItems, items)
+			assert.Equal(t, test.expectedSnapshotID, snapshotIDs)
+
+			// the pod volume backup that was still running is canceled
+			pvb := &velerov1api.PodVolumeBackup{}
+			require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "pvb-3"}, pvb))
+			assert.True(t, pvb.Spec.Cancel)
+		})
+	}
+}
+
+func TestProcessBackupResumesFromCheckpoint(t *testing.T) {
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "loc-1").Default(true).Bucket("store-1").Result()
+	backup := defaultBackup().StorageLocation("loc-1").Result()
+	backup.Status.ResumeCount = 1
+
+	checkpoint := &pkgbackup.Checkpoint{
+		BackedUpItems: []pkgbackup.CheckpointItem{{
+			Resource:  "v1/Pod",
+			Namespace: "ns-1",
+			Name:      "pod-1",
+			Paths:     []string{"resources/pods/namespaces/ns-1/pod-1.json"},
+			Content:   []byte(`{"kind":"Pod"}`),
+		}},
+	}
+	encoded := new(bytes.Buffer)
+	require.NoError(t, pkgbackup.EncodeCheckpoint(checkpoint, encoded))
+
+	var (
+		clientset       = fake.NewSimpleClientset(backup)
+		sharedInformers = informers.NewSharedInformerFactory(clientset, 0)
+		logger          = logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)
+		pluginManager   = new(pluginmocks.Manager)
+		backupStore     = new(persistencemocks.BackupStore)
+		backupper       = new(fakeBackupper)
+	)
+
+	apiServer := velerotest.NewAPIServer(t)
+	apiServer.DiscoveryClient.FakedServerVersion = &version.Info{Major: "1", Minor: "16", GitVersion: "v1.16.4"}
+	discoveryHelper, err := discovery.NewHelper(apiServer.DiscoveryClient, logger)
+	require.NoError(t, err)
+
+	c := &backupController{
+		genericController:      newGenericController("backup-test", logger),
+		discoveryHelper:        discoveryHelper,
+		client:                 clientset.VeleroV1(),
+		lister:                 sharedInformers.Velero().V1().Backups().Lister(),
+		kbClient:               velerotest.NewFakeControllerRuntimeClient(t, location),
+		snapshotLocationLister: sharedInformers.Velero().V1().VolumeSnapshotLocations().Lister(),
+		defaultBackupLocation:  location.Name,
+		backupTracker:          NewBackupTracker(),
+		metrics:                metrics.NewServerMetrics(),
+		clock:                  clock.NewFakeClock(time.Now()),
+		newPluginManager:       func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		backupStoreGetter:      NewFakeSingleObjectBackupStoreGetter(backupStore),
+		backupper:              backupper,
+		formatFlag:             logging.FormatText,
+		checkpointInterval:     time.Minute,
+	}
+
+	pluginManager.On("GetBackupItemActions").Return(nil, nil)
+	pluginManager.On("CleanupClients").Return(nil)
+	pluginManager.On("GetItemSnapshotters").Return(nil, nil)
+	backupStore.On("BackupExists", "store-1", backup.Name).Return(false, nil)
+	backupStore.On("GetBackupCheckpoint", backup.Name).Return(ioutil.NopCloser(encoded), nil)
+	backupStore.On("DeleteBackupCheckpoint", backup.Name).Return(nil)
+	backupStore.On("PutBackup", mock.Anything).Return(nil)
+
+	// the backup is run with the checkpoint it's resumed from and saves its own checkpoints
+	isResumed := func(request *pkgbackup.Request) bool {
+		return request.ResumeFrom != nil &&
+			len(request.ResumeFrom.BackedUpItems) == 1 &&
+			request.ResumeFrom.BackedUpItems[0].Name == "pod-1" &&
+			request.SaveCheckpoint != nil &&
+			request.CheckpointInterval == time.Minute
+	}
+	backupper.On("BackupWithResolvers", mock.Anything, mock.MatchedBy(isResumed), mock.Anything, framework.BackupItemActionResolver{}, framework.ItemSnapshotterResolver{}, pluginManager).Return(nil)
+
+	require.NoError(t, sharedInformers.Velero().V1().Backups().Informer().GetStore().Add(backup))
+	require.NoError(t, c.processBackup(fmt.Sprintf("%s/%s", backup.Namespace, backup.Name)))
+
+	backupper.AssertExpectations(t)
+	backupStore.AssertCalled(t, "DeleteBackupCheckpoint", backup.Name)
+
+	res, err := clientset.VeleroV1().Backups(backup.Namespace).Get(context.TODO(), backup.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, velerov1api.BackupPhaseCompleted, res.Status.Phase)
+	assert.Equal(t, 1, res.Status.ResumeCount)
+}
+
 func TestDefaultBackupTTL(t *testing.T) {
 	var (
 		defaultBackupTTL = metav1.Duration{Duration: 24 * 30 * time.Hour}
@@ -385,6 +911,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -496,6 +1023,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -534,6 +1062,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -572,6 +1101,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -610,6 +1140,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -648,6 +1179,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -686,6 +1218,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -725,6 +1258,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -763,6 +1297,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						"velero.io/source-cluster-k8s-major-version": "1",
 						"velero.io/source-cluster-k8s-minor-version": "16",
 						"velero.io/source-cluster-k8s-gitversion":    "v1.16.4",
+						"velero.io/storage-location-reason":          "default backup storage location loc-1",
 					},
 					Labels: map[string]string{
 						"velero.io/storage-location": "loc-1",
@@ -1166,3 +1701,76 @@ func Test_getLastSuccessBySchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestMoveSnapshotData(t *testing.T) {
+	defer func(interval time.Duration) { dataUploadPollInterval = interval }(dataUploadPollInterval)
+	dataUploadPollInterval = 10 * time.Millisecond
+
+	snapshot := func(name string, ready bool) *snapshotv1api.VolumeSnapshot {
+		pvc := "pvc-" + name
+		return &snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: name},
+			Spec: snapshotv1api.VolumeSnapshotSpec{
+				Source: snapshotv1api.VolumeSnapshotSource{PersistentVolumeClaimName: &pvc},
+			},
+			Status: &snapshotv1api.VolumeSnapshotStatus{ReadyToUse: &ready},
+		}
+	}
+
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t)
+	c := &backupController{
+		genericController: newGenericController("backup-test", logging.DefaultLogger(logrus.DebugLevel, logging.FormatText)),
+		kbClient:          fakeClient,
+		dataUploadTimeout: time.Second,
+	}
+	backup := &pkgbackup.Request{
+		Backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").StorageLocation("default").ObjectMeta(builder.WithUID("uid-1")).Result(),
+	}
+
+	// Complete the data upload of the first snapshot, as a node agent would, and leave the other
+	// one to time out.
+	go func() {
+		for {
+			dataUploads := &velerov1api.DataUploadList{}
+			if err := fakeClient.List(context.Background(), dataUploads); err == nil {
+				for i := range dataUploads.Items {
+					du := &dataUploads.Items[i]
+					if du.Spec.VolumeSnapshot == "snapshot-1" {
+						du.Status.Phase = velerov1api.DataUploadPhaseCompleted
+						du.Status.SnapshotID = "snapshot-id-1"
+						_ = fakeClient.Update(context.Background(), du)
+						return
+					}
+				}
+			}
+			time.Sleep(dataUploadPollInterval)
+		}
+	}()
+
+	dataUploads := c.moveSnapshotData(context.Background(), backup, []*snapshotv1api.VolumeSnapshot{
+		snapshot("snapshot-1", true),
+		snapshot("snapshot-2", true),
+		snapshot("snapshot-3", false),
+	}, velerotest.NewLogger())
+
+	require.Len(t, dataUploads, 2)
+
+	completed, unfinished := dataUploads[0], dataUploads[1]
+	assert.Equal(t, "snapshot-1", completed.Spec.VolumeSnapshot)
+	assert.Equal(t, "pvc-snapshot-1", completed.Spec.SourcePVC)
+	assert.Equal(t, "ns-1", completed.Spec.SourceNamespace)
+	assert.Equal(t, "default", completed.Spec.BackupStorageLocation)
+	assert.Equal(t, "backup-1", completed.Labels[velerov1api.BackupNameLabel])
+	assert.Equal(t, "uid-1", completed.Labels[velerov1api.BackupUIDLabel])
+	assert.Equal(t, velerov1api.DataUploadPhaseCompleted, completed.Status.Phase)
+	assert.Equal(t, "snapshot-id-1", completed.Status.SnapshotID)
+	assert.False(t, completed.Spec.Cancel)
+
+	assert.Equal(t, "snapshot-2", unfinished.Spec.VolumeSnapshot)
+	assert.True(t, unfinished.Spec.Cancel)
+
+	// The unfinished data upload is canceled in the cluster too, so that its node agent stops.
+	du := &velerov1api.DataUpload{}
+	require.NoError(t, fakeClient.Get(context.Background(), kbclient.ObjectKeyFromObject(unfinished), du))
+	assert.True(t, du.Spec.Cancel)
+}
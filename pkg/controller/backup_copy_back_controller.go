@@ -0,0 +1,222 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const backupCopyBackEnqueuePeriod = time.Minute
+
+// backupCopyBackReconciler copies the backups that were stored in the fallback location of a
+// location while it was unavailable back to it, once it's available again, if its fallback asks
+// for it. It's separate from the validation of the locations, so that copying backups doesn't
+// delay it.
+type backupCopyBackReconciler struct {
+	client            client.Client
+	logger            logrus.FieldLogger
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+}
+
+// NewBackupCopyBackReconciler constructs a new backupCopyBackReconciler.
+func NewBackupCopyBackReconciler(
+	client client.Client,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	logger logrus.FieldLogger,
+) *backupCopyBackReconciler {
+	return &backupCopyBackReconciler{
+		client:            client,
+		logger:            logger,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+}
+
+// The locations are enqueued periodically to retry the backups that couldn't be copied back, and
+// when they become available.
+func (r *backupCopyBackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov1api.BackupStorageLocationList{}, backupCopyBackEnqueuePeriod, kube.PeriodicalEnqueueSourceOption{
+		FilterFuncs: []func(object client.Object) bool{
+			func(object client.Object) bool {
+				return copiesBackBackups(object.(*velerov1api.BackupStorageLocation))
+			},
+		},
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(BackupCopyBack).
+		For(&velerov1api.BackupStorageLocation{}).
+		WithEventFilter(predicate.Funcs{
+			CreateFunc: func(ce event.CreateEvent) bool {
+				return false
+			},
+			UpdateFunc: func(ue event.UpdateEvent) bool {
+				old := ue.ObjectOld.(*velerov1api.BackupStorageLocation)
+				return old.Status.Phase != velerov1api.BackupStorageLocationPhaseAvailable &&
+					copiesBackBackups(ue.ObjectNew.(*velerov1api.BackupStorageLocation))
+			},
+			DeleteFunc: func(de event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(ge event.GenericEvent) bool {
+				return false
+			},
+		}).
+		Watches(s, nil).
+		Complete(r)
+}
+
+// copiesBackBackups returns true if backups are copied back to a location from its fallback.
+func copiesBackBackups(location *velerov1api.BackupStorageLocation) bool {
+	return location.Spec.Fallback != nil && location.Spec.Fallback.CopyBack &&
+		location.Spec.AccessMode != velerov1api.BackupStorageLocationAccessModeReadOnly &&
+		location.Status.Phase == velerov1api.BackupStorageLocationPhaseAvailable
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update;patch
+func (r *backupCopyBackReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("controller", BackupCopyBack).WithField(BackupStorageLocation, req.NamespacedName.String())
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, req.NamespacedName, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup storage location %s", req.String())
+	}
+	if !copiesBackBackups(location) {
+		return ctrl.Result{}, nil
+	}
+
+	backups := &velerov1api.BackupList{}
+	if err := r.client.List(ctx, backups, client.InNamespace(location.Namespace)); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error listing backups to copy back")
+	}
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	var backupStore persistence.BackupStore
+	fallbackStores := make(map[string]persistence.BackupStore)
+	for i := range backups.Items {
+		backup := &backups.Items[i]
+		if backup.Annotations[velerov1api.FailoverFromAnnotation] != location.Name || backup.Spec.StorageLocation == location.Name {
+			continue
+		}
+		if backup.Status.Phase != velerov1api.BackupPhaseCompleted && backup.Status.Phase != velerov1api.BackupPhasePartiallyFailed {
+			continue
+		}
+		log := log.WithFields(logrus.Fields{"backup": backup.Name, "fallbackLocation": backup.Spec.StorageLocation})
+
+		if backupStore == nil {
+			var err error
+			if backupStore, err = r.backupStoreGetter.Get(location, pluginManager, log); err != nil {
+				log.WithError(err).Error("Error getting a backup store")
+				return ctrl.Result{}, nil
+			}
+		}
+
+		fallbackStore, ok := fallbackStores[backup.Spec.StorageLocation]
+		if !ok {
+			fallback := &velerov1api.BackupStorageLocation{}
+			if err := r.client.Get(ctx, client.ObjectKey{Namespace: location.Namespace, Name: backup.Spec.StorageLocation}, fallback); err != nil {
+				log.WithError(err).Error("Error getting the fallback location of the backup")
+				continue
+			}
+			var err error
+			if fallbackStore, err = r.backupStoreGetter.Get(fallback, pluginManager, log); err != nil {
+				log.WithError(err).Error("Error getting the backup store of the fallback location")
+				continue
+			}
+			fallbackStores[backup.Spec.StorageLocation] = fallbackStore
+		}
+
+		if err := r.copyBackBackup(ctx, backup, location, fallbackStore, backupStore, log); err != nil {
+			log.WithError(err).Error("Error copying the backup back from its fallback location")
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *backupCopyBackReconciler) copyBackBackup(ctx context.Context, backup *velerov1api.Backup, location *velerov1api.BackupStorageLocation, from, to persistence.BackupStore, log logrus.FieldLogger) error {
+	// the pod volume and moved snapshot data of the backup is in the backup repositories of the
+	// fallback location, which the backup can't be separated from
+	podVolumeBackups, err := from.GetPodVolumeBackups(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting pod volume backups")
+	}
+	dataUploads, err := from.GetDataUploads(backup.Name)
+	if err != nil {
+		return errors.Wrap(err, "error getting data uploads")
+	}
+	if len(podVolumeBackups) > 0 || len(dataUploads) > 0 {
+		log.Debug("Backup has data in the backup repositories of its fallback location, keeping it there")
+		return nil
+	}
+
+	log.Info("Copying the backup back from its fallback location")
+	if err := persistence.CopyBackup(backup.Name, from, to); err != nil {
+		return err
+	}
+
+	original := backup.DeepCopy()
+	backup.Spec.StorageLocation = location.Name
+	if backup.Labels == nil {
+		backup.Labels = make(map[string]string)
+	}
+	backup.Labels[velerov1api.StorageLocationLabel] = label.GetValidName(location.Name)
+
+	// backups copied back to an immutable location are locked like the backups stored in it, with
+	// the lock recorded in their metadata first
+	backup.Status.ObjectLock = backupObjectLock(location, backup)
+	if backup.Status.ObjectLock != nil {
+		if err := putBackupMetadata(backup, to); err != nil {
+			return errors.Wrap(err, "error updating the metadata of the backup")
+		}
+		if err := lockBackup(to, location, backup.Name, backup.Status.ObjectLock, log); err != nil {
+			return err
+		}
+	}
+
+	if err := r.client.Patch(ctx, backup, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error updating the storage location of the backup")
+	}
+
+	if err := from.DeleteBackup(backup.Name); err != nil {
+		return errors.Wrap(err, "error deleting the backup from its fallback location")
+	}
+	log.Info("Copied the backup back from its fallback location")
+	return nil
+}
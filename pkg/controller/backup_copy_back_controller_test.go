@@ -0,0 +1,189 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newCopyBackReconciler(t *testing.T, stores map[string]*persistencemocks.BackupStore, objects ...runtime.Object) *backupCopyBackReconciler {
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	return NewBackupCopyBackReconciler(
+		velerotest.NewFakeControllerRuntimeClient(t, objects...),
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(stores),
+		velerotest.NewLogger(),
+	)
+}
+
+func failedOverBackup(name string, phase velerov1api.BackupPhase) *velerov1api.Backup {
+	return builder.ForBackup(velerov1api.DefaultNamespace, name).
+		ObjectMeta(
+			builder.WithAnnotations(velerov1api.FailoverFromAnnotation, "primary"),
+			builder.WithLabels(velerov1api.StorageLocationLabel, "secondary"),
+		).
+		StorageLocation("secondary").
+		Phase(phase).
+		Result()
+}
+
+func TestBackupCopyBackReconcile(t *testing.T) {
+	primary := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Fallback("secondary", true).Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+	secondary := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "secondary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+
+	primaryStore := &persistencemocks.BackupStore{}
+	primaryStore.On("PutBackupObject", "backup-1", mock.Anything, mock.Anything).Return(nil)
+
+	secondaryStore := &persistencemocks.BackupStore{}
+	secondaryStore.On("GetPodVolumeBackups", "backup-1").Return(nil, nil)
+	secondaryStore.On("GetDataUploads", "backup-1").Return(nil, nil)
+	secondaryStore.On("ListBackupObjects", "backup-1").Return([]string{"velero-backup.json", "backup-1.tar.gz"}, nil)
+	secondaryStore.On("GetBackupObject", "backup-1", mock.Anything).Return(
+		func(string, string) io.ReadCloser { return ioutil.NopCloser(strings.NewReader("data")) }, nil)
+	secondaryStore.On("DeleteBackup", "backup-1").Return(nil)
+	secondaryStore.On("GetPodVolumeBackups", "backup-2").Return([]*velerov1api.PodVolumeBackup{{}}, nil)
+	secondaryStore.On("GetDataUploads", "backup-2").Return(nil, nil)
+
+	r := newCopyBackReconciler(t,
+		map[string]*persistencemocks.BackupStore{
+			"primary":   primaryStore,
+			"secondary": secondaryStore,
+		},
+		primary,
+		secondary,
+		failedOverBackup("backup-1", velerov1api.BackupPhaseCompleted),
+		failedOverBackup("backup-2", velerov1api.BackupPhaseCompleted),
+		failedOverBackup("backup-3", velerov1api.BackupPhaseInProgress),
+		builder.ForBackup(velerov1api.DefaultNamespace, "backup-4").ObjectMeta(builder.WithLabels(velerov1api.StorageLocationLabel, "secondary")).StorageLocation("secondary").Phase(velerov1api.BackupPhaseCompleted).Result(),
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: primary.Namespace, Name: primary.Name}})
+	require.NoError(t, err)
+
+	// the contents of the backup are copied before its metadata
+	primaryStore.AssertNumberOfCalls(t, "PutBackupObject", 2)
+	assert.Equal(t, "backup-1.tar.gz", primaryStore.Calls[0].Arguments.String(1))
+	assert.Equal(t, "velero-backup.json", primaryStore.Calls[1].Arguments.String(1))
+	secondaryStore.AssertCalled(t, "DeleteBackup", "backup-1")
+
+	for name, expectedLocation := range map[string]string{
+		"backup-1": "primary",
+		"backup-2": "secondary",
+		"backup-3": "secondary",
+		"backup-4": "secondary",
+	} {
+		backup := &velerov1api.Backup{}
+		require.NoError(t, r.client.Get(context.Background(), client.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, backup))
+		assert.Equal(t, expectedLocation, backup.Spec.StorageLocation, name)
+		assert.Equal(t, expectedLocation, backup.Labels[velerov1api.StorageLocationLabel], name)
+	}
+}
+
+func TestBackupCopyBackReconcileImmutableLocation(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	primary := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Fallback("secondary", true).Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+	primary.Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{Mode: velerov1api.ObjectLockModeCompliance}
+	secondary := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "secondary").Phase(velerov1api.BackupStorageLocationPhaseAvailable).Result()
+	backup := failedOverBackup("backup-1", velerov1api.BackupPhaseCompleted)
+	backup.Status.Expiration = &metav1.Time{Time: expiration}
+
+	primaryStore := &persistencemocks.BackupStore{}
+	primaryStore.On("PutBackupObject", "backup-1", mock.Anything, mock.Anything).Return(nil)
+	hasObjectLock := func(metadata io.Reader) bool {
+		data, _ := ioutil.ReadAll(metadata)
+		return strings.Contains(string(data), `"objectLock"`) && strings.Contains(string(data), `"storageLocation": "primary"`)
+	}
+	primaryStore.On("PutBackupMetadata", "backup-1", mock.MatchedBy(hasObjectLock)).Return(nil)
+	untilExpiration := func(retention velero.ObjectRetention) bool {
+		return retention.Mode == velero.ObjectRetentionModeCompliance && retention.RetainUntil.Equal(expiration)
+	}
+	primaryStore.On("LockBackup", "backup-1", mock.MatchedBy(untilExpiration)).Return(nil)
+
+	secondaryStore := &persistencemocks.BackupStore{}
+	secondaryStore.On("GetPodVolumeBackups", "backup-1").Return(nil, nil)
+	secondaryStore.On("GetDataUploads", "backup-1").Return(nil, nil)
+	secondaryStore.On("ListBackupObjects", "backup-1").Return([]string{"velero-backup.json", "backup-1.tar.gz"}, nil)
+	secondaryStore.On("GetBackupObject", "backup-1", mock.Anything).Return(
+		func(string, string) io.ReadCloser { return ioutil.NopCloser(strings.NewReader("data")) }, nil)
+	secondaryStore.On("DeleteBackup", "backup-1").Return(nil)
+
+	r := newCopyBackReconciler(t,
+		map[string]*persistencemocks.BackupStore{
+			"primary":   primaryStore,
+			"secondary": secondaryStore,
+		},
+		primary,
+		secondary,
+		backup,
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: primary.Namespace, Name: primary.Name}})
+	require.NoError(t, err)
+	primaryStore.AssertExpectations(t)
+	secondaryStore.AssertCalled(t, "DeleteBackup", "backup-1")
+
+	updated := &velerov1api.Backup{}
+	require.NoError(t, r.client.Get(context.Background(), client.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, updated))
+	assert.Equal(t, "primary", updated.Spec.StorageLocation)
+	require.NotNil(t, updated.Status.ObjectLock)
+	assert.Equal(t, velerov1api.ObjectLockModeCompliance, updated.Status.ObjectLock.Mode)
+	assert.True(t, expiration.Equal(updated.Status.ObjectLock.RetainUntil.Time))
+}
+
+func TestBackupCopyBackReconcileUnavailableLocation(t *testing.T) {
+	primary := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "primary").Fallback("secondary", true).Phase(velerov1api.BackupStorageLocationPhaseUnavailable).Result()
+
+	primaryStore := &persistencemocks.BackupStore{}
+	r := newCopyBackReconciler(t,
+		map[string]*persistencemocks.BackupStore{"primary": primaryStore},
+		primary,
+		failedOverBackup("backup-1", velerov1api.BackupPhaseCompleted),
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: primary.Namespace, Name: primary.Name}})
+	require.NoError(t, err)
+	primaryStore.AssertNotCalled(t, "PutBackupObject", mock.Anything, mock.Anything, mock.Anything)
+
+	backup := &velerov1api.Backup{}
+	require.NoError(t, r.client.Get(context.Background(), client.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: "backup-1"}, backup))
+	assert.Equal(t, "secondary", backup.Spec.StorageLocation)
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -41,6 +43,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/repository"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 
@@ -200,13 +203,37 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "error getting the backup store")
+	}
+
+	// Don't allow deleting backups whose objects are still locked. The lock is read from the
+	// object store, since a legal hold may have been released or a retention extended there.
+	if location.Spec.Immutability != nil || backup.Status.ObjectLock != nil {
+		retention, err := backupStore.GetBackupLock(backup.Name)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "error getting the object lock of the backup")
+		}
+		if message := backupLockedMessage(backup.Name, location.Name, retention, r.clock.Now()); message != "" {
+			_, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+				r.Status.Phase = velerov1api.DeleteBackupRequestPhaseProcessed
+				r.Status.Errors = append(r.Status.Errors, message)
+			})
+			return ctrl.Result{}, err
+		}
+	}
+
 	// if the request object has no labels defined, initialize an empty map since
 	// we will be updating labels
 	if dbr.Labels == nil {
 		dbr.Labels = map[string]string{}
 	}
 	// Update status to InProgress and set backup-name and backup-uid label if needed
-	dbr, err := r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
+	dbr, err = r.patchDeleteBackupRequest(ctx, dbr, func(r *velerov1api.DeleteBackupRequest) {
 		r.Status.Phase = velerov1api.DeleteBackupRequestPhaseInProgress
 
 		if r.Labels[velerov1api.BackupNameLabel] == "" {
@@ -233,14 +260,6 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	r.metrics.RegisterBackupDeletionAttempt(backupScheduleName)
 
-	pluginManager := r.newPluginManager(log)
-	defer pluginManager.CleanupClients()
-
-	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
-	if err != nil {
-		return ctrl.Result{}, errors.Wrap(err, "error getting the backup store")
-	}
-
 	actions, err := pluginManager.GetDeleteItemActions()
 	log.Debugf("%d actions before invoking actions", len(actions))
 	if err != nil {
@@ -252,17 +271,28 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		// Download the tarball
 		backupFile, err := downloadToTempFile(backup.Name, backupStore, log)
 
+		var namespaceFiles map[string]*os.File
+		if err == nil {
+			defer closeAndRemoveFile(backupFile, r.logger)
+			namespaceFiles, err = downloadNamespaceArchivesToTempFiles(backup.Name, collections.NewIncludesExcludes(), backupStore, log)
+		}
+
 		if err != nil {
 			log.WithError(err).Errorf("Unable to download tarball for backup %s, skipping associated DeleteItemAction plugins", backup.Name)
 		} else {
-			defer closeAndRemoveFile(backupFile, r.logger)
+			namespaceReaders := make(map[string]io.Reader, len(namespaceFiles))
+			for namespace, file := range namespaceFiles {
+				defer closeAndRemoveFile(file, r.logger)
+				namespaceReaders[namespace] = file
+			}
 			ctx := &delete.Context{
-				Backup:          backup,
-				BackupReader:    backupFile,
-				Actions:         actions,
-				Log:             r.logger,
-				DiscoveryHelper: r.discoveryHelper,
-				Filesystem:      filesystem.NewFileSystem(),
+				Backup:                 backup,
+				BackupReader:           backupFile,
+				NamespaceBackupReaders: namespaceReaders,
+				Actions:                actions,
+				Log:                    r.logger,
+				DiscoveryHelper:        r.discoveryHelper,
+				Filesystem:             filesystem.NewFileSystem(),
 			}
 
 			// Optimization: wrap in a gofunc? Would be useful for large backups with lots of objects.
@@ -303,7 +333,7 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 	log.Info("Removing restic snapshots")
-	if deleteErrs := r.deleteResticSnapshots(ctx, backup); len(deleteErrs) > 0 {
+	if deleteErrs := r.deleteResticSnapshots(ctx, backup, backupStore); len(deleteErrs) > 0 {
 		for _, err := range deleteErrs {
 			errs = append(errs, err.Error())
 		}
@@ -387,6 +417,21 @@ func (r *backupDeletionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
+// backupLockedMessage returns why a backup can't be deleted yet because of the object lock of
+// its objects, or an empty string if it can be deleted.
+func backupLockedMessage(backup, location string, retention *velero.ObjectRetention, now time.Time) string {
+	switch {
+	case retention == nil:
+		return ""
+	case retention.LegalHold:
+		return fmt.Sprintf("backup %s can't be deleted because its objects in backup storage location %s have a legal hold", backup, location)
+	case retention.RetainUntil.After(now):
+		return fmt.Sprintf("backup %s can't be deleted because its objects in backup storage location %s are locked until %s", backup, location, retention.RetainUntil.UTC().Format(time.RFC3339))
+	default:
+		return ""
+	}
+}
+
 func volumeSnapshottersForVSL(
 	ctx context.Context,
 	namespace, vslName string,
@@ -436,7 +481,9 @@ func (r *backupDeletionReconciler) deleteExistingDeletionRequests(ctx context.Co
 	return errs
 }
 
-func (r *backupDeletionReconciler) deleteResticSnapshots(ctx context.Context, backup *velerov1api.Backup) []error {
+// deleteResticSnapshots forgets the backup repository snapshots of the backup's pod volume
+// backups, and of its data uploads if the backup's store is available.
+func (r *backupDeletionReconciler) deleteResticSnapshots(ctx context.Context, backup *velerov1api.Backup, backupStore persistence.BackupStore) []error {
 	if r.repoMgr == nil {
 		return nil
 	}
@@ -446,6 +493,14 @@ func (r *backupDeletionReconciler) deleteResticSnapshots(ctx context.Context, ba
 		return []error{err}
 	}
 
+	if backupStore != nil {
+		dataUploads, err := backupStore.GetDataUploads(backup.Name)
+		if err != nil {
+			return []error{errors.Wrap(err, "error getting backup's data uploads")}
+		}
+		snapshots = append(snapshots, getDataUploadSnapshots(dataUploads)...)
+	}
+
 	ctx2, cancelFunc := context.WithTimeout(ctx, resticTimeout)
 	defer cancelFunc()
 
@@ -495,6 +550,26 @@ func (r *backupDeletionReconciler) patchBackup(ctx context.Context, backup *vele
 
 // getSnapshotsInBackup returns a list of all restic snapshot ids associated with
 // a given Velero backup.
+// getDataUploadSnapshots returns the identifiers of the backup repository snapshots that
+// data uploads took.
+func getDataUploadSnapshots(dataUploads []*velerov1api.DataUpload) []repository.SnapshotIdentifier {
+	var res []repository.SnapshotIdentifier
+	for _, du := range dataUploads {
+		if du.Status.SnapshotID == "" {
+			continue
+		}
+
+		res = append(res, repository.SnapshotIdentifier{
+			VolumeNamespace:       du.Spec.SourceNamespace,
+			BackupStorageLocation: du.Spec.BackupStorageLocation,
+			SnapshotID:            du.Status.SnapshotID,
+			RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+		})
+	}
+
+	return res
+}
+
 func getSnapshotsInBackup(ctx context.Context, backup *velerov1api.Backup, kbClient client.Client) ([]repository.SnapshotIdentifier, error) {
 	podVolumeBackups := &velerov1api.PodVolumeBackupList{}
 	options := &client.ListOptions{
@@ -55,6 +55,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
 	"github.com/vmware-tanzu/velero/pkg/repository"
+	repomocks "github.com/vmware-tanzu/velero/pkg/repository/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
@@ -258,6 +259,28 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 		assert.Equal(t, 1, len(res.Status.Errors))
 		assert.Equal(t, "cannot delete backup because backup storage location default is currently in read-only mode", res.Status.Errors[0])
 	})
+	t.Run("backup whose objects are locked isn't deleted", func(t *testing.T) {
+		backup := builder.ForBackup(velerov1api.DefaultNamespace, "foo").StorageLocation("default").Result()
+		location := builder.ForBackupStorageLocation("velero", "default").Result()
+		location.Spec.Immutability = &velerov1api.BackupStorageLocationImmutability{Mode: velerov1api.ObjectLockModeCompliance}
+
+		td := setupBackupDeletionControllerTest(t, defaultTestDbr(), location, backup)
+		retainUntil := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+		td.backupStore.On("GetBackupLock", "foo").Return(&velero.ObjectRetention{Mode: velero.ObjectRetentionModeCompliance, RetainUntil: retainUntil}, nil)
+
+		_, err := td.controller.Reconcile(context.TODO(), td.req)
+		require.NoError(t, err)
+
+		res := &velerov1api.DeleteBackupRequest{}
+		err = td.fakeClient.Get(ctx, td.req.NamespacedName, res)
+		require.NoError(t, err)
+		assert.Equal(t, "Processed", string(res.Status.Phase))
+		require.Equal(t, 1, len(res.Status.Errors))
+		assert.Equal(t, "backup foo can't be deleted because its objects in backup storage location default are locked until 2100-01-01T00:00:00Z", res.Status.Errors[0])
+
+		td.backupStore.AssertNotCalled(t, "DeleteBackup", mock.Anything)
+		assert.NoError(t, td.fakeClient.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}, &velerov1api.Backup{}))
+	})
 	t.Run("full delete, no errors", func(t *testing.T) {
 
 		input := defaultTestDbr()
@@ -696,6 +719,17 @@ func TestBackupDeletionControllerReconcile(t *testing.T) {
 	})
 }
 
+func TestBackupLockedMessage(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Empty(t, backupLockedMessage("backup-1", "default", nil, now))
+	assert.Empty(t, backupLockedMessage("backup-1", "default", &velero.ObjectRetention{RetainUntil: now}, now))
+	assert.Equal(t, "backup backup-1 can't be deleted because its objects in backup storage location default are locked until 2022-06-01T13:00:00Z",
+		backupLockedMessage("backup-1", "default", &velero.ObjectRetention{RetainUntil: now.Add(time.Hour)}, now))
+	assert.Equal(t, "backup backup-1 can't be deleted because its objects in backup storage location default have a legal hold",
+		backupLockedMessage("backup-1", "default", &velero.ObjectRetention{RetainUntil: now.Add(-time.Hour), LegalHold: true}, now))
+}
+
 func TestGetSnapshotsInBackup(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -867,3 +901,51 @@ func TestGetSnapshotsInBackup(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteResticSnapshots(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	pvb := &velerov1api.PodVolumeBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "pvb-1", Labels: map[string]string{velerov1api.BackupNameLabel: "backup-1"}},
+		Spec: velerov1api.PodVolumeBackupSpec{
+			Pod:                   corev1api.ObjectReference{Name: "pod-1", Namespace: "ns-1"},
+			BackupStorageLocation: "default",
+		},
+		Status: velerov1api.PodVolumeBackupStatus{SnapshotID: "snap-1"},
+	}
+	dataUploads := []*velerov1api.DataUpload{
+		{
+			Spec:   velerov1api.DataUploadSpec{SourceNamespace: "ns-2", BackupStorageLocation: "default"},
+			Status: velerov1api.DataUploadStatus{SnapshotID: "snap-2"},
+		},
+		{
+			// failed data uploads don't have a snapshot
+			Spec: velerov1api.DataUploadSpec{SourceNamespace: "ns-3", BackupStorageLocation: "default"},
+		},
+	}
+
+	repoMgr := &repomocks.Manager{}
+	repoMgr.On("Forget", mock.Anything, mock.Anything).Return(nil)
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetDataUploads", "backup-1").Return(dataUploads, nil)
+
+	r := &backupDeletionReconciler{
+		Client:  velerotest.NewFakeControllerRuntimeClient(t, pvb),
+		repoMgr: repoMgr,
+	}
+	errs := r.deleteResticSnapshots(context.Background(), backup, backupStore)
+	assert.Empty(t, errs)
+
+	repoMgr.AssertNumberOfCalls(t, "Forget", 2)
+	repoMgr.AssertCalled(t, "Forget", mock.Anything, repository.SnapshotIdentifier{
+		VolumeNamespace:       "ns-1",
+		BackupStorageLocation: "default",
+		SnapshotID:            "snap-1",
+		RepositoryType:        velerov1api.BackupRepositoryTypeRestic,
+	})
+	repoMgr.AssertCalled(t, "Forget", mock.Anything, repository.SnapshotIdentifier{
+		VolumeNamespace:       "ns-2",
+		BackupStorageLocation: "default",
+		SnapshotID:            "snap-2",
+		RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+	})
+}
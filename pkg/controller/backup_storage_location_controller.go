@@ -142,6 +142,16 @@ func (r *backupStorageLocationReconciler) Reconcile(ctx context.Context, req ctr
 			}
 		}()
 
+		if err = storage.ValidatePlacement(location.Spec.Placement); err != nil {
+			log.WithError(err).Error("Invalid placement rules")
+			return
+		}
+
+		if err = storage.ValidateFallback(&location); err != nil {
+			log.WithError(err).Error("Invalid fallback location")
+			return
+		}
+
 		backupStore, err := r.backupStoreGetter.Get(&location, pluginManager, log)
 		if err != nil {
 			log.WithError(err).Error("Error getting a backup store")
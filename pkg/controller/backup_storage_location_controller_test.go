@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 
@@ -59,6 +60,20 @@ var _ = Describe("Backup Storage Location Reconciler", func() {
 				expectedIsDefault: false,
 				expectedPhase:     velerov1api.BackupStorageLocationPhaseUnavailable,
 			},
+			{
+				backupLocation: builder.ForBackupStorageLocation("ns-1", "location-3").ValidationFrequency(1 * time.Second).Placement(&velerov1api.BackupStorageLocationPlacement{
+					BackupSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Bogus"}}},
+				}).Result(),
+				isValidError:      nil,
+				expectedIsDefault: false,
+				expectedPhase:     velerov1api.BackupStorageLocationPhaseUnavailable,
+			},
+			{
+				backupLocation:    builder.ForBackupStorageLocation("ns-1", "location-4").ValidationFrequency(1*time.Second).Fallback("location-4", false).Result(),
+				isValidError:      nil,
+				expectedIsDefault: false,
+				expectedPhase:     velerov1api.BackupStorageLocationPhaseUnavailable,
+			},
 		}
 
 		// Setup
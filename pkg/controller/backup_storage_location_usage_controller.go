@@ -0,0 +1,210 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/vmware-tanzu/velero/internal/storage"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const defaultUsageMeasurementFrequency = time.Hour
+
+// bslUsageReconciler measures the storage used by backup storage locations, and reports it in
+// their status and in metrics.
+type bslUsageReconciler struct {
+	client            client.Client
+	logger            logrus.FieldLogger
+	clock             clock.Clock
+	frequency         time.Duration
+	newPluginManager  func(logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+	metrics           *metrics.ServerMetrics
+}
+
+// NewBackupStorageLocationUsageReconciler constructs a new bslUsageReconciler.
+func NewBackupStorageLocationUsageReconciler(
+	client client.Client,
+	frequency time.Duration,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+	metrics *metrics.ServerMetrics,
+	logger logrus.FieldLogger,
+) *bslUsageReconciler {
+	r := &bslUsageReconciler{
+		client:            client,
+		logger:            logger,
+		clock:             clock.RealClock{},
+		frequency:         frequency,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+		metrics:           metrics,
+	}
+	if r.frequency <= 0 {
+		r.frequency = defaultUsageMeasurementFrequency
+	}
+	return r
+}
+
+// The locations are enqueued more often than they're measured, so that each one is measured
+// soon after its frequency elapsed. Updates of the locations are filtered, since their validation
+// updates them every minute.
+func (r *bslUsageReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov1api.BackupStorageLocationList{}, r.frequency/10, kube.PeriodicalEnqueueSourceOption{})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(BackupStorageLocationUsage).
+		For(&velerov1api.BackupStorageLocation{}).
+		WithEventFilter(predicate.Funcs{
+			UpdateFunc: func(ue event.UpdateEvent) bool {
+				return false
+			},
+			GenericFunc: func(ge event.GenericEvent) bool {
+				return false
+			},
+		}).
+		Watches(s, nil).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=backuprepositories,verbs=get;list;watch
+func (r *bslUsageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("controller", BackupStorageLocationUsage).WithField(BackupStorageLocation, req.NamespacedName.String())
+
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, req.NamespacedName, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.metrics.RemoveBackupStorageLocation(req.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting backup storage location %s", req.String())
+	}
+
+	if usage := location.Status.Usage; usage != nil && usage.MeasurementTimestamp != nil &&
+		r.clock.Now().Before(usage.MeasurementTimestamp.Add(r.frequency)) {
+		log.Debug("Usage of the backup storage location was measured recently, skipping")
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("Measuring the usage of the backup storage location")
+
+	pluginManager := r.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(location, pluginManager, log)
+	if err != nil {
+		log.WithError(err).Error("Error getting a backup store")
+		return ctrl.Result{}, nil
+	}
+
+	objects, backupBytes, err := backupStore.MeasureObjects()
+	if err != nil {
+		log.WithError(err).Error("Error measuring the objects of the backup storage location")
+		return ctrl.Result{}, nil
+	}
+
+	repositoryBytes, err := r.repositoryBytes(ctx, location)
+	if err != nil {
+		log.WithError(err).Error("Error getting the usage of the backup repositories")
+		return ctrl.Result{}, nil
+	}
+
+	// the size of the location's data is unknown if its plugin can't list the sizes of objects
+	var totalBytes *int64
+	if backupBytes != nil {
+		total := *backupBytes + repositoryBytes
+		totalBytes = &total
+	}
+
+	original := location.DeepCopy()
+	previous := location.Status.Usage
+	location.Status.Usage = &velerov1api.BackupStorageLocationUsage{
+		MeasurementTimestamp: &metav1.Time{Time: r.clock.Now()},
+		TotalBytes:           totalBytes,
+		BackupBytes:          backupBytes,
+		RepositoryBytes:      repositoryBytes,
+		Objects:              objects,
+	}
+	storage.SetUsageConditions(location, previous)
+	if err := r.client.Patch(ctx, location, client.MergeFrom(original)); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error updating the usage of backup storage location %s", req.String())
+	}
+
+	r.metrics.SetBackupStorageLocationUsedBytes(location.Name, backupBytes, repositoryBytes)
+	quota := int64(-1)
+	if location.Spec.Quota != nil && location.Spec.Quota.Limit != nil {
+		quota = location.Spec.Quota.Limit.Value()
+	}
+	r.metrics.SetBackupStorageLocationQuotaBytes(location.Name, quota)
+
+	if exceeded, message := storage.QuotaExceeded(location); exceeded {
+		log.Warn(message)
+	}
+	fields := logrus.Fields{
+		"backupBytes":     "unknown",
+		"repositoryBytes": repositoryBytes,
+		"objects":         objects,
+	}
+	if backupBytes != nil {
+		fields["backupBytes"] = *backupBytes
+	}
+	log.WithFields(fields).Info("Measured the usage of the backup storage location")
+
+	return ctrl.Result{}, nil
+}
+
+// repositoryBytes returns the storage used by the backup repositories in a location, as of their
+// latest maintenance.
+func (r *bslUsageReconciler) repositoryBytes(ctx context.Context, location *velerov1api.BackupStorageLocation) (int64, error) {
+	repos := &velerov1api.BackupRepositoryList{}
+	if err := r.client.List(ctx, repos, client.InNamespace(location.Namespace),
+		client.MatchingLabels{velerov1api.StorageLocationLabel: label.GetValidName(location.Name)}); err != nil {
+		return 0, errors.Wrap(err, "error listing backup repositories")
+	}
+
+	var bytes int64
+	for _, repo := range repos.Items {
+		if repo.Spec.BackupStorageLocation != location.Name || repo.Status.Stats == nil {
+			continue
+		}
+		// restic repositories can't measure their storage, the data their snapshots reference is
+		// the closest estimate
+		if repo.Status.Stats.TotalBytes != nil {
+			bytes += *repo.Status.Stats.TotalBytes
+		} else {
+			bytes += repo.Status.Stats.UniqueBytes
+		}
+	}
+	return bytes, nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/metrics"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newUsageReconciler(t *testing.T, backupStore *persistencemocks.BackupStore, now time.Time, objects ...runtime.Object) *bslUsageReconciler {
+	pluginManager := &pluginmocks.Manager{}
+	pluginManager.On("CleanupClients").Return(nil)
+
+	r := NewBackupStorageLocationUsageReconciler(
+		velerotest.NewFakeControllerRuntimeClient(t, objects...),
+		time.Hour,
+		func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+		NewFakeObjectBackupStoreGetter(map[string]*persistencemocks.BackupStore{"location-1": backupStore}),
+		metrics.NewServerMetrics(),
+		velerotest.NewLogger(),
+	)
+	r.clock = clock.NewFakeClock(now)
+	return r
+}
+
+func TestBackupStorageLocationUsageReconcile(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	limit := resource.MustParse("1000")
+
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-1").Bucket("bucket-1").Result()
+	location.Spec.Quota = &velerov1api.BackupStorageLocationQuota{Limit: &limit, GrowthThresholdPercent: 50}
+	previousBytes := int64(400)
+	location.Status.Usage = &velerov1api.BackupStorageLocationUsage{
+		MeasurementTimestamp: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+		TotalBytes:           &previousBytes,
+	}
+
+	repoBytes, otherRepoBytes := int64(600), int64(10000)
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "repo-1",
+			Labels:    map[string]string{velerov1api.StorageLocationLabel: "location-1"},
+		},
+		Spec:   velerov1api.BackupRepositorySpec{BackupStorageLocation: "location-1"},
+		Status: velerov1api.BackupRepositoryStatus{Stats: &velerov1api.BackupRepositoryStats{TotalBytes: &repoBytes}},
+	}
+	otherRepo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: velerov1api.DefaultNamespace,
+			Name:      "repo-2",
+			Labels:    map[string]string{velerov1api.StorageLocationLabel: "location-2"},
+		},
+		Spec:   velerov1api.BackupRepositorySpec{BackupStorageLocation: "location-2"},
+		Status: velerov1api.BackupRepositoryStatus{Stats: &velerov1api.BackupRepositoryStats{TotalBytes: &otherRepoBytes}},
+	}
+
+	backupStore := &persistencemocks.BackupStore{}
+	backupBytes := int64(400)
+	backupStore.On("MeasureObjects").Return(2, &backupBytes, nil).Once()
+
+	r := newUsageReconciler(t, backupStore, now, location, repo, otherRepo)
+
+	key := types.NamespacedName{Namespace: location.Namespace, Name: location.Name}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &velerov1api.BackupStorageLocation{}
+	require.NoError(t, r.client.Get(context.Background(), key, updated))
+	require.NotNil(t, updated.Status.Usage)
+	assert.True(t, now.Equal(updated.Status.Usage.MeasurementTimestamp.Time))
+	require.NotNil(t, updated.Status.Usage.TotalBytes)
+	assert.Equal(t, int64(1000), *updated.Status.Usage.TotalBytes)
+	require.NotNil(t, updated.Status.Usage.BackupBytes)
+	assert.Equal(t, int64(400), *updated.Status.Usage.BackupBytes)
+	assert.Equal(t, int64(600), updated.Status.Usage.RepositoryBytes)
+	assert.Equal(t, 2, updated.Status.Usage.Objects)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, velerov1api.BackupStorageLocationConditionQuotaExceeded))
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, velerov1api.BackupStorageLocationConditionQuotaWarning))
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, velerov1api.BackupStorageLocationConditionUnexpectedGrowth))
+
+	// the usage isn't measured again before the frequency elapsed
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	backupStore.AssertNumberOfCalls(t, "MeasureObjects", 1)
+
+	// the usage is unknown if the object store can't list the sizes of objects
+	r.clock = clock.NewFakeClock(now.Add(time.Hour))
+	backupStore.On("MeasureObjects").Return(2, nil, nil).Once()
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	backupStore.AssertNumberOfCalls(t, "MeasureObjects", 2)
+
+	require.NoError(t, r.client.Get(context.Background(), key, updated))
+	assert.Nil(t, updated.Status.Usage.TotalBytes)
+	assert.Nil(t, updated.Status.Usage.BackupBytes)
+	assert.Equal(t, int64(600), updated.Status.Usage.RepositoryBytes)
+	assert.Equal(t, 2, updated.Status.Usage.Objects)
+	exceeded := meta.FindStatusCondition(updated.Status.Conditions, velerov1api.BackupStorageLocationConditionQuotaExceeded)
+	require.NotNil(t, exceeded)
+	assert.Equal(t, metav1.ConditionUnknown, exceeded.Status)
+
+	// the metrics of a deleted location are removed
+	require.NoError(t, r.client.Delete(context.Background(), updated))
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+}
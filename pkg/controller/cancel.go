@@ -0,0 +1,64 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// cancelPollInterval is how often a running backup, restore, or pod volume operation
+// checks whether it has been asked to cancel.
+const cancelPollInterval = 2 * time.Second
+
+// errCanceled is returned by the backup and restore controllers' run functions when the
+// backup or restore was canceled while running.
+var errCanceled = errors.New("canceled")
+
+// withCancelRequest returns a copy of parent that is canceled once cancelRequested returns
+// true. cancelRequested is called every interval until the returned context is done, so
+// callers must call the returned CancelFunc when the operation finishes.
+func withCancelRequest(parent context.Context, interval time.Duration, cancelRequested func() bool) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if cancelRequested() {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// wasCanceled returns true if ctx, created by withCancelRequest from parent, was canceled
+// because cancellation was requested rather than because parent is done.
+func wasCanceled(parent, ctx context.Context) bool {
+	return ctx.Err() != nil && parent.Err() == nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCancelRequest(t *testing.T) {
+	t.Run("context is canceled once cancellation is requested", func(t *testing.T) {
+		var requested int32
+		parent := context.Background()
+		ctx, cancel := withCancelRequest(parent, time.Millisecond, func() bool { return atomic.LoadInt32(&requested) == 1 })
+		defer cancel()
+
+		assert.NoError(t, ctx.Err())
+		atomic.StoreInt32(&requested, 1)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("context was not canceled")
+		}
+		assert.True(t, wasCanceled(parent, ctx))
+	})
+
+	t.Run("canceled parent is not reported as a cancel request", func(t *testing.T) {
+		parent, cancelParent := context.WithCancel(context.Background())
+		ctx, cancel := withCancelRequest(parent, time.Hour, func() bool { return false })
+		defer cancel()
+
+		cancelParent()
+		<-ctx.Done()
+		assert.False(t, wasCanceled(parent, ctx))
+	})
+
+	t.Run("running context is not canceled", func(t *testing.T) {
+		parent := context.Background()
+		ctx, cancel := withCancelRequest(parent, time.Hour, func() bool { return true })
+		defer cancel()
+
+		assert.False(t, wasCanceled(parent, ctx))
+	})
+}
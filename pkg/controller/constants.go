@@ -17,24 +17,28 @@ limitations under the License.
 package controller
 
 const (
-	Backup                = "backup"
-	BackupDeletion        = "backup-deletion"
-	BackupStorageLocation = "backup-storage-location"
-	BackupSync            = "backup-sync"
-	DownloadRequest       = "download-request"
-	GarbageCollection     = "gc"
-	PodVolumeBackup       = "pod-volume-backup"
-	PodVolumeRestore      = "pod-volume-restore"
-	ResticRepo            = "restic-repo"
-	Restore               = "restore"
-	Schedule              = "schedule"
-	ServerStatusRequest   = "server-status-request"
+	Backup                     = "backup"
+	BackupCopyBack             = "backup-copy-back"
+	BackupDeletion             = "backup-deletion"
+	BackupStorageLocation      = "backup-storage-location"
+	BackupStorageLocationUsage = "backup-storage-location-usage"
+	BackupSync                 = "backup-sync"
+	DownloadRequest            = "download-request"
+	GarbageCollection          = "gc"
+	PodVolumeBackup            = "pod-volume-backup"
+	PodVolumeRestore           = "pod-volume-restore"
+	ResticRepo                 = "restic-repo"
+	Restore                    = "restore"
+	Schedule                   = "schedule"
+	ServerStatusRequest        = "server-status-request"
 )
 
 // DisableableControllers is a list of controllers that can be disabled
 var DisableableControllers = []string{
 	Backup,
+	BackupCopyBack,
 	BackupDeletion,
+	BackupStorageLocationUsage,
 	BackupSync,
 	DownloadRequest,
 	GarbageCollection,
@@ -0,0 +1,343 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// NewDataDownloadReconciler returns a reconciler for the DataDownloads of the node agent running
+// on nodeName. Data mover pods use image.
+func NewDataDownloadReconciler(logger logrus.FieldLogger, client client.Client, credentialGetter *credentials.CredentialGetter,
+	repoEnsurer *repository.RepositoryEnsurer, loadLimiter *nodeagent.LoadLimiter, nodeName, image string) *DataDownloadReconciler {
+	return &DataDownloadReconciler{
+		client:           client,
+		logger:           logger.WithField("controller", "DataDownload"),
+		credentialGetter: credentialGetter,
+		repoEnsurer:      repoEnsurer,
+		loadLimiter:      loadLimiter,
+		nodeName:         nodeName,
+		image:            image,
+		fileSystem:       filesystem.NewFileSystem(),
+		clock:            &clock.RealClock{},
+	}
+}
+
+// DataDownloadReconciler downloads data that DataUploads moved to the backup repository into
+// new volumes for restored PersistentVolumeClaims. Every node agent tries to accept a new
+// DataDownload, and the one that does provisions a volume and mounts it in a pod. The node
+// agent on the node the pod runs on then downloads the data and binds the volume to the claim.
+type DataDownloadReconciler struct {
+	client           client.Client
+	logger           logrus.FieldLogger
+	credentialGetter *credentials.CredentialGetter
+	repoEnsurer      *repository.RepositoryEnsurer
+	loadLimiter      *nodeagent.LoadLimiter
+	nodeName         string
+	image            string
+	fileSystem       filesystem.Interface
+	clock            clock.Clock
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=datadownloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods;persistentvolumeclaims,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;update;patch
+
+func (r *DataDownloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("datadownload", req.NamespacedName.String())
+
+	dd := &velerov1api.DataDownload{}
+	if err := r.client.Get(ctx, req.NamespacedName, dd); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find DataDownload")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "getting DataDownload")
+	}
+
+	switch dd.Status.Phase {
+	case "", velerov1api.DataDownloadPhaseNew:
+		if dd.Spec.Cancel {
+			return r.updateStatusToCanceled(ctx, dd, "canceled before it started", log)
+		}
+		return r.accept(ctx, dd, log)
+	case velerov1api.DataDownloadPhaseAccepted:
+		return r.prepare(ctx, dd, log)
+	case velerov1api.DataDownloadPhasePrepared:
+		// The download hasn't started yet because the node was busy, or the node agent
+		// restarted.
+		if dd.Status.Node != r.nodeName {
+			return ctrl.Result{}, nil
+		}
+		if dd.Spec.Cancel {
+			datamover.CleanUpVolume(ctx, r.client, dd, log)
+			return r.updateStatusToCanceled(ctx, dd, "canceled before it started", log)
+		}
+		return r.run(ctx, dd, log)
+	default:
+		log.Debug("DataDownload is not new, not processing")
+		return ctrl.Result{}, nil
+	}
+}
+
+// SetupWithManager registers the DataDownload controller. The data mover pods are watched
+// so that the node they're scheduled on picks up their DataDownloads once they're running.
+func (r *DataDownloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.DataDownload{}).
+		Watches(&source.Kind{Type: &corev1api.Pod{}}, handler.EnqueueRequestsFromMapFunc(findDataMoverRequestForPod(velerov1api.DataDownloadLabel))).
+		WithOptions(controller.Options{MaxConcurrentReconciles: maxConcurrentReconciles(r.loadLimiter)}).
+		Complete(r)
+}
+
+// accept marks dd as accepted by this node and exposes a volume for it. Every node agent
+// tries to accept a new DataDownload; the optimistic lock lets only one of them succeed.
+func (r *DataDownloadReconciler) accept(ctx context.Context, dd *velerov1api.DataDownload, log logrus.FieldLogger) (ctrl.Result, error) {
+	original := dd.DeepCopy()
+	dd.Status.Phase = velerov1api.DataDownloadPhaseAccepted
+	dd.Status.Node = r.nodeName
+	dd.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, dd, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		if apierrors.IsConflict(err) {
+			log.Debug("DataDownload was accepted by another node")
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Error("error updating DataDownload status")
+		return ctrl.Result{}, err
+	}
+	log.Info("DataDownload accepted")
+
+	if err := datamover.ExposeVolume(ctx, r.client, dd, r.image, log); err != nil {
+		datamover.CleanUpVolume(ctx, r.client, dd, log)
+		return r.updateStatusToFailed(ctx, dd, err, "error exposing volume", log)
+	}
+
+	return ctrl.Result{RequeueAfter: dataMoverPreparePollInterval}, nil
+}
+
+// prepare starts the download of an accepted DataDownload if its volume is mounted on this
+// node. The node that accepted it fails it if the volume isn't mounted in time.
+func (r *DataDownloadReconciler) prepare(ctx context.Context, dd *velerov1api.DataDownload, log logrus.FieldLogger) (ctrl.Result, error) {
+	pod := &corev1api.Pod{}
+	err := r.client.Get(ctx, client.ObjectKey{Namespace: dd.Namespace, Name: dd.Name}, pod)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, errors.Wrap(err, "getting data mover pod")
+	}
+
+	if err == nil && pod.Spec.NodeName == r.nodeName && kube.IsPodRunning(pod) == nil && !dd.Spec.Cancel {
+		original := dd.DeepCopy()
+		dd.Status.Phase = velerov1api.DataDownloadPhasePrepared
+		dd.Status.Node = r.nodeName
+		if err := r.client.Patch(ctx, dd, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			log.WithError(err).Error("error updating DataDownload status")
+			return ctrl.Result{}, err
+		}
+		return r.run(ctx, dd, log)
+	}
+
+	if dd.Status.Node != r.nodeName {
+		return ctrl.Result{}, nil
+	}
+	if dd.Spec.Cancel {
+		datamover.CleanUpVolume(ctx, r.client, dd, log)
+		return r.updateStatusToCanceled(ctx, dd, "canceled before it started", log)
+	}
+	if dd.Status.StartTimestamp != nil && r.clock.Now().Sub(dd.Status.StartTimestamp.Time) > dataMoverPrepareTimeout {
+		datamover.CleanUpVolume(ctx, r.client, dd, log)
+		return r.updateStatusToFailed(ctx, dd, errors.New("timed out"), "error waiting for the volume to be mounted", log)
+	}
+	return ctrl.Result{RequeueAfter: dataMoverPreparePollInterval}, nil
+}
+
+// run downloads the data of dd into the volume mounted in the data mover pod on this node,
+// then binds the volume to the restored PersistentVolumeClaim.
+func (r *DataDownloadReconciler) run(ctx context.Context, dd *velerov1api.DataDownload, log logrus.FieldLogger) (ctrl.Result, error) {
+	if !r.loadLimiter.TryAcquire() {
+		log.Debugf("Node is running %d data operations already, waiting", r.loadLimiter.Limit())
+		return ctrl.Result{RequeueAfter: queuedRequeueInterval}, nil
+	}
+	defer r.loadLimiter.Release()
+
+	original := dd.DeepCopy()
+	dd.Status.Phase = velerov1api.DataDownloadPhaseInProgress
+	if err := r.client.Patch(ctx, dd, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataDownload status")
+		return ctrl.Result{}, err
+	}
+
+	if err := r.download(ctx, dd, log); err != nil {
+		datamover.CleanUpVolume(ctx, r.client, dd, log)
+		if errors.Is(err, context.Canceled) && ctx.Err() == nil {
+			return r.updateStatusToCanceled(ctx, dd, "canceled while running", log)
+		}
+		return r.updateStatusToFailed(ctx, dd, err, "error downloading data", log)
+	}
+
+	if err := datamover.RebindVolume(ctx, r.client, dd, log); err != nil {
+		datamover.CleanUpVolume(ctx, r.client, dd, log)
+		return r.updateStatusToFailed(ctx, dd, err, "error binding volume", log)
+	}
+
+	original = dd.DeepCopy()
+	dd.Status.Phase = velerov1api.DataDownloadPhaseCompleted
+	dd.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, dd, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataDownload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("DataDownload completed")
+	return ctrl.Result{}, nil
+}
+
+// download restores the snapshot of dd from the backup repository into the volume mounted
+// in the data mover pod. It returns context.Canceled if dd is canceled while it's running.
+func (r *DataDownloadReconciler) download(ctx context.Context, dd *velerov1api.DataDownload, log logrus.FieldLogger) error {
+	if dd.Spec.SnapshotID == "" {
+		log.Info("The backed up volume was empty, so there's no data to download")
+		return nil
+	}
+
+	pod := &corev1api.Pod{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: dd.Namespace, Name: dd.Name}, pod); err != nil {
+		return errors.Wrap(err, "error getting data mover pod")
+	}
+
+	volDir, err := kube.GetVolumeDirectory(ctx, log, pod, datamover.DataMoverVolume, r.client)
+	if err != nil {
+		return errors.Wrap(err, "error getting volume directory name")
+	}
+	path, err := kube.SinglePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(pod.UID), volDir), r.fileSystem, log)
+	if err != nil {
+		return errors.Wrap(err, "error identifying unique volume path on host")
+	}
+	log.WithField("path", path).Debugf("Found path matching glob")
+
+	backupLocation := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: dd.Namespace, Name: dd.Spec.BackupStorageLocation}, backupLocation); err != nil {
+		return errors.Wrap(err, "error getting backup storage location")
+	}
+
+	backupRepo, err := r.repoEnsurer.EnsureRepo(ctx, dd.Namespace, dd.Spec.SourceNamespace, dd.Spec.BackupStorageLocation, velerov1api.BackupRepositoryTypeKopia)
+	if err != nil {
+		return errors.Wrap(err, "error ensuring backup repository")
+	}
+
+	uploaderProv, err := NewUploaderProviderFunc(ctx, r.client, uploader.KopiaType, "",
+		backupLocation, backupRepo, r.credentialGetter, repokey.RepoKeySelector(backupRepo), log)
+	if err != nil {
+		return errors.Wrap(err, "error creating uploader")
+	}
+	defer func() {
+		if err := uploaderProv.Close(ctx); err != nil {
+			log.Errorf("failed to close uploader provider with error %v", err)
+		}
+	}()
+
+	// Stop the download if the DataDownload is canceled while it's running.
+	downloadCtx, cancelDownload := withCancelRequest(ctx, cancelPollInterval, func() bool {
+		current := &velerov1api.DataDownload{}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(dd), current); err != nil {
+			log.WithError(err).Debug("Unable to check whether DataDownload was canceled")
+			return false
+		}
+		return current.Spec.Cancel
+	})
+	defer cancelDownload()
+
+	updater := &dataDownloadProgressUpdater{dataDownload: dd, log: log, ctx: ctx, client: r.client}
+	if err := uploaderProv.RunRestore(downloadCtx, dd.Spec.SnapshotID, path, updater); err != nil {
+		if wasCanceled(ctx, downloadCtx) {
+			return context.Canceled
+		}
+		return errors.Wrap(err, "error running restore")
+	}
+	return nil
+}
+
+func (r *DataDownloadReconciler) updateStatusToFailed(ctx context.Context, dd *velerov1api.DataDownload, err error, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.WithError(err).Error(msg)
+
+	original := dd.DeepCopy()
+	dd.Status.Phase = velerov1api.DataDownloadPhaseFailed
+	dd.Status.Message = errors.WithMessage(err, msg).Error()
+	dd.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, dd, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataDownload status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DataDownloadReconciler) updateStatusToCanceled(ctx context.Context, dd *velerov1api.DataDownload, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Info("DataDownload canceled")
+
+	original := dd.DeepCopy()
+	dd.Status.Phase = velerov1api.DataDownloadPhaseCanceled
+	dd.Status.Message = msg
+	dd.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, dd, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataDownload status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// dataDownloadProgressUpdater records the progress of a download in its DataDownload.
+type dataDownloadProgressUpdater struct {
+	dataDownload *velerov1api.DataDownload
+	log          logrus.FieldLogger
+	ctx          context.Context
+	client       client.Client
+}
+
+// UpdateProgress implements uploader.ProgressUpdater.
+func (u *dataDownloadProgressUpdater) UpdateProgress(p *uploader.UploaderProgress) {
+	original := u.dataDownload.DeepCopy()
+	u.dataDownload.Status.Progress = velerov1api.PodVolumeOperationProgress{TotalBytes: p.TotalBytes, BytesDone: p.BytesDone}
+	if err := u.client.Patch(u.ctx, u.dataDownload, client.MergeFrom(original)); err != nil {
+		u.log.WithError(err).Errorf("error updating progress of DataDownload %s", u.dataDownload.Name)
+	}
+}
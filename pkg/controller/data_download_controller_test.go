@@ -0,0 +1,197 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func dataDownloadWith(phase velerov1api.DataDownloadPhase, node string, cancel bool, start time.Time) *velerov1api.DataDownload {
+	return &velerov1api.DataDownload{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "restore-1-abcde"},
+		Spec: velerov1api.DataDownloadSpec{
+			TargetPVC:             "pvc-1",
+			TargetNamespace:       "ns-1",
+			SnapshotID:            "snapshot-id",
+			SourceNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			Cancel:                cancel,
+		},
+		Status: velerov1api.DataDownloadStatus{
+			Phase:          phase,
+			Node:           node,
+			StartTimestamp: &metav1.Time{Time: start},
+		},
+	}
+}
+
+func runningDataMoverPod(name, node string) *corev1api.Pod {
+	pod := builder.ForPod(velerov1api.DefaultNamespace, name).NodeName(node).Result()
+	pod.Status.Phase = corev1api.PodRunning
+	return pod
+}
+
+func TestDataDownloadReconcile(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	targetPVC := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("csi-sc").Result()
+
+	tests := []struct {
+		name            string
+		dataDownload    *velerov1api.DataDownload
+		objects         []runtime.Object
+		busy            bool
+		expectedResult  ctrl.Result
+		expectedPhase   velerov1api.DataDownloadPhase
+		expectedNode    string
+		expectedMessage string
+		expectPod       bool
+	}{
+		{
+			name:           "a data download that doesn't exist is ignored",
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:            "a new data download that's canceled is canceled",
+			dataDownload:    dataDownloadWith("", "", true, now),
+			expectedPhase:   velerov1api.DataDownloadPhaseCanceled,
+			expectedMessage: "canceled before it started",
+		},
+		{
+			name:           "a new data download is accepted and a volume is exposed for it",
+			dataDownload:   dataDownloadWith(velerov1api.DataDownloadPhaseNew, "", false, now),
+			objects:        []runtime.Object{targetPVC},
+			expectedResult: ctrl.Result{RequeueAfter: dataMoverPreparePollInterval},
+			expectedPhase:  velerov1api.DataDownloadPhaseAccepted,
+			expectedNode:   "node-1",
+			expectPod:      true,
+		},
+		{
+			name:            "a new data download whose target claim doesn't exist fails",
+			dataDownload:    dataDownloadWith(velerov1api.DataDownloadPhaseNew, "", false, now),
+			expectedPhase:   velerov1api.DataDownloadPhaseFailed,
+			expectedNode:    "node-1",
+			expectedMessage: "error exposing volume: error getting persistent volume claim ns-1/pvc-1",
+		},
+		{
+			name:           "a data download accepted by another node is left to it",
+			dataDownload:   dataDownloadWith(velerov1api.DataDownloadPhaseAccepted, "node-2", false, now),
+			expectedPhase:  velerov1api.DataDownloadPhaseAccepted,
+			expectedNode:   "node-2",
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:           "a data download whose volume is mounted on this node is prepared",
+			dataDownload:   dataDownloadWith(velerov1api.DataDownloadPhaseAccepted, "node-2", false, now),
+			objects:        []runtime.Object{runningDataMoverPod("restore-1-abcde", "node-1")},
+			busy:           true,
+			expectedResult: ctrl.Result{RequeueAfter: queuedRequeueInterval},
+			expectedPhase:  velerov1api.DataDownloadPhasePrepared,
+			expectedNode:   "node-1",
+			expectPod:      true,
+		},
+		{
+			name:            "a data download accepted by this node that's canceled is canceled",
+			dataDownload:    dataDownloadWith(velerov1api.DataDownloadPhaseAccepted, "node-1", true, now),
+			expectedPhase:   velerov1api.DataDownloadPhaseCanceled,
+			expectedNode:    "node-1",
+			expectedMessage: "canceled before it started",
+		},
+		{
+			name:            "a data download whose volume isn't mounted in time fails",
+			dataDownload:    dataDownloadWith(velerov1api.DataDownloadPhaseAccepted, "node-1", false, now.Add(-dataMoverPrepareTimeout-time.Minute)),
+			expectedPhase:   velerov1api.DataDownloadPhaseFailed,
+			expectedNode:    "node-1",
+			expectedMessage: "error waiting for the volume to be mounted: timed out",
+		},
+		{
+			name:           "a data download prepared on another node is left to it",
+			dataDownload:   dataDownloadWith(velerov1api.DataDownloadPhasePrepared, "node-2", false, now),
+			expectedPhase:  velerov1api.DataDownloadPhasePrepared,
+			expectedNode:   "node-2",
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:            "a data download prepared on this node that's canceled is canceled",
+			dataDownload:    dataDownloadWith(velerov1api.DataDownloadPhasePrepared, "node-1", true, now),
+			objects:         []runtime.Object{runningDataMoverPod("restore-1-abcde", "node-1")},
+			expectedPhase:   velerov1api.DataDownloadPhaseCanceled,
+			expectedNode:    "node-1",
+			expectedMessage: "canceled before it started",
+		},
+		{
+			name:           "a completed data download isn't processed",
+			dataDownload:   dataDownloadWith(velerov1api.DataDownloadPhaseCompleted, "node-1", false, now),
+			expectedPhase:  velerov1api.DataDownloadPhaseCompleted,
+			expectedNode:   "node-1",
+			expectedResult: ctrl.Result{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objects := test.objects
+			if test.dataDownload != nil {
+				objects = append(objects, test.dataDownload)
+			}
+			client := velerotest.NewFakeControllerRuntimeClient(t, objects...)
+
+			limiter := nodeagent.NewLoadLimiter(1)
+			if test.busy {
+				require.True(t, limiter.TryAcquire())
+			}
+
+			r := NewDataDownloadReconciler(velerotest.NewLogger(), client, nil, nil, limiter, "node-1", "velero/velero:main")
+			r.clock = clock.NewFakeClock(now)
+
+			key := types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "restore-1-abcde"}
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResult, result)
+
+			if test.dataDownload == nil {
+				return
+			}
+
+			dd := &velerov1api.DataDownload{}
+			require.NoError(t, client.Get(context.Background(), key, dd))
+			assert.Equal(t, test.expectedPhase, dd.Status.Phase)
+			assert.Equal(t, test.expectedNode, dd.Status.Node)
+			assert.Contains(t, dd.Status.Message, test.expectedMessage)
+
+			pod := &corev1api.Pod{}
+			err = client.Get(context.Background(), kbclient.ObjectKey{Namespace: key.Namespace, Name: key.Name}, pod)
+			assert.Equal(t, test.expectPod, err == nil, "data mover pod exists")
+		})
+	}
+}
@@ -0,0 +1,356 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+const (
+	// dataMoverPrepareTimeout is how long the node that accepted a DataUpload or DataDownload
+	// waits for its snapshot or volume to be mounted on a node before failing it.
+	dataMoverPrepareTimeout = 30 * time.Minute
+
+	// dataMoverPreparePollInterval is how often the node that accepted a DataUpload or
+	// DataDownload checks whether it was canceled or timed out while it's being prepared.
+	dataMoverPreparePollInterval = time.Minute
+)
+
+// NewDataUploadReconciler returns a reconciler for the DataUploads of the node agent running on
+// nodeName. Data mover pods use image.
+func NewDataUploadReconciler(logger logrus.FieldLogger, client client.Client, credentialGetter *credentials.CredentialGetter,
+	repoEnsurer *repository.RepositoryEnsurer, loadLimiter *nodeagent.LoadLimiter, nodeName, image string) *DataUploadReconciler {
+	return &DataUploadReconciler{
+		client:           client,
+		logger:           logger.WithField("controller", "DataUpload"),
+		credentialGetter: credentialGetter,
+		repoEnsurer:      repoEnsurer,
+		loadLimiter:      loadLimiter,
+		nodeName:         nodeName,
+		image:            image,
+		fileSystem:       filesystem.NewFileSystem(),
+		clock:            &clock.RealClock{},
+	}
+}
+
+// DataUploadReconciler uploads the data of CSI snapshots to the backup repository. Every node
+// agent tries to accept a new DataUpload, and the one that does exposes its snapshot in a pod.
+// The node agent on the node the pod runs on then uploads the snapshot's data.
+type DataUploadReconciler struct {
+	client           client.Client
+	logger           logrus.FieldLogger
+	credentialGetter *credentials.CredentialGetter
+	repoEnsurer      *repository.RepositoryEnsurer
+	loadLimiter      *nodeagent.LoadLimiter
+	nodeName         string
+	image            string
+	fileSystem       filesystem.Interface
+	clock            clock.Clock
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods;persistentvolumeclaims,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots;volumesnapshotcontents,verbs=get;list;watch;create;delete
+
+func (r *DataUploadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("dataupload", req.NamespacedName.String())
+
+	du := &velerov1api.DataUpload{}
+	if err := r.client.Get(ctx, req.NamespacedName, du); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find DataUpload")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrap(err, "getting DataUpload")
+	}
+
+	switch du.Status.Phase {
+	case "", velerov1api.DataUploadPhaseNew:
+		if du.Spec.Cancel {
+			return r.updateStatusToCanceled(ctx, du, "canceled before it started", log)
+		}
+		return r.accept(ctx, du, log)
+	case velerov1api.DataUploadPhaseAccepted:
+		return r.prepare(ctx, du, log)
+	case velerov1api.DataUploadPhasePrepared:
+		// The upload hasn't started yet because the node was busy, or the node agent
+		// restarted.
+		if du.Status.Node != r.nodeName {
+			return ctrl.Result{}, nil
+		}
+		if du.Spec.Cancel {
+			datamover.CleanUpSnapshot(ctx, r.client, du, log)
+			return r.updateStatusToCanceled(ctx, du, "canceled before it started", log)
+		}
+		return r.run(ctx, du, log)
+	default:
+		log.Debug("DataUpload is not new, not processing")
+		return ctrl.Result{}, nil
+	}
+}
+
+// SetupWithManager registers the DataUpload controller. The data mover pods are watched so
+// that the node they're scheduled on picks up their DataUploads once they're running.
+func (r *DataUploadReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.DataUpload{}).
+		Watches(&source.Kind{Type: &corev1api.Pod{}}, handler.EnqueueRequestsFromMapFunc(findDataMoverRequestForPod(velerov1api.DataUploadLabel))).
+		WithOptions(controller.Options{MaxConcurrentReconciles: maxConcurrentReconciles(r.loadLimiter)}).
+		Complete(r)
+}
+
+// findDataMoverRequestForPod returns a function that maps a data mover pod to the request
+// for the DataUpload or DataDownload named in its label.
+func findDataMoverRequestForPod(label string) handler.MapFunc {
+	return func(pod client.Object) []reconcile.Request {
+		name, ok := pod.GetLabels()[label]
+		if !ok {
+			return []reconcile.Request{}
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: pod.GetNamespace(), Name: name}}}
+	}
+}
+
+// accept marks du as accepted by this node and exposes its snapshot. Every node agent
+// tries to accept a new DataUpload; the optimistic lock lets only one of them succeed.
+func (r *DataUploadReconciler) accept(ctx context.Context, du *velerov1api.DataUpload, log logrus.FieldLogger) (ctrl.Result, error) {
+	original := du.DeepCopy()
+	du.Status.Phase = velerov1api.DataUploadPhaseAccepted
+	du.Status.Node = r.nodeName
+	du.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, du, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		if apierrors.IsConflict(err) {
+			log.Debug("DataUpload was accepted by another node")
+			return ctrl.Result{}, nil
+		}
+		log.WithError(err).Error("error updating DataUpload status")
+		return ctrl.Result{}, err
+	}
+	log.Info("DataUpload accepted")
+
+	if err := datamover.ExposeSnapshot(ctx, r.client, du, r.image, log); err != nil {
+		datamover.CleanUpSnapshot(ctx, r.client, du, log)
+		return r.updateStatusToFailed(ctx, du, err, "error exposing snapshot", log)
+	}
+
+	return ctrl.Result{RequeueAfter: dataMoverPreparePollInterval}, nil
+}
+
+// prepare starts the upload of an accepted DataUpload if its snapshot is mounted on this
+// node. The node that accepted it fails it if the snapshot isn't mounted in time.
+func (r *DataUploadReconciler) prepare(ctx context.Context, du *velerov1api.DataUpload, log logrus.FieldLogger) (ctrl.Result, error) {
+	pod := &corev1api.Pod{}
+	err := r.client.Get(ctx, client.ObjectKey{Namespace: du.Namespace, Name: du.Name}, pod)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, errors.Wrap(err, "getting data mover pod")
+	}
+
+	if err == nil && pod.Spec.NodeName == r.nodeName && kube.IsPodRunning(pod) == nil && !du.Spec.Cancel {
+		original := du.DeepCopy()
+		du.Status.Phase = velerov1api.DataUploadPhasePrepared
+		du.Status.Node = r.nodeName
+		if err := r.client.Patch(ctx, du, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			log.WithError(err).Error("error updating DataUpload status")
+			return ctrl.Result{}, err
+		}
+		return r.run(ctx, du, log)
+	}
+
+	if du.Status.Node != r.nodeName {
+		return ctrl.Result{}, nil
+	}
+	if du.Spec.Cancel {
+		datamover.CleanUpSnapshot(ctx, r.client, du, log)
+		return r.updateStatusToCanceled(ctx, du, "canceled before it started", log)
+	}
+	if du.Status.StartTimestamp != nil && r.clock.Now().Sub(du.Status.StartTimestamp.Time) > dataMoverPrepareTimeout {
+		datamover.CleanUpSnapshot(ctx, r.client, du, log)
+		return r.updateStatusToFailed(ctx, du, errors.New("timed out"), "error waiting for the snapshot to be mounted", log)
+	}
+	return ctrl.Result{RequeueAfter: dataMoverPreparePollInterval}, nil
+}
+
+// run uploads the data of du's snapshot, which is mounted in the data mover pod on this node.
+func (r *DataUploadReconciler) run(ctx context.Context, du *velerov1api.DataUpload, log logrus.FieldLogger) (ctrl.Result, error) {
+	if !r.loadLimiter.TryAcquire() {
+		log.Debugf("Node is running %d data operations already, waiting", r.loadLimiter.Limit())
+		return ctrl.Result{RequeueAfter: queuedRequeueInterval}, nil
+	}
+	defer r.loadLimiter.Release()
+
+	original := du.DeepCopy()
+	du.Status.Phase = velerov1api.DataUploadPhaseInProgress
+	if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataUpload status")
+		return ctrl.Result{}, err
+	}
+	defer datamover.CleanUpSnapshot(ctx, r.client, du, log)
+
+	pod := &corev1api.Pod{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: du.Namespace, Name: du.Name}, pod); err != nil {
+		return r.updateStatusToFailed(ctx, du, err, "error getting data mover pod", log)
+	}
+
+	volDir, err := kube.GetVolumeDirectory(ctx, log, pod, datamover.DataMoverVolume, r.client)
+	if err != nil {
+		return r.updateStatusToFailed(ctx, du, err, "error getting volume directory name", log)
+	}
+	path, err := kube.SinglePathMatch(fmt.Sprintf("/host_pods/%s/volumes/*/%s", string(pod.UID), volDir), r.fileSystem, log)
+	if err != nil {
+		return r.updateStatusToFailed(ctx, du, err, "error identifying unique volume path on host", log)
+	}
+	log.WithField("path", path).Debugf("Found path matching glob")
+
+	backupLocation := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: du.Namespace, Name: du.Spec.BackupStorageLocation}, backupLocation); err != nil {
+		return r.updateStatusToFailed(ctx, du, err, "error getting backup storage location", log)
+	}
+
+	backupRepo, err := r.repoEnsurer.EnsureRepo(ctx, du.Namespace, du.Spec.SourceNamespace, du.Spec.BackupStorageLocation, velerov1api.BackupRepositoryTypeKopia)
+	if err != nil {
+		return r.updateStatusToFailed(ctx, du, err, "error ensuring backup repository", log)
+	}
+
+	uploaderProv, err := NewUploaderProviderFunc(ctx, r.client, uploader.KopiaType, "",
+		backupLocation, backupRepo, r.credentialGetter, repokey.RepoKeySelector(backupRepo), log)
+	if err != nil {
+		return r.updateStatusToFailed(ctx, du, err, "error creating uploader", log)
+	}
+	defer func() {
+		if err := uploaderProv.Close(ctx); err != nil {
+			log.Errorf("failed to close uploader provider with error %v", err)
+		}
+	}()
+
+	// Stop the upload if the DataUpload is canceled while it's running.
+	uploadCtx, cancelUpload := withCancelRequest(ctx, cancelPollInterval, func() bool {
+		current := &velerov1api.DataUpload{}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(du), current); err != nil {
+			log.WithError(err).Debug("Unable to check whether DataUpload was canceled")
+			return false
+		}
+		return current.Spec.Cancel
+	})
+	defer cancelUpload()
+
+	tags := map[string]string{
+		"backup":     du.Labels[velerov1api.BackupNameLabel],
+		"backup-uid": du.Labels[velerov1api.BackupUIDLabel],
+		"ns":         du.Spec.SourceNamespace,
+		"pvc":        du.Spec.SourcePVC,
+	}
+	updater := &dataUploadProgressUpdater{dataUpload: du, log: log, ctx: ctx, client: r.client}
+	snapshotID, emptySnapshot, err := uploaderProv.RunBackup(uploadCtx, path, tags, "", updater)
+	if err != nil {
+		if wasCanceled(ctx, uploadCtx) {
+			return r.updateStatusToCanceled(ctx, du, "canceled while running", log)
+		}
+		return r.updateStatusToFailed(ctx, du, err, "error running backup", log)
+	}
+
+	original = du.DeepCopy()
+	du.Status.Phase = velerov1api.DataUploadPhaseCompleted
+	du.Status.Path = path
+	du.Status.SnapshotID = snapshotID
+	du.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if emptySnapshot {
+		du.Status.Message = "volume was empty so no snapshot was taken"
+	}
+	if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataUpload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("DataUpload completed")
+	return ctrl.Result{}, nil
+}
+
+func (r *DataUploadReconciler) updateStatusToFailed(ctx context.Context, du *velerov1api.DataUpload, err error, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.WithError(err).Error(msg)
+
+	original := du.DeepCopy()
+	du.Status.Phase = velerov1api.DataUploadPhaseFailed
+	du.Status.Message = errors.WithMessage(err, msg).Error()
+	du.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataUpload status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DataUploadReconciler) updateStatusToCanceled(ctx context.Context, du *velerov1api.DataUpload, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Info("DataUpload canceled")
+
+	original := du.DeepCopy()
+	du.Status.Phase = velerov1api.DataUploadPhaseCanceled
+	du.Status.Message = msg
+	du.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if err := r.client.Patch(ctx, du, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating DataUpload status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// dataUploadProgressUpdater records the progress of an upload in its DataUpload.
+type dataUploadProgressUpdater struct {
+	dataUpload *velerov1api.DataUpload
+	log        logrus.FieldLogger
+	ctx        context.Context
+	client     client.Client
+}
+
+// UpdateProgress implements uploader.ProgressUpdater.
+func (u *dataUploadProgressUpdater) UpdateProgress(p *uploader.UploaderProgress) {
+	original := u.dataUpload.DeepCopy()
+	u.dataUpload.Status.Progress = velerov1api.PodVolumeOperationProgress{TotalBytes: p.TotalBytes, BytesDone: p.BytesDone}
+	if err := u.client.Patch(u.ctx, u.dataUpload, client.MergeFrom(original)); err != nil {
+		u.log.WithError(err).Errorf("error updating progress of DataUpload %s", u.dataUpload.Name)
+	}
+}
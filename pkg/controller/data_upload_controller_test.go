@@ -0,0 +1,220 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func dataUploadWith(phase velerov1api.DataUploadPhase, node string, cancel bool, start time.Time) *velerov1api.DataUpload {
+	return &velerov1api.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "backup-1-abcde"},
+		Spec: velerov1api.DataUploadSpec{
+			VolumeSnapshot:        "snapshot-1",
+			SourcePVC:             "pvc-1",
+			SourceNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			Cancel:                cancel,
+		},
+		Status: velerov1api.DataUploadStatus{
+			Phase:          phase,
+			Node:           node,
+			StartTimestamp: &metav1.Time{Time: start},
+		},
+	}
+}
+
+// snapshotObjects returns a ready VolumeSnapshot of pvc-1 in ns-1, its content and the
+// claim, which a DataUpload's snapshot can be exposed from.
+func snapshotObjects() []runtime.Object {
+	ready := true
+	contentName := "content-1"
+	restoreSize := resource.MustParse("1Gi")
+	handle := "snap-0123"
+
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("csi-sc").Result()
+	pvc.Spec.Resources.Requests = corev1api.ResourceList{corev1api.ResourceStorage: restoreSize}
+
+	return []runtime.Object{
+		&snapshotv1api.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "snapshot-1"},
+			Status: &snapshotv1api.VolumeSnapshotStatus{
+				BoundVolumeSnapshotContentName: &contentName,
+				ReadyToUse:                     &ready,
+				RestoreSize:                    &restoreSize,
+			},
+		},
+		&snapshotv1api.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{Name: contentName},
+			Spec: snapshotv1api.VolumeSnapshotContentSpec{
+				DeletionPolicy: snapshotv1api.VolumeSnapshotContentDelete,
+				Driver:         "csi.example.com",
+			},
+			Status: &snapshotv1api.VolumeSnapshotContentStatus{SnapshotHandle: &handle},
+		},
+		pvc,
+	}
+}
+
+func TestDataUploadReconcile(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		dataUpload      *velerov1api.DataUpload
+		objects         []runtime.Object
+		busy            bool
+		expectedResult  ctrl.Result
+		expectedPhase   velerov1api.DataUploadPhase
+		expectedNode    string
+		expectedMessage string
+		expectPod       bool
+	}{
+		{
+			name:           "a data upload that doesn't exist is ignored",
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:            "a new data upload that's canceled is canceled",
+			dataUpload:      dataUploadWith("", "", true, now),
+			expectedPhase:   velerov1api.DataUploadPhaseCanceled,
+			expectedMessage: "canceled before it started",
+		},
+		{
+			name:           "a new data upload is accepted and its snapshot is exposed",
+			dataUpload:     dataUploadWith(velerov1api.DataUploadPhaseNew, "", false, now),
+			objects:        snapshotObjects(),
+			expectedResult: ctrl.Result{RequeueAfter: dataMoverPreparePollInterval},
+			expectedPhase:  velerov1api.DataUploadPhaseAccepted,
+			expectedNode:   "node-1",
+			expectPod:      true,
+		},
+		{
+			name:            "a new data upload whose snapshot can't be exposed fails",
+			dataUpload:      dataUploadWith(velerov1api.DataUploadPhaseNew, "", false, now),
+			expectedPhase:   velerov1api.DataUploadPhaseFailed,
+			expectedNode:    "node-1",
+			expectedMessage: "error exposing snapshot: error getting volume snapshot ns-1/snapshot-1",
+		},
+		{
+			name:           "a data upload accepted by another node is left to it",
+			dataUpload:     dataUploadWith(velerov1api.DataUploadPhaseAccepted, "node-2", false, now),
+			expectedPhase:  velerov1api.DataUploadPhaseAccepted,
+			expectedNode:   "node-2",
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:           "a data upload accepted by this node waits for its snapshot to be mounted",
+			dataUpload:     dataUploadWith(velerov1api.DataUploadPhaseAccepted, "node-1", false, now.Add(-time.Minute)),
+			expectedPhase:  velerov1api.DataUploadPhaseAccepted,
+			expectedNode:   "node-1",
+			expectedResult: ctrl.Result{RequeueAfter: dataMoverPreparePollInterval},
+		},
+		{
+			name:            "a data upload accepted by this node that's canceled is canceled",
+			dataUpload:      dataUploadWith(velerov1api.DataUploadPhaseAccepted, "node-1", true, now),
+			expectedPhase:   velerov1api.DataUploadPhaseCanceled,
+			expectedNode:    "node-1",
+			expectedMessage: "canceled before it started",
+		},
+		{
+			name:            "a data upload whose snapshot isn't mounted in time fails",
+			dataUpload:      dataUploadWith(velerov1api.DataUploadPhaseAccepted, "node-1", false, now.Add(-dataMoverPrepareTimeout-time.Minute)),
+			expectedPhase:   velerov1api.DataUploadPhaseFailed,
+			expectedNode:    "node-1",
+			expectedMessage: "error waiting for the snapshot to be mounted: timed out",
+		},
+		{
+			name:           "a data upload prepared on another node is left to it",
+			dataUpload:     dataUploadWith(velerov1api.DataUploadPhasePrepared, "node-2", false, now),
+			expectedPhase:  velerov1api.DataUploadPhasePrepared,
+			expectedNode:   "node-2",
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:           "a data upload prepared on this node waits while the node is busy",
+			dataUpload:     dataUploadWith(velerov1api.DataUploadPhasePrepared, "node-1", false, now),
+			busy:           true,
+			expectedPhase:  velerov1api.DataUploadPhasePrepared,
+			expectedNode:   "node-1",
+			expectedResult: ctrl.Result{RequeueAfter: queuedRequeueInterval},
+		},
+		{
+			name:           "a completed data upload isn't processed",
+			dataUpload:     dataUploadWith(velerov1api.DataUploadPhaseCompleted, "node-1", false, now),
+			expectedPhase:  velerov1api.DataUploadPhaseCompleted,
+			expectedNode:   "node-1",
+			expectedResult: ctrl.Result{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objects := test.objects
+			if test.dataUpload != nil {
+				objects = append(objects, test.dataUpload)
+			}
+			client := velerotest.NewFakeControllerRuntimeClient(t, objects...)
+
+			limiter := nodeagent.NewLoadLimiter(1)
+			if test.busy {
+				require.True(t, limiter.TryAcquire())
+			}
+
+			r := NewDataUploadReconciler(velerotest.NewLogger(), client, nil, nil, limiter, "node-1", "velero/velero:main")
+			r.clock = clock.NewFakeClock(now)
+
+			key := types.NamespacedName{Namespace: velerov1api.DefaultNamespace, Name: "backup-1-abcde"}
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResult, result)
+
+			if test.dataUpload == nil {
+				return
+			}
+
+			du := &velerov1api.DataUpload{}
+			require.NoError(t, client.Get(context.Background(), key, du))
+			assert.Equal(t, test.expectedPhase, du.Status.Phase)
+			assert.Equal(t, test.expectedNode, du.Status.Node)
+			assert.Contains(t, du.Status.Message, test.expectedMessage)
+
+			pod := &corev1api.Pod{}
+			err = client.Get(context.Background(), kbclient.ObjectKey{Namespace: key.Namespace, Name: key.Name}, pod)
+			assert.Equal(t, test.expectPod, err == nil, "data mover pod exists")
+		})
+	}
+}
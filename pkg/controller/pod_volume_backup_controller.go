@@ -30,10 +30,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
@@ -46,6 +48,17 @@ import (
 // For unit test to mock function
 var NewUploaderProviderFunc = provider.NewUploaderProvider
 
+// queuedRequeueInterval is how often queued pod volume backups and restores check whether the
+// node has capacity to run them.
+const queuedRequeueInterval = 5 * time.Second
+
+// maxConcurrentReconciles returns the number of workers for a pod volume controller limited by
+// limiter. One worker more than the limit is used so that items that can't run yet are still
+// picked up and reported as Queued.
+func maxConcurrentReconciles(limiter *nodeagent.LoadLimiter) int {
+	return limiter.Limit() + 1
+}
+
 // PodVolumeBackupReconciler reconciles a PodVolumeBackup object
 type PodVolumeBackupReconciler struct {
 	Scheme           *runtime.Scheme
@@ -56,6 +69,8 @@ type PodVolumeBackupReconciler struct {
 	NodeName         string
 	FileSystem       filesystem.Interface
 	Log              logrus.FieldLogger
+	// LoadLimiter limits the number of pod volume backups and restores running on the node at once.
+	LoadLimiter *nodeagent.LoadLimiter
 }
 
 type BackupProgressUpdater struct {
@@ -96,13 +111,22 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	switch pvb.Status.Phase {
-	case "", velerov1api.PodVolumeBackupPhaseNew:
-		// Only process new items.
+	case "", velerov1api.PodVolumeBackupPhaseNew, velerov1api.PodVolumeBackupPhaseQueued:
+		// Only process new or queued items.
 	default:
 		log.Debug("PodVolumeBackup is not new, not processing")
 		return ctrl.Result{}, nil
 	}
 
+	if pvb.Spec.Cancel {
+		return r.updateStatusToCanceled(ctx, &pvb, "canceled before it started", log)
+	}
+
+	if !r.LoadLimiter.TryAcquire() {
+		return r.queue(ctx, &pvb, log)
+	}
+	defer r.LoadLimiter.Release()
+
 	r.Metrics.RegisterPodVolumeBackupEnqueue(r.NodeName)
 
 	// Update status to InProgress.
@@ -156,7 +180,7 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	var uploaderProv provider.Provider
 	uploaderProv, err = NewUploaderProviderFunc(ctx, r.Client, pvb.Spec.UploaderType, pvb.Spec.RepoIdentifier,
-		backupLocation, backupRepo, r.CredentialGetter, repokey.RepoKeySelector(), log)
+		backupLocation, backupRepo, r.CredentialGetter, repokey.RepoKeySelector(backupRepo), log)
 	if err != nil {
 		return r.updateStatusToFailed(ctx, &pvb, err, "error creating uploader", log)
 	}
@@ -182,8 +206,22 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}()
 
-	snapshotID, emptySnapshot, err := uploaderProv.RunBackup(ctx, path, pvb.Spec.Tags, parentSnapshotID, r.NewBackupProgressUpdater(&pvb, log, ctx))
+	// Stop the upload if the PodVolumeBackup is canceled while it's running.
+	uploadCtx, cancelUpload := withCancelRequest(ctx, cancelPollInterval, func() bool {
+		current := &velerov1api.PodVolumeBackup{}
+		if err := r.Client.Get(ctx, req.NamespacedName, current); err != nil {
+			log.WithError(err).Debug("Unable to check whether PodVolumeBackup was canceled")
+			return false
+		}
+		return current.Spec.Cancel
+	})
+	defer cancelUpload()
+
+	snapshotID, emptySnapshot, err := uploaderProv.RunBackup(uploadCtx, path, pvb.Spec.Tags, parentSnapshotID, r.NewBackupProgressUpdater(&pvb, log, ctx))
 	if err != nil {
+		if wasCanceled(ctx, uploadCtx) {
+			return r.updateStatusToCanceled(ctx, &pvb, "canceled while running", log)
+		}
 		return r.updateStatusToFailed(ctx, &pvb, err, fmt.Sprintf("running backup, stderr=%v", err), log)
 	}
 
@@ -217,9 +255,27 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 func (r *PodVolumeBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.PodVolumeBackup{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: maxConcurrentReconciles(r.LoadLimiter)}).
 		Complete(r)
 }
 
+// queue marks pvb as Queued, if it isn't already, and requeues it to be retried once the node
+// has capacity.
+func (r *PodVolumeBackupReconciler) queue(ctx context.Context, pvb *velerov1api.PodVolumeBackup, log logrus.FieldLogger) (ctrl.Result, error) {
+	if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseQueued {
+		log.Infof("Node is running %d pod volume operations already, queueing PodVolumeBackup", r.LoadLimiter.Limit())
+
+		original := pvb.DeepCopy()
+		pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseQueued
+		if err := r.Client.Patch(ctx, pvb, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("error updating PodVolumeBackup status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: queuedRequeueInterval}, nil
+}
+
 // getParentSnapshot finds the most recent completed PodVolumeBackup for the
 // specified PVC and returns its snapshot ID. Any errors encountered are
 // logged but not returned since they do not prevent a backup from proceeding.
@@ -292,6 +348,22 @@ func (r *PodVolumeBackupReconciler) updateStatusToFailed(ctx context.Context, pv
 	return ctrl.Result{}, nil
 }
 
+func (r *PodVolumeBackupReconciler) updateStatusToCanceled(ctx context.Context, pvb *velerov1api.PodVolumeBackup, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Info("PodVolumeBackup canceled")
+
+	original := pvb.DeepCopy()
+	pvb.Status.Phase = velerov1api.PodVolumeBackupPhaseCanceled
+	pvb.Status.Message = msg
+	pvb.Status.CompletionTimestamp = &metav1.Time{Time: r.Clock.Now()}
+
+	if err := r.Client.Patch(ctx, pvb, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("error updating PodVolumeBackup status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
 func (r *PodVolumeBackupReconciler) NewBackupProgressUpdater(pvb *velerov1api.PodVolumeBackup, log logrus.FieldLogger, ctx context.Context) *BackupProgressUpdater {
 	return &BackupProgressUpdater{pvb, log, ctx, r.Client}
 }
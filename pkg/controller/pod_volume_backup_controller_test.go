@@ -40,6 +40,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
@@ -93,12 +94,22 @@ func buildBackupRepo() *velerov1api.BackupRepository {
 	}
 }
 
+// fullLoadLimiter returns a LoadLimiter whose slots are all taken.
+func fullLoadLimiter(limit int) *nodeagent.LoadLimiter {
+	limiter := nodeagent.NewLoadLimiter(limit)
+	for i := 0; i < limit; i++ {
+		limiter.TryAcquire()
+	}
+	return limiter
+}
+
 var _ = Describe("PodVolumeBackup Reconciler", func() {
 	type request struct {
 		pvb               *velerov1api.PodVolumeBackup
 		pod               *corev1.Pod
 		bsl               *velerov1api.BackupStorageLocation
 		backupRepo        *velerov1api.BackupRepository
+		loadLimiter       *nodeagent.LoadLimiter
 		expectedProcessed bool
 		expected          *velerov1api.PodVolumeBackup
 		expectedRequeue   ctrl.Result
@@ -150,6 +161,7 @@ var _ = Describe("PodVolumeBackup Reconciler", func() {
 				NodeName:         "test_node",
 				FileSystem:       fakeFS,
 				Log:              velerotest.NewLogger(),
+				LoadLimiter:      test.loadLimiter,
 			}
 			NewUploaderProviderFunc = func(ctx context.Context, client kbclient.Client, uploaderType, repoIdentifier string, bsl *velerov1api.BackupStorageLocation, backupRepo *velerov1api.BackupRepository, credGetter *credentials.CredentialGetter, repoKeySelector *corev1.SecretKeySelector, log logrus.FieldLogger) (provider.Provider, error) {
 				return &fakeProvider{}, nil
@@ -215,6 +227,36 @@ var _ = Describe("PodVolumeBackup Reconciler", func() {
 				Result(),
 			expectedRequeue: ctrl.Result{},
 		}),
+		Entry("queued phase pvb on same node should be processed when the node has capacity", request{
+			pvb: pvbBuilder().
+				Phase(velerov1api.PodVolumeBackupPhaseQueued).
+				Node("test_node").
+				Result(),
+			pod:               podBuilder().Result(),
+			bsl:               bslBuilder().Result(),
+			backupRepo:        buildBackupRepo(),
+			loadLimiter:       nodeagent.NewLoadLimiter(1),
+			expectedProcessed: true,
+			expected: builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
+				Phase(velerov1api.PodVolumeBackupPhaseCompleted).
+				Result(),
+			expectedRequeue: ctrl.Result{},
+		}),
+		Entry("new phase pvb on same node should be queued when the node is at capacity", request{
+			pvb: pvbBuilder().
+				Phase(velerov1api.PodVolumeBackupPhaseNew).
+				Node("test_node").
+				Result(),
+			pod:               podBuilder().Result(),
+			bsl:               bslBuilder().Result(),
+			backupRepo:        buildBackupRepo(),
+			loadLimiter:       fullLoadLimiter(1),
+			expectedProcessed: false,
+			expected: builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").
+				Phase(velerov1api.PodVolumeBackupPhaseQueued).
+				Result(),
+			expectedRequeue: ctrl.Result{RequeueAfter: queuedRequeueInterval},
+		}),
 		Entry("in progress phase pvb on same node should not be processed", request{
 			pvb: pvbBuilder().
 				Phase(velerov1api.PodVolumeBackupPhaseInProgress).
@@ -33,12 +33,14 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/nodeagent"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
@@ -49,11 +51,12 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
-func NewPodVolumeRestoreReconciler(logger logrus.FieldLogger, client client.Client, credentialGetter *credentials.CredentialGetter) *PodVolumeRestoreReconciler {
+func NewPodVolumeRestoreReconciler(logger logrus.FieldLogger, client client.Client, credentialGetter *credentials.CredentialGetter, loadLimiter *nodeagent.LoadLimiter) *PodVolumeRestoreReconciler {
 	return &PodVolumeRestoreReconciler{
 		Client:           client,
 		logger:           logger.WithField("controller", "PodVolumeRestore"),
 		credentialGetter: credentialGetter,
+		loadLimiter:      loadLimiter,
 		fileSystem:       filesystem.NewFileSystem(),
 		clock:            &clock.RealClock{},
 	}
@@ -63,6 +66,7 @@ type PodVolumeRestoreReconciler struct {
 	client.Client
 	logger           logrus.FieldLogger
 	credentialGetter *credentials.CredentialGetter
+	loadLimiter      *nodeagent.LoadLimiter
 	fileSystem       filesystem.Interface
 	clock            clock.Clock
 }
@@ -105,6 +109,15 @@ func (c *PodVolumeRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, nil
 	}
 
+	if pvr.Spec.Cancel {
+		return c.updateStatusToCanceled(ctx, pvr, "canceled before it started", log)
+	}
+
+	if !c.loadLimiter.TryAcquire() {
+		return c.queue(ctx, pvr, log)
+	}
+	defer c.loadLimiter.Release()
+
 	resticInitContainerIndex := getResticInitContainerIndex(pod)
 	if resticInitContainerIndex > 0 {
 		log.Warnf(`Init containers before the %s container may cause issues
@@ -120,7 +133,22 @@ func (c *PodVolumeRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
-	if err = c.processRestore(ctx, pvr, pod, log); err != nil {
+	// Stop the restore if the PodVolumeRestore is canceled while it's running.
+	restoreCtx, cancelRestore := withCancelRequest(ctx, cancelPollInterval, func() bool {
+		current := &velerov1api.PodVolumeRestore{}
+		if err := c.Get(ctx, req.NamespacedName, current); err != nil {
+			log.WithError(err).Debug("Unable to check whether PodVolumeRestore was canceled")
+			return false
+		}
+		return current.Spec.Cancel
+	})
+	defer cancelRestore()
+
+	if err = c.processRestore(restoreCtx, pvr, pod, log); err != nil {
+		if wasCanceled(ctx, restoreCtx) {
+			return c.updateStatusToCanceled(ctx, pvr, "canceled while running", log)
+		}
+
 		original = pvr.DeepCopy()
 		pvr.Status.Phase = velerov1api.PodVolumeRestorePhaseFailed
 		pvr.Status.Message = err.Error()
@@ -144,6 +172,20 @@ func (c *PodVolumeRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	return ctrl.Result{}, nil
 }
 
+func (c *PodVolumeRestoreReconciler) updateStatusToCanceled(ctx context.Context, pvr *velerov1api.PodVolumeRestore, msg string, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Info("Restore canceled")
+
+	original := pvr.DeepCopy()
+	pvr.Status.Phase = velerov1api.PodVolumeRestorePhaseCanceled
+	pvr.Status.Message = msg
+	pvr.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	if err := c.Patch(ctx, pvr, client.MergeFrom(original)); err != nil {
+		log.WithError(err).Error("Unable to update status to canceled")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
 func (c *PodVolumeRestoreReconciler) shouldProcess(ctx context.Context, log logrus.FieldLogger, pvr *velerov1api.PodVolumeRestore) (bool, *corev1api.Pod, error) {
 	if !isPVRNew(pvr) {
 		log.Debug("PodVolumeRestore is not new, skip")
@@ -176,9 +218,27 @@ func (c *PodVolumeRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.PodVolumeRestore{}).
 		Watches(&source.Kind{Type: &corev1api.Pod{}}, handler.EnqueueRequestsFromMapFunc(c.findVolumeRestoresForPod)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: maxConcurrentReconciles(c.loadLimiter)}).
 		Complete(c)
 }
 
+// queue marks pvr as Queued, if it isn't already, and requeues it to be retried once the node
+// has capacity.
+func (c *PodVolumeRestoreReconciler) queue(ctx context.Context, pvr *velerov1api.PodVolumeRestore, log logrus.FieldLogger) (ctrl.Result, error) {
+	if pvr.Status.Phase != velerov1api.PodVolumeRestorePhaseQueued {
+		log.Infof("Node is running %d pod volume operations already, queueing PodVolumeRestore", c.loadLimiter.Limit())
+
+		original := pvr.DeepCopy()
+		pvr.Status.Phase = velerov1api.PodVolumeRestorePhaseQueued
+		if err := c.Patch(ctx, pvr, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("Unable to update status to queued")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: queuedRequeueInterval}, nil
+}
+
 func (c *PodVolumeRestoreReconciler) findVolumeRestoresForPod(pod client.Object) []reconcile.Request {
 	list := &velerov1api.PodVolumeRestoreList{}
 	options := &client.ListOptions{
@@ -204,7 +264,7 @@ func (c *PodVolumeRestoreReconciler) findVolumeRestoresForPod(pod client.Object)
 }
 
 func isPVRNew(pvr *velerov1api.PodVolumeRestore) bool {
-	return pvr.Status.Phase == "" || pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseNew
+	return pvr.Status.Phase == "" || pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseNew || pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseQueued
 }
 
 func isResticInitContainerRunning(pod *corev1api.Pod) bool {
@@ -259,7 +319,7 @@ func (c *PodVolumeRestoreReconciler) processRestore(ctx context.Context, req *ve
 	}
 
 	uploaderProv, err := provider.NewUploaderProvider(ctx, c.Client, req.Spec.UploaderType,
-		req.Spec.RepoIdentifier, backupLocation, backupRepo, c.credentialGetter, repokey.RepoKeySelector(), log)
+		req.Spec.RepoIdentifier, backupLocation, backupRepo, c.credentialGetter, repokey.RepoKeySelector(backupRepo), log)
 	if err != nil {
 		return errors.Wrap(err, "error creating uploader")
 	}
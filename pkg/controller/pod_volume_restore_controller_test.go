@@ -27,7 +27,9 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -178,6 +180,26 @@ func TestShouldProcess(t *testing.T) {
 			},
 			shouldProcessed: true,
 		},
+		{
+			name: "Queued phase pvr with pod on node running init container should be enqueued",
+			obj: &velerov1api.PodVolumeRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "velero",
+					Name:      "pvr-1",
+				},
+				Spec: velerov1api.PodVolumeRestoreSpec{
+					Pod: corev1api.ObjectReference{
+						Namespace: "ns-1",
+						Name:      "pod-1",
+					},
+				},
+				Status: velerov1api.PodVolumeRestoreStatus{
+					Phase: velerov1api.PodVolumeRestorePhaseQueued,
+				},
+			},
+			pod:             podWithRunningResticInitContainer("ns-1", "pod-1", controllerNode),
+			shouldProcessed: true,
+		},
 	}
 
 	for _, ts := range tests {
@@ -205,6 +227,93 @@ func TestShouldProcess(t *testing.T) {
 	}
 }
 
+func podWithRunningResticInitContainer(namespace, name, node string) *corev1api.Pod {
+	return &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: corev1api.PodSpec{
+			NodeName: node,
+			InitContainers: []corev1api.Container{
+				{
+					Name: podvolume.InitContainer,
+				},
+			},
+		},
+		Status: corev1api.PodStatus{
+			InitContainerStatuses: []corev1api.ContainerStatus{
+				{
+					State: corev1api.ContainerState{
+						Running: &corev1api.ContainerStateRunning{
+							StartedAt: metav1.Time{Time: time.Now()},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPodVolumeRestoreReconcileQueuesWhenNodeIsAtCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	pvr := &velerov1api.PodVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "pvr-1",
+		},
+		Spec: velerov1api.PodVolumeRestoreSpec{
+			Pod: corev1api.ObjectReference{
+				Namespace: "ns-1",
+				Name:      "pod-1",
+			},
+		},
+	}
+	cli := test.NewFakeControllerRuntimeClient(t, pvr, podWithRunningResticInitContainer("ns-1", "pod-1", "foo"))
+
+	c := NewPodVolumeRestoreReconciler(logrus.New(), cli, nil, fullLoadLimiter(2))
+
+	result, err := c.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "pvr-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{RequeueAfter: queuedRequeueInterval}, result)
+
+	updated := &velerov1api.PodVolumeRestore{}
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "velero", Name: "pvr-1"}, updated))
+	assert.Equal(t, velerov1api.PodVolumeRestorePhaseQueued, updated.Status.Phase)
+	assert.Nil(t, updated.Status.StartTimestamp)
+}
+
+func TestPodVolumeRestoreReconcileCancelsBeforeStart(t *testing.T) {
+	ctx := context.Background()
+
+	pvr := &velerov1api.PodVolumeRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "velero",
+			Name:      "pvr-1",
+		},
+		Spec: velerov1api.PodVolumeRestoreSpec{
+			Pod: corev1api.ObjectReference{
+				Namespace: "ns-1",
+				Name:      "pod-1",
+			},
+			Cancel: true,
+		},
+	}
+	cli := test.NewFakeControllerRuntimeClient(t, pvr, podWithRunningResticInitContainer("ns-1", "pod-1", "foo"))
+
+	c := NewPodVolumeRestoreReconciler(logrus.New(), cli, nil, fullLoadLimiter(2))
+
+	result, err := c.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "velero", Name: "pvr-1"}})
+	require.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+
+	updated := &velerov1api.PodVolumeRestore{}
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Namespace: "velero", Name: "pvr-1"}, updated))
+	assert.Equal(t, velerov1api.PodVolumeRestorePhaseCanceled, updated.Status.Phase)
+	assert.NotNil(t, updated.Status.CompletionTimestamp)
+}
+
 func TestIsResticContainerRunning(t *testing.T) {
 	tests := []struct {
 		name     string
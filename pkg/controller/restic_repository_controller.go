@@ -18,17 +18,21 @@ package controller
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repoconfig "github.com/vmware-tanzu/velero/pkg/repository/config"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
@@ -37,25 +41,41 @@ import (
 const (
 	repoSyncPeriod           = 5 * time.Minute
 	defaultMaintainFrequency = 7 * 24 * time.Hour
+
+	// maintenanceJobRequeueInterval is how often a repository that's due for maintenance
+	// checks whether it can start a maintenance job, when the maximum number of them are
+	// running already.
+	maintenanceJobRequeueInterval = time.Minute
+
+	// maintenanceRetryInterval is how long after a failed maintenance run it's retried, unless
+	// the repository's maintenance frequency is shorter.
+	maintenanceRetryInterval = time.Hour
 )
 
 type ResticRepoReconciler struct {
 	client.Client
-	namespace            string
-	logger               logrus.FieldLogger
-	clock                clock.Clock
-	maintenanceFrequency time.Duration
-	repositoryManager    repository.Manager
+	namespace             string
+	logger                logrus.FieldLogger
+	clock                 clock.Clock
+	maintenanceFrequency  time.Duration
+	verificationFrequency time.Duration
+	perRepositoryKeys     bool
+	maintenanceJobConfig  repository.MaintenanceJobConfig
+	repositoryManager     repository.Manager
 }
 
 func NewResticRepoReconciler(namespace string, logger logrus.FieldLogger, client client.Client,
-	maintenanceFrequency time.Duration, repositoryManager repository.Manager) *ResticRepoReconciler {
+	maintenanceFrequency time.Duration, verificationFrequency time.Duration, perRepositoryKeys bool,
+	maintenanceJobConfig repository.MaintenanceJobConfig, repositoryManager repository.Manager) *ResticRepoReconciler {
 	c := &ResticRepoReconciler{
 		client,
 		namespace,
 		logger,
 		clock.RealClock{},
 		maintenanceFrequency,
+		verificationFrequency,
+		perRepositoryKeys,
+		maintenanceJobConfig,
 		repositoryManager,
 	}
 
@@ -66,10 +86,19 @@ func (r *ResticRepoReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	s := kube.NewPeriodicalEnqueueSource(r.logger, mgr.GetClient(), &velerov1api.BackupRepositoryList{}, repoSyncPeriod, kube.PeriodicalEnqueueSourceOption{})
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&velerov1api.BackupRepository{}).
+		Owns(&batchv1api.Job{}).
 		Watches(s, nil).
 		Complete(r)
 }
 
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments;replicasets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=podvolumebackups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
 func (r *ResticRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.logger.WithField("resticRepo", req.String())
 	resticRepo := &velerov1api.BackupRepository{}
@@ -101,7 +130,22 @@ func (r *ResticRepoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	switch resticRepo.Status.Phase {
 	case velerov1api.BackupRepositoryPhaseReady:
-		return ctrl.Result{}, r.runMaintenanceIfDue(ctx, resticRepo, log)
+		var result ctrl.Result
+		for _, run := range []func(context.Context, *velerov1api.BackupRepository, logrus.FieldLogger) (ctrl.Result, error){
+			r.runMaintenanceIfDue,
+			r.runVerificationIfDue,
+			r.runKeyRotationIfRequested,
+			r.runMigrationIfRequested,
+		} {
+			runResult, err := run(ctx, resticRepo, log)
+			if result.IsZero() {
+				result = runResult
+			}
+			if err != nil {
+				return result, err
+			}
+		}
+		return result, nil
 	case velerov1api.BackupRepositoryPhaseNotReady:
 		return ctrl.Result{}, r.checkNotReadyRepo(ctx, resticRepo, log)
 	}
@@ -141,17 +185,27 @@ func (r *ResticRepoReconciler) initializeRepo(ctx context.Context, req *velerov1
 		if rr.Spec.MaintenanceFrequency.Duration <= 0 {
 			rr.Spec.MaintenanceFrequency = metav1.Duration{Duration: r.getRepositoryMaintenanceFrequency(req)}
 		}
+
+		if rr.Spec.VerificationFrequency.Duration <= 0 && r.verificationFrequency > 0 {
+			rr.Spec.VerificationFrequency = metav1.Duration{Duration: r.verificationFrequency}
+		}
 	}); err != nil {
 		return err
 	}
 
-	if err := ensureRepo(req, r.repositoryManager); err != nil {
+	if err := r.prepareRepo(ctx, req, log); err != nil {
 		return r.patchResticRepository(ctx, req, repoNotReady(err.Error()))
 	}
 
+	keyGeneration, err := repository.RepoKeyGeneration(ctx, r.Client, req)
+	if err != nil {
+		log.WithError(err).Warn("Error getting the generation of the repository's key")
+	}
+
 	return r.patchResticRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 		rr.Status.Phase = velerov1api.BackupRepositoryPhaseReady
 		rr.Status.LastMaintenanceTime = &metav1.Time{Time: time.Now()}
+		rr.Status.KeyGeneration = keyGeneration
 	})
 }
 
@@ -178,35 +232,509 @@ func ensureRepo(repo *velerov1api.BackupRepository, repoManager repository.Manag
 	return repoManager.PrepareRepo(repo)
 }
 
-func (r *ResticRepoReconciler) runMaintenanceIfDue(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
-	log.Debug("resticRepositoryController.runMaintenanceIfDue")
+// prepareRepo ensures the repo is ready for use, and gives a repository that's initialized
+// its own key if per-repository keys are enabled.
+func (r *ResticRepoReconciler) prepareRepo(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	if !r.perRepositoryKeys {
+		return ensureRepo(req, r.repositoryManager)
+	}
+	return repository.PrepareRepoWithOwnKey(ctx, r.Client, r.repositoryManager, req, log)
+}
 
-	now := r.clock.Now()
+// runningRepositoryJob returns the type of the repository's job that's running other than
+// jobType, if any. A repository runs one job at a time.
+func runningRepositoryJob(req *velerov1api.BackupRepository, jobType string) string {
+	switch {
+	case jobType != repository.RepositoryJobMaintenance && req.Status.MaintenanceJob != "":
+		return repository.RepositoryJobMaintenance
+	case jobType != repository.RepositoryJobVerification && req.Status.VerificationJob != "":
+		return repository.RepositoryJobVerification
+	case jobType != repository.RepositoryJobKeyRotation && req.Status.KeyRotationJob != "":
+		return repository.RepositoryJobKeyRotation
+	case jobType != repository.RepositoryJobMigration && req.Status.MigrationJob != "":
+		return repository.RepositoryJobMigration
+	}
+	return ""
+}
 
-	if !dueForMaintenance(req, now) {
-		log.Debug("not due for maintenance")
-		return nil
+// repositoryJob is a type of job that runs an operation on a repository. A repository runs one
+// job at a time, whose name is recorded in the repository's status until its result is recorded.
+type repositoryJob struct {
+	// jobType is the value of the velero.io/repository-job label of the jobs of this type.
+	jobType string
+
+	// description is what the job does, for log and error messages.
+	description string
+
+	// runningJob returns the field of the repository's status that the name of its running job
+	// of this type is recorded in.
+	runningJob func(*velerov1api.BackupRepository) *string
+
+	// requestAnnotation is the annotation that requests the job, if any. It's removed once the
+	// job is started.
+	requestAnnotation string
+
+	// build returns the job to run for the repository.
+	build func(context.Context, *velerov1api.BackupRepository, logrus.FieldLogger) (*batchv1api.Job, error)
+
+	// finish returns the change to the repository that records the result of its finished job,
+	// which is nil if the job was deleted before it finished.
+	finish func(context.Context, *velerov1api.BackupRepository, *batchv1api.Job, logrus.FieldLogger) (func(*velerov1api.BackupRepository), error)
+}
+
+// started returns the change to the repository that records that its job name was started.
+func (j repositoryJob) started(name string) func(*velerov1api.BackupRepository) {
+	return func(rr *velerov1api.BackupRepository) {
+		*j.runningJob(rr) = name
+		if j.requestAnnotation != "" {
+			delete(rr.Annotations, j.requestAnnotation)
+		}
 	}
+}
 
-	log.Info("Running maintenance on restic repository")
+// startRepositoryJob starts a job of type job for the repository, unless another one of the
+// repository's jobs or the maximum number of repository jobs are running.
+func (r *ResticRepoReconciler) startRepositoryJob(ctx context.Context, req *velerov1api.BackupRepository, job repositoryJob, log logrus.FieldLogger) (ctrl.Result, error) {
+	// the repository's other job is reconciled again when it finishes
+	if jobType := runningRepositoryJob(req, job.jobType); jobType != "" {
+		log.Debugf("waiting for the repository's %s job to finish before starting its %s job", jobType, job.jobType)
+		return ctrl.Result{}, nil
+	}
 
-	// prune failures should be displayed in the `.status.message` field but
+	running, err := repository.GetRunningRepositoryJobs(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for _, runningJob := range running {
+		// the repository's status wasn't updated after its job was created
+		if isRepositoryJob(&runningJob, req, job.jobType) {
+			log.WithField("job", runningJob.Name).Infof("Found running %s job", job.description)
+			return ctrl.Result{}, r.patchResticRepository(ctx, req, job.started(runningJob.Name))
+		}
+	}
+	if limit := r.maintenanceJobConfig.MaxConcurrentJobs; limit > 0 && len(running) >= limit {
+		log.Debugf("%d repository jobs are running already, waiting", len(running))
+		return ctrl.Result{RequeueAfter: maintenanceJobRequeueInterval}, nil
+	}
+
+	// job failures should be displayed in the `.status.message` field but
 	// should not cause the repo to move to `NotReady`.
-	log.Debug("Pruning repo")
-	if err := r.repositoryManager.PruneRepo(req); err != nil {
-		log.WithError(err).Warn("error pruning repository")
-		return r.patchResticRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+	created, err := job.build(ctx, req, log)
+	if err != nil {
+		log.WithError(err).Warnf("error building %s job", job.description)
+		return ctrl.Result{}, r.patchResticRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
 			rr.Status.Message = err.Error()
 		})
 	}
+	if err := r.Create(ctx, created); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error creating %s job", job.description)
+	}
+	log.WithField("job", created.Name).Infof("Created %s job", job.description)
 
-	return r.patchResticRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
-		rr.Status.LastMaintenanceTime = &metav1.Time{Time: now}
-	})
+	return ctrl.Result{}, r.patchResticRepository(ctx, req, job.started(created.Name))
 }
 
+// checkRepositoryJob records the result of the repository's running job of type job once it's
+// finished, and deletes the oldest finished jobs of that type.
+func (r *ResticRepoReconciler) checkRepositoryJob(ctx context.Context, req *velerov1api.BackupRepository, job repositoryJob, log logrus.FieldLogger) error {
+	log = log.WithField("job", *job.runningJob(req))
+
+	var finished *batchv1api.Job
+	current := &batchv1api.Job{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: *job.runningJob(req)}, current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error getting %s job", job.description)
+		}
+		log.Warnf("The %s job was deleted before it finished", job.description)
+	} else {
+		if !repository.RepositoryJobFinished(current) {
+			log.Debugf("The %s job is still running", job.description)
+			return nil
+		}
+		finished = current
+	}
+
+	record, err := job.finish(ctx, req, finished, log)
+	if err != nil {
+		return err
+	}
+	if err := r.patchResticRepository(ctx, req, func(rr *velerov1api.BackupRepository) {
+		*job.runningJob(rr) = ""
+		record(rr)
+	}); err != nil {
+		return err
+	}
+
+	if err := repository.DeleteOldRepositoryJobs(ctx, r.Client, req, job.jobType, r.maintenanceJobConfig.KeepLatestJobs); err != nil {
+		log.WithError(err).Warnf("Error deleting old %s jobs", job.description)
+	}
+	return nil
+}
+
+// runMaintenanceIfDue starts a job that runs maintenance on the repository if it's due, or
+// records the result of the repository's maintenance job once it's finished.
+func (r *ResticRepoReconciler) runMaintenanceIfDue(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Debug("resticRepositoryController.runMaintenanceIfDue")
+
+	job := r.maintenanceJob()
+	if req.Status.MaintenanceJob != "" {
+		return ctrl.Result{}, r.checkRepositoryJob(ctx, req, job, log)
+	}
+
+	if !dueForMaintenance(req, r.clock.Now()) {
+		log.Debug("not due for maintenance")
+		return ctrl.Result{}, nil
+	}
+
+	return r.startRepositoryJob(ctx, req, job, log)
+}
+
+func (r *ResticRepoReconciler) maintenanceJob() repositoryJob {
+	return repositoryJob{
+		jobType:     repository.RepositoryJobMaintenance,
+		description: "maintenance",
+		runningJob:  func(rr *velerov1api.BackupRepository) *string { return &rr.Status.MaintenanceJob },
+		build: func(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (*batchv1api.Job, error) {
+			log.Info("Running maintenance on restic repository")
+			return repository.BuildMaintenanceJob(ctx, r.Client, req, r.maintenanceJobConfig)
+		},
+		finish: r.finishMaintenance,
+	}
+}
+
+// finishMaintenance records the result of a maintenance run in the repository's recent
+// maintenance, which keeps as many of them as jobs are kept, but at least the latest.
+func (r *ResticRepoReconciler) finishMaintenance(ctx context.Context, req *velerov1api.BackupRepository, job *batchv1api.Job, log logrus.FieldLogger) (func(*velerov1api.BackupRepository), error) {
+	status := velerov1api.BackupRepositoryMaintenanceStatus{
+		Job:     req.Status.MaintenanceJob,
+		Result:  velerov1api.BackupRepositoryMaintenanceFailed,
+		Message: "maintenance job was deleted before it finished",
+	}
+	if job != nil {
+		status = repository.GetMaintenanceStatus(ctx, r.Client, job)
+	}
+
+	if status.Result == velerov1api.BackupRepositoryMaintenanceSucceeded {
+		log.Info("Maintenance job succeeded")
+	} else {
+		log.WithField("message", status.Message).Warn("Maintenance job failed")
+	}
+	// the time of a failed run is when the next one is backed off from
+	if status.CompleteTimestamp.IsZero() {
+		status.CompleteTimestamp = &metav1.Time{Time: r.clock.Now()}
+	}
+
+	history := r.maintenanceJobConfig.KeepLatestJobs
+	if history < 1 {
+		history = 1
+	}
+	return func(rr *velerov1api.BackupRepository) {
+		rr.Status.RecentMaintenance = append(rr.Status.RecentMaintenance, status)
+		if len(rr.Status.RecentMaintenance) > history {
+			rr.Status.RecentMaintenance = rr.Status.RecentMaintenance[len(rr.Status.RecentMaintenance)-history:]
+		}
+
+		if status.Result == velerov1api.BackupRepositoryMaintenanceSucceeded {
+			rr.Status.LastMaintenanceTime = status.CompleteTimestamp
+		} else {
+			rr.Status.Message = status.Message
+		}
+	}, nil
+}
+
+// dueForMaintenance returns whether the repository's maintenance frequency has passed since its
+// last successful maintenance run. A failed run is retried after maintenanceRetryInterval, or
+// after the maintenance frequency if that's shorter.
 func dueForMaintenance(req *velerov1api.BackupRepository, now time.Time) bool {
-	return req.Status.LastMaintenanceTime == nil || req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now)
+	if req.Status.LastMaintenanceTime != nil && !req.Status.LastMaintenanceTime.Add(req.Spec.MaintenanceFrequency.Duration).Before(now) {
+		return false
+	}
+
+	if n := len(req.Status.RecentMaintenance); n > 0 {
+		latest := req.Status.RecentMaintenance[n-1]
+		if latest.Result == velerov1api.BackupRepositoryMaintenanceFailed && latest.CompleteTimestamp != nil {
+			retryInterval := maintenanceRetryInterval
+			if frequency := req.Spec.MaintenanceFrequency.Duration; frequency < retryInterval {
+				retryInterval = frequency
+			}
+			return latest.CompleteTimestamp.Add(retryInterval).Before(now)
+		}
+	}
+	return true
+}
+
+// isRepositoryJob returns whether job is a job of type jobType for the repository.
+func isRepositoryJob(job *batchv1api.Job, req *velerov1api.BackupRepository, jobType string) bool {
+	return job.Labels[velerov1api.BackupRepositoryNameLabel] == label.GetValidName(req.Name) &&
+		job.Labels[velerov1api.BackupRepositoryJobLabel] == jobType
+}
+
+// runVerificationIfDue starts a job that verifies the data in the repository if it's due or was
+// requested, or records the result of the repository's verification job once it's finished.
+func (r *ResticRepoReconciler) runVerificationIfDue(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Debug("resticRepositoryController.runVerificationIfDue")
+
+	job := r.verificationJob()
+	if req.Status.VerificationJob != "" {
+		return ctrl.Result{}, r.checkRepositoryJob(ctx, req, job, log)
+	}
+
+	if _, requested := verificationRequest(req, log); !requested && !dueForVerification(req, r.clock.Now()) {
+		log.Debug("not due for verification")
+		return ctrl.Result{}, nil
+	}
+
+	return r.startRepositoryJob(ctx, req, job, log)
+}
+
+func (r *ResticRepoReconciler) verificationJob() repositoryJob {
+	return repositoryJob{
+		jobType:           repository.RepositoryJobVerification,
+		description:       "verification",
+		runningJob:        func(rr *velerov1api.BackupRepository) *string { return &rr.Status.VerificationJob },
+		requestAnnotation: velerov1api.VerifyBackupRepositoryAnnotation,
+		build: func(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (*batchv1api.Job, error) {
+			readDataPercent, _ := verificationRequest(req, log)
+			log.WithField("readDataPercent", readDataPercent).Info("Verifying restic repository")
+			return repository.BuildVerificationJob(ctx, r.Client, req, r.maintenanceJobConfig, readDataPercent)
+		},
+		finish: r.finishVerification,
+	}
+}
+
+// verificationRequest returns whether the verification of the repository was requested through its
+// annotation, and the percentage of its data to read back.
+func verificationRequest(req *velerov1api.BackupRepository, log logrus.FieldLogger) (int, bool) {
+	value, requested := req.Annotations[velerov1api.VerifyBackupRepositoryAnnotation]
+	if !requested || value == "" {
+		return req.Spec.VerificationReadDataPercent, requested
+	}
+
+	readDataPercent, err := strconv.Atoi(value)
+	if err != nil || readDataPercent < 0 || readDataPercent > 100 {
+		log.Warnf("Invalid value %q of annotation %s, reading back the percentage of data in the repository's spec", value, velerov1api.VerifyBackupRepositoryAnnotation)
+		return req.Spec.VerificationReadDataPercent, true
+	}
+	return readDataPercent, true
+}
+
+func dueForVerification(req *velerov1api.BackupRepository, now time.Time) bool {
+	if req.Spec.VerificationFrequency.Duration <= 0 {
+		return false
+	}
+	last := req.Status.LastVerification
+	return last == nil || last.CompleteTimestamp == nil || last.CompleteTimestamp.Add(req.Spec.VerificationFrequency.Duration).Before(now)
+}
+
+// finishVerification records the result of a verification in the repository, after updating the
+// damaged volume backups of the backups with data in the repository.
+func (r *ResticRepoReconciler) finishVerification(ctx context.Context, req *velerov1api.BackupRepository, job *batchv1api.Job, log logrus.FieldLogger) (func(*velerov1api.BackupRepository), error) {
+	status := velerov1api.BackupRepositoryVerificationStatus{
+		Job:     req.Status.VerificationJob,
+		Result:  velerov1api.BackupRepositoryVerificationFailed,
+		Message: "verification job was deleted before it finished",
+	}
+	if job != nil {
+		status = repository.GetVerificationStatus(ctx, r.Client, job)
+	}
+	if status.CompleteTimestamp == nil {
+		status.CompleteTimestamp = &metav1.Time{Time: r.clock.Now()}
+	}
+
+	switch status.Result {
+	case velerov1api.BackupRepositoryVerificationPassed:
+		log.Info("Repository verification passed")
+	case velerov1api.BackupRepositoryVerificationDamaged:
+		log.WithField("damagedSnapshots", status.DamagedSnapshots).Warnf("Repository is damaged: %s", status.Message)
+	default:
+		log.WithField("message", status.Message).Warn("Verification job failed")
+	}
+
+	// backups are flagged before the result is recorded, so that it's retried if it fails
+	if status.Result != velerov1api.BackupRepositoryVerificationFailed {
+		if err := r.flagDamagedBackups(ctx, req, status, log); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(rr *velerov1api.BackupRepository) {
+		rr.Status.LastVerification = &status
+		if status.Result != velerov1api.BackupRepositoryVerificationPassed {
+			rr.Status.Message = status.Message
+		}
+	}, nil
+}
+
+// flagDamagedBackups sets the damaged volume backups of the backups with data in the repository to
+// the ones whose snapshots the verification found to be damaged. A verification that found damage
+// it couldn't attribute to particular snapshots flags all of the repository's volume backups.
+func (r *ResticRepoReconciler) flagDamagedBackups(ctx context.Context, req *velerov1api.BackupRepository, status velerov1api.BackupRepositoryVerificationStatus, log logrus.FieldLogger) error {
+	damagedSnapshots := sets.NewString(status.DamagedSnapshots...)
+	allDamaged := status.Result == velerov1api.BackupRepositoryVerificationDamaged && damagedSnapshots.Len() == 0
+
+	volumeBackups, err := repository.ListVolumeBackups(ctx, r.Client, req)
+	if err != nil {
+		return err
+	}
+
+	// the volume backups of each backup that have data in the repository, and the damaged ones
+	inRepo := map[string]sets.String{}
+	damaged := map[string]sets.String{}
+	for _, vb := range volumeBackups {
+		if inRepo[vb.Backup] == nil {
+			inRepo[vb.Backup], damaged[vb.Backup] = sets.NewString(), sets.NewString()
+		}
+		inRepo[vb.Backup].Insert(vb.Name)
+		if allDamaged || damagedSnapshots.Has(vb.SnapshotID) {
+			damaged[vb.Backup].Insert(vb.Name)
+		}
+	}
+
+	for name, inBackup := range inRepo {
+		backup := &velerov1api.Backup{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: name}, backup); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "error getting backup %s", name)
+		}
+
+		flagged := sets.NewString(backup.Status.DamagedVolumeBackups...)
+		updated := flagged.Difference(inBackup).Union(damaged[name])
+		if updated.Equal(flagged) {
+			continue
+		}
+
+		original := backup.DeepCopy()
+		backup.Status.DamagedVolumeBackups = updated.List()
+		if updated.Len() == 0 {
+			backup.Status.DamagedVolumeBackups = nil
+		}
+		if err := r.Patch(ctx, backup, client.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error patching backup %s", name)
+		}
+		log.WithFields(logrus.Fields{
+			"backup":               name,
+			"damagedVolumeBackups": backup.Status.DamagedVolumeBackups,
+		}).Info("Updated damaged volume backups of backup")
+	}
+
+	return nil
+}
+
+// runKeyRotationIfRequested starts a job that rotates the key of the repository if it was
+// requested, or records the result of the repository's key rotation job once it's finished.
+func (r *ResticRepoReconciler) runKeyRotationIfRequested(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Debug("resticRepositoryController.runKeyRotationIfRequested")
+
+	job := r.keyRotationJob()
+	if req.Status.KeyRotationJob != "" {
+		return ctrl.Result{}, r.checkRepositoryJob(ctx, req, job, log)
+	}
+
+	if _, requested := req.Annotations[velerov1api.RotateBackupRepositoryKeyAnnotation]; !requested {
+		return ctrl.Result{}, nil
+	}
+
+	return r.startRepositoryJob(ctx, req, job, log)
+}
+
+func (r *ResticRepoReconciler) keyRotationJob() repositoryJob {
+	return repositoryJob{
+		jobType:           repository.RepositoryJobKeyRotation,
+		description:       "key rotation",
+		runningJob:        func(rr *velerov1api.BackupRepository) *string { return &rr.Status.KeyRotationJob },
+		requestAnnotation: velerov1api.RotateBackupRepositoryKeyAnnotation,
+		build: func(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (*batchv1api.Job, error) {
+			log.Info("Rotating key of restic repository")
+			return repository.BuildKeyRotationJob(ctx, r.Client, req, r.maintenanceJobConfig)
+		},
+		finish: r.finishKeyRotation,
+	}
+}
+
+// finishKeyRotation records the key generation a key rotation changed the repository to.
+func (r *ResticRepoReconciler) finishKeyRotation(ctx context.Context, req *velerov1api.BackupRepository, job *batchv1api.Job, log logrus.FieldLogger) (func(*velerov1api.BackupRepository), error) {
+	result := repository.KeyRotationResult{Error: "key rotation job was deleted before it finished"}
+	if job != nil {
+		result = repository.GetKeyRotationResult(ctx, r.Client, job)
+	}
+
+	if result.Error == "" {
+		log.WithField("keyGeneration", result.KeyGeneration).Info("Key rotation job succeeded")
+	} else {
+		log.WithField("message", result.Error).Warn("Key rotation job failed")
+	}
+
+	return func(rr *velerov1api.BackupRepository) {
+		if result.Error != "" {
+			rr.Status.Message = "error rotating repository key: " + result.Error
+			return
+		}
+		// the job sets the repository's key secret itself, this only makes sure it's done
+		if result.KeySecret != "" {
+			rr.Spec.KeySecret = result.KeySecret
+		}
+		rr.Status.KeyGeneration = result.KeyGeneration
+		rr.Status.LastKeyRotationTime = &metav1.Time{Time: r.clock.Now()}
+	}, nil
+}
+
+// runMigrationIfRequested starts a job that migrates the repository to another backup storage
+// location if it was requested, or records the result of the repository's migration job once
+// it's finished.
+func (r *ResticRepoReconciler) runMigrationIfRequested(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (ctrl.Result, error) {
+	log.Debug("resticRepositoryController.runMigrationIfRequested")
+
+	job := r.migrationJob()
+	if req.Status.MigrationJob != "" {
+		return ctrl.Result{}, r.checkRepositoryJob(ctx, req, job, log)
+	}
+
+	if _, requested := req.Annotations[velerov1api.MigrateBackupRepositoryAnnotation]; !requested {
+		return ctrl.Result{}, nil
+	}
+
+	return r.startRepositoryJob(ctx, req, job, log)
+}
+
+func (r *ResticRepoReconciler) migrationJob() repositoryJob {
+	return repositoryJob{
+		jobType:           repository.RepositoryJobMigration,
+		description:       "migration",
+		runningJob:        func(rr *velerov1api.BackupRepository) *string { return &rr.Status.MigrationJob },
+		requestAnnotation: velerov1api.MigrateBackupRepositoryAnnotation,
+		build: func(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) (*batchv1api.Job, error) {
+			targetLocation := req.Annotations[velerov1api.MigrateBackupRepositoryAnnotation]
+			log.WithField("targetLocation", targetLocation).Info("Migrating restic repository")
+			return repository.BuildMigrationJob(ctx, r.Client, req, r.maintenanceJobConfig, targetLocation)
+		},
+		finish: r.finishMigration,
+	}
+}
+
+// finishMigration records the result of a migration in the repository.
+func (r *ResticRepoReconciler) finishMigration(ctx context.Context, req *velerov1api.BackupRepository, job *batchv1api.Job, log logrus.FieldLogger) (func(*velerov1api.BackupRepository), error) {
+	status := velerov1api.BackupRepositoryMigrationStatus{
+		Job:     req.Status.MigrationJob,
+		Result:  velerov1api.BackupRepositoryMigrationFailed,
+		Message: "migration job was deleted before it finished",
+	}
+	if job != nil {
+		status = repository.GetMigrationStatus(ctx, r.Client, job)
+	}
+
+	log.WithFields(logrus.Fields{
+		"result":           status.Result,
+		"targetRepository": status.TargetRepository,
+		"snapshots":        status.Snapshots,
+		"backups":          status.Backups,
+		"message":          status.Message,
+	}).Info("Migration job finished")
+
+	return func(rr *velerov1api.BackupRepository) {
+		rr.Status.LastMigration = &status
+	}, nil
 }
 
 func (r *ResticRepoReconciler) checkNotReadyRepo(ctx context.Context, req *velerov1api.BackupRepository, log logrus.FieldLogger) error {
@@ -219,7 +747,7 @@ func (r *ResticRepoReconciler) checkNotReadyRepo(ctx context.Context, req *veler
 
 	// we need to ensure it (first check, if check fails, attempt to init)
 	// because we don't know if it's been successfully initialized yet.
-	if err := ensureRepo(req, r.repositoryManager); err != nil {
+	if err := r.prepareRepo(ctx, req, log); err != nil {
 		return r.patchResticRepository(ctx, req, repoNotReady(err.Error()))
 	}
 	return r.patchResticRepository(ctx, req, repoReady())
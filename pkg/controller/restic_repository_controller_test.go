@@ -21,11 +21,17 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	repomokes "github.com/vmware-tanzu/velero/pkg/repository/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -43,6 +49,9 @@ func mockResticRepoReconciler(t *testing.T, rr *velerov1api.BackupRepository, mo
 		velerotest.NewLogger(),
 		velerotest.NewFakeControllerRuntimeClient(t),
 		testMaintenanceFrequency,
+		0,
+		false,
+		repository.MaintenanceJobConfig{KeepLatestJobs: 2, MaxConcurrentJobs: 1},
 		mgr,
 	)
 }
@@ -89,19 +98,548 @@ func TestCheckNotReadyRepo(t *testing.T) {
 
 func TestRunMaintenanceIfDue(t *testing.T) {
 	rr := mockResticRepositoryCR()
-	reconciler := mockResticRepoReconciler(t, rr, "PruneRepo", rr, nil)
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
 	err := reconciler.Client.Create(context.TODO(), rr)
 	assert.NoError(t, err)
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	err = reconciler.Client.Create(context.TODO(), deployment)
+	assert.NoError(t, err)
+
+	// a job is started when maintenance is due
 	lastTm := rr.Status.LastMaintenanceTime
-	err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, rr.Status.LastMaintenanceTime, lastTm)
+	require.NotEmpty(t, rr.Status.MaintenanceJob)
+
+	job := &batchv1api.Job{}
+	err = reconciler.Client.Get(context.TODO(), client.ObjectKey{Namespace: rr.Namespace, Name: rr.Status.MaintenanceJob}, job)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"repo", "maintain", "repo", "--namespace=velero"}, job.Spec.Template.Spec.Containers[0].Args)
+
+	// nothing changes while the job is running
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, job.Name, rr.Status.MaintenanceJob)
+	assert.Empty(t, rr.Status.RecentMaintenance)
+
+	// the result is recorded once the job is finished
+	completed := time.Now().Add(-time.Minute).Round(time.Second)
+	job.Status.Conditions = []batchv1api.JobCondition{{Type: batchv1api.JobComplete, Status: corev1api.ConditionTrue, LastTransitionTime: metav1.Time{Time: completed}}}
+	err = reconciler.Client.Update(context.TODO(), job)
+	require.NoError(t, err)
+	pod := builder.ForPod(rr.Namespace, job.Name+"-xyz12").ObjectMeta(builder.WithLabels("job-name", job.Name)).Result()
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{Message: `{"reclaimedBytes":1024}`, FinishedAt: metav1.Time{Time: completed}}}},
+	}
+	err = reconciler.Client.Create(context.TODO(), pod)
+	require.NoError(t, err)
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
 	assert.NoError(t, err)
-	assert.NotEqual(t, rr.Status.LastMaintenanceTime, lastTm)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+	require.Len(t, rr.Status.RecentMaintenance, 1)
+	assert.Equal(t, job.Name, rr.Status.RecentMaintenance[0].Job)
+	assert.Equal(t, velerov1api.BackupRepositoryMaintenanceSucceeded, rr.Status.RecentMaintenance[0].Result)
+	assert.True(t, rr.Status.LastMaintenanceTime.Time.Equal(completed))
 
+	// no job is started when maintenance isn't due
 	rr.Status.LastMaintenanceTime = &metav1.Time{Time: time.Now()}
 	lastTm = rr.Status.LastMaintenanceTime
-	err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
 	assert.NoError(t, err)
 	assert.Equal(t, rr.Status.LastMaintenanceTime, lastTm)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+}
+
+func TestRunMaintenanceIfDueFailedJob(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	rr.Status.MaintenanceJob = "repo-maintain-abcde"
+	rr.Status.RecentMaintenance = []velerov1api.BackupRepositoryMaintenanceStatus{
+		{Job: "repo-maintain-00001", Result: velerov1api.BackupRepositoryMaintenanceSucceeded},
+		{Job: "repo-maintain-00002", Result: velerov1api.BackupRepositoryMaintenanceSucceeded},
+	}
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+	job := &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: rr.Namespace,
+			Name:      rr.Status.MaintenanceJob,
+			Labels: map[string]string{
+				velerov1api.BackupRepositoryNameLabel: rr.Name,
+				velerov1api.BackupRepositoryJobLabel:  repository.RepositoryJobMaintenance,
+			},
+		},
+		Status: batchv1api.JobStatus{
+			Conditions: []batchv1api.JobCondition{{Type: batchv1api.JobFailed, Status: corev1api.ConditionTrue}},
+		},
+	}
+	err = reconciler.Client.Create(context.TODO(), job)
+	assert.NoError(t, err)
+	pod := builder.ForPod(rr.Namespace, job.Name+"-xyz12").ObjectMeta(builder.WithLabels("job-name", job.Name)).Result()
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{Message: `{"error":"error pruning repository"}`}}},
+	}
+	err = reconciler.Client.Create(context.TODO(), pod)
+	assert.NoError(t, err)
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	err = reconciler.Client.Create(context.TODO(), deployment)
+	assert.NoError(t, err)
+	now := time.Now().Round(time.Second)
+	fakeClock := clock.NewFakeClock(now)
+	reconciler.clock = fakeClock
+
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+
+	assert.Nil(t, rr.Status.LastMaintenanceTime)
+	assert.Equal(t, "error pruning repository", rr.Status.Message)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+
+	// only the latest results are kept
+	require.Len(t, rr.Status.RecentMaintenance, 2)
+	assert.Equal(t, "repo-maintain-00002", rr.Status.RecentMaintenance[0].Job)
+	assert.Equal(t, job.Name, rr.Status.RecentMaintenance[1].Job)
+	assert.Equal(t, velerov1api.BackupRepositoryMaintenanceFailed, rr.Status.RecentMaintenance[1].Result)
+	assert.True(t, rr.Status.RecentMaintenance[1].CompleteTimestamp.Time.Equal(now))
+
+	// failed maintenance isn't retried right away
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+
+	// but once the maintenance frequency has passed
+	fakeClock.Step(testMaintenanceFrequency + time.Second)
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, rr.Status.MaintenanceJob)
+}
+
+func TestDueForMaintenance(t *testing.T) {
+	now := time.Now()
+	failed := func(completed time.Time) []velerov1api.BackupRepositoryMaintenanceStatus {
+		return []velerov1api.BackupRepositoryMaintenanceStatus{
+			{Result: velerov1api.BackupRepositoryMaintenanceFailed, CompleteTimestamp: &metav1.Time{Time: completed}},
+		}
+	}
+	tests := []struct {
+		name      string
+		frequency time.Duration
+		last      *metav1.Time
+		recent    []velerov1api.BackupRepositoryMaintenanceStatus
+		expected  bool
+	}{
+		{
+			name:      "repository was never maintained",
+			frequency: 24 * time.Hour,
+			expected:  true,
+		},
+		{
+			name:      "repository was maintained recently",
+			frequency: 24 * time.Hour,
+			last:      &metav1.Time{Time: now.Add(-time.Hour)},
+			expected:  false,
+		},
+		{
+			name:      "repository maintenance is due",
+			frequency: 24 * time.Hour,
+			last:      &metav1.Time{Time: now.Add(-25 * time.Hour)},
+			expected:  true,
+		},
+		{
+			name:      "maintenance failed recently",
+			frequency: 24 * time.Hour,
+			last:      &metav1.Time{Time: now.Add(-25 * time.Hour)},
+			recent:    failed(now.Add(-time.Minute)),
+			expected:  false,
+		},
+		{
+			name:      "maintenance is retried after a failure",
+			frequency: 24 * time.Hour,
+			recent:    failed(now.Add(-maintenanceRetryInterval - time.Minute)),
+			expected:  true,
+		},
+		{
+			name:      "maintenance is retried after a failure when the frequency is shorter than the retry interval",
+			frequency: 10 * time.Minute,
+			recent:    failed(now.Add(-11 * time.Minute)),
+			expected:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := mockResticRepositoryCR()
+			rr.Spec.MaintenanceFrequency = metav1.Duration{Duration: test.frequency}
+			rr.Status.LastMaintenanceTime = test.last
+			rr.Status.RecentMaintenance = test.recent
+			assert.Equal(t, test.expected, dueForMaintenance(rr, now))
+		})
+	}
+}
+
+func TestRunMaintenanceIfDueConcurrencyLimit(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+	running := &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: rr.Namespace,
+			Name:      "other-repo-maintain-abcde",
+			Labels: map[string]string{
+				velerov1api.BackupRepositoryNameLabel: "other-repo",
+				velerov1api.BackupRepositoryJobLabel:  repository.RepositoryJobMaintenance,
+			},
+		},
+	}
+	err = reconciler.Client.Create(context.TODO(), running)
+	assert.NoError(t, err)
+
+	result, err := reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, maintenanceJobRequeueInterval, result.RequeueAfter)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+
+	// the running job of the repository itself is picked up
+	running.Labels[velerov1api.BackupRepositoryNameLabel] = rr.Name
+	err = reconciler.Client.Update(context.TODO(), running)
+	assert.NoError(t, err)
+	result, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, running.Name, rr.Status.MaintenanceJob)
+}
+
+func TestRunVerificationIfDue(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	rr.Annotations = map[string]string{velerov1api.VerifyBackupRepositoryAnnotation: "25"}
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	err = reconciler.Client.Create(context.TODO(), deployment)
+	assert.NoError(t, err)
+
+	// no verification is run while the repository is maintained
+	rr.Status.MaintenanceJob = "repo-maintain-abcde"
+	_, err = reconciler.runVerificationIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.VerificationJob)
+	rr.Status.MaintenanceJob = ""
+
+	// a job is started when verification is requested
+	_, err = reconciler.runVerificationIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	require.NotEmpty(t, rr.Status.VerificationJob)
+	assert.NotContains(t, rr.Annotations, velerov1api.VerifyBackupRepositoryAnnotation)
+
+	job := &batchv1api.Job{}
+	err = reconciler.Client.Get(context.TODO(), client.ObjectKey{Namespace: rr.Namespace, Name: rr.Status.VerificationJob}, job)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"repo", "check", "repo", "--namespace=velero", "--read-data-percent=25"}, job.Spec.Template.Spec.Containers[0].Args)
+
+	// no maintenance is run while the repository is verified
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+
+	// nothing changes while the job is running
+	_, err = reconciler.runVerificationIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, job.Name, rr.Status.VerificationJob)
+	assert.Nil(t, rr.Status.LastVerification)
+
+	// the result is recorded once the job is finished
+	job.Status.Conditions = []batchv1api.JobCondition{{Type: batchv1api.JobComplete, Status: corev1api.ConditionTrue}}
+	err = reconciler.Client.Update(context.TODO(), job)
+	require.NoError(t, err)
+	pod := builder.ForPod(rr.Namespace, job.Name+"-xyz12").ObjectMeta(builder.WithLabels("job-name", job.Name)).Result()
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{Message: `{"readDataPercent":25}`}}},
+	}
+	err = reconciler.Client.Create(context.TODO(), pod)
+	require.NoError(t, err)
+	_, err = reconciler.runVerificationIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.VerificationJob)
+	require.NotNil(t, rr.Status.LastVerification)
+	assert.Equal(t, job.Name, rr.Status.LastVerification.Job)
+	assert.Equal(t, velerov1api.BackupRepositoryVerificationPassed, rr.Status.LastVerification.Result)
+	assert.Equal(t, 25, rr.Status.LastVerification.ReadDataPercent)
+
+	// no job is started when verification isn't requested and the repository isn't verified periodically
+	_, err = reconciler.runVerificationIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.VerificationJob)
+}
+
+func TestDueForVerification(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		frequency time.Duration
+		last      *velerov1api.BackupRepositoryVerificationStatus
+		expected  bool
+	}{
+		{
+			name:      "periodic verification is disabled",
+			frequency: 0,
+			expected:  false,
+		},
+		{
+			name:      "repository was never verified",
+			frequency: time.Hour,
+			expected:  true,
+		},
+		{
+			name:      "repository was verified recently",
+			frequency: time.Hour,
+			last:      &velerov1api.BackupRepositoryVerificationStatus{CompleteTimestamp: &metav1.Time{Time: now.Add(-time.Minute)}},
+			expected:  false,
+		},
+		{
+			name:      "repository verification is due",
+			frequency: time.Hour,
+			last:      &velerov1api.BackupRepositoryVerificationStatus{CompleteTimestamp: &metav1.Time{Time: now.Add(-2 * time.Hour)}},
+			expected:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr := mockResticRepositoryCR()
+			rr.Spec.VerificationFrequency = metav1.Duration{Duration: test.frequency}
+			rr.Status.LastVerification = test.last
+			assert.Equal(t, test.expected, dueForVerification(rr, now))
+		})
+	}
+}
+
+func TestCheckVerificationJobFlagsDamagedBackups(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	rr.Spec.BackupStorageLocation = "default"
+	rr.Spec.VolumeNamespace = "ns-1"
+	rr.Status.VerificationJob = "repo-check-abcde"
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	require.NoError(t, err)
+
+	backup := builder.ForBackup(rr.Namespace, "backup-1").Result()
+	backup.Status.DamagedVolumeBackups = []string{"podvolumebackup/pvb-2", "podvolumebackup/other-repo"}
+	err = reconciler.Client.Create(context.TODO(), backup)
+	require.NoError(t, err)
+	for _, pvb := range []*velerov1api.PodVolumeBackup{
+		builder.ForPodVolumeBackup(rr.Namespace, "pvb-1").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backup.Name)).
+			BackupStorageLocation("default").PodNamespace("ns-1").SnapshotID("snapshot-1").Result(),
+		builder.ForPodVolumeBackup(rr.Namespace, "pvb-2").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backup.Name)).
+			BackupStorageLocation("default").PodNamespace("ns-1").SnapshotID("snapshot-2").Result(),
+		builder.ForPodVolumeBackup(rr.Namespace, "pvb-3").ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backup.Name)).
+			BackupStorageLocation("default").PodNamespace("ns-2").SnapshotID("snapshot-1").Result(),
+	} {
+		err = reconciler.Client.Create(context.TODO(), pvb)
+		require.NoError(t, err)
+	}
+
+	job := &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: rr.Namespace,
+			Name:      rr.Status.VerificationJob,
+			Labels: map[string]string{
+				velerov1api.BackupRepositoryNameLabel: rr.Name,
+				velerov1api.BackupRepositoryJobLabel:  repository.RepositoryJobVerification,
+			},
+		},
+		Status: batchv1api.JobStatus{
+			Conditions: []batchv1api.JobCondition{{Type: batchv1api.JobComplete, Status: corev1api.ConditionTrue}},
+		},
+	}
+	err = reconciler.Client.Create(context.TODO(), job)
+	require.NoError(t, err)
+	pod := builder.ForPod(rr.Namespace, job.Name+"-xyz12").ObjectMeta(builder.WithLabels("job-name", job.Name)).Result()
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{Message: `{"damage":"snapshot-1 is damaged","damagedSnapshots":["snapshot-1"]}`}}},
+	}
+	err = reconciler.Client.Create(context.TODO(), pod)
+	require.NoError(t, err)
+
+	err = reconciler.checkRepositoryJob(context.TODO(), rr, reconciler.verificationJob(), reconciler.logger)
+	require.NoError(t, err)
+	assert.Empty(t, rr.Status.VerificationJob)
+	require.NotNil(t, rr.Status.LastVerification)
+	assert.Equal(t, velerov1api.BackupRepositoryVerificationDamaged, rr.Status.LastVerification.Result)
+	assert.Equal(t, "snapshot-1 is damaged", rr.Status.Message)
+
+	// the volume backups of other repositories keep their flags
+	err = reconciler.Client.Get(context.TODO(), client.ObjectKeyFromObject(backup), backup)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"podvolumebackup/other-repo", "podvolumebackup/pvb-1"}, backup.Status.DamagedVolumeBackups)
+}
+
+func TestRunKeyRotationIfRequested(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	rr.Annotations = map[string]string{velerov1api.RotateBackupRepositoryKeyAnnotation: ""}
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	err = reconciler.Client.Create(context.TODO(), deployment)
+	assert.NoError(t, err)
+
+	// the key isn't rotated while the repository is verified
+	rr.Status.VerificationJob = "repo-check-abcde"
+	_, err = reconciler.runKeyRotationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.KeyRotationJob)
+	rr.Status.VerificationJob = ""
+
+	// a job is started when key rotation is requested
+	_, err = reconciler.runKeyRotationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	require.NotEmpty(t, rr.Status.KeyRotationJob)
+	assert.NotContains(t, rr.Annotations, velerov1api.RotateBackupRepositoryKeyAnnotation)
+
+	job := &batchv1api.Job{}
+	err = reconciler.Client.Get(context.TODO(), client.ObjectKey{Namespace: rr.Namespace, Name: rr.Status.KeyRotationJob}, job)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"repo", "rekey", "repo", "--namespace=velero"}, job.Spec.Template.Spec.Containers[0].Args)
+
+	// no maintenance or verification is run while the key is rotated
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+	rr.Annotations = map[string]string{velerov1api.VerifyBackupRepositoryAnnotation: "0"}
+	_, err = reconciler.runVerificationIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.VerificationJob)
+	rr.Annotations = nil
+
+	// nothing changes while the job is running
+	_, err = reconciler.runKeyRotationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, job.Name, rr.Status.KeyRotationJob)
+	assert.Nil(t, rr.Status.LastKeyRotationTime)
+
+	// the result is recorded once the job is finished
+	job.Status.Conditions = []batchv1api.JobCondition{{Type: batchv1api.JobComplete, Status: corev1api.ConditionTrue}}
+	err = reconciler.Client.Update(context.TODO(), job)
+	require.NoError(t, err)
+	pod := builder.ForPod(rr.Namespace, job.Name+"-xyz12").ObjectMeta(builder.WithLabels("job-name", job.Name)).Result()
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{Message: `{"keySecret":"velero-repo-key-ns-1-default-restic","keyGeneration":2}`}}},
+	}
+	err = reconciler.Client.Create(context.TODO(), pod)
+	require.NoError(t, err)
+	_, err = reconciler.runKeyRotationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.KeyRotationJob)
+	assert.Equal(t, "velero-repo-key-ns-1-default-restic", rr.Spec.KeySecret)
+	assert.Equal(t, 2, rr.Status.KeyGeneration)
+	assert.NotNil(t, rr.Status.LastKeyRotationTime)
+
+	// no job is started when key rotation isn't requested
+	_, err = reconciler.runKeyRotationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.KeyRotationJob)
+}
+
+func TestRunKeyRotationIfRequestedDeletedJob(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	rr.Status.KeyGeneration = 1
+	rr.Status.KeyRotationJob = "repo-key-rotation-abcde"
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+
+	_, err = reconciler.runKeyRotationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.KeyRotationJob)
+	assert.Equal(t, "error rotating repository key: key rotation job was deleted before it finished", rr.Status.Message)
+	assert.Equal(t, 1, rr.Status.KeyGeneration)
+	assert.Nil(t, rr.Status.LastKeyRotationTime)
+}
+
+func TestRunMigrationIfRequested(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	rr.Annotations = map[string]string{velerov1api.MigrateBackupRepositoryAnnotation: "location-2"}
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	err = reconciler.Client.Create(context.TODO(), deployment)
+	assert.NoError(t, err)
+
+	// the repository isn't migrated while its key is rotated
+	rr.Status.KeyRotationJob = "repo-rekey-abcde"
+	_, err = reconciler.runMigrationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MigrationJob)
+	rr.Status.KeyRotationJob = ""
+
+	// a job is started when migration is requested
+	_, err = reconciler.runMigrationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	require.NotEmpty(t, rr.Status.MigrationJob)
+	assert.NotContains(t, rr.Annotations, velerov1api.MigrateBackupRepositoryAnnotation)
+
+	job := &batchv1api.Job{}
+	err = reconciler.Client.Get(context.TODO(), client.ObjectKey{Namespace: rr.Namespace, Name: rr.Status.MigrationJob}, job)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"repo", "copy", "repo", "--namespace=velero", "--to-location=location-2"}, job.Spec.Template.Spec.Containers[0].Args)
+
+	// no maintenance is run while the repository is migrated
+	_, err = reconciler.runMaintenanceIfDue(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MaintenanceJob)
+
+	// nothing changes while the job is running
+	_, err = reconciler.runMigrationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Equal(t, job.Name, rr.Status.MigrationJob)
+	assert.Nil(t, rr.Status.LastMigration)
+
+	// the result is recorded once the job is finished
+	job.Status.Conditions = []batchv1api.JobCondition{{Type: batchv1api.JobComplete, Status: corev1api.ConditionTrue}}
+	err = reconciler.Client.Update(context.TODO(), job)
+	require.NoError(t, err)
+	pod := builder.ForPod(rr.Namespace, job.Name+"-xyz12").ObjectMeta(builder.WithLabels("job-name", job.Name)).Result()
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{State: corev1api.ContainerState{Terminated: &corev1api.ContainerStateTerminated{Message: `{"targetRepository":"ns-1-location-2-restic-xyz12","snapshots":3,"backups":2}`}}},
+	}
+	err = reconciler.Client.Create(context.TODO(), pod)
+	require.NoError(t, err)
+	_, err = reconciler.runMigrationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MigrationJob)
+	require.NotNil(t, rr.Status.LastMigration)
+	assert.Equal(t, velerov1api.BackupRepositoryMigrationSucceeded, rr.Status.LastMigration.Result)
+	assert.Equal(t, "location-2", rr.Status.LastMigration.TargetLocation)
+	assert.Equal(t, "ns-1-location-2-restic-xyz12", rr.Status.LastMigration.TargetRepository)
+	assert.Equal(t, 3, rr.Status.LastMigration.Snapshots)
+	assert.Equal(t, 2, rr.Status.LastMigration.Backups)
+
+	// no job is started when migration isn't requested
+	_, err = reconciler.runMigrationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MigrationJob)
+}
+
+func TestRunMigrationIfRequestedDeletedJob(t *testing.T) {
+	rr := mockResticRepositoryCR()
+	rr.Status.MigrationJob = "repo-copy-abcde"
+	reconciler := mockResticRepoReconciler(t, rr, "", nil, nil)
+	err := reconciler.Client.Create(context.TODO(), rr)
+	assert.NoError(t, err)
+
+	_, err = reconciler.runMigrationIfRequested(context.TODO(), rr, reconciler.logger)
+	assert.NoError(t, err)
+	assert.Empty(t, rr.Status.MigrationJob)
+	require.NotNil(t, rr.Status.LastMigration)
+	assert.Equal(t, velerov1api.BackupRepositoryMigrationFailed, rr.Status.LastMigration.Result)
+	assert.Equal(t, "migration job was deleted before it finished", rr.Status.LastMigration.Message)
 }
 
 func TestInitializeRepo(t *testing.T) {
@@ -125,6 +663,7 @@ func TestInitializeRepo(t *testing.T) {
 	err = reconciler.initializeRepo(context.TODO(), rr, reconciler.logger)
 	assert.NoError(t, err)
 	assert.Equal(t, rr.Status.Phase, velerov1api.BackupRepositoryPhaseReady)
+	assert.Equal(t, 1, rr.Status.KeyGeneration)
 }
 
 func TestResticRepoReconcile(t *testing.T) {
@@ -232,6 +771,9 @@ func TestGetRepositoryMaintenanceFrequency(t *testing.T) {
 				velerotest.NewLogger(),
 				velerotest.NewFakeControllerRuntimeClient(t),
 				test.userDefinedFreq,
+				0,
+				false,
+				repository.MaintenanceJobConfig{},
 				&mgr,
 			)
 
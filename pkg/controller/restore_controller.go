@@ -41,6 +41,7 @@ import (
 	hook "github.com/vmware-tanzu/velero/internal/hook"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	velerov1informers "github.com/vmware-tanzu/velero/pkg/generated/informers/externalversions/velero/v1"
 	velerov1listers "github.com/vmware-tanzu/velero/pkg/generated/listers/velero/v1"
@@ -50,6 +51,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
@@ -80,6 +82,11 @@ var nonRestorableResources = []string{
 
 	// Backup repositories were renamed from Restic repositories
 	"backuprepositories.velero.io",
+
+	// Data uploads and downloads are created by backups and restores that move CSI
+	// snapshot data; the data uploads are persisted with the backup.
+	"datauploads.velero.io",
+	"datadownloads.velero.io",
 }
 
 type restoreController struct {
@@ -150,21 +157,26 @@ func NewRestoreController(
 
 				switch restore.Status.Phase {
 				case "", api.RestorePhaseNew:
-					// only process new restores
+					// only process new restores, and restores to roll back
 				default:
-					c.logger.WithFields(logrus.Fields{
-						"restore": kubeutil.NamespaceAndName(restore),
-						"phase":   restore.Status.Phase,
-					}).Debug("Restore is not new, skipping")
-					return
+					if !rollbackRequested(restore) {
+						c.logger.WithFields(logrus.Fields{
+							"restore": kubeutil.NamespaceAndName(restore),
+							"phase":   restore.Status.Phase,
+						}).Debug("Restore is not new, skipping")
+						return
+					}
 				}
 
-				key, err := cache.MetaNamespaceKeyFunc(restore)
-				if err != nil {
-					c.logger.WithError(errors.WithStack(err)).WithField("restore", restore).Error("Error creating queue key, item not added to queue")
-					return
+				c.enqueue(restore)
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				restore := obj.(*api.Restore)
+
+				// a rollback is requested when the restore finishes or when spec.rollback is set
+				if rollbackRequested(restore) && restore.Status.Rollback == nil {
+					c.enqueue(restore)
 				}
-				c.queue.Add(key)
 			},
 		},
 	)
@@ -172,6 +184,15 @@ func NewRestoreController(
 	return c
 }
 
+func (c *restoreController) enqueue(restore *api.Restore) {
+	key, err := cache.MetaNamespaceKeyFunc(restore)
+	if err != nil {
+		c.logger.WithError(errors.WithStack(err)).WithField("restore", restore).Error("Error creating queue key, item not added to queue")
+		return
+	}
+	c.queue.Add(key)
+}
+
 func (c *restoreController) resync() {
 	restores, err := c.restoreLister.List(labels.Everything())
 	if err != nil {
@@ -209,6 +230,9 @@ func (c *restoreController) processQueueItem(key string) error {
 	case "", api.RestorePhaseNew:
 		// only process new restores
 	default:
+		if rollbackRequested(restore) {
+			return c.processRollback(restore)
+		}
 		return nil
 	}
 
@@ -226,6 +250,16 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	// store a copy of the original restore for creating patch
 	original := restore.DeepCopy()
 
+	if restore.Spec.Cancel {
+		c.logger.WithField("restore", kubeutil.NamespaceAndName(restore)).Info("Restore was canceled before it started")
+		restore.Status.Phase = api.RestorePhaseCanceled
+		restore.Status.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+		if _, err := patchRestore(original, restore, c.restoreClient); err != nil {
+			return errors.Wrapf(err, "error updating Restore phase to %s", restore.Status.Phase)
+		}
+		return nil
+	}
+
 	// Validate the restore and fetch the backup. Note that the plugin
 	// manager used here is not the same one used by c.runValidatedRestore,
 	// since within that function we want the plugin manager to log to
@@ -233,6 +267,9 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 	pluginManager := c.newPluginManager(c.logger)
 	defer pluginManager.CleanupClients()
 	info := c.validateAndComplete(restore, pluginManager)
+	if info.backupFile != nil {
+		defer closeAndRemoveFile(info.backupFile, c.logger)
+	}
 
 	// Register attempts after validation so we don't have to fetch the backup multiple times
 	backupScheduleName := restore.Spec.ScheduleName
@@ -261,7 +298,10 @@ func (c *restoreController) processRestore(restore *api.Restore) error {
 		return nil
 	}
 
-	if err := c.runValidatedRestore(restore, info); err != nil {
+	if err := c.runValidatedRestore(restore, info); err == errCanceled {
+		c.logger.Debug("Restore canceled")
+		restore.Status.Phase = api.RestorePhaseCanceled
+	} else if err != nil {
 		c.logger.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
@@ -289,6 +329,9 @@ type backupInfo struct {
 	backup      *api.Backup
 	location    *velerov1api.BackupStorageLocation
 	backupStore persistence.BackupStore
+	// backupFile holds the backup's contents if they were downloaded
+	// during validation, so that they aren't downloaded again.
+	backupFile *os.File
 }
 
 func (c *restoreController) validateAndComplete(restore *api.Restore, pluginManager clientmgmt.Manager) backupInfo {
@@ -313,6 +356,14 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded resource lists: %v", err))
 	}
 
+	// validate included/excluded items
+	for _, err := range pkgrestore.ValidateItemPatterns(restore.Spec.IncludedItems, restore.Spec.ExcludedItems) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded item lists: %v", err))
+	}
+	for _, err := range pkgrestore.ValidateFieldSelectors(restore.Spec.FieldSelectors) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid field selectors: %v", err))
+	}
+
 	// validate included/excluded namespaces
 	for _, err := range collections.ValidateIncludesExcludes(restore.Spec.IncludedNamespaces, restore.Spec.ExcludedNamespaces) {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
@@ -380,6 +431,31 @@ func (c *restoreController) validateAndComplete(restore *api.Restore, pluginMana
 		restore.Spec.ScheduleName = info.backup.GetLabels()[velerov1api.ScheduleNameLabel]
 	}
 
+	// resolve the namespace mapping rules against the namespaces in the backup, so that
+	// conflicting mappings are reported before anything is restored
+	if len(restore.Spec.NamespaceMappingRules) > 0 {
+		backupFile, err := downloadToTempFile(restore.Spec.BackupName, info.backupStore, c.logger)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error downloading backup: %v", err))
+			return info
+		}
+		info.backupFile = backupFile
+
+		namespaces, err := backupNamespaces(restore.Spec.BackupName, backupFile, info.backupStore)
+		if err != nil {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Error getting the namespaces in the backup: %v", err))
+			return info
+		}
+
+		mapping, errs := pkgrestore.ResolveNamespaceMapping(&restore.Spec, namespaces)
+		for _, err := range errs {
+			restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid namespace mapping: %v", err))
+		}
+		if len(mapping) > 0 {
+			restore.Spec.NamespaceMapping = mapping
+		}
+	}
+
 	return info
 }
 
@@ -478,11 +554,27 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	}
 	snapshotItemResolver := framework.NewItemSnapshotterResolver(itemSnapshotters)
 
-	backupFile, err := downloadToTempFile(restore.Spec.BackupName, info.backupStore, restoreLog)
+	backupFile := info.backupFile
+	if backupFile == nil {
+		backupFile, err = downloadToTempFile(restore.Spec.BackupName, info.backupStore, restoreLog)
+		if err != nil {
+			return errors.Wrap(err, "error downloading backup")
+		}
+		defer closeAndRemoveFile(backupFile, c.logger)
+	}
+
+	// only the archives of the namespaces being restored are needed
+	namespaceFiles, err := downloadNamespaceArchivesToTempFiles(restore.Spec.BackupName,
+		collections.NewIncludesExcludes().Includes(restore.Spec.IncludedNamespaces...).Excludes(restore.Spec.ExcludedNamespaces...),
+		info.backupStore, restoreLog)
 	if err != nil {
 		return errors.Wrap(err, "error downloading backup")
 	}
-	defer closeAndRemoveFile(backupFile, c.logger)
+	namespaceReaders := make(map[string]io.Reader, len(namespaceFiles))
+	for namespace, file := range namespaceFiles {
+		defer closeAndRemoveFile(file, c.logger)
+		namespaceReaders[namespace] = file
+	}
 
 	opts := label.NewListOptionsForBackup(restore.Spec.BackupName)
 
@@ -496,23 +588,50 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 		return errors.Wrap(err, "error fetching volume snapshots metadata")
 	}
 
+	var dataUploads []*velerov1api.DataUpload
+	if boolptr.IsSetToTrue(info.backup.Spec.SnapshotMoveData) {
+		if dataUploads, err = info.backupStore.GetDataUploads(restore.Spec.BackupName); err != nil {
+			return errors.Wrap(err, "error fetching data uploads metadata")
+		}
+	}
+
 	restoreLog.Info("starting restore")
 
 	var podVolumeBackups []*velerov1api.PodVolumeBackup
 	for i := range podVolumeBackupList.Items {
 		podVolumeBackups = append(podVolumeBackups, &podVolumeBackupList.Items[i])
 	}
+	// Stop the restore if it is canceled while it's running.
+	ctx, cancelRestore := withCancelRequest(context.Background(), cancelPollInterval, func() bool {
+		current, err := c.restoreLister.Restores(restore.Namespace).Get(restore.Name)
+		return err == nil && current.Spec.Cancel
+	})
+	defer cancelRestore()
+
+	rollbackRecord := new(pkgrestore.RollbackRecord)
+	serviceResults := new(pkgrestore.Result)
 	restoreReq := pkgrestore.Request{
-		Log:              restoreLog,
-		Restore:          restore,
-		Backup:           info.backup,
-		PodVolumeBackups: podVolumeBackups,
-		VolumeSnapshots:  volumeSnapshots,
-		BackupReader:     backupFile,
+		Log:                    restoreLog,
+		Restore:                restore,
+		Backup:                 info.backup,
+		PodVolumeBackups:       podVolumeBackups,
+		VolumeSnapshots:        volumeSnapshots,
+		DataUploads:            dataUploads,
+		BackupReader:           backupFile,
+		NamespaceBackupReaders: namespaceReaders,
+		Context:                ctx,
+		RollbackRecord:         rollbackRecord,
+		ServiceResults:         serviceResults,
 	}
 	restoreWarnings, restoreErrors := c.restorer.RestoreWithResolvers(restoreReq, actionsResolver, snapshotItemResolver,
 		c.snapshotLocationLister, pluginManager)
 
+	canceled := ctx.Err() != nil
+	if canceled {
+		restoreLog.Info("Restore was canceled, canceling pod volume restores")
+		c.cancelPodVolumeRestores(restore, restoreLog)
+	}
+
 	// log errors and warnings to the restore log
 	for _, msg := range restoreErrors.Velero {
 		restoreLog.Errorf("Velero restore error: %v", msg)
@@ -569,15 +688,156 @@ func (c *restoreController) runValidatedRestore(restore *api.Restore, info backu
 	m := map[string]pkgrestore.Result{
 		"warnings": restoreWarnings,
 		"errors":   restoreErrors,
+		"services": *serviceResults,
 	}
 
 	if err := putResults(restore, m, info.backupStore, c.logger); err != nil {
 		c.logger.WithError(err).Error("Error uploading restore results to backup storage")
 	}
 
+	if err := putRollbackRecord(restore, rollbackRecord, info.backupStore); err != nil {
+		c.logger.WithError(err).Error("Error uploading restore rollback record to backup storage")
+	}
+
+	if canceled {
+		return errCanceled
+	}
+	return nil
+}
+
+// cancelPodVolumeRestores requests cancellation of restore's unfinished pod volume restores.
+func (c *restoreController) cancelPodVolumeRestores(restore *api.Restore, log logrus.FieldLogger) {
+	pvrs := &velerov1api.PodVolumeRestoreList{}
+	if err := c.kbClient.List(context.Background(), pvrs, client.InNamespace(restore.Namespace), client.MatchingLabels{
+		velerov1api.RestoreNameLabel: label.GetValidName(restore.Name),
+	}); err != nil {
+		log.WithError(err).Error("Error listing pod volume restores to cancel")
+		return
+	}
+
+	for i := range pvrs.Items {
+		pvr := &pvrs.Items[i]
+		switch pvr.Status.Phase {
+		case velerov1api.PodVolumeRestorePhaseCompleted, velerov1api.PodVolumeRestorePhaseFailed, velerov1api.PodVolumeRestorePhaseCanceled:
+			continue
+		}
+
+		log.Infof("Canceling pod volume restore %s", pvr.Name)
+		original := pvr.DeepCopy()
+		pvr.Spec.Cancel = true
+		if err := c.kbClient.Patch(context.Background(), pvr, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Errorf("Error canceling pod volume restore %s", pvr.Name)
+		}
+	}
+}
+
+// rollbackRequested returns whether restore has finished and should be rolled back, either
+// because a rollback was requested or because it failed and should be rolled back on failure,
+// and the rollback hasn't run yet or was interrupted.
+func rollbackRequested(restore *api.Restore) bool {
+	if restore.Status.Rollback != nil && restore.Status.Rollback.Phase != api.RestoreRollbackPhaseInProgress {
+		return false
+	}
+
+	switch restore.Status.Phase {
+	case api.RestorePhaseFailed, api.RestorePhasePartiallyFailed:
+		return restore.Spec.Rollback || restore.Spec.RollbackOnFailure
+	case api.RestorePhaseCompleted, api.RestorePhaseCanceled:
+		return restore.Spec.Rollback
+	default:
+		return false
+	}
+}
+
+// processRollback rolls back the changes restore made to the cluster and records the outcome
+// in its status.
+func (c *restoreController) processRollback(restore *api.Restore) error {
+	log := c.logger.WithField("restore", kubeutil.NamespaceAndName(restore))
+
+	// the restore from the lister may be stale if the rollback has just run, so
+	// get the latest version before starting it
+	restore, err := c.restoreClient.Restores(restore.Namespace).Get(context.TODO(), restore.Name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error getting Restore")
+	}
+	if !rollbackRequested(restore) {
+		return nil
+	}
+
+	original := restore.DeepCopy()
+	restore.Status.Rollback = &api.RestoreRollbackStatus{
+		Phase:          api.RestoreRollbackPhaseInProgress,
+		StartTimestamp: &metav1.Time{Time: c.clock.Now()},
+	}
+	updatedRestore, err := patchRestore(original, restore, c.restoreClient)
+	if err != nil {
+		return errors.Wrapf(err, "error updating Restore rollback phase to %s", restore.Status.Rollback.Phase)
+	}
+	original = updatedRestore
+	restore = updatedRestore.DeepCopy()
+
+	log.Info("Rolling back restore")
+	result, err := c.runRollback(restore, log)
+	if err != nil {
+		log.WithError(err).Error("Rollback failed")
+		restore.Status.Rollback.Phase = api.RestoreRollbackPhaseFailed
+		restore.Status.Rollback.FailureReason = err.Error()
+	} else {
+		restore.Status.Rollback.ItemsDeleted = result.ItemsDeleted
+		restore.Status.Rollback.ItemsReverted = result.ItemsReverted
+		restore.Status.Rollback.Errors = result.Errors
+		if len(result.Errors) > 0 {
+			restore.Status.Rollback.Phase = api.RestoreRollbackPhasePartiallyFailed
+		} else {
+			restore.Status.Rollback.Phase = api.RestoreRollbackPhaseCompleted
+		}
+		log.Infof("Rollback finished: %d items deleted, %d items reverted, %d errors", result.ItemsDeleted, result.ItemsReverted, len(result.Errors))
+	}
+
+	restore.Status.Rollback.CompletionTimestamp = &metav1.Time{Time: c.clock.Now()}
+	if _, err := patchRestore(original, restore, c.restoreClient); err != nil {
+		log.WithError(errors.WithStack(err)).Info("Error updating restore's rollback status")
+	}
+
 	return nil
 }
 
+// runRollback fetches the record of the changes restore made to the cluster and reverts them.
+func (c *restoreController) runRollback(restore *api.Restore, log logrus.FieldLogger) (pkgrestore.RollbackResult, error) {
+	pluginManager := c.newPluginManager(log)
+	defer pluginManager.CleanupClients()
+
+	info, err := c.fetchBackupInfo(restore.Spec.BackupName, pluginManager)
+	if err != nil {
+		return pkgrestore.RollbackResult{}, errors.Wrapf(err, "error getting the backup storage location of backup %s", restore.Spec.BackupName)
+	}
+
+	rc, err := info.backupStore.GetRestoreRollbackRecord(restore.Name)
+	if err != nil {
+		return pkgrestore.RollbackResult{}, errors.Wrap(err, "error getting the restore's rollback record")
+	}
+	if rc == nil {
+		return pkgrestore.RollbackResult{}, errors.New("no record of the restore's changes was found in backup storage")
+	}
+	defer rc.Close()
+
+	record, err := pkgrestore.DecodeRollbackRecord(rc)
+	if err != nil {
+		return pkgrestore.RollbackResult{}, err
+	}
+
+	return c.restorer.Rollback(record, log), nil
+}
+
+func putRollbackRecord(restore *api.Restore, record *pkgrestore.RollbackRecord, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	if err := pkgrestore.EncodeRollbackRecord(record, buf); err != nil {
+		return err
+	}
+
+	return backupStore.PutRestoreRollbackRecord(restore.Name, buf)
+}
+
 func putResults(restore *api.Restore, results map[string]pkgrestore.Result, backupStore persistence.BackupStore, log logrus.FieldLogger) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -603,6 +863,68 @@ func downloadToTempFile(backupName string, backupStore persistence.BackupStore,
 	if err != nil {
 		return nil, err
 	}
+
+	return copyToTempFile(backupName, readCloser, logger)
+}
+
+// backupNamespaces returns the namespaces in the backup's archives. backupFile holds the
+// backup's downloaded contents and is rewound afterwards so that it can be restored from.
+func backupNamespaces(backupName string, backupFile *os.File, backupStore persistence.BackupStore) (sets.String, error) {
+	namespaces, err := archive.Namespaces(backupFile)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := backupFile.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "error rewinding backup file")
+	}
+
+	// the items of a backup in the split archive format are in the archives of their namespaces
+	index, err := backupStore.GetBackupIndex(backupName)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup archive index")
+	}
+	if index != nil {
+		for namespace := range index.Namespaces {
+			namespaces.Insert(namespace)
+		}
+	}
+
+	return namespaces, nil
+}
+
+// downloadNamespaceArchivesToTempFiles downloads the archives of the namespaces that namespaces
+// includes, keyed by namespace, if the backup is in the split archive format. It returns nil if
+// the backup is a single tarball, which is downloaded by downloadToTempFile.
+func downloadNamespaceArchivesToTempFiles(backupName string, namespaces *collections.IncludesExcludes, backupStore persistence.BackupStore, logger logrus.FieldLogger) (map[string]*os.File, error) {
+	index, err := backupStore.GetBackupIndex(backupName)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup archive index")
+	}
+	if index == nil {
+		return nil, nil
+	}
+
+	files := map[string]*os.File{}
+	for _, namespace := range index.NamespacesFor(namespaces) {
+		readCloser, err := backupStore.GetBackupNamespaceContents(backupName, namespace)
+		if err == nil {
+			var file *os.File
+			if file, err = copyToTempFile(backupName, readCloser, logger); err == nil {
+				files[namespace] = file
+				continue
+			}
+		}
+
+		for _, file := range files {
+			closeAndRemoveFile(file, logger)
+		}
+		return nil, errors.Wrapf(err, "error downloading archive of namespace %s", namespace)
+	}
+
+	return files, nil
+}
+
+func copyToTempFile(backupName string, readCloser io.ReadCloser, logger logrus.FieldLogger) (*os.File, error) {
 	defer readCloser.Close()
 
 	file, err := ioutil.TempFile("", backupName)
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -248,10 +249,12 @@ func TestProcessQueueItem(t *testing.T) {
 		expectedStartTime               *metav1.Time
 		expectedCompletedTime           *metav1.Time
 		expectedValidationErrors        []string
+		expectedNamespaceMapping        map[string]string
 		expectedRestoreErrors           int
 		expectedRestorerCall            *velerov1api.Restore
 		backupStoreGetBackupMetadataErr error
 		backupStoreGetBackupContentsErr error
+		backupContents                  io.Reader
 		putRestoreLogErr                error
 		expectedFinalPhase              string
 	}{
@@ -273,6 +276,41 @@ func TestProcessQueueItem(t *testing.T) {
 			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
 			expectedValidationErrors: []string{"Invalid included/excluded resource lists: excludes list cannot contain an item in the includes list: a-resource"},
 		},
+		{
+			name:     "restore with namespace mapping rules that map two backup namespaces to one fails validation",
+			location: defaultStorageLocation,
+			restore: NewRestore("foo", "bar", "backup-1", "*", "", velerov1api.RestorePhaseNew).
+				NamespaceMappingRules(velerov1api.NamespaceMappingRule{Pattern: "*-blue", Target: "${1}"}, velerov1api.NamespaceMappingRule{Pattern: "*-green", Target: "${1}"}).
+				Result(),
+			backup: defaultBackup().StorageLocation("default").Result(),
+			backupContents: velerotest.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("app-blue", "pod-1").Result(), builder.ForPod("app-green", "pod-1").Result()).
+				Done(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Invalid namespace mapping: namespaces app-blue, app-green would all be restored into namespace app"},
+			expectedNamespaceMapping: map[string]string{"app-blue": "app", "app-green": "app"},
+		},
+		{
+			name:     "restore with namespace mapping rules downloads the backup once",
+			location: defaultStorageLocation,
+			restore: NewRestore("foo", "bar", "backup-1", "*", "", velerov1api.RestorePhaseNew).
+				NamespaceMappingRules(velerov1api.NamespaceMappingRule{Pattern: "*-blue", Target: "${1}"}).
+				Result(),
+			backup: defaultBackup().StorageLocation("default").Result(),
+			backupContents: velerotest.NewTarWriter(t).
+				AddItems("pods", builder.ForPod("app-blue", "pod-1").Result()).
+				Done(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseInProgress),
+			expectedStartTime:        &timestamp,
+			expectedCompletedTime:    &timestamp,
+			expectedNamespaceMapping: map[string]string{"app-blue": "app"},
+			expectedRestorerCall: NewRestore("foo", "bar", "backup-1", "*", "", velerov1api.RestorePhaseInProgress).
+				NamespaceMappingRules(velerov1api.NamespaceMappingRule{Pattern: "*-blue", Target: "${1}"}).
+				NamespaceMappings("app-blue", "app").
+				Result(),
+		},
 		{
 			name:                     "new restore with empty backup and schedule names fails validation",
 			restore:                  NewRestore("foo", "bar", "", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
@@ -498,6 +536,11 @@ func TestProcessQueueItem(t *testing.T) {
 						res.Spec.BackupName = backupName
 					}
 
+					namespaceMapping, found, err := unstructured.NestedStringMap(patchMap, "spec", "namespaceMapping")
+					if found {
+						res.Spec.NamespaceMapping = namespaceMapping
+					}
+
 					return true, res, nil
 				})
 			}
@@ -514,7 +557,10 @@ func TestProcessQueueItem(t *testing.T) {
 				errors.Velero = append(errors.Velero, "error uploading log file to object storage: "+test.putRestoreLogErr.Error())
 			}
 			if test.expectedRestorerCall != nil {
-				backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+				if test.backupContents == nil {
+					backupStore.On("GetBackupContents", test.backup.Name).Return(ioutil.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+					backupStore.On("GetBackupIndex", test.backup.Name).Return(nil, nil)
+				}
 
 				restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 					mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(warnings, errors)
@@ -522,6 +568,7 @@ func TestProcessQueueItem(t *testing.T) {
 				backupStore.On("PutRestoreLog", test.backup.Name, test.restore.Name, mock.Anything).Return(test.putRestoreLogErr)
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreRollbackRecord", test.restore.Name, mock.Anything).Return(nil)
 
 				volumeSnapshots := []*volume.Snapshot{
 					{
@@ -550,6 +597,11 @@ func TestProcessQueueItem(t *testing.T) {
 				backupStore.On("GetBackupMetadata", test.restore.Spec.BackupName).Return(nil, test.backupStoreGetBackupMetadataErr).Maybe()
 			}
 
+			if test.backupContents != nil {
+				backupStore.On("GetBackupContents", test.restore.Spec.BackupName).Return(ioutil.NopCloser(test.backupContents), nil)
+				backupStore.On("GetBackupIndex", test.restore.Spec.BackupName).Return(nil, nil)
+			}
+
 			if test.backupStoreGetBackupContentsErr != nil {
 				// TODO why do I need .Maybe() here?
 				backupStore.On("GetBackupContents", test.restore.Spec.BackupName).Return(nil, test.backupStoreGetBackupContentsErr).Maybe()
@@ -573,7 +625,8 @@ func TestProcessQueueItem(t *testing.T) {
 
 			// structs and func for decoding patch content
 			type SpecPatch struct {
-				BackupName string `json:"backupName"`
+				BackupName       string            `json:"backupName"`
+				NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 			}
 
 			type StatusPatch struct {
@@ -612,6 +665,8 @@ func TestProcessQueueItem(t *testing.T) {
 				}
 			}
 
+			expected.Spec.NamespaceMapping = test.expectedNamespaceMapping
+
 			if test.expectedStartTime != nil {
 				expected.Status.StartTimestamp = test.expectedStartTime
 			}
@@ -652,6 +707,10 @@ func TestProcessQueueItem(t *testing.T) {
 			// I want to validate the called arg as of the time of calling, but
 			// the mock stores the pointer, which gets modified after
 			assert.Equal(t, *test.expectedRestorerCall, restorer.calledWithArg)
+
+			if test.backupContents != nil {
+				backupStore.AssertNumberOfCalls(t, "GetBackupContents", 1)
+			}
 		})
 	}
 }
@@ -766,6 +825,33 @@ func TestBackupXorScheduleProvided(t *testing.T) {
 	assert.True(t, backupXorScheduleProvided(r))
 }
 
+func TestRollbackRequested(t *testing.T) {
+	r := &velerov1api.Restore{}
+	r.Status.Phase = velerov1api.RestorePhaseCompleted
+	assert.False(t, rollbackRequested(r))
+
+	r.Spec.RollbackOnFailure = true
+	assert.False(t, rollbackRequested(r))
+
+	r.Status.Phase = velerov1api.RestorePhasePartiallyFailed
+	assert.True(t, rollbackRequested(r))
+
+	r.Spec.RollbackOnFailure = false
+	r.Spec.Rollback = true
+	r.Status.Phase = velerov1api.RestorePhaseCompleted
+	assert.True(t, rollbackRequested(r))
+
+	r.Status.Phase = velerov1api.RestorePhaseInProgress
+	assert.False(t, rollbackRequested(r))
+
+	r.Status.Phase = velerov1api.RestorePhaseCompleted
+	r.Status.Rollback = &velerov1api.RestoreRollbackStatus{Phase: velerov1api.RestoreRollbackPhaseInProgress}
+	assert.True(t, rollbackRequested(r))
+
+	r.Status.Rollback.Phase = velerov1api.RestoreRollbackPhaseCompleted
+	assert.False(t, rollbackRequested(r))
+}
+
 func TestMostRecentCompletedBackup(t *testing.T) {
 	backups := []*velerov1api.Backup{
 		{
@@ -885,3 +971,8 @@ func (r *fakeRestorer) RestoreWithResolvers(req pkgrestore.Request,
 
 	return res.Get(0).(pkgrestore.Result), res.Get(1).(pkgrestore.Result)
 }
+
+func (r *fakeRestorer) Rollback(record *pkgrestore.RollbackRecord, log logrus.FieldLogger) pkgrestore.RollbackResult {
+	res := r.Called(record, log)
+	return res.Get(0).(pkgrestore.RollbackResult)
+}
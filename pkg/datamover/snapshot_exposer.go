@@ -0,0 +1,189 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamover
+
+import (
+	"context"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// ExposeSnapshot makes the data of a DataUpload's CSI VolumeSnapshot available to the
+// node agent: the snapshot is bound to a new VolumeSnapshot in the DataUpload's namespace,
+// which is restored to a PersistentVolumeClaim that a pod mounts. The objects are named
+// after the DataUpload, and ExposeSnapshot can be called again if it fails part way.
+func ExposeSnapshot(ctx context.Context, client kbclient.Client, du *velerov1api.DataUpload, image string, log logrus.FieldLogger) error {
+	vs := new(snapshotv1api.VolumeSnapshot)
+	if err := client.Get(ctx, kbclient.ObjectKey{Namespace: du.Spec.SourceNamespace, Name: du.Spec.VolumeSnapshot}, vs); err != nil {
+		return errors.Wrapf(err, "error getting volume snapshot %s/%s", du.Spec.SourceNamespace, du.Spec.VolumeSnapshot)
+	}
+	if vs.Status == nil || vs.Status.BoundVolumeSnapshotContentName == nil || !boolptr.IsSetToTrue(vs.Status.ReadyToUse) {
+		return errors.Errorf("volume snapshot %s/%s is not ready to use", vs.Namespace, vs.Name)
+	}
+
+	vsc := new(snapshotv1api.VolumeSnapshotContent)
+	if err := client.Get(ctx, kbclient.ObjectKey{Name: *vs.Status.BoundVolumeSnapshotContentName}, vsc); err != nil {
+		return errors.Wrapf(err, "error getting volume snapshot content %s", *vs.Status.BoundVolumeSnapshotContentName)
+	}
+	if vsc.Status == nil || vsc.Status.SnapshotHandle == nil {
+		return errors.Errorf("volume snapshot content %s has no snapshot handle", vsc.Name)
+	}
+
+	sourcePVC := new(corev1api.PersistentVolumeClaim)
+	if err := client.Get(ctx, kbclient.ObjectKey{Namespace: du.Spec.SourceNamespace, Name: du.Spec.SourcePVC}, sourcePVC); err != nil {
+		return errors.Wrapf(err, "error getting persistent volume claim %s/%s", du.Spec.SourceNamespace, du.Spec.SourcePVC)
+	}
+	if sourcePVC.Spec.VolumeMode != nil && *sourcePVC.Spec.VolumeMode == corev1api.PersistentVolumeBlock {
+		return errors.Errorf("persistent volume claim %s/%s is a block volume, which isn't supported", sourcePVC.Namespace, sourcePVC.Name)
+	}
+
+	size := sourcePVC.Spec.Resources.Requests[corev1api.ResourceStorage]
+	if vs.Status.RestoreSize != nil && vs.Status.RestoreSize.Cmp(size) > 0 {
+		size = *vs.Status.RestoreSize
+	}
+
+	labels := map[string]string{velerov1api.DataUploadLabel: du.Name}
+
+	// The snapshot is bound statically to a new VolumeSnapshotContent that retains it, so
+	// that cleaning up doesn't delete the snapshot the backup took.
+	content := &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: du.Name, Labels: labels},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy: snapshotv1api.VolumeSnapshotContentRetain,
+			Driver:         vsc.Spec.Driver,
+			Source: snapshotv1api.VolumeSnapshotContentSource{
+				SnapshotHandle: vsc.Status.SnapshotHandle,
+			},
+			VolumeSnapshotClassName: vsc.Spec.VolumeSnapshotClassName,
+			VolumeSnapshotRef: corev1api.ObjectReference{
+				Kind:       "VolumeSnapshot",
+				APIVersion: snapshotv1api.SchemeGroupVersion.String(),
+				Namespace:  du.Namespace,
+				Name:       du.Name,
+			},
+		},
+	}
+	if err := createIfNotExists(ctx, client, content); err != nil {
+		return errors.Wrapf(err, "error creating volume snapshot content %s", content.Name)
+	}
+
+	snapshot := &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Namespace: du.Namespace, Name: du.Name, Labels: labels},
+		Spec: snapshotv1api.VolumeSnapshotSpec{
+			Source: snapshotv1api.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &content.Name,
+			},
+			VolumeSnapshotClassName: vsc.Spec.VolumeSnapshotClassName,
+		},
+	}
+	if err := createIfNotExists(ctx, client, snapshot); err != nil {
+		return errors.Wrapf(err, "error creating volume snapshot %s/%s", snapshot.Namespace, snapshot.Name)
+	}
+
+	apiGroup := snapshotv1api.GroupName
+	pvc := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: du.Namespace, Name: du.Name, Labels: labels},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteOnce},
+			StorageClassName: sourcePVC.Spec.StorageClassName,
+			Resources: corev1api.ResourceRequirements{
+				Requests: corev1api.ResourceList{corev1api.ResourceStorage: size},
+			},
+			DataSource: &corev1api.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VolumeSnapshot",
+				Name:     snapshot.Name,
+			},
+		},
+	}
+	if err := createIfNotExists(ctx, client, pvc); err != nil {
+		return errors.Wrapf(err, "error creating persistent volume claim %s/%s", pvc.Namespace, pvc.Name)
+	}
+
+	if err := createIfNotExists(ctx, client, newDataMoverPod(du.Namespace, du.Name, pvc.Name, image, labels)); err != nil {
+		return errors.Wrapf(err, "error creating pod %s/%s", du.Namespace, du.Name)
+	}
+
+	log.WithField("dataUpload", du.Name).Infof("Exposed volume snapshot %s/%s", vs.Namespace, vs.Name)
+	return nil
+}
+
+// CleanUpSnapshot deletes the objects ExposeSnapshot created for a DataUpload. The
+// snapshot itself is retained. Errors are logged, since they don't affect the upload.
+func CleanUpSnapshot(ctx context.Context, client kbclient.Client, du *velerov1api.DataUpload, log logrus.FieldLogger) {
+	deleteIfExists(ctx, client, &corev1api.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: du.Namespace, Name: du.Name}}, log)
+	deleteIfExists(ctx, client, &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: du.Namespace, Name: du.Name}}, log)
+	deleteIfExists(ctx, client, &snapshotv1api.VolumeSnapshot{ObjectMeta: metav1.ObjectMeta{Namespace: du.Namespace, Name: du.Name}}, log)
+	deleteIfExists(ctx, client, &snapshotv1api.VolumeSnapshotContent{ObjectMeta: metav1.ObjectMeta{Name: du.Name}}, log)
+}
+
+// newDataMoverPod returns a pod that mounts a PersistentVolumeClaim at /data and does
+// nothing else, so that the node agent on its node can read and write the volume's data
+// through the kubelet's pod volumes directory.
+func newDataMoverPod(namespace, name, claimName, image string, labels map[string]string) *corev1api.Pod {
+	return &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec: corev1api.PodSpec{
+			Containers: []corev1api.Container{{
+				Name:    DataMoverVolume,
+				Image:   image,
+				Command: []string{"/velero", "restic", "pause"},
+				VolumeMounts: []corev1api.VolumeMount{{
+					Name:      DataMoverVolume,
+					MountPath: "/" + DataMoverVolume,
+				}},
+				Resources: corev1api.ResourceRequirements{
+					Requests: corev1api.ResourceList{
+						corev1api.ResourceCPU:    resource.MustParse("10m"),
+						corev1api.ResourceMemory: resource.MustParse("16Mi"),
+					},
+				},
+			}},
+			Volumes: []corev1api.Volume{{
+				Name: DataMoverVolume,
+				VolumeSource: corev1api.VolumeSource{
+					PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+				},
+			}},
+			RestartPolicy:                corev1api.RestartPolicyNever,
+			AutomountServiceAccountToken: boolptr.False(),
+		},
+	}
+}
+
+func createIfNotExists(ctx context.Context, client kbclient.Client, obj kbclient.Object) error {
+	if err := client.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func deleteIfExists(ctx context.Context, client kbclient.Client, obj kbclient.Object, log logrus.FieldLogger) {
+	if err := client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		log.WithError(err).Warnf("Unable to delete %T %s", obj, obj.GetName())
+	}
+}
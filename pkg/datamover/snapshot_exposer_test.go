@@ -0,0 +1,176 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamover
+
+import (
+	"context"
+	"testing"
+
+	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func newDataUpload() *velerov1api.DataUpload {
+	return &velerov1api.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "backup-1-abcde"},
+		Spec: velerov1api.DataUploadSpec{
+			VolumeSnapshot:        "snapshot-1",
+			SourcePVC:             "pvc-1",
+			SourceNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+		},
+	}
+}
+
+func newVolumeSnapshot(ready bool) *snapshotv1api.VolumeSnapshot {
+	contentName := "content-1"
+	restoreSize := resource.MustParse("2Gi")
+	return &snapshotv1api.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "snapshot-1"},
+		Status: &snapshotv1api.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &contentName,
+			ReadyToUse:                     &ready,
+			RestoreSize:                    &restoreSize,
+		},
+	}
+}
+
+func newVolumeSnapshotContent() *snapshotv1api.VolumeSnapshotContent {
+	handle := "snap-0123"
+	class := "csi-class"
+	return &snapshotv1api.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: "content-1"},
+		Spec: snapshotv1api.VolumeSnapshotContentSpec{
+			DeletionPolicy:          snapshotv1api.VolumeSnapshotContentDelete,
+			Driver:                  "csi.example.com",
+			VolumeSnapshotClassName: &class,
+		},
+		Status: &snapshotv1api.VolumeSnapshotContentStatus{SnapshotHandle: &handle},
+	}
+}
+
+func newSourcePVC(volumeMode corev1api.PersistentVolumeMode) *corev1api.PersistentVolumeClaim {
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("csi-sc").Result()
+	pvc.Spec.VolumeMode = &volumeMode
+	pvc.Spec.Resources.Requests = corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("1Gi")}
+	return pvc
+}
+
+func TestExposeSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		expectedErr string
+	}{
+		{
+			name:        "volume snapshot not found",
+			objects:     []runtime.Object{newVolumeSnapshotContent(), newSourcePVC(corev1api.PersistentVolumeFilesystem)},
+			expectedErr: "error getting volume snapshot ns-1/snapshot-1",
+		},
+		{
+			name:        "volume snapshot not ready",
+			objects:     []runtime.Object{newVolumeSnapshot(false), newVolumeSnapshotContent(), newSourcePVC(corev1api.PersistentVolumeFilesystem)},
+			expectedErr: "volume snapshot ns-1/snapshot-1 is not ready to use",
+		},
+		{
+			name:        "block volume",
+			objects:     []runtime.Object{newVolumeSnapshot(true), newVolumeSnapshotContent(), newSourcePVC(corev1api.PersistentVolumeBlock)},
+			expectedErr: "persistent volume claim ns-1/pvc-1 is a block volume, which isn't supported",
+		},
+		{
+			name:    "snapshot is exposed",
+			objects: []runtime.Object{newVolumeSnapshot(true), newVolumeSnapshotContent(), newSourcePVC(corev1api.PersistentVolumeFilesystem)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, test.objects...)
+			du := newDataUpload()
+
+			err := ExposeSnapshot(context.Background(), client, du, "velero/velero:main", velerotest.NewLogger())
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			content := new(snapshotv1api.VolumeSnapshotContent)
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Name: du.Name}, content))
+			assert.Equal(t, snapshotv1api.VolumeSnapshotContentRetain, content.Spec.DeletionPolicy)
+			assert.Equal(t, "csi.example.com", content.Spec.Driver)
+			assert.Equal(t, "snap-0123", *content.Spec.Source.SnapshotHandle)
+			assert.Equal(t, du.Namespace, content.Spec.VolumeSnapshotRef.Namespace)
+			assert.Equal(t, du.Name, content.Spec.VolumeSnapshotRef.Name)
+
+			snapshot := new(snapshotv1api.VolumeSnapshot)
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: du.Namespace, Name: du.Name}, snapshot))
+			assert.Equal(t, du.Name, *snapshot.Spec.Source.VolumeSnapshotContentName)
+
+			pvc := new(corev1api.PersistentVolumeClaim)
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: du.Namespace, Name: du.Name}, pvc))
+			assert.Equal(t, "csi-sc", *pvc.Spec.StorageClassName)
+			assert.Equal(t, "VolumeSnapshot", pvc.Spec.DataSource.Kind)
+			assert.Equal(t, du.Name, pvc.Spec.DataSource.Name)
+			size := pvc.Spec.Resources.Requests[corev1api.ResourceStorage]
+			assert.Equal(t, "2Gi", size.String())
+
+			pod := new(corev1api.Pod)
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: du.Namespace, Name: du.Name}, pod))
+			assert.Equal(t, du.Name, pod.Labels[velerov1api.DataUploadLabel])
+			assert.Equal(t, du.Name, pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+			assert.Equal(t, "velero/velero:main", pod.Spec.Containers[0].Image)
+			assert.False(t, boolptr.IsSetToTrue(pod.Spec.AutomountServiceAccountToken))
+
+			// exposing the snapshot again, for example after a node agent restart, succeeds
+			require.NoError(t, ExposeSnapshot(context.Background(), client, du, "velero/velero:main", velerotest.NewLogger()))
+		})
+	}
+}
+
+func TestCleanUpSnapshot(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t, newVolumeSnapshot(true), newVolumeSnapshotContent(), newSourcePVC(corev1api.PersistentVolumeFilesystem))
+	du := newDataUpload()
+	require.NoError(t, ExposeSnapshot(context.Background(), client, du, "velero/velero:main", velerotest.NewLogger()))
+
+	CleanUpSnapshot(context.Background(), client, du, velerotest.NewLogger())
+
+	key := kbclient.ObjectKey{Namespace: du.Namespace, Name: du.Name}
+	assert.True(t, apierrors.IsNotFound(client.Get(context.Background(), key, new(corev1api.Pod))))
+	assert.True(t, apierrors.IsNotFound(client.Get(context.Background(), key, new(corev1api.PersistentVolumeClaim))))
+	assert.True(t, apierrors.IsNotFound(client.Get(context.Background(), key, new(snapshotv1api.VolumeSnapshot))))
+	assert.True(t, apierrors.IsNotFound(client.Get(context.Background(), kbclient.ObjectKey{Name: du.Name}, new(snapshotv1api.VolumeSnapshotContent))))
+
+	// the snapshot the backup took is retained
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Name: "content-1"}, new(snapshotv1api.VolumeSnapshotContent)))
+
+	// cleaning up again is a no-op
+	CleanUpSnapshot(context.Background(), client, du, velerotest.NewLogger())
+}
@@ -0,0 +1,173 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamover
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// DataMoverVolume is the name of the volume of the pods that expose snapshots and
+// volumes to the node agent.
+const DataMoverVolume = "data"
+
+// rebindTimeout is how long RebindVolume waits for the restored PersistentVolumeClaim
+// to bind to the volume the data was downloaded into.
+var rebindTimeout = 5 * time.Minute
+
+// ExposeVolume provisions a volume for the PersistentVolumeClaim a DataDownload restores
+// data for: a PersistentVolumeClaim like it is created in the DataDownload's namespace and
+// mounted by a pod, so that the node agent can download the data into it. The objects are
+// named after the DataDownload, and ExposeVolume can be called again if it fails part way.
+func ExposeVolume(ctx context.Context, client kbclient.Client, dd *velerov1api.DataDownload, image string, log logrus.FieldLogger) error {
+	targetPVC := new(corev1api.PersistentVolumeClaim)
+	if err := client.Get(ctx, kbclient.ObjectKey{Namespace: dd.Spec.TargetNamespace, Name: dd.Spec.TargetPVC}, targetPVC); err != nil {
+		return errors.Wrapf(err, "error getting persistent volume claim %s/%s", dd.Spec.TargetNamespace, dd.Spec.TargetPVC)
+	}
+	if targetPVC.Spec.VolumeMode != nil && *targetPVC.Spec.VolumeMode == corev1api.PersistentVolumeBlock {
+		return errors.Errorf("persistent volume claim %s/%s is a block volume, which isn't supported", targetPVC.Namespace, targetPVC.Name)
+	}
+
+	labels := map[string]string{velerov1api.DataDownloadLabel: dd.Name}
+
+	pvc := &corev1api.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: dd.Namespace, Name: dd.Name, Labels: labels},
+		Spec: corev1api.PersistentVolumeClaimSpec{
+			AccessModes:      targetPVC.Spec.AccessModes,
+			StorageClassName: targetPVC.Spec.StorageClassName,
+			Resources:        targetPVC.Spec.Resources,
+		},
+	}
+	if err := createIfNotExists(ctx, client, pvc); err != nil {
+		return errors.Wrapf(err, "error creating persistent volume claim %s/%s", pvc.Namespace, pvc.Name)
+	}
+
+	if err := createIfNotExists(ctx, client, newDataMoverPod(dd.Namespace, dd.Name, pvc.Name, image, labels)); err != nil {
+		return errors.Wrapf(err, "error creating pod %s/%s", dd.Namespace, dd.Name)
+	}
+
+	log.WithField("dataDownload", dd.Name).Infof("Exposed a volume for persistent volume claim %s/%s", targetPVC.Namespace, targetPVC.Name)
+	return nil
+}
+
+// RebindVolume hands the volume ExposeVolume provisioned for a DataDownload over to the
+// restored PersistentVolumeClaim: the volume is retained while the pod and claim that
+// exposed it are deleted, then it's pre-bound and labeled to match the restored claim's
+// selector. Its reclaim policy is reset once the restored claim is bound to it.
+func RebindVolume(ctx context.Context, client kbclient.Client, dd *velerov1api.DataDownload, log logrus.FieldLogger) error {
+	targetPVC := new(corev1api.PersistentVolumeClaim)
+	if err := client.Get(ctx, kbclient.ObjectKey{Namespace: dd.Spec.TargetNamespace, Name: dd.Spec.TargetPVC}, targetPVC); err != nil {
+		return errors.Wrapf(err, "error getting persistent volume claim %s/%s", dd.Spec.TargetNamespace, dd.Spec.TargetPVC)
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := client.Get(ctx, kbclient.ObjectKey{Namespace: dd.Namespace, Name: dd.Name}, pvc); err != nil {
+		return errors.Wrapf(err, "error getting persistent volume claim %s/%s", dd.Namespace, dd.Name)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return errors.Errorf("persistent volume claim %s/%s isn't bound", pvc.Namespace, pvc.Name)
+	}
+
+	pv := new(corev1api.PersistentVolume)
+	if err := client.Get(ctx, kbclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+		return errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+	}
+	reclaimPolicy := pv.Spec.PersistentVolumeReclaimPolicy
+
+	original := pv.DeepCopy()
+	pv.Spec.PersistentVolumeReclaimPolicy = corev1api.PersistentVolumeReclaimRetain
+	if err := client.Patch(ctx, pv, kbclient.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error retaining persistent volume %s", pv.Name)
+	}
+
+	// The volume can only be bound to the restored claim once the claim that exposed it
+	// is gone.
+	CleanUpVolume(ctx, client, dd, log)
+	err := wait.PollImmediate(time.Second, rebindTimeout, func() (bool, error) {
+		err := client.Get(ctx, kbclient.ObjectKey{Namespace: pvc.Namespace, Name: pvc.Name}, pvc)
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error waiting for persistent volume claim %s/%s to be deleted", pvc.Namespace, pvc.Name)
+	}
+
+	original = pv.DeepCopy()
+	pv.Spec.ClaimRef = &corev1api.ObjectReference{
+		Kind:       "PersistentVolumeClaim",
+		APIVersion: "v1",
+		Namespace:  targetPVC.Namespace,
+		Name:       targetPVC.Name,
+	}
+	if pv.Labels == nil {
+		pv.Labels = make(map[string]string)
+	}
+	if targetPVC.Spec.Selector != nil {
+		for key, value := range targetPVC.Spec.Selector.MatchLabels {
+			pv.Labels[key] = value
+		}
+	}
+	if err := client.Patch(ctx, pv, kbclient.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error binding persistent volume %s to persistent volume claim %s/%s", pv.Name, targetPVC.Namespace, targetPVC.Name)
+	}
+
+	err = wait.PollImmediate(time.Second, rebindTimeout, func() (bool, error) {
+		if err := client.Get(ctx, kbclient.ObjectKey{Namespace: targetPVC.Namespace, Name: targetPVC.Name}, targetPVC); err != nil {
+			return false, err
+		}
+		return targetPVC.Status.Phase == corev1api.ClaimBound && targetPVC.Spec.VolumeName == pv.Name, nil
+	})
+	if err != nil {
+		// The volume keeps the Retain reclaim policy, so that it isn't deleted before the
+		// claim binds to it.
+		log.WithError(err).Warnf("Persistent volume claim %s/%s didn't bind to persistent volume %s, leaving its reclaim policy Retain", targetPVC.Namespace, targetPVC.Name, pv.Name)
+		return nil
+	}
+
+	if reclaimPolicy != corev1api.PersistentVolumeReclaimRetain {
+		if err := client.Get(ctx, kbclient.ObjectKey{Name: pv.Name}, pv); err != nil {
+			return errors.Wrapf(err, "error getting persistent volume %s", pv.Name)
+		}
+		original = pv.DeepCopy()
+		pv.Spec.PersistentVolumeReclaimPolicy = reclaimPolicy
+		if err := client.Patch(ctx, pv, kbclient.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "error resetting the reclaim policy of persistent volume %s", pv.Name)
+		}
+	}
+
+	log.WithField("dataDownload", dd.Name).Infof("Bound persistent volume %s to persistent volume claim %s/%s", pv.Name, targetPVC.Namespace, targetPVC.Name)
+	return nil
+}
+
+// CleanUpVolume deletes the objects ExposeVolume created for a DataDownload. Errors are
+// logged, since they don't affect the download.
+func CleanUpVolume(ctx context.Context, client kbclient.Client, dd *velerov1api.DataDownload, log logrus.FieldLogger) {
+	deleteIfExists(ctx, client, &corev1api.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: dd.Namespace, Name: dd.Name}}, log)
+	deleteIfExists(ctx, client, &corev1api.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: dd.Namespace, Name: dd.Name}}, log)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamover
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newDataDownload() *velerov1api.DataDownload {
+	return &velerov1api.DataDownload{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "velero", Name: "restore-1-abcde"},
+		Spec: velerov1api.DataDownloadSpec{
+			TargetPVC:             "pvc-1",
+			TargetNamespace:       "ns-1",
+			SnapshotID:            "k0123",
+			SourceNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+		},
+	}
+}
+
+// newTargetPVC returns a restored PersistentVolumeClaim waiting for the volume of a DataDownload.
+func newTargetPVC() *corev1api.PersistentVolumeClaim {
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").StorageClass("csi-sc").Result()
+	pvc.Spec.AccessModes = []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteMany}
+	pvc.Spec.Resources.Requests = corev1api.ResourceList{corev1api.ResourceStorage: resource.MustParse("1Gi")}
+	pvc.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{velerov1api.DynamicPVRestoreLabel: "restore-1-backup-1-abcde"}}
+	return pvc
+}
+
+func TestExposeVolume(t *testing.T) {
+	client := velerotest.NewFakeControllerRuntimeClient(t, newTargetPVC())
+	dd := newDataDownload()
+
+	require.NoError(t, ExposeVolume(context.Background(), client, dd, "velero/velero:main", velerotest.NewLogger()))
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: dd.Namespace, Name: dd.Name}, pvc))
+	assert.Equal(t, []corev1api.PersistentVolumeAccessMode{corev1api.ReadWriteMany}, pvc.Spec.AccessModes)
+	assert.Equal(t, "csi-sc", *pvc.Spec.StorageClassName)
+	assert.Nil(t, pvc.Spec.Selector)
+
+	pod := new(corev1api.Pod)
+	require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Namespace: dd.Namespace, Name: dd.Name}, pod))
+	assert.Equal(t, dd.Name, pod.Labels[velerov1api.DataDownloadLabel])
+	assert.Equal(t, dd.Name, pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+
+	// exposing the volume again, for example after a node agent restart, succeeds
+	require.NoError(t, ExposeVolume(context.Background(), client, dd, "velero/velero:main", velerotest.NewLogger()))
+}
+
+func TestRebindVolume(t *testing.T) {
+	defer func(timeout time.Duration) { rebindTimeout = timeout }(rebindTimeout)
+	rebindTimeout = time.Second
+
+	tests := []struct {
+		name                  string
+		targetBound           bool
+		expectedReclaimPolicy corev1api.PersistentVolumeReclaimPolicy
+	}{
+		{
+			name:                  "the reclaim policy is reset once the restored claim is bound",
+			targetBound:           true,
+			expectedReclaimPolicy: corev1api.PersistentVolumeReclaimDelete,
+		},
+		{
+			name:                  "the volume is retained if the restored claim doesn't bind",
+			expectedReclaimPolicy: corev1api.PersistentVolumeReclaimRetain,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dd := newDataDownload()
+			targetPVC := newTargetPVC()
+			if test.targetBound {
+				targetPVC.Spec.VolumeName = "pv-1"
+				targetPVC.Status.Phase = corev1api.ClaimBound
+			}
+			pvc := builder.ForPersistentVolumeClaim(dd.Namespace, dd.Name).VolumeName("pv-1").Result()
+			pv := builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef(dd.Namespace, dd.Name).Result()
+			client := velerotest.NewFakeControllerRuntimeClient(t, targetPVC, pvc, pv)
+
+			require.NoError(t, RebindVolume(context.Background(), client, dd, velerotest.NewLogger()))
+
+			assert.True(t, apierrors.IsNotFound(client.Get(context.Background(), kbclient.ObjectKey{Namespace: dd.Namespace, Name: dd.Name}, new(corev1api.PersistentVolumeClaim))))
+
+			require.NoError(t, client.Get(context.Background(), kbclient.ObjectKey{Name: "pv-1"}, pv))
+			assert.Equal(t, "ns-1", pv.Spec.ClaimRef.Namespace)
+			assert.Equal(t, "pvc-1", pv.Spec.ClaimRef.Name)
+			assert.Equal(t, "restore-1-backup-1-abcde", pv.Labels[velerov1api.DynamicPVRestoreLabel])
+			assert.Equal(t, test.expectedReclaimPolicy, pv.Spec.PersistentVolumeReclaimPolicy)
+		})
+	}
+}
@@ -29,10 +29,15 @@ var (
 	PersistentVolumeClaims    = schema.GroupResource{Group: "", Resource: "persistentvolumeclaims"}
 	PersistentVolumes         = schema.GroupResource{Group: "", Resource: "persistentvolumes"}
 	Pods                      = schema.GroupResource{Group: "", Resource: "pods"}
+	Services                  = schema.GroupResource{Group: "", Resource: "services"}
 	ServiceAccounts           = schema.GroupResource{Group: "", Resource: "serviceaccounts"}
 	Secrets                   = schema.GroupResource{Group: "", Resource: "secrets"}
 	VolumeSnapshotClasses     = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotclasses"}
 	VolumeSnapshots           = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshots"}
 	VolumeSnapshotContents    = schema.GroupResource{Group: "snapshot.storage.k8s.io", Resource: "volumesnapshotcontents"}
 	PriorityClasses           = schema.GroupResource{Group: "scheduling.k8s.io", Resource: "priorityclasses"}
+	IngressClasses            = schema.GroupResource{Group: "networking.k8s.io", Resource: "ingressclasses"}
+	RoleBindings              = schema.GroupResource{Group: "rbac.authorization.k8s.io", Resource: "rolebindings"}
+	RuntimeClasses            = schema.GroupResource{Group: "node.k8s.io", Resource: "runtimeclasses"}
+	StorageClasses            = schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}
 )
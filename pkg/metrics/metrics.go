@@ -57,6 +57,8 @@ const (
 	csiSnapshotAttemptTotal       = "csi_snapshot_attempt_total"
 	csiSnapshotSuccessTotal       = "csi_snapshot_success_total"
 	csiSnapshotFailureTotal       = "csi_snapshot_failure_total"
+	bslUsedBytesGauge             = "backup_storage_location_used_bytes"
+	bslQuotaBytesGauge            = "backup_storage_location_quota_bytes"
 
 	// Restic metrics
 	podVolumeBackupEnqueueTotal        = "pod_volume_backup_enqueue_count"
@@ -70,6 +72,12 @@ const (
 	pvbNameLabel         = "pod_volume_backup"
 	scheduleLabel        = "schedule"
 	backupNameLabel      = "backupName"
+	bslLabel             = "backupLocation"
+	usageTypeLabel       = "type"
+
+	// Usage types
+	usageTypeBackups      = "backups"
+	usageTypeRepositories = "repositories"
 )
 
 // NewServerMetrics returns new ServerMetrics
@@ -293,6 +301,22 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel, backupNameLabel},
 			),
+			bslUsedBytesGauge: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslUsedBytesGauge,
+					Help:      "Size, in bytes, of the data stored in a backup storage location, by type",
+				},
+				[]string{bslLabel, usageTypeLabel},
+			),
+			bslQuotaBytesGauge: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricNamespace,
+					Name:      bslQuotaBytesGauge,
+					Help:      "Quota, in bytes, of a backup storage location",
+				},
+				[]string{bslLabel},
+			),
 		},
 	}
 }
@@ -648,3 +672,40 @@ func (m *ServerMetrics) RegisterCSISnapshotFailures(backupSchedule, backupName s
 		c.WithLabelValues(backupSchedule, backupName).Add(float64(csiSnapshotsFailed))
 	}
 }
+
+// SetBackupStorageLocationUsedBytes records the size, in bytes, of the backups and the backup
+// repositories stored in a backup storage location. The size of the backups is removed if
+// it's unknown.
+func (m *ServerMetrics) SetBackupStorageLocationUsedBytes(location string, backupBytes *int64, repositoryBytes int64) {
+	if g, ok := m.metrics[bslUsedBytesGauge].(*prometheus.GaugeVec); ok {
+		if backupBytes != nil {
+			g.WithLabelValues(location, usageTypeBackups).Set(float64(*backupBytes))
+		} else {
+			g.DeleteLabelValues(location, usageTypeBackups)
+		}
+		g.WithLabelValues(location, usageTypeRepositories).Set(float64(repositoryBytes))
+	}
+}
+
+// SetBackupStorageLocationQuotaBytes records the quota, in bytes, of a backup storage location.
+// A negative quota removes it.
+func (m *ServerMetrics) SetBackupStorageLocationQuotaBytes(location string, quota int64) {
+	if g, ok := m.metrics[bslQuotaBytesGauge].(*prometheus.GaugeVec); ok {
+		if quota < 0 {
+			g.DeleteLabelValues(location)
+			return
+		}
+		g.WithLabelValues(location).Set(float64(quota))
+	}
+}
+
+// RemoveBackupStorageLocation removes the metrics of a deleted backup storage location.
+func (m *ServerMetrics) RemoveBackupStorageLocation(location string) {
+	if g, ok := m.metrics[bslUsedBytesGauge].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(location, usageTypeBackups)
+		g.DeleteLabelValues(location, usageTypeRepositories)
+	}
+	if g, ok := m.metrics[bslQuotaBytesGauge].(*prometheus.GaugeVec); ok {
+		g.DeleteLabelValues(location)
+	}
+}
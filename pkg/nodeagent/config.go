@@ -0,0 +1,166 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// configKey is the key in the node agent ConfigMap whose value holds the Configs.
+	configKey = "config"
+
+	// DefaultLoadConcurrency is the number of pod volume backups and restores that
+	// run at once on a node when no limit is configured.
+	DefaultLoadConcurrency = 1
+)
+
+// Configs is the node agent configuration read from a ConfigMap in the Velero namespace.
+type Configs struct {
+	// LoadConcurrency limits how many pod volume backups and restores run at once on a node.
+	LoadConcurrency *LoadConcurrency `json:"loadConcurrency,omitempty"`
+}
+
+// LoadConcurrency is the per-node concurrency configuration.
+type LoadConcurrency struct {
+	// GlobalConfig is the limit for nodes that no PerNodeConfig rule selects.
+	GlobalConfig int `json:"globalConfig,omitempty"`
+
+	// PerNodeConfig overrides GlobalConfig for the nodes each rule selects. If more than
+	// one rule selects a node, the smallest number applies.
+	PerNodeConfig []RuledConfigs `json:"perNodeConfig,omitempty"`
+}
+
+// RuledConfigs is a concurrency limit for the nodes matching a label selector.
+type RuledConfigs struct {
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+	Number       int                  `json:"number"`
+}
+
+// GetConfigs reads the Configs from the ConfigMap name in namespace. It returns nil if
+// the ConfigMap does not exist.
+func GetConfigs(ctx context.Context, namespace, name string, client corev1client.ConfigMapsGetter) (*Configs, error) {
+	cm, err := client.ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting node agent config %s/%s", namespace, name)
+	}
+
+	data, ok := cm.Data[configKey]
+	if !ok {
+		return nil, errors.Errorf("node agent config %s/%s has no %q key", namespace, name, configKey)
+	}
+
+	configs := new(Configs)
+	if err := yaml.UnmarshalStrict([]byte(data), configs); err != nil {
+		return nil, errors.Wrapf(err, "error parsing node agent config %s/%s", namespace, name)
+	}
+
+	return configs, nil
+}
+
+// GetLoadConcurrency returns the number of pod volume backups and restores allowed to run
+// at once on node according to configs.
+func GetLoadConcurrency(configs *Configs, node *corev1api.Node, log logrus.FieldLogger) int {
+	if configs == nil || configs.LoadConcurrency == nil {
+		return DefaultLoadConcurrency
+	}
+
+	concurrency := configs.LoadConcurrency.GlobalConfig
+	if concurrency <= 0 {
+		concurrency = DefaultLoadConcurrency
+	}
+
+	matched := false
+	for i, rule := range configs.LoadConcurrency.PerNodeConfig {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.NodeSelector)
+		if err != nil {
+			log.WithError(err).Warnf("Ignoring per-node concurrency rule %d with invalid node selector", i)
+			continue
+		}
+		if rule.Number <= 0 {
+			log.Warnf("Ignoring per-node concurrency rule %d with non-positive number %d", i, rule.Number)
+			continue
+		}
+		if !selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+
+		if !matched || rule.Number < concurrency {
+			concurrency = rule.Number
+		}
+		matched = true
+	}
+
+	return concurrency
+}
+
+// LoadLimiter bounds the number of pod volume backups and restores running at once on a
+// node. A nil LoadLimiter does not limit anything.
+type LoadLimiter struct {
+	slots chan struct{}
+}
+
+// NewLoadLimiter returns a LoadLimiter that allows limit operations at once.
+func NewLoadLimiter(limit int) *LoadLimiter {
+	if limit < 1 {
+		limit = 1
+	}
+	return &LoadLimiter{slots: make(chan struct{}, limit)}
+}
+
+// TryAcquire takes a slot without blocking, returning false if none is free. Each
+// successful call must be paired with a call to Release.
+func (l *LoadLimiter) TryAcquire() bool {
+	if l == nil {
+		return true
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release frees a slot taken by TryAcquire.
+func (l *LoadLimiter) Release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
+
+// Limit returns the number of operations l allows at once, or 0 if l is nil.
+func (l *LoadLimiter) Limit() int {
+	if l == nil {
+		return 0
+	}
+	return cap(l.slots)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetConfigs(t *testing.T) {
+	tests := []struct {
+		name        string
+		configMap   string
+		expected    *Configs
+		expectedErr string
+	}{
+		{
+			name:     "missing ConfigMap returns nil",
+			expected: nil,
+		},
+		{
+			name: "valid config",
+			configMap: `
+loadConcurrency:
+  globalConfig: 2
+  perNodeConfig:
+  - nodeSelector:
+      matchLabels:
+        size: large
+    number: 4
+`,
+			expected: &Configs{
+				LoadConcurrency: &LoadConcurrency{
+					GlobalConfig: 2,
+					PerNodeConfig: []RuledConfigs{
+						{
+							NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"size": "large"}},
+							Number:       4,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:        "unknown field",
+			configMap:   "loadConcurrency:\n  global: 2\n",
+			expectedErr: `error parsing node agent config velero/node-agent-config: error unmarshaling JSON: while decoding JSON: json: unknown field "global"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			if tc.configMap != "" {
+				cm := builder.ForConfigMap("velero", "node-agent-config").Data(configKey, tc.configMap).Result()
+				_, err := client.CoreV1().ConfigMaps("velero").Create(context.TODO(), cm, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			configs, err := GetConfigs(context.TODO(), "velero", "node-agent-config", client.CoreV1())
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, configs)
+		})
+	}
+}
+
+func TestGetLoadConcurrency(t *testing.T) {
+	large := metav1.LabelSelector{MatchLabels: map[string]string{"size": "large"}}
+	zoneA := metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}}
+
+	tests := []struct {
+		name     string
+		configs  *Configs
+		labels   []string
+		expected int
+	}{
+		{
+			name:     "no config uses the default",
+			expected: DefaultLoadConcurrency,
+		},
+		{
+			name:     "no load concurrency uses the default",
+			configs:  &Configs{},
+			expected: DefaultLoadConcurrency,
+		},
+		{
+			name:     "global config applies to unselected nodes",
+			configs:  &Configs{LoadConcurrency: &LoadConcurrency{GlobalConfig: 3, PerNodeConfig: []RuledConfigs{{NodeSelector: large, Number: 6}}}},
+			labels:   []string{"size", "small"},
+			expected: 3,
+		},
+		{
+			name:     "per-node config overrides global config",
+			configs:  &Configs{LoadConcurrency: &LoadConcurrency{GlobalConfig: 3, PerNodeConfig: []RuledConfigs{{NodeSelector: large, Number: 6}}}},
+			labels:   []string{"size", "large"},
+			expected: 6,
+		},
+		{
+			name:     "smallest matching per-node config wins",
+			configs:  &Configs{LoadConcurrency: &LoadConcurrency{PerNodeConfig: []RuledConfigs{{NodeSelector: large, Number: 6}, {NodeSelector: zoneA, Number: 2}}}},
+			labels:   []string{"size", "large", "zone", "a"},
+			expected: 2,
+		},
+		{
+			name:     "non-positive numbers are ignored",
+			configs:  &Configs{LoadConcurrency: &LoadConcurrency{GlobalConfig: -1, PerNodeConfig: []RuledConfigs{{NodeSelector: large, Number: 0}}}},
+			labels:   []string{"size", "large"},
+			expected: DefaultLoadConcurrency,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node := builder.ForNode("node-1").ObjectMeta(builder.WithLabels(tc.labels...)).Result()
+			assert.Equal(t, tc.expected, GetLoadConcurrency(tc.configs, node, velerotest.NewLogger()))
+		})
+	}
+}
+
+func TestLoadLimiter(t *testing.T) {
+	limiter := NewLoadLimiter(2)
+	assert.Equal(t, 2, limiter.Limit())
+
+	assert.True(t, limiter.TryAcquire())
+	assert.True(t, limiter.TryAcquire())
+	assert.False(t, limiter.TryAcquire())
+
+	limiter.Release()
+	assert.True(t, limiter.TryAcquire())
+
+	var unlimited *LoadLimiter
+	assert.True(t, unlimited.TryAcquire())
+	assert.Equal(t, 0, unlimited.Limit())
+	unlimited.Release()
+}
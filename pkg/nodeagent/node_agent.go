@@ -73,3 +73,16 @@ func IsRunningInNode(ctx context.Context, namespace string, nodeName string, pod
 
 	return errors.Errorf("daemonset pod not found in running state in node %s", nodeName)
 }
+
+// GetImage returns the image of the node agent daemonset's container. The pods that expose
+// volumes to the node agent for data movement run the same image.
+func GetImage(ctx context.Context, kubeClient kubernetes.Interface, namespace string) (string, error) {
+	ds, err := kubeClient.AppsV1().DaemonSets(namespace).Get(ctx, daemonSet, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting daemonset %s", daemonSet)
+	}
+	if len(ds.Spec.Template.Spec.Containers) == 0 {
+		return "", errors.Errorf("daemonset %s has no containers", daemonSet)
+	}
+	return ds.Spec.Template.Spec.Containers[0].Image, nil
+}
@@ -23,6 +23,8 @@ import (
 	"io/ioutil"
 	"strings"
 	"time"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 type BucketData map[string][]byte
@@ -33,15 +35,19 @@ type BucketData map[string][]byte
 type inMemoryObjectStore struct {
 	Data   map[string]BucketData
 	Config map[string]string
+	// Retentions contains the retention of the locked objects by bucket and key.
+	Retentions map[string]map[string]velero.ObjectRetention
 }
 
 func newInMemoryObjectStore(buckets ...string) *inMemoryObjectStore {
 	o := &inMemoryObjectStore{
-		Data: make(map[string]BucketData),
+		Data:       make(map[string]BucketData),
+		Retentions: make(map[string]map[string]velero.ObjectRetention),
 	}
 
 	for _, bucket := range buckets {
 		o.Data[bucket] = make(map[string][]byte)
+		o.Retentions[bucket] = make(map[string]velero.ObjectRetention)
 	}
 
 	return o
@@ -142,12 +148,32 @@ func (o *inMemoryObjectStore) ListObjects(bucket, prefix string) ([]string, erro
 	return objs, nil
 }
 
+func (o *inMemoryObjectStore) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return nil, errors.New("bucket not found")
+	}
+
+	sizes := make(map[string]int64)
+	for key, obj := range bucketData {
+		if strings.HasPrefix(key, prefix) {
+			sizes[key] = int64(len(obj))
+		}
+	}
+
+	return sizes, nil
+}
+
 func (o *inMemoryObjectStore) DeleteObject(bucket, key string) error {
 	bucketData, ok := o.Data[bucket]
 	if !ok {
 		return errors.New("bucket not found")
 	}
 
+	if retention := o.Retentions[bucket][key]; retention.LegalHold || retention.RetainUntil.After(time.Now()) {
+		return errors.New("object is locked")
+	}
+
 	delete(bucketData, key)
 
 	return nil
@@ -167,6 +193,34 @@ func (o *inMemoryObjectStore) CreateSignedURL(bucket, key string, ttl time.Durat
 	return "a-url", nil
 }
 
+func (o *inMemoryObjectStore) PutObjectRetention(bucket, key string, retention velero.ObjectRetention) error {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return errors.New("bucket not found")
+	}
+
+	if _, ok := bucketData[key]; !ok {
+		return errors.New("key not found")
+	}
+
+	o.Retentions[bucket][key] = retention
+
+	return nil
+}
+
+func (o *inMemoryObjectStore) GetObjectRetention(bucket, key string) (velero.ObjectRetention, error) {
+	bucketData, ok := o.Data[bucket]
+	if !ok {
+		return velero.ObjectRetention{}, errors.New("bucket not found")
+	}
+
+	if _, ok := bucketData[key]; !ok {
+		return velero.ObjectRetention{}, errors.New("key not found")
+	}
+
+	return o.Retentions[bucket][key], nil
+}
+
 //
 // Test Helper Methods
 //
@@ -24,7 +24,9 @@ import (
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	archive "github.com/vmware-tanzu/velero/pkg/archive"
 	persistence "github.com/vmware-tanzu/velero/pkg/persistence"
+	velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	volume "github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -68,6 +70,20 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) DeleteBackupCheckpoint(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -82,6 +98,29 @@ func (_m *BackupStore) DeleteRestore(name string) error {
 	return r0
 }
 
+// GetBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	ret := _m.Called(name)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupContents provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	ret := _m.Called(name)
@@ -105,6 +144,52 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupIndex provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupIndex(name string) (*archive.Index, error) {
+	ret := _m.Called(name)
+
+	var r0 *archive.Index
+	if rf, ok := ret.Get(0).(func(string) *archive.Index); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*archive.Index)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupLock provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupLock(name string) (*velero.ObjectRetention, error) {
+	ret := _m.Called(name)
+
+	var r0 *velero.ObjectRetention
+	if rf, ok := ret.Get(0).(func(string) *velero.ObjectRetention); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*velero.ObjectRetention)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupMetadata provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	ret := _m.Called(name)
@@ -128,6 +213,52 @@ func (_m *BackupStore) GetBackupMetadata(name string) (*v1.Backup, error) {
 	return r0, r1
 }
 
+// GetBackupNamespaceContents provides a mock function with given fields: name, namespace
+func (_m *BackupStore) GetBackupNamespaceContents(name string, namespace string) (io.ReadCloser, error) {
+	ret := _m.Called(name, namespace)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string, string) io.ReadCloser); ok {
+		r0 = rf(name, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBackupObject provides a mock function with given fields: name, object
+func (_m *BackupStore) GetBackupObject(name string, object string) (io.ReadCloser, error) {
+	ret := _m.Called(name, object)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string, string) io.ReadCloser); ok {
+		r0 = rf(name, object)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, object)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupVolumeSnapshots provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	ret := _m.Called(name)
@@ -195,6 +326,52 @@ func (_m *BackupStore) GetPodVolumeBackups(name string) ([]*v1.PodVolumeBackup,
 	return r0, r1
 }
 
+// GetDataUploads provides a mock function with given fields: name
+func (_m *BackupStore) GetDataUploads(name string) ([]*v1.DataUpload, error) {
+	ret := _m.Called(name)
+
+	var r0 []*v1.DataUpload
+	if rf, ok := ret.Get(0).(func(string) []*v1.DataUpload); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1.DataUpload)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRestoreRollbackRecord provides a mock function with given fields: restore
+func (_m *BackupStore) GetRestoreRollbackRecord(restore string) (io.ReadCloser, error) {
+	ret := _m.Called(restore)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(restore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(restore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsValid provides a mock function with given fields:
 func (_m *BackupStore) IsValid() error {
 	ret := _m.Called()
@@ -232,6 +409,73 @@ func (_m *BackupStore) ListBackups() ([]string, error) {
 	return r0, r1
 }
 
+// ListBackupObjects provides a mock function with given fields: name
+func (_m *BackupStore) ListBackupObjects(name string) ([]string, error) {
+	ret := _m.Called(name)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockBackup provides a mock function with given fields: name, retention
+func (_m *BackupStore) LockBackup(name string, retention velero.ObjectRetention) error {
+	ret := _m.Called(name, retention)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, velero.ObjectRetention) error); ok {
+		r0 = rf(name, retention)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MeasureObjects provides a mock function with given fields:
+func (_m *BackupStore) MeasureObjects() (int, *int64, error) {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 *int64
+	if rf, ok := ret.Get(1).(func() *int64); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*int64)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // PutBackup provides a mock function with given fields: info
 func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	ret := _m.Called(info)
@@ -246,6 +490,62 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupCheckpoint provides a mock function with given fields: name, checkpoint
+func (_m *BackupStore) PutBackupCheckpoint(name string, checkpoint io.Reader) error {
+	ret := _m.Called(name, checkpoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupMetadata provides a mock function with given fields: name, metadata
+func (_m *BackupStore) PutBackupMetadata(name string, metadata io.Reader) error {
+	ret := _m.Called(name, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(name, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutBackupObject provides a mock function with given fields: name, object, body
+func (_m *BackupStore) PutBackupObject(name string, object string, body io.Reader) error {
+	ret := _m.Called(name, object, body)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) error); ok {
+		r0 = rf(name, object, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutPodVolumeBackups provides a mock function with given fields: name, podVolumeBackups
+func (_m *BackupStore) PutPodVolumeBackups(name string, podVolumeBackups []*v1.PodVolumeBackup) error {
+	ret := _m.Called(name, podVolumeBackups)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []*v1.PodVolumeBackup) error); ok {
+		r0 = rf(name, podVolumeBackups)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreLog provides a mock function with given fields: backup, restore, log
 func (_m *BackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
 	ret := _m.Called(backup, restore, log)
@@ -274,6 +574,20 @@ func (_m *BackupStore) PutRestoreResults(backup string, restore string, results
 	return r0
 }
 
+// PutRestoreRollbackRecord provides a mock function with given fields: restore, record
+func (_m *BackupStore) PutRestoreRollbackRecord(restore string, record io.Reader) error {
+	ret := _m.Called(restore, record)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 func (_m *BackupStore) GetCSIVolumeSnapshots(backup string) ([]*snapshotv1api.VolumeSnapshot, error) {
 	panic("Not implemented")
 	return nil, nil
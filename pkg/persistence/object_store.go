@@ -17,10 +17,11 @@ limitations under the License.
 package persistence
 
 import (
-	"compress/gzip"
+	"bytes"
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"path"
 	"strings"
 	"time"
 
@@ -32,8 +33,10 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/scheme"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -48,7 +51,17 @@ type BackupInfo struct {
 	BackupResourceList,
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents,
-	CSIVolumeSnapshotClasses io.Reader
+	CSIVolumeSnapshotClasses,
+	DataUploads,
+	Index io.Reader
+
+	// NamespaceContents are the archives of each namespace of a backup in the split
+	// archive format, keyed by namespace.
+	NamespaceContents map[string]io.Reader
+
+	// Compression is the algorithm Contents and NamespaceContents were compressed with,
+	// which sets the extension of their keys.
+	Compression velerov1api.CompressionAlgorithm
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -59,11 +72,23 @@ type BackupStore interface {
 	ListBackups() ([]string, error)
 
 	PutBackup(info BackupInfo) error
+	// PutBackupMetadata replaces the metadata of a backup.
+	PutBackupMetadata(name string, metadata io.Reader) error
 	GetBackupMetadata(name string) (*velerov1api.Backup, error)
 	GetItemSnapshots(name string) ([]*volume.ItemSnapshot, error)
 	GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error)
 	GetPodVolumeBackups(name string) ([]*velerov1api.PodVolumeBackup, error)
+	// PutPodVolumeBackups replaces the pod volume backups of a backup, e.g. after the
+	// repository they're in was migrated.
+	PutPodVolumeBackups(name string, podVolumeBackups []*velerov1api.PodVolumeBackup) error
+	// GetDataUploads returns the DataUploads that moved a backup's CSI snapshot data to
+	// the backup repository.
+	GetDataUploads(name string) ([]*velerov1api.DataUpload, error)
 	GetBackupContents(name string) (io.ReadCloser, error)
+	// GetBackupIndex returns the index of a backup in the split archive format, or nil if
+	// the backup is in the single tarball format.
+	GetBackupIndex(name string) (*archive.Index, error)
+	GetBackupNamespaceContents(name, namespace string) (io.ReadCloser, error)
 	GetCSIVolumeSnapshots(name string) ([]*snapshotv1api.VolumeSnapshot, error)
 	GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1api.VolumeSnapshotContent, error)
 	GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error)
@@ -73,11 +98,40 @@ type BackupStore interface {
 
 	DeleteBackup(name string) error
 
+	// ListBackupObjects returns the names of the objects of a backup, relative to its directory.
+	ListBackupObjects(name string) ([]string, error)
+	GetBackupObject(name, object string) (io.ReadCloser, error)
+	PutBackupObject(name, object string, body io.Reader) error
+
+	// LockBackup protects the objects of a backup from being deleted or overwritten according
+	// to retention. The metadata is locked last, so it can still be replaced if locking the
+	// other objects fails. It returns velero.ErrObjectLockNotSupported if the object store
+	// doesn't support object lock.
+	LockBackup(name string, retention velero.ObjectRetention) error
+	// GetBackupLock returns the protection of the objects of a backup, or nil if they aren't
+	// protected.
+	GetBackupLock(name string) (*velero.ObjectRetention, error)
+
+	// PutBackupCheckpoint saves the checkpoint of a running backup, replacing any earlier one.
+	PutBackupCheckpoint(name string, checkpoint io.Reader) error
+	// GetBackupCheckpoint returns the checkpoint of a backup, or nil if there isn't one.
+	GetBackupCheckpoint(name string) (io.ReadCloser, error)
+	DeleteBackupCheckpoint(name string) error
+
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
+	PutRestoreRollbackRecord(restore string, record io.Reader) error
+	// GetRestoreRollbackRecord returns the record of the changes a restore made to the
+	// cluster, or nil if there isn't one.
+	GetRestoreRollbackRecord(restore string) (io.ReadCloser, error)
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
+
+	// MeasureObjects returns the number of the backup, restore and backup checkpoint objects in
+	// the location, and their total size, which is nil if the object store can't list the sizes
+	// of objects.
+	MeasureObjects() (int, *int64, error)
 }
 
 // DownloadURLTTL is how long a download URL is valid for.
@@ -234,6 +288,40 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 	return output, nil
 }
 
+func (s *objectBackupStore) MeasureObjects() (int, *int64, error) {
+	lister, measurable := s.objectStore.(velero.ObjectSizeLister)
+
+	objects := 0
+	var bytes int64
+	for _, subdir := range []string{"backups", "restores", "checkpoints"} {
+		if measurable {
+			sizes, err := lister.ListObjectSizes(s.bucket, s.layout.subdirs[subdir])
+			if err == nil {
+				objects += len(sizes)
+				for _, size := range sizes {
+					bytes += size
+				}
+				continue
+			}
+			if !errors.Is(err, velero.ErrObjectSizesNotSupported) {
+				return 0, nil, errors.Wrapf(err, "error listing the sizes of %s objects", subdir)
+			}
+			measurable = false
+		}
+
+		keys, err := s.objectStore.ListObjects(s.bucket, s.layout.subdirs[subdir])
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "error listing %s objects", subdir)
+		}
+		objects += len(keys)
+	}
+
+	if !measurable {
+		return objects, nil, nil
+	}
+	return objects, &bytes, nil
+}
+
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
@@ -246,11 +334,23 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name, info.Compression), info.Contents); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
 
+	for namespace, contents := range info.NamespaceContents {
+		if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupNamespaceContentsKey(info.Name, namespace, info.Compression), contents); err != nil {
+			errs := []error{err}
+
+			// attempt to clean up the backup contents and metadata so the backup isn't seen as complete;
+			// namespace archives that were uploaded are removed along with the backup
+			errs = append(errs, s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name, info.Compression)))
+			errs = append(errs, s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name)))
+			return kerrors.NewAggregate(errs)
+		}
+	}
+
 	// Since the logic for all of these files is the exact same except for the name and the contents,
 	// use a map literal to iterate through them and write them to the bucket.
 	var backupObjs = map[string]io.Reader{
@@ -261,6 +361,8 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
 		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
 		s.layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
+		s.layout.getDataUploadsKey(info.Name):               info.DataUploads,
+		s.layout.getBackupIndexKey(info.Name):               info.Index,
 	}
 
 	for key, reader := range backupObjs {
@@ -268,7 +370,7 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 			errs := []error{err}
 
 			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
-			deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
+			deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name, info.Compression))
 			errs = append(errs, deleteErr)
 
 			deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
@@ -280,6 +382,10 @@ func (s *objectBackupStore) PutBackup(info BackupInfo) error {
 	return nil
 }
 
+func (s *objectBackupStore) PutBackupMetadata(name string, metadata io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupMetadataKey(name), metadata)
+}
+
 func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
 	metadataKey := s.layout.getBackupMetadataKey(name)
 
@@ -367,9 +473,9 @@ func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser,
 // decode extracts a .json.gz file reader into the object pointed to
 // by 'into'.
 func decode(jsongzReader io.Reader, into interface{}) error {
-	gzr, err := gzip.NewReader(jsongzReader)
+	gzr, err := compression.NewReader(jsongzReader)
 	if err != nil {
-		return errors.WithStack(err)
+		return err
 	}
 	defer gzr.Close()
 
@@ -456,8 +562,84 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 	return podVolumeBackups, nil
 }
 
+func (s *objectBackupStore) PutPodVolumeBackups(name string, podVolumeBackups []*velerov1api.PodVolumeBackup) error {
+	buf := new(bytes.Buffer)
+	w, err := compression.NewWriter(buf, nil)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(podVolumeBackups); err != nil {
+		return errors.Wrap(err, "error encoding pod volume backups")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "error closing compression writer for pod volume backups")
+	}
+
+	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getPodVolumeBackupsKey(name), buf)
+}
+
+func (s *objectBackupStore) GetDataUploads(name string) ([]*velerov1api.DataUpload, error) {
+	// backups that didn't move snapshot data don't have the datauploads file.
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getDataUploadsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var dataUploads []*velerov1api.DataUpload
+	if err := decode(res, &dataUploads); err != nil {
+		return nil, err
+	}
+
+	return dataUploads, nil
+}
+
+// backupCompression returns the algorithm the contents of a backup were compressed with, which
+// is recorded in its metadata.
+func (s *objectBackupStore) backupCompression(name string) (velerov1api.CompressionAlgorithm, error) {
+	backup, err := s.GetBackupMetadata(name)
+	if err != nil {
+		return "", errors.WithMessagef(err, "error getting metadata of backup %s", name)
+	}
+	return backup.Status.Compression, nil
+}
+
 func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
-	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
+	algorithm, err := s.backupCompression(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name, algorithm))
+}
+
+func (s *objectBackupStore) GetBackupIndex(name string) (*archive.Index, error) {
+	// a backup in the single tarball format doesn't have an index
+	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupIndexKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	index := new(archive.Index)
+	if err := decode(res, index); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func (s *objectBackupStore) GetBackupNamespaceContents(name, namespace string) (io.ReadCloser, error) {
+	algorithm, err := s.backupCompression(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.objectStore.GetObject(s.bucket, s.layout.getBackupNamespaceContentsKey(name, namespace, algorithm))
 }
 
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
@@ -483,6 +665,139 @@ func (s *objectBackupStore) DeleteBackup(name string) error {
 	return errors.WithStack(kerrors.NewAggregate(errs))
 }
 
+func (s *objectBackupStore) ListBackupObjects(name string) ([]string, error) {
+	dir := s.layout.getBackupDir(name)
+	keys, err := s.objectStore.ListObjects(s.bucket, dir)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	objects := make([]string, 0, len(keys))
+	for _, key := range keys {
+		objects = append(objects, strings.TrimPrefix(key, dir))
+	}
+	return objects, nil
+}
+
+func (s *objectBackupStore) GetBackupObject(name, object string) (io.ReadCloser, error) {
+	return s.objectStore.GetObject(s.bucket, path.Join(s.layout.getBackupDir(name), object))
+}
+
+func (s *objectBackupStore) PutBackupObject(name, object string, body io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, path.Join(s.layout.getBackupDir(name), object), body)
+}
+
+func (s *objectBackupStore) LockBackup(name string, retention velero.ObjectRetention) error {
+	locker, ok := s.objectStore.(velero.ObjectLocker)
+	if !ok {
+		return velero.ErrObjectLockNotSupported
+	}
+
+	keys, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	metadataKey := s.layout.getBackupMetadataKey(name)
+	var metadataFound bool
+	for _, key := range keys {
+		if key == metadataKey {
+			metadataFound = true
+			continue
+		}
+		if err := locker.PutObjectRetention(s.bucket, key, retention); err != nil {
+			if errors.Is(err, velero.ErrObjectLockNotSupported) {
+				return err
+			}
+			return errors.Wrapf(err, "error locking object %s", key)
+		}
+	}
+
+	if metadataFound {
+		if err := locker.PutObjectRetention(s.bucket, metadataKey, retention); err != nil {
+			return errors.Wrapf(err, "error locking object %s", metadataKey)
+		}
+	}
+	return nil
+}
+
+func (s *objectBackupStore) GetBackupLock(name string) (*velero.ObjectRetention, error) {
+	locker, ok := s.objectStore.(velero.ObjectLocker)
+	if !ok {
+		return nil, nil
+	}
+
+	// the metadata is locked along with the other objects of the backup
+	retention, err := locker.GetObjectRetention(s.bucket, s.layout.getBackupMetadataKey(name))
+	if err != nil {
+		if errors.Is(err, velero.ErrObjectLockNotSupported) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "error getting the retention of the backup metadata")
+	}
+	if !retention.LegalHold && retention.RetainUntil.IsZero() {
+		return nil, nil
+	}
+	return &retention, nil
+}
+
+// CopyBackup copies the objects of a backup from one backup store to another. The metadata
+// of the backup is copied last, so that the backup is only synced from the destination once
+// it's complete.
+func CopyBackup(name string, from, to BackupStore) error {
+	objects, err := from.ListBackupObjects(name)
+	if err != nil {
+		return errors.Wrap(err, "error listing backup objects")
+	}
+
+	var contents []string
+	for _, object := range objects {
+		if object != backupMetadataObject {
+			contents = append(contents, object)
+		}
+	}
+	if len(contents) == len(objects) {
+		return errors.Errorf("backup %s has no metadata", name)
+	}
+
+	for _, object := range append(contents, backupMetadataObject) {
+		if err := copyBackupObject(name, object, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyBackupObject(name, object string, from, to BackupStore) error {
+	body, err := from.GetBackupObject(name, object)
+	if err != nil {
+		return errors.Wrapf(err, "error getting backup object %s", object)
+	}
+	defer body.Close()
+
+	if err := to.PutBackupObject(name, object, body); err != nil {
+		return errors.Wrapf(err, "error putting backup object %s", object)
+	}
+	return nil
+}
+
+func (s *objectBackupStore) PutBackupCheckpoint(name string, checkpoint io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getBackupCheckpointKey(name), checkpoint)
+}
+
+func (s *objectBackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getBackupCheckpointKey(name))
+}
+
+func (s *objectBackupStore) DeleteBackupCheckpoint(name string) error {
+	key := s.layout.getBackupCheckpointKey(name)
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil || !exists {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(s.objectStore.DeleteObject(s.bucket, key))
+}
+
 func (s *objectBackupStore) DeleteRestore(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getRestoreDir(name))
 	if err != nil {
@@ -510,10 +825,33 @@ func (s *objectBackupStore) PutRestoreResults(backup string, restore string, res
 	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
 }
 
+func (s *objectBackupStore) PutRestoreRollbackRecord(restore string, record io.Reader) error {
+	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreRollbackRecordKey(restore), record)
+}
+
+func (s *objectBackupStore) GetRestoreRollbackRecord(restore string) (io.ReadCloser, error) {
+	return tryGet(s.objectStore, s.bucket, s.layout.getRestoreRollbackRecordKey(restore))
+}
+
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
 	switch target.Kind {
 	case velerov1api.DownloadTargetKindBackupContents:
-		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name), DownloadURLTTL)
+		algorithm, err := s.backupCompression(target.Name)
+		if err != nil {
+			return "", err
+		}
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupContentsKey(target.Name, algorithm), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupIndex:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupIndexKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupNamespaceContents:
+		if target.Namespace == "" {
+			return "", errors.Errorf("download target kind %q requires a namespace", target.Kind)
+		}
+		algorithm, err := s.backupCompression(target.Name)
+		if err != nil {
+			return "", err
+		}
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupNamespaceContentsKey(target.Name, target.Namespace, algorithm), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupLog:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupLogKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
@@ -20,6 +20,9 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/compression"
 )
 
 // ObjectStoreLayout defines how Velero's persisted files map to
@@ -29,18 +32,22 @@ type ObjectStoreLayout struct {
 	subdirs    map[string]string
 }
 
+// backupMetadataObject is the name of the object of a backup that contains its metadata.
+const backupMetadataObject = "velero-backup.json"
+
 func NewObjectStoreLayout(prefix string) *ObjectStoreLayout {
 	if prefix != "" && !strings.HasSuffix(prefix, "/") {
 		prefix = prefix + "/"
 	}
 
 	subdirs := map[string]string{
-		"backups":  path.Join(prefix, "backups") + "/",
-		"restores": path.Join(prefix, "restores") + "/",
-		"restic":   path.Join(prefix, "restic") + "/",
-		"metadata": path.Join(prefix, "metadata") + "/",
-		"plugins":  path.Join(prefix, "plugins") + "/",
-		"kopia":    path.Join(prefix, "kopia") + "/",
+		"backups":     path.Join(prefix, "backups") + "/",
+		"restores":    path.Join(prefix, "restores") + "/",
+		"restic":      path.Join(prefix, "restic") + "/",
+		"metadata":    path.Join(prefix, "metadata") + "/",
+		"plugins":     path.Join(prefix, "plugins") + "/",
+		"kopia":       path.Join(prefix, "kopia") + "/",
+		"checkpoints": path.Join(prefix, "checkpoints") + "/",
 	}
 
 	return &ObjectStoreLayout{
@@ -70,11 +77,19 @@ func (l *ObjectStoreLayout) getRestoreDir(restore string) string {
 }
 
 func (l *ObjectStoreLayout) getBackupMetadataKey(backup string) string {
-	return path.Join(l.subdirs["backups"], backup, "velero-backup.json")
+	return path.Join(l.subdirs["backups"], backup, backupMetadataObject)
+}
+
+func (l *ObjectStoreLayout) getBackupContentsKey(backup string, algorithm velerov1api.CompressionAlgorithm) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s.tar%s", backup, compression.Extension(algorithm)))
+}
+
+func (l *ObjectStoreLayout) getBackupNamespaceContentsKey(backup, namespace string, algorithm velerov1api.CompressionAlgorithm) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-namespace-%s.tar%s", backup, namespace, compression.Extension(algorithm)))
 }
 
-func (l *ObjectStoreLayout) getBackupContentsKey(backup string) string {
-	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s.tar.gz", backup))
+func (l *ObjectStoreLayout) getBackupIndexKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-index.json.gz", backup))
 }
 
 func (l *ObjectStoreLayout) getBackupLogKey(backup string) string {
@@ -85,6 +100,10 @@ func (l *ObjectStoreLayout) getPodVolumeBackupsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-podvolumebackups.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getDataUploadsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-datauploads.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getBackupVolumeSnapshotsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-volumesnapshots.json.gz", backup))
 }
@@ -97,6 +116,10 @@ func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupCheckpointKey(backup string) string {
+	return path.Join(l.subdirs["checkpoints"], fmt.Sprintf("%s.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getRestoreLogKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-logs.gz", restore))
 }
@@ -105,6 +128,10 @@ func (l *ObjectStoreLayout) getRestoreResultsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-results.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreRollbackRecordKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-rollback.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
@@ -27,6 +27,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
@@ -225,6 +227,9 @@ func TestPutBackup(t *testing.T) {
 		snapshots       io.Reader
 		itemSnapshots   io.Reader
 		resourceList    io.Reader
+		index           io.Reader
+		namespaces      map[string]io.Reader
+		compression     velerov1api.CompressionAlgorithm
 		expectedErr     string
 		expectedKeys    []string
 	}{
@@ -269,6 +274,92 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-resource-list.json.gz",
 			},
 		},
+		{
+			name:            "split archives are uploaded with the index",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			itemSnapshots:   newStringReadSeeker("itemSnapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			index:           newStringReadSeeker("index"),
+			namespaces: map[string]io.Reader{
+				"ns-1": newStringReadSeeker("ns-1"),
+				"ns-2": newStringReadSeeker("ns-2"),
+			},
+			expectedErr: "",
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.gz",
+				"backups/backup-1/backup-1-namespace-ns-1.tar.gz",
+				"backups/backup-1/backup-1-namespace-ns-2.tar.gz",
+				"backups/backup-1/backup-1-index.json.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-itemsnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+			},
+		},
+		{
+			name:            "keys of zstd compressed contents have the zstd extension",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			itemSnapshots:   newStringReadSeeker("itemSnapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			index:           newStringReadSeeker("index"),
+			namespaces:      map[string]io.Reader{"ns-1": newStringReadSeeker("ns-1")},
+			compression:     velerov1api.CompressionAlgorithmZstd,
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar.zst",
+				"backups/backup-1/backup-1-namespace-ns-1.tar.zst",
+				"backups/backup-1/backup-1-index.json.gz",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-itemsnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+			},
+		},
+		{
+			name:            "keys of uncompressed contents have the tar extension",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			itemSnapshots:   newStringReadSeeker("itemSnapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			compression:     velerov1api.CompressionAlgorithmNone,
+			expectedKeys: []string{
+				"backups/backup-1/velero-backup.json",
+				"backups/backup-1/backup-1.tar",
+				"backups/backup-1/backup-1-logs.gz",
+				"backups/backup-1/backup-1-podvolumebackups.json.gz",
+				"backups/backup-1/backup-1-volumesnapshots.json.gz",
+				"backups/backup-1/backup-1-itemsnapshots.json.gz",
+				"backups/backup-1/backup-1-resource-list.json.gz",
+			},
+		},
+		{
+			name:            "error on namespace data upload deletes data and metadata",
+			metadata:        newStringReadSeeker("metadata"),
+			contents:        newStringReadSeeker("contents"),
+			log:             newStringReadSeeker("log"),
+			podVolumeBackup: newStringReadSeeker("podVolumeBackup"),
+			snapshots:       newStringReadSeeker("snapshots"),
+			itemSnapshots:   newStringReadSeeker("itemSnapshots"),
+			resourceList:    newStringReadSeeker("resourceList"),
+			index:           newStringReadSeeker("index"),
+			namespaces:      map[string]io.Reader{"ns-1": new(errorReader)},
+			expectedErr:     "error readers return errors",
+			expectedKeys:    []string{"backups/backup-1/backup-1-logs.gz"},
+		},
 		{
 			name:            "error on metadata upload does not upload data",
 			metadata:        new(errorReader),
@@ -343,6 +434,9 @@ func TestPutBackup(t *testing.T) {
 				VolumeSnapshots:    tc.snapshots,
 				ItemSnapshots:      tc.itemSnapshots,
 				BackupResourceList: tc.resourceList,
+				Index:              tc.index,
+				NamespaceContents:  tc.namespaces,
+				Compression:        tc.compression,
 			}
 			err := harness.PutBackup(backupInfo)
 
@@ -442,6 +536,160 @@ func TestGetBackupVolumeSnapshots(t *testing.T) {
 	assert.EqualValues(t, snapshots, res)
 }
 
+func TestGetDataUploads(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// datauploads file not found should not error
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", newStringReadSeeker("foo"))
+	res, err := harness.GetDataUploads("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// datauploads file containing invalid data should error
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-datauploads.json.gz", newStringReadSeeker("foo"))
+	_, err = harness.GetDataUploads("test-backup")
+	assert.NotNil(t, err)
+
+	// datauploads file containing gzipped json data should return correctly
+	dataUploads := []*velerov1api.DataUpload{
+		{
+			Spec:   velerov1api.DataUploadSpec{SourceNamespace: "ns-1", SourcePVC: "pvc-1"},
+			Status: velerov1api.DataUploadStatus{Phase: velerov1api.DataUploadPhaseCompleted, SnapshotID: "k0123"},
+		},
+	}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(dataUploads))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-datauploads.json.gz", obj))
+
+	res, err = harness.GetDataUploads("test-backup")
+	assert.NoError(t, err)
+	assert.EqualValues(t, dataUploads, res)
+}
+
+func TestPutPodVolumeBackups(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	podVolumeBackups := []*velerov1api.PodVolumeBackup{
+		{
+			Spec:   velerov1api.PodVolumeBackupSpec{BackupStorageLocation: "location-2", RepoIdentifier: "s3:bucket-2/restic/ns-1"},
+			Status: velerov1api.PodVolumeBackupStatus{SnapshotID: "snapshot-1"},
+		},
+	}
+
+	require.NoError(t, harness.PutPodVolumeBackups("test-backup", podVolumeBackups))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-podvolumebackups.json.gz")
+
+	res, err := harness.GetPodVolumeBackups("test-backup")
+	assert.NoError(t, err)
+	assert.EqualValues(t, podVolumeBackups, res)
+}
+
+func TestMeasureObjects(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-1/velero-backup.json", newStringReadSeeker("backup-1"))
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-1/backup-1.tar.gz", newStringReadSeeker("contents"))
+	harness.objectStore.PutObject(harness.bucket, "restores/restore-1/restore-restore-1-logs.gz", newStringReadSeeker("log"))
+	harness.objectStore.PutObject(harness.bucket, "checkpoints/backup-2.json.gz", newStringReadSeeker("checkpoint"))
+	harness.objectStore.PutObject(harness.bucket, "restic/ns-1/config", newStringReadSeeker("repository data"))
+
+	objects, bytes, err := harness.MeasureObjects()
+	require.NoError(t, err)
+	assert.Equal(t, 4, objects)
+	require.NotNil(t, bytes)
+	assert.Equal(t, int64(29), *bytes)
+
+	// overwritten objects are measured again
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-1/backup-1.tar.gz", newStringReadSeeker("more contents"))
+	_, bytes, err = harness.MeasureObjects()
+	require.NoError(t, err)
+	require.NotNil(t, bytes)
+	assert.Equal(t, int64(34), *bytes)
+
+	// the objects are only counted if the object store can't list their sizes
+	harness.objectBackupStore.objectStore = struct{ velero.ObjectStore }{harness.objectStore}
+	objects, bytes, err = harness.MeasureObjects()
+	require.NoError(t, err)
+	assert.Equal(t, 4, objects)
+	assert.Nil(t, bytes)
+}
+
+func TestCopyBackup(t *testing.T) {
+	from := newObjectBackupStoreTestHarness("from-bucket", "")
+	to := newObjectBackupStoreTestHarness("to-bucket", "prefix")
+
+	from.objectStore.PutObject(from.bucket, "backups/backup-1/velero-backup.json", newStringReadSeeker("metadata"))
+	from.objectStore.PutObject(from.bucket, "backups/backup-1/backup-1.tar.gz", newStringReadSeeker("contents"))
+	from.objectStore.PutObject(from.bucket, "backups/backup-1/backup-1-logs.gz", newStringReadSeeker("log"))
+	from.objectStore.PutObject(from.bucket, "backups/backup-2/velero-backup.json", newStringReadSeeker("other"))
+
+	require.NoError(t, CopyBackup("backup-1", from, to))
+	assert.Equal(t, BucketData{
+		"prefix/backups/backup-1/velero-backup.json": []byte("metadata"),
+		"prefix/backups/backup-1/backup-1.tar.gz":    []byte("contents"),
+		"prefix/backups/backup-1/backup-1-logs.gz":   []byte("log"),
+	}, to.objectStore.Data[to.bucket])
+
+	// a backup without metadata isn't copied
+	from.objectStore.PutObject(from.bucket, "backups/backup-3/backup-3.tar.gz", newStringReadSeeker("contents"))
+	assert.EqualError(t, CopyBackup("backup-3", from, to), "backup backup-3 has no metadata")
+	assert.NotContains(t, to.objectStore.Data[to.bucket], "prefix/backups/backup-3/backup-3.tar.gz")
+}
+
+// failingObjectLocker fails to lock the object with the given key.
+type failingObjectLocker struct {
+	*inMemoryObjectStore
+	key string
+}
+
+func (l *failingObjectLocker) PutObjectRetention(bucket, key string, retention velero.ObjectRetention) error {
+	if key == l.key {
+		return errors.New("lock failed")
+	}
+	return l.inMemoryObjectStore.PutObjectRetention(bucket, key, retention)
+}
+
+func TestLockBackup(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-1/velero-backup.json", newStringReadSeeker("metadata"))
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-1/backup-1.tar.gz", newStringReadSeeker("contents"))
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-2/velero-backup.json", newStringReadSeeker("metadata"))
+
+	lock, err := harness.GetBackupLock("backup-1")
+	require.NoError(t, err)
+	assert.Nil(t, lock)
+
+	retention := velero.ObjectRetention{Mode: velero.ObjectRetentionModeCompliance, RetainUntil: time.Now().Add(time.Hour)}
+	require.NoError(t, harness.LockBackup("backup-1", retention))
+	assert.Equal(t, map[string]velero.ObjectRetention{
+		"backups/backup-1/velero-backup.json": retention,
+		"backups/backup-1/backup-1.tar.gz":    retention,
+	}, harness.objectStore.Retentions[harness.bucket])
+
+	lock, err = harness.GetBackupLock("backup-1")
+	require.NoError(t, err)
+	assert.Equal(t, &retention, lock)
+	assert.Error(t, harness.DeleteBackup("backup-1"))
+
+	// the metadata is locked last, so it isn't locked if locking another object fails
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-3/velero-backup.json", newStringReadSeeker("metadata"))
+	harness.objectStore.PutObject(harness.bucket, "backups/backup-3/backup-3.tar.gz", newStringReadSeeker("contents"))
+	harness.objectBackupStore.objectStore = &failingObjectLocker{inMemoryObjectStore: harness.objectStore, key: "backups/backup-3/backup-3.tar.gz"}
+	assert.EqualError(t, harness.LockBackup("backup-3", retention), "error locking object backups/backup-3/backup-3.tar.gz: lock failed")
+	assert.NotContains(t, harness.objectStore.Retentions[harness.bucket], "backups/backup-3/velero-backup.json")
+
+	// object stores that don't support object lock
+	harness.objectBackupStore.objectStore = struct{ velero.ObjectStore }{harness.objectStore}
+	assert.Equal(t, velero.ErrObjectLockNotSupported, harness.LockBackup("backup-2", retention))
+	lock, err = harness.GetBackupLock("backup-1")
+	require.NoError(t, err)
+	assert.Nil(t, lock)
+}
+
 func TestGetItemSnapshots(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -485,17 +733,117 @@ func TestGetItemSnapshots(t *testing.T) {
 }
 
 func TestGetBackupContents(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression velerov1api.CompressionAlgorithm
+		key         string
+	}{
+		{
+			name: "gzip compressed contents",
+			key:  "backups/test-backup/test-backup.tar.gz",
+		},
+		{
+			name:        "zstd compressed contents",
+			compression: velerov1api.CompressionAlgorithmZstd,
+			key:         "backups/test-backup/test-backup.tar.zst",
+		},
+		{
+			name:        "uncompressed contents",
+			compression: velerov1api.CompressionAlgorithmNone,
+			key:         "backups/test-backup/test-backup.tar",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+			backup := builder.ForBackup(velerov1api.DefaultNamespace, "test-backup").Result()
+			backup.Status.Compression = test.compression
+			harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", bytes.NewReader(encodeToBytes(backup)))
+			harness.objectStore.PutObject(harness.bucket, test.key, newStringReadSeeker("foo"))
+
+			rc, err := harness.GetBackupContents("test-backup")
+			require.NoError(t, err)
+			require.NotNil(t, rc)
+
+			data, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			assert.Equal(t, "foo", string(data))
+		})
+	}
+
+	// the contents can't be found without the backup's metadata
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	_, err := harness.GetBackupContents("test-backup")
+	assert.Error(t, err)
+}
+
+func TestGetBackupIndex(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// a backup in the single tarball format doesn't have an index
+	res, err := harness.GetBackupIndex("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, res)
+
+	index := &archive.Index{Namespaces: map[string]archive.IndexEntry{"ns-1": {Files: 2}}}
+
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+
+	require.NoError(t, json.NewEncoder(gzw).Encode(index))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-index.json.gz", obj))
+
+	res, err = harness.GetBackupIndex("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, index, res)
+}
+
+func TestGetBackupNamespaceContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
-	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup.tar.gz", newStringReadSeeker("foo"))
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "test-backup").Result()
+	backup.Status.Compression = velerov1api.CompressionAlgorithmZstd
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/velero-backup.json", bytes.NewReader(encodeToBytes(backup)))
+	harness.objectStore.PutObject(harness.bucket, "backups/test-backup/test-backup-namespace-ns-1.tar.zst", newStringReadSeeker("foo"))
 
-	rc, err := harness.GetBackupContents("test-backup")
+	rc, err := harness.GetBackupNamespaceContents("test-backup", "ns-1")
 	require.NoError(t, err)
 	require.NotNil(t, rc)
 
 	data, err := ioutil.ReadAll(rc)
 	require.NoError(t, err)
 	assert.Equal(t, "foo", string(data))
+
+	_, err = harness.GetBackupNamespaceContents("test-backup", "ns-2")
+	assert.Error(t, err)
+}
+
+func TestBackupCheckpoint(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "prefix")
+
+	// a missing checkpoint is not an error
+	rc, err := harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, rc)
+	require.NoError(t, harness.DeleteBackupCheckpoint("test-backup"))
+
+	require.NoError(t, harness.PutBackupCheckpoint("test-backup", newStringReadSeeker("foo")))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "prefix/checkpoints/test-backup.json.gz")
+
+	rc, err = harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	require.NotNil(t, rc)
+	data, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(data))
+
+	require.NoError(t, harness.DeleteBackupCheckpoint("test-backup"))
+	rc, err = harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	assert.Nil(t, rc)
 }
 
 func TestDeleteBackup(t *testing.T) {
@@ -562,6 +910,7 @@ func TestGetDownloadURL(t *testing.T) {
 			targetName: "my-backup",
 			expectedKeyByKind: map[velerov1api.DownloadTargetKind]string{
 				velerov1api.DownloadTargetKindBackupContents:        "backups/my-backup/my-backup.tar.gz",
+				velerov1api.DownloadTargetKindBackupIndex:           "backups/my-backup/my-backup-index.json.gz",
 				velerov1api.DownloadTargetKindBackupLog:             "backups/my-backup/my-backup-logs.gz",
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemSnapshots:   "backups/my-backup/my-backup-itemsnapshots.json.gz",
@@ -644,6 +993,7 @@ func TestGetDownloadURL(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			harness := newObjectBackupStoreTestHarness("test-bucket", test.prefix)
+			require.NoError(t, harness.objectStore.PutObject("test-bucket", test.prefix+"backups/"+test.targetName+"/velero-backup.json", bytes.NewReader(encodeToBytes(builder.ForBackup("", test.targetName).Result()))))
 
 			for kind, expectedKey := range test.expectedKeyByKind {
 				t.Run(string(kind), func(t *testing.T) {
@@ -658,6 +1008,19 @@ func TestGetDownloadURL(t *testing.T) {
 	}
 }
 
+func TestGetDownloadURLForNamespaceContents(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	require.NoError(t, harness.objectStore.PutObject("test-bucket", "backups/my-backup/velero-backup.json", bytes.NewReader(encodeToBytes(builder.ForBackup("", "my-backup").Result()))))
+	require.NoError(t, harness.objectStore.PutObject("test-bucket", "backups/my-backup/my-backup-namespace-ns-1.tar.gz", newStringReadSeeker("foo")))
+
+	url, err := harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupNamespaceContents, Name: "my-backup", Namespace: "ns-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "a-url", url)
+
+	_, err = harness.GetDownloadURL(velerov1api.DownloadTarget{Kind: velerov1api.DownloadTargetKindBackupNamespaceContents, Name: "my-backup"})
+	assert.EqualError(t, err, `download target kind "BackupNamespaceContents" requires a namespace`)
+}
+
 type objectStoreGetter map[string]velero.ObjectStore
 
 func (osg objectStoreGetter) GetObjectStore(provider string) (velero.ObjectStore, error) {
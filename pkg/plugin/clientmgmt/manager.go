@@ -26,6 +26,7 @@ import (
 
 	biav1cli "github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/backupitemaction/v1"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	biav1 "github.com/vmware-tanzu/velero/pkg/plugin/velero/backupitemaction/v1"
@@ -77,6 +78,10 @@ type manager struct {
 	logLevel logrus.Level
 	registry process.Registry
 
+	// pluginConfigGetter supplies PluginConfig data that is merged into the config
+	// passed to ObjectStore and VolumeSnapshotter plugins at Init time. It may be nil.
+	pluginConfigGetter framework.PluginConfigGetter
+
 	restartableProcessFactory process.RestartableProcessFactory
 
 	// lock guards restartableProcesses
@@ -84,14 +89,17 @@ type manager struct {
 	restartableProcesses map[string]process.RestartableProcess
 }
 
-// NewManager constructs a manager for getting plugins.
-func NewManager(logger logrus.FieldLogger, level logrus.Level, registry process.Registry) Manager {
+// NewManager constructs a manager for getting plugins. pluginConfigGetter may be nil if
+// plugins should only be initialized with the config given by their callers. remoteEndpoints
+// are the remote plugin servers that registry discovered plugins from.
+func NewManager(logger logrus.FieldLogger, level logrus.Level, registry process.Registry, pluginConfigGetter framework.PluginConfigGetter, remoteEndpoints ...process.RemoteEndpoint) Manager {
 	return &manager{
-		logger:   logger,
-		logLevel: level,
-		registry: registry,
+		logger:             logger,
+		logLevel:           level,
+		registry:           registry,
+		pluginConfigGetter: pluginConfigGetter,
 
-		restartableProcessFactory: process.NewRestartableProcessFactory(),
+		restartableProcessFactory: process.NewRestartableProcessFactory(remoteEndpoints...),
 
 		restartableProcesses: make(map[string]process.RestartableProcess),
 	}
@@ -154,6 +162,7 @@ func (m *manager) GetObjectStore(name string) (velero.ObjectStore, error) {
 	}
 
 	r := NewRestartableObjectStore(name, restartableProcess)
+	r.pluginConfigGetter = m.pluginConfigGetter
 
 	return r, nil
 }
@@ -168,6 +177,7 @@ func (m *manager) GetVolumeSnapshotter(name string) (velero.VolumeSnapshotter, e
 	}
 
 	r := NewRestartableVolumeSnapshotter(name, restartableProcess)
+	r.pluginConfigGetter = m.pluginConfigGetter
 
 	return r, nil
 }
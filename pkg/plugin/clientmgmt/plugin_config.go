@@ -0,0 +1,50 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientmgmt
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+)
+
+// mergePluginConfig returns config with the plugin's unscoped PluginConfig merged in. Keys in
+// config, which come from the location the plugin is being initialized for, take precedence.
+func mergePluginConfig(getter framework.PluginConfigGetter, key process.KindAndName, config map[string]string) (map[string]string, error) {
+	if getter == nil {
+		return config, nil
+	}
+
+	pluginConfig, err := getter.GetPluginConfig(key.Kind, key.Name, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting plugin config for %s plugin %s", key.Kind, key.Name)
+	}
+	if len(pluginConfig) == 0 {
+		return config, nil
+	}
+
+	merged := make(map[string]string, len(pluginConfig)+len(config))
+	for k, v := range pluginConfig {
+		merged[k] = v
+	}
+	for k, v := range config {
+		merged[k] = v
+	}
+
+	return merged, nil
+}
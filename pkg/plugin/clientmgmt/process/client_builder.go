@@ -67,17 +67,22 @@ func (b *clientBuilder) clientConfig() *hcplugin.ClientConfig {
 	return &hcplugin.ClientConfig{
 		HandshakeConfig:  framework.Handshake(),
 		AllowedProtocols: []hcplugin.Protocol{hcplugin.ProtocolGRPC},
-		Plugins: map[string]hcplugin.Plugin{
-			string(common.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(common.ClientLogger(b.clientLogger)),
-			string(common.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(common.ClientLogger(b.clientLogger)),
-			string(common.PluginKindObjectStore):       framework.NewObjectStorePlugin(common.ClientLogger(b.clientLogger)),
-			string(common.PluginKindPluginLister):      &framework.PluginListerPlugin{},
-			string(common.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(common.ClientLogger(b.clientLogger)),
-			string(common.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(common.ClientLogger(b.clientLogger)),
-			string(common.PluginKindItemSnapshotter):   framework.NewItemSnapshotterPlugin(common.ClientLogger(b.clientLogger)),
-		},
-		Logger: b.pluginLogger,
-		Cmd:    exec.Command(b.commandName, b.commandArgs...),
+		Plugins:          clientPlugins(b.clientLogger),
+		Logger:           b.pluginLogger,
+		Cmd:              exec.Command(b.commandName, b.commandArgs...),
+	}
+}
+
+// clientPlugins returns the client side of every plugin kind Velero supports, keyed by kind.
+func clientPlugins(logger logrus.FieldLogger) map[string]hcplugin.Plugin {
+	return map[string]hcplugin.Plugin{
+		string(common.PluginKindBackupItemAction):  framework.NewBackupItemActionPlugin(common.ClientLogger(logger)),
+		string(common.PluginKindVolumeSnapshotter): framework.NewVolumeSnapshotterPlugin(common.ClientLogger(logger)),
+		string(common.PluginKindObjectStore):       framework.NewObjectStorePlugin(common.ClientLogger(logger)),
+		string(common.PluginKindPluginLister):      &framework.PluginListerPlugin{},
+		string(common.PluginKindRestoreItemAction): framework.NewRestoreItemActionPlugin(common.ClientLogger(logger)),
+		string(common.PluginKindDeleteItemAction):  framework.NewDeleteItemActionPlugin(common.ClientLogger(logger)),
+		string(common.PluginKindItemSnapshotter):   framework.NewItemSnapshotterPlugin(common.ClientLogger(logger)),
 	}
 }
 
@@ -31,14 +31,17 @@ type ProcessFactory interface {
 }
 
 type processFactory struct {
+	remoteEndpoints []RemoteEndpoint
 }
 
-func newProcessFactory() ProcessFactory {
-	return &processFactory{}
+// newProcessFactory returns a ProcessFactory that connects to the remoteEndpoints for their
+// commands, and launches any other command as a plugin executable.
+func newProcessFactory(remoteEndpoints ...RemoteEndpoint) ProcessFactory {
+	return &processFactory{remoteEndpoints: remoteEndpoints}
 }
 
 func (pf *processFactory) newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
-	return newProcess(command, logger, logLevel)
+	return newProcess(command, logger, logLevel, pf.remoteEndpoints)
 }
 
 type Process interface {
@@ -52,7 +55,11 @@ type process struct {
 	protocolClient plugin.ClientProtocol
 }
 
-func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (Process, error) {
+func newProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, remoteEndpoints []RemoteEndpoint) (Process, error) {
+	if endpoint, found := findRemoteEndpoint(remoteEndpoints, command); found {
+		return newRemoteProcess(endpoint, logger)
+	}
+
 	builder := newClientBuilder(command, logger.WithField("cmd", command), logLevel)
 
 	// This creates a new go-plugin Client that has its own unique exec.Cmd for launching the plugin process.
@@ -131,6 +138,11 @@ func (r *process) dispense(key KindAndName) (interface{}, error) {
 		return nil, errors.WithStack(err)
 	}
 
+	return clientFor(key, dispensed)
+}
+
+// clientFor returns the client for key.Name if dispensed is a clientDispenser, or dispensed itself otherwise.
+func clientFor(key KindAndName, dispensed interface{}) (interface{}, error) {
 	// Currently all plugins except for PluginLister dispense clientDispenser instances.
 	if clientDispenser, ok := dispensed.(common.ClientDispenser); ok {
 		if key.Name == "" {
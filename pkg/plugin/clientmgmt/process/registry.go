@@ -52,20 +52,25 @@ type registry struct {
 	logger   logrus.FieldLogger
 	logLevel logrus.Level
 
+	// remoteEndpoints are plugin servers reached over gRPC rather than found in dir.
+	remoteEndpoints []RemoteEndpoint
+
 	processFactory ProcessFactory
 	fs             filesystem.Interface
 	pluginsByID    map[KindAndName]framework.PluginIdentifier
 	pluginsByKind  map[common.PluginKind][]framework.PluginIdentifier
 }
 
-// NewRegistry returns a new registry.
-func NewRegistry(dir string, logger logrus.FieldLogger, logLevel logrus.Level) Registry {
+// NewRegistry returns a new registry. Plugins are discovered from the executables in dir and from
+// any remoteEndpoints.
+func NewRegistry(dir string, logger logrus.FieldLogger, logLevel logrus.Level, remoteEndpoints ...RemoteEndpoint) Registry {
 	return &registry{
-		dir:      dir,
-		logger:   logger,
-		logLevel: logLevel,
+		dir:             dir,
+		logger:          logger,
+		logLevel:        logLevel,
+		remoteEndpoints: remoteEndpoints,
 
-		processFactory: newProcessFactory(),
+		processFactory: newProcessFactory(remoteEndpoints...),
 		fs:             filesystem.NewFileSystem(),
 		pluginsByID:    make(map[KindAndName]framework.PluginIdentifier),
 		pluginsByKind:  make(map[common.PluginKind][]framework.PluginIdentifier),
@@ -82,6 +87,10 @@ func (r *registry) DiscoverPlugins() error {
 	commands := []string{os.Args[0]}
 	// Then add the discovered plugin executables
 	commands = append(commands, plugins...)
+	// And finally the remote plugin servers
+	for _, endpoint := range r.remoteEndpoints {
+		commands = append(commands, endpoint.Command())
+	}
 
 	return r.discoverPlugins(commands)
 }
@@ -193,7 +202,20 @@ func (r *registry) listPlugins(command string) ([]framework.PluginIdentifier, er
 		return nil, errors.Errorf("%T is not a PluginLister", plugin)
 	}
 
-	return lister.ListPlugins()
+	plugins, err := lister.ListPlugins()
+	if err != nil {
+		return nil, err
+	}
+
+	// A remote plugin server reports its own executable as the command, which means nothing
+	// on this side of the connection, so record the endpoint instead.
+	if _, remote := findRemoteEndpoint(r.remoteEndpoints, command); remote {
+		for i := range plugins {
+			plugins[i].Command = command
+		}
+	}
+
+	return plugins, nil
 }
 
 // register registers a PluginIdentifier with the registry.
@@ -0,0 +1,218 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package process
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	hcplugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"sigs.k8s.io/yaml"
+)
+
+// remoteCommandPrefix is prepended to the address of a remote plugin endpoint to build the
+// "command" that identifies it in the registry, e.g. grpc://velero-plugin-aws.velero.svc:9443.
+const remoteCommandPrefix = "grpc://"
+
+// remoteDialTimeout is how long to wait for a remote plugin endpoint to accept a connection.
+const remoteDialTimeout = 30 * time.Second
+
+// RemoteEndpoint describes a plugin server that is already running, e.g. as a sidecar container
+// or a separate Deployment, and is reached over gRPC instead of being launched as a child process.
+type RemoteEndpoint struct {
+	// Address is the host:port of the plugin server.
+	Address string `json:"address"`
+
+	// CAFile is the path to the PEM-encoded CA bundle used to verify the plugin server's certificate.
+	CAFile string `json:"caFile,omitempty"`
+
+	// CertFile and KeyFile are the paths to the PEM-encoded client certificate and key that Velero
+	// presents to the plugin server.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+
+	// ServerName overrides the name used to verify the plugin server's certificate. Defaults to
+	// the host part of Address.
+	ServerName string `json:"serverName,omitempty"`
+
+	// Insecure disables TLS entirely. It should only be used for local development.
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// RemoteEndpointsConfig is the format of the file passed to the Velero server's
+// --remote-plugins-config flag.
+type RemoteEndpointsConfig struct {
+	Endpoints []RemoteEndpoint `json:"endpoints"`
+}
+
+// LoadRemoteEndpoints reads and validates a RemoteEndpointsConfig from path.
+func LoadRemoteEndpoints(path string) ([]RemoteEndpoint, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading remote plugins config %s", path)
+	}
+
+	config := new(RemoteEndpointsConfig)
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, errors.Wrapf(err, "error parsing remote plugins config %s", path)
+	}
+
+	for _, endpoint := range config.Endpoints {
+		if err := endpoint.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return config.Endpoints, nil
+}
+
+func (e RemoteEndpoint) validate() error {
+	if e.Address == "" {
+		return errors.New("remote plugin endpoint address must be specified")
+	}
+	if e.Insecure {
+		return nil
+	}
+	if e.CAFile == "" {
+		return errors.Errorf("remote plugin endpoint %s: caFile must be specified unless insecure is set", e.Address)
+	}
+	if (e.CertFile == "") != (e.KeyFile == "") {
+		return errors.Errorf("remote plugin endpoint %s: certFile and keyFile must be specified together", e.Address)
+	}
+	return nil
+}
+
+// Command returns the identifier under which the plugins served by e are registered.
+func (e RemoteEndpoint) Command() string {
+	return remoteCommandPrefix + e.Address
+}
+
+// tlsConfig builds the client-side TLS configuration for e.
+func (e RemoteEndpoint) tlsConfig() (*tls.Config, error) {
+	caData, err := ioutil.ReadFile(e.CAFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading CA file for remote plugin endpoint %s", e.Address)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caData) {
+		return nil, errors.Errorf("no certificates found in CA file %s", e.CAFile)
+	}
+
+	config := &tls.Config{
+		RootCAs:    pool,
+		ServerName: e.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if e.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(e.CertFile, e.KeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error loading client certificate for remote plugin endpoint %s", e.Address)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// findRemoteEndpoint returns the endpoint among endpoints that command identifies, or false if
+// command isn't a remote plugin endpoint's.
+func findRemoteEndpoint(endpoints []RemoteEndpoint, command string) (RemoteEndpoint, bool) {
+	if !strings.HasPrefix(command, remoteCommandPrefix) {
+		return RemoteEndpoint{}, false
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Command() == command {
+			return endpoint, true
+		}
+	}
+	return RemoteEndpoint{}, false
+}
+
+// remoteProcess implements Process for a plugin server reached over the network. Plugins are
+// dispensed through the same go-plugin client types used for plugin executables, so callers
+// can't tell the two apart.
+type remoteProcess struct {
+	conn    *grpc.ClientConn
+	plugins map[string]hcplugin.Plugin
+}
+
+func newRemoteProcess(endpoint RemoteEndpoint, logger logrus.FieldLogger) (Process, error) {
+	logger = logger.WithField("endpoint", endpoint.Address)
+
+	var creds grpc.DialOption
+	if endpoint.Insecure {
+		logger.Warn("Connecting to remote plugin endpoint without TLS")
+		creds = grpc.WithInsecure()
+	} else {
+		tlsConfig, err := endpoint.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteDialTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, endpoint.Address, creds, grpc.WithBlock())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error connecting to remote plugin endpoint %s", endpoint.Address)
+	}
+
+	logger.Debug("Connected to remote plugin endpoint")
+
+	return &remoteProcess{
+		conn:    conn,
+		plugins: clientPlugins(logger),
+	}, nil
+}
+
+func (r *remoteProcess) dispense(key KindAndName) (interface{}, error) {
+	plugin, ok := r.plugins[key.Kind.String()].(hcplugin.GRPCPlugin)
+	if !ok {
+		return nil, errors.Errorf("unknown plugin kind %s", key.Kind.String())
+	}
+
+	dispensed, err := plugin.GRPCClient(context.Background(), nil, r.conn)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return clientFor(key, dispensed)
+}
+
+// exited returns true once the connection has been closed. Transient network failures are
+// retried by gRPC itself, so they are not reported as an exit.
+func (r *remoteProcess) exited() bool {
+	return r.conn.GetState() == connectivity.Shutdown
+}
+
+func (r *remoteProcess) kill() {
+	r.conn.Close()
+}
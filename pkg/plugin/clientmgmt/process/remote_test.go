@@ -0,0 +1,119 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package process
+
+import (
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestLoadRemoteEndpoints(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		expected    []RemoteEndpoint
+		expectedErr string
+	}{
+		{
+			name: "valid mTLS and insecure endpoints",
+			config: `
+endpoints:
+- address: velero-plugin-aws.velero.svc:9443
+  caFile: /certs/ca.crt
+  certFile: /certs/tls.crt
+  keyFile: /certs/tls.key
+- address: localhost:9443
+  insecure: true
+`,
+			expected: []RemoteEndpoint{
+				{Address: "velero-plugin-aws.velero.svc:9443", CAFile: "/certs/ca.crt", CertFile: "/certs/tls.crt", KeyFile: "/certs/tls.key"},
+				{Address: "localhost:9443", Insecure: true},
+			},
+		},
+		{
+			name:        "missing address",
+			config:      "endpoints:\n- insecure: true\n",
+			expectedErr: "remote plugin endpoint address must be specified",
+		},
+		{
+			name:        "missing CA file",
+			config:      "endpoints:\n- address: localhost:9443\n",
+			expectedErr: "remote plugin endpoint localhost:9443: caFile must be specified unless insecure is set",
+		},
+		{
+			name:        "cert without key",
+			config:      "endpoints:\n- address: localhost:9443\n  caFile: ca.crt\n  certFile: tls.crt\n",
+			expectedErr: "remote plugin endpoint localhost:9443: certFile and keyFile must be specified together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "remote-plugins.yaml")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tc.config), 0644))
+
+			endpoints, err := LoadRemoteEndpoints(path)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, endpoints)
+		})
+	}
+}
+
+func TestRemoteProcessListPlugins(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	lister := framework.NewPluginLister(framework.PluginIdentifier{
+		Command: "/plugins/velero-plugin-example",
+		Kind:    common.PluginKindObjectStore,
+		Name:    "example.io/object-store",
+	})
+	require.NoError(t, framework.NewPluginListerPlugin(lister).GRPCServer(nil, server))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	endpoint := RemoteEndpoint{Address: listener.Addr().String(), Insecure: true}
+	r := NewRegistry("/plugins", test.NewLogger(), logrus.InfoLevel, endpoint).(*registry)
+
+	plugins, err := r.listPlugins(endpoint.Command())
+	require.NoError(t, err)
+
+	expected := []framework.PluginIdentifier{
+		{
+			Command: "grpc://" + listener.Addr().String(),
+			Kind:    common.PluginKindObjectStore,
+			Name:    "example.io/object-store",
+		},
+	}
+	assert.Equal(t, expected, plugins)
+}
@@ -28,14 +28,17 @@ type RestartableProcessFactory interface {
 }
 
 type restartableProcessFactory struct {
+	remoteEndpoints []RemoteEndpoint
 }
 
-func NewRestartableProcessFactory() RestartableProcessFactory {
-	return &restartableProcessFactory{}
+// NewRestartableProcessFactory returns a RestartableProcessFactory whose processes connect to the
+// remoteEndpoints for their commands, and launch any other command as a plugin executable.
+func NewRestartableProcessFactory(remoteEndpoints ...RemoteEndpoint) RestartableProcessFactory {
+	return &restartableProcessFactory{remoteEndpoints: remoteEndpoints}
 }
 
 func (rpf *restartableProcessFactory) NewRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
-	return newRestartableProcess(command, logger, logLevel)
+	return newRestartableProcess(command, logger, logLevel, rpf.remoteEndpoints)
 }
 
 type RestartableProcess interface {
@@ -50,9 +53,10 @@ type RestartableProcess interface {
 // to restart a plugin process if it is terminated for any reason. If this happens, all plugins are reinitialized using
 // the original configuration data.
 type restartableProcess struct {
-	command  string
-	logger   logrus.FieldLogger
-	logLevel logrus.Level
+	command         string
+	logger          logrus.FieldLogger
+	logLevel        logrus.Level
+	remoteEndpoints []RemoteEndpoint
 
 	// lock guards all of the fields below
 	lock           sync.RWMutex
@@ -69,13 +73,14 @@ type Reinitializer interface {
 }
 
 // newRestartableProcess creates a new restartableProcess for the given command and options.
-func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level) (RestartableProcess, error) {
+func newRestartableProcess(command string, logger logrus.FieldLogger, logLevel logrus.Level, remoteEndpoints []RemoteEndpoint) (RestartableProcess, error) {
 	p := &restartableProcess{
-		command:        command,
-		logger:         logger,
-		logLevel:       logLevel,
-		plugins:        make(map[KindAndName]interface{}),
-		reinitializers: make(map[KindAndName]Reinitializer),
+		command:         command,
+		logger:          logger,
+		logLevel:        logLevel,
+		remoteEndpoints: remoteEndpoints,
+		plugins:         make(map[KindAndName]interface{}),
+		reinitializers:  make(map[KindAndName]Reinitializer),
 	}
 
 	// This launches the process
@@ -109,7 +114,7 @@ func (p *restartableProcess) resetLH() error {
 		return errors.Errorf("unable to restart plugin process: exceeded maximum number of reset failures")
 	}
 
-	process, err := newProcess(p.command, p.logger, p.logLevel)
+	process, err := newProcess(p.command, p.logger, p.logLevel, p.remoteEndpoints)
 	if err != nil {
 		p.resetFailures++
 		return err
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
@@ -37,6 +38,8 @@ type restartableObjectStore struct {
 	// config contains the data used to initialize the plugin. It is used to reinitialize the plugin in the event its
 	// sharedPluginProcess gets restarted.
 	config map[string]string
+	// pluginConfigGetter, if set, supplies PluginConfig data that Init merges under config.
+	pluginConfigGetter framework.PluginConfigGetter
 }
 
 // NewRestartableObjectStore returns a new restartableObjectStore.
@@ -101,6 +104,11 @@ func (r *restartableObjectStore) Init(config map[string]string) error {
 		return err
 	}
 
+	config, err = mergePluginConfig(r.pluginConfigGetter, r.key, config)
+	if err != nil {
+		return err
+	}
+
 	r.config = config
 
 	return r.init(delegate, config)
@@ -157,6 +165,19 @@ func (r *restartableObjectStore) ListObjects(bucket string, prefix string) ([]st
 	return delegate.ListObjects(bucket, prefix)
 }
 
+// ListObjectSizes restarts the plugin's process if needed, then delegates the call.
+func (r *restartableObjectStore) ListObjectSizes(bucket string, prefix string) (map[string]int64, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return nil, err
+	}
+	lister, ok := delegate.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, velero.ErrObjectSizesNotSupported
+	}
+	return lister.ListObjectSizes(bucket, prefix)
+}
+
 // DeleteObject restarts the plugin's process if needed, then delegates the call.
 func (r *restartableObjectStore) DeleteObject(bucket string, key string) error {
 	delegate, err := r.getDelegate()
@@ -174,3 +195,29 @@ func (r *restartableObjectStore) CreateSignedURL(bucket string, key string, ttl
 	}
 	return delegate.CreateSignedURL(bucket, key, ttl)
 }
+
+// PutObjectRetention restarts the plugin's process if needed, then delegates the call.
+func (r *restartableObjectStore) PutObjectRetention(bucket string, key string, retention velero.ObjectRetention) error {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return err
+	}
+	locker, ok := delegate.(velero.ObjectLocker)
+	if !ok {
+		return velero.ErrObjectLockNotSupported
+	}
+	return locker.PutObjectRetention(bucket, key, retention)
+}
+
+// GetObjectRetention restarts the plugin's process if needed, then delegates the call.
+func (r *restartableObjectStore) GetObjectRetention(bucket string, key string) (velero.ObjectRetention, error) {
+	delegate, err := r.getDelegate()
+	if err != nil {
+		return velero.ObjectRetention{}, err
+	}
+	locker, ok := delegate.(velero.ObjectLocker)
+	if !ok {
+		return velero.ObjectRetention{}, velero.ErrObjectLockNotSupported
+	}
+	return locker.GetObjectRetention(bucket, key)
+}
@@ -26,9 +26,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestRestartableGetObjectStore(t *testing.T) {
@@ -184,6 +188,38 @@ func TestRestartableObjectStoreInit(t *testing.T) {
 	assert.EqualError(t, err, "already initialized")
 }
 
+func TestRestartableObjectStoreInitWithPluginConfig(t *testing.T) {
+	p := new(mockRestartableProcess)
+	p.Test(t)
+	defer p.AssertExpectations(t)
+
+	name := "velero.io/aws"
+	key := process.KindAndName{Kind: common.PluginKindObjectStore, Name: name}
+	r := &restartableObjectStore{
+		key:                 key,
+		sharedPluginProcess: p,
+		pluginConfigGetter: framework.NewPluginConfigGetter(velerotest.NewFakeControllerRuntimeClient(t,
+			builder.ForPluginConfig("velero", "aws").Plugin(common.PluginKindObjectStore.String(), name).Config("region", "us-east-1", "color", "red").Result(),
+		), "velero"),
+	}
+
+	objectStore := new(providermocks.ObjectStore)
+	objectStore.Test(t)
+	defer objectStore.AssertExpectations(t)
+	p.On("GetByKindAndName", key).Return(objectStore, nil)
+
+	// config from the location takes precedence over the PluginConfig
+	expected := map[string]string{
+		"region": "us-east-1",
+		"color":  "blue",
+	}
+	objectStore.On("Init", expected).Return(nil)
+
+	err := r.Init(map[string]string{"color": "blue"})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, r.config)
+}
+
 func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 	runRestartableDelegateTests(
 		t,
@@ -221,6 +257,12 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{([]string)(nil), errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{[]string{"a", "b"}, errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "ListObjectSizes",
+			inputs:                  []interface{}{"bucket", "prefix"},
+			expectedErrorOutputs:    []interface{}{(map[string]int64)(nil), errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{map[string]int64{"a": 1, "b": 2}, errors.Errorf("delegate error")},
+		},
 		restartableDelegateTest{
 			function:                "DeleteObject",
 			inputs:                  []interface{}{"bucket", "key"},
@@ -233,5 +275,17 @@ func TestRestartableObjectStoreDelegatedFunctions(t *testing.T) {
 			expectedErrorOutputs:    []interface{}{"", errors.Errorf("reset error")},
 			expectedDelegateOutputs: []interface{}{"signedURL", errors.Errorf("delegate error")},
 		},
+		restartableDelegateTest{
+			function:                "PutObjectRetention",
+			inputs:                  []interface{}{"bucket", "key", velero.ObjectRetention{Mode: velero.ObjectRetentionModeCompliance, RetainUntil: time.Unix(1000, 0)}},
+			expectedErrorOutputs:    []interface{}{errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{errors.Errorf("delegate error")},
+		},
+		restartableDelegateTest{
+			function:                "GetObjectRetention",
+			inputs:                  []interface{}{"bucket", "key"},
+			expectedErrorOutputs:    []interface{}{velero.ObjectRetention{}, errors.Errorf("reset error")},
+			expectedDelegateOutputs: []interface{}{velero.ObjectRetention{LegalHold: true}, errors.Errorf("delegate error")},
+		},
 	)
 }
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt/process"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
@@ -33,6 +34,8 @@ type restartableVolumeSnapshotter struct {
 	key                 process.KindAndName
 	sharedPluginProcess process.RestartableProcess
 	config              map[string]string
+	// pluginConfigGetter, if set, supplies PluginConfig data that Init merges under config.
+	pluginConfigGetter framework.PluginConfigGetter
 }
 
 // NewRestartableVolumeSnapshotter returns a new restartableVolumeSnapshotter.
@@ -96,6 +99,11 @@ func (r *restartableVolumeSnapshotter) Init(config map[string]string) error {
 		return err
 	}
 
+	config, err = mergePluginConfig(r.pluginConfigGetter, r.key, config)
+	if err != nil {
+		return err
+	}
+
 	r.config = config
 
 	return r.init(delegate, config)
@@ -23,9 +23,12 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 const byteChunkSize = 16384
@@ -219,3 +222,69 @@ func (c *ObjectStoreGRPCClient) CreateSignedURL(bucket, key string, ttl time.Dur
 
 	return res.Url, nil
 }
+
+// ListObjectSizes gets the sizes of all objects in the object storage bucket that have the
+// same prefix, by key. It returns velero.ErrObjectSizesNotSupported if the plugin can't list
+// the sizes of objects.
+func (c *ObjectStoreGRPCClient) ListObjectSizes(bucket, prefix string) (map[string]int64, error) {
+	req := &proto.ListObjectsRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Prefix: prefix,
+	}
+
+	res, err := c.grpcClient.ListObjectSizes(context.Background(), req)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, velero.ErrObjectSizesNotSupported
+		}
+		return nil, common.FromGRPCError(err)
+	}
+
+	return res.Sizes, nil
+}
+
+// PutObjectRetention protects the object with the given key in the object storage bucket
+// according to retention. It returns velero.ErrObjectLockNotSupported if the plugin doesn't
+// support object lock.
+func (c *ObjectStoreGRPCClient) PutObjectRetention(bucket, key string, retention velero.ObjectRetention) error {
+	req := &proto.PutObjectRetentionRequest{
+		Plugin:    c.Plugin,
+		Bucket:    bucket,
+		Key:       key,
+		Retention: retentionToProto(retention),
+	}
+
+	if _, err := c.grpcClient.PutObjectRetention(context.Background(), req); err != nil {
+		return fromObjectLockGRPCError(err)
+	}
+
+	return nil
+}
+
+// GetObjectRetention returns the protection of the object with the given key in the object
+// storage bucket. It returns velero.ErrObjectLockNotSupported if the plugin doesn't support
+// object lock.
+func (c *ObjectStoreGRPCClient) GetObjectRetention(bucket, key string) (velero.ObjectRetention, error) {
+	req := &proto.GetObjectRetentionRequest{
+		Plugin: c.Plugin,
+		Bucket: bucket,
+		Key:    key,
+	}
+
+	res, err := c.grpcClient.GetObjectRetention(context.Background(), req)
+	if err != nil {
+		return velero.ObjectRetention{}, fromObjectLockGRPCError(err)
+	}
+
+	return retentionFromProto(res.Retention), nil
+}
+
+// fromObjectLockGRPCError converts the errors of plugins that don't support object lock, including
+// plugins built before it was added to the protocol, to velero.ErrObjectLockNotSupported.
+func fromObjectLockGRPCError(err error) error {
+	if status.Code(err) == codes.Unimplemented {
+		return velero.ErrObjectLockNotSupported
+	}
+	return common.FromGRPCError(err)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
@@ -230,6 +231,32 @@ func (s *ObjectStoreGRPCServer) ListObjects(ctx context.Context, req *proto.List
 	return &proto.ListObjectsResponse{Keys: keys}, nil
 }
 
+// ListObjectSizes gets the sizes of all objects in bucket that have the same prefix, by key.
+func (s *ObjectStoreGRPCServer) ListObjectSizes(ctx context.Context, req *proto.ListObjectsRequest) (response *proto.ListObjectSizesResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	impl, err := s.getImpl(req.Plugin)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	lister, ok := impl.(velero.ObjectSizeLister)
+	if !ok {
+		return nil, common.NewGRPCErrorWithCode(errors.Errorf("%T doesn't support listing object sizes", impl), codes.Unimplemented)
+	}
+
+	sizes, err := lister.ListObjectSizes(req.Bucket, req.Prefix)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &proto.ListObjectSizesResponse{Sizes: sizes}, nil
+}
+
 // DeleteObject removes object with the specified key from the given
 // bucket.
 func (s *ObjectStoreGRPCServer) DeleteObject(ctx context.Context, req *proto.DeleteObjectRequest) (response *proto.Empty, err error) {
@@ -271,3 +298,86 @@ func (s *ObjectStoreGRPCServer) CreateSignedURL(ctx context.Context, req *proto.
 
 	return &proto.CreateSignedURLResponse{Url: url}, nil
 }
+
+// getLocker returns the implementation of the optional ObjectLocker interface of the plugin.
+func (s *ObjectStoreGRPCServer) getLocker(name string) (velero.ObjectLocker, error) {
+	impl, err := s.getImpl(name)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	locker, ok := impl.(velero.ObjectLocker)
+	if !ok {
+		return nil, common.NewGRPCErrorWithCode(errors.Errorf("%T doesn't support object lock", impl), codes.Unimplemented)
+	}
+
+	return locker, nil
+}
+
+// PutObjectRetention protects the object with the given key in the object storage bucket
+// according to retention.
+func (s *ObjectStoreGRPCServer) PutObjectRetention(ctx context.Context, req *proto.PutObjectRetentionRequest) (response *proto.Empty, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	locker, err := s.getLocker(req.Plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.PutObjectRetention(req.Bucket, req.Key, retentionFromProto(req.Retention)); err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// GetObjectRetention returns the protection of the object with the given key in the object
+// storage bucket.
+func (s *ObjectStoreGRPCServer) GetObjectRetention(ctx context.Context, req *proto.GetObjectRetentionRequest) (response *proto.GetObjectRetentionResponse, err error) {
+	defer func() {
+		if recoveredErr := common.HandlePanic(recover()); recoveredErr != nil {
+			err = recoveredErr
+		}
+	}()
+
+	locker, err := s.getLocker(req.Plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	retention, err := locker.GetObjectRetention(req.Bucket, req.Key)
+	if err != nil {
+		return nil, common.NewGRPCError(err)
+	}
+
+	return &proto.GetObjectRetentionResponse{Retention: retentionToProto(retention)}, nil
+}
+
+func retentionToProto(retention velero.ObjectRetention) *proto.ObjectRetention {
+	res := &proto.ObjectRetention{
+		Mode:      retention.Mode,
+		LegalHold: retention.LegalHold,
+	}
+	if !retention.RetainUntil.IsZero() {
+		res.RetainUntil = retention.RetainUntil.Unix()
+	}
+	return res
+}
+
+func retentionFromProto(retention *proto.ObjectRetention) velero.ObjectRetention {
+	if retention == nil {
+		return velero.ObjectRetention{}
+	}
+	res := velero.ObjectRetention{
+		Mode:      retention.Mode,
+		LegalHold: retention.LegalHold,
+	}
+	if retention.RetainUntil != 0 {
+		res.RetainUntil = time.Unix(retention.RetainUntil, 0).UTC()
+	}
+	return res
+}
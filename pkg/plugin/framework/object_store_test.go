@@ -0,0 +1,79 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	proto "github.com/vmware-tanzu/velero/pkg/plugin/generated"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestObjectRetentionProtoConversion(t *testing.T) {
+	retentions := []velero.ObjectRetention{
+		{},
+		{LegalHold: true},
+		{Mode: velero.ObjectRetentionModeGovernance, RetainUntil: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+	for _, retention := range retentions {
+		assert.Equal(t, retention, retentionFromProto(retentionToProto(retention)))
+	}
+	assert.Equal(t, velero.ObjectRetention{}, retentionFromProto(nil))
+}
+
+func TestFromObjectLockGRPCError(t *testing.T) {
+	// plugins built before object lock was added return Unimplemented for unknown methods
+	assert.Equal(t, velero.ErrObjectLockNotSupported, fromObjectLockGRPCError(status.Error(codes.Unimplemented, "unknown method PutObjectRetention")))
+	assert.Equal(t, velero.ErrObjectLockNotSupported, fromObjectLockGRPCError(common.NewGRPCErrorWithCode(errors.New("not supported"), codes.Unimplemented)))
+
+	err := fromObjectLockGRPCError(common.NewGRPCError(errors.New("access denied")))
+	assert.NotEqual(t, velero.ErrObjectLockNotSupported, err)
+	assert.Contains(t, err.Error(), "access denied")
+}
+
+func TestListObjectSizes(t *testing.T) {
+	objectStore := new(mocks.ObjectStore)
+	objectStore.On("ListObjectSizes", "bucket", "backups/").Return(map[string]int64{"backups/backup-1/velero-backup.json": 100}, nil)
+
+	s := &ObjectStoreGRPCServer{mux: &common.ServerMux{
+		ServerLog: velerotest.NewLogger(),
+		Handlers: map[string]interface{}{
+			"sizes":    objectStore,
+			"no-sizes": struct{ velero.ObjectStore }{objectStore},
+		},
+	}}
+
+	res, err := s.ListObjectSizes(context.Background(), &proto.ListObjectsRequest{Plugin: "sizes", Bucket: "bucket", Prefix: "backups/"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"backups/backup-1/velero-backup.json": 100}, res.Sizes)
+
+	// plugins whose object store can't list sizes return Unimplemented, like plugins built before
+	// the method was added to the protocol
+	_, err = s.ListObjectSizes(context.Background(), &proto.ListObjectsRequest{Plugin: "no-sizes", Bucket: "bucket", Prefix: "backups/"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,194 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/clock"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+)
+
+// PluginConfigGetter looks up the configuration for a plugin.
+type PluginConfigGetter interface {
+	// GetPluginConfig returns the configuration for the plugin of kind and name that applies to
+	// items in namespace, or nil if there is none. An empty namespace only matches configuration
+	// that is not scoped to any namespace.
+	GetPluginConfig(kind common.PluginKind, name, namespace string) (map[string]string, error)
+}
+
+// GetValidPluginConfig returns the configuration for a plugin like getter.GetPluginConfig,
+// or an error if it doesn't match the plugin's schema.
+func GetValidPluginConfig(getter PluginConfigGetter, kind common.PluginKind, name, namespace string, schema PluginConfigSchema) (map[string]string, error) {
+	config, err := getter.GetPluginConfig(kind, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidatePluginConfig(config, schema); err != nil {
+		return nil, errors.Wrapf(err, "error validating the configuration of %s plugin %s", kind, name)
+	}
+	return config, nil
+}
+
+// pluginConfigCacheTTL is how long the PluginConfigs and ConfigMaps listed by a
+// pluginConfigGetter are reused, so that plugins called for every item of a restore don't
+// list them for each one.
+const pluginConfigCacheTTL = 30 * time.Second
+
+type pluginConfigGetter struct {
+	client    kbclient.Client
+	namespace string
+	clock     clock.Clock
+
+	lock          sync.Mutex
+	listed        time.Time
+	pluginConfigs []velerov1api.PluginConfig
+	configMaps    []corev1.ConfigMap
+}
+
+// NewPluginConfigGetter returns a PluginConfigGetter that reads PluginConfig resources in
+// namespace, falling back to ConfigMaps labeled velero.io/plugin-config for compatibility
+// with configuration created before PluginConfig existed.
+func NewPluginConfigGetter(client kbclient.Client, namespace string) PluginConfigGetter {
+	return &pluginConfigGetter{
+		client:    client,
+		namespace: namespace,
+		clock:     clock.RealClock{},
+	}
+}
+
+func (g *pluginConfigGetter) GetPluginConfig(kind common.PluginKind, name, namespace string) (map[string]string, error) {
+	pluginConfigs, configMaps, err := g.list()
+	if err != nil {
+		return nil, err
+	}
+
+	var scoped, unscoped []*velerov1api.PluginConfig
+	for i := range pluginConfigs {
+		config := &pluginConfigs[i]
+		if config.Spec.PluginKind != kind.String() || config.Spec.PluginName != name {
+			continue
+		}
+
+		switch {
+		case len(config.Spec.IncludedNamespaces) == 0:
+			unscoped = append(unscoped, config)
+		case namespace != "" && containsNamespace(config.Spec.IncludedNamespaces, namespace):
+			scoped = append(scoped, config)
+		}
+	}
+
+	for _, matches := range [][]*velerov1api.PluginConfig{scoped, unscoped} {
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			// a matching PluginConfig with no data is still a match, so don't return nil
+			if matches[0].Spec.Config == nil {
+				return map[string]string{}, nil
+			}
+			return matches[0].Spec.Config, nil
+		default:
+			var names []string
+			for _, match := range matches {
+				names = append(names, match.Name)
+			}
+			return nil, errors.Errorf("found more than one PluginConfig for %s plugin %s: %v", kind, name, names)
+		}
+	}
+
+	return getConfigMap(configMaps, kind, name)
+}
+
+// list returns the PluginConfigs and the ConfigMaps labeled velero.io/plugin-config in the
+// namespace, listing them again if they were listed more than pluginConfigCacheTTL ago.
+func (g *pluginConfigGetter) list() ([]velerov1api.PluginConfig, []corev1.ConfigMap, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if !g.listed.IsZero() && g.clock.Since(g.listed) < pluginConfigCacheTTL {
+		return g.pluginConfigs, g.configMaps, nil
+	}
+
+	pluginConfigs := new(velerov1api.PluginConfigList)
+	if err := g.client.List(context.TODO(), pluginConfigs, kbclient.InNamespace(g.namespace)); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	configMaps := new(corev1.ConfigMapList)
+	if err := g.client.List(context.TODO(), configMaps, kbclient.InNamespace(g.namespace), kbclient.HasLabels{"velero.io/plugin-config"}); err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	g.pluginConfigs = pluginConfigs.Items
+	g.configMaps = configMaps.Items
+	g.listed = g.clock.Now()
+	return g.pluginConfigs, g.configMaps, nil
+}
+
+// getConfigMap returns the data of the ConfigMap labeled velero.io/plugin-config and <name>=<kind>.
+func getConfigMap(configMaps []corev1.ConfigMap, kind common.PluginKind, name string) (map[string]string, error) {
+	// velero.io/plugin-config: true
+	// velero.io/restic: RestoreItemAction
+	selector, err := labels.Parse(fmt.Sprintf("velero.io/plugin-config,%s=%s", name, kind))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var matches []*corev1.ConfigMap
+	for i := range configMaps {
+		if selector.Matches(labels.Set(configMaps[i].Labels)) {
+			matches = append(matches, &configMaps[i])
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	if len(matches) > 1 {
+		var items []string
+		for _, item := range matches {
+			items = append(items, item.Name)
+		}
+		return nil, errors.Errorf("found more than one ConfigMap matching label selector %q: %v", selector.String(), items)
+	}
+
+	// a matching ConfigMap with no data is still a match, so don't return nil
+	if matches[0].Data == nil {
+		return map[string]string{}, nil
+	}
+	return matches[0].Data, nil
+}
+
+func containsNamespace(namespaces []string, namespace string) bool {
+	for _, ns := range namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
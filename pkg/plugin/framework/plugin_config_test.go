@@ -0,0 +1,195 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestGetPluginConfig(t *testing.T) {
+	const (
+		kind = common.PluginKindRestoreItemAction
+		name = "velero.io/change-storage-class"
+	)
+
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		namespace   string
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			name:     "nothing found returns nil",
+			expected: nil,
+		},
+		{
+			name: "unscoped PluginConfig is returned",
+			objects: []runtime.Object{
+				builder.ForPluginConfig("velero", "storage-class").Plugin(kind.String(), name).Config("foo", "bar").Result(),
+				builder.ForPluginConfig("velero", "other-plugin").Plugin(kind.String(), "velero.io/other").Config("foo", "baz").Result(),
+				builder.ForPluginConfig("velero", "other-kind").Plugin(common.PluginKindBackupItemAction.String(), name).Config("foo", "baz").Result(),
+			},
+			namespace: "ns-1",
+			expected:  map[string]string{"foo": "bar"},
+		},
+		{
+			name: "PluginConfig with no config returns empty map",
+			objects: []runtime.Object{
+				builder.ForPluginConfig("velero", "storage-class").Plugin(kind.String(), name).Result(),
+			},
+			expected: map[string]string{},
+		},
+		{
+			name: "PluginConfig scoped to the namespace takes precedence",
+			objects: []runtime.Object{
+				builder.ForPluginConfig("velero", "unscoped").Plugin(kind.String(), name).Config("foo", "bar").Result(),
+				builder.ForPluginConfig("velero", "scoped").Plugin(kind.String(), name).Config("foo", "baz").IncludedNamespaces("ns-1").Result(),
+			},
+			namespace: "ns-1",
+			expected:  map[string]string{"foo": "baz"},
+		},
+		{
+			name: "PluginConfig scoped to another namespace is ignored",
+			objects: []runtime.Object{
+				builder.ForPluginConfig("velero", "unscoped").Plugin(kind.String(), name).Config("foo", "bar").Result(),
+				builder.ForPluginConfig("velero", "scoped").Plugin(kind.String(), name).Config("foo", "baz").IncludedNamespaces("ns-2").Result(),
+			},
+			namespace: "ns-1",
+			expected:  map[string]string{"foo": "bar"},
+		},
+		{
+			name: "PluginConfig in another namespace is ignored",
+			objects: []runtime.Object{
+				builder.ForPluginConfig("default", "storage-class").Plugin(kind.String(), name).Config("foo", "bar").Result(),
+			},
+			expected: nil,
+		},
+		{
+			name: "more than one matching PluginConfig returns an error",
+			objects: []runtime.Object{
+				builder.ForPluginConfig("velero", "config-1").Plugin(kind.String(), name).Result(),
+				builder.ForPluginConfig("velero", "config-2").Plugin(kind.String(), name).Result(),
+			},
+			expectedErr: "found more than one PluginConfig for RestoreItemAction plugin velero.io/change-storage-class: [config-1 config-2]",
+		},
+		{
+			name: "falls back to a labeled ConfigMap",
+			objects: []runtime.Object{
+				builder.ForConfigMap("velero", "storage-class").
+					ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", name, kind.String())).
+					Data("foo", "bar").
+					Result(),
+			},
+			expected: map[string]string{"foo": "bar"},
+		},
+		{
+			name: "PluginConfig takes precedence over a labeled ConfigMap",
+			objects: []runtime.Object{
+				builder.ForPluginConfig("velero", "storage-class").Plugin(kind.String(), name).Config("foo", "bar").Result(),
+				builder.ForConfigMap("velero", "storage-class").
+					ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", name, kind.String())).
+					Data("foo", "baz").
+					Result(),
+			},
+			expected: map[string]string{"foo": "bar"},
+		},
+		{
+			name: "more than one matching ConfigMap returns an error",
+			objects: []runtime.Object{
+				builder.ForConfigMap("velero", "config-1").ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", name, kind.String())).Result(),
+				builder.ForConfigMap("velero", "config-2").ObjectMeta(builder.WithLabels("velero.io/plugin-config", "", name, kind.String())).Result(),
+			},
+			expectedErr: `found more than one ConfigMap matching label selector "velero.io/change-storage-class=RestoreItemAction,velero.io/plugin-config": [config-1 config-2]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := NewPluginConfigGetter(velerotest.NewFakeControllerRuntimeClient(t, tc.objects...), "velero")
+
+			config, err := getter.GetPluginConfig(kind, name, tc.namespace)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, config)
+		})
+	}
+}
+
+func TestGetPluginConfigIsCached(t *testing.T) {
+	const (
+		kind = common.PluginKindRestoreItemAction
+		name = "velero.io/change-storage-class"
+	)
+
+	client := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForPluginConfig("velero", "storage-class").Plugin(kind.String(), name).Config("foo", "bar").Result(),
+	)
+	fakeClock := clock.NewFakeClock(time.Now())
+	getter := NewPluginConfigGetter(client, "velero").(*pluginConfigGetter)
+	getter.clock = fakeClock
+
+	config, err := getter.GetPluginConfig(kind, name, "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "bar"}, config)
+
+	require.NoError(t, client.Create(context.TODO(), builder.ForPluginConfig("velero", "scoped").Plugin(kind.String(), name).Config("foo", "baz").IncludedNamespaces("ns-1").Result()))
+
+	// the PluginConfigs listed earlier are reused until they're too old
+	config, err = getter.GetPluginConfig(kind, name, "ns-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "bar"}, config)
+
+	fakeClock.Step(pluginConfigCacheTTL)
+	config, err = getter.GetPluginConfig(kind, name, "ns-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "baz"}, config)
+}
+
+func TestGetValidPluginConfig(t *testing.T) {
+	const (
+		kind = common.PluginKindRestoreItemAction
+		name = "velero.io/restic"
+	)
+	schema := PluginConfigSchema{Keys: map[string]func(string) error{"cpuRequest": ValidateQuantityValue}}
+
+	getter := NewPluginConfigGetter(velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForPluginConfig("velero", "restic").Plugin(kind.String(), name).Config("cpuRequest", "100m").Result(),
+		builder.ForPluginConfig("velero", "restic-ns-1").Plugin(kind.String(), name).Config("cpuRequest", "lots").IncludedNamespaces("ns-1").Result(),
+	), "velero")
+
+	config, err := GetValidPluginConfig(getter, kind, name, "ns-2", schema)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cpuRequest": "100m"}, config)
+
+	_, err = GetValidPluginConfig(getter, kind, name, "ns-1", schema)
+	assert.EqualError(t, err, `error validating the configuration of RestoreItemAction plugin velero.io/restic: invalid plugin config: key cpuRequest: "lots" isn't a valid quantity`)
+}
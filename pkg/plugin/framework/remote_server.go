@@ -0,0 +1,125 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	plugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// remoteServerConfig holds the settings for serving plugins over the network, e.g. from a
+// sidecar container or a separate deployment, rather than as a child process of Velero.
+type remoteServerConfig struct {
+	listenAddress string
+	certFile      string
+	keyFile       string
+	clientCAFile  string
+}
+
+// tlsConfig returns the server-side TLS configuration for c, or nil if TLS is not configured.
+// When a client CA is configured, clients must present a certificate signed by it.
+func (c remoteServerConfig) tlsConfig() (*tls.Config, error) {
+	if c.certFile == "" && c.keyFile == "" {
+		if c.clientCAFile != "" {
+			return nil, errors.New("--tls-client-ca-file requires --tls-cert-file and --tls-key-file")
+		}
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading server certificate")
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if c.clientCAFile != "" {
+		caData, err := ioutil.ReadFile(c.clientCAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading client CA file")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, errors.Errorf("no certificates found in client CA file %s", c.clientCAFile)
+		}
+
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// serveRemote registers plugins on a gRPC server listening on config.listenAddress and blocks
+// until the process is asked to terminate.
+func serveRemote(config remoteServerConfig, plugins map[string]plugin.Plugin, log logrus.FieldLogger) error {
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		return err
+	}
+
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else {
+		log.Warn("Serving plugins without TLS")
+	}
+
+	server := grpc.NewServer(opts...)
+
+	for kind, p := range plugins {
+		grpcPlugin, ok := p.(plugin.GRPCPlugin)
+		if !ok {
+			return errors.Errorf("plugin kind %s does not support gRPC", kind)
+		}
+		if err := grpcPlugin.GRPCServer(nil, server); err != nil {
+			return errors.Wrapf(err, "error registering %s plugins", kind)
+		}
+	}
+
+	listener, err := net.Listen("tcp", config.listenAddress)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Infof("Received %s, shutting down plugin server", sig)
+		server.GracefulStop()
+	}()
+
+	log.Infof("Serving plugins on %s", listener.Addr())
+
+	return errors.WithStack(server.Serve(listener))
+}
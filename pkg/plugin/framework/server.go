@@ -94,6 +94,10 @@ type server struct {
 	restoreItemAction *RestoreItemActionPlugin
 	deleteItemAction  *DeleteItemActionPlugin
 	itemSnapshotter   *ItemSnapshotterPlugin
+
+	// remote holds the settings used when the plugins are served over the network
+	// instead of to a parent Velero process.
+	remote remoteServerConfig
 }
 
 // NewServer returns a new Server
@@ -114,6 +118,10 @@ func NewServer() Server {
 
 func (s *server) BindFlags(flags *pflag.FlagSet) Server {
 	flags.Var(s.logLevelFlag, "log-level", fmt.Sprintf("The level at which to log. Valid values are %s.", strings.Join(s.logLevelFlag.AllowedValues(), ", ")))
+	flags.StringVar(&s.remote.listenAddress, "listen-address", "", "The address to serve plugins on over gRPC, for plugins running as a sidecar or a separate deployment. If empty, plugins are served to the Velero process that launched this one.")
+	flags.StringVar(&s.remote.certFile, "tls-cert-file", "", "Path to the PEM-encoded certificate served on --listen-address.")
+	flags.StringVar(&s.remote.keyFile, "tls-key-file", "", "Path to the PEM-encoded private key for --tls-cert-file.")
+	flags.StringVar(&s.remote.clientCAFile, "tls-client-ca-file", "", "Path to the PEM-encoded CA bundle used to verify Velero's client certificate. If empty, client certificates are not required.")
 	s.flagSet = flags
 	s.flagSet.ParseErrorsWhitelist.UnknownFlags = true
 
@@ -224,17 +232,26 @@ func (s *server) Serve() {
 
 	pluginLister := NewPluginLister(pluginIdentifiers...)
 
+	plugins := map[string]plugin.Plugin{
+		string(common.PluginKindBackupItemAction):  s.backupItemAction,
+		string(common.PluginKindVolumeSnapshotter): s.volumeSnapshotter,
+		string(common.PluginKindObjectStore):       s.objectStore,
+		string(common.PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
+		string(common.PluginKindRestoreItemAction): s.restoreItemAction,
+		string(common.PluginKindDeleteItemAction):  s.deleteItemAction,
+		string(common.PluginKindItemSnapshotter):   s.itemSnapshotter,
+	}
+
+	if s.remote.listenAddress != "" {
+		if err := serveRemote(s.remote, plugins, s.log); err != nil {
+			s.log.WithError(err).Fatal("Error serving plugins")
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: Handshake(),
-		Plugins: map[string]plugin.Plugin{
-			string(common.PluginKindBackupItemAction):  s.backupItemAction,
-			string(common.PluginKindVolumeSnapshotter): s.volumeSnapshotter,
-			string(common.PluginKindObjectStore):       s.objectStore,
-			string(common.PluginKindPluginLister):      NewPluginListerPlugin(pluginLister),
-			string(common.PluginKindRestoreItemAction): s.restoreItemAction,
-			string(common.PluginKindDeleteItemAction):  s.deleteItemAction,
-			string(common.PluginKindItemSnapshotter):   s.itemSnapshotter,
-		},
-		GRPCServer: plugin.DefaultGRPCServer,
+		Plugins:         plugins,
+		GRPCServer:      plugin.DefaultGRPCServer,
 	})
 }
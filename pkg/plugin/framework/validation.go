@@ -17,8 +17,14 @@ limitations under the License.
 package framework
 
 import (
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // ValidateObjectStoreConfigKeys ensures that an object store's config
@@ -52,3 +58,76 @@ func validateConfigKeys(config map[string]string, validKeys ...string) error {
 
 	return nil
 }
+
+// PluginConfigSchema describes the configuration data a plugin accepts in its PluginConfig.
+type PluginConfigSchema struct {
+	// Keys maps the keys the plugin accepts to the validation of their values, which is
+	// nil for values that can be any string.
+	Keys map[string]func(value string) error
+
+	// OtherKeys validates the keys that aren't in Keys, and their values. If it's nil,
+	// other keys are invalid.
+	OtherKeys func(key, value string) error
+}
+
+// ValidatePluginConfig ensures that a plugin's configuration data matches its schema,
+// returning an error that lists every invalid key.
+func ValidatePluginConfig(config map[string]string, schema PluginConfigSchema) error {
+	var problems []string
+	for key, value := range config {
+		validate, ok := schema.Keys[key]
+		switch {
+		case ok && validate != nil:
+			if err := validate(value); err != nil {
+				problems = append(problems, errors.Wrapf(err, "key %s", key).Error())
+			}
+		case ok:
+		case schema.OtherKeys != nil:
+			if err := schema.OtherKeys(key, value); err != nil {
+				problems = append(problems, errors.Wrapf(err, "key %s", key).Error())
+			}
+		default:
+			problems = append(problems, errors.Errorf("key %s isn't supported", key).Error())
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return errors.Errorf("invalid plugin config: %s", strings.Join(problems, "; "))
+}
+
+// ValidateQuantityValue ensures that a plugin config value is a resource quantity, e.g. 100m
+// or 128Mi.
+func ValidateQuantityValue(value string) error {
+	if _, err := resource.ParseQuantity(value); err != nil {
+		return errors.Errorf("%q isn't a valid quantity", value)
+	}
+	return nil
+}
+
+// ValidateIntegerValue ensures that a plugin config value is an integer.
+func ValidateIntegerValue(value string) error {
+	if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+		return errors.Errorf("%q isn't a valid integer", value)
+	}
+	return nil
+}
+
+// ValidateBooleanValue ensures that a plugin config value is a boolean.
+func ValidateBooleanValue(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return errors.Errorf("%q isn't a valid boolean", value)
+	}
+	return nil
+}
+
+// ValidateNameValue ensures that a plugin config value is the name of a Kubernetes resource,
+// e.g. of a StorageClass or a Node.
+func ValidateNameValue(value string) error {
+	if problems := validation.IsDNS1123Subdomain(value); len(problems) > 0 {
+		return errors.Errorf("%q isn't a valid name: %s", value, strings.Join(problems, ", "))
+	}
+	return nil
+}
@@ -35,3 +35,33 @@ func TestValidateConfigKeys(t *testing.T) {
 	assert.NoError(t, ValidateObjectStoreConfigKeys(map[string]string{"bucket": "foo"}))
 	assert.Error(t, ValidateVolumeSnapshotterConfigKeys(map[string]string{"bucket": "foo"}))
 }
+
+func TestValidatePluginConfig(t *testing.T) {
+	schema := PluginConfigSchema{
+		Keys: map[string]func(string) error{
+			"image":      nil,
+			"cpuRequest": ValidateQuantityValue,
+			"runAsUser":  ValidateIntegerValue,
+			"privileged": ValidateBooleanValue,
+		},
+	}
+
+	assert.NoError(t, ValidatePluginConfig(nil, schema))
+	assert.NoError(t, ValidatePluginConfig(map[string]string{"image": "any:thing", "cpuRequest": "100m", "runAsUser": "1000", "privileged": "false"}, schema))
+	assert.EqualError(t,
+		ValidatePluginConfig(map[string]string{"cpuRequest": "lots", "runAsUser": "root", "privileged": "maybe", "other": "foo"}, schema),
+		`invalid plugin config: key cpuRequest: "lots" isn't a valid quantity; key other isn't supported; key privileged: "maybe" isn't a valid boolean; key runAsUser: "root" isn't a valid integer`,
+	)
+
+	mapping := PluginConfigSchema{
+		OtherKeys: func(key, value string) error {
+			if err := ValidateNameValue(key); err != nil {
+				return err
+			}
+			return ValidateNameValue(value)
+		},
+	}
+	assert.NoError(t, ValidatePluginConfig(map[string]string{"gp2": "gp3"}, mapping))
+	assert.Error(t, ValidatePluginConfig(map[string]string{"gp2": "GP3"}, mapping))
+	assert.Error(t, ValidatePluginConfig(map[string]string{"gp_2": "gp3"}, mapping))
+}
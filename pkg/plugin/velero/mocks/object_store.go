@@ -5,6 +5,7 @@ package mocks
 import io "io"
 import mock "github.com/stretchr/testify/mock"
 import time "time"
+import velero "github.com/vmware-tanzu/velero/pkg/plugin/velero"
 
 // ObjectStore is an autogenerated mock type for the ObjectStore type
 type ObjectStore struct {
@@ -69,6 +70,27 @@ func (_m *ObjectStore) GetObject(bucket string, key string) (io.ReadCloser, erro
 	return r0, r1
 }
 
+// GetObjectRetention provides a mock function with given fields: bucket, key
+func (_m *ObjectStore) GetObjectRetention(bucket string, key string) (velero.ObjectRetention, error) {
+	ret := _m.Called(bucket, key)
+
+	var r0 velero.ObjectRetention
+	if rf, ok := ret.Get(0).(func(string, string) velero.ObjectRetention); ok {
+		r0 = rf(bucket, key)
+	} else {
+		r0 = ret.Get(0).(velero.ObjectRetention)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(bucket, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Init provides a mock function with given fields: config
 func (_m *ObjectStore) Init(config map[string]string) error {
 	ret := _m.Called(config)
@@ -129,6 +151,29 @@ func (_m *ObjectStore) ListObjects(bucket string, prefix string) ([]string, erro
 	return r0, r1
 }
 
+// ListObjectSizes provides a mock function with given fields: bucket, prefix
+func (_m *ObjectStore) ListObjectSizes(bucket string, prefix string) (map[string]int64, error) {
+	ret := _m.Called(bucket, prefix)
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(string, string) map[string]int64); ok {
+		r0 = rf(bucket, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(bucket, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ObjectExists provides a mock function with given fields: bucket, key
 func (_m *ObjectStore) ObjectExists(bucket string, key string) (bool, error) {
 	ret := _m.Called(bucket, key)
@@ -163,3 +208,17 @@ func (_m *ObjectStore) PutObject(bucket string, key string, body io.Reader) erro
 
 	return r0
 }
+
+// PutObjectRetention provides a mock function with given fields: bucket, key, retention
+func (_m *ObjectStore) PutObjectRetention(bucket string, key string, retention velero.ObjectRetention) error {
+	ret := _m.Called(bucket, key, retention)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, velero.ObjectRetention) error); ok {
+		r0 = rf(bucket, key, retention)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package velero
 
 import (
+	"errors"
 	"io"
 	"time"
 )
@@ -63,3 +64,59 @@ type ObjectStore interface {
 	// CreateSignedURL creates a pre-signed URL for the given bucket and key that expires after ttl.
 	CreateSignedURL(bucket, key string, ttl time.Duration) (string, error)
 }
+
+// ErrObjectLockNotSupported is returned by ObjectLocker methods when the object store
+// doesn't support object lock.
+var ErrObjectLockNotSupported = errors.New("object store doesn't support object lock")
+
+const (
+	// ObjectRetentionModeGovernance protects objects from being deleted or overwritten,
+	// except by users with special permissions.
+	ObjectRetentionModeGovernance = "Governance"
+
+	// ObjectRetentionModeCompliance protects objects from being deleted or overwritten
+	// by any user.
+	ObjectRetentionModeCompliance = "Compliance"
+)
+
+// ObjectRetention is the protection of an object from being deleted or overwritten.
+type ObjectRetention struct {
+	// Mode is the retention mode, i.e. ObjectRetentionModeGovernance or
+	// ObjectRetentionModeCompliance.
+	Mode string
+
+	// RetainUntil is the time until which the object is protected. A zero time
+	// means the object has no retention.
+	RetainUntil time.Time
+
+	// LegalHold is true if the object is protected by a legal hold, regardless of
+	// its retention, until the hold is removed.
+	LegalHold bool
+}
+
+// ObjectLocker is an optional interface that ObjectStores implement when the object
+// storage can protect objects from being deleted or overwritten, e.g. with S3 Object
+// Lock. Its methods return ErrObjectLockNotSupported when the ObjectStore doesn't
+// implement it.
+type ObjectLocker interface {
+	// PutObjectRetention protects the object with the given key in the object storage
+	// bucket according to retention. The retention of an object can only be extended.
+	PutObjectRetention(bucket, key string, retention ObjectRetention) error
+
+	// GetObjectRetention returns the protection of the object with the given key in the
+	// object storage bucket, or a zero ObjectRetention if it isn't protected.
+	GetObjectRetention(bucket, key string) (ObjectRetention, error)
+}
+
+// ErrObjectSizesNotSupported is returned by ObjectSizeLister methods when the object store
+// can't list the sizes of objects.
+var ErrObjectSizesNotSupported = errors.New("object store doesn't support listing object sizes")
+
+// ObjectSizeLister is an optional interface that ObjectStores implement when they can list
+// the sizes of objects without reading them. Its methods return ErrObjectSizesNotSupported
+// when the ObjectStore doesn't implement it.
+type ObjectSizeLister interface {
+	// ListObjectSizes gets the sizes of all objects in the object storage bucket
+	// that have the same prefix, by key.
+	ListObjectSizes(bucket, prefix string) (map[string]int64, error)
+}
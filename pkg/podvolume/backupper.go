@@ -20,12 +20,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -38,6 +40,9 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
+// nodeAgentPollInterval is how often to check whether the node agent on a pod's node is running.
+const nodeAgentPollInterval = 5 * time.Second
+
 // Backupper can execute restic backups of volumes in a pod.
 type Backupper interface {
 	// BackupPodVolumes backs up all specified volumes in a pod.
@@ -53,6 +58,8 @@ type backupper struct {
 	pvClient     corev1client.PersistentVolumesGetter
 	podClient    corev1client.PodsGetter
 	uploaderType string
+	// nodeAgentWaitTimeout is how long to wait for the node agent on a pod's node to be running.
+	nodeAgentWaitTimeout time.Duration
 
 	results     map[string]chan *velerov1api.PodVolumeBackup
 	resultsLock sync.Mutex
@@ -68,17 +75,19 @@ func newBackupper(
 	pvClient corev1client.PersistentVolumesGetter,
 	podClient corev1client.PodsGetter,
 	uploaderType string,
+	nodeAgentWaitTimeout time.Duration,
 	log logrus.FieldLogger,
 ) *backupper {
 	b := &backupper{
-		ctx:          ctx,
-		repoLocker:   repoLocker,
-		repoEnsurer:  repoEnsurer,
-		veleroClient: veleroClient,
-		pvcClient:    pvcClient,
-		pvClient:     pvClient,
-		podClient:    podClient,
-		uploaderType: uploaderType,
+		ctx:                  ctx,
+		repoLocker:           repoLocker,
+		repoEnsurer:          repoEnsurer,
+		veleroClient:         veleroClient,
+		pvcClient:            pvcClient,
+		pvClient:             pvClient,
+		podClient:            podClient,
+		uploaderType:         uploaderType,
+		nodeAgentWaitTimeout: nodeAgentWaitTimeout,
 
 		results: make(map[string]chan *velerov1api.PodVolumeBackup),
 	}
@@ -88,7 +97,7 @@ func newBackupper(
 			UpdateFunc: func(_, obj interface{}) {
 				pvb := obj.(*velerov1api.PodVolumeBackup)
 
-				if pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted || pvb.Status.Phase == velerov1api.PodVolumeBackupPhaseFailed {
+				if isPVBDone(pvb) {
 					b.resultsLock.Lock()
 					defer b.resultsLock.Unlock()
 
@@ -115,7 +124,7 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, nil
 	}
 
-	repositoryType := getRepositoryType(b.uploaderType)
+	repositoryType := repository.GetRepositoryType(b.uploaderType)
 	if repositoryType == "" {
 		err := errors.Errorf("empty repository type, uploader %s", b.uploaderType)
 		return nil, []error{err}
@@ -131,7 +140,7 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, []error{err}
 	}
 
-	err = nodeagent.IsRunningInNode(b.ctx, backup.Namespace, pod.Spec.NodeName, b.podClient)
+	err = b.waitForNodeAgent(backup.Namespace, pod.Spec.NodeName, log)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -213,7 +222,11 @@ ForEachVolume:
 	for i, count := 0, numVolumeSnapshots; i < count; i++ {
 		select {
 		case <-b.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			if b.ctx.Err() == context.Canceled {
+				errs = append(errs, errors.New("backup was canceled while waiting for all PodVolumeBackups to complete"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
 			switch res.Status.Phase {
@@ -222,6 +235,8 @@ ForEachVolume:
 			case velerov1api.PodVolumeBackupPhaseFailed:
 				errs = append(errs, errors.Errorf("pod volume backup failed: %s", res.Status.Message))
 				podVolumeBackups = append(podVolumeBackups, res)
+			case velerov1api.PodVolumeBackupPhaseCanceled:
+				errs = append(errs, errors.Errorf("pod volume backup canceled: %s", res.Status.Message))
 			}
 		}
 	}
@@ -233,6 +248,46 @@ ForEachVolume:
 	return podVolumeBackups, errs
 }
 
+// waitForNodeAgent waits up to b.nodeAgentWaitTimeout for the node agent pod on nodeName to be
+// running, e.g. while it is being restarted or rolled out, and returns the last error seen if
+// it isn't. A timeout of 0 doesn't wait, and the wait ends when the backup is canceled.
+func (b *backupper) waitForNodeAgent(namespace, nodeName string, log logrus.FieldLogger) error {
+	if b.nodeAgentWaitTimeout <= 0 {
+		return nodeagent.IsRunningInNode(b.ctx, namespace, nodeName, b.podClient)
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, b.nodeAgentWaitTimeout)
+	defer cancel()
+
+	var lastErr error
+	err := wait.PollImmediateUntil(nodeAgentPollInterval, func() (bool, error) {
+		if lastErr = nodeagent.IsRunningInNode(ctx, namespace, nodeName, b.podClient); lastErr != nil {
+			log.WithError(lastErr).Debug("Waiting for node agent to be running")
+			return false, nil
+		}
+		return true, nil
+	}, ctx.Done())
+	if err == nil {
+		return nil
+	}
+	if b.ctx.Err() != nil {
+		return errors.Wrap(b.ctx.Err(), "backup was canceled while waiting for node agent")
+	}
+	if lastErr != nil {
+		return errors.Wrapf(lastErr, "timed out after %s waiting for node agent", b.nodeAgentWaitTimeout)
+	}
+	return errors.WithStack(err)
+}
+
+// isPVBDone returns true if pvb has finished, successfully or not.
+func isPVBDone(pvb *velerov1api.PodVolumeBackup) bool {
+	switch pvb.Status.Phase {
+	case velerov1api.PodVolumeBackupPhaseCompleted, velerov1api.PodVolumeBackupPhaseFailed, velerov1api.PodVolumeBackupPhaseCanceled:
+		return true
+	}
+	return false
+}
+
 type pvcGetter interface {
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1api.PersistentVolumeClaim, error)
 }
@@ -19,6 +19,7 @@ package podvolume
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -45,28 +46,31 @@ func NewBackupperFactory(repoLocker *repository.RepoLocker,
 	pvClient corev1client.PersistentVolumesGetter,
 	podClient corev1client.PodsGetter,
 	repoInformerSynced cache.InformerSynced,
+	nodeAgentWaitTimeout time.Duration,
 	log logrus.FieldLogger) BackupperFactory {
 	return &backupperFactory{
-		repoLocker:         repoLocker,
-		repoEnsurer:        repoEnsurer,
-		veleroClient:       veleroClient,
-		pvcClient:          pvcClient,
-		pvClient:           pvClient,
-		podClient:          podClient,
-		repoInformerSynced: repoInformerSynced,
-		log:                log,
+		repoLocker:           repoLocker,
+		repoEnsurer:          repoEnsurer,
+		veleroClient:         veleroClient,
+		pvcClient:            pvcClient,
+		pvClient:             pvClient,
+		podClient:            podClient,
+		repoInformerSynced:   repoInformerSynced,
+		nodeAgentWaitTimeout: nodeAgentWaitTimeout,
+		log:                  log,
 	}
 }
 
 type backupperFactory struct {
-	repoLocker         *repository.RepoLocker
-	repoEnsurer        *repository.RepositoryEnsurer
-	veleroClient       clientset.Interface
-	pvcClient          corev1client.PersistentVolumeClaimsGetter
-	pvClient           corev1client.PersistentVolumesGetter
-	podClient          corev1client.PodsGetter
-	repoInformerSynced cache.InformerSynced
-	log                logrus.FieldLogger
+	repoLocker           *repository.RepoLocker
+	repoEnsurer          *repository.RepositoryEnsurer
+	veleroClient         clientset.Interface
+	pvcClient            corev1client.PersistentVolumeClaimsGetter
+	pvClient             corev1client.PersistentVolumesGetter
+	podClient            corev1client.PodsGetter
+	repoInformerSynced   cache.InformerSynced
+	nodeAgentWaitTimeout time.Duration
+	log                  logrus.FieldLogger
 }
 
 func (bf *backupperFactory) NewBackupper(ctx context.Context, backup *velerov1api.Backup, uploaderType string) (Backupper, error) {
@@ -80,7 +84,7 @@ func (bf *backupperFactory) NewBackupper(ctx context.Context, backup *velerov1ap
 		},
 	)
 
-	b := newBackupper(ctx, bf.repoLocker, bf.repoEnsurer, informer, bf.veleroClient, bf.pvcClient, bf.pvClient, bf.podClient, uploaderType, bf.log)
+	b := newBackupper(ctx, bf.repoLocker, bf.repoEnsurer, informer, bf.veleroClient, bf.pvcClient, bf.pvClient, bf.podClient, uploaderType, bf.nodeAgentWaitTimeout, bf.log)
 
 	go informer.Run(ctx.Done())
 	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced, bf.repoInformerSynced) {
@@ -19,11 +19,17 @@ package podvolume
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func TestIsHostPathVolume(t *testing.T) {
@@ -139,3 +145,40 @@ func (g *fakePVGetter) Get(ctx context.Context, name string, opts metav1.GetOpti
 
 	return nil, errors.New("item not found")
 }
+
+func TestWaitForNodeAgent(t *testing.T) {
+	runningAgent := builder.ForPod("velero", "restic-1").ObjectMeta(builder.WithLabels("name", "restic")).NodeName("node-1").Result()
+	runningAgent.Status.Phase = corev1api.PodRunning
+
+	newBackupperWithTimeout := func(ctx context.Context, timeout time.Duration, pods ...*corev1api.Pod) *backupper {
+		client := fake.NewSimpleClientset()
+		for _, pod := range pods {
+			_, err := client.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+			require.NoError(t, err)
+		}
+		return &backupper{ctx: ctx, podClient: client.CoreV1(), nodeAgentWaitTimeout: timeout}
+	}
+
+	// a running node agent is found without waiting
+	b := newBackupperWithTimeout(context.Background(), time.Hour, runningAgent)
+	assert.NoError(t, b.waitForNodeAgent("velero", "node-1", velerotest.NewLogger()))
+
+	// a timeout of 0 doesn't wait
+	b = newBackupperWithTimeout(context.Background(), 0)
+	err := b.waitForNodeAgent("velero", "node-1", velerotest.NewLogger())
+	assert.EqualError(t, err, "daemonset pod not found in running state in node node-1")
+
+	// the wait ends at the timeout
+	b = newBackupperWithTimeout(context.Background(), 10*time.Millisecond)
+	err = b.waitForNodeAgent("velero", "node-1", velerotest.NewLogger())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out after 10ms waiting for node agent")
+
+	// the wait ends when the backup is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	b = newBackupperWithTimeout(ctx, time.Hour)
+	err = b.waitForNodeAgent("velero", "node-1", velerotest.NewLogger())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup was canceled while waiting for node agent")
+}
@@ -84,7 +84,7 @@ func newRestorer(
 			UpdateFunc: func(_, obj interface{}) {
 				pvr := obj.(*velerov1api.PodVolumeRestore)
 
-				if pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseCompleted || pvr.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed {
+				if isPVRDone(pvr) {
 					r.resultsLock.Lock()
 					defer r.resultsLock.Unlock()
 
@@ -165,11 +165,18 @@ ForEachVolume:
 	for i := 0; i < numRestores; i++ {
 		select {
 		case <-r.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			if r.ctx.Err() == context.Canceled {
+				errs = append(errs, errors.New("restore was canceled while waiting for all PodVolumeRestores to complete"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
-			if res.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed {
+			switch res.Status.Phase {
+			case velerov1api.PodVolumeRestorePhaseFailed:
 				errs = append(errs, errors.Errorf("pod volume restore failed: %s", res.Status.Message))
+			case velerov1api.PodVolumeRestorePhaseCanceled:
+				errs = append(errs, errors.Errorf("pod volume restore canceled: %s", res.Status.Message))
 			}
 		}
 	}
@@ -181,6 +188,15 @@ ForEachVolume:
 	return errs
 }
 
+// isPVRDone returns true if pvr has finished, successfully or not.
+func isPVRDone(pvr *velerov1api.PodVolumeRestore) bool {
+	switch pvr.Status.Phase {
+	case velerov1api.PodVolumeRestorePhaseCompleted, velerov1api.PodVolumeRestorePhaseFailed, velerov1api.PodVolumeRestorePhaseCanceled:
+		return true
+	}
+	return false
+}
+
 func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backupLocation, volume, snapshot, repoIdentifier, uploaderType string, pvc *corev1api.PersistentVolumeClaim) *velerov1api.PodVolumeRestore {
 	pvr := &velerov1api.PodVolumeRestore{
 		ObjectMeta: metav1.ObjectMeta{
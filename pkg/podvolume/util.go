@@ -75,12 +75,12 @@ func GetVolumeBackupsForPod(podVolumeBackups []*velerov1api.PodVolumeBackup, pod
 
 // GetPvbRepositoryType returns the repositoryType according to the PVB information
 func GetPvbRepositoryType(pvb *velerov1api.PodVolumeBackup) string {
-	return getRepositoryType(pvb.Spec.UploaderType)
+	return repository.GetRepositoryType(pvb.Spec.UploaderType)
 }
 
 // GetPvrRepositoryType returns the repositoryType according to the PVR information
 func GetPvrRepositoryType(pvr *velerov1api.PodVolumeRestore) string {
-	return getRepositoryType(pvr.Spec.UploaderType)
+	return repository.GetRepositoryType(pvr.Spec.UploaderType)
 }
 
 // getVolumeBackupInfoForPod returns a map, of volume name -> VolumeBackupInfo,
@@ -109,7 +109,7 @@ func getVolumeBackupInfoForPod(podVolumeBackups []*velerov1api.PodVolumeBackup,
 		volumes[pvb.Spec.Volume] = volumeBackupInfo{
 			snapshotID:     pvb.Status.SnapshotID,
 			uploaderType:   getUploaderTypeOrDefault(pvb.Spec.UploaderType),
-			repositoryType: getRepositoryType(pvb.Spec.UploaderType),
+			repositoryType: repository.GetRepositoryType(pvb.Spec.UploaderType),
 		}
 	}
 
@@ -141,7 +141,7 @@ func GetSnapshotIdentifier(podVolumeBackups *velerov1api.PodVolumeBackupList) []
 			VolumeNamespace:       item.Spec.Pod.Namespace,
 			BackupStorageLocation: item.Spec.BackupStorageLocation,
 			SnapshotID:            item.Status.SnapshotID,
-			RepositoryType:        getRepositoryType(item.Spec.UploaderType),
+			RepositoryType:        repository.GetRepositoryType(item.Spec.UploaderType),
 		})
 	}
 
@@ -156,26 +156,6 @@ func getUploaderTypeOrDefault(uploaderType string) string {
 	}
 }
 
-// getRepositoryType returns the hardcode repositoryType for different backup methods - Restic or Kopia,uploaderType
-// indicates the method.
-// For Restic backup method, it is always hardcode to BackupRepositoryTypeRestic, never changed.
-// For Kopia backup method, this means we hardcode repositoryType as BackupRepositoryTypeKopia for Unified Repo,
-// at present (Kopia backup method is using Unified Repo). However, it doesn't mean we could deduce repositoryType
-// from uploaderType for Unified Repo.
-// TODO: post v1.10, refactor this function for Kopia backup method. In future, when we have multiple implementations of
-// Unified Repo (besides Kopia), we will add the repositoryType to BSL, because by then, we are not able to hardcode
-// the repositoryType to BackupRepositoryTypeKopia for Unified Repo.
-func getRepositoryType(uploaderType string) string {
-	switch uploaderType {
-	case "", uploader.ResticType:
-		return velerov1api.BackupRepositoryTypeRestic
-	case uploader.KopiaType:
-		return velerov1api.BackupRepositoryTypeKopia
-	default:
-		return ""
-	}
-}
-
 func isPVBMatchPod(pvb *velerov1api.PodVolumeBackup, podName string, namespace string) bool {
 	return podName == pvb.Spec.Pod.Name && namespace == pvb.Spec.Pod.Namespace
 }
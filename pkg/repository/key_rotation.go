@@ -0,0 +1,251 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/repository/keys"
+)
+
+// RepositoryJobKeyRotation is the value of the velero.io/repository-job label of key rotation jobs.
+const RepositoryJobKeyRotation = "key-rotation"
+
+// KeyRotationResult is the result of the rotation of a backup repository's key, which the key
+// rotation job reports in the termination message of its container.
+type KeyRotationResult struct {
+	// KeySecret is the name of the Secret that holds the repository's new key.
+	KeySecret string `json:"keySecret,omitempty"`
+
+	// KeyGeneration is the generation of the repository's new key.
+	KeyGeneration int `json:"keyGeneration,omitempty"`
+
+	// Error is the error the key rotation failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// PrepareRepoWithOwnKey connects to repo, or initializes it with its own key. A repository that
+// exists already keeps the key it's encrypted with, which is the one shared by all the
+// repositories unless it got its own key before its BackupRepository was recreated.
+func PrepareRepoWithOwnKey(ctx context.Context, cli client.Client, repoManager Manager, repo *velerov1api.BackupRepository, log logrus.FieldLogger) error {
+	if repo.Spec.KeySecret != "" {
+		return repoManager.PrepareRepo(repo)
+	}
+
+	secretName := keys.RepoKeySecretName(repo)
+	secret := &corev1api.Secret{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: secretName}, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error getting secret %s", secretName)
+	}
+	if err == nil {
+		if !keys.HasRepoKey(secret) {
+			// the rotation of the shared key to the repository's own was interrupted
+			log.Warnf("Secret %s doesn't hold a repository key yet, using the shared key", secretName)
+			return repoManager.PrepareRepo(repo)
+		}
+		log.WithField("secret", secretName).Info("Using the repository's existing key")
+		if err := setKeySecret(ctx, cli, repo, secretName); err != nil {
+			return err
+		}
+		return repoManager.PrepareRepo(repo)
+	}
+
+	if err := repoManager.ConnectToRepo(repo); err == nil {
+		log.Info("Repository exists already, using the shared key it's encrypted with")
+		return nil
+	}
+
+	password, err := keys.GenerateRepoKey()
+	if err != nil {
+		return err
+	}
+	secret = keys.NewRepoKeySecret(repo)
+	keys.SetRepoKey(secret, password)
+	if err := cli.Create(ctx, secret); err != nil {
+		return errors.Wrapf(err, "error creating secret %s", secretName)
+	}
+	if err := setKeySecret(ctx, cli, repo, secretName); err != nil {
+		return err
+	}
+
+	if err := repoManager.PrepareRepo(repo); err != nil {
+		// the repository may exist but be unreachable, so it isn't given a key it may not be
+		// encrypted with
+		if revertErr := setKeySecret(ctx, cli, repo, ""); revertErr != nil {
+			log.WithError(revertErr).Warn("Error reverting the repository to the shared key")
+			return err
+		}
+		if deleteErr := cli.Delete(ctx, secret); client.IgnoreNotFound(deleteErr) != nil {
+			log.WithError(deleteErr).Warnf("Error deleting secret %s", secretName)
+		}
+		return err
+	}
+
+	log.WithField("secret", secretName).Info("Initialized repository with its own key")
+	return nil
+}
+
+// RepoKeyGeneration returns the generation of the key repo is encrypted with.
+func RepoKeyGeneration(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository) (int, error) {
+	if repo.Spec.KeySecret == "" {
+		return 1, nil
+	}
+
+	secret := &corev1api.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: repo.Spec.KeySecret}, secret); err != nil {
+		return 0, errors.Wrapf(err, "error getting secret %s", repo.Spec.KeySecret)
+	}
+	return keys.KeyGeneration(secret), nil
+}
+
+// RotateKey changes the password of repo to a new random one, which becomes the key in the
+// repository's own key Secret. A repository that's encrypted with the key shared by all the
+// repositories gets its own key Secret.
+func RotateKey(ctx context.Context, cli client.Client, repoManager Manager, repo *velerov1api.BackupRepository, log logrus.FieldLogger) KeyRotationResult {
+	secretName := repo.Spec.KeySecret
+	if secretName == "" {
+		secretName = keys.RepoKeySecretName(repo)
+	}
+	result := KeyRotationResult{KeySecret: secretName}
+
+	secret := &corev1api.Secret{}
+	exists := true
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: secretName}, secret); err != nil {
+		if !apierrors.IsNotFound(err) || repo.Spec.KeySecret != "" {
+			result.Error = errors.Wrapf(err, "error getting secret %s", secretName).Error()
+			return result
+		}
+		secret = keys.NewRepoKeySecret(repo)
+		exists = false
+	}
+
+	// Connecting sets up the local configuration the repository is opened with.
+	if err := repoManager.ConnectToRepo(repo); err != nil {
+		err = errors.Wrap(err, "error connecting to repository")
+		if keys.PendingRepoKey(secret) != "" {
+			err = errors.Wrapf(err, "an interrupted key rotation may have changed the repository's password to the %s key of secret %s", keys.PendingCredentialsKey, secretName)
+		}
+		result.Error = err.Error()
+		return result
+	}
+
+	password := keys.PendingRepoKey(secret)
+	if password != "" {
+		log.Info("Resuming an interrupted key rotation")
+	} else {
+		var err error
+		if password, err = keys.GenerateRepoKey(); err != nil {
+			result.Error = err.Error()
+			return result
+		}
+
+		// the new password is stored before it's set, so that it isn't lost if the rotation
+		// is interrupted
+		keys.SetPendingRepoKey(secret, password)
+		if exists {
+			err = cli.Update(ctx, secret)
+		} else {
+			err = cli.Create(ctx, secret)
+		}
+		if err != nil {
+			result.Error = errors.Wrapf(err, "error storing new key in secret %s", secretName).Error()
+			return result
+		}
+	}
+
+	log.Info("Changing repository password")
+	if err := repoManager.ChangePassword(repo, password); err != nil {
+		result.Error = errors.Wrap(err, "error changing repository password").Error()
+		return result
+	}
+
+	keys.PromotePendingRepoKey(secret)
+	if err := cli.Update(ctx, secret); err != nil {
+		result.Error = errors.Wrapf(err, "error updating secret %s, the repository's password was changed to its %s key", secretName, keys.PendingCredentialsKey).Error()
+		return result
+	}
+	result.KeyGeneration = keys.KeyGeneration(secret)
+
+	if err := setKeySecret(ctx, cli, repo, secretName); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	return result
+}
+
+// setKeySecret sets the name of the Secret with the key of repo.
+func setKeySecret(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, secretName string) error {
+	if repo.Spec.KeySecret == secretName {
+		return nil
+	}
+
+	original := repo.DeepCopy()
+	repo.Spec.KeySecret = secretName
+	if err := cli.Patch(ctx, repo, client.MergeFrom(original)); err != nil {
+		return errors.Wrap(err, "error patching repository key secret")
+	}
+	return nil
+}
+
+// BuildKeyRotationJob returns a job that rotates the key of repo.
+func BuildKeyRotationJob(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, config MaintenanceJobConfig) (*batchv1api.Job, error) {
+	return buildRepositoryJob(ctx, cli, repo, config, RepositoryJobKeyRotation, "rekey")
+}
+
+// GetKeyRotationResult returns the result of a finished key rotation job, as reported in the
+// termination message of its pod.
+func GetKeyRotationResult(ctx context.Context, cli client.Client, job *batchv1api.Job) KeyRotationResult {
+	var failure string
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1api.JobFailed && condition.Status == corev1api.ConditionTrue {
+			failure = condition.Message
+			if failure == "" {
+				failure = "key rotation job failed"
+			}
+		}
+	}
+
+	terminated, err := getJobTermination(ctx, cli, job)
+	if err != nil {
+		return KeyRotationResult{Error: appendMessage(failure, err.Error())}
+	}
+
+	result := KeyRotationResult{}
+	if err := json.Unmarshal([]byte(terminated.Message), &result); err != nil {
+		// the job didn't get to report its result, so the message is the end of its log
+		return KeyRotationResult{Error: appendMessage(failure, terminated.Message)}
+	}
+	if result.Error == "" && failure != "" {
+		result.Error = failure
+	}
+	if result.Error == "" && result.KeyGeneration == 0 {
+		result.Error = fmt.Sprintf("key rotation job %s didn't report the generation of the new key", job.Name)
+	}
+	return result
+}
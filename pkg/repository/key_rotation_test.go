@@ -0,0 +1,330 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/repository/keys"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newKeyTestRepo(keySecret string) *velerov1api.BackupRepository {
+	repo := newMaintenanceTestRepo()
+	repo.Spec = velerov1api.BackupRepositorySpec{
+		VolumeNamespace:       "ns-1",
+		BackupStorageLocation: "default",
+		RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+		KeySecret:             keySecret,
+	}
+	return repo
+}
+
+func newKeyTestSecret(repo *velerov1api.BackupRepository, key, pendingKey string, generation string) *corev1api.Secret {
+	secret := keys.NewRepoKeySecret(repo)
+	if key != "" {
+		keys.SetRepoKey(secret, key)
+	}
+	if pendingKey != "" {
+		keys.SetPendingRepoKey(secret, pendingKey)
+	}
+	if generation != "" {
+		secret.Annotations = map[string]string{keys.KeyGenerationAnnotation: generation}
+	}
+	return secret
+}
+
+func getKeyTestSecret(t *testing.T, cli client.Client, name string) *corev1api.Secret {
+	t.Helper()
+	secret := &corev1api.Secret{}
+	require.NoError(t, cli.Get(context.Background(), client.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, secret))
+	return secret
+}
+
+func TestPrepareRepoWithOwnKey(t *testing.T) {
+	secretName := keys.RepoKeySecretName(newKeyTestRepo(""))
+
+	tests := []struct {
+		name              string
+		keySecret         string
+		secret            *corev1api.Secret
+		manager           *fakeMaintenanceManager
+		expectedErr       string
+		expectedKeySecret string
+		expectSecret      bool
+	}{
+		{
+			name:              "repository with its own key",
+			keySecret:         "custom-key",
+			manager:           &fakeMaintenanceManager{},
+			expectedKeySecret: "custom-key",
+		},
+		{
+			name:              "recreated repository gets its existing key",
+			secret:            newKeyTestSecret(newKeyTestRepo(""), "existing-key", "", "3"),
+			manager:           &fakeMaintenanceManager{},
+			expectedKeySecret: secretName,
+			expectSecret:      true,
+		},
+		{
+			name:         "interrupted rotation from the shared key keeps the shared key",
+			secret:       newKeyTestSecret(newKeyTestRepo(""), "", "new-key", ""),
+			manager:      &fakeMaintenanceManager{},
+			expectSecret: true,
+		},
+		{
+			name:    "existing repository keeps the shared key",
+			manager: &fakeMaintenanceManager{},
+		},
+		{
+			name:              "new repository gets its own key",
+			manager:           &fakeMaintenanceManager{connectErr: errors.New("repository not found")},
+			expectedKeySecret: secretName,
+			expectSecret:      true,
+		},
+		{
+			name:        "new key is reverted if the repository can't be prepared",
+			manager:     &fakeMaintenanceManager{connectErr: errors.New("timeout"), prepareErr: errors.New("timeout")},
+			expectedErr: "timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := newKeyTestRepo(test.keySecret)
+			cli := velerotest.NewFakeControllerRuntimeClient(t, repo)
+			if test.secret != nil {
+				require.NoError(t, cli.Create(context.Background(), test.secret))
+			}
+
+			err := PrepareRepoWithOwnKey(context.Background(), cli, test.manager, repo, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			stored := &velerov1api.BackupRepository{}
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(repo), stored))
+			assert.Equal(t, test.expectedKeySecret, stored.Spec.KeySecret)
+
+			secret := &corev1api.Secret{}
+			err = cli.Get(context.Background(), client.ObjectKey{Namespace: repo.Namespace, Name: secretName}, secret)
+			if test.expectSecret {
+				require.NoError(t, err)
+				if test.secret == nil {
+					assert.True(t, keys.HasRepoKey(secret))
+					assert.Equal(t, 1, keys.KeyGeneration(secret))
+				}
+			} else {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+		})
+	}
+}
+
+func TestRepoKeyGeneration(t *testing.T) {
+	repo := newKeyTestRepo("")
+	cli := velerotest.NewFakeControllerRuntimeClient(t, newKeyTestSecret(repo, "key", "", "4"))
+
+	generation, err := RepoKeyGeneration(context.Background(), cli, repo)
+	require.NoError(t, err)
+	assert.Equal(t, 1, generation)
+
+	repo.Spec.KeySecret = keys.RepoKeySecretName(repo)
+	generation, err = RepoKeyGeneration(context.Background(), cli, repo)
+	require.NoError(t, err)
+	assert.Equal(t, 4, generation)
+
+	repo.Spec.KeySecret = "missing"
+	_, err = RepoKeyGeneration(context.Background(), cli, repo)
+	assert.Error(t, err)
+}
+
+func TestRotateKey(t *testing.T) {
+	secretName := keys.RepoKeySecretName(newKeyTestRepo(""))
+
+	tests := []struct {
+		name               string
+		keySecret          string
+		secret             *corev1api.Secret
+		manager            *fakeMaintenanceManager
+		expected           KeyRotationResult
+		expectedNewKey     string
+		expectedPendingKey bool
+	}{
+		{
+			name:     "repository moves from the shared key to its own",
+			manager:  &fakeMaintenanceManager{},
+			expected: KeyRotationResult{KeySecret: secretName, KeyGeneration: 2},
+		},
+		{
+			name:      "repository's own key is rotated",
+			keySecret: secretName,
+			secret:    newKeyTestSecret(newKeyTestRepo(""), "old-key", "", "2"),
+			manager:   &fakeMaintenanceManager{},
+			expected:  KeyRotationResult{KeySecret: secretName, KeyGeneration: 3},
+		},
+		{
+			name:           "new key of an interrupted rotation is reused",
+			keySecret:      secretName,
+			secret:         newKeyTestSecret(newKeyTestRepo(""), "old-key", "new-key", "2"),
+			manager:        &fakeMaintenanceManager{},
+			expected:       KeyRotationResult{KeySecret: secretName, KeyGeneration: 3},
+			expectedNewKey: "new-key",
+		},
+		{
+			name:      "missing key secret is reported",
+			keySecret: "missing",
+			manager:   &fakeMaintenanceManager{},
+			expected:  KeyRotationResult{KeySecret: "missing", Error: `error getting secret missing: secrets "missing" not found`},
+		},
+		{
+			name:      "connect error after an interrupted rotation points to the new key",
+			keySecret: secretName,
+			secret:    newKeyTestSecret(newKeyTestRepo(""), "old-key", "new-key", "2"),
+			manager:   &fakeMaintenanceManager{connectErr: errors.New("invalid repository password")},
+			expected: KeyRotationResult{
+				KeySecret: secretName,
+				Error:     "an interrupted key rotation may have changed the repository's password to the new-repository-password key of secret " + secretName + ": error connecting to repository: invalid repository password",
+			},
+			expectedPendingKey: true,
+		},
+		{
+			name:      "password change error keeps the new key",
+			keySecret: secretName,
+			secret:    newKeyTestSecret(newKeyTestRepo(""), "old-key", "", "2"),
+			manager:   &fakeMaintenanceManager{changePasswordErr: errors.New("repository is locked")},
+			expected: KeyRotationResult{
+				KeySecret: secretName,
+				Error:     "error changing repository password: repository is locked",
+			},
+			expectedPendingKey: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := newKeyTestRepo(test.keySecret)
+			cli := velerotest.NewFakeControllerRuntimeClient(t, repo)
+			if test.secret != nil {
+				require.NoError(t, cli.Create(context.Background(), test.secret))
+			}
+
+			result := RotateKey(context.Background(), cli, test.manager, repo, velerotest.NewLogger())
+			assert.Equal(t, test.expected, result)
+			if test.expectedNewKey != "" {
+				assert.Equal(t, test.expectedNewKey, test.manager.newPassword)
+			}
+
+			if result.Error != "" {
+				if test.expectedPendingKey {
+					secret := getKeyTestSecret(t, cli, secretName)
+					assert.NotEmpty(t, keys.PendingRepoKey(secret))
+				}
+				return
+			}
+
+			secret := getKeyTestSecret(t, cli, secretName)
+			assert.Empty(t, keys.PendingRepoKey(secret))
+			assert.Equal(t, test.expected.KeyGeneration, keys.KeyGeneration(secret))
+			assert.NotEmpty(t, test.manager.newPassword)
+			assert.Equal(t, test.manager.newPassword, string(secret.Data["repository-password"]))
+
+			stored := &velerov1api.BackupRepository{}
+			require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(repo), stored))
+			assert.Equal(t, secretName, stored.Spec.KeySecret)
+		})
+	}
+}
+
+func TestBuildKeyRotationJob(t *testing.T) {
+	repo := newMaintenanceTestRepo()
+
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	cli := velerotest.NewFakeControllerRuntimeClient(t, deployment)
+
+	job, err := BuildKeyRotationJob(context.Background(), cli, repo, MaintenanceJobConfig{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ns-1-default-restic-abcde-rekey-", job.GenerateName)
+	assert.Equal(t, RepositoryJobKeyRotation, job.Labels[velerov1api.BackupRepositoryJobLabel])
+
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "velero-repo-key-rotation", container.Name)
+	assert.Equal(t, []string{"repo", "rekey", repo.Name, "--namespace=velero"}, container.Args)
+}
+
+func TestGetKeyRotationResult(t *testing.T) {
+	created := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	started, finished := created.Add(10*time.Second), created.Add(50*time.Second)
+
+	tests := []struct {
+		name     string
+		job      *batchv1api.Job
+		pod      *corev1api.Pod
+		expected KeyRotationResult
+	}{
+		{
+			name:     "succeeded",
+			job:      newRepositoryJob("job-1", RepositoryJobKeyRotation, created, batchv1api.JobComplete),
+			pod:      newMaintenancePod("job-1", `{"keySecret":"key-secret","keyGeneration":2}`, started, finished),
+			expected: KeyRotationResult{KeySecret: "key-secret", KeyGeneration: 2},
+		},
+		{
+			name:     "failed with an error",
+			job:      newRepositoryJob("job-1", RepositoryJobKeyRotation, created, batchv1api.JobFailed),
+			pod:      newMaintenancePod("job-1", `{"keySecret":"key-secret","error":"error changing repository password"}`, started, finished),
+			expected: KeyRotationResult{KeySecret: "key-secret", Error: "error changing repository password"},
+		},
+		{
+			name:     "failed without a result",
+			job:      newRepositoryJob("job-1", RepositoryJobKeyRotation, created, batchv1api.JobFailed),
+			pod:      newMaintenancePod("job-1", "panic: out of memory", started, finished),
+			expected: KeyRotationResult{Error: "key rotation job failed: panic: out of memory"},
+		},
+		{
+			name:     "pod not found",
+			job:      newRepositoryJob("job-1", RepositoryJobKeyRotation, created, batchv1api.JobComplete),
+			expected: KeyRotationResult{Error: "unable to find the result of job job-1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t)
+			if test.pod != nil {
+				require.NoError(t, cli.Create(context.Background(), test.pod))
+			}
+
+			assert.Equal(t, test.expected, GetKeyRotationResult(context.Background(), cli, test.job))
+		})
+	}
+}
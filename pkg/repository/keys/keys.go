@@ -18,6 +18,10 @@ package keys
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
 
 	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
@@ -25,14 +29,27 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/label"
 )
 
 const (
 	credentialsSecretName = "velero-restic-credentials"
 	credentialsKey        = "repository-password"
 
+	// PendingCredentialsKey holds the new password of a repository while its key is rotated,
+	// so that it isn't lost if the rotation is interrupted after the password is changed.
+	PendingCredentialsKey = "new-repository-password"
+
+	// KeyGenerationAnnotation is the annotation on the Secret of a repository's key with the
+	// generation of the key in it.
+	KeyGenerationAnnotation = "velero.io/key-generation"
+
 	encryptionKey = "static-passw0rd"
+
+	// generatedKeyBytes is the number of random bytes in a generated repository key.
+	generatedKeyBytes = 32
 )
 
 func EnsureCommonRepositoryKey(secretClient corev1client.SecretsGetter, namespace string) error {
@@ -65,11 +82,119 @@ func EnsureCommonRepositoryKey(secretClient corev1client.SecretsGetter, namespac
 }
 
 // RepoKeySelector returns the SecretKeySelector which can be used to fetch
-// the restic repository key.
-func RepoKeySelector() *corev1api.SecretKeySelector {
-	// For now, all restic repos share the same key so we don't need the repoName to fetch it.
-	// When we move to full-backup encryption, we'll likely have a separate key per restic repo
-	// (all within the Velero server's namespace) so RepoKeySelector will need to select the key
-	// for that repo.
+// the key of repo. Repositories that don't have their own key Secret, or a nil
+// repo, use the key shared by all the repositories.
+func RepoKeySelector(repo *velerov1api.BackupRepository) *corev1api.SecretKeySelector {
+	if repo != nil && repo.Spec.KeySecret != "" {
+		return builder.ForSecretKeySelector(repo.Spec.KeySecret, credentialsKey).Result()
+	}
 	return builder.ForSecretKeySelector(credentialsSecretName, credentialsKey).Result()
 }
+
+// RepoKeySecretName returns the name of the Secret that holds the own key of repo. It only
+// depends on what the repository stores, so a BackupRepository that's recreated for the same
+// repository finds the same Secret.
+func RepoKeySecretName(repo *velerov1api.BackupRepository) string {
+	repoType := repo.Spec.RepositoryType
+	if repoType == "" {
+		repoType = velerov1api.BackupRepositoryTypeRestic
+	}
+	return label.GetValidName(fmt.Sprintf("velero-repo-key-%s-%s-%s", repo.Spec.VolumeNamespace, repo.Spec.BackupStorageLocation, repoType))
+}
+
+// GenerateRepoKey returns a new random repository password.
+func GenerateRepoKey() (string, error) {
+	key := make([]byte, generatedKeyBytes)
+	if _, err := rand.Read(key); err != nil {
+		return "", errors.Wrap(err, "error generating repository key")
+	}
+	return base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+// NewRepoKeySecret returns an empty Secret for the own key of repo.
+func NewRepoKeySecret(repo *velerov1api.BackupRepository) *corev1api.Secret {
+	return &corev1api.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: repo.Namespace,
+			Name:      RepoKeySecretName(repo),
+			Labels: map[string]string{
+				velerov1api.VolumeNamespaceLabel: label.GetValidName(repo.Spec.VolumeNamespace),
+				velerov1api.StorageLocationLabel: label.GetValidName(repo.Spec.BackupStorageLocation),
+				velerov1api.RepositoryTypeLabel:  label.GetValidName(repo.Spec.RepositoryType),
+			},
+		},
+		Type: corev1api.SecretTypeOpaque,
+		Data: map[string][]byte{},
+	}
+}
+
+// HasRepoKey returns whether secret holds the current key of a repository.
+func HasRepoKey(secret *corev1api.Secret) bool {
+	return len(secret.Data[credentialsKey]) > 0
+}
+
+// SetRepoKey stores password in secret as the current key of a repository.
+func SetRepoKey(secret *corev1api.Secret, password string) {
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[credentialsKey] = []byte(password)
+}
+
+// CopyRepoKey stores the current key of a repository in source, and the key's generation, in
+// secret, e.g. for a copy of the repository.
+func CopyRepoKey(secret *corev1api.Secret, source *corev1api.Secret) {
+	SetRepoKey(secret, string(source.Data[credentialsKey]))
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[KeyGenerationAnnotation] = strconv.Itoa(KeyGeneration(source))
+}
+
+// SameRepoKey returns whether secret and other hold the same current key of a repository.
+func SameRepoKey(secret *corev1api.Secret, other *corev1api.Secret) bool {
+	return HasRepoKey(secret) && string(secret.Data[credentialsKey]) == string(other.Data[credentialsKey])
+}
+
+// KeyGeneration returns the generation of the key in secret. A key that was never rotated,
+// like the one shared by all the repositories, is the first generation.
+func KeyGeneration(secret *corev1api.Secret) int {
+	generation, err := strconv.Atoi(secret.Annotations[KeyGenerationAnnotation])
+	if err != nil || generation < 1 {
+		return 1
+	}
+	return generation
+}
+
+// PendingRepoKey returns the new password stored in secret while a key rotation is in progress,
+// or an empty string if there's none.
+func PendingRepoKey(secret *corev1api.Secret) string {
+	return string(secret.Data[PendingCredentialsKey])
+}
+
+// SetPendingRepoKey stores password in secret as the new password of a key rotation.
+func SetPendingRepoKey(secret *corev1api.Secret, password string) {
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[PendingCredentialsKey] = []byte(password)
+}
+
+// PromotePendingRepoKey makes the new password stored in secret the current key of the
+// repository, and increments the key's generation.
+func PromotePendingRepoKey(secret *corev1api.Secret) {
+	pending, found := secret.Data[PendingCredentialsKey]
+	if !found {
+		return
+	}
+
+	generation := KeyGeneration(secret)
+	secret.Data[credentialsKey] = pending
+	delete(secret.Data, PendingCredentialsKey)
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[KeyGenerationAnnotation] = strconv.Itoa(generation + 1)
+}
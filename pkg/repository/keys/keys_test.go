@@ -19,12 +19,84 @@ package keys
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 func TestRepoKeySelector(t *testing.T) {
-	selector := RepoKeySelector()
+	selector := RepoKeySelector(nil)
+
+	require.Equal(t, credentialsSecretName, selector.Name)
+	require.Equal(t, credentialsKey, selector.Key)
 
+	selector = RepoKeySelector(&velerov1api.BackupRepository{})
 	require.Equal(t, credentialsSecretName, selector.Name)
+
+	selector = RepoKeySelector(&velerov1api.BackupRepository{Spec: velerov1api.BackupRepositorySpec{KeySecret: "repo-key"}})
+	require.Equal(t, "repo-key", selector.Name)
 	require.Equal(t, credentialsKey, selector.Key)
 }
+
+func TestRepoKeySecretName(t *testing.T) {
+	repo := &velerov1api.BackupRepository{
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+		},
+	}
+	assert.Equal(t, "velero-repo-key-ns-1-default-restic", RepoKeySecretName(repo))
+
+	repo.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+	assert.Equal(t, "velero-repo-key-ns-1-default-kopia", RepoKeySecretName(repo))
+
+	repo.Spec.VolumeNamespace = "a-very-long-namespace-name-that-makes-the-secret-name-too-long"
+	assert.Len(t, RepoKeySecretName(repo), 63)
+}
+
+func TestGenerateRepoKey(t *testing.T) {
+	key, err := GenerateRepoKey()
+	require.NoError(t, err)
+	assert.Len(t, key, 43)
+
+	other, err := GenerateRepoKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestPromotePendingRepoKey(t *testing.T) {
+	secret := NewRepoKeySecret(&velerov1api.BackupRepository{})
+	assert.False(t, HasRepoKey(secret))
+	assert.Equal(t, 1, KeyGeneration(secret))
+
+	// without a new key, nothing changes
+	PromotePendingRepoKey(secret)
+	assert.False(t, HasRepoKey(secret))
+
+	// the key shared by all the repositories is replaced by the repository's own
+	SetPendingRepoKey(secret, "key-2")
+	PromotePendingRepoKey(secret)
+	assert.Equal(t, "key-2", string(secret.Data[credentialsKey]))
+	assert.Empty(t, PendingRepoKey(secret))
+	assert.Equal(t, 2, KeyGeneration(secret))
+
+	SetPendingRepoKey(secret, "key-3")
+	assert.Equal(t, "key-3", PendingRepoKey(secret))
+	PromotePendingRepoKey(secret)
+	assert.Equal(t, "key-3", string(secret.Data[credentialsKey]))
+	assert.Equal(t, 3, KeyGeneration(secret))
+}
+
+func TestCopyRepoKey(t *testing.T) {
+	source := NewRepoKeySecret(&velerov1api.BackupRepository{})
+	SetPendingRepoKey(source, "key-2")
+	PromotePendingRepoKey(source)
+
+	secret := NewRepoKeySecret(&velerov1api.BackupRepository{})
+	assert.False(t, SameRepoKey(secret, source))
+
+	CopyRepoKey(secret, source)
+	assert.True(t, SameRepoKey(secret, source))
+	assert.Equal(t, 2, KeyGeneration(secret))
+}
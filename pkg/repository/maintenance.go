@@ -0,0 +1,393 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+const (
+	// DefaultKeepLatestMaintenanceJobs is how many finished maintenance jobs are kept for
+	// each backup repository by default.
+	DefaultKeepLatestMaintenanceJobs = 3
+
+	// DefaultMaxConcurrentMaintenanceJobs is how many maintenance jobs run at the same time
+	// by default.
+	DefaultMaxConcurrentMaintenanceJobs = 3
+
+	// DefaultServerDeployment is the name of the Velero server deployment that repository jobs
+	// run with when it isn't configured.
+	DefaultServerDeployment = "velero"
+
+	// maintenanceJobNamePrefixLength leaves room for the random suffix the API server adds to the
+	// name of a maintenance or verification job, which can't be longer than 63 characters.
+	maintenanceJobNamePrefixLength = 57
+
+	// RepositoryJobMaintenance and RepositoryJobVerification are the values of the
+	// velero.io/repository-job label of maintenance and verification jobs.
+	RepositoryJobMaintenance  = "maintenance"
+	RepositoryJobVerification = "verification"
+)
+
+// MaintenanceJobConfig is the configuration of the jobs that run backup repository maintenance
+// and verification.
+type MaintenanceJobConfig struct {
+	// Resources are the compute resources of the job's container.
+	Resources corev1api.ResourceRequirements
+
+	// NodeSelector constrains the nodes the jobs run on.
+	NodeSelector map[string]string
+
+	// KeepLatestJobs is how many finished jobs of each type are kept for each backup repository.
+	KeepLatestJobs int
+
+	// MaxConcurrentJobs is how many maintenance and verification jobs run at the same time.
+	// Zero means there's no limit.
+	MaxConcurrentJobs int
+
+	// LogLevel and LogFormat are passed on to the command the job runs.
+	LogLevel  string
+	LogFormat string
+
+	// ServerDeployment is the name of the Velero server deployment. The jobs run with its image,
+	// credentials and service account. DefaultServerDeployment is used if it's empty.
+	ServerDeployment string
+}
+
+func (c MaintenanceJobConfig) serverDeployment() string {
+	if c.ServerDeployment == "" {
+		return DefaultServerDeployment
+	}
+	return c.ServerDeployment
+}
+
+// GetServerDeployment returns the name of the deployment that owns the pod podName in namespace,
+// through its replica set.
+func GetServerDeployment(ctx context.Context, cli client.Reader, namespace, podName string) (string, error) {
+	pod := &corev1api.Pod{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: podName}, pod); err != nil {
+		return "", errors.Wrapf(err, "error getting pod %s", podName)
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "ReplicaSet" {
+		return "", errors.Errorf("pod %s isn't owned by a replica set", podName)
+	}
+
+	replicaSet := &appsv1api.ReplicaSet{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: owner.Name}, replicaSet); err != nil {
+		return "", errors.Wrapf(err, "error getting replica set %s", owner.Name)
+	}
+	owner = metav1.GetControllerOf(replicaSet)
+	if owner == nil || owner.Kind != "Deployment" {
+		return "", errors.Errorf("replica set %s isn't owned by a deployment", replicaSet.Name)
+	}
+	return owner.Name, nil
+}
+
+// MaintenanceResult is the result of a maintenance run, which the maintenance job reports
+// in the termination message of its container.
+type MaintenanceResult struct {
+	// ReclaimedBytes is how much the storage used by the repository shrank. It's nil if the
+	// size of the repository couldn't be measured.
+	ReclaimedBytes *int64 `json:"reclaimedBytes,omitempty"`
+
+	// Error is the error the maintenance failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// RunMaintenance prunes repo, and returns how much storage was reclaimed or the error the
+// prune failed with.
+func RunMaintenance(repoManager Manager, repo *velerov1api.BackupRepository, log logrus.FieldLogger) MaintenanceResult {
+	// Connecting sets up the local configuration the repository is opened with.
+	if err := repoManager.ConnectToRepo(repo); err != nil {
+		return MaintenanceResult{Error: errors.Wrap(err, "error connecting to repository").Error()}
+	}
+
+	sizeBefore, sizeErr := repoManager.RepoSize(repo)
+	if sizeErr != nil {
+		log.WithError(sizeErr).Warn("Unable to get size of repository before maintenance")
+	}
+
+	if err := repoManager.PruneRepo(repo); err != nil {
+		return MaintenanceResult{Error: errors.Wrap(err, "error pruning repository").Error()}
+	}
+
+	if sizeErr != nil {
+		return MaintenanceResult{}
+	}
+
+	sizeAfter, err := repoManager.RepoSize(repo)
+	if err != nil {
+		log.WithError(err).Warn("Unable to get size of repository after maintenance")
+		return MaintenanceResult{}
+	}
+
+	reclaimed := sizeBefore - sizeAfter
+	if reclaimed < 0 {
+		reclaimed = 0
+	}
+	return MaintenanceResult{ReclaimedBytes: &reclaimed}
+}
+
+// BuildMaintenanceJob returns a job that runs maintenance on repo.
+func BuildMaintenanceJob(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, config MaintenanceJobConfig) (*batchv1api.Job, error) {
+	return buildRepositoryJob(ctx, cli, repo, config, RepositoryJobMaintenance, "maintain")
+}
+
+// buildRepositoryJob returns a job of type jobType that runs the given `velero repo`
+// command for repo. The job runs with the image, environment, volumes and service account of
+// the Velero server, so that it has access to the same backup storage locations.
+func buildRepositoryJob(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, config MaintenanceJobConfig, jobType, command string, flags ...string) (*batchv1api.Job, error) {
+	deployment, err := getServerDeployment(ctx, cli, repo.Namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	podSpec := deployment.Spec.Template.Spec
+	if len(podSpec.Containers) == 0 {
+		return nil, errors.Errorf("deployment %s has no containers", deployment.Name)
+	}
+	server := podSpec.Containers[0]
+
+	args := []string{"repo", command, repo.Name, "--namespace=" + repo.Namespace}
+	args = append(args, flags...)
+	if config.LogLevel != "" {
+		args = append(args, "--log-level="+config.LogLevel)
+	}
+	if config.LogFormat != "" {
+		args = append(args, "--log-format="+config.LogFormat)
+	}
+
+	labels := map[string]string{
+		velerov1api.BackupRepositoryNameLabel: label.GetValidName(repo.Name),
+		velerov1api.BackupRepositoryJobLabel:  jobType,
+	}
+
+	prefix := repo.Name + "-" + command + "-"
+	if len(prefix) > maintenanceJobNamePrefixLength {
+		prefix = prefix[:maintenanceJobNamePrefixLength]
+	}
+
+	var backoffLimit int32
+	return &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    repo.Namespace,
+			GenerateName: prefix,
+			Labels:       labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "BackupRepository",
+					Name:       repo.Name,
+					UID:        repo.UID,
+					Controller: boolptr.True(),
+				},
+			},
+		},
+		Spec: batchv1api.JobSpec{
+			// Failed jobs are retried by the next reconciliation of the repository.
+			BackoffLimit: &backoffLimit,
+			Template: corev1api.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1api.PodSpec{
+					RestartPolicy:      corev1api.RestartPolicyNever,
+					ServiceAccountName: podSpec.ServiceAccountName,
+					ImagePullSecrets:   podSpec.ImagePullSecrets,
+					SecurityContext:    podSpec.SecurityContext,
+					Tolerations:        podSpec.Tolerations,
+					NodeSelector:       config.NodeSelector,
+					Volumes:            podSpec.Volumes,
+					Containers: []corev1api.Container{
+						{
+							Name:            "velero-repo-" + jobType,
+							Image:           server.Image,
+							ImagePullPolicy: server.ImagePullPolicy,
+							Command:         server.Command,
+							Args:            args,
+							Env:             server.Env,
+							EnvFrom:         server.EnvFrom,
+							VolumeMounts:    server.VolumeMounts,
+							Resources:       config.Resources,
+							// the command reports its result in the termination message
+							TerminationMessagePolicy: corev1api.TerminationMessageFallbackToLogsOnError,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func getServerDeployment(ctx context.Context, cli client.Client, namespace string, config MaintenanceJobConfig) (*appsv1api.Deployment, error) {
+	deployment := &appsv1api.Deployment{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: config.serverDeployment()}, deployment); err != nil {
+		return nil, errors.Wrapf(err, "error getting deployment %s", config.serverDeployment())
+	}
+	return deployment, nil
+}
+
+// RepositoryJobFinished returns whether a maintenance or verification job has completed or failed.
+func RepositoryJobFinished(job *batchv1api.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1api.JobComplete || condition.Type == batchv1api.JobFailed) && condition.Status == corev1api.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMaintenanceStatus returns the status of a finished maintenance job, as reported in the
+// termination message of its pod.
+func GetMaintenanceStatus(ctx context.Context, cli client.Client, job *batchv1api.Job) velerov1api.BackupRepositoryMaintenanceStatus {
+	status := velerov1api.BackupRepositoryMaintenanceStatus{
+		Job:            job.Name,
+		Result:         velerov1api.BackupRepositoryMaintenanceSucceeded,
+		StartTimestamp: job.Status.StartTime,
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1api.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1api.JobComplete:
+			status.CompleteTimestamp = &metav1.Time{Time: condition.LastTransitionTime.Time}
+		case batchv1api.JobFailed:
+			status.Result = velerov1api.BackupRepositoryMaintenanceFailed
+			status.CompleteTimestamp = &metav1.Time{Time: condition.LastTransitionTime.Time}
+			status.Message = condition.Message
+		}
+	}
+
+	terminated, err := getJobTermination(ctx, cli, job)
+	if err != nil {
+		status.Result = velerov1api.BackupRepositoryMaintenanceFailed
+		status.Message = appendMessage(status.Message, err.Error())
+		return status
+	}
+
+	status.StartTimestamp = &metav1.Time{Time: terminated.StartedAt.Time}
+	status.CompleteTimestamp = &metav1.Time{Time: terminated.FinishedAt.Time}
+
+	result := MaintenanceResult{}
+	if err := json.Unmarshal([]byte(terminated.Message), &result); err != nil {
+		// the job didn't get to report its result, so the message is the end of its log
+		status.Message = appendMessage(status.Message, terminated.Message)
+		return status
+	}
+	status.ReclaimedBytes = result.ReclaimedBytes
+	if result.Error != "" {
+		status.Result = velerov1api.BackupRepositoryMaintenanceFailed
+		status.Message = result.Error
+	}
+
+	return status
+}
+
+// getJobTermination returns the terminated state of the container of a finished job's pod, which
+// holds the result the job reported.
+func getJobTermination(ctx context.Context, cli client.Client, job *batchv1api.Job) (*corev1api.ContainerStateTerminated, error) {
+	pods := &corev1api.PodList{}
+	if err := cli.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return nil, errors.Wrapf(err, "error listing pods of job %s", job.Name)
+	}
+
+	var terminated *corev1api.ContainerStateTerminated
+	for _, pod := range pods.Items {
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.State.Terminated != nil {
+				terminated = container.State.Terminated
+			}
+		}
+	}
+	if terminated == nil {
+		return nil, errors.Errorf("unable to find the result of job %s", job.Name)
+	}
+	return terminated, nil
+}
+
+func appendMessage(message, more string) string {
+	if message == "" {
+		return more
+	}
+	return fmt.Sprintf("%s: %s", message, more)
+}
+
+// GetRunningRepositoryJobs returns the maintenance and verification jobs that are running in namespace.
+func GetRunningRepositoryJobs(ctx context.Context, cli client.Client, namespace string) ([]batchv1api.Job, error) {
+	jobs := &batchv1api.JobList{}
+	if err := cli.List(ctx, jobs, client.InNamespace(namespace), client.HasLabels{velerov1api.BackupRepositoryNameLabel}); err != nil {
+		return nil, errors.Wrap(err, "error listing repository jobs")
+	}
+
+	var running []batchv1api.Job
+	for _, job := range jobs.Items {
+		if !RepositoryJobFinished(&job) {
+			running = append(running, job)
+		}
+	}
+	return running, nil
+}
+
+// DeleteOldRepositoryJobs deletes the finished jobs of type jobType of repo, except for the
+// latest keep of them.
+func DeleteOldRepositoryJobs(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, jobType string, keep int) error {
+	jobs := &batchv1api.JobList{}
+	if err := cli.List(ctx, jobs, client.InNamespace(repo.Namespace), client.MatchingLabels{
+		velerov1api.BackupRepositoryNameLabel: label.GetValidName(repo.Name),
+		velerov1api.BackupRepositoryJobLabel:  jobType,
+	}); err != nil {
+		return errors.Wrapf(err, "error listing %s jobs", jobType)
+	}
+
+	var finished []batchv1api.Job
+	for _, job := range jobs.Items {
+		if RepositoryJobFinished(&job) {
+			finished = append(finished, job)
+		}
+	}
+	if len(finished) <= keep {
+		return nil
+	}
+
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].CreationTimestamp.Before(&finished[j].CreationTimestamp)
+	})
+
+	propagation := metav1.DeletePropagationBackground
+	for i := range finished[:len(finished)-keep] {
+		if err := cli.Delete(ctx, &finished[i], &client.DeleteOptions{PropagationPolicy: &propagation}); client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, "error deleting %s job %s", jobType, finished[i].Name)
+		}
+	}
+	return nil
+}
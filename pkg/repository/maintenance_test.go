@@ -0,0 +1,412 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// fakeMaintenanceManager implements the methods of Manager that maintenance, verification, key
+// rotation and migration use.
+type fakeMaintenanceManager struct {
+	Manager
+	connectErr        error
+	prepareErr        error
+	pruneErr          error
+	sizes             []int64
+	sizeErrs          []error
+	checkResult       provider.CheckResult
+	checkErr          error
+	repoStats         provider.RepoStats
+	statsErr          error
+	changePasswordErr error
+	newPassword       string
+	copyIDs           map[string]string
+	copyErr           error
+	copyTarget        *velerov1api.BackupRepository
+}
+
+func (m *fakeMaintenanceManager) ConnectToRepo(repo *velerov1api.BackupRepository) error {
+	return m.connectErr
+}
+
+func (m *fakeMaintenanceManager) PrepareRepo(repo *velerov1api.BackupRepository) error {
+	return m.prepareErr
+}
+
+func (m *fakeMaintenanceManager) PruneRepo(repo *velerov1api.BackupRepository) error {
+	return m.pruneErr
+}
+
+func (m *fakeMaintenanceManager) RepoSize(repo *velerov1api.BackupRepository) (int64, error) {
+	size, err := m.sizes[0], m.sizeErrs[0]
+	m.sizes, m.sizeErrs = m.sizes[1:], m.sizeErrs[1:]
+	return size, err
+}
+
+func (m *fakeMaintenanceManager) CheckRepo(repo *velerov1api.BackupRepository, readDataPercent int) (provider.CheckResult, error) {
+	return m.checkResult, m.checkErr
+}
+
+func (m *fakeMaintenanceManager) RepoStats(repo *velerov1api.BackupRepository) (provider.RepoStats, error) {
+	return m.repoStats, m.statsErr
+}
+
+func (m *fakeMaintenanceManager) ChangePassword(repo *velerov1api.BackupRepository, newPassword string) error {
+	m.newPassword = newPassword
+	return m.changePasswordErr
+}
+
+func (m *fakeMaintenanceManager) CopyRepo(repo *velerov1api.BackupRepository, target *velerov1api.BackupRepository) (map[string]string, error) {
+	m.copyTarget = target.DeepCopy()
+	return m.copyIDs, m.copyErr
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestRunMaintenance(t *testing.T) {
+	tests := []struct {
+		name     string
+		manager  *fakeMaintenanceManager
+		expected MaintenanceResult
+	}{
+		{
+			name:     "reclaimed bytes are reported",
+			manager:  &fakeMaintenanceManager{sizes: []int64{100, 40}, sizeErrs: []error{nil, nil}},
+			expected: MaintenanceResult{ReclaimedBytes: int64Ptr(60)},
+		},
+		{
+			name:     "a repository that grew reclaimed nothing",
+			manager:  &fakeMaintenanceManager{sizes: []int64{100, 120}, sizeErrs: []error{nil, nil}},
+			expected: MaintenanceResult{ReclaimedBytes: int64Ptr(0)},
+		},
+		{
+			name:     "reclaimed bytes are omitted if the size of the repository is unknown",
+			manager:  &fakeMaintenanceManager{sizes: []int64{0}, sizeErrs: []error{errors.New("stats failed")}},
+			expected: MaintenanceResult{},
+		},
+		{
+			name:     "connect error is reported",
+			manager:  &fakeMaintenanceManager{connectErr: errors.New("wrong password")},
+			expected: MaintenanceResult{Error: "error connecting to repository: wrong password"},
+		},
+		{
+			name:     "prune error is reported",
+			manager:  &fakeMaintenanceManager{pruneErr: errors.New("repository is locked"), sizes: []int64{100}, sizeErrs: []error{nil}},
+			expected: MaintenanceResult{Error: "error pruning repository: repository is locked"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := RunMaintenance(test.manager, &velerov1api.BackupRepository{}, velerotest.NewLogger())
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func newMaintenanceTestRepo() *velerov1api.BackupRepository {
+	return &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "ns-1-default-restic-abcde", UID: "repo-uid"},
+	}
+}
+
+func TestBuildMaintenanceJob(t *testing.T) {
+	repo := newMaintenanceTestRepo()
+	config := MaintenanceJobConfig{
+		Resources: corev1api.ResourceRequirements{
+			Limits: corev1api.ResourceList{corev1api.ResourceMemory: resource.MustParse("1Gi")},
+		},
+		NodeSelector: map[string]string{"pool": "maintenance"},
+		LogLevel:     "debug",
+		LogFormat:    "json",
+	}
+
+	cli := velerotest.NewFakeControllerRuntimeClient(t)
+	_, err := BuildMaintenanceJob(context.Background(), cli, repo, config)
+	assert.EqualError(t, err, `error getting deployment velero: deployments.apps "velero" not found`)
+
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.ServiceAccountName = "velero"
+	deployment.Spec.Template.Spec.Volumes = []corev1api.Volume{{Name: "cloud-credentials"}}
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{
+		{
+			Name:         "velero",
+			Image:        "velero/velero:main",
+			Command:      []string{"/velero"},
+			Args:         []string{"server"},
+			Env:          []corev1api.EnvVar{{Name: "VELERO_NAMESPACE", Value: velerov1api.DefaultNamespace}},
+			VolumeMounts: []corev1api.VolumeMount{{Name: "cloud-credentials", MountPath: "/credentials"}},
+		},
+	}
+	cli = velerotest.NewFakeControllerRuntimeClient(t, deployment)
+
+	job, err := BuildMaintenanceJob(context.Background(), cli, repo, config)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ns-1-default-restic-abcde-maintain-", job.GenerateName)
+	assert.Equal(t, repo.Name, job.Labels[velerov1api.BackupRepositoryNameLabel])
+	assert.Equal(t, RepositoryJobMaintenance, job.Labels[velerov1api.BackupRepositoryJobLabel])
+	assert.Equal(t, repo.UID, job.OwnerReferences[0].UID)
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+
+	podSpec := job.Spec.Template.Spec
+	assert.Equal(t, corev1api.RestartPolicyNever, podSpec.RestartPolicy)
+	assert.Equal(t, "velero", podSpec.ServiceAccountName)
+	assert.Equal(t, config.NodeSelector, podSpec.NodeSelector)
+	assert.Equal(t, deployment.Spec.Template.Spec.Volumes, podSpec.Volumes)
+
+	container := podSpec.Containers[0]
+	assert.Equal(t, "velero/velero:main", container.Image)
+	assert.Equal(t, []string{"/velero"}, container.Command)
+	assert.Equal(t, []string{"repo", "maintain", repo.Name, "--namespace=velero", "--log-level=debug", "--log-format=json"}, container.Args)
+	assert.Equal(t, config.Resources, container.Resources)
+	assert.Equal(t, deployment.Spec.Template.Spec.Containers[0].Env, container.Env)
+	assert.Equal(t, deployment.Spec.Template.Spec.Containers[0].VolumeMounts, container.VolumeMounts)
+}
+
+func TestBuildMaintenanceJobServerDeployment(t *testing.T) {
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "backup-server").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	cli := velerotest.NewFakeControllerRuntimeClient(t, deployment)
+
+	_, err := BuildMaintenanceJob(context.Background(), cli, newMaintenanceTestRepo(), MaintenanceJobConfig{})
+	assert.EqualError(t, err, `error getting deployment velero: deployments.apps "velero" not found`)
+
+	job, err := BuildMaintenanceJob(context.Background(), cli, newMaintenanceTestRepo(), MaintenanceJobConfig{ServerDeployment: "backup-server"})
+	require.NoError(t, err)
+	assert.Equal(t, "velero/velero:main", job.Spec.Template.Spec.Containers[0].Image)
+}
+
+func TestGetServerDeployment(t *testing.T) {
+	controlledBy := func(kind, name string) builder.ObjectMetaOpt {
+		return func(obj metav1.Object) {
+			obj.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kind, Name: name, Controller: boolptr.True()}})
+		}
+	}
+	replicaSet := &appsv1api.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "backup-server-7d9f8"}}
+	controlledBy("Deployment", "backup-server")(replicaSet)
+	orphanSet := &appsv1api.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "orphan"}}
+
+	cli := velerotest.NewFakeControllerRuntimeClient(t,
+		replicaSet,
+		orphanSet,
+		builder.ForPod(velerov1api.DefaultNamespace, "backup-server-7d9f8-x2c4v").ObjectMeta(controlledBy("ReplicaSet", replicaSet.Name)).Result(),
+		builder.ForPod(velerov1api.DefaultNamespace, "orphan-x2c4v").ObjectMeta(controlledBy("ReplicaSet", orphanSet.Name)).Result(),
+		builder.ForPod(velerov1api.DefaultNamespace, "velero").Result(),
+	)
+
+	name, err := GetServerDeployment(context.Background(), cli, velerov1api.DefaultNamespace, "backup-server-7d9f8-x2c4v")
+	require.NoError(t, err)
+	assert.Equal(t, "backup-server", name)
+
+	_, err = GetServerDeployment(context.Background(), cli, velerov1api.DefaultNamespace, "orphan-x2c4v")
+	assert.EqualError(t, err, "replica set orphan isn't owned by a deployment")
+
+	_, err = GetServerDeployment(context.Background(), cli, velerov1api.DefaultNamespace, "velero")
+	assert.EqualError(t, err, "pod velero isn't owned by a replica set")
+
+	_, err = GetServerDeployment(context.Background(), cli, velerov1api.DefaultNamespace, "missing")
+	assert.Error(t, err)
+}
+
+func newMaintenanceJob(name string, created time.Time, conditionType batchv1api.JobConditionType) *batchv1api.Job {
+	return newRepositoryJob(name, RepositoryJobMaintenance, created, conditionType)
+}
+
+func newRepositoryJob(name, jobType string, created time.Time, conditionType batchv1api.JobConditionType) *batchv1api.Job {
+	job := &batchv1api.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         velerov1api.DefaultNamespace,
+			Name:              name,
+			CreationTimestamp: metav1.Time{Time: created},
+			Labels: map[string]string{
+				velerov1api.BackupRepositoryNameLabel: "ns-1-default-restic-abcde",
+				velerov1api.BackupRepositoryJobLabel:  jobType,
+			},
+		},
+	}
+	if conditionType != "" {
+		job.Status.Conditions = []batchv1api.JobCondition{{Type: conditionType, Status: corev1api.ConditionTrue, LastTransitionTime: metav1.Time{Time: created.Add(time.Minute)}}}
+	}
+	return job
+}
+
+func newMaintenancePod(job string, message string, started, finished time.Time) *corev1api.Pod {
+	pod := builder.ForPod(velerov1api.DefaultNamespace, job+"-xyz12").ObjectMeta(builder.WithLabels("job-name", job)).Result()
+	pod.Status.ContainerStatuses = []corev1api.ContainerStatus{
+		{
+			State: corev1api.ContainerState{
+				Terminated: &corev1api.ContainerStateTerminated{
+					Message:    message,
+					StartedAt:  metav1.Time{Time: started},
+					FinishedAt: metav1.Time{Time: finished},
+				},
+			},
+		},
+	}
+	return pod
+}
+
+func TestGetMaintenanceStatus(t *testing.T) {
+	created := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	started, finished := created.Add(10*time.Second), created.Add(50*time.Second)
+
+	tests := []struct {
+		name     string
+		job      *batchv1api.Job
+		pod      *corev1api.Pod
+		expected velerov1api.BackupRepositoryMaintenanceStatus
+	}{
+		{
+			name: "succeeded",
+			job:  newMaintenanceJob("job-1", created, batchv1api.JobComplete),
+			pod:  newMaintenancePod("job-1", `{"reclaimedBytes":1024}`, started, finished),
+			expected: velerov1api.BackupRepositoryMaintenanceStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryMaintenanceSucceeded,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+				ReclaimedBytes:    int64Ptr(1024),
+			},
+		},
+		{
+			name: "failed with an error",
+			job:  newMaintenanceJob("job-1", created, batchv1api.JobFailed),
+			pod:  newMaintenancePod("job-1", `{"error":"error pruning repository: repository is locked"}`, started, finished),
+			expected: velerov1api.BackupRepositoryMaintenanceStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryMaintenanceFailed,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+				Message:           "error pruning repository: repository is locked",
+			},
+		},
+		{
+			name: "failed without reporting a result",
+			job:  newMaintenanceJob("job-1", created, batchv1api.JobFailed),
+			pod:  newMaintenancePod("job-1", "panic: runtime error", started, finished),
+			expected: velerov1api.BackupRepositoryMaintenanceStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryMaintenanceFailed,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+				Message:           "panic: runtime error",
+			},
+		},
+		{
+			name: "pod not found",
+			job:  newMaintenanceJob("job-1", created, batchv1api.JobComplete),
+			expected: velerov1api.BackupRepositoryMaintenanceStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryMaintenanceFailed,
+				CompleteTimestamp: &metav1.Time{Time: created.Add(time.Minute)},
+				Message:           "unable to find the result of job job-1",
+			},
+		},
+		{
+			name: "pod without a terminated container",
+			job:  newMaintenanceJob("job-1", created, batchv1api.JobComplete),
+			pod:  builder.ForPod(velerov1api.DefaultNamespace, "job-1-xyz12").ObjectMeta(builder.WithLabels("job-name", "job-1")).Result(),
+			expected: velerov1api.BackupRepositoryMaintenanceStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryMaintenanceFailed,
+				CompleteTimestamp: &metav1.Time{Time: created.Add(time.Minute)},
+				Message:           "unable to find the result of job job-1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t)
+			if test.pod != nil {
+				require.NoError(t, cli.Create(context.Background(), test.pod))
+			}
+
+			status := GetMaintenanceStatus(context.Background(), cli, test.job)
+			assert.Equal(t, test.expected.Job, status.Job)
+			assert.Equal(t, test.expected.Result, status.Result)
+			assert.Equal(t, test.expected.Message, status.Message)
+			assert.Equal(t, test.expected.ReclaimedBytes, status.ReclaimedBytes)
+			if test.expected.StartTimestamp != nil {
+				assert.True(t, test.expected.StartTimestamp.Equal(status.StartTimestamp))
+			}
+			assert.True(t, test.expected.CompleteTimestamp.Equal(status.CompleteTimestamp))
+		})
+	}
+}
+
+func TestGetRunningRepositoryJobs(t *testing.T) {
+	now := time.Now()
+	cli := velerotest.NewFakeControllerRuntimeClient(t,
+		newMaintenanceJob("job-1", now, batchv1api.JobComplete),
+		newMaintenanceJob("job-2", now, batchv1api.JobFailed),
+		newMaintenanceJob("job-3", now, ""),
+		newRepositoryJob("job-4", RepositoryJobVerification, now, ""),
+		// jobs that aren't repository jobs aren't counted
+		&batchv1api.Job{ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "other"}},
+	)
+
+	running, err := GetRunningRepositoryJobs(context.Background(), cli, velerov1api.DefaultNamespace)
+	require.NoError(t, err)
+	require.Len(t, running, 2)
+	assert.ElementsMatch(t, []string{"job-3", "job-4"}, []string{running[0].Name, running[1].Name})
+}
+
+func TestDeleteOldRepositoryJobs(t *testing.T) {
+	now := time.Now()
+	cli := velerotest.NewFakeControllerRuntimeClient(t,
+		newMaintenanceJob("job-1", now.Add(-4*time.Hour), batchv1api.JobComplete),
+		newMaintenanceJob("job-2", now.Add(-3*time.Hour), batchv1api.JobFailed),
+		newMaintenanceJob("job-3", now.Add(-2*time.Hour), batchv1api.JobComplete),
+		newMaintenanceJob("job-4", now.Add(-1*time.Hour), batchv1api.JobComplete),
+		newMaintenanceJob("job-5", now, ""),
+		// verification jobs are kept separately
+		newRepositoryJob("job-6", RepositoryJobVerification, now.Add(-5*time.Hour), batchv1api.JobComplete),
+	)
+
+	require.NoError(t, DeleteOldRepositoryJobs(context.Background(), cli, newMaintenanceTestRepo(), RepositoryJobMaintenance, 2))
+
+	jobs := &batchv1api.JobList{}
+	require.NoError(t, cli.List(context.Background(), jobs, client.InNamespace(velerov1api.DefaultNamespace)))
+	var names []string
+	for _, job := range jobs.Items {
+		names = append(names, job.Name)
+	}
+	assert.ElementsMatch(t, []string{"job-3", "job-4", "job-5", "job-6"}, names)
+}
@@ -66,6 +66,23 @@ type Manager interface {
 	// PruneRepo deletes unused data from a repo.
 	PruneRepo(repo *velerov1api.BackupRepository) error
 
+	// RepoSize returns the size of the storage used by a repo.
+	RepoSize(repo *velerov1api.BackupRepository) (int64, error)
+
+	// RepoStats returns the storage usage of a repo and of each of its snapshots.
+	RepoStats(repo *velerov1api.BackupRepository) (provider.RepoStats, error)
+
+	// CheckRepo checks the integrity of a repo, and reads back readDataPercent
+	// percent of its data.
+	CheckRepo(repo *velerov1api.BackupRepository, readDataPercent int) (provider.CheckResult, error)
+
+	// ChangePassword changes the password of a repo to newPassword.
+	ChangePassword(repo *velerov1api.BackupRepository, newPassword string) error
+
+	// CopyRepo copies a repo to the storage of target, and returns the ID of each
+	// snapshot in the copy by the snapshot's ID in the repo.
+	CopyRepo(repo *velerov1api.BackupRepository, target *velerov1api.BackupRepository) (map[string]string, error)
+
 	// UnlockRepo removes stale locks from a repo.
 	UnlockRepo(repo *velerov1api.BackupRepository) error
 
@@ -175,6 +192,93 @@ func (m *manager) PruneRepo(repo *velerov1api.BackupRepository) error {
 	return prd.PruneRepo(context.Background(), param)
 }
 
+func (m *manager) RepoSize(repo *velerov1api.BackupRepository) (int64, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return prd.RepoSize(context.Background(), param)
+}
+
+func (m *manager) RepoStats(repo *velerov1api.BackupRepository) (provider.RepoStats, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return provider.RepoStats{}, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return provider.RepoStats{}, errors.WithStack(err)
+	}
+	return prd.RepoStats(context.Background(), param)
+}
+
+func (m *manager) CheckRepo(repo *velerov1api.BackupRepository, readDataPercent int) (provider.CheckResult, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return provider.CheckResult{}, errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return provider.CheckResult{}, errors.WithStack(err)
+	}
+	return prd.CheckRepo(context.Background(), param, readDataPercent)
+}
+
+func (m *manager) ChangePassword(repo *velerov1api.BackupRepository, newPassword string) error {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return prd.ChangePassword(context.Background(), param, newPassword)
+}
+
+func (m *manager) CopyRepo(repo *velerov1api.BackupRepository, target *velerov1api.BackupRepository) (map[string]string, error) {
+	m.repoLocker.Lock(repo.Name)
+	defer m.repoLocker.Unlock(repo.Name)
+
+	prd, err := m.getRepositoryProvider(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	targetPrd, err := m.getRepositoryProvider(target)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if prd != targetPrd {
+		return nil, errors.Errorf("can't copy a %s repository to a %s repository", repo.Spec.RepositoryType, target.Spec.RepositoryType)
+	}
+
+	param, err := m.assembleRepoParam(repo)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	targetParam, err := m.assembleRepoParam(target)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return prd.CopyRepo(context.Background(), param, targetParam)
+}
+
 func (m *manager) UnlockRepo(repo *velerov1api.BackupRepository) error {
 	m.repoLocker.Lock(repo.Name)
 	defer m.repoLocker.Unlock(repo.Name)
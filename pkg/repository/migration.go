@@ -0,0 +1,305 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	repoconfig "github.com/vmware-tanzu/velero/pkg/repository/config"
+	"github.com/vmware-tanzu/velero/pkg/repository/keys"
+)
+
+// RepositoryJobMigration is the value of the velero.io/repository-job label of migration jobs.
+const RepositoryJobMigration = "migration"
+
+// MigrationResult is the result of the migration of a backup repository to another backup
+// storage location, which the migration job reports in the termination message of its container.
+type MigrationResult struct {
+	// TargetRepository is the name of the BackupRepository for the copy of the repository.
+	TargetRepository string `json:"targetRepository,omitempty"`
+
+	// Snapshots is the number of snapshots that were copied.
+	Snapshots int `json:"snapshots,omitempty"`
+
+	// Backups is the number of backups whose pod volume backups were updated.
+	Backups int `json:"backups,omitempty"`
+
+	// Error is the error the migration failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// MigrateRepo copies repo to targetLocation, and updates the pod volume backups of the backups
+// in backupStore, the backup store of targetLocation, to refer to the copy. The backups must be
+// in the target location already, e.g. because the backup metadata was copied to its bucket.
+// Only the backup metadata in the target location is updated, the backups in the cluster keep
+// referring to repo. A migration that fails can be run again, it resumes copying where the last
+// one stopped.
+func MigrateRepo(ctx context.Context, cli client.Client, repoManager Manager, repo *velerov1api.BackupRepository,
+	targetLocation *velerov1api.BackupStorageLocation, backupStore persistence.BackupStore, log logrus.FieldLogger) MigrationResult {
+	result := MigrationResult{}
+
+	if targetLocation.Name == repo.Spec.BackupStorageLocation {
+		result.Error = fmt.Sprintf("repository is in backup storage location %s already", targetLocation.Name)
+		return result
+	}
+	if targetLocation.Spec.AccessMode == velerov1api.BackupStorageLocationAccessModeReadOnly {
+		result.Error = fmt.Sprintf("backup storage location %s is read-only", targetLocation.Name)
+		return result
+	}
+
+	target, err := getMigrationTarget(ctx, cli, repo, targetLocation)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	// Connecting sets up the local configuration the repository is opened with.
+	if err := repoManager.ConnectToRepo(repo); err != nil {
+		result.Error = errors.Wrap(err, "error connecting to repository").Error()
+		return result
+	}
+
+	log.WithField("targetLocation", targetLocation.Name).Info("Copying repository")
+	ids, err := repoManager.CopyRepo(repo, target)
+	if err != nil {
+		result.Error = errors.Wrap(err, "error copying repository").Error()
+		return result
+	}
+	result.Snapshots = len(ids)
+
+	if target.Name == "" {
+		if err := cli.Create(ctx, target); err != nil {
+			result.Error = errors.Wrap(err, "error creating backup repository for the copy").Error()
+			return result
+		}
+	}
+	result.TargetRepository = target.Name
+
+	backups, err := updatePodVolumeBackups(repo, target, ids, backupStore, log)
+	result.Backups = backups
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// getMigrationTarget returns the BackupRepository for the copy of repo in targetLocation. It's
+// the one left by an earlier migration of repo, or a new one that isn't created yet.
+func getMigrationTarget(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, targetLocation *velerov1api.BackupStorageLocation) (*velerov1api.BackupRepository, error) {
+	repoType := repo.Spec.RepositoryType
+	if repoType == "" {
+		repoType = velerov1api.BackupRepositoryTypeRestic
+	}
+	key := BackupRepositoryKey{repo.Spec.VolumeNamespace, targetLocation.Name, repoType}
+
+	target, err := GetBackupRepository(ctx, cli, repo.Namespace, key, false)
+	switch {
+	case err == nil:
+		if target.Annotations[velerov1api.MigratedFromBackupRepositoryAnnotation] != repo.Name {
+			return nil, errors.Errorf("backup storage location %s has a repository for namespace %s already", targetLocation.Name, repo.Spec.VolumeNamespace)
+		}
+	case isBackupRepositoryNotFoundError(err):
+		target = newBackupRepository(repo.Namespace, key)
+		target.Annotations = map[string]string{velerov1api.MigratedFromBackupRepositoryAnnotation: repo.Name}
+		target.Spec.MaintenanceFrequency = repo.Spec.MaintenanceFrequency
+		target.Spec.VerificationFrequency = repo.Spec.VerificationFrequency
+		target.Spec.VerificationReadDataPercent = repo.Spec.VerificationReadDataPercent
+	default:
+		return nil, err
+	}
+
+	if target.Spec.ResticIdentifier == "" {
+		if target.Spec.ResticIdentifier, err = repoconfig.GetRepoIdentifier(targetLocation, repo.Spec.VolumeNamespace); err != nil {
+			return nil, err
+		}
+	}
+
+	// the copy is encrypted with the same key as repo
+	if repo.Spec.KeySecret != "" {
+		secretName, err := copyRepoKey(ctx, cli, repo, target)
+		if err != nil {
+			return nil, err
+		}
+		target.Spec.KeySecret = secretName
+	}
+
+	return target, nil
+}
+
+// copyRepoKey stores the key of repo in the own key Secret of target, and returns the Secret's name.
+func copyRepoKey(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, target *velerov1api.BackupRepository) (string, error) {
+	source := &corev1api.Secret{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: repo.Spec.KeySecret}, source); err != nil {
+		return "", errors.Wrapf(err, "error getting secret %s", repo.Spec.KeySecret)
+	}
+	if keys.PendingRepoKey(source) != "" {
+		return "", errors.New("the key rotation of the repository was interrupted, rotate its key again before migrating it")
+	}
+
+	secretName := keys.RepoKeySecretName(target)
+	secret := &corev1api.Secret{}
+	err := cli.Get(ctx, client.ObjectKey{Namespace: repo.Namespace, Name: secretName}, secret)
+	if err == nil {
+		if !keys.SameRepoKey(secret, source) {
+			return "", errors.Errorf("secret %s holds a different key than the repository's", secretName)
+		}
+		return secretName, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return "", errors.Wrapf(err, "error getting secret %s", secretName)
+	}
+
+	secret = keys.NewRepoKeySecret(target)
+	keys.CopyRepoKey(secret, source)
+	if err := cli.Create(ctx, secret); err != nil {
+		return "", errors.Wrapf(err, "error creating secret %s", secretName)
+	}
+	return secretName, nil
+}
+
+// updatePodVolumeBackups updates the pod volume backups with data in repo, of the backups in
+// backupStore, to refer to the snapshots in target. ids are the IDs of the snapshots in target by
+// their ID in repo. It returns the number of backups that were updated, which doesn't include the
+// ones an earlier migration of repo updated already.
+//
+// The backups in the cluster are left alone. They're the backups in the original location, whose
+// repository still has their snapshots, and they're deleted from it with them.
+func updatePodVolumeBackups(repo, target *velerov1api.BackupRepository, ids map[string]string,
+	backupStore persistence.BackupStore, log logrus.FieldLogger) (int, error) {
+	backupNames, err := backupStore.ListBackups()
+	if err != nil {
+		return 0, errors.Wrap(err, "error listing backups in the target location")
+	}
+
+	updated := 0
+	for _, backupName := range backupNames {
+		pvbs, err := backupStore.GetPodVolumeBackups(backupName)
+		if err != nil {
+			return updated, errors.Wrapf(err, "error getting pod volume backups of backup %s", backupName)
+		}
+
+		changed := false
+		for _, pvb := range pvbs {
+			if !podVolumeBackupInRepo(pvb, repo) {
+				continue
+			}
+			if pvb.Status.SnapshotID != "" {
+				id, found := ids[pvb.Status.SnapshotID]
+				if !found {
+					return updated, errors.Errorf("error updating backup %s: snapshot %s of pod volume backup %s wasn't copied", backupName, pvb.Status.SnapshotID, pvb.Name)
+				}
+				pvb.Status.SnapshotID = id
+			}
+			pvb.Spec.BackupStorageLocation = target.Spec.BackupStorageLocation
+			pvb.Spec.RepoIdentifier = target.Spec.ResticIdentifier
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+
+		if err := backupStore.PutPodVolumeBackups(backupName, pvbs); err != nil {
+			return updated, errors.Wrapf(err, "error storing pod volume backups of backup %s", backupName)
+		}
+		log.WithField("backup", backupName).Info("Updated pod volume backups")
+		updated++
+	}
+
+	return updated, nil
+}
+
+// BuildMigrationJob returns a job that migrates repo to the backup storage location targetLocation.
+// The job runs the init containers of the Velero server too, so that it has the object store
+// plugins that the backup metadata in the target location is accessed with.
+func BuildMigrationJob(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, config MaintenanceJobConfig, targetLocation string) (*batchv1api.Job, error) {
+	job, err := buildRepositoryJob(ctx, cli, repo, config, RepositoryJobMigration, "copy", "--to-location="+targetLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getServerDeployment(ctx, cli, repo.Namespace, config)
+	if err != nil {
+		return nil, err
+	}
+	job.Spec.Template.Spec.InitContainers = deployment.Spec.Template.Spec.InitContainers
+	job.Annotations = map[string]string{velerov1api.MigrateBackupRepositoryAnnotation: targetLocation}
+
+	return job, nil
+}
+
+// GetMigrationStatus returns the status of a finished migration job, as reported in the
+// termination message of its pod.
+func GetMigrationStatus(ctx context.Context, cli client.Client, job *batchv1api.Job) velerov1api.BackupRepositoryMigrationStatus {
+	status := velerov1api.BackupRepositoryMigrationStatus{
+		Job:            job.Name,
+		TargetLocation: job.Annotations[velerov1api.MigrateBackupRepositoryAnnotation],
+		Result:         velerov1api.BackupRepositoryMigrationSucceeded,
+		StartTimestamp: job.Status.StartTime,
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1api.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1api.JobComplete:
+			status.CompleteTimestamp = &metav1.Time{Time: condition.LastTransitionTime.Time}
+		case batchv1api.JobFailed:
+			status.Result = velerov1api.BackupRepositoryMigrationFailed
+			status.CompleteTimestamp = &metav1.Time{Time: condition.LastTransitionTime.Time}
+			status.Message = condition.Message
+		}
+	}
+
+	terminated, err := getJobTermination(ctx, cli, job)
+	if err != nil {
+		status.Result = velerov1api.BackupRepositoryMigrationFailed
+		status.Message = appendMessage(status.Message, err.Error())
+		return status
+	}
+
+	status.StartTimestamp = &metav1.Time{Time: terminated.StartedAt.Time}
+	status.CompleteTimestamp = &metav1.Time{Time: terminated.FinishedAt.Time}
+
+	result := MigrationResult{}
+	if err := json.Unmarshal([]byte(terminated.Message), &result); err != nil {
+		// the job didn't get to report its result, so the message is the end of its log
+		status.Result = velerov1api.BackupRepositoryMigrationFailed
+		status.Message = appendMessage(status.Message, terminated.Message)
+		return status
+	}
+	status.TargetRepository = result.TargetRepository
+	status.Snapshots = result.Snapshots
+	status.Backups = result.Backups
+	if result.Error != "" {
+		status.Result = velerov1api.BackupRepositoryMigrationFailed
+		status.Message = result.Error
+	}
+
+	return status
+}
@@ -0,0 +1,338 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/repository/keys"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func newMigrationTestRepo(keySecret string) *velerov1api.BackupRepository {
+	repo := newMaintenanceTestRepo()
+	repo.Spec = velerov1api.BackupRepositorySpec{
+		VolumeNamespace:       "ns-1",
+		BackupStorageLocation: "default",
+		RepositoryType:        velerov1api.BackupRepositoryTypeRestic,
+		ResticIdentifier:      "azure:bucket-1:/restic/ns-1",
+		MaintenanceFrequency:  metav1.Duration{Duration: time.Hour},
+		KeySecret:             keySecret,
+	}
+	return repo
+}
+
+func newMigrationTestLocation() *velerov1api.BackupStorageLocation {
+	return builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-2").Provider("azure").Bucket("bucket-2").Result()
+}
+
+func newMigrationTestPVB(name, backup, namespace, snapshotID string) *velerov1api.PodVolumeBackup {
+	return builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).
+		ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backup)).
+		BackupStorageLocation("default").PodNamespace(namespace).SnapshotID(snapshotID).Result()
+}
+
+func TestMigrateRepoErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *velerov1api.BackupStorageLocation
+		existing *velerov1api.BackupRepository
+		manager  *fakeMaintenanceManager
+		expected string
+	}{
+		{
+			name:     "repository is in the target location already",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result(),
+			manager:  &fakeMaintenanceManager{},
+			expected: "repository is in backup storage location default already",
+		},
+		{
+			name:     "target location is read-only",
+			location: builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "location-2").AccessMode(velerov1api.BackupStorageLocationAccessModeReadOnly).Result(),
+			manager:  &fakeMaintenanceManager{},
+			expected: "backup storage location location-2 is read-only",
+		},
+		{
+			name:     "target location has another repository for the namespace",
+			location: newMigrationTestLocation(),
+			existing: newBackupRepository(velerov1api.DefaultNamespace, BackupRepositoryKey{"ns-1", "location-2", velerov1api.BackupRepositoryTypeRestic}),
+			manager:  &fakeMaintenanceManager{},
+			expected: "backup storage location location-2 has a repository for namespace ns-1 already",
+		},
+		{
+			name:     "connect error",
+			location: newMigrationTestLocation(),
+			manager:  &fakeMaintenanceManager{connectErr: errors.New("repository not found")},
+			expected: "error connecting to repository: repository not found",
+		},
+		{
+			name:     "copy error",
+			location: newMigrationTestLocation(),
+			manager:  &fakeMaintenanceManager{copyErr: errors.New("snapshots abc weren't found in the target repository after copying")},
+			expected: "error copying repository: snapshots abc weren't found in the target repository after copying",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := newMigrationTestRepo("")
+			cli := velerotest.NewFakeControllerRuntimeClient(t, repo)
+			if test.existing != nil {
+				test.existing.Name = "existing"
+				require.NoError(t, cli.Create(context.Background(), test.existing))
+			}
+
+			result := MigrateRepo(context.Background(), cli, test.manager, repo, test.location, new(persistencemocks.BackupStore), velerotest.NewLogger())
+			assert.Equal(t, MigrationResult{Error: test.expected}, result)
+
+			// the copy's BackupRepository is only created after the repository was copied
+			expectedRepos := 1
+			if test.existing != nil {
+				expectedRepos++
+			}
+			repos := &velerov1api.BackupRepositoryList{}
+			require.NoError(t, cli.List(context.Background(), repos))
+			assert.Len(t, repos.Items, expectedRepos)
+		})
+	}
+}
+
+func TestMigrateRepo(t *testing.T) {
+	repo := newMigrationTestRepo("")
+	inCluster := newMigrationTestPVB("pvb-1", "backup-1", "ns-1", "aaaa")
+	otherBackup := newMigrationTestPVB("pvb-2", "backup-3", "ns-1", "cccc")
+	cli := velerotest.NewFakeControllerRuntimeClient(t, repo, inCluster, otherBackup)
+
+	manager := &fakeMaintenanceManager{copyIDs: map[string]string{"aaaa": "bbbb", "cccc": "dddd"}}
+
+	backupStore := new(persistencemocks.BackupStore)
+	backupStore.On("ListBackups").Return([]string{"backup-1", "backup-2"}, nil)
+	backupStore.On("GetPodVolumeBackups", "backup-1").Return([]*velerov1api.PodVolumeBackup{
+		newMigrationTestPVB("pvb-1", "backup-1", "ns-1", "aaaa"),
+		newMigrationTestPVB("pvb-3", "backup-1", "ns-2", "eeee"),
+	}, nil)
+	backupStore.On("GetPodVolumeBackups", "backup-2").Return([]*velerov1api.PodVolumeBackup{
+		newMigrationTestPVB("pvb-4", "backup-2", "ns-2", "ffff"),
+	}, nil)
+	var stored []*velerov1api.PodVolumeBackup
+	backupStore.On("PutPodVolumeBackups", "backup-1", mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).([]*velerov1api.PodVolumeBackup)
+	}).Return(nil)
+
+	result := MigrateRepo(context.Background(), cli, manager, repo, newMigrationTestLocation(), backupStore, velerotest.NewLogger())
+	require.Empty(t, result.Error)
+	assert.Equal(t, 2, result.Snapshots)
+	assert.Equal(t, 1, result.Backups)
+	backupStore.AssertExpectations(t)
+
+	// the copy was made to the target location
+	assert.Equal(t, "location-2", manager.copyTarget.Spec.BackupStorageLocation)
+	assert.Equal(t, "azure:bucket-2:/restic/ns-1", manager.copyTarget.Spec.ResticIdentifier)
+
+	target := &velerov1api.BackupRepository{}
+	require.NoError(t, cli.Get(context.Background(), client.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: result.TargetRepository}, target))
+	assert.Equal(t, repo.Name, target.Annotations[velerov1api.MigratedFromBackupRepositoryAnnotation])
+	assert.Equal(t, "azure:bucket-2:/restic/ns-1", target.Spec.ResticIdentifier)
+	assert.Equal(t, repo.Spec.MaintenanceFrequency, target.Spec.MaintenanceFrequency)
+
+	// only the pod volume backups in the repository were updated
+	require.Len(t, stored, 2)
+	assert.Equal(t, "location-2", stored[0].Spec.BackupStorageLocation)
+	assert.Equal(t, "azure:bucket-2:/restic/ns-1", stored[0].Spec.RepoIdentifier)
+	assert.Equal(t, "bbbb", stored[0].Status.SnapshotID)
+	assert.Equal(t, "default", stored[1].Spec.BackupStorageLocation)
+	assert.Equal(t, "eeee", stored[1].Status.SnapshotID)
+
+	// the backups in the cluster still refer to the original repository, which has their snapshots
+	pvb := &velerov1api.PodVolumeBackup{}
+	require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(inCluster), pvb))
+	assert.Equal(t, "default", pvb.Spec.BackupStorageLocation)
+	assert.Equal(t, "aaaa", pvb.Status.SnapshotID)
+	require.NoError(t, cli.Get(context.Background(), client.ObjectKeyFromObject(otherBackup), pvb))
+	assert.Equal(t, "default", pvb.Spec.BackupStorageLocation)
+	assert.Equal(t, "cccc", pvb.Status.SnapshotID)
+
+	// a migration that's run again reuses the copy, and skips the backups that were updated already
+	resumedStore := new(persistencemocks.BackupStore)
+	resumedStore.On("ListBackups").Return([]string{"backup-1", "backup-2"}, nil)
+	resumedStore.On("GetPodVolumeBackups", "backup-1").Return(stored, nil)
+	resumedStore.On("GetPodVolumeBackups", "backup-2").Return([]*velerov1api.PodVolumeBackup{
+		newMigrationTestPVB("pvb-4", "backup-2", "ns-2", "ffff"),
+	}, nil)
+	result = MigrateRepo(context.Background(), cli, manager, repo, newMigrationTestLocation(), resumedStore, velerotest.NewLogger())
+	require.Empty(t, result.Error)
+	assert.Equal(t, target.Name, result.TargetRepository)
+	assert.Equal(t, 0, result.Backups)
+	resumedStore.AssertNotCalled(t, "PutPodVolumeBackups", mock.Anything, mock.Anything)
+	assert.Equal(t, "bbbb", stored[0].Status.SnapshotID)
+}
+
+func TestMigrateRepoMissingSnapshot(t *testing.T) {
+	repo := newMigrationTestRepo("")
+	cli := velerotest.NewFakeControllerRuntimeClient(t, repo)
+
+	backupStore := new(persistencemocks.BackupStore)
+	backupStore.On("ListBackups").Return([]string{"backup-1"}, nil)
+	backupStore.On("GetPodVolumeBackups", "backup-1").Return([]*velerov1api.PodVolumeBackup{
+		newMigrationTestPVB("pvb-1", "backup-1", "ns-1", "aaaa"),
+	}, nil)
+
+	manager := &fakeMaintenanceManager{copyIDs: map[string]string{}}
+	result := MigrateRepo(context.Background(), cli, manager, repo, newMigrationTestLocation(), backupStore, velerotest.NewLogger())
+	assert.Equal(t, "error updating backup backup-1: snapshot aaaa of pod volume backup pvb-1 wasn't copied", result.Error)
+	assert.NotEmpty(t, result.TargetRepository)
+	backupStore.AssertNotCalled(t, "PutPodVolumeBackups", mock.Anything, mock.Anything)
+}
+
+func TestMigrateRepoCopiesKey(t *testing.T) {
+	source := newMigrationTestRepo("")
+	secret := newKeyTestSecret(source, "repo-key", "", "3")
+	repo := newMigrationTestRepo(secret.Name)
+	cli := velerotest.NewFakeControllerRuntimeClient(t, repo, secret)
+
+	backupStore := new(persistencemocks.BackupStore)
+	backupStore.On("ListBackups").Return([]string{}, nil)
+
+	manager := &fakeMaintenanceManager{}
+	result := MigrateRepo(context.Background(), cli, manager, repo, newMigrationTestLocation(), backupStore, velerotest.NewLogger())
+	require.Empty(t, result.Error)
+
+	targetSecret := keys.RepoKeySecretName(manager.copyTarget)
+	assert.Equal(t, targetSecret, manager.copyTarget.Spec.KeySecret)
+	copied := getKeyTestSecret(t, cli, targetSecret)
+	assert.True(t, keys.SameRepoKey(copied, secret))
+	assert.Equal(t, 3, keys.KeyGeneration(copied))
+
+	// the key of a repository whose key rotation was interrupted may not be the one it's
+	// encrypted with
+	keys.SetPendingRepoKey(secret, "new-key")
+	require.NoError(t, cli.Update(context.Background(), secret))
+	result = MigrateRepo(context.Background(), cli, manager, repo, newMigrationTestLocation(), backupStore, velerotest.NewLogger())
+	assert.Equal(t, "the key rotation of the repository was interrupted, rotate its key again before migrating it", result.Error)
+}
+
+func TestBuildMigrationJob(t *testing.T) {
+	repo := newMaintenanceTestRepo()
+
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	deployment.Spec.Template.Spec.InitContainers = []corev1api.Container{{Name: "velero-plugin-for-aws", Image: "velero/velero-plugin-for-aws:main"}}
+	cli := velerotest.NewFakeControllerRuntimeClient(t, deployment)
+
+	job, err := BuildMigrationJob(context.Background(), cli, repo, MaintenanceJobConfig{}, "location-2")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ns-1-default-restic-abcde-copy-", job.GenerateName)
+	assert.Equal(t, RepositoryJobMigration, job.Labels[velerov1api.BackupRepositoryJobLabel])
+	assert.Equal(t, "location-2", job.Annotations[velerov1api.MigrateBackupRepositoryAnnotation])
+	assert.Equal(t, deployment.Spec.Template.Spec.InitContainers, job.Spec.Template.Spec.InitContainers)
+
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "velero-repo-migration", container.Name)
+	assert.Equal(t, []string{"repo", "copy", repo.Name, "--namespace=velero", "--to-location=location-2"}, container.Args)
+}
+
+func TestGetMigrationStatus(t *testing.T) {
+	created := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	started, finished := created.Add(10*time.Second), created.Add(50*time.Second)
+
+	tests := []struct {
+		name     string
+		job      *batchv1api.Job
+		pod      *corev1api.Pod
+		expected velerov1api.BackupRepositoryMigrationStatus
+	}{
+		{
+			name: "succeeded",
+			job:  newRepositoryJob("job-1", RepositoryJobMigration, created, batchv1api.JobComplete),
+			pod:  newMaintenancePod("job-1", `{"targetRepository":"ns-1-location-2-restic-xyz12","snapshots":3,"backups":2}`, started, finished),
+			expected: velerov1api.BackupRepositoryMigrationStatus{
+				Job:               "job-1",
+				TargetLocation:    "location-2",
+				TargetRepository:  "ns-1-location-2-restic-xyz12",
+				Result:            velerov1api.BackupRepositoryMigrationSucceeded,
+				Snapshots:         3,
+				Backups:           2,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+			},
+		},
+		{
+			name: "failed with an error",
+			job:  newRepositoryJob("job-1", RepositoryJobMigration, created, batchv1api.JobFailed),
+			pod:  newMaintenancePod("job-1", `{"snapshots":3,"error":"error listing backups in the target location"}`, started, finished),
+			expected: velerov1api.BackupRepositoryMigrationStatus{
+				Job:               "job-1",
+				TargetLocation:    "location-2",
+				Result:            velerov1api.BackupRepositoryMigrationFailed,
+				Snapshots:         3,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+				Message:           "error listing backups in the target location",
+			},
+		},
+		{
+			name: "pod not found",
+			job:  newRepositoryJob("job-1", RepositoryJobMigration, created, batchv1api.JobComplete),
+			expected: velerov1api.BackupRepositoryMigrationStatus{
+				Job:               "job-1",
+				TargetLocation:    "location-2",
+				Result:            velerov1api.BackupRepositoryMigrationFailed,
+				CompleteTimestamp: &metav1.Time{Time: created.Add(time.Minute)},
+				Message:           "unable to find the result of job job-1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t)
+			if test.pod != nil {
+				require.NoError(t, cli.Create(context.Background(), test.pod))
+			}
+
+			test.job.Annotations = map[string]string{velerov1api.MigrateBackupRepositoryAnnotation: "location-2"}
+
+			status := GetMigrationStatus(context.Background(), cli, test.job)
+			assert.Equal(t, test.expected.Job, status.Job)
+			assert.Equal(t, test.expected.TargetLocation, status.TargetLocation)
+			assert.Equal(t, test.expected.TargetRepository, status.TargetRepository)
+			assert.Equal(t, test.expected.Result, status.Result)
+			assert.Equal(t, test.expected.Snapshots, status.Snapshots)
+			assert.Equal(t, test.expected.Backups, status.Backups)
+			assert.Equal(t, test.expected.Message, status.Message)
+			if test.expected.StartTimestamp != nil {
+				assert.True(t, test.expected.StartTimestamp.Equal(status.StartTimestamp))
+			}
+			assert.True(t, test.expected.CompleteTimestamp.Equal(status.CompleteTimestamp))
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	repository "github.com/vmware-tanzu/velero/pkg/repository"
 
+	provider "github.com/vmware-tanzu/velero/pkg/repository/provider"
+
 	time "time"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -18,6 +20,20 @@ type Manager struct {
 	mock.Mock
 }
 
+// ChangePassword provides a mock function with given fields: repo, newPassword
+func (_m *Manager) ChangePassword(repo *v1.BackupRepository, newPassword string) error {
+	ret := _m.Called(repo, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository, string) error); ok {
+		r0 = rf(repo, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ConnectToRepo provides a mock function with given fields: repo
 func (_m *Manager) ConnectToRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
@@ -32,6 +48,29 @@ func (_m *Manager) ConnectToRepo(repo *v1.BackupRepository) error {
 	return r0
 }
 
+// CopyRepo provides a mock function with given fields: repo, target
+func (_m *Manager) CopyRepo(repo *v1.BackupRepository, target *v1.BackupRepository) (map[string]string, error) {
+	ret := _m.Called(repo, target)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository, *v1.BackupRepository) map[string]string); ok {
+		r0 = rf(repo, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository, *v1.BackupRepository) error); ok {
+		r1 = rf(repo, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DefaultMaintenanceFrequency provides a mock function with given fields: repo
 func (_m *Manager) DefaultMaintenanceFrequency(repo *v1.BackupRepository) (time.Duration, error) {
 	ret := _m.Called(repo)
@@ -109,6 +148,69 @@ func (_m *Manager) PruneRepo(repo *v1.BackupRepository) error {
 	return r0
 }
 
+// RepoSize provides a mock function with given fields: repo
+func (_m *Manager) RepoSize(repo *v1.BackupRepository) (int64, error) {
+	ret := _m.Called(repo)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) int64); ok {
+		r0 = rf(repo)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository) error); ok {
+		r1 = rf(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepoStats provides a mock function with given fields: repo
+func (_m *Manager) RepoStats(repo *v1.BackupRepository) (provider.RepoStats, error) {
+	ret := _m.Called(repo)
+
+	var r0 provider.RepoStats
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository) provider.RepoStats); ok {
+		r0 = rf(repo)
+	} else {
+		r0 = ret.Get(0).(provider.RepoStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository) error); ok {
+		r1 = rf(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckRepo provides a mock function with given fields: repo, readDataPercent
+func (_m *Manager) CheckRepo(repo *v1.BackupRepository, readDataPercent int) (provider.CheckResult, error) {
+	ret := _m.Called(repo, readDataPercent)
+
+	var r0 provider.CheckResult
+	if rf, ok := ret.Get(0).(func(*v1.BackupRepository, int) provider.CheckResult); ok {
+		r0 = rf(repo, readDataPercent)
+	} else {
+		r0 = ret.Get(0).(provider.CheckResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*v1.BackupRepository, int) error); ok {
+		r1 = rf(repo, readDataPercent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnlockRepo provides a mock function with given fields: repo
 func (_m *Manager) UnlockRepo(repo *v1.BackupRepository) error {
 	ret := _m.Called(repo)
@@ -29,6 +29,46 @@ type RepoParam struct {
 	BackupRepo     *velerov1api.BackupRepository
 }
 
+// CheckResult is the result of checking the integrity of a backup repository
+type CheckResult struct {
+	// Damage describes the damage found in the repository, it's empty if no damage was found
+	Damage string
+
+	// DamagedSnapshots are the IDs of the snapshots whose data is damaged, it's empty if
+	// the damage can't be attributed to particular snapshots
+	DamagedSnapshots []string
+}
+
+// RepoStats is the storage usage of a backup repository
+type RepoStats struct {
+	// TotalSize is the size of the storage used by the repository, it's nil if the
+	// repository can't measure it
+	TotalSize *int64
+
+	// UniqueSize is the size of the deduplicated data of all the snapshots, as stored
+	UniqueSize int64
+
+	// LogicalSize is the size of the data of all the snapshots before deduplication, it's
+	// nil if the size of some of the snapshots is unknown
+	LogicalSize *int64
+
+	// SnapshotCount is the number of snapshots in the repository
+	SnapshotCount int
+
+	// Snapshots is the storage usage of each snapshot whose usage is known, by snapshot ID
+	Snapshots map[string]SnapshotStats
+}
+
+// SnapshotStats is the storage usage of a snapshot in a backup repository
+type SnapshotStats struct {
+	// LogicalSize is the size of the snapshot's data before deduplication
+	LogicalSize int64
+
+	// AddedSize is the size of the data the snapshot stored that no earlier snapshot had
+	// stored, it's nil if the repository can't attribute its data to snapshots
+	AddedSize *int64
+}
+
 // Provider defines the methods to manipulate a backup repository
 type Provider interface {
 	// InitRepo is to initialize a repository from a new storage place
@@ -46,6 +86,23 @@ type Provider interface {
 	// PruneRepo does a full prune/maintenance of the repository
 	PruneRepo(ctx context.Context, param RepoParam) error
 
+	// RepoSize returns the size of the storage used by the repository
+	RepoSize(ctx context.Context, param RepoParam) (int64, error)
+
+	// RepoStats returns the storage usage of the repository and of each of its snapshots
+	RepoStats(ctx context.Context, param RepoParam) (RepoStats, error)
+
+	// CheckRepo checks the integrity of the repository, and reads back
+	// readDataPercent percent of its data
+	CheckRepo(ctx context.Context, param RepoParam, readDataPercent int) (CheckResult, error)
+
+	// ChangePassword changes the password of the repository to newPassword
+	ChangePassword(ctx context.Context, param RepoParam, newPassword string) error
+
+	// CopyRepo copies the repository to the storage of targetParam, which must not hold a repository
+	// or hold an earlier copy of the repository, and checks that all of its snapshots are in the copy.
+	// It returns the ID of each snapshot in the copy by the snapshot's ID in the repository
+	CopyRepo(ctx context.Context, param RepoParam, targetParam RepoParam) (map[string]string, error)
 	// EnsureUnlockRepo esures to remove any stale file locks in the storage
 	EnsureUnlockRepo(ctx context.Context, param RepoParam) error
 
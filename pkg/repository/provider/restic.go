@@ -66,6 +66,47 @@ func (r *resticRepositoryProvider) PruneRepo(ctx context.Context, param RepoPara
 	return r.svc.PruneRepo(param.BackupLocation, param.BackupRepo)
 }
 
+func (r *resticRepositoryProvider) RepoSize(ctx context.Context, param RepoParam) (int64, error) {
+	return r.svc.RepoSize(param.BackupLocation, param.BackupRepo)
+}
+
+func (r *resticRepositoryProvider) RepoStats(ctx context.Context, param RepoParam) (RepoStats, error) {
+	uniqueSize, err := r.svc.RepoSize(param.BackupLocation, param.BackupRepo)
+	if err != nil {
+		return RepoStats{}, err
+	}
+	sizes, err := r.svc.SnapshotSizes(param.BackupLocation, param.BackupRepo)
+	if err != nil {
+		return RepoStats{}, err
+	}
+
+	// restic can't measure the storage the repository uses, which includes the data that no
+	// snapshot references until it's pruned
+	stats := RepoStats{UniqueSize: uniqueSize, SnapshotCount: len(sizes), Snapshots: make(map[string]SnapshotStats, len(sizes))}
+	var logicalSize int64
+	for id, size := range sizes {
+		added := size.AddedSize
+		stats.Snapshots[id] = SnapshotStats{LogicalSize: size.LogicalSize, AddedSize: &added}
+		logicalSize += size.LogicalSize
+	}
+	stats.LogicalSize = &logicalSize
+	return stats, nil
+}
+
+func (r *resticRepositoryProvider) CheckRepo(ctx context.Context, param RepoParam, readDataPercent int) (CheckResult, error) {
+	// restic doesn't report which snapshots the damage it finds belongs to
+	damage, err := r.svc.CheckRepo(param.BackupLocation, param.BackupRepo, readDataPercent)
+	return CheckResult{Damage: damage}, err
+}
+
+func (r *resticRepositoryProvider) ChangePassword(ctx context.Context, param RepoParam, newPassword string) error {
+	return r.svc.ChangePassword(param.BackupLocation, param.BackupRepo, newPassword)
+}
+
+func (r *resticRepositoryProvider) CopyRepo(ctx context.Context, param RepoParam, targetParam RepoParam) (map[string]string, error) {
+	return r.svc.CopyRepo(param.BackupLocation, param.BackupRepo, targetParam.BackupLocation, targetParam.BackupRepo)
+}
+
 func (r *resticRepositoryProvider) EnsureUnlockRepo(ctx context.Context, param RepoParam) error {
 	return r.svc.UnlockRepo(param.BackupLocation, param.BackupRepo)
 }
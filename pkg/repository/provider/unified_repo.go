@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -63,6 +64,11 @@ var funcTable = localFuncTable{
 const (
 	repoOpDescMaintain = "repo maintenance"
 	repoOpDescForget   = "forget"
+	repoOpDescSize     = "size"
+	repoOpDescStats    = "stats"
+	repoOpDescVerify   = "verify"
+	repoOpDescPassword = "change password"
+	repoOpDescCopy     = "copy"
 
 	repoConnectDesc = "unfied repo"
 )
@@ -227,6 +233,160 @@ func (urp *unifiedRepoProvider) PruneRepo(ctx context.Context, param RepoParam)
 	return nil
 }
 
+func (urp *unifiedRepoProvider) RepoSize(ctx context.Context, param RepoParam) (int64, error) {
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescSize),
+	)
+
+	if err != nil {
+		return 0, errors.Wrap(err, "error to get repo options")
+	}
+
+	size, err := urp.repoService.RepoSize(ctx, *repoOption)
+	if err != nil {
+		return 0, errors.Wrap(err, "error to get size of backup repo")
+	}
+
+	return size, nil
+}
+
+func (urp *unifiedRepoProvider) RepoStats(ctx context.Context, param RepoParam) (RepoStats, error) {
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescStats),
+	)
+
+	if err != nil {
+		return RepoStats{}, errors.Wrap(err, "error to get repo options")
+	}
+
+	repoStats, err := urp.repoService.Stats(ctx, *repoOption)
+	if err != nil {
+		return RepoStats{}, errors.Wrap(err, "error to get stats of backup repo")
+	}
+
+	stats := RepoStats{
+		TotalSize:     &repoStats.TotalSize,
+		UniqueSize:    repoStats.UniqueSize,
+		LogicalSize:   &repoStats.LogicalSize,
+		SnapshotCount: len(repoStats.Snapshots),
+		Snapshots:     make(map[string]SnapshotStats, len(repoStats.Snapshots)),
+	}
+	for id, snapshotStats := range repoStats.Snapshots {
+		added := snapshotStats.AddedSize
+		stats.Snapshots[string(id)] = SnapshotStats{LogicalSize: snapshotStats.LogicalSize, AddedSize: &added}
+	}
+
+	return stats, nil
+}
+
+func (urp *unifiedRepoProvider) CheckRepo(ctx context.Context, param RepoParam, readDataPercent int) (CheckResult, error) {
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescVerify),
+	)
+
+	if err != nil {
+		return CheckResult{}, errors.Wrap(err, "error to get repo options")
+	}
+
+	damaged, err := urp.repoService.Verify(ctx, *repoOption, readDataPercent)
+	if err != nil {
+		return CheckResult{}, errors.Wrap(err, "error to verify backup repo")
+	}
+
+	if len(damaged) == 0 {
+		return CheckResult{}, nil
+	}
+
+	result := CheckResult{}
+	for id := range damaged {
+		result.DamagedSnapshots = append(result.DamagedSnapshots, string(id))
+	}
+	sort.Strings(result.DamagedSnapshots)
+
+	first := result.DamagedSnapshots[0]
+	result.Damage = fmt.Sprintf("%d snapshots are damaged, snapshot %s: %s", len(damaged), first, damaged[udmrepo.ID(first)])
+
+	return result, nil
+}
+
+func (urp *unifiedRepoProvider) ChangePassword(ctx context.Context, param RepoParam, newPassword string) error {
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescPassword),
+	)
+
+	if err != nil {
+		return errors.Wrap(err, "error to get repo options")
+	}
+
+	err = urp.repoService.ChangePassword(ctx, *repoOption, newPassword)
+	if err != nil {
+		return errors.Wrap(err, "error to change password of backup repo")
+	}
+
+	return nil
+}
+
+func (urp *unifiedRepoProvider) CopyRepo(ctx context.Context, param RepoParam, targetParam RepoParam) (map[string]string, error) {
+	log := urp.log.WithFields(logrus.Fields{
+		"BSL name":        param.BackupLocation.Name,
+		"repo name":       param.BackupRepo.Name,
+		"repo UID":        param.BackupRepo.UID,
+		"target BSL name": targetParam.BackupLocation.Name,
+	})
+
+	repoOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, param),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)),
+		udmrepo.WithDescription(repoOpDescCopy),
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get repo options")
+	}
+
+	// the copy is only connected to while it's checked, it doesn't have a UID of its own yet
+	targetOption, err := udmrepo.NewRepoOptions(
+		udmrepo.WithPassword(urp, targetParam),
+		udmrepo.WithConfigFile(urp.workPath, string(param.BackupRepo.UID)+"-copy"),
+		udmrepo.WithGenOptions(
+			map[string]string{
+				udmrepo.GenOptionOwnerName:   udmrepo.GetRepoUser(),
+				udmrepo.GenOptionOwnerDomain: udmrepo.GetRepoDomain(),
+			},
+		),
+		udmrepo.WithStoreOptions(urp, targetParam),
+		udmrepo.WithDescription(repoOpDescCopy),
+	)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "error to get target repo options")
+	}
+
+	log.Debug("Start to copy repo")
+
+	ids, err := urp.repoService.Copy(ctx, *repoOption, *targetOption)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to copy backup repo")
+	}
+
+	log.WithField("snapshots", len(ids)).Debug("Copy repo complete")
+
+	result := make(map[string]string, len(ids))
+	for id, copyID := range ids {
+		result[string(id)] = string(copyID)
+	}
+
+	return result, nil
+}
+
 func (urp *unifiedRepoProvider) EnsureUnlockRepo(ctx context.Context, param RepoParam) error {
 	return nil
 }
@@ -333,7 +493,7 @@ func getRepoPassword(secretStore credentials.SecretStore, param RepoParam) (stri
 		return "", errors.New("invalid credentials interface")
 	}
 
-	rawPass, err := secretStore.Get(repokey.RepoKeySelector())
+	rawPass, err := secretStore.Get(repokey.RepoKeySelector(param.BackupRepo))
 	if err != nil {
 		return "", errors.Wrap(err, "error to get password")
 	}
@@ -19,6 +19,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	awscredentials "github.com/aws/aws-sdk-go/aws/credentials"
@@ -27,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerocredentials "github.com/vmware-tanzu/velero/internal/credentials"
 	credmock "github.com/vmware-tanzu/velero/internal/credentials/mocks"
@@ -793,3 +795,325 @@ func TestForget(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRepo(t *testing.T) {
+	testCases := []struct {
+		name           string
+		getter         *credmock.SecretStore
+		verifyReturn   map[udmrepo.ID]string
+		verifyError    error
+		expectedResult CheckResult
+		expectedErr    string
+	}{
+		{
+			name:        "get repo option fail",
+			expectedErr: "error to get repo options: error to get repo password: invalid credentials interface",
+		},
+		{
+			name:        "verify fail",
+			getter:      new(credmock.SecretStore),
+			verifyError: errors.New("fake-error"),
+			expectedErr: "error to verify backup repo: fake-error",
+		},
+		{
+			name:   "no damage",
+			getter: new(credmock.SecretStore),
+		},
+		{
+			name:   "damaged snapshots",
+			getter: new(credmock.SecretStore),
+			verifyReturn: map[udmrepo.ID]string{
+				"snapshot-2": "fake-damage-2",
+				"snapshot-1": "fake-damage-1",
+			},
+			expectedResult: CheckResult{
+				Damage:           "2 snapshots are damaged, snapshot snapshot-1: fake-damage-1",
+				DamagedSnapshots: []string{"snapshot-1", "snapshot-2"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			funcTable = localFuncTable{
+				getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+				getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+			}
+
+			var secretStore velerocredentials.SecretStore
+			if tc.getter != nil {
+				tc.getter.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+				secretStore = tc.getter
+			}
+
+			repoService := new(reposervicenmocks.BackupRepoService)
+			repoService.On("Verify", mock.Anything, mock.Anything, 10).Return(tc.verifyReturn, tc.verifyError)
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: secretStore,
+				},
+				repoService: repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			result, err := urp.CheckRepo(context.Background(), RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{},
+			}, 10)
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRepoStats(t *testing.T) {
+	added, total, logical := int64(10), int64(100), int64(40)
+	testCases := []struct {
+		name          string
+		getter        *credmock.SecretStore
+		statsReturn   udmrepo.RepoStats
+		statsError    error
+		expectedStats RepoStats
+		expectedErr   string
+	}{
+		{
+			name:        "get repo option fail",
+			expectedErr: "error to get repo options: error to get repo password: invalid credentials interface",
+		},
+		{
+			name:        "stats fail",
+			getter:      new(credmock.SecretStore),
+			statsError:  errors.New("fake-error"),
+			expectedErr: "error to get stats of backup repo: fake-error",
+		},
+		{
+			name:   "succeed",
+			getter: new(credmock.SecretStore),
+			statsReturn: udmrepo.RepoStats{
+				TotalSize:   100,
+				UniqueSize:  10,
+				LogicalSize: 40,
+				Snapshots: map[udmrepo.ID]udmrepo.SnapshotStats{
+					"snapshot-1": {LogicalSize: 20, AddedSize: 10},
+				},
+			},
+			expectedStats: RepoStats{
+				TotalSize:     &total,
+				UniqueSize:    10,
+				LogicalSize:   &logical,
+				SnapshotCount: 1,
+				Snapshots: map[string]SnapshotStats{
+					"snapshot-1": {LogicalSize: 20, AddedSize: &added},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			funcTable = localFuncTable{
+				getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+				getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+			}
+
+			var secretStore velerocredentials.SecretStore
+			if tc.getter != nil {
+				tc.getter.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+				secretStore = tc.getter
+			}
+
+			repoService := new(reposervicenmocks.BackupRepoService)
+			repoService.On("Stats", mock.Anything, mock.Anything).Return(tc.statsReturn, tc.statsError)
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: secretStore,
+				},
+				repoService: repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			stats, err := urp.RepoStats(context.Background(), RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{},
+			})
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStats, stats)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	testCases := []struct {
+		name        string
+		getter      *credmock.SecretStore
+		changeError error
+		expectedErr string
+	}{
+		{
+			name:        "get repo option fail",
+			expectedErr: "error to get repo options: error to get repo password: invalid credentials interface",
+		},
+		{
+			name:        "change password fail",
+			getter:      new(credmock.SecretStore),
+			changeError: errors.New("fake-error"),
+			expectedErr: "error to change password of backup repo: fake-error",
+		},
+		{
+			name:   "succeed",
+			getter: new(credmock.SecretStore),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var secretStore velerocredentials.SecretStore
+			if tc.getter != nil {
+				tc.getter.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+				secretStore = tc.getter
+			}
+
+			repoService := new(reposervicenmocks.BackupRepoService)
+			repoService.On("ChangePassword", mock.Anything, mock.MatchedBy(func(option udmrepo.RepoOptions) bool {
+				return option.RepoPassword == "fake-password"
+			}), "fake-new-password").Return(tc.changeError)
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: secretStore,
+				},
+				repoService: repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			err := urp.ChangePassword(context.Background(), RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{},
+			}, "fake-new-password")
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCopyRepo(t *testing.T) {
+	testCases := []struct {
+		name        string
+		getter      *credmock.SecretStore
+		funcTable   localFuncTable
+		copyIDs     map[udmrepo.ID]udmrepo.ID
+		copyError   error
+		expected    map[string]string
+		expectedErr string
+	}{
+		{
+			name:        "get repo option fail",
+			expectedErr: "error to get repo options: error to get repo password: invalid credentials interface",
+		},
+		{
+			name:   "get target repo option fail",
+			getter: new(credmock.SecretStore),
+			funcTable: localFuncTable{
+				getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+					return map[string]string{}, errors.New("fake-store-option-error")
+				},
+			},
+			expectedErr: "error to get target repo options: error to get storage variables: fake-store-option-error",
+		},
+		{
+			name:   "copy fail",
+			getter: new(credmock.SecretStore),
+			funcTable: localFuncTable{
+				getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+					return map[string]string{"fake-option": "fake-value"}, nil
+				},
+				getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+			},
+			copyError:   errors.New("fake-error"),
+			expectedErr: "error to copy backup repo: fake-error",
+		},
+		{
+			name:   "succeed",
+			getter: new(credmock.SecretStore),
+			funcTable: localFuncTable{
+				getStorageVariables: func(*velerov1api.BackupStorageLocation, string, string) (map[string]string, error) {
+					return map[string]string{"fake-option": "fake-value"}, nil
+				},
+				getStorageCredentials: func(*velerov1api.BackupStorageLocation, velerocredentials.FileStore) (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+			},
+			copyIDs:  map[udmrepo.ID]udmrepo.ID{"snapshot-1": "snapshot-1"},
+			expected: map[string]string{"snapshot-1": "snapshot-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			funcTable = tc.funcTable
+
+			var secretStore velerocredentials.SecretStore
+			if tc.getter != nil {
+				tc.getter.On("Get", mock.Anything, mock.Anything).Return("fake-password", nil)
+				secretStore = tc.getter
+			}
+
+			repoService := new(reposervicenmocks.BackupRepoService)
+			repoService.On("Copy", mock.Anything, mock.MatchedBy(func(option udmrepo.RepoOptions) bool {
+				return option.RepoPassword == "fake-password" && strings.HasSuffix(option.ConfigFilePath, "fake-uid.conf")
+			}), mock.MatchedBy(func(option udmrepo.RepoOptions) bool {
+				return option.RepoPassword == "fake-password" && strings.HasSuffix(option.ConfigFilePath, "fake-uid-copy.conf") &&
+					option.StorageOptions["fake-option"] == "fake-value"
+			})).Return(tc.copyIDs, tc.copyError)
+
+			urp := unifiedRepoProvider{
+				credentialGetter: velerocredentials.CredentialGetter{
+					FromSecret: secretStore,
+				},
+				repoService: repoService,
+				log:         velerotest.NewLogger(),
+			}
+
+			ids, err := urp.CopyRepo(context.Background(), RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{ObjectMeta: metav1.ObjectMeta{UID: "fake-uid"}},
+			}, RepoParam{
+				BackupLocation: &velerov1api.BackupStorageLocation{},
+				BackupRepo:     &velerov1api.BackupRepository{},
+			})
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, ids)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package restic
 
 import (
+	"encoding/json"
 	"os"
+	"os/exec"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -31,11 +35,20 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
 
+const (
+	// repoContainsErrors is what restic reports when checking a repository finds damage in it.
+	repoContainsErrors = "repository contains errors"
+
+	// repoNotInitialized is what restic reports when there's no repository at a location.
+	repoNotInitialized = "Is there a repository at the following location?"
+)
+
 func NewRepositoryService(store credentials.FileStore, fs filesystem.Interface, log logrus.FieldLogger) *RepositoryService {
 	return &RepositoryService{
 		credentialsFileStore: store,
 		fileSystem:           fs,
 		log:                  log,
+		runCommand:           veleroexec.RunCommand,
 	}
 }
 
@@ -43,10 +56,11 @@ type RepositoryService struct {
 	credentialsFileStore credentials.FileStore
 	fileSystem           filesystem.Interface
 	log                  logrus.FieldLogger
+	runCommand           func(*exec.Cmd) (string, string, error)
 }
 
 func (r *RepositoryService) InitRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
-	return r.exec(restic.InitCommand(repo.Spec.ResticIdentifier), bsl)
+	return r.exec(restic.InitCommand(repo.Spec.ResticIdentifier), bsl, repo)
 }
 
 func (r *RepositoryService) ConnectToRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
@@ -57,41 +71,383 @@ func (r *RepositoryService) ConnectToRepo(bsl *velerov1api.BackupStorageLocation
 	// "--last" is replaced by "--latest=1" in restic v0.12.1
 	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--latest=1")
 
-	return r.exec(snapshotsCmd, bsl)
+	return r.exec(snapshotsCmd, bsl, repo)
 }
 
 func (r *RepositoryService) PruneRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
-	return r.exec(restic.PruneCommand(repo.Spec.ResticIdentifier), bsl)
+	return r.exec(restic.PruneCommand(repo.Spec.ResticIdentifier), bsl, repo)
 }
 
 func (r *RepositoryService) UnlockRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
-	return r.exec(restic.UnlockCommand(repo.Spec.ResticIdentifier), bsl)
+	return r.exec(restic.UnlockCommand(repo.Spec.ResticIdentifier), bsl, repo)
 }
 
 func (r *RepositoryService) Forget(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository, snapshotID string) error {
-	return r.exec(restic.ForgetCommand(repo.Spec.ResticIdentifier, snapshotID), bsl)
+	return r.exec(restic.ForgetCommand(repo.Spec.ResticIdentifier, snapshotID), bsl, repo)
 }
 
-func (r *RepositoryService) DefaultMaintenanceFrequency() time.Duration {
-	return restic.DefaultMaintenanceFrequency
+// RepoSize returns the size of the deduplicated data stored in the repository.
+func (r *RepositoryService) RepoSize(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) (int64, error) {
+	stdout, err := r.run(restic.RepoStatsCommand(repo.Spec.ResticIdentifier), bsl, repo)
+	if err != nil {
+		return 0, err
+	}
+
+	var stats struct {
+		TotalSize int64 `json:"total_size"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &stats); err != nil {
+		return 0, errors.Wrapf(err, "error unmarshalling restic stats result, stdout=%s", stdout)
+	}
+
+	return stats.TotalSize, nil
 }
 
-func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation) error {
-	file, err := r.credentialsFileStore.Path(repokey.RepoKeySelector())
+// SnapshotSize is the size of a snapshot's data.
+type SnapshotSize struct {
+	// LogicalSize is the size of the data the snapshot backed up.
+	LogicalSize int64
+
+	// AddedSize is the size of the data the snapshot stored that no earlier snapshot had
+	// stored, as stored.
+	AddedSize int64
+}
+
+// SnapshotSizes returns the sizes of the repository's snapshots, by the short snapshot ID that
+// pod volume backups record. restic only records the sizes of the snapshots it takes since
+// version 0.17, so they're measured with the stats of the snapshots: the logical size of each
+// snapshot is its restore size, and the data it added is the growth of the raw data of the
+// snapshots up to it, oldest first, which takes a stats command per snapshot.
+func (r *RepositoryService) SnapshotSizes(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) (map[string]SnapshotSize, error) {
+	snapshotsCmd := restic.SnapshotsCommand(repo.Spec.ResticIdentifier)
+	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--json")
+	stdout, err := r.run(snapshotsCmd, bsl, repo)
 	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []struct {
+		ID      string    `json:"id"`
+		ShortID string    `json:"short_id"`
+		Time    time.Time `json:"time"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &snapshots); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshalling restic snapshots result, stdout=%s", stdout)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+
+	sizes := make(map[string]SnapshotSize, len(snapshots))
+	var ids []string
+	var stored int64
+	for _, snapshot := range snapshots {
+		logicalSize, err := r.snapshotStats(bsl, repo, "restore-size", []string{snapshot.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, snapshot.ID)
+		total, err := r.snapshotStats(bsl, repo, "raw-data", ids)
+		if err != nil {
+			return nil, err
+		}
+
+		sizes[snapshot.ShortID] = SnapshotSize{LogicalSize: logicalSize, AddedSize: total - stored}
+		stored = total
+	}
+
+	return sizes, nil
+}
+
+// snapshotStats returns the total size restic's stats report in mode for snapshotIDs.
+func (r *RepositoryService) snapshotStats(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository, mode string, snapshotIDs []string) (int64, error) {
+	stdout, err := r.run(restic.SnapshotStatsCommand(repo.Spec.ResticIdentifier, mode, snapshotIDs), bsl, repo)
+	if err != nil {
+		return 0, err
+	}
+
+	var stats struct {
+		TotalSize int64 `json:"total_size"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &stats); err != nil {
+		return 0, errors.Wrapf(err, "error unmarshalling restic stats result, stdout=%s", stdout)
+	}
+	return stats.TotalSize, nil
+}
+
+// CheckRepo checks the integrity of the repository and reads back readDataPercent percent of its
+// data. It returns a description of the damage found in the repository, or an empty string if
+// no damage was found.
+func (r *RepositoryService) CheckRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository, readDataPercent int) (string, error) {
+	cmd := restic.CheckCommand(repo.Spec.ResticIdentifier, readDataPercent)
+	stdout, stderr, err := r.output(cmd, bsl, repo)
+	if err == nil {
+		return "", nil
+	}
+	if !strings.Contains(stderr, repoContainsErrors) {
+		return "", errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+	}
+
+	var damage []string
+	for _, line := range strings.Split(stderr, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.Contains(line, repoContainsErrors) {
+			damage = append(damage, line)
+		}
+	}
+	if len(damage) == 0 {
+		return repoContainsErrors, nil
+	}
+	return strings.Join(damage, "; "), nil
+}
+
+// ChangePassword changes the password of the repository to newPassword, by adding a key with
+// newPassword and removing the key that's currently used.
+func (r *RepositoryService) ChangePassword(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository, newPassword string) error {
+	stdout, err := r.run(restic.KeyListCommand(repo.Spec.ResticIdentifier), bsl, repo)
+	if err != nil {
+		return err
+	}
+
+	var keys []struct {
+		Current bool   `json:"current"`
+		ID      string `json:"id"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &keys); err != nil {
+		return errors.Wrapf(err, "error unmarshalling restic key list result, stdout=%s", stdout)
+	}
+
+	var currentKey string
+	for _, key := range keys {
+		if key.Current {
+			currentKey = key.ID
+			break
+		}
+	}
+	if currentKey == "" {
+		return errors.Errorf("error finding the current key in restic key list result, stdout=%s", stdout)
+	}
+
+	file, err := r.fileSystem.TempFile("", "repo-password-"+repo.Name)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write([]byte(newPassword)); err != nil {
+		file.Close()
+		return errors.WithStack(err)
+	}
+	if err := file.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := r.exec(restic.KeyAddCommand(repo.Spec.ResticIdentifier, file.Name()), bsl, repo); err != nil {
 		return err
 	}
+
+	// restic doesn't remove the key that's used to run the command
+	removeCmd := restic.KeyRemoveCommand(repo.Spec.ResticIdentifier, currentKey)
+	removeCmd.PasswordFile = file.Name()
+	return r.exec(removeCmd, bsl, repo)
+}
+
+// CopyRepo copies the snapshots of repo to target in targetBSL, and initializes target with the
+// chunker parameters of repo if it doesn't exist yet. restic gives the copies of the snapshots
+// new IDs, so it returns the short ID of each snapshot's copy by the short ID of the snapshot,
+// once the copies of all the snapshots are listed in target.
+func (r *RepositoryService) CopyRepo(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository,
+	targetBSL *velerov1api.BackupStorageLocation, target *velerov1api.BackupRepository) (map[string]string, error) {
+	passwordFile, err := r.credentialsFileStore.Path(repokey.RepoKeySelector(repo))
+	if err != nil {
+		return nil, err
+	}
 	// ignore error since there's nothing we can do and it's a temp file.
-	defer os.Remove(file)
+	defer os.Remove(passwordFile)
+
+	targetPasswordFile, err := r.credentialsFileStore.Path(repokey.RepoKeySelector(target))
+	if err != nil {
+		return nil, err
+	}
+	// ignore error since there's nothing we can do and it's a temp file.
+	defer os.Remove(targetPasswordFile)
+
+	// the target exists already if an earlier copy was interrupted
+	if err := r.ConnectToRepo(targetBSL, target); err != nil {
+		if !strings.Contains(err.Error(), repoNotInitialized) {
+			return nil, err
+		}
+		initCmd := restic.CopyInitCommand(target.Spec.ResticIdentifier, repo.Spec.ResticIdentifier, passwordFile)
+		if _, err := r.runWith(initCmd, targetBSL, target, bsl); err != nil {
+			return nil, err
+		}
+	}
+
+	copyCmd := restic.CopyCommand(repo.Spec.ResticIdentifier, target.Spec.ResticIdentifier, targetPasswordFile)
+	if _, err := r.runWith(copyCmd, bsl, repo, targetBSL); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := r.listSnapshots(bsl, repo)
+	if err != nil {
+		return nil, err
+	}
+	copies, err := r.listSnapshots(targetBSL, target)
+	if err != nil {
+		return nil, err
+	}
 
-	cmd.PasswordFile = file
+	// restic records the ID of the snapshot a copy was made from as its original
+	copyIDs := make(map[string]string, len(copies))
+	for _, copy := range copies {
+		if copy.Original != "" {
+			copyIDs[copy.Original] = copy.ShortID
+		}
+	}
+
+	ids := make(map[string]string, len(snapshots))
+	var missing []string
+	for _, snapshot := range snapshots {
+		original := snapshot.Original
+		if original == "" {
+			original = snapshot.ID
+		}
+		copyID, found := copyIDs[original]
+		if !found {
+			missing = append(missing, snapshot.ShortID)
+			continue
+		}
+		ids[snapshot.ShortID] = copyID
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf("snapshots %s weren't found in the target repository after copying", strings.Join(missing, ", "))
+	}
+
+	return ids, nil
+}
+
+// resticSnapshot is a snapshot as listed by restic's snapshots command.
+type resticSnapshot struct {
+	ID       string `json:"id"`
+	ShortID  string `json:"short_id"`
+	Original string `json:"original"`
+}
+
+// listSnapshots returns the snapshots of repo in bsl.
+func (r *RepositoryService) listSnapshots(bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) ([]resticSnapshot, error) {
+	snapshotsCmd := restic.SnapshotsCommand(repo.Spec.ResticIdentifier)
+	snapshotsCmd.ExtraFlags = append(snapshotsCmd.ExtraFlags, "--json")
+	stdout, err := r.run(snapshotsCmd, bsl, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []resticSnapshot
+	if err := json.Unmarshal([]byte(stdout), &snapshots); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshalling restic snapshots result, stdout=%s", stdout)
+	}
+	return snapshots, nil
+}
+
+func (r *RepositoryService) DefaultMaintenanceFrequency() time.Duration {
+	return restic.DefaultMaintenanceFrequency
+}
+
+func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) error {
+	_, err := r.run(cmd, bsl, repo)
+	return err
+}
+
+// run runs a restic command against repo in bsl, and returns its standard output.
+func (r *RepositoryService) run(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) (string, error) {
+	stdout, stderr, err := r.output(cmd, bsl, repo)
+	if err != nil {
+		return "", errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
+	}
+
+	return stdout, nil
+}
+
+// runWith runs a restic command against repo in bsl that also accesses a second repository in
+// secondaryBSL. restic reads the credentials of both repositories from the same environment
+// variables, so the command fails if the locations need different values of them.
+func (r *RepositoryService) runWith(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository,
+	secondaryBSL *velerov1api.BackupStorageLocation) (string, error) {
+	env, err := restic.CmdEnv(bsl, r.credentialsFileStore)
+	if err != nil {
+		return "", err
+	}
+	secondaryEnv, err := restic.CmdEnv(secondaryBSL, r.credentialsFileStore)
+	if err != nil {
+		return "", err
+	}
+
+	values := make(map[string]string, len(env))
+	for _, variable := range env {
+		name, value := splitEnvVar(variable)
+		values[name] = value
+	}
+	for _, variable := range secondaryEnv {
+		name, value := splitEnvVar(variable)
+		existing, found := values[name]
+		if !found {
+			cmd.Env = append(cmd.Env, variable)
+			continue
+		}
+		if existing != value {
+			return "", errors.Errorf("backup storage locations %s and %s need different values of %s, which restic can't use in one command",
+				bsl.Name, secondaryBSL.Name, name)
+		}
+	}
+
+	if secondaryBSL.Spec.ObjectStorage != nil && secondaryBSL.Spec.ObjectStorage.CACert != nil {
+		caCertFile, err := restic.TempCACertFile(secondaryBSL.Spec.ObjectStorage.CACert, secondaryBSL.Name, r.fileSystem)
+		if err != nil {
+			return "", errors.Wrap(err, "error creating temp cacert file")
+		}
+		// ignore error since there's nothing we can do and it's a temp file.
+		defer os.Remove(caCertFile)
+		cmd.ExtraFlags = append(cmd.ExtraFlags, "--cacert="+caCertFile)
+	}
+
+	if skipTLSRet := restic.GetInsecureSkipTLSVerifyFromBSL(secondaryBSL, r.log); len(skipTLSRet) > 0 {
+		cmd.ExtraFlags = append(cmd.ExtraFlags, skipTLSRet)
+	}
+
+	return r.run(cmd, bsl, repo)
+}
+
+// splitEnvVar returns the name and value of an environment variable in NAME=VALUE form.
+func splitEnvVar(variable string) (string, string) {
+	parts := strings.SplitN(variable, "=", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// output runs a restic command against repo in bsl, and returns its standard output and
+// standard error. The command authenticates with repo's key unless it has a password file set.
+func (r *RepositoryService) output(cmd *restic.Command, bsl *velerov1api.BackupStorageLocation, repo *velerov1api.BackupRepository) (string, string, error) {
+	var err error
+	if cmd.PasswordFile == "" {
+		file, err := r.credentialsFileStore.Path(repokey.RepoKeySelector(repo))
+		if err != nil {
+			return "", "", err
+		}
+		// ignore error since there's nothing we can do and it's a temp file.
+		defer os.Remove(file)
+
+		cmd.PasswordFile = file
+	}
 
 	// if there's a caCert on the ObjectStorage, write it to disk so that it can be passed to restic
 	var caCertFile string
 	if bsl.Spec.ObjectStorage != nil && bsl.Spec.ObjectStorage.CACert != nil {
 		caCertFile, err = restic.TempCACertFile(bsl.Spec.ObjectStorage.CACert, bsl.Name, r.fileSystem)
 		if err != nil {
-			return errors.Wrap(err, "error creating temp cacert file")
+			return "", "", errors.Wrap(err, "error creating temp cacert file")
 		}
 		// ignore error since there's nothing we can do and it's a temp file.
 		defer os.Remove(caCertFile)
@@ -100,9 +456,9 @@ func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupSto
 
 	env, err := restic.CmdEnv(bsl, r.credentialsFileStore)
 	if err != nil {
-		return err
+		return "", "", err
 	}
-	cmd.Env = env
+	cmd.Env = append(env, cmd.Env...)
 
 	// #4820: restrieve insecureSkipTLSVerify from BSL configuration for
 	// AWS plugin. If nothing is return, that means insecureSkipTLSVerify
@@ -112,16 +468,13 @@ func (r *RepositoryService) exec(cmd *restic.Command, bsl *velerov1api.BackupSto
 		cmd.ExtraFlags = append(cmd.ExtraFlags, skipTLSRet)
 	}
 
-	stdout, stderr, err := veleroexec.RunCommand(cmd.Cmd())
+	stdout, stderr, err := r.runCommand(cmd.Cmd())
 	r.log.WithFields(logrus.Fields{
 		"repository": cmd.RepoName(),
 		"command":    cmd.String(),
 		"stdout":     stdout,
 		"stderr":     stderr,
 	}).Debugf("Ran restic command")
-	if err != nil {
-		return errors.Wrapf(err, "error running command=%s, stdout=%s, stderr=%s", cmd.String(), stdout, stderr)
-	}
 
-	return nil
+	return stdout, stderr, err
 }
@@ -0,0 +1,359 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/restic"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// fakeCommand is the result of the restic commands whose arguments contain all of match.
+type fakeCommand struct {
+	match  []string
+	stdout string
+	stderr string
+	err    error
+}
+
+// fakeRunner runs restic commands by returning the result of the first of its commands that
+// matches them, and records the arguments and environment of the commands it ran.
+type fakeRunner struct {
+	commands []fakeCommand
+	ran      []string
+	envs     [][]string
+}
+
+func (f *fakeRunner) run(cmd *exec.Cmd) (string, string, error) {
+	args := strings.Join(cmd.Args, " ")
+	f.ran = append(f.ran, args)
+	f.envs = append(f.envs, cmd.Env)
+	for _, command := range f.commands {
+		matched := true
+		for _, match := range command.match {
+			if !strings.Contains(args, match) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return command.stdout, command.stderr, command.err
+		}
+	}
+	return "", "", fmt.Errorf("unexpected command %s", args)
+}
+
+func newTestRepositoryService(runner *fakeRunner) *RepositoryService {
+	service := NewRepositoryService(velerotest.NewFakeCredentialsFileStore("/tmp/credentials/repo-password", nil), velerotest.NewFakeFileSystem(), velerotest.NewLogger())
+	service.runCommand = runner.run
+	return service
+}
+
+func newTestRepository(name, location, identifier string) (*velerov1api.BackupStorageLocation, *velerov1api.BackupRepository) {
+	bsl := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, location).Provider("velero.io/aws").Bucket("bucket").Result()
+	repo := &velerov1api.BackupRepository{}
+	repo.Namespace = velerov1api.DefaultNamespace
+	repo.Name = name
+	repo.Spec.BackupStorageLocation = location
+	repo.Spec.ResticIdentifier = identifier
+	return bsl, repo
+}
+
+func TestSnapshotSizes(t *testing.T) {
+	// restic 0.13 doesn't record the sizes of snapshots in them
+	runner := &fakeRunner{commands: []fakeCommand{
+		{
+			match: []string{"snapshots", "--json"},
+			stdout: `[
+				{"time":"2023-01-02T00:00:00Z","tree":"bbbb","paths":["/host_pods/pod-2/volumes"],"hostname":"velero","tags":["backup=backup-2"],"id":"2222222222","short_id":"22222222"},
+				{"time":"2023-01-01T00:00:00Z","tree":"aaaa","paths":["/host_pods/pod-1/volumes"],"hostname":"velero","tags":["backup=backup-1"],"id":"1111111111","short_id":"11111111"}
+			]`,
+		},
+		{match: []string{"--mode=restore-size", "1111111111"}, stdout: `{"total_size":1000,"total_file_count":10}`},
+		{match: []string{"--mode=restore-size", "2222222222"}, stdout: `{"total_size":1500,"total_file_count":12}`},
+		{match: []string{"--mode=raw-data", "1111111111 2222222222"}, stdout: `{"total_size":500,"total_blob_count":5}`},
+		{match: []string{"--mode=raw-data", "1111111111"}, stdout: `{"total_size":400,"total_blob_count":4}`},
+	}}
+	bsl, repo := newTestRepository("ns-1-default-restic-abcde", "default", "s3:s3.amazonaws.com/bucket/restic/ns-1")
+
+	sizes, err := newTestRepositoryService(runner).SnapshotSizes(bsl, repo)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]SnapshotSize{
+		"11111111": {LogicalSize: 1000, AddedSize: 400},
+		"22222222": {LogicalSize: 1500, AddedSize: 100},
+	}, sizes)
+	for _, ran := range runner.ran {
+		assert.Contains(t, ran, "--repo=s3:s3.amazonaws.com/bucket/restic/ns-1")
+	}
+
+	runner = &fakeRunner{commands: []fakeCommand{{match: []string{"snapshots"}, stdout: "not json"}}}
+	_, err = newTestRepositoryService(runner).SnapshotSizes(bsl, repo)
+	assert.Error(t, err)
+
+	runner = &fakeRunner{commands: []fakeCommand{
+		{match: []string{"snapshots", "--json"}, stdout: `[{"time":"2023-01-01T00:00:00Z","id":"1111111111","short_id":"11111111"}]`},
+		{match: []string{"stats"}, err: fmt.Errorf("stats failed")},
+	}}
+	_, err = newTestRepositoryService(runner).SnapshotSizes(bsl, repo)
+	assert.Error(t, err)
+}
+
+func TestChangePassword(t *testing.T) {
+	keys := `[{"current":false,"id":"aaaa1111","userName":"velero"},{"current":true,"id":"bbbb2222","userName":"velero"}]`
+
+	tests := []struct {
+		name          string
+		commands      []fakeCommand
+		expectedError string
+		expectedRan   []string
+	}{
+		{
+			name: "a key with the new password is added and the current key is removed",
+			commands: []fakeCommand{
+				{match: []string{" list"}, stdout: keys},
+				{match: []string{" add"}},
+				{match: []string{" remove bbbb2222"}},
+			},
+			expectedRan: []string{" list", " add", " remove bbbb2222"},
+		},
+		{
+			name: "the current key isn't removed if the new key can't be added",
+			commands: []fakeCommand{
+				{match: []string{" list"}, stdout: keys},
+				{match: []string{" add"}, stderr: "permission denied", err: fmt.Errorf("exit status 1")},
+			},
+			expectedError: "permission denied",
+			expectedRan:   []string{" list", " add"},
+		},
+		{
+			name: "no key is added if the current key isn't listed",
+			commands: []fakeCommand{
+				{match: []string{" list"}, stdout: `[{"current":false,"id":"aaaa1111"}]`},
+			},
+			expectedError: "error finding the current key",
+			expectedRan:   []string{" list"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := &fakeRunner{commands: test.commands}
+			service := newTestRepositoryService(runner)
+			bsl, repo := newTestRepository("ns-1-default-restic-abcde", "default", "s3:s3.amazonaws.com/bucket/restic/ns-1")
+
+			err := service.ChangePassword(bsl, repo, "new-password")
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, runner.ran, len(test.expectedRan))
+			for i, expected := range test.expectedRan {
+				assert.Contains(t, runner.ran[i], expected)
+			}
+			if len(runner.ran) < 3 {
+				return
+			}
+
+			// the new key is added with the new password, and the old key is removed with it
+			newPasswordFile := regexp.MustCompile(`--new-password-file=(\S+)`).FindStringSubmatch(runner.ran[1])
+			require.Len(t, newPasswordFile, 2)
+			password, err := service.fileSystem.ReadFile(newPasswordFile[1])
+			require.NoError(t, err)
+			assert.Equal(t, "new-password", string(password))
+			assert.Contains(t, runner.ran[2], "--password-file="+newPasswordFile[1])
+		})
+	}
+}
+
+func TestCopyRepo(t *testing.T) {
+	sourceRepo := "--repo=s3:s3.amazonaws.com/bucket/restic/ns-1"
+	targetRepo := "--repo=s3:s3.amazonaws.com/bucket-2/restic/ns-1"
+	notInitialized := fakeCommand{
+		match:  []string{"snapshots", "--latest=1", targetRepo},
+		stderr: "Fatal: unable to open config file: Stat: 404 Not Found\nIs there a repository at the following location?",
+		err:    fmt.Errorf("exit status 1"),
+	}
+	// snapshot 22222222 is a copy of a snapshot of another repository itself
+	sourceSnapshots := fakeCommand{
+		match:  []string{"snapshots", "--json", sourceRepo},
+		stdout: `[{"id":"1111111111","short_id":"11111111"},{"id":"2222222222","short_id":"22222222","original":"0000000000"}]`,
+	}
+	copies := fakeCommand{
+		match:  []string{"snapshots", "--json", targetRepo},
+		stdout: `[{"id":"aaaaaaaaaa","short_id":"aaaaaaaa","original":"1111111111"},{"id":"bbbbbbbbbb","short_id":"bbbbbbbb","original":"0000000000"}]`,
+	}
+
+	tests := []struct {
+		name          string
+		commands      []fakeCommand
+		expected      map[string]string
+		expectedError string
+		expectedRan   []string
+	}{
+		{
+			name: "a new target is initialized with the chunker parameters of the source",
+			commands: []fakeCommand{
+				notInitialized,
+				{match: []string{"init", targetRepo, "--copy-chunker-params"}},
+				{match: []string{"copy", sourceRepo, "--repo2=s3:s3.amazonaws.com/bucket-2/restic/ns-1"}},
+				sourceSnapshots,
+				copies,
+			},
+			expected:    map[string]string{"11111111": "aaaaaaaa", "22222222": "bbbbbbbb"},
+			expectedRan: []string{"snapshots", "init", "copy", "snapshots", "snapshots"},
+		},
+		{
+			name: "an existing target is copied to",
+			commands: []fakeCommand{
+				{match: []string{"snapshots", "--latest=1", targetRepo}},
+				{match: []string{"copy", sourceRepo}},
+				sourceSnapshots,
+				copies,
+			},
+			expected:    map[string]string{"11111111": "aaaaaaaa", "22222222": "bbbbbbbb"},
+			expectedRan: []string{"snapshots", "copy", "snapshots", "snapshots"},
+		},
+		{
+			name: "the target can't be connected to",
+			commands: []fakeCommand{
+				{match: []string{"snapshots", "--latest=1", targetRepo}, stderr: "wrong password or no key found", err: fmt.Errorf("exit status 1")},
+			},
+			expectedError: "wrong password or no key found",
+			expectedRan:   []string{"snapshots"},
+		},
+		{
+			name: "snapshots that weren't copied fail the copy",
+			commands: []fakeCommand{
+				{match: []string{"snapshots", "--latest=1", targetRepo}},
+				{match: []string{"copy", sourceRepo}},
+				sourceSnapshots,
+				{match: []string{"snapshots", "--json", targetRepo}, stdout: `[{"id":"aaaaaaaaaa","short_id":"aaaaaaaa","original":"1111111111"}]`},
+			},
+			expectedError: "snapshots 22222222 weren't found in the target repository after copying",
+			expectedRan:   []string{"snapshots", "copy", "snapshots", "snapshots"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := &fakeRunner{commands: test.commands}
+			bsl, repo := newTestRepository("ns-1-default-restic-abcde", "default", "s3:s3.amazonaws.com/bucket/restic/ns-1")
+			targetBSL, target := newTestRepository("ns-1-location-2-restic-fghij", "location-2", "s3:s3.amazonaws.com/bucket-2/restic/ns-1")
+
+			ids, err := newTestRepositoryService(runner).CopyRepo(bsl, repo, targetBSL, target)
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, ids)
+			}
+
+			require.Len(t, runner.ran, len(test.expectedRan))
+			for i, expected := range test.expectedRan {
+				assert.Contains(t, runner.ran[i], "restic "+expected+" ")
+			}
+		})
+	}
+}
+
+func TestRunWith(t *testing.T) {
+	withConfig := func(location string, config map[string]string) *velerov1api.BackupStorageLocation {
+		bsl, _ := newTestRepository("", location, "")
+		bsl.Spec.Config = config
+		return bsl
+	}
+	withCACert := withConfig("location-2", nil)
+	withCACert.Spec.ObjectStorage = &velerov1api.ObjectStorageLocation{Bucket: "bucket-2", CACert: []byte("ca-cert")}
+
+	tests := []struct {
+		name          string
+		bsl           *velerov1api.BackupStorageLocation
+		secondaryBSL  *velerov1api.BackupStorageLocation
+		expectedError string
+		expectedEnv   []string
+		expectedArgs  []string
+	}{
+		{
+			name:         "the variables of the secondary location are added",
+			bsl:          withConfig("default", nil),
+			secondaryBSL: withConfig("location-2", map[string]string{"profile": "profile-2"}),
+			expectedEnv:  []string{"AWS_PROFILE=profile-2"},
+		},
+		{
+			name:         "the locations may set the same values",
+			bsl:          withConfig("default", map[string]string{"profile": "profile-1"}),
+			secondaryBSL: withConfig("location-2", map[string]string{"profile": "profile-1"}),
+			expectedEnv:  []string{"AWS_PROFILE=profile-1"},
+		},
+		{
+			name:          "the locations can't set different values",
+			bsl:           withConfig("default", map[string]string{"profile": "profile-1"}),
+			secondaryBSL:  withConfig("location-2", map[string]string{"profile": "profile-2"}),
+			expectedError: "backup storage locations default and location-2 need different values of AWS_PROFILE, which restic can't use in one command",
+		},
+		{
+			name:         "the CA certificate of the secondary location is passed",
+			bsl:          withConfig("default", nil),
+			secondaryBSL: withCACert,
+			expectedArgs: []string{"--cacert="},
+		},
+		{
+			name:         "the TLS verification of the secondary location is skipped",
+			bsl:          withConfig("default", nil),
+			secondaryBSL: withConfig("location-2", map[string]string{"insecureSkipTLSVerify": "true"}),
+			expectedArgs: []string{"--insecure-tls=true"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := &fakeRunner{commands: []fakeCommand{{match: []string{"copy"}}}}
+			_, repo := newTestRepository("ns-1-default-restic-abcde", "default", "s3:s3.amazonaws.com/bucket/restic/ns-1")
+
+			cmd := restic.CopyCommand(repo.Spec.ResticIdentifier, "s3:s3.amazonaws.com/bucket-2/restic/ns-1", "/tmp/target-password")
+			_, err := newTestRepositoryService(runner).runWith(cmd, test.bsl, repo, test.secondaryBSL)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+				assert.Empty(t, runner.ran)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, runner.ran, 1)
+			for _, expected := range test.expectedEnv {
+				assert.Contains(t, runner.envs[0], expected)
+			}
+			for _, expected := range test.expectedArgs {
+				assert.Contains(t, runner.ran[0], expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// CollectStats returns the storage usage of repo, and of each backup with data in it. The
+// data that several backups share is attributed to the oldest of them.
+func CollectStats(ctx context.Context, cli client.Client, repoManager Manager, repo *velerov1api.BackupRepository, now time.Time) (*velerov1api.BackupRepositoryStats, error) {
+	repoStats, err := repoManager.RepoStats(repo)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting repository stats")
+	}
+
+	volumeBackups, err := ListVolumeBackups(ctx, cli, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &velerov1api.BackupRepositoryStats{
+		CollectionTimestamp: &metav1.Time{Time: now},
+		TotalBytes:          repoStats.TotalSize,
+		UniqueBytes:         repoStats.UniqueSize,
+		LogicalBytes:        repoStats.LogicalSize,
+		SnapshotCount:       repoStats.SnapshotCount,
+	}
+
+	backups := map[string]*velerov1api.BackupRepositoryBackupStats{}
+	for _, vb := range volumeBackups {
+		// the snapshots of deleted backups may still be referenced until their volume backups are gone
+		snapshotStats, found := repoStats.Snapshots[vb.SnapshotID]
+		if !found {
+			continue
+		}
+
+		backup := backups[vb.Backup]
+		if backup == nil {
+			backup = &velerov1api.BackupRepositoryBackupStats{Backup: vb.Backup}
+			backups[vb.Backup] = backup
+		}
+		backup.SnapshotCount++
+		backup.LogicalBytes += snapshotStats.LogicalSize
+		if snapshotStats.AddedSize != nil {
+			if backup.AddedBytes == nil {
+				backup.AddedBytes = new(int64)
+			}
+			*backup.AddedBytes += *snapshotStats.AddedSize
+		}
+	}
+
+	for _, backup := range backups {
+		stats.Backups = append(stats.Backups, *backup)
+	}
+	sort.Slice(stats.Backups, func(i, j int) bool {
+		return stats.Backups[i].Backup < stats.Backups[j].Backup
+	})
+
+	return stats, nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func newStatsTestPVB(name, backup, namespace, uploaderType, snapshotID string) *velerov1api.PodVolumeBackup {
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).ObjectMeta(builder.WithLabels(velerov1api.BackupNameLabel, backup)).
+		BackupStorageLocation("default").PodNamespace(namespace).SnapshotID(snapshotID).Result()
+	pvb.Spec.UploaderType = uploaderType
+	return pvb
+}
+
+func TestListVolumeBackups(t *testing.T) {
+	cli := velerotest.NewFakeControllerRuntimeClient(t,
+		newStatsTestPVB("pvb-1", "backup-1", "ns-1", "", "snapshot-1"),
+		newStatsTestPVB("pvb-2", "backup-2", "ns-1", "kopia", "snapshot-2"),
+		newStatsTestPVB("pvb-3", "backup-2", "ns-2", "kopia", "snapshot-3"),
+		newStatsTestPVB("pvb-4", "backup-2", "ns-1", "kopia", ""),
+		&velerov1api.DataUpload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       velerov1api.DefaultNamespace,
+				Name:            "du-1",
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Backup", Name: "backup-3", Controller: boolptr.True()}},
+			},
+			Spec:   velerov1api.DataUploadSpec{BackupStorageLocation: "default", SourceNamespace: "ns-1"},
+			Status: velerov1api.DataUploadStatus{SnapshotID: "snapshot-4"},
+		},
+	)
+
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "ns-1-default-restic"},
+		Spec:       velerov1api.BackupRepositorySpec{VolumeNamespace: "ns-1", BackupStorageLocation: "default"},
+	}
+	volumeBackups, err := ListVolumeBackups(context.Background(), cli, repo)
+	require.NoError(t, err)
+	assert.Equal(t, []VolumeBackup{{Name: "podvolumebackup/pvb-1", Backup: "backup-1", SnapshotID: "snapshot-1"}}, volumeBackups)
+
+	repo.Spec.RepositoryType = velerov1api.BackupRepositoryTypeKopia
+	volumeBackups, err = ListVolumeBackups(context.Background(), cli, repo)
+	require.NoError(t, err)
+	assert.Equal(t, []VolumeBackup{
+		{Name: "podvolumebackup/pvb-2", Backup: "backup-2", SnapshotID: "snapshot-2"},
+		{Name: "dataupload/du-1", Backup: "backup-3", SnapshotID: "snapshot-4"},
+	}, volumeBackups)
+}
+
+func TestCollectStats(t *testing.T) {
+	now := time.Now().Round(time.Second)
+	cli := velerotest.NewFakeControllerRuntimeClient(t,
+		newStatsTestPVB("pvb-1", "backup-1", "ns-1", "kopia", "snapshot-1"),
+		newStatsTestPVB("pvb-2", "backup-1", "ns-1", "kopia", "snapshot-2"),
+		newStatsTestPVB("pvb-3", "backup-2", "ns-1", "kopia", "snapshot-3"),
+		newStatsTestPVB("pvb-4", "backup-3", "ns-1", "kopia", "deleted-snapshot"),
+	)
+	repo := &velerov1api.BackupRepository{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "ns-1-default-kopia"},
+		Spec: velerov1api.BackupRepositorySpec{
+			VolumeNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+			RepositoryType:        velerov1api.BackupRepositoryTypeKopia,
+		},
+	}
+
+	manager := &fakeMaintenanceManager{repoStats: provider.RepoStats{
+		TotalSize:     int64Ptr(1000),
+		UniqueSize:    600,
+		LogicalSize:   int64Ptr(1500),
+		SnapshotCount: 4,
+		Snapshots: map[string]provider.SnapshotStats{
+			"snapshot-1": {LogicalSize: 500, AddedSize: int64Ptr(400)},
+			"snapshot-2": {LogicalSize: 300, AddedSize: int64Ptr(100)},
+			"snapshot-3": {LogicalSize: 500, AddedSize: int64Ptr(0)},
+			"orphaned":   {LogicalSize: 200, AddedSize: int64Ptr(100)},
+		},
+	}}
+	stats, err := CollectStats(context.Background(), cli, manager, repo, now)
+	require.NoError(t, err)
+	assert.Equal(t, &velerov1api.BackupRepositoryStats{
+		CollectionTimestamp: &metav1.Time{Time: now},
+		TotalBytes:          int64Ptr(1000),
+		UniqueBytes:         600,
+		LogicalBytes:        int64Ptr(1500),
+		SnapshotCount:       4,
+		Backups: []velerov1api.BackupRepositoryBackupStats{
+			{Backup: "backup-1", SnapshotCount: 2, LogicalBytes: 800, AddedBytes: int64Ptr(500)},
+			{Backup: "backup-2", SnapshotCount: 1, LogicalBytes: 500, AddedBytes: int64Ptr(0)},
+		},
+	}, stats)
+
+	// the added bytes are omitted if the repository can't attribute its data to snapshots
+	manager.repoStats.Snapshots = map[string]provider.SnapshotStats{"snapshot-3": {LogicalSize: 500}}
+	stats, err = CollectStats(context.Background(), cli, manager, repo, now)
+	require.NoError(t, err)
+	assert.Equal(t, []velerov1api.BackupRepositoryBackupStats{{Backup: "backup-2", SnapshotCount: 1, LogicalBytes: 500}}, stats.Backups)
+
+	manager.statsErr = errors.New("repository is locked")
+	_, err = CollectStats(context.Background(), cli, manager, repo, now)
+	assert.EqualError(t, err, "error getting repository stats: repository is locked")
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
 	"github.com/kopia/kopia/repo/compression"
 	"github.com/kopia/kopia/repo/content/index"
 	"github.com/kopia/kopia/repo/maintenance"
@@ -203,6 +204,47 @@ func (ks *kopiaRepoService) Maintain(ctx context.Context, repoOption udmrepo.Rep
 	return nil
 }
 
+func (ks *kopiaRepoService) RepoSize(ctx context.Context, repoOption udmrepo.RepoOptions) (int64, error) {
+	repoConfig := repoOption.ConfigFilePath
+	if repoConfig == "" {
+		return 0, errors.New("invalid config file path")
+	}
+
+	if _, err := os.Stat(repoConfig); os.IsNotExist(err) {
+		return 0, errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
+	}
+
+	repoCtx := logging.SetupKopiaLog(ctx, ks.logger)
+
+	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		c := r.Close(repoCtx)
+		if c != nil {
+			ks.logger.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	dr, ok := r.(repo.DirectRepository)
+	if !ok {
+		return 0, errors.Errorf("unexpected repo type %T", r)
+	}
+
+	var size int64
+	err = dr.BlobReader().ListBlobs(repoCtx, "", func(bm blob.Metadata) error {
+		size += bm.Length
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "error to list repo blobs")
+	}
+
+	return size, nil
+}
+
 func (ks *kopiaRepoService) DefaultMaintenanceFrequency() time.Duration {
 	return defaultMaintainCheckPeriod
 }
@@ -517,6 +559,14 @@ func (lt *logThrottle) shouldLog() bool {
 
 func openKopiaRepo(ctx context.Context, configFile string, password string) (repo.Repository, error) {
 	r, err := kopiaRepoOpen(ctx, configFile, password, &repo.Options{})
+	if errors.Is(err, repo.ErrInvalidPassword) {
+		// the password may have been changed by another client while the format blob
+		// encrypted with the old one is still cached, read it from the storage again
+		if rmErr := removeCachedFormatBlobs(configFile); rmErr == nil {
+			r, err = kopiaRepoOpen(ctx, configFile, password, &repo.Options{})
+		}
+	}
+
 	if os.IsNotExist(err) {
 		return nil, errors.Wrap(err, "error to open repo, repo doesn't exist")
 	}
@@ -0,0 +1,289 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+// formatBlobIDs are the blobs that make a storage a kopia repository. They're copied last, so
+// that the target of an interrupted copy isn't taken for a repository.
+var formatBlobIDs = []blob.ID{repo.BlobCfgBlobID, repo.FormatBlobID}
+
+func (ks *kopiaRepoService) Copy(ctx context.Context, repoOption udmrepo.RepoOptions, targetOption udmrepo.RepoOptions) (map[udmrepo.ID]udmrepo.ID, error) {
+	repoConfig := repoOption.ConfigFilePath
+	if repoConfig == "" || targetOption.ConfigFilePath == "" {
+		return nil, errors.New("invalid config file path")
+	}
+
+	if _, err := os.Stat(repoConfig); os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
+	}
+
+	repoCtx := logging.SetupKopiaLog(ctx, ks.logger)
+
+	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		c := r.Close(repoCtx)
+		if c != nil {
+			ks.logger.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	dr, ok := r.(repo.DirectRepository)
+	if !ok {
+		return nil, errors.Errorf("unexpected repo type %T", r)
+	}
+
+	// snapshots created while the blobs are copied may not be copied completely
+	snapshotIDs, err := snapshot.ListSnapshotManifests(repoCtx, r, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list snapshots")
+	}
+
+	targetStore, err := setupBackendStore(repoCtx, targetOption.StorageType, targetOption.StorageOptions)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to setup target backend storage")
+	}
+
+	st, err := targetStore.store.Connect(repoCtx, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to connect to target storage")
+	}
+
+	defer func() {
+		c := st.Close(repoCtx)
+		if c != nil {
+			ks.logger.WithError(c).Error("Failed to close target storage")
+		}
+	}()
+
+	if err := copyBlobs(repoCtx, dr.BlobReader(), st, ks.logger); err != nil {
+		return nil, err
+	}
+
+	copied, err := listCopiedSnapshots(repoCtx, st, targetOption, ks.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[udmrepo.ID]udmrepo.ID, len(snapshotIDs))
+	var missing []string
+	for _, id := range snapshotIDs {
+		if !copied[udmrepo.ID(id)] {
+			missing = append(missing, string(id))
+			continue
+		}
+		ids[udmrepo.ID(id)] = udmrepo.ID(id)
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, errors.Errorf("snapshots %s weren't found in the target repo after copying", strings.Join(missing, ", "))
+	}
+
+	return ids, nil
+}
+
+// copyBlobs copies the blobs of src that dst doesn't have to dst. dst must be empty, or hold the
+// same repository as src from an earlier copy.
+func copyBlobs(ctx context.Context, src blob.Reader, dst blob.Storage, logger logrus.FieldLogger) error {
+	if err := ensureSameRepo(ctx, src, dst); err != nil {
+		return err
+	}
+
+	existing := map[blob.ID]int64{}
+	err := dst.ListBlobs(ctx, "", func(bm blob.Metadata) error {
+		existing[bm.BlobID] = bm.Length
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "error to list target blobs")
+	}
+
+	var toCopy []blob.ID
+	var size int64
+	err = src.ListBlobs(ctx, "", func(bm blob.Metadata) error {
+		if length, found := existing[bm.BlobID]; found && length == bm.Length {
+			return nil
+		}
+		for _, id := range formatBlobIDs {
+			if bm.BlobID == id {
+				return nil
+			}
+		}
+		toCopy = append(toCopy, bm.BlobID)
+		size += bm.Length
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "error to list repo blobs")
+	}
+
+	logger.Infof("Copying %d blobs (%d bytes), %d blobs are in the target already", len(toCopy), size, len(existing))
+
+	for _, id := range append(toCopy, formatBlobIDs...) {
+		if err := copyBlob(ctx, src, dst, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyBlob copies the blob with the given ID from src to dst. Blobs that were deleted from src
+// after they were listed aren't copied.
+func copyBlob(ctx context.Context, src blob.Reader, dst blob.Storage, id blob.ID) error {
+	data := &blobData{}
+	if err := src.GetBlob(ctx, id, 0, -1, data); err != nil {
+		if errors.Is(err, blob.ErrBlobNotFound) {
+			return nil
+		}
+		return errors.Wrapf(err, "error to read blob %s", id)
+	}
+
+	if err := dst.PutBlob(ctx, id, data, blob.PutOptions{}); err != nil {
+		return errors.Wrapf(err, "error to write blob %s to target", id)
+	}
+
+	return nil
+}
+
+// ensureSameRepo returns an error if dst holds a repository other than the one in src.
+func ensureSameRepo(ctx context.Context, src blob.Reader, dst blob.Storage) error {
+	targetFormat := &blobData{}
+	if err := dst.GetBlob(ctx, repo.FormatBlobID, 0, -1, targetFormat); err != nil {
+		if errors.Is(err, blob.ErrBlobNotFound) {
+			return nil
+		}
+		return errors.Wrap(err, "error to read target format blob")
+	}
+
+	format := &blobData{}
+	if err := src.GetBlob(ctx, repo.FormatBlobID, 0, -1, format); err != nil {
+		return errors.Wrap(err, "error to read format blob")
+	}
+
+	id, err := repoUniqueID(format.Bytes())
+	if err != nil {
+		return err
+	}
+	targetID, err := repoUniqueID(targetFormat.Bytes())
+	if err != nil {
+		return err
+	}
+	if id != targetID {
+		return errors.New("target storage holds a different repo")
+	}
+
+	return nil
+}
+
+// repoUniqueID returns the unique ID of a repository from its format blob.
+func repoUniqueID(format []byte) (string, error) {
+	var f struct {
+		UniqueID string `json:"uniqueID"`
+	}
+	if err := json.Unmarshal(format, &f); err != nil {
+		return "", errors.Wrap(err, "error to parse format blob")
+	}
+	if f.UniqueID == "" {
+		return "", errors.New("format blob has no unique ID")
+	}
+	return f.UniqueID, nil
+}
+
+// listCopiedSnapshots connects to the repository copied to st, and returns the IDs of its
+// snapshots. The connection is removed afterwards.
+func listCopiedSnapshots(ctx context.Context, st blob.Storage, targetOption udmrepo.RepoOptions, logger logrus.FieldLogger) (map[udmrepo.ID]bool, error) {
+	if err := connectWithStorage(ctx, st, targetOption); err != nil {
+		return nil, errors.Wrap(err, "error to connect to target repo")
+	}
+
+	defer func() {
+		if err := repo.Disconnect(ctx, targetOption.ConfigFilePath); err != nil {
+			logger.WithError(err).Error("Failed to disconnect from target repo")
+		}
+	}()
+
+	r, err := openKopiaRepo(ctx, targetOption.ConfigFilePath, targetOption.RepoPassword)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to open target repo")
+	}
+
+	defer func() {
+		c := r.Close(ctx)
+		if c != nil {
+			logger.WithError(c).Error("Failed to close target repo")
+		}
+	}()
+
+	snapshotIDs, err := snapshot.ListSnapshotManifests(ctx, r, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list snapshots of target repo")
+	}
+
+	copied := make(map[udmrepo.ID]bool, len(snapshotIDs))
+	for _, id := range snapshotIDs {
+		copied[udmrepo.ID(id)] = true
+	}
+
+	return copied, nil
+}
+
+// blobData holds the data of a blob. Blobs are read into it, and written from it.
+type blobData struct {
+	bytes.Buffer
+}
+
+func (d *blobData) Length() int {
+	return d.Len()
+}
+
+func (d *blobData) WriteTo(w io.Writer) (int64, error) {
+	return bytes.NewReader(d.Bytes()).WriteTo(w)
+}
+
+func (d *blobData) Reader() io.ReadSeekCloser {
+	return nopReadSeekCloser{bytes.NewReader(d.Bytes())}
+}
+
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestCopy(t *testing.T) {
+	ctx := context.Background()
+
+	oldRepoOpen := kopiaRepoOpen
+	kopiaRepoOpen = repo.Open
+	defer func() { kopiaRepoOpen = oldRepoOpen }()
+
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: t.TempDir()}, true)
+	require.NoError(t, err)
+	require.NoError(t, repo.Initialize(ctx, st, &repo.NewRepositoryOptions{}, testRepoPassword))
+
+	configFile := filepath.Join(t.TempDir(), "repo.config")
+	require.NoError(t, repo.Connect(ctx, configFile, st, testRepoPassword, &repo.ConnectOptions{}))
+
+	rep, err := repo.Open(ctx, configFile, testRepoPassword, &repo.Options{})
+	require.NoError(t, err)
+	first, _ := createTestSnapshot(t, ctx, rep, "first", "data of the first snapshot")
+	second, _ := createTestSnapshot(t, ctx, rep, "second", "data of the second snapshot")
+	require.NoError(t, rep.Close(ctx))
+
+	ks := &kopiaRepoService{logger: velerotest.NewLogger()}
+	repoOption := udmrepo.RepoOptions{ConfigFilePath: configFile, RepoPassword: testRepoPassword}
+	targetOption := udmrepo.RepoOptions{
+		StorageType:    udmrepo.StorageTypeFs,
+		StorageOptions: map[string]string{udmrepo.StoreOptionFsPath: t.TempDir()},
+		ConfigFilePath: filepath.Join(t.TempDir(), "target.config"),
+		RepoPassword:   testRepoPassword,
+	}
+
+	expected := map[udmrepo.ID]udmrepo.ID{udmrepo.ID(first): udmrepo.ID(first), udmrepo.ID(second): udmrepo.ID(second)}
+	ids, err := ks.Copy(ctx, repoOption, targetOption)
+	require.NoError(t, err)
+	assert.Equal(t, expected, ids)
+
+	// the connection to the copy is removed
+	_, err = os.Stat(targetOption.ConfigFilePath)
+	assert.True(t, os.IsNotExist(err))
+
+	// copying again only checks the copy
+	ids, err = ks.Copy(ctx, repoOption, targetOption)
+	require.NoError(t, err)
+	assert.Equal(t, expected, ids)
+
+	// a storage with another repository isn't overwritten
+	otherPath := t.TempDir()
+	other, err := filesystem.New(ctx, &filesystem.Options{Path: otherPath}, true)
+	require.NoError(t, err)
+	require.NoError(t, repo.Initialize(ctx, other, &repo.NewRepositoryOptions{}, testRepoPassword))
+	targetOption.StorageOptions = map[string]string{udmrepo.StoreOptionFsPath: otherPath}
+	_, err = ks.Copy(ctx, repoOption, targetOption)
+	assert.EqualError(t, err, "target storage holds a different repo")
+}
+
+func TestCopyInvalidOptions(t *testing.T) {
+	ks := &kopiaRepoService{logger: velerotest.NewLogger()}
+
+	_, err := ks.Copy(context.Background(), udmrepo.RepoOptions{}, udmrepo.RepoOptions{ConfigFilePath: "fake-target-file"})
+	assert.EqualError(t, err, "invalid config file path")
+
+	_, err = ks.Copy(context.Background(), udmrepo.RepoOptions{ConfigFilePath: "fake-file"}, udmrepo.RepoOptions{})
+	assert.EqualError(t, err, "invalid config file path")
+
+	_, err = ks.Copy(context.Background(), udmrepo.RepoOptions{ConfigFilePath: "fake-file"}, udmrepo.RepoOptions{ConfigFilePath: "fake-target-file"})
+	assert.EqualError(t, err, "repo config fake-file doesn't exist: stat fake-file: no such file or directory")
+}
@@ -0,0 +1,96 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/kopia/kopia/repo"
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+func (ks *kopiaRepoService) ChangePassword(ctx context.Context, repoOption udmrepo.RepoOptions, newPassword string) error {
+	repoConfig := repoOption.ConfigFilePath
+	if repoConfig == "" {
+		return errors.New("invalid config file path")
+	}
+
+	if newPassword == "" {
+		return errors.New("invalid new password")
+	}
+
+	if _, err := os.Stat(repoConfig); os.IsNotExist(err) {
+		return errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
+	}
+
+	repoCtx := logging.SetupKopiaLog(ctx, ks.logger)
+
+	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		c := r.Close(repoCtx)
+		if c != nil {
+			ks.logger.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	dr, ok := r.(repo.DirectRepository)
+	if !ok {
+		return errors.Errorf("unexpected repo type %T", r)
+	}
+
+	err = repo.DirectWriteSession(repoCtx, dr, repo.WriteSessionOptions{
+		Purpose: "UdmRepoChangePassword",
+	}, func(ctx context.Context, dw repo.DirectRepositoryWriter) error {
+		return dw.ChangePassword(ctx, newPassword)
+	})
+	if err != nil {
+		return errors.Wrap(err, "error to change repo password")
+	}
+
+	return nil
+}
+
+// removeCachedFormatBlobs removes the format blobs that kopia caches for the repo connected
+// with configFile. They're encrypted with the repo password, so they must be read again from
+// the storage once the password has been changed by another client.
+func removeCachedFormatBlobs(configFile string) error {
+	lc, err := repo.LoadConfigFromFile(configFile)
+	if err != nil {
+		return errors.Wrap(err, "error to load repo config")
+	}
+
+	if lc.Caching == nil || lc.Caching.CacheDirectory == "" {
+		return nil
+	}
+
+	for _, blobID := range []string{repo.FormatBlobID, repo.BlobCfgBlobID} {
+		if err := os.Remove(filepath.Join(lc.Caching.CacheDirectory, blobID)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "error to remove cached blob %s", blobID)
+		}
+	}
+
+	return nil
+}
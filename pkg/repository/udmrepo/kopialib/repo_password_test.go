@@ -0,0 +1,88 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo/kopialib/backend"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestChangePassword(t *testing.T) {
+	ctx := context.Background()
+	newPassword := "fake-new-password"
+
+	oldRepoOpen := kopiaRepoOpen
+	kopiaRepoOpen = repo.Open
+	defer func() { kopiaRepoOpen = oldRepoOpen }()
+
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: t.TempDir()}, true)
+	require.NoError(t, err)
+	require.NoError(t, repo.Initialize(ctx, st, &repo.NewRepositoryOptions{}, testRepoPassword))
+
+	// two clients connected to the same repo, each with its own cache
+	connect := func() string {
+		configFile := filepath.Join(t.TempDir(), "repo.config")
+		options := backend.SetupConnectOptions(ctx, udmrepo.RepoOptions{})
+		options.CachingOptions.CacheDirectory = t.TempDir()
+		require.NoError(t, repo.Connect(ctx, configFile, st, testRepoPassword, &options))
+		return configFile
+	}
+	configFile := connect()
+	otherConfigFile := connect()
+
+	rep, err := openKopiaRepo(ctx, otherConfigFile, testRepoPassword)
+	require.NoError(t, err)
+	require.NoError(t, rep.Close(ctx))
+
+	ks := &kopiaRepoService{logger: velerotest.NewLogger()}
+	require.NoError(t, ks.ChangePassword(ctx, udmrepo.RepoOptions{ConfigFilePath: configFile, RepoPassword: testRepoPassword}, newPassword))
+
+	_, err = openKopiaRepo(ctx, configFile, testRepoPassword)
+	assert.Error(t, err)
+
+	rep, err = openKopiaRepo(ctx, configFile, newPassword)
+	require.NoError(t, err)
+	require.NoError(t, rep.Close(ctx))
+
+	// the other client still has the format blob encrypted with the old password in its cache
+	rep, err = openKopiaRepo(ctx, otherConfigFile, newPassword)
+	require.NoError(t, err)
+	require.NoError(t, rep.Close(ctx))
+}
+
+func TestChangePasswordInvalidOptions(t *testing.T) {
+	ks := &kopiaRepoService{logger: velerotest.NewLogger()}
+
+	err := ks.ChangePassword(context.Background(), udmrepo.RepoOptions{}, "fake-new-password")
+	assert.EqualError(t, err, "invalid config file path")
+
+	err = ks.ChangePassword(context.Background(), udmrepo.RepoOptions{ConfigFilePath: "fake-file"}, "")
+	assert.EqualError(t, err, "invalid new password")
+
+	err = ks.ChangePassword(context.Background(), udmrepo.RepoOptions{ConfigFilePath: "fake-file"}, "fake-new-password")
+	assert.EqualError(t, err, "repo config fake-file doesn't exist: stat fake-file: no such file or directory")
+}
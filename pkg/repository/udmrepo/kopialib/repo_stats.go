@@ -0,0 +1,111 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+func (ks *kopiaRepoService) Stats(ctx context.Context, repoOption udmrepo.RepoOptions) (udmrepo.RepoStats, error) {
+	repoConfig := repoOption.ConfigFilePath
+	if repoConfig == "" {
+		return udmrepo.RepoStats{}, errors.New("invalid config file path")
+	}
+
+	if _, err := os.Stat(repoConfig); os.IsNotExist(err) {
+		return udmrepo.RepoStats{}, errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
+	}
+
+	repoCtx := logging.SetupKopiaLog(ctx, ks.logger)
+
+	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword)
+	if err != nil {
+		return udmrepo.RepoStats{}, err
+	}
+
+	defer func() {
+		c := r.Close(repoCtx)
+		if c != nil {
+			ks.logger.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	return repoStats(repoCtx, r)
+}
+
+// repoStats returns the storage usage of rep and of each of its snapshots. The data shared by
+// several snapshots is attributed to the oldest of them.
+func repoStats(ctx context.Context, rep repo.Repository) (udmrepo.RepoStats, error) {
+	dr, ok := rep.(repo.DirectRepository)
+	if !ok {
+		return udmrepo.RepoStats{}, errors.Errorf("unexpected repo type %T", rep)
+	}
+
+	stats := udmrepo.RepoStats{Snapshots: map[udmrepo.ID]udmrepo.SnapshotStats{}}
+
+	err := dr.BlobReader().ListBlobs(ctx, "", func(bm blob.Metadata) error {
+		stats.TotalSize += bm.Length
+		return nil
+	})
+	if err != nil {
+		return udmrepo.RepoStats{}, errors.Wrap(err, "error to list repo blobs")
+	}
+
+	manifestIDs, err := snapshot.ListSnapshotManifests(ctx, rep, nil, nil)
+	if err != nil {
+		return udmrepo.RepoStats{}, errors.Wrap(err, "error to list snapshots")
+	}
+
+	manifests, err := snapshot.LoadSnapshots(ctx, rep, manifestIDs)
+	if err != nil {
+		return udmrepo.RepoStats{}, errors.Wrap(err, "error to load snapshots")
+	}
+
+	if len(manifests) == 0 {
+		return stats, nil
+	}
+
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].StartTime.Before(manifests[j].StartTime)
+	})
+
+	err = snapshotfs.CalculateStorageStats(ctx, rep, manifests, func(man *snapshot.Manifest) error {
+		stats.LogicalSize += man.Stats.TotalFileSize
+		stats.UniqueSize += man.StorageStats.NewData.PackedContentBytes
+		stats.Snapshots[udmrepo.ID(man.ID)] = udmrepo.SnapshotStats{
+			LogicalSize: man.Stats.TotalFileSize,
+			AddedSize:   man.StorageStats.NewData.PackedContentBytes,
+		}
+		return nil
+	})
+	if err != nil {
+		return udmrepo.RepoStats{}, errors.Wrap(err, "error to calculate snapshot storage stats")
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRepoStats(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: t.TempDir()}, true)
+	require.NoError(t, err)
+	require.NoError(t, repo.Initialize(ctx, st, &repo.NewRepositoryOptions{}, testRepoPassword))
+
+	configFile := filepath.Join(t.TempDir(), "repo.config")
+	require.NoError(t, repo.Connect(ctx, configFile, st, testRepoPassword, &repo.ConnectOptions{}))
+
+	rep, err := repo.Open(ctx, configFile, testRepoPassword, &repo.Options{})
+	require.NoError(t, err)
+	defer rep.Close(ctx)
+
+	stats, err := repoStats(ctx, rep)
+	require.NoError(t, err)
+	assert.Positive(t, stats.TotalSize)
+	assert.Empty(t, stats.Snapshots)
+
+	content := "data shared by both snapshots"
+	first, _ := createTestSnapshot(t, ctx, rep, "first", content)
+	second, _ := createTestSnapshot(t, ctx, rep, "second", content)
+
+	stats, err = repoStats(ctx, rep)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2*len(content)), stats.LogicalSize)
+	require.Len(t, stats.Snapshots, 2)
+
+	// the shared data is attributed to the older snapshot
+	assert.Equal(t, int64(len(content)), stats.Snapshots[udmrepo.ID(first)].LogicalSize)
+	assert.Positive(t, stats.Snapshots[udmrepo.ID(first)].AddedSize)
+	assert.Equal(t, int64(len(content)), stats.Snapshots[udmrepo.ID(second)].LogicalSize)
+	assert.Zero(t, stats.Snapshots[udmrepo.ID(second)].AddedSize)
+	assert.Equal(t, stats.Snapshots[udmrepo.ID(first)].AddedSize, stats.UniqueSize)
+}
+
+func TestStatsInvalidConfig(t *testing.T) {
+	ks := &kopiaRepoService{logger: velerotest.NewLogger()}
+
+	_, err := ks.Stats(context.Background(), udmrepo.RepoOptions{})
+	assert.EqualError(t, err, "invalid config file path")
+
+	_, err = ks.Stats(context.Background(), udmrepo.RepoOptions{ConfigFilePath: "fake-file"})
+	assert.EqualError(t, err, "repo config fake-file doesn't exist: stat fake-file: no such file or directory")
+}
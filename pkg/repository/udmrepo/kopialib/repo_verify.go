@@ -0,0 +1,214 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"path"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob"
+	"github.com/kopia/kopia/repo/object"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
+)
+
+// snapshotVerifier verifies the data of the snapshots in a repository. Objects shared by several
+// snapshots are only verified once, and their result applies to all of the snapshots.
+type snapshotVerifier struct {
+	rep             repo.Repository
+	blobs           map[blob.ID]struct{}
+	readDataPercent int
+	verified        map[object.ID]error
+	logger          logrus.FieldLogger
+}
+
+func (ks *kopiaRepoService) Verify(ctx context.Context, repoOption udmrepo.RepoOptions, readDataPercent int) (map[udmrepo.ID]string, error) {
+	repoConfig := repoOption.ConfigFilePath
+	if repoConfig == "" {
+		return nil, errors.New("invalid config file path")
+	}
+
+	if _, err := os.Stat(repoConfig); os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "repo config %s doesn't exist", repoConfig)
+	}
+
+	repoCtx := logging.SetupKopiaLog(ctx, ks.logger)
+
+	r, err := openKopiaRepo(repoCtx, repoConfig, repoOption.RepoPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		c := r.Close(repoCtx)
+		if c != nil {
+			ks.logger.WithError(c).Error("Failed to close repo")
+		}
+	}()
+
+	return verifySnapshots(repoCtx, r, readDataPercent, ks.logger)
+}
+
+// verifySnapshots verifies the data of all the snapshots in rep, and returns the damaged ones mapped
+// to the damage found in them.
+func verifySnapshots(ctx context.Context, rep repo.Repository, readDataPercent int, logger logrus.FieldLogger) (map[udmrepo.ID]string, error) {
+	dr, ok := rep.(repo.DirectRepository)
+	if !ok {
+		return nil, errors.Errorf("unexpected repo type %T", rep)
+	}
+
+	// the index may refer to contents whose pack blobs are gone, so the contents of every object
+	// are looked up in the blobs that are actually there
+	blobs := map[blob.ID]struct{}{}
+	err := dr.BlobReader().ListBlobs(ctx, "", func(bm blob.Metadata) error {
+		blobs[bm.BlobID] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list repo blobs")
+	}
+
+	manifestIDs, err := snapshot.ListSnapshotManifests(ctx, rep, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to list snapshots")
+	}
+
+	manifests, err := snapshot.LoadSnapshots(ctx, rep, manifestIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "error to load snapshots")
+	}
+
+	v := &snapshotVerifier{
+		rep:             rep,
+		blobs:           blobs,
+		readDataPercent: readDataPercent,
+		verified:        map[object.ID]error{},
+		logger:          logger,
+	}
+
+	damaged := map[udmrepo.ID]string{}
+	for _, man := range manifests {
+		log := logger.WithField("snapshot", man.ID)
+		log.Debug("Verifying snapshot")
+
+		err := v.verifySnapshot(ctx, man)
+		if ctx.Err() != nil {
+			return nil, errors.Wrap(ctx.Err(), "verification is canceled")
+		}
+		if err != nil {
+			log.WithError(err).Warn("Snapshot is damaged")
+			damaged[udmrepo.ID(man.ID)] = err.Error()
+		}
+	}
+
+	logger.Infof("Verified %d snapshots, %d of them are damaged", len(manifests), len(damaged))
+
+	return damaged, nil
+}
+
+func (v *snapshotVerifier) verifySnapshot(ctx context.Context, man *snapshot.Manifest) error {
+	if man.RootEntry == nil {
+		return nil
+	}
+
+	root, err := snapshotfs.SnapshotRoot(v.rep, man)
+	if err != nil {
+		return errors.Wrap(err, "error to get snapshot root")
+	}
+
+	return v.verifyEntry(ctx, root, man.Source.Path)
+}
+
+func (v *snapshotVerifier) verifyEntry(ctx context.Context, entry fs.Entry, entryPath string) error {
+	h, ok := entry.(object.HasObjectID)
+	if !ok {
+		return nil
+	}
+
+	oid := h.ObjectID()
+	if err, verified := v.verified[oid]; verified {
+		return err
+	}
+
+	err := v.verifyObject(ctx, entry, oid, entryPath)
+	v.verified[oid] = err
+
+	return err
+}
+
+func (v *snapshotVerifier) verifyObject(ctx context.Context, entry fs.Entry, oid object.ID, entryPath string) error {
+	contentIDs, err := v.rep.VerifyObject(ctx, oid)
+	if err != nil {
+		return errors.Wrapf(err, "error to verify object %v of %s", oid, entryPath)
+	}
+
+	for _, cid := range contentIDs {
+		info, err := v.rep.ContentInfo(ctx, cid)
+		if err != nil {
+			return errors.Wrapf(err, "error to get content %v of %s", cid, entryPath)
+		}
+
+		if _, ok := v.blobs[info.GetPackBlobID()]; !ok {
+			return errors.Errorf("object %v of %s is backed by missing blob %v", oid, entryPath, info.GetPackBlobID())
+		}
+	}
+
+	if dir, ok := entry.(fs.Directory); ok {
+		entries, err := dir.Readdir(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "error to read directory %s", entryPath)
+		}
+
+		for _, child := range entries {
+			if err := v.verifyEntry(ctx, child, path.Join(entryPath, child.Name())); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	//nolint:gosec
+	if rand.Intn(100) < v.readDataPercent {
+		if err := v.readObject(ctx, oid); err != nil {
+			return errors.Wrapf(err, "error to read object %v of %s", oid, entryPath)
+		}
+	}
+
+	return nil
+}
+
+func (v *snapshotVerifier) readObject(ctx context.Context, oid object.ID) error {
+	r, err := v.rep.OpenObject(ctx, oid)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = io.Copy(io.Discard, r)
+	return err
+}
@@ -0,0 +1,126 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopialib
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/fs/localfs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/repo/blob/filesystem"
+	"github.com/kopia/kopia/repo/manifest"
+	"github.com/kopia/kopia/repo/object"
+	"github.com/kopia/kopia/snapshot"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/velero/pkg/repository/udmrepo"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+const testRepoPassword = "fake-password"
+
+// createTestSnapshot snapshots a directory that contains a single file with the given content, and
+// returns the snapshot's manifest ID and the ID of the file's object.
+func createTestSnapshot(t *testing.T, ctx context.Context, rep repo.Repository, name, content string) (string, object.ID) {
+	dir := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data"), []byte(content), 0644))
+
+	source, err := localfs.Directory(dir)
+	require.NoError(t, err)
+
+	var manifestID string
+	err = repo.WriteSession(ctx, rep, repo.WriteSessionOptions{Purpose: "test"}, func(ctx context.Context, w repo.RepositoryWriter) error {
+		man, err := snapshotfs.NewUploader(w).Upload(ctx, source, nil, snapshot.SourceInfo{Host: "host", UserName: "user", Path: dir})
+		if err != nil {
+			return err
+		}
+
+		id, err := snapshot.SaveSnapshot(ctx, w, man)
+		manifestID = string(id)
+		return err
+	})
+	require.NoError(t, err)
+
+	man, err := snapshot.LoadSnapshot(ctx, rep, manifest.ID(manifestID))
+	require.NoError(t, err)
+	root, err := snapshotfs.SnapshotRoot(rep, man)
+	require.NoError(t, err)
+	entries, err := root.(fs.Directory).Readdir(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	return manifestID, entries[0].(object.HasObjectID).ObjectID()
+}
+
+func TestVerifySnapshots(t *testing.T) {
+	ctx := context.Background()
+	logger := velerotest.NewLogger()
+
+	st, err := filesystem.New(ctx, &filesystem.Options{Path: t.TempDir()}, true)
+	require.NoError(t, err)
+	require.NoError(t, repo.Initialize(ctx, st, &repo.NewRepositoryOptions{}, testRepoPassword))
+
+	configFile := filepath.Join(t.TempDir(), "repo.config")
+	require.NoError(t, repo.Connect(ctx, configFile, st, testRepoPassword, &repo.ConnectOptions{}))
+
+	rep, err := repo.Open(ctx, configFile, testRepoPassword, &repo.Options{})
+	require.NoError(t, err)
+
+	intact, _ := createTestSnapshot(t, ctx, rep, "intact", "intact data")
+	damaged, damagedObject := createTestSnapshot(t, ctx, rep, "damaged", "damaged data")
+
+	result, err := verifySnapshots(ctx, rep, 100, logger)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	// delete the pack blob that contains the data of the damaged snapshot
+	contentIDs, err := rep.VerifyObject(ctx, damagedObject)
+	require.NoError(t, err)
+	require.NotEmpty(t, contentIDs)
+	info, err := rep.ContentInfo(ctx, contentIDs[0])
+	require.NoError(t, err)
+	require.NoError(t, st.DeleteBlob(ctx, info.GetPackBlobID()))
+	require.NoError(t, rep.Close(ctx))
+
+	rep, err = repo.Open(ctx, configFile, testRepoPassword, &repo.Options{})
+	require.NoError(t, err)
+	defer rep.Close(ctx)
+
+	result, err = verifySnapshots(ctx, rep, 0, logger)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Contains(t, result, udmrepo.ID(damaged))
+	assert.Contains(t, result[udmrepo.ID(damaged)], "missing blob")
+	assert.NotContains(t, result, udmrepo.ID(intact))
+}
+
+func TestVerifyInvalidConfig(t *testing.T) {
+	ks := &kopiaRepoService{logger: velerotest.NewLogger()}
+
+	_, err := ks.Verify(context.Background(), udmrepo.RepoOptions{}, 0)
+	assert.EqualError(t, err, "invalid config file path")
+
+	_, err = ks.Verify(context.Background(), udmrepo.RepoOptions{ConfigFilePath: "fake-file"}, 0)
+	assert.EqualError(t, err, "repo config fake-file doesn't exist: stat fake-file: no such file or directory")
+}
@@ -16,6 +16,43 @@ type BackupRepoService struct {
 	mock.Mock
 }
 
+// ChangePassword provides a mock function with given fields: ctx, repoOption, newPassword
+func (_m *BackupRepoService) ChangePassword(ctx context.Context, repoOption udmrepo.RepoOptions, newPassword string) error {
+	ret := _m.Called(ctx, repoOption, newPassword)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions, string) error); ok {
+		r0 = rf(ctx, repoOption, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Copy provides a mock function with given fields: ctx, repoOption, targetOption
+func (_m *BackupRepoService) Copy(ctx context.Context, repoOption udmrepo.RepoOptions, targetOption udmrepo.RepoOptions) (map[udmrepo.ID]udmrepo.ID, error) {
+	ret := _m.Called(ctx, repoOption, targetOption)
+
+	var r0 map[udmrepo.ID]udmrepo.ID
+	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions, udmrepo.RepoOptions) map[udmrepo.ID]udmrepo.ID); ok {
+		r0 = rf(ctx, repoOption, targetOption)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[udmrepo.ID]udmrepo.ID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, udmrepo.RepoOptions, udmrepo.RepoOptions) error); ok {
+		r1 = rf(ctx, repoOption, targetOption)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DefaultMaintenanceFrequency provides a mock function with given fields:
 func (_m *BackupRepoService) DefaultMaintenanceFrequency() time.Duration {
 	ret := _m.Called()
@@ -81,6 +118,71 @@ func (_m *BackupRepoService) Open(ctx context.Context, repoOption udmrepo.RepoOp
 	return r0, r1
 }
 
+// RepoSize provides a mock function with given fields: ctx, repoOption
+func (_m *BackupRepoService) RepoSize(ctx context.Context, repoOption udmrepo.RepoOptions) (int64, error) {
+	ret := _m.Called(ctx, repoOption)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions) int64); ok {
+		r0 = rf(ctx, repoOption)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, udmrepo.RepoOptions) error); ok {
+		r1 = rf(ctx, repoOption)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stats provides a mock function with given fields: ctx, repoOption
+func (_m *BackupRepoService) Stats(ctx context.Context, repoOption udmrepo.RepoOptions) (udmrepo.RepoStats, error) {
+	ret := _m.Called(ctx, repoOption)
+
+	var r0 udmrepo.RepoStats
+	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions) udmrepo.RepoStats); ok {
+		r0 = rf(ctx, repoOption)
+	} else {
+		r0 = ret.Get(0).(udmrepo.RepoStats)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, udmrepo.RepoOptions) error); ok {
+		r1 = rf(ctx, repoOption)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Verify provides a mock function with given fields: ctx, repoOption, readDataPercent
+func (_m *BackupRepoService) Verify(ctx context.Context, repoOption udmrepo.RepoOptions, readDataPercent int) (map[udmrepo.ID]string, error) {
+	ret := _m.Called(ctx, repoOption, readDataPercent)
+
+	var r0 map[udmrepo.ID]string
+	if rf, ok := ret.Get(0).(func(context.Context, udmrepo.RepoOptions, int) map[udmrepo.ID]string); ok {
+		r0 = rf(ctx, repoOption, readDataPercent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[udmrepo.ID]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, udmrepo.RepoOptions, int) error); ok {
+		r1 = rf(ctx, repoOption, readDataPercent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewBackupRepoService interface {
 	mock.TestingT
 	Cleanup(func())
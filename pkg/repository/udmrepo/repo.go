@@ -41,6 +41,20 @@ type ManifestFilter struct {
 	Labels map[string]string
 }
 
+// RepoStats describes the storage usage of a backup repository
+type RepoStats struct {
+	TotalSize   int64                // The size of the data stored in the underlying storage
+	UniqueSize  int64                // The size of the deduplicated data of all the snapshots, as stored
+	LogicalSize int64                // The size of the data of all the snapshots before deduplication
+	Snapshots   map[ID]SnapshotStats // The storage usage of each snapshot, by the snapshot's manifest ID
+}
+
+// SnapshotStats describes the storage usage of one snapshot
+type SnapshotStats struct {
+	LogicalSize int64 // The size of the snapshot's data before deduplication
+	AddedSize   int64 // The size of the data the snapshot stored that no earlier snapshot had stored
+}
+
 const (
 	// Below consts descrbe the data type of one object.
 	// Metadata: This type describes how the data is organized.
@@ -85,6 +99,31 @@ type BackupRepoService interface {
 	// repoOption: options to maintain the backup repository.
 	Maintain(ctx context.Context, repoOption RepoOptions) error
 
+	// RepoSize returns the size of the data that the backup repository stores in the underlying storage.
+	// repoOption: options to open the backup repository and the underlying storage.
+	RepoSize(ctx context.Context, repoOption RepoOptions) (int64, error)
+
+	// Stats returns the storage usage of the backup repository and of each of its snapshots.
+	// repoOption: options to open the backup repository and the underlying storage.
+	Stats(ctx context.Context, repoOption RepoOptions) (RepoStats, error)
+
+	// Verify checks that the data of the snapshots in the backup repository is present, and reads back
+	// readDataPercent percent of it.
+	// repoOption: options to open the backup repository and the underlying storage.
+	// return: the damaged snapshots, mapped to the damage found in them.
+	Verify(ctx context.Context, repoOption RepoOptions, readDataPercent int) (map[ID]string, error)
+
+	// ChangePassword changes the password of the backup repository to newPassword.
+	// repoOption: options to open the backup repository with its current password and the underlying storage.
+	ChangePassword(ctx context.Context, repoOption RepoOptions, newPassword string) error
+
+	// Copy copies the backup repository to the storage of targetOption, which must be empty or hold an
+	// earlier copy of the backup repository, and checks that its snapshots are all listed in the copy.
+	// repoOption: options to open the backup repository and the underlying storage.
+	// targetOption: options to connect to the copy and its underlying storage.
+	// return: the ID of each snapshot in the copy, by its ID in the backup repository.
+	Copy(ctx context.Context, repoOption RepoOptions, targetOption RepoOptions) (map[ID]ID, error)
+
 	// DefaultMaintenanceFrequency returns the defgault frequency of maintenance, callers refer this
 	// frequency to maintain the backup repository to get the best maintenance performance
 	DefaultMaintenanceFrequency() time.Duration
@@ -0,0 +1,150 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	// maxReportedDamagedSnapshots and maxReportedDamageLength keep the result of a verification
+	// job within the 4096 bytes that the termination message of its container can hold.
+	maxReportedDamagedSnapshots = 40
+	maxReportedDamageLength     = 512
+)
+
+// VerificationResult is the result of a verification of a backup repository, which the
+// verification job reports in the termination message of its container.
+type VerificationResult struct {
+	// ReadDataPercent is the percentage of the repository's data that was read back.
+	ReadDataPercent int `json:"readDataPercent,omitempty"`
+
+	// Damage describes the damage found in the repository. It's empty if no damage was found.
+	Damage string `json:"damage,omitempty"`
+
+	// DamagedSnapshots are the IDs of the snapshots whose data is damaged. It's empty if the
+	// damage can't be attributed to particular snapshots.
+	DamagedSnapshots []string `json:"damagedSnapshots,omitempty"`
+
+	// Error is the error the verification failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// RunVerification checks the integrity of repo and reads back readDataPercent percent of its
+// data, and returns the damage found or the error the check failed with.
+func RunVerification(repoManager Manager, repo *velerov1api.BackupRepository, readDataPercent int, log logrus.FieldLogger) VerificationResult {
+	result := VerificationResult{ReadDataPercent: readDataPercent}
+
+	// Connecting sets up the local configuration the repository is opened with.
+	if err := repoManager.ConnectToRepo(repo); err != nil {
+		result.Error = errors.Wrap(err, "error connecting to repository").Error()
+		return result
+	}
+
+	checkResult, err := repoManager.CheckRepo(repo, readDataPercent)
+	if err != nil {
+		result.Error = errors.Wrap(err, "error checking repository").Error()
+		return result
+	}
+	if checkResult.Damage == "" {
+		return result
+	}
+
+	log.WithField("damagedSnapshots", checkResult.DamagedSnapshots).Warn(checkResult.Damage)
+
+	result.Damage = checkResult.Damage
+	if len(result.Damage) > maxReportedDamageLength {
+		result.Damage = result.Damage[:maxReportedDamageLength] + "..."
+	}
+	// without a list of damaged snapshots, all of the repository's snapshots are considered
+	// damaged, which is the safe assumption when there are too many to report
+	if len(checkResult.DamagedSnapshots) <= maxReportedDamagedSnapshots {
+		result.DamagedSnapshots = checkResult.DamagedSnapshots
+	}
+	return result
+}
+
+// BuildVerificationJob returns a job that verifies the data in repo, and reads back
+// readDataPercent percent of it.
+func BuildVerificationJob(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository, config MaintenanceJobConfig, readDataPercent int) (*batchv1api.Job, error) {
+	return buildRepositoryJob(ctx, cli, repo, config, RepositoryJobVerification, "check", fmt.Sprintf("--read-data-percent=%d", readDataPercent))
+}
+
+// GetVerificationStatus returns the status of a finished verification job, as reported in the
+// termination message of its pod.
+func GetVerificationStatus(ctx context.Context, cli client.Client, job *batchv1api.Job) velerov1api.BackupRepositoryVerificationStatus {
+	status := velerov1api.BackupRepositoryVerificationStatus{
+		Job:            job.Name,
+		Result:         velerov1api.BackupRepositoryVerificationPassed,
+		StartTimestamp: job.Status.StartTime,
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1api.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1api.JobComplete:
+			status.CompleteTimestamp = &metav1.Time{Time: condition.LastTransitionTime.Time}
+		case batchv1api.JobFailed:
+			status.Result = velerov1api.BackupRepositoryVerificationFailed
+			status.CompleteTimestamp = &metav1.Time{Time: condition.LastTransitionTime.Time}
+			status.Message = condition.Message
+		}
+	}
+
+	terminated, err := getJobTermination(ctx, cli, job)
+	if err != nil {
+		status.Result = velerov1api.BackupRepositoryVerificationFailed
+		status.Message = appendMessage(status.Message, err.Error())
+		return status
+	}
+
+	status.StartTimestamp = &metav1.Time{Time: terminated.StartedAt.Time}
+	status.CompleteTimestamp = &metav1.Time{Time: terminated.FinishedAt.Time}
+
+	result := VerificationResult{}
+	if err := json.Unmarshal([]byte(terminated.Message), &result); err != nil {
+		// the job didn't get to report its result, so the message is the end of its log
+		status.Result = velerov1api.BackupRepositoryVerificationFailed
+		status.Message = appendMessage(status.Message, terminated.Message)
+		return status
+	}
+	status.ReadDataPercent = result.ReadDataPercent
+
+	switch {
+	case result.Error != "":
+		status.Result = velerov1api.BackupRepositoryVerificationFailed
+		status.Message = result.Error
+	case result.Damage != "":
+		status.Result = velerov1api.BackupRepositoryVerificationDamaged
+		status.Message = result.Damage
+		status.DamagedSnapshots = result.DamagedSnapshots
+	}
+
+	return status
+}
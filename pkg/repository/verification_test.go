@@ -0,0 +1,194 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/repository/provider"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRunVerification(t *testing.T) {
+	var manySnapshots []string
+	for i := 0; i <= maxReportedDamagedSnapshots; i++ {
+		manySnapshots = append(manySnapshots, fmt.Sprintf("snapshot-%d", i))
+	}
+	longDamage := strings.Repeat("x", maxReportedDamageLength+1)
+
+	tests := []struct {
+		name     string
+		manager  *fakeMaintenanceManager
+		expected VerificationResult
+	}{
+		{
+			name:     "no damage",
+			manager:  &fakeMaintenanceManager{},
+			expected: VerificationResult{ReadDataPercent: 10},
+		},
+		{
+			name: "damaged snapshots are reported",
+			manager: &fakeMaintenanceManager{checkResult: provider.CheckResult{
+				Damage:           "1 snapshots are damaged",
+				DamagedSnapshots: []string{"snapshot-1"},
+			}},
+			expected: VerificationResult{ReadDataPercent: 10, Damage: "1 snapshots are damaged", DamagedSnapshots: []string{"snapshot-1"}},
+		},
+		{
+			name: "too many damaged snapshots aren't reported individually",
+			manager: &fakeMaintenanceManager{checkResult: provider.CheckResult{
+				Damage:           "41 snapshots are damaged",
+				DamagedSnapshots: manySnapshots,
+			}},
+			expected: VerificationResult{ReadDataPercent: 10, Damage: "41 snapshots are damaged"},
+		},
+		{
+			name:     "long damage descriptions are truncated",
+			manager:  &fakeMaintenanceManager{checkResult: provider.CheckResult{Damage: longDamage}},
+			expected: VerificationResult{ReadDataPercent: 10, Damage: longDamage[:maxReportedDamageLength] + "..."},
+		},
+		{
+			name:     "connect error is reported",
+			manager:  &fakeMaintenanceManager{connectErr: errors.New("wrong password")},
+			expected: VerificationResult{ReadDataPercent: 10, Error: "error connecting to repository: wrong password"},
+		},
+		{
+			name:     "check error is reported",
+			manager:  &fakeMaintenanceManager{checkErr: errors.New("repository is locked")},
+			expected: VerificationResult{ReadDataPercent: 10, Error: "error checking repository: repository is locked"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := RunVerification(test.manager, &velerov1api.BackupRepository{}, 10, velerotest.NewLogger())
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestBuildVerificationJob(t *testing.T) {
+	repo := newMaintenanceTestRepo()
+
+	deployment := builder.ForDeployment(velerov1api.DefaultNamespace, "velero").Result()
+	deployment.Spec.Template.Spec.Containers = []corev1api.Container{{Name: "velero", Image: "velero/velero:main"}}
+	cli := velerotest.NewFakeControllerRuntimeClient(t, deployment)
+
+	job, err := BuildVerificationJob(context.Background(), cli, repo, MaintenanceJobConfig{LogLevel: "info"}, 25)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ns-1-default-restic-abcde-check-", job.GenerateName)
+	assert.Equal(t, repo.Name, job.Labels[velerov1api.BackupRepositoryNameLabel])
+	assert.Equal(t, RepositoryJobVerification, job.Labels[velerov1api.BackupRepositoryJobLabel])
+
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "velero-repo-verification", container.Name)
+	assert.Equal(t, []string{"repo", "check", repo.Name, "--namespace=velero", "--read-data-percent=25", "--log-level=info"}, container.Args)
+}
+
+func TestGetVerificationStatus(t *testing.T) {
+	created := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	started, finished := created.Add(10*time.Second), created.Add(50*time.Second)
+
+	tests := []struct {
+		name     string
+		job      *batchv1api.Job
+		pod      *corev1api.Pod
+		expected velerov1api.BackupRepositoryVerificationStatus
+	}{
+		{
+			name: "passed",
+			job:  newRepositoryJob("job-1", RepositoryJobVerification, created, batchv1api.JobComplete),
+			pod:  newMaintenancePod("job-1", `{"readDataPercent":10}`, started, finished),
+			expected: velerov1api.BackupRepositoryVerificationStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryVerificationPassed,
+				ReadDataPercent:   10,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+			},
+		},
+		{
+			name: "damaged",
+			job:  newRepositoryJob("job-1", RepositoryJobVerification, created, batchv1api.JobComplete),
+			pod:  newMaintenancePod("job-1", `{"damage":"1 snapshots are damaged","damagedSnapshots":["snapshot-1"]}`, started, finished),
+			expected: velerov1api.BackupRepositoryVerificationStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryVerificationDamaged,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+				DamagedSnapshots:  []string{"snapshot-1"},
+				Message:           "1 snapshots are damaged",
+			},
+		},
+		{
+			name: "failed with an error",
+			job:  newRepositoryJob("job-1", RepositoryJobVerification, created, batchv1api.JobFailed),
+			pod:  newMaintenancePod("job-1", `{"error":"error checking repository: repository is locked"}`, started, finished),
+			expected: velerov1api.BackupRepositoryVerificationStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryVerificationFailed,
+				StartTimestamp:    &metav1.Time{Time: started},
+				CompleteTimestamp: &metav1.Time{Time: finished},
+				Message:           "error checking repository: repository is locked",
+			},
+		},
+		{
+			name: "pod not found",
+			job:  newRepositoryJob("job-1", RepositoryJobVerification, created, batchv1api.JobComplete),
+			expected: velerov1api.BackupRepositoryVerificationStatus{
+				Job:               "job-1",
+				Result:            velerov1api.BackupRepositoryVerificationFailed,
+				CompleteTimestamp: &metav1.Time{Time: created.Add(time.Minute)},
+				Message:           "unable to find the result of job job-1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli := velerotest.NewFakeControllerRuntimeClient(t)
+			if test.pod != nil {
+				require.NoError(t, cli.Create(context.Background(), test.pod))
+			}
+
+			status := GetVerificationStatus(context.Background(), cli, test.job)
+			assert.Equal(t, test.expected.Job, status.Job)
+			assert.Equal(t, test.expected.Result, status.Result)
+			assert.Equal(t, test.expected.ReadDataPercent, status.ReadDataPercent)
+			assert.Equal(t, test.expected.DamagedSnapshots, status.DamagedSnapshots)
+			assert.Equal(t, test.expected.Message, status.Message)
+			if test.expected.StartTimestamp != nil {
+				assert.True(t, test.expected.StartTimestamp.Equal(status.StartTimestamp))
+			}
+			assert.True(t, test.expected.CompleteTimestamp.Equal(status.CompleteTimestamp))
+		})
+	}
+}
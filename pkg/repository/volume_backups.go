@@ -0,0 +1,121 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+// VolumeBackup is a pod volume backup or a data upload whose data is in a backup repository.
+type VolumeBackup struct {
+	// Name is the kind and name of the volume backup, e.g. podvolumebackup/NAME.
+	Name string
+
+	// Backup is the name of the backup the volume backup belongs to.
+	Backup string
+
+	// SnapshotID is the ID of the volume backup's snapshot in the repository.
+	SnapshotID string
+}
+
+// GetRepositoryType returns the hardcode repositoryType for different backup methods - Restic or Kopia,uploaderType
+// indicates the method.
+// For Restic backup method, it is always hardcode to BackupRepositoryTypeRestic, never changed.
+// For Kopia backup method, this means we hardcode repositoryType as BackupRepositoryTypeKopia for Unified Repo,
+// at present (Kopia backup method is using Unified Repo). However, it doesn't mean we could deduce repositoryType
+// from uploaderType for Unified Repo.
+// TODO: post v1.10, refactor this function for Kopia backup method. In future, when we have multiple implementations of
+// Unified Repo (besides Kopia), we will add the repositoryType to BSL, because by then, we are not able to hardcode
+// the repositoryType to BackupRepositoryTypeKopia for Unified Repo.
+func GetRepositoryType(uploaderType string) string {
+	switch uploaderType {
+	case "", uploader.ResticType:
+		return velerov1api.BackupRepositoryTypeRestic
+	case uploader.KopiaType:
+		return velerov1api.BackupRepositoryTypeKopia
+	default:
+		return ""
+	}
+}
+
+// ListVolumeBackups returns the pod volume backups and data uploads that have a snapshot in repo.
+func ListVolumeBackups(ctx context.Context, cli client.Client, repo *velerov1api.BackupRepository) ([]VolumeBackup, error) {
+	repoType := repo.Spec.RepositoryType
+	if repoType == "" {
+		repoType = velerov1api.BackupRepositoryTypeRestic
+	}
+
+	var volumeBackups []VolumeBackup
+	add := func(obj metav1.Object, name, snapshotID string) {
+		backup := backupNameOf(obj)
+		if backup == "" || snapshotID == "" {
+			return
+		}
+		volumeBackups = append(volumeBackups, VolumeBackup{Name: name, Backup: backup, SnapshotID: snapshotID})
+	}
+
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := cli.List(ctx, pvbs, client.InNamespace(repo.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "error listing pod volume backups")
+	}
+	for i, pvb := range pvbs.Items {
+		if podVolumeBackupInRepo(&pvbs.Items[i], repo) {
+			add(&pvbs.Items[i], "podvolumebackup/"+pvb.Name, pvb.Status.SnapshotID)
+		}
+	}
+
+	// CSI snapshot data is always moved to a kopia repository
+	if repoType == velerov1api.BackupRepositoryTypeKopia {
+		dataUploads := &velerov1api.DataUploadList{}
+		if err := cli.List(ctx, dataUploads, client.InNamespace(repo.Namespace)); err != nil {
+			return nil, errors.Wrap(err, "error listing data uploads")
+		}
+		for i, du := range dataUploads.Items {
+			if du.Spec.BackupStorageLocation == repo.Spec.BackupStorageLocation && du.Spec.SourceNamespace == repo.Spec.VolumeNamespace {
+				add(&dataUploads.Items[i], "dataupload/"+du.Name, du.Status.SnapshotID)
+			}
+		}
+	}
+
+	return volumeBackups, nil
+}
+
+// podVolumeBackupInRepo returns whether the data of pvb is in repo.
+func podVolumeBackupInRepo(pvb *velerov1api.PodVolumeBackup, repo *velerov1api.BackupRepository) bool {
+	repoType := repo.Spec.RepositoryType
+	if repoType == "" {
+		repoType = velerov1api.BackupRepositoryTypeRestic
+	}
+
+	return pvb.Spec.BackupStorageLocation == repo.Spec.BackupStorageLocation && pvb.Spec.Pod.Namespace == repo.Spec.VolumeNamespace &&
+		GetRepositoryType(pvb.Spec.UploaderType) == repoType
+}
+
+// backupNameOf returns the name of the backup a pod volume backup or data upload belongs to.
+func backupNameOf(obj metav1.Object) string {
+	if owner := metav1.GetControllerOf(obj); owner != nil && owner.Kind == "Backup" {
+		return owner.Name
+	}
+	return obj.GetLabels()[velerov1api.BackupNameLabel]
+}
@@ -114,6 +114,98 @@ func UnlockCommand(repoIdentifier string) *Command {
 	}
 }
 
+// RepoStatsCommand returns a command that prints the size of the deduplicated data stored
+// in a repository.
+func RepoStatsCommand(repoIdentifier string) *Command {
+	return &Command{
+		Command:        "stats",
+		RepoIdentifier: repoIdentifier,
+		ExtraFlags:     []string{"--mode=raw-data", "--json"},
+	}
+}
+
+// SnapshotStatsCommand returns a command that prints the stats of snapshots in mode, e.g.
+// restore-size or raw-data.
+func SnapshotStatsCommand(repoIdentifier, mode string, snapshotIDs []string) *Command {
+	return &Command{
+		Command:        "stats",
+		RepoIdentifier: repoIdentifier,
+		Args:           snapshotIDs,
+		ExtraFlags:     []string{fmt.Sprintf("--mode=%s", mode), "--json"},
+	}
+}
+
+// CheckCommand returns a command that checks the integrity of a repository and reads back
+// readDataPercent percent of its data.
+func CheckCommand(repoIdentifier string, readDataPercent int) *Command {
+	cmd := &Command{
+		Command:        "check",
+		RepoIdentifier: repoIdentifier,
+	}
+	if readDataPercent > 0 {
+		cmd.ExtraFlags = append(cmd.ExtraFlags, fmt.Sprintf("--read-data-subset=%d%%", readDataPercent))
+	}
+	return cmd
+}
+
+// KeyListCommand returns a command that lists the keys of a repository.
+func KeyListCommand(repoIdentifier string) *Command {
+	return &Command{
+		Command:        "key",
+		RepoIdentifier: repoIdentifier,
+		Args:           []string{"list"},
+		ExtraFlags:     []string{"--json"},
+	}
+}
+
+// KeyAddCommand returns a command that adds a key with the password in newPasswordFile
+// to a repository.
+func KeyAddCommand(repoIdentifier, newPasswordFile string) *Command {
+	return &Command{
+		Command:        "key",
+		RepoIdentifier: repoIdentifier,
+		Args:           []string{"add"},
+		ExtraFlags:     []string{fmt.Sprintf("--new-password-file=%s", newPasswordFile)},
+	}
+}
+
+// KeyRemoveCommand returns a command that removes the key with the given ID from a repository.
+func KeyRemoveCommand(repoIdentifier, keyID string) *Command {
+	return &Command{
+		Command:        "key",
+		RepoIdentifier: repoIdentifier,
+		Args:           []string{"remove", keyID},
+	}
+}
+
+// CopyInitCommand returns a command that initializes a repository with the chunker parameters
+// of the source repository, so that the data copied from the source is deduplicated with the
+// data that's backed up to the repository later.
+func CopyInitCommand(repoIdentifier, sourceRepoIdentifier, sourcePasswordFile string) *Command {
+	return &Command{
+		Command:        "init",
+		RepoIdentifier: repoIdentifier,
+		ExtraFlags: []string{
+			fmt.Sprintf("--repo2=%s", sourceRepoIdentifier),
+			fmt.Sprintf("--password-file2=%s", sourcePasswordFile),
+			"--copy-chunker-params",
+		},
+	}
+}
+
+// CopyCommand returns a command that copies the snapshots of a repository that aren't in the
+// target repository yet to it.
+func CopyCommand(repoIdentifier, targetRepoIdentifier, targetPasswordFile string) *Command {
+	return &Command{
+		Command:        "copy",
+		RepoIdentifier: repoIdentifier,
+		ExtraFlags: []string{
+			fmt.Sprintf("--repo2=%s", targetRepoIdentifier),
+			fmt.Sprintf("--password-file2=%s", targetPasswordFile),
+		},
+	}
+}
+
 func StatsCommand(repoIdentifier, passwordFile, snapshotID string) *Command {
 	return &Command{
 		Command:        "stats",
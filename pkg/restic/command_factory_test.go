@@ -121,6 +121,69 @@ func TestForgetCommand(t *testing.T) {
 	assert.Equal(t, []string{"snapshot-id"}, c.Args)
 }
 
+func TestRepoStatsCommand(t *testing.T) {
+	c := RepoStatsCommand("repo-id")
+
+	assert.Equal(t, "stats", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Empty(t, c.Args)
+	assert.Equal(t, []string{"--mode=raw-data", "--json"}, c.ExtraFlags)
+}
+
+func TestCheckCommand(t *testing.T) {
+	c := CheckCommand("repo-id", 0)
+
+	assert.Equal(t, "check", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Empty(t, c.Args)
+	assert.Empty(t, c.ExtraFlags)
+
+	c = CheckCommand("repo-id", 10)
+	assert.Equal(t, []string{"--read-data-subset=10%"}, c.ExtraFlags)
+}
+
+func TestKeyCommands(t *testing.T) {
+	c := KeyListCommand("repo-id")
+	assert.Equal(t, "key", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"list"}, c.Args)
+	assert.Equal(t, []string{"--json"}, c.ExtraFlags)
+
+	c = KeyAddCommand("repo-id", "new-password-file")
+	assert.Equal(t, "key", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"add"}, c.Args)
+	assert.Equal(t, []string{"--new-password-file=new-password-file"}, c.ExtraFlags)
+
+	c = KeyRemoveCommand("repo-id", "key-id")
+	assert.Equal(t, "key", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"remove", "key-id"}, c.Args)
+	assert.Empty(t, c.ExtraFlags)
+}
+
+func TestCopyCommands(t *testing.T) {
+	c := CopyInitCommand("target-id", "repo-id", "password-file")
+	assert.Equal(t, "init", c.Command)
+	assert.Equal(t, "target-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"--repo2=repo-id", "--password-file2=password-file", "--copy-chunker-params"}, c.ExtraFlags)
+
+	c = CopyCommand("repo-id", "target-id", "target-password-file")
+	assert.Equal(t, "copy", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Empty(t, c.Args)
+	assert.Equal(t, []string{"--repo2=target-id", "--password-file2=target-password-file"}, c.ExtraFlags)
+}
+
+func TestSnapshotStatsCommand(t *testing.T) {
+	c := SnapshotStatsCommand("repo-id", "raw-data", []string{"snapshot-1", "snapshot-2"})
+
+	assert.Equal(t, "stats", c.Command)
+	assert.Equal(t, "repo-id", c.RepoIdentifier)
+	assert.Equal(t, []string{"snapshot-1", "snapshot-2"}, c.Args)
+	assert.Equal(t, []string{"--mode=raw-data", "--json"}, c.ExtraFlags)
+}
+
 func TestStatsCommand(t *testing.T) {
 	c := StatsCommand("repo-id", "password-file", "snapshot-id")
 
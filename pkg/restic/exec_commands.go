@@ -18,6 +18,7 @@ package restic
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -70,8 +71,8 @@ func GetSnapshotID(snapshotIdCmd *Command) (string, error) {
 }
 
 // RunBackup runs a `restic backup` command and watches the output to provide
-// progress updates to the caller.
-func RunBackup(backupCmd *Command, log logrus.FieldLogger, updater uploader.ProgressUpdater) (string, string, error) {
+// progress updates to the caller. The command is killed if ctx is done before it exits.
+func RunBackup(ctx context.Context, backupCmd *Command, log logrus.FieldLogger, updater uploader.ProgressUpdater) (string, string, error) {
 	// buffers for copying command stdout/err output into
 	stdoutBuf := new(bytes.Buffer)
 	stderrBuf := new(bytes.Buffer)
@@ -80,7 +81,7 @@ func RunBackup(backupCmd *Command, log logrus.FieldLogger, updater uploader.Prog
 	// updates
 	quit := make(chan struct{})
 
-	cmd := backupCmd.Cmd()
+	cmd := backupCmd.CmdContext(ctx)
 	cmd.Stdout = stdoutBuf
 	cmd.Stderr = stderrBuf
 
@@ -183,8 +184,9 @@ func getSummaryLine(b []byte) ([]byte, error) {
 }
 
 // RunRestore runs a `restic restore` command and monitors the volume size to
-// provide progress updates to the caller.
-func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updater uploader.ProgressUpdater) (string, string, error) {
+// provide progress updates to the caller. The command is killed if ctx is done
+// before it exits.
+func RunRestore(ctx context.Context, restoreCmd *Command, log logrus.FieldLogger, updater uploader.ProgressUpdater) (string, string, error) {
 	insecureTLSFlag := ""
 
 	for _, extraFlag := range restoreCmd.ExtraFlags {
@@ -229,7 +231,7 @@ func RunRestore(restoreCmd *Command, log logrus.FieldLogger, updater uploader.Pr
 		}
 	}()
 
-	stdout, stderr, err := exec.RunCommand(restoreCmd.Cmd())
+	stdout, stderr, err := exec.RunCommand(restoreCmd.CmdContext(ctx))
 	quit <- struct{}{}
 
 	// update progress to 100%
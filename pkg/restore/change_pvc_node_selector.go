@@ -26,28 +26,29 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 // ChangePVCNodeSelectorAction updates/reset PVC's node selector
-// if a mapping is found in the plugin's config map.
+// if a mapping is found in the plugin's config.
 type ChangePVCNodeSelectorAction struct {
-	logger          logrus.FieldLogger
-	configMapClient corev1client.ConfigMapInterface
-	nodeClient      corev1client.NodeInterface
+	logger       logrus.FieldLogger
+	configGetter framework.PluginConfigGetter
+	nodeClient   corev1client.NodeInterface
 }
 
 // NewChangePVCNodeSelectorAction is the constructor for ChangePVCNodeSelectorAction.
 func NewChangePVCNodeSelectorAction(
 	logger logrus.FieldLogger,
-	configMapClient corev1client.ConfigMapInterface,
+	configGetter framework.PluginConfigGetter,
 	nodeClient corev1client.NodeInterface,
 ) *ChangePVCNodeSelectorAction {
 	return &ChangePVCNodeSelectorAction{
-		logger:          logger,
-		configMapClient: configMapClient,
-		nodeClient:      nodeClient,
+		logger:       logger,
+		configGetter: configGetter,
+		nodeClient:   nodeClient,
 	}
 }
 
@@ -94,7 +95,11 @@ func (p *ChangePVCNodeSelectorAction) Execute(input *velero.RestoreItemActionExe
 	}
 
 	// fetch node mapping from configMap
-	newNode, err := getNewNodeFromConfigMap(p.configMapClient, node)
+	namespace, err := pluginConfigNamespace(input)
+	if err != nil {
+		return nil, err
+	}
+	newNode, err := getNewNodeFromPluginConfig(p.configGetter, namespace, node)
 	if err != nil {
 		return nil, err
 	}
@@ -128,9 +133,9 @@ func (p *ChangePVCNodeSelectorAction) Execute(input *velero.RestoreItemActionExe
 	return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
 }
 
-func getNewNodeFromConfigMap(client corev1client.ConfigMapInterface, node string) (string, error) {
-	// fetch node mapping from configMap
-	config, err := getPluginConfig(common.PluginKindRestoreItemAction, "velero.io/change-pvc-node-selector", client)
+func getNewNodeFromPluginConfig(configGetter framework.PluginConfigGetter, namespace, node string) (string, error) {
+	// fetch node mapping from the plugin config
+	config, err := framework.GetValidPluginConfig(configGetter, common.PluginKindRestoreItemAction, "velero.io/change-pvc-node-selector", namespace, ChangePVCNodeSelectorConfigSchema)
 	if err != nil {
 		return "", err
 	}
@@ -141,7 +146,7 @@ func getNewNodeFromConfigMap(client corev1client.ConfigMapInterface, node string
 		return "", nil
 	}
 
-	return config.Data[node], nil
+	return config[node], nil
 }
 
 // isNodeExist check if node resource exist or not
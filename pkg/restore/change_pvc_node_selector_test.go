@@ -31,7 +31,9 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 // TestChangePVCNodeSelectorActionExecute runs the ChangePVCNodeSelectorAction's Execute
@@ -127,17 +129,17 @@ func TestChangePVCNodeSelectorActionExecute(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			clientset := fake.NewSimpleClientset()
+			var configs []runtime.Object
+			if tc.configMap != nil {
+				configs = append(configs, tc.configMap)
+			}
 			a := NewChangePVCNodeSelectorAction(
 				logrus.StandardLogger(),
-				clientset.CoreV1().ConfigMaps("velero"),
+				framework.NewPluginConfigGetter(velerotest.NewFakeControllerRuntimeClient(t, configs...), "velero"),
 				clientset.CoreV1().Nodes(),
 			)
 
 			// set up test data
-			if tc.configMap != nil {
-				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
-				require.NoError(t, err)
-			}
 
 			if tc.node != nil {
 				_, err := clientset.CoreV1().Nodes().Create(context.TODO(), tc.node, metav1.CreateOptions{})
@@ -22,34 +22,33 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	storagev1client "k8s.io/client-go/kubernetes/typed/storage/v1"
 
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 )
 
 // ChangeStorageClassAction updates a PV or PVC's storage class name
-// if a mapping is found in the plugin's config map.
+// if a mapping is found in the plugin's config.
 type ChangeStorageClassAction struct {
 	logger             logrus.FieldLogger
-	configMapClient    corev1client.ConfigMapInterface
+	configGetter       framework.PluginConfigGetter
 	storageClassClient storagev1client.StorageClassInterface
 }
 
 // NewChangeStorageClassAction is the constructor for ChangeStorageClassAction.
 func NewChangeStorageClassAction(
 	logger logrus.FieldLogger,
-	configMapClient corev1client.ConfigMapInterface,
+	configGetter framework.PluginConfigGetter,
 	storageClassClient storagev1client.StorageClassInterface,
 ) *ChangeStorageClassAction {
 	return &ChangeStorageClassAction{
 		logger:             logger,
-		configMapClient:    configMapClient,
+		configGetter:       configGetter,
 		storageClassClient: storageClassClient,
 	}
 }
@@ -63,27 +62,31 @@ func (a *ChangeStorageClassAction) AppliesTo() (velero.ResourceSelector, error)
 }
 
 // Execute updates the item's spec.storageClassName if a mapping is found
-// in the config map for the plugin.
+// in the config for the plugin.
 func (a *ChangeStorageClassAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 	a.logger.Info("Executing ChangeStorageClassAction")
 	defer a.logger.Info("Done executing ChangeStorageClassAction")
 
+	obj, ok := input.Item.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
+	}
+
 	a.logger.Debug("Getting plugin config")
-	config, err := getPluginConfig(common.PluginKindRestoreItemAction, "velero.io/change-storage-class", a.configMapClient)
+	namespace, err := pluginConfigNamespace(input)
+	if err != nil {
+		return nil, err
+	}
+	config, err := framework.GetValidPluginConfig(a.configGetter, common.PluginKindRestoreItemAction, "velero.io/change-storage-class", namespace, ChangeStorageClassConfigSchema)
 	if err != nil {
 		return nil, err
 	}
 
-	if config == nil || len(config.Data) == 0 {
+	if len(config) == 0 {
 		a.logger.Debug("No storage class mappings found")
 		return velero.NewRestoreItemActionExecuteOutput(input.Item), nil
 	}
 
-	obj, ok := input.Item.(*unstructured.Unstructured)
-	if !ok {
-		return nil, errors.Errorf("object was of unexpected type %T", input.Item)
-	}
-
 	log := a.logger.WithFields(map[string]interface{}{
 		"kind":      obj.GetKind(),
 		"namespace": obj.GetNamespace(),
@@ -140,13 +143,13 @@ func (a *ChangeStorageClassAction) Execute(input *velero.RestoreItemActionExecut
 	return velero.NewRestoreItemActionExecuteOutput(obj), nil
 }
 
-func (a *ChangeStorageClassAction) isStorageClassExist(log *logrus.Entry, storageClass *string, cm *corev1.ConfigMap) (exists bool, newStorageClass string, err error) {
+func (a *ChangeStorageClassAction) isStorageClassExist(log *logrus.Entry, storageClass *string, config map[string]string) (exists bool, newStorageClass string, err error) {
 	if storageClass == nil || *storageClass == "" {
 		log.Debug("Item has no storage class specified")
 		return false, "", nil
 	}
 
-	newStorageClass, ok := cm.Data[*storageClass]
+	newStorageClass, ok := config[*storageClass]
 	if !ok {
 		log.Debugf("No mapping found for storage class %s", *storageClass)
 		return false, "", nil
@@ -32,7 +32,9 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 // TestChangeStorageClassActionExecute runs the ChangeStorageClassAction's Execute
@@ -218,17 +220,17 @@ func TestChangeStorageClassActionExecute(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			clientset := fake.NewSimpleClientset()
+			var configs []runtime.Object
+			if tc.configMap != nil {
+				configs = append(configs, tc.configMap)
+			}
 			a := NewChangeStorageClassAction(
 				logrus.StandardLogger(),
-				clientset.CoreV1().ConfigMaps("velero"),
+				framework.NewPluginConfigGetter(velerotest.NewFakeControllerRuntimeClient(t, configs...), "velero"),
 				clientset.StorageV1().StorageClasses(),
 			)
 
 			// set up test data
-			if tc.configMap != nil {
-				_, err := clientset.CoreV1().ConfigMaps(tc.configMap.Namespace).Create(context.TODO(), tc.configMap, metav1.CreateOptions{})
-				require.NoError(t, err)
-			}
 
 			if tc.storageClass != nil {
 				_, err := clientset.StorageV1().StorageClasses().Create(context.TODO(), tc.storageClass, metav1.CreateOptions{})
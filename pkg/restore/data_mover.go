@@ -0,0 +1,198 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// dataDownloadPollInterval is how often a restore checks whether its data downloads have
+// finished.
+var dataDownloadPollInterval = 5 * time.Second
+
+// dataUploadForPVC returns the completed DataUpload that moved the data of the backed up
+// PersistentVolumeClaim namespace/name to the backup repository, or nil if there's none.
+func (ctx *restoreContext) dataUploadForPVC(namespace, name string) *velerov1api.DataUpload {
+	for _, du := range ctx.dataUploads {
+		if du.Status.Phase == velerov1api.DataUploadPhaseCompleted && du.Spec.SourceNamespace == namespace && du.Spec.SourcePVC == name {
+			return du
+		}
+	}
+	return nil
+}
+
+// hasDataUpload returns whether the data of a backed up PersistentVolume was moved to the
+// backup repository, in which case the volume is provisioned by a DataDownload instead.
+func hasDataUpload(unstructuredPV *unstructured.Unstructured, ctx *restoreContext) bool {
+	if len(ctx.dataUploads) == 0 {
+		return false
+	}
+
+	pv := new(v1.PersistentVolume)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredPV.Object, pv); err != nil {
+		ctx.log.WithError(err).Warnf("Unable to convert PV from unstructured to structured")
+		return false
+	}
+
+	if pv.Spec.ClaimRef == nil {
+		return false
+	}
+
+	return ctx.dataUploadForPVC(pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name) != nil
+}
+
+// resetPVCForDataDownload unbinds a PersistentVolumeClaim whose data is restored from a
+// DataUpload, and makes it select the volume the DataDownload provisions by the
+// velero.io/dynamic-pv-restore label. The claim isn't dynamically provisioned, since it has
+// a selector.
+func (ctx *restoreContext) resetPVCForDataDownload(obj *unstructured.Unstructured, du *velerov1api.DataUpload) error {
+	unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+	unstructured.RemoveNestedField(obj.Object, "spec", "dataSource")
+	unstructured.RemoveNestedField(obj.Object, "spec", "dataSourceRef")
+	unstructured.RemoveNestedField(obj.Object, "spec", "selector")
+
+	value := label.GetValidName(ctx.restore.Name + "-" + du.Name)
+	return errors.WithStack(unstructured.SetNestedStringMap(obj.Object, map[string]string{velerov1api.DynamicPVRestoreLabel: value}, "spec", "selector", "matchLabels"))
+}
+
+// createDataDownload creates a DataDownload that restores the data a DataUpload moved to the
+// backup repository into a new volume for the restored PersistentVolumeClaim namespace/name.
+func (ctx *restoreContext) createDataDownload(du *velerov1api.DataUpload, namespace, name string) error {
+	dd := &velerov1api.DataDownload{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: velerov1api.SchemeGroupVersion.String(),
+			Kind:       "DataDownload",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    ctx.restore.Namespace,
+			GenerateName: ctx.restore.Name + "-",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: velerov1api.SchemeGroupVersion.String(),
+					Kind:       "Restore",
+					Name:       ctx.restore.Name,
+					UID:        ctx.restore.UID,
+					Controller: boolptr.True(),
+				},
+			},
+			Labels: map[string]string{
+				velerov1api.RestoreNameLabel: label.GetValidName(ctx.restore.Name),
+				velerov1api.RestoreUIDLabel:  string(ctx.restore.UID),
+			},
+		},
+		Spec: velerov1api.DataDownloadSpec{
+			TargetPVC:             name,
+			TargetNamespace:       namespace,
+			SnapshotID:            du.Status.SnapshotID,
+			SourceNamespace:       du.Spec.SourceNamespace,
+			BackupStorageLocation: ctx.backup.Spec.StorageLocation,
+		},
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dd)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	client, err := ctx.dataDownloadClient()
+	if err != nil {
+		return err
+	}
+	created, err := client.Create(&unstructured.Unstructured{Object: content})
+	if err != nil {
+		return errors.Wrapf(err, "error creating data download for persistent volume claim %s/%s", namespace, name)
+	}
+
+	ctx.log.Infof("Created data download %s to restore the data of persistent volume claim %s/%s", created.GetName(), namespace, name)
+	ctx.dataDownloads = append(ctx.dataDownloads, created.GetName())
+	return nil
+}
+
+// waitDataDownloads waits for the restore's DataDownloads to finish, and returns an error for
+// each of them that didn't complete. The DataDownloads that are still running when the restore
+// is canceled or times out are canceled.
+func (ctx *restoreContext) waitDataDownloads() []error {
+	if len(ctx.dataDownloads) == 0 {
+		return nil
+	}
+
+	client, err := ctx.dataDownloadClient()
+	if err != nil {
+		return []error{err}
+	}
+
+	stop := ctx.requestContext.Done()
+	if ctx.podVolumeContext != nil {
+		stop = ctx.podVolumeContext.Done()
+	}
+
+	var errs []error
+	pending := sets.NewString(ctx.dataDownloads...)
+	err = wait.PollImmediateUntil(dataDownloadPollInterval, func() (bool, error) {
+		for _, name := range pending.List() {
+			obj, err := client.Get(name, metav1.GetOptions{})
+			if err != nil {
+				ctx.log.WithError(err).Warnf("Error getting data download %s", name)
+				continue
+			}
+			dd := new(velerov1api.DataDownload)
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, dd); err != nil {
+				ctx.log.WithError(err).Warnf("Unable to convert data download %s from unstructured to structured", name)
+				continue
+			}
+
+			switch dd.Status.Phase {
+			case velerov1api.DataDownloadPhaseCompleted:
+				pending.Delete(name)
+			case velerov1api.DataDownloadPhaseFailed, velerov1api.DataDownloadPhaseCanceled:
+				pending.Delete(name)
+				errs = append(errs, errors.Errorf("data download %s for persistent volume claim %s/%s %s: %s",
+					name, dd.Spec.TargetNamespace, dd.Spec.TargetPVC, strings.ToLower(string(dd.Status.Phase)), dd.Status.Message))
+			}
+		}
+		return pending.Len() == 0, nil
+	}, stop)
+	if err != nil {
+		for _, name := range pending.List() {
+			if _, err := client.Patch(name, []byte(`{"spec":{"cancel":true}}`)); err != nil {
+				ctx.log.WithError(err).Errorf("Error canceling data download %s", name)
+			}
+			errs = append(errs, errors.Errorf("data download %s didn't finish before the restore was canceled or timed out", name))
+		}
+	}
+
+	return errs
+}
+
+func (ctx *restoreContext) dataDownloadClient() (client.Dynamic, error) {
+	resource := metav1.APIResource{Name: "datadownloads", Namespaced: true}
+	return ctx.dynamicFactory.ClientForGroupVersionResource(velerov1api.SchemeGroupVersion, resource, ctx.restore.Namespace)
+}
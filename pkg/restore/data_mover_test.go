@@ -0,0 +1,135 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestRestoreDataMover runs a restore of a backup that moved the data of a CSI snapshot to
+// the backup repository, and verifies that the volume isn't restored, that its claim is reset
+// to bind to the volume of a DataDownload, and that the DataDownload is created and waited for.
+func TestRestoreDataMover(t *testing.T) {
+	defer func(interval time.Duration) { dataDownloadPollInterval = interval }(dataDownloadPollInterval)
+	dataDownloadPollInterval = 10 * time.Millisecond
+
+	dataUpload := &velerov1api.DataUpload{
+		ObjectMeta: metav1.ObjectMeta{Namespace: velerov1api.DefaultNamespace, Name: "backup-1-abcde"},
+		Spec: velerov1api.DataUploadSpec{
+			VolumeSnapshot:        "snapshot-1",
+			SourcePVC:             "pvc-1",
+			SourceNamespace:       "ns-1",
+			BackupStorageLocation: "default",
+		},
+		Status: velerov1api.DataUploadStatus{
+			Phase:      velerov1api.DataUploadPhaseCompleted,
+			SnapshotID: "k0123",
+		},
+	}
+
+	h := newHarness(t)
+	// the fake dynamic client doesn't generate names
+	h.DynamicClient.PrependReactor("create", "datadownloads", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		obj := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		obj.SetName(obj.GetGenerateName() + "fghij")
+		return false, nil, nil
+	})
+	h.AddItems(t, test.PVs())
+	h.AddItems(t, test.PVCs())
+
+	pvc := builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
+	pvc.Spec.DataSource = &corev1api.TypedLocalObjectReference{Kind: "VolumeSnapshot", Name: "snapshot-1"}
+
+	// the other claim's data wasn't moved, so it's restored as before
+	other := builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result()
+
+	tarball := test.NewTarWriter(t).
+		AddItems("persistentvolumes",
+			builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimDelete).ClaimRef("ns-1", "pvc-1").Result(),
+			builder.ForPersistentVolume("pv-2").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).ClaimRef("ns-1", "pvc-2").Result(),
+		).
+		AddItems("persistentvolumeclaims", pvc, other).
+		Done()
+
+	data := Request{
+		Log: h.log,
+		Restore: defaultRestore().
+			ObjectMeta(builder.WithAnnotations(velerov1api.PodVolumeOperationTimeoutAnnotation, "100ms")).
+			Result(),
+		Backup:       defaultBackup().StorageLocation("default").Result(),
+		BackupReader: tarball,
+		DataUploads:  []*velerov1api.DataUpload{dataUpload},
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+
+	assertEmptyResults(t, warnings)
+	assert.Empty(t, errs.Namespaces)
+	require.Len(t, errs.Velero, 1)
+	assert.Contains(t, errs.Velero[0], "didn't finish before the restore was canceled or timed out")
+
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.PVs():  {"/pv-2"},
+		test.PVCs(): {"ns-1/pvc-1", "ns-1/pvc-2"},
+	})
+
+	restoredPVC, err := h.DynamicClient.Resource(test.PVCs().GVR()).Namespace("ns-1").Get(context.TODO(), "pvc-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	volumeName, _, _ := unstructured.NestedString(restoredPVC.Object, "spec", "volumeName")
+	assert.Empty(t, volumeName)
+	_, found, _ := unstructured.NestedMap(restoredPVC.Object, "spec", "dataSource")
+	assert.False(t, found)
+	matchLabels, _, _ := unstructured.NestedStringMap(restoredPVC.Object, "spec", "selector", "matchLabels")
+	assert.Equal(t, map[string]string{velerov1api.DynamicPVRestoreLabel: "restore-1-backup-1-abcde"}, matchLabels)
+
+	otherPVC, err := h.DynamicClient.Resource(test.PVCs().GVR()).Namespace("ns-1").Get(context.TODO(), "pvc-2", metav1.GetOptions{})
+	require.NoError(t, err)
+	_, found, _ = unstructured.NestedMap(otherPVC.Object, "spec", "selector")
+	assert.False(t, found)
+
+	gvr := velerov1api.SchemeGroupVersion.WithResource("datadownloads")
+	dataDownloads, err := h.DynamicClient.Resource(gvr).Namespace(velerov1api.DefaultNamespace).List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, dataDownloads.Items, 1)
+
+	dd := new(velerov1api.DataDownload)
+	require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(dataDownloads.Items[0].Object, dd))
+	assert.Equal(t, velerov1api.DataDownloadSpec{
+		TargetPVC:             "pvc-1",
+		TargetNamespace:       "ns-1",
+		SnapshotID:            "k0123",
+		SourceNamespace:       "ns-1",
+		BackupStorageLocation: "default",
+		// the data download didn't finish in time
+		Cancel: true,
+	}, dd.Spec)
+	assert.Equal(t, "restore-1-fghij", dd.Name)
+	assert.Equal(t, "restore-1", dd.Labels[velerov1api.RestoreNameLabel])
+}
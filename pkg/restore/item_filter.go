@@ -0,0 +1,366 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// csiVolumeSnapshotNameAnnotation is the annotation the CSI plugin sets on the
+// PersistentVolumeClaims it snapshots, naming their VolumeSnapshot.
+const csiVolumeSnapshotNameAnnotation = "velero.io/volume-snapshot-name"
+
+// itemPattern matches the items of a resource by namespace and name.
+type itemPattern struct {
+	resource string
+	// namespace is nil if the pattern matches items in any namespace,
+	// and cluster-scoped items.
+	namespace *regexp.Regexp
+	name      *regexp.Regexp
+}
+
+// parseItemPattern parses a pattern in the form resource[.group]/name or
+// resource[.group]/namespace/name.
+func parseItemPattern(pattern string) (itemPattern, error) {
+	parts := strings.Split(pattern, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return itemPattern{}, errors.Errorf("invalid item pattern %q, must be in the form resource[.group]/name or resource[.group]/namespace/name", pattern)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return itemPattern{}, errors.Errorf("invalid item pattern %q, resource, namespace and name must not be empty", pattern)
+		}
+	}
+	if strings.ContainsAny(parts[0], "*?") {
+		return itemPattern{}, errors.Errorf("invalid item pattern %q, resource must not contain wildcards", pattern)
+	}
+
+	res := itemPattern{resource: parts[0]}
+	var err error
+	if len(parts) == 3 {
+		if res.namespace, err = compileGlob(parts[1]); err != nil {
+			return itemPattern{}, err
+		}
+	}
+	if res.name, err = compileGlob(parts[len(parts)-1]); err != nil {
+		return itemPattern{}, err
+	}
+	return res, nil
+}
+
+// ValidateItemPatterns returns an error for each of the included and excluded item patterns
+// of a restore that's invalid.
+func ValidateItemPatterns(includes, excludes []string) []error {
+	var errs []error
+	for _, pattern := range append(append([]string{}, includes...), excludes...) {
+		if _, err := parseItemPattern(pattern); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// ValidateFieldSelectors returns an error for each of the field selectors of a restore
+// that's invalid.
+func ValidateFieldSelectors(selectors map[string]string) []error {
+	var errs []error
+	for resource, selector := range selectors {
+		if resource == "" {
+			errs = append(errs, errors.Errorf("invalid field selector %q, resource must not be empty", selector))
+			continue
+		}
+		if _, err := fields.ParseSelector(selector); err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid field selector of %s", resource))
+		}
+	}
+	return errs
+}
+
+func (p itemPattern) matches(resource, namespace, name string) bool {
+	if p.resource != resource {
+		return false
+	}
+	if p.namespace != nil && !p.namespace.MatchString(namespace) {
+		return false
+	}
+	return p.name.MatchString(name)
+}
+
+// itemFilter selects the items in the backup to restore by name and by fields.
+type itemFilter struct {
+	includes []itemPattern
+	excludes []itemPattern
+	// dependencies are the items that included items depend on.
+	dependencies map[velero.ResourceIdentifier]struct{}
+	// fieldSelectors are the field selectors of the items of resources, keyed by
+	// resource.group.
+	fieldSelectors map[string]fields.Selector
+}
+
+// newItemFilter returns an item filter for the restore's included and excluded items and
+// field selectors, or nil if it doesn't have any. The resources of the patterns and field
+// selectors are resolved to resource.group names, and the items that the included items
+// in the backup depend on are found. It returns warnings for the resources that can't be
+// resolved, which are matched by their name as given.
+func (ctx *restoreContext) newItemFilter(backupResources map[string]*archive.ResourceItems) (*itemFilter, Result, error) {
+	warnings := Result{}
+	spec := ctx.restore.Spec
+	if len(spec.IncludedItems) == 0 && len(spec.ExcludedItems) == 0 && len(spec.FieldSelectors) == 0 {
+		return nil, warnings, nil
+	}
+
+	filter := &itemFilter{
+		dependencies:   make(map[velero.ResourceIdentifier]struct{}),
+		fieldSelectors: make(map[string]fields.Selector),
+	}
+	var err error
+	if filter.includes, err = ctx.resolveItemPatterns(spec.IncludedItems, &warnings); err != nil {
+		return nil, warnings, err
+	}
+	if filter.excludes, err = ctx.resolveItemPatterns(spec.ExcludedItems, &warnings); err != nil {
+		return nil, warnings, err
+	}
+	for resource, s := range spec.FieldSelectors {
+		selector, err := fields.ParseSelector(s)
+		if err != nil {
+			return nil, warnings, errors.Wrapf(err, "invalid field selector of %s", resource)
+		}
+		filter.fieldSelectors[ctx.resolveResource(resource, &warnings)] = selector
+	}
+
+	if len(filter.includes) > 0 {
+		for _, resource := range []schema.GroupResource{kuberesource.Pods, kuberesource.PersistentVolumeClaims} {
+			resourceItems := backupResources[resource.String()]
+			if resourceItems == nil {
+				continue
+			}
+			for namespace, names := range resourceItems.ItemsByNamespace {
+				for _, name := range names {
+					if filter.included(resource.String(), namespace, name) {
+						ctx.addItemDependencies(filter, resource, namespace, name)
+					}
+				}
+			}
+		}
+	}
+
+	return filter, warnings, nil
+}
+
+// resolveItemPatterns parses item patterns and resolves their resources to resource.group
+// names.
+func (ctx *restoreContext) resolveItemPatterns(patterns []string, warnings *Result) ([]itemPattern, error) {
+	var res []itemPattern
+	for _, pattern := range patterns {
+		p, err := parseItemPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		p.resource = ctx.resolveResource(p.resource, warnings)
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+// resolveResource resolves a resource to its resource.group name, such as deployments.apps
+// for deployments. Resources that the cluster doesn't serve, for example because their
+// CRDs are restored by the restore, are returned as given with a warning.
+func (ctx *restoreContext) resolveResource(resource string, warnings *Result) string {
+	gvr, _, err := ctx.discoveryHelper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+	if err != nil {
+		ctx.log.WithError(err).Warnf("Unable to resolve resource %s of the restore's item filters", resource)
+		warnings.AddVeleroError(errors.Wrapf(err, "unable to resolve resource %s of the restore's item filters, matching it by name", resource))
+		return resource
+	}
+	return gvr.GroupResource().String()
+}
+
+// itemFilePath returns the path of an item in the backup, in the directory of the API group
+// version chosen to be restored if there is one.
+func (ctx *restoreContext) itemFilePath(groupResource schema.GroupResource, namespace, name string) string {
+	resource := groupResource.String()
+	if cgv, ok := ctx.chosenGrpVersToRestore[resource]; ok {
+		resource = filepath.Join(resource, cgv.Dir)
+	}
+	return archive.GetItemFilePath(ctx.restoreDir, resource, namespace, name)
+}
+
+// addItemDependencies adds the items that a Pod or PersistentVolumeClaim in the backup
+// depends on to the filter's dependencies: the PersistentVolumeClaims of a Pod, and the
+// PersistentVolume, CSI VolumeSnapshot and Pods with Restic backups of the volume of a
+// PersistentVolumeClaim.
+func (ctx *restoreContext) addItemDependencies(filter *itemFilter, groupResource schema.GroupResource, namespace, name string) {
+	add := func(groupResource schema.GroupResource, namespace, name string) {
+		key := velero.ResourceIdentifier{GroupResource: groupResource, Namespace: namespace, Name: name}
+		if _, ok := filter.dependencies[key]; ok {
+			return
+		}
+		ctx.log.Infof("Including %s %s/%s in the restore because an included item depends on it", groupResource, namespace, name)
+		filter.dependencies[key] = struct{}{}
+		if groupResource == kuberesource.Pods || groupResource == kuberesource.PersistentVolumeClaims {
+			ctx.addItemDependencies(filter, groupResource, namespace, name)
+		}
+	}
+
+	obj, err := archive.Unmarshal(ctx.fileSystem, ctx.itemFilePath(groupResource, namespace, name))
+	if err != nil {
+		ctx.log.WithError(err).Warnf("Unable to read %s %s/%s to find the items it depends on", groupResource, namespace, name)
+		return
+	}
+
+	switch groupResource {
+	case kuberesource.Pods:
+		for _, claim := range podClaimNames(obj) {
+			add(kuberesource.PersistentVolumeClaims, namespace, claim)
+		}
+	case kuberesource.PersistentVolumeClaims:
+		if volumeName, _, _ := unstructured.NestedString(obj.Object, "spec", "volumeName"); volumeName != "" {
+			add(kuberesource.PersistentVolumes, "", volumeName)
+		}
+		if snapshot := obj.GetAnnotations()[csiVolumeSnapshotNameAnnotation]; snapshot != "" {
+			add(kuberesource.VolumeSnapshots, namespace, snapshot)
+		}
+		// Restic restores the data of a volume through the pod that mounted it when it
+		// was backed up.
+		for _, pvb := range ctx.podVolumeBackups {
+			if pvb.Spec.Pod.Namespace != namespace {
+				continue
+			}
+			pod, err := archive.Unmarshal(ctx.fileSystem, ctx.itemFilePath(kuberesource.Pods, namespace, pvb.Spec.Pod.Name))
+			if err != nil {
+				continue
+			}
+			if podVolumeClaimName(pod, pvb.Spec.Volume) == name {
+				add(kuberesource.Pods, namespace, pvb.Spec.Pod.Name)
+			}
+		}
+	}
+}
+
+// podClaimNames returns the names of the PersistentVolumeClaims a pod mounts.
+func podClaimNames(pod *unstructured.Unstructured) []string {
+	var claims []string
+	volumes, _, _ := unstructured.NestedSlice(pod.Object, "spec", "volumes")
+	for _, volume := range volumes {
+		volume, ok := volume.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if claim, _, _ := unstructured.NestedString(volume, "persistentVolumeClaim", "claimName"); claim != "" {
+			claims = append(claims, claim)
+		}
+	}
+	return claims
+}
+
+// podVolumeClaimName returns the name of the PersistentVolumeClaim of a pod's volume, or
+// an empty string if the volume isn't a PersistentVolumeClaim.
+func podVolumeClaimName(pod *unstructured.Unstructured, volumeName string) string {
+	volumes, _, _ := unstructured.NestedSlice(pod.Object, "spec", "volumes")
+	for _, volume := range volumes {
+		volume, ok := volume.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(volume, "name"); name != volumeName {
+			continue
+		}
+		claim, _, _ := unstructured.NestedString(volume, "persistentVolumeClaim", "claimName")
+		return claim
+	}
+	return ""
+}
+
+// included returns whether an item matches the filter's included item patterns, or all
+// items are included.
+func (f *itemFilter) included(resource, namespace, name string) bool {
+	if len(f.includes) == 0 {
+		return true
+	}
+	for _, p := range f.includes {
+		if p.matches(resource, namespace, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldInclude returns whether an item in the backup should be restored: it isn't
+// excluded, and it's either included or an included item depends on it.
+func (f *itemFilter) ShouldInclude(groupResource schema.GroupResource, namespace, name string) bool {
+	if f == nil {
+		return true
+	}
+	for _, p := range f.excludes {
+		if p.matches(groupResource.String(), namespace, name) {
+			return false
+		}
+	}
+	if f.included(groupResource.String(), namespace, name) {
+		return true
+	}
+	_, ok := f.dependencies[velero.ResourceIdentifier{GroupResource: groupResource, Namespace: namespace, Name: name}]
+	return ok
+}
+
+// MatchesFields returns whether an item in the backup matches the field selector of its
+// resource, if there is one.
+func (f *itemFilter) MatchesFields(groupResource schema.GroupResource, obj *unstructured.Unstructured) bool {
+	if f == nil {
+		return true
+	}
+	selector, ok := f.fieldSelectors[groupResource.String()]
+	if !ok {
+		return true
+	}
+	return selector.Matches(itemFields(obj.Object))
+}
+
+// itemFields exposes the fields of an item to field selectors by their path, such as
+// metadata.name or status.phase. Fields that aren't set, or that aren't strings, numbers
+// or booleans, have empty values.
+type itemFields map[string]interface{}
+
+func (f itemFields) Has(field string) bool {
+	_, found, err := unstructured.NestedFieldNoCopy(f, strings.Split(field, ".")...)
+	return found && err == nil
+}
+
+func (f itemFields) Get(field string) string {
+	value, found, err := unstructured.NestedFieldNoCopy(f, strings.Split(field, ".")...)
+	if !found || err != nil {
+		return ""
+	}
+	switch value.(type) {
+	case string, bool, int64, float64:
+		return fmt.Sprint(value)
+	default:
+		return ""
+	}
+}
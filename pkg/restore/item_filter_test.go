@@ -0,0 +1,259 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	uploadermocks "github.com/vmware-tanzu/velero/pkg/podvolume/mocks"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestRestoreItemFiltering runs restores with included and excluded item patterns, and
+// verifies that the set of items created in the API are correct.
+func TestRestoreItemFiltering(t *testing.T) {
+	tests := []struct {
+		name             string
+		restore          *velerov1api.Restore
+		apiResources     []*test.APIResource
+		podVolumeBackups []*velerov1api.PodVolumeBackup
+		tarball          io.Reader
+		want             map[*test.APIResource][]string
+		wantWarnings     Result
+	}{
+		{
+			name:    "included items in any namespace are restored",
+			restore: defaultRestore().IncludedItems("secrets/app-*").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets",
+					builder.ForSecret("ns-1", "app-1").Result(),
+					builder.ForSecret("ns-2", "app-2").Result(),
+					builder.ForSecret("ns-1", "other").Result(),
+				).
+				AddItems("pods", builder.ForPod("ns-1", "app-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{test.Secrets(), test.Pods()},
+			want: map[*test.APIResource][]string{
+				test.Secrets(): {"ns-1/app-1", "ns-2/app-2"},
+				test.Pods():    {},
+			},
+		},
+		{
+			name:    "included items in a namespace are restored",
+			restore: defaultRestore().IncludedItems("secrets/ns-1/db-creds", "deployments/ns-?/deploy-1").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets",
+					builder.ForSecret("ns-1", "db-creds").Result(),
+					builder.ForSecret("ns-2", "db-creds").Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").Result(),
+					builder.ForDeployment("ns-2", "deploy-1").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Secrets(), test.Deployments()},
+			want: map[*test.APIResource][]string{
+				test.Secrets():     {"ns-1/db-creds"},
+				test.Deployments(): {"ns-1/deploy-1", "ns-2/deploy-1"},
+			},
+		},
+		{
+			name:    "excluded items aren't restored",
+			restore: defaultRestore().ExcludedItems("secrets/ns-1/*", "pods/pod-2").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets",
+					builder.ForSecret("ns-1", "secret-1").Result(),
+					builder.ForSecret("ns-2", "secret-2").Result(),
+				).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Secrets(), test.Pods()},
+			want: map[*test.APIResource][]string{
+				test.Secrets(): {"ns-2/secret-2"},
+				test.Pods():    {"ns-1/pod-1"},
+			},
+		},
+		{
+			name:    "the persistent volume claims of included pods are restored",
+			restore: defaultRestore().IncludedItems("pods/ns-1/pod-1").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+				).
+				AddItems("persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods(), test.PVCs()},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1"},
+				test.PVCs(): {"ns-1/pvc-1"},
+			},
+		},
+		{
+			name:    "the persistent volumes and restic backed up pods of included persistent volume claims are restored",
+			restore: defaultRestore().IncludedItems("persistentvolumeclaims/ns-1/pvc-1").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-1").Result()).Result(),
+					builder.ForPod("ns-1", "pod-2").Volumes(builder.ForVolume("data").PersistentVolumeClaimSource("pvc-2").Result()).Result(),
+				).
+				AddItems("persistentvolumeclaims",
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+				).
+				AddItems("persistentvolumes",
+					builder.ForPersistentVolume("pv-1").Result(),
+					builder.ForPersistentVolume("pv-2").Result(),
+				).
+				Done(),
+			podVolumeBackups: []*velerov1api.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-1").PodNamespace("ns-1").PodName("pod-1").Volume("data").SnapshotID("snapshot-1").Result(),
+				builder.ForPodVolumeBackup("velero", "pvb-2").PodNamespace("ns-1").PodName("pod-2").Volume("data").SnapshotID("snapshot-2").Result(),
+			},
+			apiResources: []*test.APIResource{test.Pods(), test.PVCs(), test.PVs()},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1"},
+				test.PVCs(): {"ns-1/pvc-1"},
+				test.PVs():  {"/pv-1"},
+			},
+		},
+		{
+			name: "items of resources with field selectors are restored if they match",
+			restore: defaultRestore().
+				FieldSelector("secrets", "type=Opaque,metadata.namespace!=ns-2").
+				FieldSelector("pods", "spec.nodeName=node-1").
+				Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets",
+					builder.ForSecret("ns-1", "opaque").Type(corev1api.SecretTypeOpaque).Result(),
+					builder.ForSecret("ns-1", "registry").Type(corev1api.SecretTypeDockerConfigJson).Result(),
+					builder.ForSecret("ns-2", "opaque").Type(corev1api.SecretTypeOpaque).Result(),
+				).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").NodeName("node-1").Result(),
+					builder.ForPod("ns-1", "pod-2").NodeName("node-2").Result(),
+					builder.ForPod("ns-1", "pod-3").Result(),
+				).
+				AddItems("serviceaccounts", builder.ForServiceAccount("ns-1", "sa-1").Result()).
+				Done(),
+			apiResources: []*test.APIResource{test.Secrets(), test.Pods(), test.ServiceAccounts()},
+			want: map[*test.APIResource][]string{
+				test.Secrets():         {"ns-1/opaque"},
+				test.Pods():            {"ns-1/pod-1"},
+				test.ServiceAccounts(): {"ns-1/sa-1"},
+			},
+		},
+		{
+			name:    "resources the cluster doesn't serve are matched by name with a warning",
+			restore: defaultRestore().IncludedItems("widgets.example.com/ns-1/widget-1", "secrets/ns-1/db-creds").Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets",
+					builder.ForSecret("ns-1", "db-creds").Result(),
+					builder.ForSecret("ns-1", "other").Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Secrets()},
+			want: map[*test.APIResource][]string{
+				test.Secrets(): {"ns-1/db-creds"},
+			},
+			wantWarnings: Result{Velero: []string{"unable to resolve resource widgets.example.com of the restore's item filters, matching it by name"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			restorer := new(uploadermocks.Restorer)
+			restorer.On("RestorePodVolumes", mock.Anything).Return(nil)
+			h.restorer.resticRestorerFactory = &fakeResticRestorerFactory{restorer: restorer}
+
+			for _, r := range tc.apiResources {
+				h.DiscoveryClient.WithAPIResource(r)
+			}
+			require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+			data := Request{
+				Log:              h.log,
+				Restore:          tc.restore,
+				Backup:           defaultBackup().Result(),
+				PodVolumeBackups: tc.podVolumeBackups,
+				BackupReader:     tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+
+			assertEmptyResults(t, errs)
+			if tc.wantWarnings.Velero == nil {
+				assertEmptyResults(t, warnings)
+			}
+			assertWantErrsOrWarnings(t, tc.wantWarnings, warnings)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+}
+
+func TestValidateItemPatterns(t *testing.T) {
+	assert.Empty(t, ValidateItemPatterns([]string{"configmaps/app-*", "secrets/ns-1/db-creds", "deployments.apps/ns-?/*"}, []string{"pods/pod-1"}))
+
+	errs := ValidateItemPatterns([]string{"configmaps", "secrets/ns-1/db/creds", "secrets//db-creds"}, []string{"config*/app"})
+	require.Len(t, errs, 4)
+	assert.EqualError(t, errs[0], `invalid item pattern "configmaps", must be in the form resource[.group]/name or resource[.group]/namespace/name`)
+	assert.EqualError(t, errs[1], `invalid item pattern "secrets/ns-1/db/creds", must be in the form resource[.group]/name or resource[.group]/namespace/name`)
+	assert.EqualError(t, errs[2], `invalid item pattern "secrets//db-creds", resource, namespace and name must not be empty`)
+	assert.EqualError(t, errs[3], `invalid item pattern "config*/app", resource must not contain wildcards`)
+}
+
+func TestValidateFieldSelectors(t *testing.T) {
+	assert.Empty(t, ValidateFieldSelectors(map[string]string{"pods": "status.phase=Running,spec.nodeName!=node-1", "secrets": "type=Opaque"}))
+
+	errs := ValidateFieldSelectors(map[string]string{"": "type=Opaque"})
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], `invalid field selector "type=Opaque", resource must not be empty`)
+
+	errs = ValidateFieldSelectors(map[string]string{"pods": "status.phase"})
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "invalid field selector of pods")
+}
+
+func TestItemFilePath(t *testing.T) {
+	ctx := &restoreContext{
+		restoreDir: "/restore",
+		chosenGrpVersToRestore: map[string]ChosenGroupVersion{
+			"horizontalpodautoscalers.autoscaling": {Group: "autoscaling", Version: "v2beta1", Dir: "v2beta1"},
+		},
+	}
+
+	assert.Equal(t, "/restore/resources/horizontalpodautoscalers.autoscaling/v2beta1/namespaces/ns-1/hpa-1.json",
+		ctx.itemFilePath(schema.GroupResource{Group: "autoscaling", Resource: "horizontalpodautoscalers"}, "ns-1", "hpa-1"))
+	assert.Equal(t, "/restore/resources/pods/namespaces/ns-1/pod-1.json", ctx.itemFilePath(kuberesource.Pods, "ns-1", "pod-1"))
+}
@@ -0,0 +1,144 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/collections"
+)
+
+// ResolveNamespaceMapping resolves the restore's namespace mapping rules against the
+// namespaces in its backup. It returns the restore's namespace mapping with the mappings of
+// the backup namespaces that the restore includes and that match a rule added, and errors
+// for invalid rules, invalid target names, and target namespaces that more than one source
+// namespace would be restored into.
+func ResolveNamespaceMapping(spec *velerov1api.RestoreSpec, backupNamespaces sets.String) (map[string]string, []error) {
+	var errs []error
+
+	type compiledRule struct {
+		re     *regexp.Regexp
+		target string
+	}
+	var rules []compiledRule
+	for i, rule := range spec.NamespaceMappingRules {
+		re, err := compileNamespaceMappingRule(rule)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "invalid namespace mapping rule %d", i))
+			continue
+		}
+		rules = append(rules, compiledRule{re: re, target: rule.Target})
+	}
+
+	mapping := make(map[string]string, len(spec.NamespaceMapping))
+	for source, target := range spec.NamespaceMapping {
+		mapping[source] = target
+	}
+
+	namespaces := collections.NewIncludesExcludes().Includes(spec.IncludedNamespaces...).Excludes(spec.ExcludedNamespaces...)
+	sources := map[string][]string{}
+	for _, namespace := range backupNamespaces.List() {
+		if !namespaces.ShouldInclude(namespace) {
+			continue
+		}
+
+		if _, ok := mapping[namespace]; !ok {
+			for _, rule := range rules {
+				match := rule.re.FindStringSubmatchIndex(namespace)
+				if match == nil {
+					continue
+				}
+
+				target := string(rule.re.ExpandString(nil, rule.target, namespace, match))
+				if msgs := validation.IsDNS1123Label(target); len(msgs) > 0 {
+					errs = append(errs, errors.Errorf("namespace %s would be mapped to invalid namespace name %q: %s", namespace, target, strings.Join(msgs, "; ")))
+					break
+				}
+				if target != namespace {
+					mapping[namespace] = target
+				}
+				break
+			}
+		}
+
+		target := namespace
+		if mapped, ok := mapping[namespace]; ok {
+			target = mapped
+		}
+		sources[target] = append(sources[target], namespace)
+	}
+
+	targets := make([]string, 0, len(sources))
+	for target := range sources {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+	for _, target := range targets {
+		if len(sources[target]) > 1 {
+			errs = append(errs, errors.Errorf("namespaces %s would all be restored into namespace %s", strings.Join(sources[target], ", "), target))
+		}
+	}
+
+	return mapping, errs
+}
+
+// compileNamespaceMappingRule returns a regular expression matching the whole of the namespace
+// names the rule applies to. The wildcards of a glob pattern are capture groups.
+func compileNamespaceMappingRule(rule velerov1api.NamespaceMappingRule) (*regexp.Regexp, error) {
+	if rule.Pattern == "" {
+		return nil, errors.New("pattern must not be empty")
+	}
+	if rule.Target == "" {
+		return nil, errors.New("target must not be empty")
+	}
+
+	if rule.Regex {
+		re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", rule.Pattern))
+		return re, errors.WithStack(err)
+	}
+
+	return compileGlob(rule.Pattern)
+}
+
+// compileGlob returns a regular expression matching the whole of the strings that a glob
+// pattern matches. The pattern's * and ? wildcards are capture groups.
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			expr.WriteString("(.*)")
+		case '?':
+			expr.WriteString("(.)")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	return re, errors.WithStack(err)
+}
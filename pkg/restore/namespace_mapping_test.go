@@ -0,0 +1,138 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestResolveNamespaceMapping(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       velerov1api.RestoreSpec
+		namespaces []string
+		want       map[string]string
+		wantErrs   []string
+	}{
+		{
+			name:       "glob rule adds a suffix to every namespace",
+			spec:       velerov1api.RestoreSpec{NamespaceMappingRules: []velerov1api.NamespaceMappingRule{{Pattern: "*", Target: "${1}-dr"}}},
+			namespaces: []string{"ns-1", "ns-2"},
+			want:       map[string]string{"ns-1": "ns-1-dr", "ns-2": "ns-2-dr"},
+		},
+		{
+			name: "glob wildcards are capture groups and literals are matched exactly",
+			spec: velerov1api.RestoreSpec{NamespaceMappingRules: []velerov1api.NamespaceMappingRule{
+				{Pattern: "team-?.app-*", Target: "dr-${2}-${1}"},
+			}},
+			namespaces: []string{"team-a.app-web", "team-ab.app-web", "teamxa.app-web"},
+			want:       map[string]string{"team-a.app-web": "dr-web-a"},
+		},
+		{
+			name: "regex rule with capture groups",
+			spec: velerov1api.RestoreSpec{NamespaceMappingRules: []velerov1api.NamespaceMappingRule{
+				{Pattern: "prod-(.+)", Regex: true, Target: "staging-${1}"},
+			}},
+			namespaces: []string{"prod-web", "prod-db", "dev-web"},
+			want:       map[string]string{"prod-web": "staging-web", "prod-db": "staging-db"},
+		},
+		{
+			name: "regex must match the whole name",
+			spec: velerov1api.RestoreSpec{NamespaceMappingRules: []velerov1api.NamespaceMappingRule{
+				{Pattern: "web|db", Regex: true, Target: "${0}-dr"},
+			}},
+			namespaces: []string{"web", "db", "webapp"},
+			want:       map[string]string{"web": "web-dr", "db": "db-dr"},
+		},
+		{
+			name: "explicit mappings take precedence and the first matching rule is used",
+			spec: velerov1api.RestoreSpec{
+				NamespaceMapping: map[string]string{"ns-1": "other"},
+				NamespaceMappingRules: []velerov1api.NamespaceMappingRule{
+					{Pattern: "ns-2", Target: "second"},
+					{Pattern: "ns-*", Target: "${1}-dr"},
+				},
+			},
+			namespaces: []string{"ns-1", "ns-2", "ns-3"},
+			want:       map[string]string{"ns-1": "other", "ns-2": "second", "ns-3": "3-dr"},
+		},
+		{
+			name: "namespaces the restore doesn't include aren't mapped",
+			spec: velerov1api.RestoreSpec{
+				IncludedNamespaces:    []string{"ns-1", "ns-2"},
+				ExcludedNamespaces:    []string{"ns-2"},
+				NamespaceMappingRules: []velerov1api.NamespaceMappingRule{{Pattern: "*", Target: "${1}-dr"}},
+			},
+			namespaces: []string{"ns-1", "ns-2", "ns-3"},
+			want:       map[string]string{"ns-1": "ns-1-dr"},
+		},
+		{
+			name: "two sources mapped to one target are reported",
+			spec: velerov1api.RestoreSpec{NamespaceMappingRules: []velerov1api.NamespaceMappingRule{
+				{Pattern: "*-blue", Target: "${1}"},
+				{Pattern: "*-green", Target: "${1}"},
+			}},
+			namespaces: []string{"app-blue", "app-green", "db-blue"},
+			want:       map[string]string{"app-blue": "app", "app-green": "app", "db-blue": "db"},
+			wantErrs:   []string{"namespaces app-blue, app-green would all be restored into namespace app"},
+		},
+		{
+			name: "a source mapped onto an unmapped namespace is reported",
+			spec: velerov1api.RestoreSpec{NamespaceMappingRules: []velerov1api.NamespaceMappingRule{
+				{Pattern: "*-old", Target: "${1}"},
+			}},
+			namespaces: []string{"app", "app-old"},
+			want:       map[string]string{"app-old": "app"},
+			wantErrs:   []string{"namespaces app, app-old would all be restored into namespace app"},
+		},
+		{
+			name: "invalid rules and target names are reported",
+			spec: velerov1api.RestoreSpec{NamespaceMappingRules: []velerov1api.NamespaceMappingRule{
+				{Pattern: "", Target: "x"},
+				{Pattern: "(", Regex: true, Target: "x"},
+				{Pattern: "ns-*", Target: ""},
+				{Pattern: "ns-*", Target: "${1}_DR"},
+			}},
+			namespaces: []string{"ns-1"},
+			want:       map[string]string{},
+			wantErrs: []string{
+				"invalid namespace mapping rule 0: pattern must not be empty",
+				"invalid namespace mapping rule 1: error parsing regexp: missing closing ): `^(?:()$`",
+				"invalid namespace mapping rule 2: target must not be empty",
+				`namespace ns-1 would be mapped to invalid namespace name "1_DR": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapping, errs := ResolveNamespaceMapping(&tc.spec, sets.NewString(tc.namespaces...))
+
+			var errMsgs []string
+			for _, err := range errs {
+				errMsgs = append(errMsgs, err.Error())
+			}
+			assert.Equal(t, tc.wantErrs, errMsgs)
+			assert.Equal(t, tc.want, mapping)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+// pendingOwnerReference is an owner reference of a restored item to an owner in the backup
+// that hadn't been restored yet when the item was created. The item is created without it,
+// and it's added once the owner is restored.
+type pendingOwnerReference struct {
+	ref            metav1.OwnerReference
+	resourceID     string
+	namespace      string
+	name           string
+	resourceClient client.Dynamic
+}
+
+// recordUID maps the UID an item had in the backup to the UID of the item restored from it,
+// or of the item that already existed in the cluster.
+func (ctx *restoreContext) recordUID(backupUID, clusterUID types.UID) {
+	if backupUID == "" || clusterUID == "" {
+		return
+	}
+	if ctx.uidMap == nil {
+		ctx.uidMap = make(map[types.UID]types.UID)
+	}
+	ctx.uidMap[backupUID] = clusterUID
+}
+
+// ownerGroupResource returns the group/resource of an owner reference's kind, and whether
+// it's namespaced.
+func (ctx *restoreContext) ownerGroupResource(ref metav1.OwnerReference) (schema.GroupResource, bool, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return schema.GroupResource{}, false, errors.WithStack(err)
+	}
+	gvr, resource, err := ctx.discoveryHelper.KindFor(gv.WithKind(ref.Kind))
+	if err != nil {
+		return schema.GroupResource{}, false, err
+	}
+	return gvr.GroupResource(), resource.Namespaced, nil
+}
+
+// ownerInBackup returns whether the owner an owner reference of obj points at is in the
+// backup and included by the restore's resource filter. obj's namespace is its namespace
+// in the backup, which is also the namespace of namespaced owners.
+func (ctx *restoreContext) ownerInBackup(obj *unstructured.Unstructured, ref metav1.OwnerReference) bool {
+	groupResource, namespaced, err := ctx.ownerGroupResource(ref)
+	if err != nil {
+		ctx.log.WithError(err).Debugf("Unable to resolve kind of owner %s %s", ref.Kind, ref.Name)
+		return false
+	}
+	if !ctx.resourceIncludesExcludes.ShouldInclude(groupResource.String()) {
+		return false
+	}
+
+	namespace := ""
+	if namespaced {
+		namespace = obj.GetNamespace()
+	}
+	_, err = ctx.fileSystem.Stat(ctx.itemFilePath(groupResource, namespace, ref.Name))
+	return err == nil
+}
+
+// ownerAttempted returns whether the restore of the owner an owner reference points at was
+// attempted. namespace is the namespace the owned item is restored into, which is also the
+// namespace of namespaced owners.
+func (ctx *restoreContext) ownerAttempted(ref metav1.OwnerReference, namespace string) bool {
+	groupResource, namespaced, err := ctx.ownerGroupResource(ref)
+	if err != nil {
+		return false
+	}
+	ownerKey := velero.ResourceIdentifier{GroupResource: groupResource, Name: ref.Name}
+	if namespaced {
+		ownerKey.Namespace = namespace
+	}
+	_, attempted := ctx.restoredItems[ownerKey]
+	return attempted
+}
+
+// remapOwnerReferences points obj's owner references at the restored owners. References to
+// owners that weren't restored are removed, since their UIDs don't exist in the cluster.
+// It returns the removed references to owners in the backup that haven't been restored
+// yet, which are added once they are, and errors for the references to owners in the
+// backup that weren't restored. itemFromBackup is obj as it was in the backup, and
+// namespace is the namespace obj is restored into.
+func (ctx *restoreContext) remapOwnerReferences(obj, itemFromBackup *unstructured.Unstructured, namespace string) ([]metav1.OwnerReference, []error) {
+	refs := obj.GetOwnerReferences()
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	var (
+		pending []metav1.OwnerReference
+		errs    []error
+	)
+	remapped := make([]metav1.OwnerReference, 0, len(refs))
+	for _, ref := range refs {
+		if uid, ok := ctx.uidMap[ref.UID]; ok {
+			ref.UID = uid
+			remapped = append(remapped, ref)
+			continue
+		}
+
+		switch {
+		case !ctx.ownerInBackup(itemFromBackup, ref):
+			ctx.log.Infof("Removing owner reference to %s %s because it isn't in the backup", ref.Kind, ref.Name)
+		case ctx.ownerAttempted(ref, namespace):
+			errs = append(errs, errors.Errorf("owner %s %s wasn't restored, removing owner reference", ref.Kind, ref.Name))
+		default:
+			pending = append(pending, ref)
+		}
+	}
+
+	if len(remapped) == 0 {
+		remapped = nil
+	}
+	obj.SetOwnerReferences(remapped)
+	return pending, errs
+}
+
+// keepOwnerReferences adds the owner references of fromCluster to the owners of the pending
+// references to obj, so that an item that already exists in the cluster isn't considered
+// different from the backed up version because its owners haven't been restored yet.
+func keepOwnerReferences(obj, fromCluster *unstructured.Unstructured, pending []metav1.OwnerReference) {
+	if len(pending) == 0 {
+		return
+	}
+
+	refs := obj.GetOwnerReferences()
+	for _, clusterRef := range fromCluster.GetOwnerReferences() {
+		for _, ref := range pending {
+			if sameOwner(ref, clusterRef) {
+				refs = append(refs, clusterRef)
+				break
+			}
+		}
+	}
+	obj.SetOwnerReferences(refs)
+}
+
+// sameOwner returns whether two owner references point at owners of the same kind and name,
+// regardless of their UIDs and API versions.
+func sameOwner(a, b metav1.OwnerReference) bool {
+	aGV, _ := schema.ParseGroupVersion(a.APIVersion)
+	bGV, _ := schema.ParseGroupVersion(b.APIVersion)
+	return aGV.Group == bGV.Group && a.Kind == b.Kind && a.Name == b.Name
+}
+
+// addPendingOwnerReferences records the owner references that are added to the item with
+// the given name once its owners are restored.
+func (ctx *restoreContext) addPendingOwnerReferences(refs []metav1.OwnerReference, resourceID, namespace, name string, resourceClient client.Dynamic) {
+	for _, ref := range refs {
+		ctx.pendingOwnerRefs = append(ctx.pendingOwnerRefs, pendingOwnerReference{
+			ref:            ref,
+			resourceID:     resourceID,
+			namespace:      namespace,
+			name:           name,
+			resourceClient: resourceClient,
+		})
+	}
+}
+
+// restoreOwnerReferences adds the owner references to the owner with the given UID in the
+// backup to the items that were restored before it, now that it has been restored.
+func (ctx *restoreContext) restoreOwnerReferences(ownerUID types.UID) Result {
+	warnings := Result{}
+
+	clusterUID, ok := ctx.uidMap[ownerUID]
+	if ownerUID == "" || !ok || len(ctx.pendingOwnerRefs) == 0 {
+		return warnings
+	}
+
+	var ready, remaining []pendingOwnerReference
+	for _, p := range ctx.pendingOwnerRefs {
+		if p.ref.UID == ownerUID {
+			ready = append(ready, p)
+		} else {
+			remaining = append(remaining, p)
+		}
+	}
+	ctx.pendingOwnerRefs = remaining
+
+	for _, p := range ready {
+		if err := p.add(clusterUID); err != nil {
+			ctx.log.Errorf("error adding owner reference to %s %s to %s: %v", p.ref.Kind, p.ref.Name, p.resourceID, err)
+			warnings.Add(p.namespace, errors.Wrapf(err, "error restoring owner references of %s", p.resourceID))
+			continue
+		}
+		ctx.log.Infof("Added owner reference to %s %s to %s", p.ref.Kind, p.ref.Name, p.resourceID)
+	}
+
+	return warnings
+}
+
+// add adds the owner reference to the restored item, pointing it at the owner's UID in
+// the cluster. Nothing is changed if the item already references the owner, for example
+// because a controller adopted it.
+func (p *pendingOwnerReference) add(clusterUID types.UID) error {
+	item, err := p.resourceClient.Get(p.name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "error getting item")
+	}
+
+	refs := item.GetOwnerReferences()
+	for _, ref := range refs {
+		if ref.UID == clusterUID {
+			return nil
+		}
+	}
+
+	updated := item.DeepCopy()
+	ref := p.ref
+	ref.UID = clusterUID
+	updated.SetOwnerReferences(append(refs, ref))
+
+	patchBytes, err := generatePatch(item, updated)
+	if err != nil {
+		return errors.Wrap(err, "error generating patch")
+	}
+	if _, err := p.resourceClient.Patch(p.name, patchBytes); err != nil {
+		return errors.Wrap(err, "error patching item")
+	}
+	return nil
+}
+
+// pendingOwnerReferenceWarnings returns warnings for the owner references that were never
+// added, because their owners weren't restored.
+func (ctx *restoreContext) pendingOwnerReferenceWarnings() Result {
+	warnings := Result{}
+	for _, p := range ctx.pendingOwnerRefs {
+		warnings.Add(p.namespace, errors.Errorf("error restoring owner references of %s: owner %s %s wasn't restored, removing owner reference", p.resourceID, p.ref.Kind, p.ref.Name))
+	}
+	ctx.pendingOwnerRefs = nil
+	return warnings
+}
@@ -0,0 +1,210 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubetesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestRestoreOwnerReferences runs restores of items with owner references, and verifies
+// that the restored items reference the UIDs of their owners in the cluster.
+func TestRestoreOwnerReferences(t *testing.T) {
+	ownedBy := func(name, uid string) func(metav1.Object) {
+		return builder.WithOwnerReference("apps/v1", "Deployment", name, uid)
+	}
+
+	tests := []struct {
+		name         string
+		restore      *velerov1api.Restore
+		tarball      io.Reader
+		apiResources []*test.APIResource
+		want         map[string][]metav1.OwnerReference // pod name to owner references
+		wantWarnings Result
+	}{
+		{
+			name:    "items restored before their owners get the owner references once the owners are restored",
+			restore: defaultRestore().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("pod-1-uid"), ownedBy("deploy-1", "deploy-1-uid")).Result(),
+					builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithUID("pod-2-uid"), ownedBy("deploy-1", "deploy-1-uid")).Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods(), test.Deployments()},
+			want: map[string][]metav1.OwnerReference{
+				"pod-1": {{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy-1", UID: "new-deploy-1"}},
+				"pod-2": {{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy-1", UID: "new-deploy-1"}},
+			},
+		},
+		{
+			name:    "owners that already exist in the cluster are referenced",
+			restore: defaultRestore().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("pod-1-uid"), ownedBy("deploy-1", "deploy-1-uid")).Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("cluster-uid")).Result()),
+			},
+			want: map[string][]metav1.OwnerReference{
+				"pod-1": {{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy-1", UID: "cluster-uid"}},
+			},
+		},
+		{
+			name:    "items that already exist aren't different because their owners haven't been restored yet",
+			restore: defaultRestore().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("pod-1-uid"), ownedBy("deploy-1", "deploy-1-uid")).Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("cluster-pod-uid"), ownedBy("deploy-1", "cluster-uid")).Result()),
+				test.Deployments(builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("cluster-uid")).Result()),
+			},
+			want: map[string][]metav1.OwnerReference{
+				"pod-1": {{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy-1", UID: "cluster-uid"}},
+			},
+		},
+		{
+			name:    "items that already exist and reference other owners are different",
+			restore: defaultRestore().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("pod-1-uid"), ownedBy("deploy-1", "deploy-1-uid")).Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("cluster-pod-uid"), ownedBy("deploy-2", "deploy-2-uid")).Result()),
+				test.Deployments(),
+			},
+			want: map[string][]metav1.OwnerReference{
+				"pod-1": {{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy-2", UID: "deploy-2-uid"}},
+			},
+			wantWarnings: Result{Namespaces: map[string][]string{
+				"ns-1": {"could not restore, Pod \"pod-1\" already exists. Warning: the in-cluster version is different than the backed-up version."},
+			}},
+		},
+		{
+			name:    "references to owners that aren't in the backup are removed",
+			restore: defaultRestore().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("pod-1-uid"), ownedBy("deploy-1", "deploy-1-uid")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods(), test.Deployments()},
+			want: map[string][]metav1.OwnerReference{
+				"pod-1": nil,
+			},
+		},
+		{
+			name:    "references to owners in the backup that aren't restored are removed with a warning",
+			restore: defaultRestore().LabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"a": "b"}}).Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithUID("pod-1-uid"), builder.WithLabels("a", "b"), ownedBy("deploy-1", "deploy-1-uid")).Result(),
+				).
+				AddItems("deployments.apps",
+					builder.ForDeployment("ns-1", "deploy-1").ObjectMeta(builder.WithUID("deploy-1-uid")).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{test.Pods(), test.Deployments()},
+			want: map[string][]metav1.OwnerReference{
+				"pod-1": nil,
+			},
+			wantWarnings: Result{Namespaces: map[string][]string{
+				"ns-1": {"error restoring owner references of pods/ns-1/pod-1: owner Deployment deploy-1 wasn't restored, removing owner reference"},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.resourcePriorities = []string{"pods", "deployments.apps"}
+
+			// the fake API server doesn't assign UIDs to the items it creates
+			h.DynamicClient.PrependReactor("create", "*", func(action kubetesting.Action) (bool, runtime.Object, error) {
+				obj := action.(kubetesting.CreateAction).GetObject().(metav1.Object)
+				if obj.GetUID() == "" {
+					obj.SetUID(types.UID("new-" + obj.GetName()))
+				}
+				return false, nil, nil
+			})
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tc.tarball,
+			}
+			warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+
+			assertEmptyResults(t, errs)
+			assertWantErrsOrWarnings(t, tc.wantWarnings, warnings)
+
+			// the restore order isn't changed by owner references
+			var created []string
+			for _, action := range h.DynamicClient.Actions() {
+				if action.GetVerb() == "create" {
+					created = append(created, action.GetResource().Resource)
+				}
+			}
+			if len(created) == 2 {
+				assert.Equal(t, []string{"pods", "deployments"}, created)
+			}
+
+			for name, want := range tc.want {
+				pod, err := h.DynamicClient.Resource(test.Pods().GVR()).Namespace("ns-1").Get(context.TODO(), name, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, want, pod.GetOwnerReferences(), name)
+			}
+		})
+	}
+}
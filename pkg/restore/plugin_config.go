@@ -0,0 +1,103 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+)
+
+var (
+	// ResticRestoreActionConfigSchema is the configuration the velero.io/restic
+	// RestoreItemAction accepts.
+	ResticRestoreActionConfigSchema = framework.PluginConfigSchema{
+		Keys: map[string]func(string) error{
+			"image":                          nil,
+			"command":                        nil,
+			"cpuRequest":                     framework.ValidateQuantityValue,
+			"memRequest":                     framework.ValidateQuantityValue,
+			"cpuLimit":                       framework.ValidateQuantityValue,
+			"memLimit":                       framework.ValidateQuantityValue,
+			"secCtxRunAsUser":                framework.ValidateIntegerValue,
+			"secCtxRunAsGroup":               framework.ValidateIntegerValue,
+			"secCtxAllowPrivilegeEscalation": framework.ValidateBooleanValue,
+			"secCtx":                         nil,
+		},
+	}
+
+	// ChangeStorageClassConfigSchema is the configuration the velero.io/change-storage-class
+	// RestoreItemAction accepts: the names of storage classes mapped to the names of the ones
+	// they're changed to.
+	ChangeStorageClassConfigSchema = framework.PluginConfigSchema{OtherKeys: validateNameMapping}
+
+	// ChangePVCNodeSelectorConfigSchema is the configuration the
+	// velero.io/change-pvc-node-selector RestoreItemAction accepts: the names of nodes mapped
+	// to the names of the ones they're changed to.
+	ChangePVCNodeSelectorConfigSchema = framework.PluginConfigSchema{OtherKeys: validateNameMapping}
+)
+
+// PluginConfigSchemaFor returns the configuration schema of a plugin included in Velero, or
+// false if the plugin isn't included in Velero or doesn't have one.
+func PluginConfigSchemaFor(kind common.PluginKind, name string) (framework.PluginConfigSchema, bool) {
+	if kind != common.PluginKindRestoreItemAction {
+		return framework.PluginConfigSchema{}, false
+	}
+
+	switch name {
+	case "velero.io/restic":
+		return ResticRestoreActionConfigSchema, true
+	case "velero.io/change-storage-class":
+		return ChangeStorageClassConfigSchema, true
+	case "velero.io/change-pvc-node-selector":
+		return ChangePVCNodeSelectorConfigSchema, true
+	default:
+		return framework.PluginConfigSchema{}, false
+	}
+}
+
+func validateNameMapping(key, value string) error {
+	if err := framework.ValidateNameValue(key); err != nil {
+		return err
+	}
+	return framework.ValidateNameValue(value)
+}
+
+// pluginConfigNamespace returns the namespace whose PluginConfigs apply to an item being
+// restored, which is the namespace it's restored into. The restore's namespace mapping isn't
+// applied to the item yet when the RestoreItemActions are executed, so it's applied here.
+func pluginConfigNamespace(input *velero.RestoreItemActionExecuteInput) (string, error) {
+	item := input.ItemFromBackup
+	if item == nil {
+		item = input.Item
+	}
+	metadata, err := meta.Accessor(item)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	namespace := metadata.GetNamespace()
+	if input.Restore != nil {
+		if target, ok := input.Restore.Spec.NamespaceMapping[namespace]; ok {
+			return target, nil
+		}
+	}
+	return namespace, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestPluginConfigNamespace(t *testing.T) {
+	pvc := velerotest.UnstructuredOrDie(`{"apiVersion":"v1","kind":"PersistentVolumeClaim","metadata":{"namespace":"ns-1","name":"pvc-1"}}`)
+
+	namespace, err := pluginConfigNamespace(&velero.RestoreItemActionExecuteInput{
+		Item:           pvc,
+		ItemFromBackup: pvc,
+		Restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ns-1", namespace)
+
+	namespace, err = pluginConfigNamespace(&velero.RestoreItemActionExecuteInput{
+		Item:           pvc,
+		ItemFromBackup: pvc,
+		Restore:        builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").NamespaceMappings("ns-1", "ns-2").Result(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ns-2", namespace)
+}
+
+func TestPluginConfigSchemaFor(t *testing.T) {
+	schema, ok := PluginConfigSchemaFor(common.PluginKindRestoreItemAction, "velero.io/change-storage-class")
+	require.True(t, ok)
+	assert.NoError(t, framework.ValidatePluginConfig(map[string]string{"gp2": "gp3"}, schema))
+	assert.Error(t, framework.ValidatePluginConfig(map[string]string{"gp2": "not a storage class"}, schema))
+
+	schema, ok = PluginConfigSchemaFor(common.PluginKindRestoreItemAction, "velero.io/restic")
+	require.True(t, ok)
+	assert.NoError(t, framework.ValidatePluginConfig(map[string]string{"cpuRequest": "200m", "secCtxRunAsUser": "1000"}, schema))
+	assert.Error(t, framework.ValidatePluginConfig(map[string]string{"cpuRequest": "a lot"}, schema))
+	assert.Error(t, framework.ValidatePluginConfig(map[string]string{"cpuRequests": "200m"}, schema))
+
+	_, ok = PluginConfigSchemaFor(common.PluginKindRestoreItemAction, "example.io/other")
+	assert.False(t, ok)
+}
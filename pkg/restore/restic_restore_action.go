@@ -24,16 +24,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	veleroimage "github.com/vmware-tanzu/velero/internal/velero"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	velerov1client "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/typed/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework/common"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
@@ -48,14 +47,14 @@ const (
 
 type ResticRestoreAction struct {
 	logger                logrus.FieldLogger
-	client                corev1client.ConfigMapInterface
+	configGetter          framework.PluginConfigGetter
 	podVolumeBackupClient velerov1client.PodVolumeBackupInterface
 }
 
-func NewResticRestoreAction(logger logrus.FieldLogger, client corev1client.ConfigMapInterface, podVolumeBackupClient velerov1client.PodVolumeBackupInterface) *ResticRestoreAction {
+func NewResticRestoreAction(logger logrus.FieldLogger, configGetter framework.PluginConfigGetter, podVolumeBackupClient velerov1client.PodVolumeBackupInterface) *ResticRestoreAction {
 	return &ResticRestoreAction{
 		logger:                logger,
-		client:                client,
+		configGetter:          configGetter,
 		podVolumeBackupClient: podVolumeBackupClient,
 	}
 }
@@ -107,7 +106,11 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	// TODO we might want/need to get plugin config at the top of this method at some point; for now, wait
 	// until we know we're doing a restore before getting config.
 	log.Debugf("Getting plugin config")
-	config, err := getPluginConfig(common.PluginKindRestoreItemAction, "velero.io/restic", a.client)
+	namespace, err := pluginConfigNamespace(input)
+	if err != nil {
+		return nil, err
+	}
+	config, err := framework.GetValidPluginConfig(a.configGetter, common.PluginKindRestoreItemAction, "velero.io/restic", namespace, ResticRestoreActionConfigSchema)
 	if err != nil {
 		return nil, err
 	}
@@ -174,28 +177,28 @@ func (a *ResticRestoreAction) Execute(input *velero.RestoreItemActionExecuteInpu
 	return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: res}), nil
 }
 
-func getCommand(log logrus.FieldLogger, config *corev1.ConfigMap) []string {
+func getCommand(log logrus.FieldLogger, config map[string]string) []string {
 	if config == nil {
 		log.Debug("No config found for plugin")
 		return []string{defaultCommand}
 	}
 
-	if config.Data["command"] == "" {
+	if config["command"] == "" {
 		log.Debugf("No custom command configured")
 		return []string{defaultCommand}
 	}
 
-	log.Debugf("Using custom command %s", config.Data["command"])
-	return []string{config.Data["command"]}
+	log.Debugf("Using custom command %s", config["command"])
+	return []string{config["command"]}
 }
 
-func getImage(log logrus.FieldLogger, config *corev1.ConfigMap) string {
+func getImage(log logrus.FieldLogger, config map[string]string) string {
 	if config == nil {
 		log.Debug("No config found for plugin")
 		return veleroimage.DefaultResticRestoreHelperImage()
 	}
 
-	image := config.Data["image"]
+	image := config["image"]
 	if image == "" {
 		log.Debugf("No custom image configured")
 		return veleroimage.DefaultResticRestoreHelperImage()
@@ -224,68 +227,39 @@ func getImage(log logrus.FieldLogger, config *corev1.ConfigMap) string {
 	}
 }
 
-// getResourceRequests extracts the CPU and memory requests from a ConfigMap.
+// getResourceRequests extracts the CPU and memory requests from the plugin config.
 // The 0 values are valid if the keys are not present
-func getResourceRequests(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string) {
+func getResourceRequests(log logrus.FieldLogger, config map[string]string) (string, string) {
 	if config == nil {
 		log.Debug("No config found for plugin")
 		return "", ""
 	}
 
-	return config.Data["cpuRequest"], config.Data["memRequest"]
+	return config["cpuRequest"], config["memRequest"]
 }
 
-// getResourceLimits extracts the CPU and memory limits from a ConfigMap.
+// getResourceLimits extracts the CPU and memory limits from the plugin config.
 // The 0 values are valid if the keys are not present
-func getResourceLimits(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string) {
+func getResourceLimits(log logrus.FieldLogger, config map[string]string) (string, string) {
 	if config == nil {
 		log.Debug("No config found for plugin")
 		return "", ""
 	}
 
-	return config.Data["cpuLimit"], config.Data["memLimit"]
+	return config["cpuLimit"], config["memLimit"]
 }
 
-// getSecurityContext extracts securityContext runAsUser, runAsGroup, allowPrivilegeEscalation, and securityContext from a ConfigMap.
-func getSecurityContext(log logrus.FieldLogger, config *corev1.ConfigMap) (string, string, string, string) {
+// getSecurityContext extracts securityContext runAsUser, runAsGroup, allowPrivilegeEscalation, and securityContext from the plugin config.
+func getSecurityContext(log logrus.FieldLogger, config map[string]string) (string, string, string, string) {
 	if config == nil {
 		log.Debug("No config found for plugin")
 		return "", "", "", ""
 	}
 
-	return config.Data["secCtxRunAsUser"],
-		config.Data["secCtxRunAsGroup"],
-		config.Data["secCtxAllowPrivilegeEscalation"],
-		config.Data["secCtx"]
-}
-
-// TODO eventually this can move to pkg/plugin/framework since it'll be used across multiple
-// plugins.
-func getPluginConfig(kind common.PluginKind, name string, client corev1client.ConfigMapInterface) (*corev1.ConfigMap, error) {
-	opts := metav1.ListOptions{
-		// velero.io/plugin-config: true
-		// velero.io/restic: RestoreItemAction
-		LabelSelector: fmt.Sprintf("velero.io/plugin-config,%s=%s", name, kind),
-	}
-
-	list, err := client.List(context.TODO(), opts)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-
-	if len(list.Items) == 0 {
-		return nil, nil
-	}
-
-	if len(list.Items) > 1 {
-		var items []string
-		for _, item := range list.Items {
-			items = append(items, item.Name)
-		}
-		return nil, errors.Errorf("found more than one ConfigMap matching label selector %q: %v", opts.LabelSelector, items)
-	}
-
-	return &list.Items[0], nil
+	return config["secCtxRunAsUser"],
+		config["secCtxRunAsGroup"],
+		config["secCtxAllowPrivilegeEscalation"],
+		config["secCtx"]
 }
 
 func newResticInitContainerBuilder(image, restoreUID string) *builder.ContainerBuilder {
@@ -28,24 +28,22 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/kubernetes/fake"
 
 	veleroimage "github.com/vmware-tanzu/velero/internal/velero"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	velerofake "github.com/vmware-tanzu/velero/pkg/generated/clientset/versioned/fake"
+	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 func TestGetImage(t *testing.T) {
-	configMapWithData := func(key, val string) *corev1api.ConfigMap {
-		return &corev1api.ConfigMap{
-			Data: map[string]string{
-				key: val,
-			},
+	configWithData := func(key, val string) map[string]string {
+		return map[string]string{
+			key: val,
 		}
 	}
 
@@ -53,46 +51,46 @@ func TestGetImage(t *testing.T) {
 
 	tests := []struct {
 		name             string
-		configMap        *corev1api.ConfigMap
+		config           map[string]string
 		buildInfoVersion string
 		want             string
 	}{
 		{
-			name:      "nil config map returns default image",
-			configMap: nil,
-			want:      defaultImage,
+			name:   "nil config returns default image",
+			config: nil,
+			want:   defaultImage,
 		},
 		{
-			name:      "config map without 'image' key returns default image",
-			configMap: configMapWithData("non-matching-key", "val"),
-			want:      defaultImage,
+			name:   "config map without 'image' key returns default image",
+			config: configWithData("non-matching-key", "val"),
+			want:   defaultImage,
 		},
 		{
-			name:      "config map without '/' in image name returns default image",
-			configMap: configMapWithData("image", "my-image"),
-			want:      defaultImage,
+			name:   "config map without '/' in image name returns default image",
+			config: configWithData("image", "my-image"),
+			want:   defaultImage,
 		},
 		{
 			name:             "config map with untagged image returns image with buildinfo.Version as tag",
-			configMap:        configMapWithData("image", "myregistry.io/my-image"),
+			config:           configWithData("image", "myregistry.io/my-image"),
 			buildInfoVersion: "buildinfo-version",
 			want:             "myregistry.io/my-image:buildinfo-version",
 		},
 		{
 			name:             "config map with untagged image and custom registry port with ':' returns image with buildinfo.Version as tag",
-			configMap:        configMapWithData("image", "myregistry.io:34567/my-image"),
+			config:           configWithData("image", "myregistry.io:34567/my-image"),
 			buildInfoVersion: "buildinfo-version",
 			want:             "myregistry.io:34567/my-image:buildinfo-version",
 		},
 		{
-			name:      "config map with tagged image returns tagged image",
-			configMap: configMapWithData("image", "myregistry.io/my-image:my-tag"),
-			want:      "myregistry.io/my-image:my-tag",
+			name:   "config map with tagged image returns tagged image",
+			config: configWithData("image", "myregistry.io/my-image:my-tag"),
+			want:   "myregistry.io/my-image:my-tag",
 		},
 		{
-			name:      "config map with tagged image and custom registry port with ':' returns tagged image",
-			configMap: configMapWithData("image", "myregistry.io:34567/my-image:my-tag"),
-			want:      "myregistry.io:34567/my-image:my-tag",
+			name:   "config map with tagged image and custom registry port with ':' returns tagged image",
+			config: configWithData("image", "myregistry.io:34567/my-image:my-tag"),
+			want:   "myregistry.io:34567/my-image:my-tag",
 		},
 	}
 
@@ -105,7 +103,7 @@ func TestGetImage(t *testing.T) {
 					buildinfo.Version = originalVersion
 				}()
 			}
-			assert.Equal(t, test.want, getImage(velerotest.NewLogger(), test.configMap))
+			assert.Equal(t, test.want, getImage(velerotest.NewLogger(), test.config))
 		})
 	}
 }
@@ -258,7 +256,6 @@ func TestResticRestoreActionExecute(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			clientset := fake.NewSimpleClientset()
 			clientsetVelero := velerofake.NewSimpleClientset()
 
 			for _, podVolumeBackup := range tc.podVolumeBackups {
@@ -293,7 +290,7 @@ func TestResticRestoreActionExecute(t *testing.T) {
 
 			a := NewResticRestoreAction(
 				logrus.StandardLogger(),
-				clientset.CoreV1().ConfigMaps(veleroNs),
+				framework.NewPluginConfigGetter(velerotest.NewFakeControllerRuntimeClient(t), veleroNs),
 				clientsetVelero.VeleroV1().PodVolumeBackups(veleroNs),
 			)
 
@@ -321,43 +318,41 @@ func TestResticRestoreActionExecute(t *testing.T) {
 }
 
 func TestGetCommand(t *testing.T) {
-	configMapWithData := func(key, val string) *corev1api.ConfigMap {
-		return &corev1api.ConfigMap{
-			Data: map[string]string{
-				key: val,
-			},
+	configWithData := func(key, val string) map[string]string {
+		return map[string]string{
+			key: val,
 		}
 	}
 	testCases := []struct {
-		name      string
-		configMap *corev1api.ConfigMap
-		expected  []string
+		name     string
+		config   map[string]string
+		expected []string
 	}{
 		{
-			name:      "should get default command when config key is missing",
-			configMap: configMapWithData("non-matching-key", "val"),
-			expected:  []string{defaultCommand},
+			name:     "should get default command when config key is missing",
+			config:   configWithData("non-matching-key", "val"),
+			expected: []string{defaultCommand},
 		},
 		{
-			name:      "should get default command when config key is empty",
-			configMap: configMapWithData("command", ""),
-			expected:  []string{defaultCommand},
+			name:     "should get default command when config key is empty",
+			config:   configWithData("command", ""),
+			expected: []string{defaultCommand},
 		},
 		{
-			name:      "should get default command when config is nil",
-			configMap: nil,
-			expected:  []string{defaultCommand},
+			name:     "should get default command when config is nil",
+			config:   nil,
+			expected: []string{defaultCommand},
 		},
 		{
-			name:      "should get command from config",
-			configMap: configMapWithData("command", "foobarbz"),
-			expected:  []string{"foobarbz"},
+			name:     "should get command from config",
+			config:   configWithData("command", "foobarbz"),
+			expected: []string{"foobarbz"},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual := getCommand(velerotest.NewLogger(), tc.configMap)
+			actual := getCommand(velerotest.NewLogger(), tc.config)
 			assert.Equal(t, tc.expected, actual)
 		})
 	}
@@ -80,6 +80,34 @@ type Request struct {
 	PodVolumeBackups []*velerov1api.PodVolumeBackup
 	VolumeSnapshots  []*volume.Snapshot
 	BackupReader     io.Reader
+
+	// DataUploads are the DataUploads that moved the data of the backup's CSI snapshots to
+	// the backup repository. The data of their volumes is restored from the repository.
+	DataUploads []*velerov1api.DataUpload
+
+	// NamespaceBackupReaders are the archives of the namespaces being restored, keyed by
+	// namespace, if the backup is in the split archive format. BackupReader is then the
+	// backup's main tarball.
+	NamespaceBackupReaders map[string]io.Reader
+
+	// Context is canceled when the restore is canceled. If nil, the restore can't be canceled.
+	Context go_context.Context
+
+	// RollbackRecord, if set, records the items the restore creates and patches so that
+	// the restore can be rolled back.
+	RollbackRecord *RollbackRecord
+
+	// ServiceResults, if set, collects what the restore's service policy did to each
+	// Service it restored, keyed by namespace.
+	ServiceResults *Result
+}
+
+// ctx returns r.Context, or a context that is never canceled if it is nil.
+func (r *Request) ctx() go_context.Context {
+	if r.Context == nil {
+		return go_context.Background()
+	}
+	return r.Context
 }
 
 // Restorer knows how to restore a backup.
@@ -97,6 +125,8 @@ type Restorer interface {
 		snapshotLocationLister listers.VolumeSnapshotLocationLister,
 		volumeSnapshotterGetter VolumeSnapshotterGetter,
 	) (Result, Result)
+	// Rollback reverts the changes a restore made to the cluster, as recorded in record.
+	Rollback(record *RollbackRecord, log logrus.FieldLogger) RollbackResult
 }
 
 // kubernetesRestorer implements Restorer for restoring into a Kubernetes cluster.
@@ -249,7 +279,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	ctx, cancelFunc := go_context.WithTimeout(req.ctx(), podVolumeTimeout)
 	defer cancelFunc()
 
 	var resticRestorer podvolume.Restorer
@@ -264,7 +294,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	if err != nil {
 		return Result{}, Result{Velero: []string{err.Error()}}
 	}
-	hooksCtx, hooksCancelFunc := go_context.WithCancel(go_context.Background())
+	hooksCtx, hooksCancelFunc := go_context.WithCancel(req.ctx())
 	waitExecHookHandler := &hook.DefaultWaitExecHookHandler{
 		PodCommandExecutor: kr.podCommandExecutor,
 		ListWatchFactory: &hook.DefaultListWatchFactory{
@@ -286,6 +316,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	restoreCtx := &restoreContext{
 		backup:                         req.Backup,
 		backupReader:                   req.BackupReader,
+		namespaceBackupReaders:         req.NamespaceBackupReaders,
 		restore:                        req.Restore,
 		resourceIncludesExcludes:       resourceIncludesExcludes,
 		resourceStatusIncludesExcludes: restoreStatusIncludesExcludes,
@@ -306,9 +337,12 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		pvRestorer:                     pvRestorer,
 		volumeSnapshots:                req.VolumeSnapshots,
 		podVolumeBackups:               req.PodVolumeBackups,
+		podVolumeContext:               ctx,
+		dataUploads:                    req.DataUploads,
 		resourceTerminatingTimeout:     kr.resourceTerminatingTimeout,
 		resourceClients:                make(map[resourceClientKey]client.Dynamic),
 		restoredItems:                  make(map[velero.ResourceIdentifier]struct{}),
+		uidMap:                         make(map[types.UID]types.UID),
 		renamedPVs:                     make(map[string]string),
 		pvRenamer:                      kr.pvRenamer,
 		discoveryHelper:                kr.discoveryHelper,
@@ -319,6 +353,9 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		hooksContext:                   hooksCtx,
 		hooksCancelFunc:                hooksCancelFunc,
 		restoreClient:                  kr.restoreClient,
+		requestContext:                 req.ctx(),
+		rollbackRecord:                 req.RollbackRecord,
+		serviceResults:                 req.ServiceResults,
 	}
 
 	return restoreCtx.execute()
@@ -327,6 +364,7 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 type restoreContext struct {
 	backup                         *velerov1api.Backup
 	backupReader                   io.Reader
+	namespaceBackupReaders         map[string]io.Reader
 	restore                        *velerov1api.Restore
 	restoreDir                     string
 	restoreClient                  velerov1client.RestoresGetter
@@ -350,9 +388,20 @@ type restoreContext struct {
 	pvRestorer                     PVRestorer
 	volumeSnapshots                []*volume.Snapshot
 	podVolumeBackups               []*velerov1api.PodVolumeBackup
+	podVolumeContext               go_context.Context
+	dataUploads                    []*velerov1api.DataUpload
+	dataDownloads                  []string
 	resourceTerminatingTimeout     time.Duration
 	resourceClients                map[resourceClientKey]client.Dynamic
 	restoredItems                  map[velero.ResourceIdentifier]struct{}
+	rollbackRecord                 *RollbackRecord
+	uidMap                         map[types.UID]types.UID
+	pendingOwnerRefs               []pendingOwnerReference
+	deferredWebhooks               []deferredItem
+	restoringWebhooks              bool
+	serviceResults                 *Result
+	clusterIPsInUse                map[string]string
+	itemFilter                     *itemFilter
 	renamedPVs                     map[string]string
 	pvRenamer                      func(string) (string, error)
 	discoveryHelper                discovery.Helper
@@ -363,6 +412,7 @@ type restoreContext struct {
 	waitExecHookHandler            hook.WaitExecHookHandler
 	hooksContext                   go_context.Context
 	hooksCancelFunc                go_context.CancelFunc
+	requestContext                 go_context.Context
 }
 
 type resourceClientKey struct {
@@ -396,7 +446,8 @@ func (ctx *restoreContext) execute() (Result, Result) {
 
 	ctx.log.Infof("Starting restore of backup %s", kube.NamespaceAndName(ctx.backup))
 
-	dir, err := archive.NewExtractor(ctx.log, ctx.fileSystem).UnzipAndExtractBackup(ctx.backupReader)
+	extractor := archive.NewExtractor(ctx.log, ctx.fileSystem)
+	dir, err := extractor.UnzipAndExtractBackup(ctx.backupReader)
 	if err != nil {
 		ctx.log.Infof("error unzipping and extracting: %v", err)
 		errs.AddVeleroError(err)
@@ -404,6 +455,15 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	defer ctx.fileSystem.RemoveAll(dir)
 
+	for namespace, reader := range ctx.namespaceBackupReaders {
+		ctx.log.Infof("Extracting archive of namespace %s", namespace)
+		if err := extractor.UnzipAndExtractBackupInto(reader, dir); err != nil {
+			ctx.log.Infof("error unzipping and extracting archive of namespace %s: %v", namespace, err)
+			errs.AddVeleroError(err)
+			return warnings, errs
+		}
+	}
+
 	// Need to set this for additionalItems to be restored.
 	ctx.restoreDir = dir
 
@@ -429,6 +489,14 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		}
 	}
 
+	var filterWarnings Result
+	ctx.itemFilter, filterWarnings, err = ctx.newItemFilter(backupResources)
+	warnings.Merge(&filterWarnings)
+	if err != nil {
+		errs.AddVeleroError(errors.Wrap(err, "error resolving included and excluded items"))
+		return warnings, errs
+	}
+
 	update := make(chan progressUpdate)
 
 	quit := make(chan struct{})
@@ -531,29 +599,22 @@ func (ctx *restoreContext) execute() (Result, Result) {
 		)
 		warnings.Merge(&w)
 		errs.Merge(&e)
+
+		w, e = ctx.restoreReadyWebhooks()
+		warnings.Merge(&w)
+		errs.Merge(&e)
 	}
 
 	// Close the progress update channel.
 	quit <- struct{}{}
 
+	if ctx.canceled() {
+		ctx.log.Info("Restore was canceled, skipped remaining items")
+	}
+
 	// Do a final progress update as stopping the ticker might have left last few
 	// updates from taking place.
-	patch := fmt.Sprintf(
-		`{"status":{"progress":{"totalItems":%d,"itemsRestored":%d}}}`,
-		len(ctx.restoredItems),
-		len(ctx.restoredItems),
-	)
-
-	_, err = ctx.restoreClient.Restores(ctx.restore.Namespace).Patch(
-		go_context.TODO(),
-		ctx.restore.Name,
-		types.MergePatchType,
-		[]byte(patch),
-		metav1.PatchOptions{},
-	)
-	if err != nil {
-		ctx.log.WithError(errors.WithStack((err))).Warn("Updating restore status.progress")
-	}
+	ctx.patchFinalProgress()
 
 	// Wait for all of the restic restore goroutines to be done, which is
 	// only possible once all of their errors have been received by the loop
@@ -577,6 +638,13 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	ctx.log.Info("Done waiting for all restic restores to complete")
 
+	// Wait for the data of the volumes restored from data uploads to be downloaded.
+	ctx.log.Info("Waiting for all data downloads to complete")
+	for _, err := range ctx.waitDataDownloads() {
+		errs.Velero = append(errs.Velero, err.Error())
+	}
+	ctx.log.Info("Done waiting for all data downloads to complete")
+
 	// Wait for all post-restore exec hooks with same logic as restic wait above.
 	go func() {
 		ctx.log.Info("Waiting for all post-restore-exec hooks to complete")
@@ -589,9 +657,46 @@ func (ctx *restoreContext) execute() (Result, Result) {
 	}
 	ctx.log.Info("Done waiting for all post-restore exec hooks to complete")
 
+	// Restore the webhook configurations and APIServices that were held back, now
+	// that the items that serve them have been restored.
+	if len(ctx.deferredWebhooks) > 0 {
+		w, e = ctx.restoreWebhooks(ctx.deferredWebhooks)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+		ctx.deferredWebhooks = nil
+
+		ctx.patchFinalProgress()
+	}
+
+	// The references to owners that weren't restored were never added to the items
+	// that were restored before them.
+	w = ctx.pendingOwnerReferenceWarnings()
+	warnings.Merge(&w)
+
 	return warnings, errs
 }
 
+// patchFinalProgress updates the restore's progress to the items restored so far, once no more
+// items are going to be processed.
+func (ctx *restoreContext) patchFinalProgress() {
+	patch := fmt.Sprintf(
+		`{"status":{"progress":{"totalItems":%d,"itemsRestored":%d}}}`,
+		len(ctx.restoredItems),
+		len(ctx.restoredItems),
+	)
+
+	_, err := ctx.restoreClient.Restores(ctx.restore.Namespace).Patch(
+		go_context.TODO(),
+		ctx.restore.Name,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+	if err != nil {
+		ctx.log.WithError(errors.WithStack((err))).Warn("Updating restore status.progress")
+	}
+}
+
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
 // in the expected total restore count.
@@ -607,6 +712,10 @@ func (ctx *restoreContext) processSelectedResource(
 
 	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
 		for _, selectedItem := range selectedItems {
+			if ctx.canceled() {
+				return processedItems, warnings, errs
+			}
+
 			// If we don't know whether this namespace exists yet, attempt to create
 			// it in order to ensure it exists. Try to get it from the backup tarball
 			// (in order to get any backed-up metadata), but if we don't find it there,
@@ -637,6 +746,7 @@ func (ctx *restoreContext) processSelectedResource(
 						Name:          ns.Name,
 					}
 					ctx.restoredItems[itemKey] = struct{}{}
+					ctx.recordCreated(kuberesource.Namespaces, v1.SchemeGroupVersion, ns)
 				}
 
 				// Keep track of namespaces that we know exist so we don't
@@ -693,6 +803,11 @@ func (ctx *restoreContext) processSelectedResource(
 	return processedItems, warnings, errs
 }
 
+// canceled returns true if the restore has been canceled.
+func (ctx *restoreContext) canceled() bool {
+	return ctx.requestContext.Err() != nil
+}
+
 // getNamespace returns a namespace API object that we should attempt to
 // create before restoring anything into it. It will come from the backup
 // tarball if it exists, else will be a new one. If from the tarball, it
@@ -962,6 +1077,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					Name:          nsToEnsure.Name,
 				}
 				ctx.restoredItems[itemKey] = struct{}{}
+				ctx.recordCreated(kuberesource.Namespaces, v1.SchemeGroupVersion, nsToEnsure)
 			}
 		}
 	} else {
@@ -1001,6 +1117,16 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		ctx.log.Infof("Skipping %s because it's already been restored.", resourceID)
 		return warnings, errs
 	}
+
+	// Webhook configurations and APIServices are held back until the items that
+	// serve them have been restored, since they'd block or fail the requests that
+	// restore the other items otherwise.
+	if ctx.deferWebhook(groupResource) {
+		ctx.log.Infof("Holding back %s until the other items have been restored", resourceID)
+		ctx.deferredWebhooks = append(ctx.deferredWebhooks, deferredItem{obj: obj, groupResource: groupResource, namespace: namespace})
+		return warnings, errs
+	}
+
 	ctx.restoredItems[itemKey] = struct{}{}
 
 	// TODO: move to restore item action if/when we add a ShouldRestore() method
@@ -1098,6 +1224,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				obj.SetAnnotations(annotations)
 			}
 
+		case hasDataUpload(obj, ctx):
+			ctx.log.Infof("Provisioning persistent volume with a data download because its snapshot data was moved to the backup repository.")
+			ctx.pvsToProvision.Insert(name)
+
+			// Return early because we don't want to restore the PV itself, the data
+			// download provisions a new one.
+			return warnings, errs
+
 		case hasResticBackup(obj, ctx):
 			ctx.log.Infof("Dynamically re-provisioning persistent volume because it has a restic backup to be restored.")
 			ctx.pvsToProvision.Insert(name)
@@ -1232,7 +1366,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			}
 		}
 
-		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
+		if du := ctx.dataUploadForPVC(obj.GetNamespace(), name); du != nil {
+			ctx.log.Infof("Resetting PersistentVolumeClaim %s/%s for its data to be restored from data upload %s", namespace, name, du.Name)
+			if err := ctx.resetPVCForDataDownload(obj, du); err != nil {
+				errs.Add(namespace, err)
+				return warnings, errs
+			}
+		} else if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
 			ctx.log.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, name, pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
 				errs.Add(namespace, err)
@@ -1248,6 +1388,30 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		obj.SetNamespace(namespace)
 	}
 
+	// Items that backup transforms modified, for example to redact credentials,
+	// may be incomplete. The annotation recording the transforms isn't restored.
+	if transforms, ok := obj.GetAnnotations()[velerov1api.BackupTransformsAnnotation]; ok {
+		warnings.Add(namespace, errors.Errorf("%s was modified by backup transforms %s and may be incomplete", resourceID, transforms))
+
+		annotations := obj.GetAnnotations()
+		delete(annotations, velerov1api.BackupTransformsAnnotation)
+		obj.SetAnnotations(annotations)
+	}
+
+	// The owners' UIDs in the backup don't exist in the cluster, so point the owner
+	// references at the restored owners. References to owners that haven't been
+	// restored yet are added once they are.
+	pendingOwnerRefs, ownerRefErrs := ctx.remapOwnerReferences(obj, itemFromBackup, namespace)
+	for _, err := range ownerRefErrs {
+		warnings.Add(namespace, errors.Wrapf(err, "error restoring owner references of %s", resourceID))
+	}
+
+	if groupResource == kuberesource.Services {
+		if err := ctx.applyServicePolicy(obj, itemFromBackup, namespace); err != nil {
+			warnings.Add(namespace, err)
+		}
+	}
+
 	// Label the resource with the restore's name and the restored backup's name
 	// for easy identification of all cluster resources created by this restore
 	// and which backup they came from.
@@ -1255,6 +1419,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 	createdObj, restoreErr := resourceClient.Create(obj)
+	if groupResource == kuberesource.Services {
+		// preserved ClusterIPs may be outside of the cluster's service CIDR, which can't be
+		// checked beforehand
+		if reassigned, err := ctx.reassignRejectedClusterIPs(obj, namespace, restoreErr); reassigned {
+			warnings.Add(namespace, err)
+			createdObj, restoreErr = resourceClient.Create(obj)
+		}
+	}
 	isAlreadyExistsError, err := isAlreadyExistsError(ctx, obj, restoreErr, resourceClient)
 	if err != nil {
 		errs.Add(namespace, err)
@@ -1279,6 +1451,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 
 	if fromCluster != nil {
+		// Items owned by this item will reference the in-cluster item.
+		ctx.recordUID(itemFromBackup.GetUID(), fromCluster.GetUID())
+		w := ctx.restoreOwnerReferences(itemFromBackup.GetUID())
+		warnings.Merge(&w)
+
 		// Remove insubstantial metadata.
 		fromCluster, err = resetMetadataAndStatus(fromCluster)
 		if err != nil {
@@ -1287,10 +1464,20 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs
 		}
 
+		// Items that already exist are only patched when they're service accounts, or
+		// when the existing resource policy is update. Record their state before the
+		// patch so it can be rolled back; if the patch fails, rolling it back is a no-op.
+		if groupResource == kuberesource.ServiceAccounts || ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
+			ctx.recordPatched(groupResource, fromCluster)
+		}
+
 		// We know the object from the cluster won't have the backup/restore name
 		// labels, so copy them from the object we attempted to restore.
 		labels := obj.GetLabels()
 		addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+		// Owners that haven't been restored yet aren't a difference.
+		keepOwnerReferences(obj, fromCluster, pendingOwnerRefs)
+
 		fromClusterWithLabels := fromCluster.DeepCopy() // saving the in-cluster object so that we can create label patch if overall patch fails
 
 		if !equality.Semantic.DeepEqual(fromCluster, obj) {
@@ -1382,6 +1569,20 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs
 	}
 
+	ctx.recordCreated(groupResource, obj.GroupVersionKind().GroupVersion(), createdObj)
+
+	if groupResource == kuberesource.PersistentVolumeClaims {
+		if du := ctx.dataUploadForPVC(originalNamespace, name); du != nil {
+			if err := ctx.createDataDownload(du, namespace, name); err != nil {
+				errs.Add(namespace, err)
+			}
+		}
+	}
+	ctx.recordUID(itemFromBackup.GetUID(), createdObj.GetUID())
+	ctx.addPendingOwnerReferences(pendingOwnerRefs, resourceID, namespace, name, resourceClient)
+	w := ctx.restoreOwnerReferences(itemFromBackup.GetUID())
+	warnings.Merge(&w)
+
 	shouldRestoreStatus := ctx.resourceStatusIncludesExcludes != nil && ctx.resourceStatusIncludesExcludes.ShouldInclude(groupResource.String())
 	if shouldRestoreStatus && statusFieldErr != nil {
 		err := fmt.Errorf("could not get status to be restored %s: %v", kube.NamespaceAndName(obj), statusFieldErr)
@@ -1706,7 +1907,7 @@ func resetMetadata(obj *unstructured.Unstructured) (*unstructured.Unstructured,
 
 	for k := range metadata {
 		switch k {
-		case "name", "namespace", "labels", "annotations":
+		case "name", "namespace", "labels", "annotations", "ownerReferences":
 		default:
 			delete(metadata, k)
 		}
@@ -1923,12 +2124,17 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 	// chosenGrpVersToRestore map would only be populated if
 	// APIGroupVersionsFeatureFlag was enabled for restore and the minimum
 	// required backup format version has been met.
+	groupResource := schema.ParseGroupResource(resource)
 	cgv, ok := ctx.chosenGrpVersToRestore[resource]
 	if ok {
 		resource = filepath.Join(resource, cgv.Dir)
 	}
 
 	for _, item := range items {
+		if !ctx.itemFilter.ShouldInclude(groupResource, originalNamespace, item) {
+			continue
+		}
+
 		itemPath := archive.GetItemFilePath(ctx.restoreDir, resource, originalNamespace, item)
 
 		obj, err := archive.Unmarshal(ctx.fileSystem, itemPath)
@@ -1948,6 +2154,10 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource, targetNamespace
 			continue
 		}
 
+		if !ctx.itemFilter.MatchesFields(groupResource, obj) {
+			continue
+		}
+
 		// Processing OrLabelSelectors when specified in the restore request. LabelSelectors as well as OrLabelSelectors
 		// cannot co-exist, only one of them can be specified
 		var skipItem = false
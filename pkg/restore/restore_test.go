@@ -1183,6 +1183,40 @@ func (a *recordResourcesAction) WithAdditionalItems(items []velero.ResourceIdent
 	return a
 }
 
+// TestRestoreTransformedItems runs a restore of items that backup transforms modified, and
+// verifies that a warning is returned for each of them and that the annotation recording
+// the transforms isn't restored.
+func TestRestoreTransformedItems(t *testing.T) {
+	h := newHarness(t)
+	h.DiscoveryClient.WithAPIResource(test.Secrets())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("secrets",
+				builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithAnnotations(velerov1api.BackupTransformsAnnotation, "redact", "a", "b")).Result(),
+				builder.ForSecret("ns-1", "secret-2").Result(),
+			).
+			Done(),
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+
+	assertEmptyResults(t, errs)
+	assert.Equal(t, map[string][]string{
+		"ns-1": {"secrets/ns-1/secret-1 was modified by backup transforms redact and may be incomplete"},
+	}, warnings.Namespaces)
+
+	assertRestoredItems(t, h, []*test.APIResource{
+		test.Secrets(
+			builder.ForSecret("ns-1", "secret-1").ObjectMeta(builder.WithAnnotations("a", "b"), builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result(),
+			builder.ForSecret("ns-1", "secret-2").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Result(),
+		),
+	})
+}
+
 // TestRestoreActionsRunsForCorrectItems runs restores with restore item actions, and
 // verifies that each restore item action is run for the correct set of resources based on its
 // AppliesTo() resource selector. Verification is done by using the recordResourcesAction struct,
@@ -2819,10 +2853,10 @@ func TestResetMetadata(t *testing.T) {
 			expectedErr: true,
 		},
 		{
-			name:        "keep name, namespace, labels, annotations only",
-			obj:         NewTestUnstructured().WithMetadata("name", "blah", "namespace", "labels", "annotations", "foo").Unstructured,
+			name:        "keep name, namespace, labels, annotations, owner references only",
+			obj:         NewTestUnstructured().WithMetadata("name", "blah", "namespace", "labels", "annotations", "ownerReferences", "foo").Unstructured,
 			expectedErr: false,
-			expectedRes: NewTestUnstructured().WithMetadata("name", "namespace", "labels", "annotations").Unstructured,
+			expectedRes: NewTestUnstructured().WithMetadata("name", "namespace", "labels", "annotations", "ownerReferences").Unstructured,
 		},
 		{
 			name:        "keep status",
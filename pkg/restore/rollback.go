@@ -0,0 +1,256 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/velero/pkg/client"
+)
+
+// RollbackRecord records the changes a restore made to the cluster so that they
+// can be rolled back.
+type RollbackRecord struct {
+	// Created are the items the restore created, in the order they were created.
+	Created []RollbackItem `json:"created,omitempty"`
+
+	// Patched are the items that already existed and that the restore patched, in
+	// the order they were patched.
+	Patched []RollbackItem `json:"patched,omitempty"`
+}
+
+// RollbackItem identifies an item changed by a restore.
+type RollbackItem struct {
+	GroupVersion string `json:"groupVersion"`
+	Resource     string `json:"resource"`
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name"`
+
+	// UID is the UID of a created item. An item whose UID has changed since the
+	// restore was replaced by someone else, so it isn't deleted.
+	UID types.UID `json:"uid,omitempty"`
+
+	// PreImage is the state of a patched item before the restore patched it,
+	// without its server-populated metadata and status.
+	PreImage *unstructured.Unstructured `json:"preImage,omitempty"`
+}
+
+func (i RollbackItem) String() string {
+	gv, _ := schema.ParseGroupVersion(i.GroupVersion)
+	return getResourceID(gv.WithResource(i.Resource).GroupResource(), i.Namespace, i.Name)
+}
+
+// RollbackResult is the outcome of rolling back a restore.
+type RollbackResult struct {
+	// ItemsDeleted is the number of created items that were deleted.
+	ItemsDeleted int
+
+	// ItemsReverted is the number of patched items that were reverted.
+	ItemsReverted int
+
+	// Errors lists the items that couldn't be rolled back, and why.
+	Errors []string
+}
+
+// EncodeRollbackRecord writes record to w as gzipped JSON.
+func EncodeRollbackRecord(record *RollbackRecord, w io.Writer) error {
+	gzw := gzip.NewWriter(w)
+	if err := json.NewEncoder(gzw).Encode(record); err != nil {
+		gzw.Close()
+		return errors.Wrap(err, "error encoding rollback record")
+	}
+	return errors.Wrap(gzw.Close(), "error closing gzip writer")
+}
+
+// DecodeRollbackRecord reads a rollback record written by EncodeRollbackRecord from r.
+func DecodeRollbackRecord(r io.Reader) (*RollbackRecord, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	record := new(RollbackRecord)
+	if err := json.NewDecoder(gzr).Decode(record); err != nil {
+		return nil, errors.Wrap(err, "error decoding rollback record")
+	}
+	return record, nil
+}
+
+// recordCreated adds an item created by the restore to the rollback record, if there is one.
+func (ctx *restoreContext) recordCreated(groupResource schema.GroupResource, groupVersion schema.GroupVersion, obj metav1.Object) {
+	if ctx.rollbackRecord == nil {
+		return
+	}
+
+	ctx.rollbackRecord.Created = append(ctx.rollbackRecord.Created, RollbackItem{
+		GroupVersion: groupVersion.String(),
+		Resource:     groupResource.Resource,
+		Namespace:    obj.GetNamespace(),
+		Name:         obj.GetName(),
+		UID:          obj.GetUID(),
+	})
+}
+
+// recordPatched adds an item the restore is about to patch to the rollback record, if there
+// is one. preImage is the item's in-cluster state without server-populated metadata and status.
+func (ctx *restoreContext) recordPatched(groupResource schema.GroupResource, preImage *unstructured.Unstructured) {
+	if ctx.rollbackRecord == nil {
+		return
+	}
+
+	ctx.rollbackRecord.Patched = append(ctx.rollbackRecord.Patched, RollbackItem{
+		GroupVersion: preImage.GroupVersionKind().GroupVersion().String(),
+		Resource:     groupResource.Resource,
+		Namespace:    preImage.GetNamespace(),
+		Name:         preImage.GetName(),
+		PreImage:     preImage.DeepCopy(),
+	})
+}
+
+// Rollback reverts the changes recorded in record. Patched items are reverted to their
+// pre-images, then created items are deleted in the reverse of the order they were created
+// in, so that items are deleted before the items they depend on. Items that no longer
+// exist are skipped.
+func (kr *kubernetesRestorer) Rollback(record *RollbackRecord, log logrus.FieldLogger) RollbackResult {
+	var result RollbackResult
+	if record == nil {
+		return result
+	}
+
+	for i := len(record.Patched) - 1; i >= 0; i-- {
+		item := record.Patched[i]
+		reverted, err := kr.revertItem(item)
+		if err != nil {
+			log.WithError(err).Errorf("Error reverting %s", item)
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: not reverted: %v", item, err))
+			continue
+		}
+		if reverted {
+			log.Infof("Reverted %s", item)
+			result.ItemsReverted++
+		}
+	}
+
+	for i := len(record.Created) - 1; i >= 0; i-- {
+		item := record.Created[i]
+		deleted, err := kr.deleteItem(item)
+		if err != nil {
+			log.WithError(err).Errorf("Error deleting %s", item)
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: not deleted: %v", item, err))
+			continue
+		}
+		if deleted {
+			log.Infof("Deleted %s", item)
+			result.ItemsDeleted++
+		}
+	}
+
+	return result
+}
+
+func (kr *kubernetesRestorer) resourceClientFor(item RollbackItem) (client.Dynamic, error) {
+	gv, err := schema.ParseGroupVersion(item.GroupVersion)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resource := metav1.APIResource{
+		Namespaced: item.Namespace != "",
+		Name:       item.Resource,
+	}
+	return kr.dynamicFactory.ClientForGroupVersionResource(gv, resource, item.Namespace)
+}
+
+// revertItem patches item back to its pre-image. It returns false if the item no longer
+// exists or already matches its pre-image.
+func (kr *kubernetesRestorer) revertItem(item RollbackItem) (bool, error) {
+	if item.PreImage == nil {
+		return false, errors.New("no pre-image was recorded")
+	}
+
+	resourceClient, err := kr.resourceClientFor(item)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := resourceClient.Get(item.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	current, err = resetMetadataAndStatus(current)
+	if err != nil {
+		return false, err
+	}
+
+	patchBytes, err := generatePatch(current, item.PreImage)
+	if err != nil {
+		return false, err
+	}
+	if patchBytes == nil {
+		return false, nil
+	}
+
+	if _, err := resourceClient.Patch(item.Name, patchBytes); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.WithStack(err)
+	}
+	return true, nil
+}
+
+// deleteItem deletes item if it's still the object the restore created. It returns false
+// if the item no longer exists.
+func (kr *kubernetesRestorer) deleteItem(item RollbackItem) (bool, error) {
+	resourceClient, err := kr.resourceClientFor(item)
+	if err != nil {
+		return false, err
+	}
+
+	var opts metav1.DeleteOptions
+	if item.UID != "" {
+		uid := item.UID
+		opts.Preconditions = &metav1.Preconditions{UID: &uid}
+	}
+
+	err = resourceClient.Delete(item.Name, opts)
+	switch {
+	case apierrors.IsNotFound(err):
+		return false, nil
+	case apierrors.IsConflict(err):
+		return false, errors.New("it was replaced after the restore")
+	case err != nil:
+		return false, errors.WithStack(err)
+	}
+	return true, nil
+}
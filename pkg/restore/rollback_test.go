@@ -0,0 +1,220 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRollbackRecordEncoding(t *testing.T) {
+	preImage := &unstructured.Unstructured{}
+	preImage.SetAPIVersion("v1")
+	preImage.SetKind("ServiceAccount")
+	preImage.SetNamespace("ns-1")
+	preImage.SetName("sa-1")
+
+	record := &RollbackRecord{
+		Created: []RollbackItem{
+			{GroupVersion: "v1", Resource: "pods", Namespace: "ns-1", Name: "pod-1", UID: "uid-1"},
+		},
+		Patched: []RollbackItem{
+			{GroupVersion: "v1", Resource: "serviceaccounts", Namespace: "ns-1", Name: "sa-1", PreImage: preImage},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, EncodeRollbackRecord(record, buf))
+
+	decoded, err := DecodeRollbackRecord(buf)
+	require.NoError(t, err)
+	assert.Equal(t, record, decoded)
+}
+
+func TestRestoreRecordsCreatedItems(t *testing.T) {
+	h := newHarness(t)
+	h.DiscoveryClient.WithAPIResource(test.Pods())
+	require.NoError(t, h.restorer.discoveryHelper.Refresh())
+
+	record := new(RollbackRecord)
+	data := Request{
+		Log:     h.log,
+		Restore: defaultRestore().Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			).
+			Done(),
+		RollbackRecord: record,
+	}
+	warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+	assertEmptyResults(t, warnings, errs)
+
+	var created []string
+	for _, item := range record.Created {
+		created = append(created, item.String())
+	}
+	assert.Equal(t, []string{"namespaces/ns-1", "pods/ns-1/pod-1", "pods/ns-1/pod-2"}, created)
+	assert.Empty(t, record.Patched)
+}
+
+func TestRollback(t *testing.T) {
+	preImage := func(obj runtime.Object) *unstructured.Unstructured {
+		res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		require.NoError(t, err)
+
+		// Pre-images are recorded without server-populated metadata.
+		unstructured.RemoveNestedField(res, "metadata", "creationTimestamp")
+		return &unstructured.Unstructured{Object: res}
+	}
+
+	tests := []struct {
+		name         string
+		apiResources []*test.APIResource
+		record       *RollbackRecord
+		want         map[*test.APIResource][]string
+		wantResult   RollbackResult
+	}{
+		{
+			name: "nil record is a no-op",
+			apiResources: []*test.APIResource{
+				test.Pods(builder.ForPod("ns-1", "pod-1").Result()),
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-1"},
+			},
+		},
+		{
+			name: "created items are deleted and missing ones are skipped",
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-1", "pod-2").Result(),
+					builder.ForPod("ns-2", "pod-3").Result(),
+				),
+			},
+			record: &RollbackRecord{
+				Created: []RollbackItem{
+					{GroupVersion: "v1", Resource: "pods", Namespace: "ns-1", Name: "pod-1"},
+					{GroupVersion: "v1", Resource: "pods", Namespace: "ns-1", Name: "pod-2"},
+					{GroupVersion: "v1", Resource: "pods", Namespace: "ns-1", Name: "pod-4"},
+				},
+			},
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"ns-2/pod-3"},
+			},
+			wantResult: RollbackResult{ItemsDeleted: 2},
+		},
+		{
+			name: "patched items are reverted to their pre-images",
+			apiResources: []*test.APIResource{
+				test.ServiceAccounts(
+					builder.ForServiceAccount("ns-1", "sa-1").ObjectMeta(builder.WithLabels("restored", "true")).Result(),
+					builder.ForServiceAccount("ns-1", "sa-2").Result(),
+				),
+			},
+			record: &RollbackRecord{
+				Patched: []RollbackItem{
+					{
+						GroupVersion: "v1",
+						Resource:     "serviceaccounts",
+						Namespace:    "ns-1",
+						Name:         "sa-1",
+						PreImage:     preImage(builder.ForServiceAccount("ns-1", "sa-1").ObjectMeta(builder.WithLabels("restored", "false")).Result()),
+					},
+					{
+						GroupVersion: "v1",
+						Resource:     "serviceaccounts",
+						Namespace:    "ns-1",
+						Name:         "sa-2",
+						PreImage:     preImage(builder.ForServiceAccount("ns-1", "sa-2").Result()),
+					},
+				},
+			},
+			want: map[*test.APIResource][]string{
+				test.ServiceAccounts(): {"ns-1/sa-1", "ns-1/sa-2"},
+			},
+			wantResult: RollbackResult{ItemsReverted: 1},
+		},
+		{
+			name: "patched items without a pre-image are reported",
+			apiResources: []*test.APIResource{
+				test.ServiceAccounts(builder.ForServiceAccount("ns-1", "sa-1").Result()),
+			},
+			record: &RollbackRecord{
+				Patched: []RollbackItem{
+					{GroupVersion: "v1", Resource: "serviceaccounts", Namespace: "ns-1", Name: "sa-1"},
+				},
+			},
+			want: map[*test.APIResource][]string{
+				test.ServiceAccounts(): {"ns-1/sa-1"},
+			},
+			wantResult: RollbackResult{Errors: []string{"serviceaccounts/ns-1/sa-1: not reverted: no pre-image was recorded"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			result := h.restorer.Rollback(tc.record, h.log)
+			assert.Equal(t, tc.wantResult, result)
+			assertAPIContents(t, h, tc.want)
+		})
+	}
+
+	t.Run("reverted items match their pre-images", func(t *testing.T) {
+		h := newHarness(t)
+		h.AddItems(t, test.ServiceAccounts(
+			builder.ForServiceAccount("ns-1", "sa-1").ObjectMeta(builder.WithLabels("restored", "true")).Result(),
+		))
+
+		record := &RollbackRecord{
+			Patched: []RollbackItem{
+				{
+					GroupVersion: "v1",
+					Resource:     "serviceaccounts",
+					Namespace:    "ns-1",
+					Name:         "sa-1",
+					PreImage:     preImage(builder.ForServiceAccount("ns-1", "sa-1").ObjectMeta(builder.WithLabels("restored", "false")).Result()),
+				},
+			},
+		}
+		result := h.restorer.Rollback(record, h.log)
+		assert.Equal(t, RollbackResult{ItemsReverted: 1}, result)
+
+		sa, err := h.DynamicClient.Resource(test.ServiceAccounts().GVR()).Namespace("ns-1").Get(context.TODO(), "sa-1", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"restored": "false"}, sa.GetLabels())
+	})
+}
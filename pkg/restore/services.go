@@ -0,0 +1,239 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// loadBalancerAnnotationPrefixes are the prefixes of the annotations that cloud providers
+// and load balancer implementations use to configure the load balancers of Services.
+var loadBalancerAnnotationPrefixes = []string{
+	"service.beta.kubernetes.io/",
+	"cloud.google.com/",
+	"networking.gke.io/",
+	"loadbalancer.openstack.org/",
+	"metallb.universe.tf/",
+}
+
+func isLoadBalancerAnnotation(key string) bool {
+	for _, prefix := range loadBalancerAnnotationPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyServicePolicy applies the restore's service policy to a Service being restored, and
+// records what it did in the service results. itemFromBackup is the Service as it was in
+// the backup, since the ServiceAction clears its ClusterIPs. It returns an error when the
+// policy can't be applied as requested, for example because the Service's ClusterIP is in
+// use; the Service is then restored with a new ClusterIP.
+func (ctx *restoreContext) applyServicePolicy(obj, itemFromBackup *unstructured.Unstructured, namespace string) error {
+	policy := ctx.restore.Spec.ServicePolicy
+	if policy == nil {
+		return nil
+	}
+
+	serviceType, _, _ := unstructured.NestedString(itemFromBackup.Object, "spec", "type")
+	if serviceType == string(v1.ServiceTypeExternalName) {
+		return nil
+	}
+
+	if serviceType == string(v1.ServiceTypeLoadBalancer) {
+		ctx.applyLoadBalancerPolicy(obj, namespace)
+	}
+
+	if policy.ClusterIPPolicy != velerov1api.ServiceClusterIPPolicyPreserveIfAvailable {
+		if clusterIP, _, _ := unstructured.NestedString(itemFromBackup.Object, "spec", "clusterIP"); clusterIP != "" && clusterIP != v1.ClusterIPNone {
+			ctx.addServiceResult(obj, namespace, "reassigned cluster IP %s", clusterIP)
+		}
+		return nil
+	}
+	return ctx.preserveClusterIPs(obj, itemFromBackup, namespace)
+}
+
+// applyLoadBalancerPolicy strips or remaps the load balancer annotations of a LoadBalancer
+// Service, and clears its loadBalancerIP, according to the restore's service policy.
+func (ctx *restoreContext) applyLoadBalancerPolicy(obj *unstructured.Unstructured, namespace string) {
+	policy := ctx.restore.Spec.ServicePolicy
+
+	if policy.LoadBalancerAnnotationPolicy == velerov1api.LoadBalancerAnnotationPolicyStrip ||
+		policy.LoadBalancerAnnotationPolicy == velerov1api.LoadBalancerAnnotationPolicyRemap {
+		annotations := obj.GetAnnotations()
+		updated := make(map[string]string, len(annotations))
+		var stripped, remapped []string
+
+		for key, value := range annotations {
+			if newKey, ok := policy.LoadBalancerAnnotationMapping[key]; ok && policy.LoadBalancerAnnotationPolicy == velerov1api.LoadBalancerAnnotationPolicyRemap {
+				updated[newKey] = value
+				remapped = append(remapped, fmt.Sprintf("%s -> %s", key, newKey))
+				continue
+			}
+			if isLoadBalancerAnnotation(key) {
+				stripped = append(stripped, key)
+				continue
+			}
+			updated[key] = value
+		}
+
+		if len(updated) == 0 {
+			updated = nil
+		}
+		obj.SetAnnotations(updated)
+
+		if len(remapped) > 0 {
+			ctx.addServiceResult(obj, namespace, "remapped load balancer annotations %s", strings.Join(sets.NewString(remapped...).List(), ", "))
+		}
+		if len(stripped) > 0 {
+			ctx.addServiceResult(obj, namespace, "removed load balancer annotations %s", strings.Join(sets.NewString(stripped...).List(), ", "))
+		}
+	}
+
+	if !boolptr.IsSetToFalse(policy.PreserveLoadBalancerIP) {
+		return
+	}
+	if ip, _, _ := unstructured.NestedString(obj.Object, "spec", "loadBalancerIP"); ip != "" {
+		unstructured.RemoveNestedField(obj.Object, "spec", "loadBalancerIP")
+		ctx.addServiceResult(obj, namespace, "cleared load balancer IP %s", ip)
+	}
+}
+
+// preserveClusterIPs sets the ClusterIPs of a Service to the ones it had in the backup, if
+// no other Service in the cluster uses them.
+func (ctx *restoreContext) preserveClusterIPs(obj, itemFromBackup *unstructured.Unstructured, namespace string) error {
+	clusterIP, _, _ := unstructured.NestedString(itemFromBackup.Object, "spec", "clusterIP")
+	if clusterIP == "" || clusterIP == v1.ClusterIPNone {
+		return nil
+	}
+	clusterIPs, _, _ := unstructured.NestedStringSlice(itemFromBackup.Object, "spec", "clusterIPs")
+	if len(clusterIPs) == 0 {
+		clusterIPs = []string{clusterIP}
+	}
+
+	inUse, err := ctx.serviceClusterIPsInUse()
+	if err != nil {
+		ctx.addServiceResult(obj, namespace, "reassigned cluster IP %s", clusterIP)
+		return errors.Wrapf(err, "unable to check whether cluster IP %s of service %s/%s is in use, reassigning it", clusterIP, namespace, obj.GetName())
+	}
+	service := namespace + "/" + obj.GetName()
+	for _, ip := range clusterIPs {
+		if user, ok := inUse[ip]; ok && user != service {
+			ctx.addServiceResult(obj, namespace, "reassigned cluster IP %s", clusterIP)
+			return errors.Errorf("cluster IP %s of service %s is in use by service %s, reassigning it", ip, service, user)
+		}
+	}
+
+	if err := unstructured.SetNestedField(obj.Object, clusterIP, "spec", "clusterIP"); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := unstructured.SetNestedStringSlice(obj.Object, clusterIPs, "spec", "clusterIPs"); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, ip := range clusterIPs {
+		inUse[ip] = service
+	}
+	ctx.addServiceResult(obj, namespace, "preserved cluster IP %s", strings.Join(clusterIPs, ", "))
+
+	return nil
+}
+
+// reassignRejectedClusterIPs clears the ClusterIPs preserved for a Service when the cluster
+// rejected its creation as invalid, which happens when they aren't in the cluster's service
+// CIDR, so that the Service can be created again with new ones. It returns false if no
+// ClusterIPs were preserved for the Service.
+func (ctx *restoreContext) reassignRejectedClusterIPs(obj *unstructured.Unstructured, namespace string, createErr error) (bool, error) {
+	policy := ctx.restore.Spec.ServicePolicy
+	if policy == nil || policy.ClusterIPPolicy != velerov1api.ServiceClusterIPPolicyPreserveIfAvailable || !apierrors.IsInvalid(createErr) {
+		return false, nil
+	}
+	clusterIP, _, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP")
+	if clusterIP == "" || clusterIP == v1.ClusterIPNone {
+		return false, nil
+	}
+
+	clusterIPs, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "clusterIPs")
+	for _, ip := range clusterIPs {
+		delete(ctx.clusterIPsInUse, ip)
+	}
+	delete(ctx.clusterIPsInUse, clusterIP)
+	unstructured.RemoveNestedField(obj.Object, "spec", "clusterIP")
+	unstructured.RemoveNestedField(obj.Object, "spec", "clusterIPs")
+
+	ctx.addServiceResult(obj, namespace, "reassigned cluster IP %s", clusterIP)
+	return true, errors.Wrapf(createErr, "cluster IP %s of service %s/%s was rejected by the cluster, reassigning it", clusterIP, namespace, obj.GetName())
+}
+
+// serviceClusterIPsInUse returns the ClusterIPs of the Services in the cluster, mapped to
+// the namespace/name of the Service using them. They're listed once per restore; the
+// ClusterIPs the restore preserves are added as it goes.
+func (ctx *restoreContext) serviceClusterIPsInUse() (map[string]string, error) {
+	if ctx.clusterIPsInUse != nil {
+		return ctx.clusterIPsInUse, nil
+	}
+
+	resource := metav1.APIResource{Name: "services", Namespaced: true}
+	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(v1.SchemeGroupVersion, resource, "")
+	if err != nil {
+		return nil, err
+	}
+	list, err := client.List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	inUse := make(map[string]string)
+	for _, item := range list.Items {
+		clusterIPs, _, _ := unstructured.NestedStringSlice(item.Object, "spec", "clusterIPs")
+		if clusterIP, _, _ := unstructured.NestedString(item.Object, "spec", "clusterIP"); clusterIP != "" {
+			clusterIPs = append(clusterIPs, clusterIP)
+		}
+		for _, ip := range clusterIPs {
+			if ip != v1.ClusterIPNone {
+				inUse[ip] = item.GetNamespace() + "/" + item.GetName()
+			}
+		}
+	}
+
+	ctx.clusterIPsInUse = inUse
+	return inUse, nil
+}
+
+// addServiceResult records what the service policy did to a Service.
+func (ctx *restoreContext) addServiceResult(obj *unstructured.Unstructured, namespace, format string, args ...interface{}) {
+	message := fmt.Sprintf("%s: %s", obj.GetName(), fmt.Sprintf(format, args...))
+	ctx.log.Infof("Service %s/%s", namespace, message)
+	if ctx.serviceResults == nil {
+		return
+	}
+	if ctx.serviceResults.Namespaces == nil {
+		ctx.serviceResults.Namespaces = make(map[string][]string)
+	}
+	ctx.serviceResults.Namespaces[namespace] = append(ctx.serviceResults.Namespaces[namespace], message)
+}
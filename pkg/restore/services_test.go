@@ -0,0 +1,247 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubetesting "k8s.io/client-go/testing"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+// TestRestoreServicePolicy runs restores of Services with service policies, and verifies
+// the restored Services, the warnings, and the service results.
+func TestRestoreServicePolicy(t *testing.T) {
+	loadBalancer := func(name string) *builder.ServiceBuilder {
+		return builder.ForService("ns-1", name).
+			Type(corev1api.ServiceTypeLoadBalancer).
+			ClusterIP("10.96.0.10").
+			LoadBalancerIP("203.0.113.10").
+			ObjectMeta(builder.WithAnnotations(
+				"service.beta.kubernetes.io/aws-load-balancer-internal", "true",
+				"service.beta.kubernetes.io/aws-load-balancer-type", "nlb",
+				"app.example.com/owner", "team-a",
+			))
+	}
+
+	type wantService struct {
+		clusterIP      string
+		loadBalancerIP string
+		annotations    map[string]string
+	}
+
+	tests := []struct {
+		name               string
+		policy             *velerov1api.ServiceRestorePolicy
+		tarball            io.Reader
+		apiResources       []*test.APIResource
+		want               map[string]wantService
+		wantWarnings       Result
+		wantServiceResults Result
+	}{
+		{
+			name:    "services are restored as before when there's no service policy",
+			tarball: test.NewTarWriter(t).AddItems("services", loadBalancer("svc-1").Result()).Done(),
+			want: map[string]wantService{
+				"svc-1": {
+					loadBalancerIP: "203.0.113.10",
+					annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+						"service.beta.kubernetes.io/aws-load-balancer-type":     "nlb",
+						"app.example.com/owner":                                 "team-a",
+					},
+				},
+			},
+		},
+		{
+			name: "cluster IPs that aren't in use are preserved, and ones in use are reassigned with a warning",
+			policy: &velerov1api.ServiceRestorePolicy{
+				ClusterIPPolicy: velerov1api.ServiceClusterIPPolicyPreserveIfAvailable,
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("services",
+					builder.ForService("ns-1", "svc-1").ClusterIP("10.96.0.10").Result(),
+					builder.ForService("ns-1", "svc-2").ClusterIP("10.96.0.20").Result(),
+					builder.ForService("ns-1", "headless").ClusterIP(corev1api.ClusterIPNone).Result(),
+				).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Services(builder.ForService("ns-2", "other").ClusterIP("10.96.0.20").Result()),
+			},
+			want: map[string]wantService{
+				"svc-1":    {clusterIP: "10.96.0.10"},
+				"svc-2":    {clusterIP: ""},
+				"headless": {clusterIP: corev1api.ClusterIPNone},
+			},
+			wantWarnings: Result{Namespaces: map[string][]string{
+				"ns-1": {"cluster IP 10.96.0.20 of service ns-1/svc-2 is in use by service ns-2/other, reassigning it"},
+			}},
+			wantServiceResults: Result{Namespaces: map[string][]string{
+				"ns-1": {
+					"svc-1: preserved cluster IP 10.96.0.10",
+					"svc-2: reassigned cluster IP 10.96.0.20",
+				},
+			}},
+		},
+		{
+			name: "load balancer annotations are stripped and the load balancer IP is cleared",
+			policy: &velerov1api.ServiceRestorePolicy{
+				LoadBalancerAnnotationPolicy: velerov1api.LoadBalancerAnnotationPolicyStrip,
+				PreserveLoadBalancerIP:       boolptr.False(),
+			},
+			tarball: test.NewTarWriter(t).AddItems("services", loadBalancer("svc-1").Result()).Done(),
+			want: map[string]wantService{
+				"svc-1": {annotations: map[string]string{"app.example.com/owner": "team-a"}},
+			},
+			wantServiceResults: Result{Namespaces: map[string][]string{
+				"ns-1": {
+					"svc-1: removed load balancer annotations service.beta.kubernetes.io/aws-load-balancer-internal, service.beta.kubernetes.io/aws-load-balancer-type",
+					"svc-1: cleared load balancer IP 203.0.113.10",
+					"svc-1: reassigned cluster IP 10.96.0.10",
+				},
+			}},
+		},
+		{
+			name: "load balancer annotations are remapped, unmapped ones are removed and the load balancer IP is preserved",
+			policy: &velerov1api.ServiceRestorePolicy{
+				LoadBalancerAnnotationPolicy: velerov1api.LoadBalancerAnnotationPolicyRemap,
+				LoadBalancerAnnotationMapping: map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-internal": "service.beta.kubernetes.io/azure-load-balancer-internal",
+				},
+			},
+			tarball: test.NewTarWriter(t).AddItems("services", loadBalancer("svc-1").Result()).Done(),
+			want: map[string]wantService{
+				"svc-1": {
+					loadBalancerIP: "203.0.113.10",
+					annotations: map[string]string{
+						"service.beta.kubernetes.io/azure-load-balancer-internal": "true",
+						"app.example.com/owner":                                   "team-a",
+					},
+				},
+			},
+			wantServiceResults: Result{Namespaces: map[string][]string{
+				"ns-1": {
+					"svc-1: remapped load balancer annotations service.beta.kubernetes.io/aws-load-balancer-internal -> service.beta.kubernetes.io/azure-load-balancer-internal",
+					"svc-1: removed load balancer annotations service.beta.kubernetes.io/aws-load-balancer-type",
+					"svc-1: reassigned cluster IP 10.96.0.10",
+				},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			h.AddItems(t, test.Services())
+			for _, r := range tc.apiResources {
+				h.AddItems(t, r)
+			}
+
+			serviceResults := new(Result)
+			data := Request{
+				Log:            h.log,
+				Restore:        defaultRestore().ServicePolicy(tc.policy).Result(),
+				Backup:         defaultBackup().Result(),
+				BackupReader:   tc.tarball,
+				ServiceResults: serviceResults,
+			}
+			warnings, errs := h.restorer.Restore(data, []velero.RestoreItemAction{NewServiceAction(h.log)}, nil, nil)
+
+			assertEmptyResults(t, errs)
+			assertWantErrsOrWarnings(t, tc.wantWarnings, warnings)
+			assert.Equal(t, tc.wantServiceResults, *serviceResults)
+
+			for name, want := range tc.want {
+				svc, err := h.DynamicClient.Resource(test.Services().GVR()).Namespace("ns-1").Get(context.TODO(), name, metav1.GetOptions{})
+				require.NoError(t, err)
+
+				clusterIP, _, _ := unstructured.NestedString(svc.Object, "spec", "clusterIP")
+				assert.Equal(t, want.clusterIP, clusterIP, name)
+				loadBalancerIP, _, _ := unstructured.NestedString(svc.Object, "spec", "loadBalancerIP")
+				assert.Equal(t, want.loadBalancerIP, loadBalancerIP, name)
+
+				annotations := svc.GetAnnotations()
+				delete(annotations, annotationLastAppliedConfig)
+				if len(annotations) == 0 {
+					annotations = nil
+				}
+				assert.Equal(t, want.annotations, annotations, name)
+			}
+		})
+	}
+}
+
+// TestRestoreServicePolicyClusterIPOutOfRange verifies that a Service whose preserved ClusterIP
+// is rejected by the cluster, because it isn't in its service CIDR, is restored with a new one.
+func TestRestoreServicePolicyClusterIPOutOfRange(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Services())
+
+	h.DynamicClient.PrependReactor("create", "services", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		obj := action.(kubetesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		if clusterIP, _, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP"); clusterIP == "172.20.0.10" {
+			return true, nil, apierrors.NewInvalid(schema.GroupKind{Kind: "Service"}, obj.GetName(), field.ErrorList{
+				field.Invalid(field.NewPath("spec", "clusterIPs").Index(0), clusterIP, "provided IP is not in the valid range"),
+			})
+		}
+		return false, nil, nil
+	})
+
+	serviceResults := new(Result)
+	data := Request{
+		Log: h.log,
+		Restore: defaultRestore().ServicePolicy(&velerov1api.ServiceRestorePolicy{
+			ClusterIPPolicy: velerov1api.ServiceClusterIPPolicyPreserveIfAvailable,
+		}).Result(),
+		Backup:         defaultBackup().Result(),
+		BackupReader:   test.NewTarWriter(t).AddItems("services", builder.ForService("ns-1", "svc-1").ClusterIP("172.20.0.10").Result()).Done(),
+		ServiceResults: serviceResults,
+	}
+	warnings, errs := h.restorer.Restore(data, []velero.RestoreItemAction{NewServiceAction(h.log)}, nil, nil)
+
+	assertEmptyResults(t, errs)
+	require.Len(t, warnings.Namespaces["ns-1"], 1)
+	assert.Contains(t, warnings.Namespaces["ns-1"][0], "cluster IP 172.20.0.10 of service ns-1/svc-1 was rejected by the cluster, reassigning it")
+	assert.Equal(t, Result{Namespaces: map[string][]string{
+		"ns-1": {
+			"svc-1: preserved cluster IP 172.20.0.10",
+			"svc-1: reassigned cluster IP 172.20.0.10",
+		},
+	}}, *serviceResults)
+
+	svc, err := h.DynamicClient.Resource(test.Services().GVR()).Namespace("ns-1").Get(context.TODO(), "svc-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	clusterIP, _, _ := unstructured.NestedString(svc.Object, "spec", "clusterIP")
+	assert.Empty(t, clusterIP)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// webhookResources are the resources that register webhooks and API services with the API
+// server. Until the Services they reference are backed by running pods, requests to the API
+// server that they apply to are blocked or fail, so by default they're restored after all
+// other items.
+var webhookResources = sets.NewString(
+	"validatingwebhookconfigurations.admissionregistration.k8s.io",
+	"mutatingwebhookconfigurations.admissionregistration.k8s.io",
+	"apiservices.apiregistration.k8s.io",
+)
+
+// deferredItem is an item from the backup whose restore is held back until other items
+// have been restored.
+type deferredItem struct {
+	obj           *unstructured.Unstructured
+	groupResource schema.GroupResource
+	namespace     string
+}
+
+// deferWebhook returns whether an item of a resource should be held back until the other
+// items have been restored, according to the restore's webhook restore policy.
+func (ctx *restoreContext) deferWebhook(groupResource schema.GroupResource) bool {
+	return !ctx.restoringWebhooks &&
+		ctx.restore.Spec.WebhookRestorePolicy != velerov1api.WebhookRestorePolicyImmediate &&
+		webhookResources.Has(groupResource.String())
+}
+
+// webhookServices returns the Services that a webhook configuration or APIService
+// references, in the namespaces they're restored into.
+func (ctx *restoreContext) webhookServices(item deferredItem) []types.NamespacedName {
+	content := item.obj.UnstructuredContent()
+
+	var refs []map[string]interface{}
+	if item.groupResource.Resource == "apiservices" {
+		if service, found, _ := unstructured.NestedMap(content, "spec", "service"); found {
+			refs = append(refs, service)
+		}
+	} else {
+		webhooks, _, _ := unstructured.NestedSlice(content, "webhooks")
+		for _, webhook := range webhooks {
+			webhook, ok := webhook.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if service, found, _ := unstructured.NestedMap(webhook, "clientConfig", "service"); found {
+				refs = append(refs, service)
+			}
+		}
+	}
+
+	var services []types.NamespacedName
+	for _, ref := range refs {
+		namespace, _, _ := unstructured.NestedString(ref, "namespace")
+		name, _, _ := unstructured.NestedString(ref, "name")
+		if namespace == "" || name == "" {
+			continue
+		}
+		if remapped, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
+			namespace = remapped
+		}
+		services = append(services, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+	return services
+}
+
+// serviceReady returns whether a Service has at least one ready endpoint.
+func (ctx *restoreContext) serviceReady(service types.NamespacedName) (bool, error) {
+	resource := metav1.APIResource{Name: "endpoints", Namespaced: true}
+	client, err := ctx.dynamicFactory.ClientForGroupVersionResource(v1.SchemeGroupVersion, resource, service.Namespace)
+	if err != nil {
+		return false, err
+	}
+
+	endpoints, err := client.Get(service.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	subsets, _, _ := unstructured.NestedSlice(endpoints.UnstructuredContent(), "subsets")
+	for _, subset := range subsets {
+		subset, ok := subset.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if addresses, _, _ := unstructured.NestedSlice(subset, "addresses"); len(addresses) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// webhookReady returns whether all of the Services a webhook configuration or APIService
+// references have ready endpoints. Items that don't reference a Service aren't ready, so
+// that they're restored after the other items.
+func (ctx *restoreContext) webhookReady(item deferredItem) bool {
+	services := ctx.webhookServices(item)
+	if len(services) == 0 {
+		return false
+	}
+
+	for _, service := range services {
+		ready, err := ctx.serviceReady(service)
+		if err != nil {
+			ctx.log.WithError(err).Warnf("Unable to check whether service %s is ready", service)
+			return false
+		}
+		if !ready {
+			return false
+		}
+	}
+	return true
+}
+
+// restoreReadyWebhooks restores the held back webhook configurations and APIServices whose
+// Services have ready endpoints, if the restore's webhook restore policy is ServiceReady.
+func (ctx *restoreContext) restoreReadyWebhooks() (Result, Result) {
+	if ctx.restore.Spec.WebhookRestorePolicy != velerov1api.WebhookRestorePolicyServiceReady {
+		return Result{}, Result{}
+	}
+
+	var ready, remaining []deferredItem
+	for _, item := range ctx.deferredWebhooks {
+		if ctx.webhookReady(item) {
+			ready = append(ready, item)
+		} else {
+			remaining = append(remaining, item)
+		}
+	}
+	ctx.deferredWebhooks = remaining
+
+	return ctx.restoreWebhooks(ready)
+}
+
+// restoreWebhooks restores held back webhook configurations and APIServices.
+func (ctx *restoreContext) restoreWebhooks(items []deferredItem) (Result, Result) {
+	warnings, errs := Result{}, Result{}
+
+	ctx.restoringWebhooks = true
+	defer func() { ctx.restoringWebhooks = false }()
+
+	for _, item := range items {
+		if ctx.canceled() {
+			break
+		}
+		ctx.log.Infof("Restoring held back %s %s", item.groupResource, item.obj.GetName())
+		w, e := ctx.restoreItem(item.obj, item.groupResource, item.namespace)
+		warnings.Merge(&w)
+		errs.Merge(&e)
+	}
+
+	return warnings, errs
+}
@@ -0,0 +1,125 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/test"
+)
+
+// TestRestoreWebhooks runs restores of admission webhook configurations with each webhook
+// restore policy, and verifies the order the items are created in.
+func TestRestoreWebhooks(t *testing.T) {
+	webhookConfiguration := func(name, serviceName string) *admissionregistrationv1.ValidatingWebhookConfiguration {
+		return &admissionregistrationv1.ValidatingWebhookConfiguration{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "ValidatingWebhookConfiguration"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name: name + ".example.com",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{Namespace: "ns-1", Name: serviceName},
+				},
+			}},
+		}
+	}
+	tarball := func() *test.TarWriter {
+		return test.NewTarWriter(t).
+			AddItems("validatingwebhookconfigurations.admissionregistration.k8s.io",
+				webhookConfiguration("ready", "svc-ready"),
+				webhookConfiguration("not-ready", "svc-not-ready"),
+			).
+			AddItems("pods", builder.ForPod("ns-1", "pod-1").Result())
+	}
+
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		want    []resourceID
+	}{
+		{
+			name:    "webhook configurations are restored after the other items by default",
+			restore: defaultRestore().Result(),
+			want: []resourceID{
+				{"pods", "ns-1/pod-1"},
+				{"validatingwebhookconfigurations.admissionregistration.k8s.io", "/not-ready"},
+				{"validatingwebhookconfigurations.admissionregistration.k8s.io", "/ready"},
+			},
+		},
+		{
+			name:    "webhook configurations whose services are ready are restored right away when the policy is ServiceReady",
+			restore: defaultRestore().WebhookRestorePolicy(velerov1api.WebhookRestorePolicyServiceReady).Result(),
+			want: []resourceID{
+				{"validatingwebhookconfigurations.admissionregistration.k8s.io", "/ready"},
+				{"pods", "ns-1/pod-1"},
+				{"validatingwebhookconfigurations.admissionregistration.k8s.io", "/not-ready"},
+			},
+		},
+		{
+			name:    "webhook configurations are restored in restore order when the policy is Immediate",
+			restore: defaultRestore().WebhookRestorePolicy(velerov1api.WebhookRestorePolicyImmediate).Result(),
+			want: []resourceID{
+				{"validatingwebhookconfigurations.admissionregistration.k8s.io", "/not-ready"},
+				{"validatingwebhookconfigurations.admissionregistration.k8s.io", "/ready"},
+				{"pods", "ns-1/pod-1"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.restorer.resourcePriorities = []string{"validatingwebhookconfigurations.admissionregistration.k8s.io", "pods"}
+
+			h.AddItems(t, test.Endpoints(
+				&corev1api.Endpoints{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Endpoints"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "svc-ready"},
+					Subsets:    []corev1api.EndpointSubset{{Addresses: []corev1api.EndpointAddress{{IP: "10.0.0.1"}}}},
+				},
+				&corev1api.Endpoints{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Endpoints"},
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "svc-not-ready"},
+					Subsets:    []corev1api.EndpointSubset{{NotReadyAddresses: []corev1api.EndpointAddress{{IP: "10.0.0.2"}}}},
+				},
+			))
+			h.AddItems(t, test.ValidatingWebhookConfigurations())
+			h.AddItems(t, test.Pods())
+
+			recorder := &createRecorder{t: t}
+			h.DynamicClient.PrependReactor("create", "*", recorder.reactor())
+
+			data := Request{
+				Log:          h.log,
+				Restore:      tc.restore,
+				Backup:       defaultBackup().Result(),
+				BackupReader: tarball().Done(),
+			}
+			warnings, errs := h.restorer.Restore(data, nil, nil, nil)
+
+			assertEmptyResults(t, warnings, errs)
+			assert.Equal(t, tc.want, recorder.resources)
+		})
+	}
+}
@@ -53,11 +53,17 @@ func NewAPIServer(t *testing.T) *APIServer {
 				{Group: "", Version: "v1", Resource: "persistentvolumeclaims"}:                             "PVCList",
 				{Group: "", Version: "v1", Resource: "secrets"}:                                            "SecretsList",
 				{Group: "", Version: "v1", Resource: "serviceaccounts"}:                                    "ServiceAccountsList",
+				{Group: "", Version: "v1", Resource: "services"}:                                           "ServicesList",
 				{Group: "apps", Version: "v1", Resource: "deployments"}:                                    "DeploymentsList",
 				{Group: "apiextensions.k8s.io", Version: "v1beta1", Resource: "customresourcedefinitions"}: "CRDList",
 				{Group: "velero.io", Version: "v1", Resource: "volumesnapshotlocations"}:                   "VSLList",
+				{Group: "velero.io", Version: "v1", Resource: "datadownloads"}:                             "DataDownloadList",
 				{Group: "extensions", Version: "v1", Resource: "deployments"}:                              "ExtDeploymentsList",
 				{Group: "velero.io", Version: "v1", Resource: "deployments"}:                               "VeleroDeploymentsList",
+				{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}:                       "StorageClassesList",
+				{Group: "scheduling.k8s.io", Version: "v1", Resource: "priorityclasses"}:                   "PriorityClassesList",
+				{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}:              "RoleBindingsList",
+				{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}:              "ClusterRolesList",
 			})
 		discoveryClient = &DiscoveryClient{FakeDiscovery: kubeClient.Discovery().(*discoveryfake.FakeDiscovery)}
 	)
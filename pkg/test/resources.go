@@ -173,3 +173,66 @@ func Services(items ...metav1.Object) *APIResource {
 		Items:      items,
 	}
 }
+
+func StorageClasses(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "storage.k8s.io",
+		Version:    "v1",
+		Name:       "storageclasses",
+		ShortName:  "sc",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
+func PriorityClasses(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "scheduling.k8s.io",
+		Version:    "v1",
+		Name:       "priorityclasses",
+		ShortName:  "pc",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
+func RoleBindings(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "rbac.authorization.k8s.io",
+		Version:    "v1",
+		Name:       "rolebindings",
+		Namespaced: true,
+		Items:      items,
+	}
+}
+
+func ClusterRoles(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "rbac.authorization.k8s.io",
+		Version:    "v1",
+		Name:       "clusterroles",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
+func ValidatingWebhookConfigurations(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "admissionregistration.k8s.io",
+		Version:    "v1",
+		Name:       "validatingwebhookconfigurations",
+		Namespaced: false,
+		Items:      items,
+	}
+}
+
+func Endpoints(items ...metav1.Object) *APIResource {
+	return &APIResource{
+		Group:      "",
+		Version:    "v1",
+		Name:       "endpoints",
+		ShortName:  "ep",
+		Namespaced: true,
+		Items:      items,
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/kopia/kopia/snapshot/snapshotfs"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/uploader"
 	"github.com/vmware-tanzu/velero/pkg/uploader/kopia"
@@ -41,9 +42,10 @@ var RestoreFunc = kopia.Restore
 
 //kopiaProvider recorded info related with kopiaProvider
 type kopiaProvider struct {
-	bkRepo     udmrepo.BackupRepo
-	credGetter *credentials.CredentialGetter
-	log        logrus.FieldLogger
+	bkRepo          udmrepo.BackupRepo
+	credGetter      *credentials.CredentialGetter
+	repoKeySelector *v1.SecretKeySelector
+	log             logrus.FieldLogger
 }
 
 //NewKopiaUploaderProvider initialized with open or create a repository
@@ -54,8 +56,9 @@ func NewKopiaUploaderProvider(
 	log logrus.FieldLogger,
 ) (Provider, error) {
 	kp := &kopiaProvider{
-		log:        log,
-		credGetter: credGetter,
+		log:             log,
+		credGetter:      credGetter,
+		repoKeySelector: repokeys.RepoKeySelector(backupRepo),
 	}
 	//repoUID which is used to generate kopia repository config with unique directory path
 	repoUID := string(backupRepo.GetUID())
@@ -157,7 +160,7 @@ func (kp *kopiaProvider) GetPassword(param interface{}) (string, error) {
 	if kp.credGetter.FromSecret == nil {
 		return "", errors.New("invalid credentials interface")
 	}
-	rawPass, err := kp.credGetter.FromSecret.Get(repokeys.RepoKeySelector())
+	rawPass, err := kp.credGetter.FromSecret.Get(kp.repoKeySelector)
 	if err != nil {
 		return "", errors.Wrap(err, "error to get password")
 	}
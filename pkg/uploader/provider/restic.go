@@ -135,8 +135,11 @@ func (rp *resticProvider) RunBackup(
 		backupCmd.ExtraFlags = append(backupCmd.ExtraFlags, fmt.Sprintf("--parent=%s", parentSnapshot))
 	}
 
-	summary, stderrBuf, err := restic.RunBackup(backupCmd, log, updater)
+	summary, stderrBuf, err := restic.RunBackup(ctx, backupCmd, log, updater)
 	if err != nil {
+		if ctx.Err() != nil {
+			return "", false, errors.Wrap(ctx.Err(), "restic backup was stopped")
+		}
 		if strings.Contains(err.Error(), "snapshot is empty") {
 			log.Debugf("Restic backup got empty dir with %s path", path)
 			return "", true, nil
@@ -177,8 +180,11 @@ func (rp *resticProvider) RunRestore(
 	if len(rp.extraFlags) != 0 {
 		restoreCmd.ExtraFlags = append(restoreCmd.ExtraFlags, rp.extraFlags...)
 	}
-	stdout, stderr, err := restic.RunRestore(restoreCmd, log, updater)
+	stdout, stderr, err := restic.RunRestore(ctx, restoreCmd, log, updater)
 
 	log.Infof("Run command=%s, stdout=%s, stderr=%s", restoreCmd.Command, stdout, stderr)
+	if err != nil && ctx.Err() != nil {
+		return errors.Wrap(ctx.Err(), "restic restore was stopped")
+	}
 	return err
 }
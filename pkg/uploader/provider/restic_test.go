@@ -38,7 +38,7 @@ func TestResticRunBackup(t *testing.T) {
 	testCases := []struct {
 		name              string
 		hookBackupFunc    func(repoIdentifier string, passwordFile string, path string, tags map[string]string) *restic.Command
-		hookRunBackupFunc func(backupCmd *restic.Command, log logrus.FieldLogger, updater uploader.ProgressUpdater) (string, string, error)
+		hookRunBackupFunc func(ctx context.Context, backupCmd *restic.Command, log logrus.FieldLogger, updater uploader.ProgressUpdater) (string, string, error)
 		errorHandleFunc   func(err error) bool
 	}{
 		{
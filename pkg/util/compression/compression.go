@@ -0,0 +1,147 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package compression compresses backup data with the algorithm configured on a
+// backup storage location, and decompresses it by detecting the algorithm from the
+// data itself.
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Algorithm returns the compression algorithm set in config, defaulting to gzip.
+func Algorithm(config *velerov1api.CompressionConfig) velerov1api.CompressionAlgorithm {
+	if config == nil || config.Algorithm == "" {
+		return velerov1api.CompressionAlgorithmGzip
+	}
+	return config.Algorithm
+}
+
+// Extension returns the file name extension of data compressed with algorithm, e.g. ".gz", or
+// an empty string if the data isn't compressed.
+func Extension(algorithm velerov1api.CompressionAlgorithm) string {
+	switch algorithm {
+	case velerov1api.CompressionAlgorithmZstd:
+		return ".zst"
+	case velerov1api.CompressionAlgorithmNone:
+		return ""
+	default:
+		return ".gz"
+	}
+}
+
+// Validate returns an error if config isn't a supported compression configuration.
+func Validate(config *velerov1api.CompressionConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	switch Algorithm(config) {
+	case velerov1api.CompressionAlgorithmGzip:
+		if config.Level < 0 || config.Level > gzip.BestCompression {
+			return errors.Errorf("invalid gzip compression level %d, must be between 1 and %d", config.Level, gzip.BestCompression)
+		}
+	case velerov1api.CompressionAlgorithmZstd:
+		if config.Level < 0 || config.Level > int(zstd.SpeedBestCompression) {
+			return errors.Errorf("invalid zstd compression level %d, must be between 1 and %d", config.Level, zstd.SpeedBestCompression)
+		}
+	case velerov1api.CompressionAlgorithmNone:
+		if config.Level != 0 {
+			return errors.New("compression level can't be set when compression is none")
+		}
+	default:
+		return errors.Errorf("unsupported compression algorithm %q", config.Algorithm)
+	}
+
+	return nil
+}
+
+// NewWriter returns a writer that compresses the data written to it into w as
+// configured by config. A nil config means gzip at the default level. Closing the
+// returned writer flushes it, but doesn't close w.
+func NewWriter(w io.Writer, config *velerov1api.CompressionConfig) (io.WriteCloser, error) {
+	if err := Validate(config); err != nil {
+		return nil, err
+	}
+
+	var level int
+	if config != nil {
+		level = config.Level
+	}
+
+	switch Algorithm(config) {
+	case velerov1api.CompressionAlgorithmZstd:
+		if level == 0 {
+			level = int(zstd.SpeedDefault)
+		}
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevel(level)))
+		return zw, errors.WithStack(err)
+	case velerov1api.CompressionAlgorithmNone:
+		return nopWriteCloser{w}, nil
+	default:
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		gzw, err := gzip.NewWriterLevel(w, level)
+		return gzw, errors.WithStack(err)
+	}
+}
+
+// NewReader returns a reader that decompresses the data read from r. The algorithm
+// is detected from the data, so data written with any algorithm NewWriter supports
+// can be read. Data that isn't gzip or zstd compressed is returned as is.
+func NewReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	// Peek returns fewer bytes along with an error if the data is shorter than the
+	// magic numbers, in which case it can't be compressed.
+	header, _ := br.Peek(len(zstdMagic))
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		gzr, err := gzip.NewReader(br)
+		return gzr, errors.WithStack(err)
+	case bytes.HasPrefix(header, zstdMagic):
+		zr, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return ioutil.NopCloser(br), nil
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -0,0 +1,117 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compression
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestExtension(t *testing.T) {
+	assert.Equal(t, ".gz", Extension(""))
+	assert.Equal(t, ".gz", Extension(velerov1api.CompressionAlgorithmGzip))
+	assert.Equal(t, ".zst", Extension(velerov1api.CompressionAlgorithmZstd))
+	assert.Equal(t, "", Extension(velerov1api.CompressionAlgorithmNone))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *velerov1api.CompressionConfig
+		wantErr bool
+	}{
+		{name: "nil config", config: nil},
+		{name: "default algorithm", config: &velerov1api.CompressionConfig{Level: 9}},
+		{name: "gzip level too high", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 10}, wantErr: true},
+		{name: "zstd", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 4}},
+		{name: "zstd level too high", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 5}, wantErr: true},
+		{name: "none", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmNone}},
+		{name: "none with level", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmNone, Level: 1}, wantErr: true},
+		{name: "unsupported algorithm", config: &velerov1api.CompressionConfig{Algorithm: "lz4"}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.config)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("velero backup contents "), 100)
+
+	tests := []struct {
+		name   string
+		config *velerov1api.CompressionConfig
+	}{
+		{name: "default", config: nil},
+		{name: "gzip", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmGzip, Level: 1}},
+		{name: "zstd", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd}},
+		{name: "zstd with level", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmZstd, Level: 4}},
+		{name: "none", config: &velerov1api.CompressionConfig{Algorithm: velerov1api.CompressionAlgorithmNone}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			w, err := NewWriter(buf, tc.config)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			if Algorithm(tc.config) == velerov1api.CompressionAlgorithmNone {
+				assert.Equal(t, data, buf.Bytes())
+			} else {
+				assert.Less(t, buf.Len(), len(data))
+			}
+
+			r, err := NewReader(buf)
+			require.NoError(t, err)
+			defer r.Close()
+
+			read, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, read)
+		})
+	}
+}
+
+func TestNewReaderShortData(t *testing.T) {
+	r, err := NewReader(bytes.NewBufferString("a"))
+	require.NoError(t, err)
+
+	read, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "a", string(read))
+}
+
+func TestNewWriterInvalidConfig(t *testing.T) {
+	_, err := NewWriter(new(bytes.Buffer), &velerov1api.CompressionConfig{Algorithm: "lz4"})
+	assert.Error(t, err)
+}